@@ -5,6 +5,16 @@ type JobType string
 
 // Defines all the background job types in the system.
 const (
-// Add other job types here as you need them, for example:
-// JobTypeSendWelcomeEmail JobType = "SEND_WELCOME_EMAIL"
+	// Personal data request (PDP law)
+	JobTypeUserDataExport  JobType = "USER_DATA_EXPORT"
+	JobTypeUserDataErasure JobType = "USER_DATA_ERASURE"
+
+	// User lifecycle (scheduled deactivation & dormant accounts)
+	JobTypeUserLifecyclePolicy JobType = "USER_LIFECYCLE_POLICY"
+
+	// Recycle bin retention purge
+	JobTypeRecycleBinPurge JobType = "RECYCLE_BIN_PURGE"
+
+	// Publication of the scheduled posts
+	JobTypePostPublishScheduled JobType = "POST_PUBLISH_SCHEDULED"
 )
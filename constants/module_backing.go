@@ -6,7 +6,11 @@ const (
 	BackingCreateFailedIDNotSet    = "failed to create backing: ID not set"
 	BackingTypeNotFound            = "Type not found"
 	BackingNotFound                = "backing with id %s not found"
+	BackingMoveSameType            = "Backing is already in the target type"
+	BackingMergeIntoItself         = "Backing cannot be merged into itself"
+	BackingMergeNameConflict       = "Target backing already has items named: %s"
 
 	// Success messages
-	BackingDeleteSuccess = "Successfully deleted Backing"
+	BackingDeleteSuccess    = "Successfully deleted Backing"
+	BackingStillUsedInItems = "Backing is still used in active items"
 )
@@ -0,0 +1,23 @@
+package constants
+
+const (
+	// Entities whose code is allocated by the code generator (helpers/codegen)
+	CodeEntityGroup      = "group"
+	CodeEntitySubGroup   = "sub_group"
+	CodeEntityType       = "type"
+	CodeEntityBacking    = "backing"
+	CodeEntityExpedition = "expedition"
+	CodeEntitySupplier   = "supplier"
+	CodeEntityCustomer   = "customer"
+	CodeEntityItem       = "item"
+
+	// Code policy defaults, same format as the legacy generate_*_code() functions ("01", "02", ...)
+	CodePolicyDefaultSeparator = "."
+	CodePolicyDefaultPadding   = 2
+
+	// Code policy errors
+	CodePolicyEntityNotFound       = "code policy entity %s not found"
+	CodePolicyInheritWithoutParent = "%s has no parent code to inherit"
+	CodePolicyParentRequired       = "parent_id is required because %s codes inherit the parent code"
+	CodePolicyParentNotFound       = "parent %s with id %s not found"
+)
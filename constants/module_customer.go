@@ -0,0 +1,26 @@
+package constants
+
+const (
+	CustomerContactTypeTelp  = "telp"
+	CustomerContactTypePhone = "hp"
+
+	CustomerAddressTypeBilling  = "billing"
+	CustomerAddressTypeShipping = "shipping"
+
+	// Parameter type holding the customer categories
+	CustomerCategoryParameterType = "customer_category"
+)
+
+const (
+	// Customer validation errors
+	CustomerNameAlreadyExists        = "Customer name already exists"
+	CustomerCreateFailedIDNotSet     = "failed to create customer: ID not set"
+	CustomerNotFound                 = "customer with id %s not found"
+	CustomerCategoryInvalid          = "customer category not found or is not a customer_category parameter"
+	CustomerExpeditionNotFound       = "expedition send not found"
+	CustomerAddressRegencyNotFound   = "address #%d: region not found or does not belong to the selected parent region"
+	CustomerAddressRegencyIncomplete = "address #%d: region requires its parent region (province > city > district > subdistrict)"
+
+	// Success messages
+	CustomerDeleteSuccess = "Successfully deleted Customer"
+)
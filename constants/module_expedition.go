@@ -13,9 +13,49 @@ const (
 	ExpeditionCreateFailedIDNotSet = "failed to create expedition: ID not set"
 	ExpeditionPhoneNumberExists    = "Phone number already exists: %s"
 	ExpeditionNotFound             = "expedition with id %s not found"
+	ExpeditionStillUsed            = "Expedition is still used in active suppliers or customers"
+	ExpeditionContactNotFound      = "expedition contact with id %s not found"
+
+	// Coverage & tariff validation errors
+	ExpeditionCoverageCityNotFound     = "city not found"
+	ExpeditionCoverageDistrictNotFound = "district not found in the selected city"
+	ExpeditionCoverageAlreadyExists    = "Area is already covered by this expedition"
+	ExpeditionCoverageNotFound         = "expedition coverage with id %s not found"
+	ExpeditionTariffCityNotFound       = "origin or destination city not found"
+	ExpeditionTariffAlreadyExists      = "Tariff for this route already exists"
+	ExpeditionTariffNotFound           = "expedition tariff with id %s not found"
+	ExpeditionQuoteDistrictNotFound    = "destination district not found in the destination city"
+
+	// Coverage & tariff import
+	ExpeditionImportFileNotFound          = "File not found. Use the 'file' field to upload the Excel file"
+	ExpeditionImportInvalidFileFormat     = "File must be in .xlsx or .xls format"
+	ExpeditionImportFileOpenFailed        = "Failed to open file"
+	ExpeditionImportExcelOpenFailed       = "failed to open Excel file"
+	ExpeditionImportExcelReadFailed       = "failed to read Excel file"
+	ExpeditionImportExcelInsufficientRows = "Excel file must have at least header row and one data row"
+	ExpeditionImportFailedPartial         = "Failed to import some rows"
+	ExpeditionImportFailed                = "Failed to import all rows"
+	ExpeditionImportTemplateCreateFailed  = "Failed to create template"
+	ExpeditionImportCityCodeRequired      = "city_code cannot be empty"
+	ExpeditionImportCityCodeNotFound      = "City with code '%s' was not found"
+	ExpeditionImportDistrictCodeNotFound  = "District with code '%s' was not found in the city"
+	ExpeditionImportOriginCodeRequired    = "origin_city_code cannot be empty"
+	ExpeditionImportDestinationRequired   = "destination_city_code cannot be empty"
+	ExpeditionImportNumberInvalid         = "%s must be a number greater than or equal to 0"
+	ExpeditionImportPricePerKgInvalid     = "price_per_kg must be a number greater than 0"
+	ExpeditionImportRowDuplicated         = "Duplicated with row %d"
+	ExpeditionImportBatchSaveFailed       = "Error saving rows in batch"
+
+	// Expedition import
+	ExpeditionImportNameRequired      = "expedition_name cannot be empty"
+	ExpeditionImportFieldTooLong      = "%s must be at most 255 characters"
+	ExpeditionImportCodeAlreadyExists = "Expedition code '%s' already exists"
 
 	// Success messages
-	ExpeditionDeleteSuccess = "Successfully deleted Expedition"
+	ExpeditionDeleteSuccess         = "Successfully deleted Expedition"
+	ExpeditionContactDeleteSuccess  = "Successfully deleted expedition contact"
+	ExpeditionCoverageDeleteSuccess = "Successfully deleted expedition coverage"
+	ExpeditionTariffDeleteSuccess   = "Successfully deleted expedition tariff"
 )
 
 // Helper functions for formatted error messages
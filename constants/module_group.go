@@ -10,3 +10,17 @@ const (
 	// Success messages
 	GroupDeleteSuccess = "Successfully deleted Group"
 )
+
+const (
+	// Catalog tree node levels (Group -> SubGroup -> Type -> Backing)
+	CatalogTreeLevelGroup    = "group"
+	CatalogTreeLevelSubGroup = "sub_group"
+	CatalogTreeLevelType     = "type"
+	CatalogTreeLevelBacking  = "backing"
+)
+
+const (
+	// Taxonomy operations recorded in taxonomy_operations (resource uses the catalog tree levels)
+	TaxonomyOperationMove  = "move"
+	TaxonomyOperationMerge = "merge"
+)
@@ -0,0 +1,53 @@
+package constants
+
+const (
+	ModuleTypeItem    = "item"
+	FileTypeItemImage = "image"
+
+	// Unit of measure stored as the base unit of an item
+	ItemDefaultBaseUom = "pcs"
+)
+
+const (
+	// Item validation errors
+	ItemNameAlreadyExistsInBacking = "Item name already exists in this backing"
+	ItemCodeAlreadyExists          = "SKU code %s already exists"
+	ItemCreateFailedIDNotSet       = "failed to create item: ID not set"
+	ItemNotFound                   = "item with id %s not found"
+	ItemBackingNotFound            = "Backing not found"
+	ItemUnitDuplicated             = "unit %s is listed more than once"
+	ItemUnitIsBaseUnit             = "unit %s is already the base unit of the item"
+	ItemBarcodeDuplicated          = "barcode %s is listed more than once"
+	ItemBarcodeAlreadyUsed         = "barcode %s is already used by another item"
+	ItemBarcodeUnitNotFound        = "barcode %s: unit %s is not a unit of the item"
+	ItemImageNotFound              = "image %s is not an image of the item"
+	ItemImageRequired              = "at least one image is required in the 'images' field"
+	ItemImageUploadFailed          = "Failed to upload image file"
+
+	// Success messages
+	ItemDeleteSuccess      = "Successfully deleted Item"
+	ItemImageDeleteSuccess = "Successfully deleted Item image"
+)
+
+const (
+	// Item import errors
+	ItemImportFileNotFound          = "File not found. Use the 'file' field to upload the Excel file"
+	ItemImportInvalidFileFormat     = "File must be in .xlsx or .xls format"
+	ItemImportFileOpenFailed        = "Failed to open file"
+	ItemImportExcelOpenFailed       = "failed to open Excel file"
+	ItemImportExcelReadFailed       = "failed to read Excel file"
+	ItemImportExcelInsufficientRows = "Excel file must have at least header row and one data row"
+	ItemImportFailedPartial         = "Failed to import some rows"
+	ItemImportFailed                = "Failed to import all rows"
+	ItemImportTemplateCreateFailed  = "Failed to create template"
+	ItemImportNameRequired          = "name cannot be empty"
+	ItemImportFieldTooLong          = "%s must be at most %d characters"
+	ItemImportBackingRequired       = "backing_code cannot be empty"
+	ItemImportBackingNotFound       = "Backing with code '%s' was not found"
+	ItemImportNumberInvalid         = "%s must be a number greater than 0"
+	ItemImportUnitInvalid           = "units must be written as uom=conversion separated by semicolon, got '%s'"
+	ItemImportBarcodeInvalid        = "barcodes must be written as barcode or barcode:uom separated by semicolon, got '%s'"
+	ItemImportIsActiveInvalid       = "is_active must be yes or no"
+	ItemImportRowDuplicated         = "Duplicated with row %d"
+	ItemImportBatchSaveFailed       = "Error saving rows in batch"
+)
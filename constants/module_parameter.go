@@ -5,4 +5,43 @@ const (
 	ParameterCodeAlreadyExists = "Parameter code already exists"
 	ParameterNameAlreadyExists = "Parameter name already exists"
 	ParameterNotFound          = "parameter with id %s not found"
+	ParameterParentInvalid     = "invalid parent parameter"
+	ParameterParentSelf        = "parent must not be the same as the parameter"
+	ParameterParentCycle       = "parent must not be a descendant of the parameter"
+	ParameterInUse             = "parameter %s or one of its descendants is used by other modules"
+
+	// Parameter type enforcement errors
+	ParameterTypeNotRegistered    = "parameter type %s is not registered"
+	ParameterParentTypeNotAllowed = "a parameter of type %s cannot have a parent of type %s"
+	ParameterChildTypeNotAllowed  = "the children of type %s of this parameter cannot have a parent of type %s"
+
+	// Parameter tree and ordering
+	ParameterTreeTypeRequired = "type is required"
+	ParameterReorderMismatch  = "ids must list every sibling exactly once"
+	ParameterReorderSuccess   = "Successfully reordered parameters"
+
+	// Parameter lookup (cached read access for other modules and the frontend)
+	ParameterLookupCodeNotFound           = "parameter %s not found"
+	ParameterLookupValueEmpty             = "parameter %s has no value"
+	ParameterLookupValueInvalid           = "value of parameter %s is not a valid %s"
+	ParameterLookupFilterRequired         = "type or parent_code is required"
+	ParameterLookupInvalidateChannel      = "parameter:lookup:invalidate"
+	ParameterLookupCacheTTLSecondsDefault = 300
+
+	// Parameter import
+	ParameterImportFileNotFound          = "File not found. Use the 'file' field to upload the Excel file"
+	ParameterImportInvalidFileFormat     = "File must be in .xlsx or .xls format"
+	ParameterImportFileOpenFailed        = "Failed to open file"
+	ParameterImportExcelOpenFailed       = "failed to open Excel file"
+	ParameterImportExcelReadFailed       = "failed to read Excel file"
+	ParameterImportExcelInsufficientRows = "Excel file must have at least header row and one data row"
+	ParameterImportFailedPartial         = "Failed to import some rows"
+	ParameterImportFailed                = "Failed to import all rows"
+	ParameterImportTemplateCreateFailed  = "Failed to create template"
+	ParameterImportFieldRequired         = "%s cannot be empty"
+	ParameterImportFieldTooLong          = "%s must be at most %d characters"
+	ParameterImportParentNotFound        = "Parent with code '%s' was not found"
+	ParameterImportParentRowFailed       = "Parent row %d failed"
+	ParameterImportRowDuplicated         = "Duplicated with row %d"
+	ParameterImportBatchSaveFailed       = "Error saving rows in batch"
 )
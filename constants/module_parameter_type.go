@@ -0,0 +1,71 @@
+package constants
+
+const (
+	// Value kinds of a parameter type, the value of a parameter is stored as text and parsed with its kind
+	ParameterValueKindString  = "string"
+	ParameterValueKindInt     = "int"
+	ParameterValueKindDecimal = "decimal"
+	ParameterValueKindBool    = "bool"
+	ParameterValueKindDate    = "date"
+	ParameterValueKindEnum    = "enum"
+	ParameterValueKindJSON    = "json"
+
+	// Parameter type validation errors
+	ParameterTypeCodeAlreadyExists    = "Parameter type %s already exists"
+	ParameterTypeNotFound             = "parameter type %s not found"
+	ParameterTypeValueKindInvalid     = "value_kind must be one of string, int, decimal, bool, date, enum, json"
+	ParameterTypeEnumValuesRequired   = "enum_values is required for an enum parameter type"
+	ParameterTypeEnumValuesNotAllowed = "enum_values is only allowed for an enum parameter type"
+	ParameterTypeEnumValueDuplicated  = "enum value %s is duplicated"
+	ParameterTypeSchemaInvalid        = "json_schema is invalid: %v"
+	ParameterTypeParentTypeNotFound   = "allowed parent type %s not found"
+	ParameterTypeInUse                = "Parameter type %s is used by %d parameter(s)"
+	ParameterTypeParameterInvalid     = "parameter %s no longer matches the type: %v"
+	ParameterTypeDeleteSuccess        = "Successfully deleted parameter type"
+
+	// Value errors, %[1]s is the parameter type
+	ParameterValueRequired     = "value is required for parameter type %s"
+	ParameterValueInvalidInt   = "value must be an integer for parameter type %s"
+	ParameterValueInvalidDec   = "value must be a decimal number for parameter type %s"
+	ParameterValueInvalidBool  = "value must be true or false for parameter type %s"
+	ParameterValueInvalidDate  = "value must be a date written as YYYY-MM-DD for parameter type %s"
+	ParameterValueInvalidEnum  = "value must be one of %s for parameter type %s"
+	ParameterValueInvalidJSON  = "value must be valid JSON for parameter type %s"
+	ParameterValueSchemaFailed = "value does not match the schema of parameter type %s: %v"
+
+	// JSON Schema errors (helpers/jsonschema), %s is the path of the checked value
+	JSONSchemaKeywordNotSupported = "keyword %s is not supported"
+	JSONSchemaKeywordInvalid      = "keyword %s is invalid"
+	JSONSchemaFormatNotSupported  = "format %s is not supported"
+	JSONSchemaTypeMismatch        = "%s must be of type %s"
+	JSONSchemaEnumMismatch        = "%s must be one of the allowed values"
+	JSONSchemaConstMismatch       = "%s must be equal to the constant value"
+	JSONSchemaMinimum             = "%s must be at least %v"
+	JSONSchemaMaximum             = "%s must be at most %v"
+	JSONSchemaExclusiveMinimum    = "%s must be greater than %v"
+	JSONSchemaExclusiveMaximum    = "%s must be less than %v"
+	JSONSchemaMultipleOf          = "%s must be a multiple of %v"
+	JSONSchemaMinLength           = "%s must be at least %d characters"
+	JSONSchemaMaxLength           = "%s must be at most %d characters"
+	JSONSchemaPattern             = "%s must match the pattern %s"
+	JSONSchemaFormat              = "%s must be a valid %s"
+	JSONSchemaRequired            = "%s is required"
+	JSONSchemaAdditionalProperty  = "%s is not allowed"
+	JSONSchemaMinItems            = "%s must have at least %d items"
+	JSONSchemaMaxItems            = "%s must have at most %d items"
+	JSONSchemaUniqueItems         = "%s must not contain duplicated items"
+	JSONSchemaMinProperties       = "%s must have at least %d properties"
+	JSONSchemaMaxProperties       = "%s must have at most %d properties"
+	JSONSchemaFalse               = "%s is not allowed by the schema"
+)
+
+// ParameterValueKinds lists every value kind of a parameter type
+var ParameterValueKinds = []string{
+	ParameterValueKindString,
+	ParameterValueKindInt,
+	ParameterValueKindDecimal,
+	ParameterValueKindBool,
+	ParameterValueKindDate,
+	ParameterValueKindEnum,
+	ParameterValueKindJSON,
+}
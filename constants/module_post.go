@@ -3,4 +3,53 @@ package constants
 const (
 	ModuleTypePost    = "post"
 	FileTypeThumbnail = "thumbnail"
+
+	// Post statuses
+	PostStatusDraft     = "draft"
+	PostStatusReview    = "review"
+	PostStatusPublished = "published"
+	PostStatusArchived  = "archived"
+
+	// Post workflow actions
+	PostActionSubmit    = "submit"
+	PostActionReject    = "reject"
+	PostActionPublish   = "publish"
+	PostActionUnpublish = "unpublish"
+	PostActionArchive   = "archive"
+
+	// Scheduler of the posts whose publish_at has passed
+	PostPublishIntervalMinutesDefault = 1
+
+	// Post workflow errors
+	PostNotFound             = "post with id %s not found"
+	PostTransitionNotAllowed = "cannot %s a post with status %s"
+	PostSubmitNotAuthor      = "only the author can submit a post for review"
+	PostEditNotAuthor        = "only the author or an editor can change a post"
+	PostStatusChanged        = "the status of the post was changed by someone else, reload and try again"
+	PostStatusInvalid        = "statuses must be one of: %s"
+
+	// Post revision actions
+	PostRevisionActionCreate  = "create"
+	PostRevisionActionUpdate  = "update"
+	PostRevisionActionRestore = "restore"
+
+	// Post revision errors
+	PostRevisionNotFound = "revision with id %s not found"
 )
+
+// PostStatuses are the statuses of the publishing workflow
+var PostStatuses = []string{PostStatusDraft, PostStatusReview, PostStatusPublished, PostStatusArchived}
+
+// PostRedraftStatuses are the statuses a post goes back to draft from when its author changes it,
+// so the change is reviewed again before it is public
+var PostRedraftStatuses = []string{PostStatusReview, PostStatusPublished}
+
+// PostTransitions lists the statuses each workflow action starts from.
+// publish moves a post to published, or keeps it in review with publish_at when scheduled in the future.
+var PostTransitions = map[string][]string{
+	PostActionSubmit:    {PostStatusDraft},
+	PostActionReject:    {PostStatusReview},
+	PostActionPublish:   {PostStatusDraft, PostStatusReview},
+	PostActionUnpublish: {PostStatusPublished, PostStatusArchived},
+	PostActionArchive:   {PostStatusDraft, PostStatusReview, PostStatusPublished},
+}
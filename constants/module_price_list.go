@@ -0,0 +1,46 @@
+package constants
+
+const (
+	ModuleTypePriceList = "price_list"
+)
+
+const (
+	// Price list validation errors
+	PriceListCodeAlreadyExists    = "Price list code %s already exists"
+	PriceListCreateFailedIDNotSet = "failed to create price list: ID not set"
+	PriceListNotFound             = "price list with id %s not found"
+	PriceListCategoryInvalid      = "customer category %s not found or is not a customer_category parameter"
+	PriceListItemNotFound         = "Item not found"
+	PriceListUomInvalid           = "unit %s is not a unit of the item"
+	PriceListPeriodInvalid        = "effective_to must be on or after effective_from"
+	PriceListPeriodOverlap        = "Another price of the item for unit %s and minimum quantity %s overlaps this period"
+	PriceListPriceNotFound        = "price with id %s not found in the price list"
+	PriceListNoPriceFound         = "No price found for the item, unit, quantity and date"
+
+	// Success messages
+	PriceListDeleteSuccess      = "Successfully deleted Price List"
+	PriceListPriceDeleteSuccess = "Successfully deleted price"
+)
+
+const (
+	// Price list import errors
+	PriceListImportFileNotFound          = "File not found. Use the 'file' field to upload the Excel file"
+	PriceListImportInvalidFileFormat     = "File must be in .xlsx or .xls format"
+	PriceListImportFileOpenFailed        = "Failed to open file"
+	PriceListImportExcelOpenFailed       = "failed to open Excel file"
+	PriceListImportExcelReadFailed       = "failed to read Excel file"
+	PriceListImportExcelInsufficientRows = "Excel file must have at least header row and one data row"
+	PriceListImportFailedPartial         = "Failed to import some rows"
+	PriceListImportFailed                = "Failed to import all rows"
+	PriceListImportTemplateCreateFailed  = "Failed to create template"
+	PriceListImportItemCodeRequired      = "item_code cannot be empty"
+	PriceListImportItemNotFound          = "Item with code '%s' was not found"
+	PriceListImportUomTooLong            = "uom must be at most 20 characters"
+	PriceListImportMinQtyInvalid         = "min_qty must be a number greater than 0"
+	PriceListImportPriceInvalid          = "price must be a number greater than or equal to 0"
+	PriceListImportDateRequired          = "effective_from cannot be empty"
+	PriceListImportDateInvalid           = "%s must be a date written as YYYY-MM-DD"
+	PriceListImportRowDuplicated         = "Duplicated with row %d"
+	PriceListImportRowOverlap            = "Period overlaps row %d"
+	PriceListImportBatchSaveFailed       = "Error saving rows in batch"
+)
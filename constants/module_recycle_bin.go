@@ -0,0 +1,35 @@
+package constants
+
+const (
+	// Recycle bin resources
+	RecycleBinResourceUsers        = "users"
+	RecycleBinResourceRoles        = "roles"
+	RecycleBinResourceGroups       = "groups"
+	RecycleBinResourceSubGroups    = "sub-groups"
+	RecycleBinResourceTypes        = "types"
+	RecycleBinResourceBackings     = "backings"
+	RecycleBinResourceExpeditions  = "expeditions"
+	RecycleBinResourceSuppliers    = "suppliers"
+	RecycleBinResourceCustomers    = "customers"
+	RecycleBinResourceParameters   = "parameters"
+	RecycleBinResourceProvinces    = "provinces"
+	RecycleBinResourceCities       = "cities"
+	RecycleBinResourceDistricts    = "districts"
+	RecycleBinResourceSubdistricts = "subdistricts"
+	RecycleBinResourcePosts        = "posts"
+	RecycleBinResourceItems        = "items"
+	RecycleBinResourcePriceLists   = "price-lists"
+
+	// Recycle bin retention defaults
+	RecycleBinRetentionDaysDefault        = 30
+	RecycleBinPurgeIntervalMinutesDefault = 1440
+
+	// Recycle bin errors
+	RecycleBinResourceNotFound  = "recycle bin resource %s not found"
+	RecycleBinItemNotFound      = "deleted %s with id %s not found"
+	RecycleBinRestoreConflict   = "cannot restore: an active record with the same %s already exists"
+	RecycleBinParentDeleted     = "cannot restore: the %s it belongs to is deleted, restore it first"
+	RecycleBinStillReferenced   = "cannot delete permanently: the record is still referenced by other data"
+	RecycleBinRestoreSuccess    = "Successfully restored %s"
+	RecycleBinHardDeleteSuccess = "Successfully deleted %s permanently"
+)
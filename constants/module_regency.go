@@ -24,6 +24,82 @@ const (
 	SubdistrictDistrictNotFound     = "District not found"
 	SubdistrictNotFound             = "subdistrict with id %s not found"
 
+	// Regency tree & search
+	RegencyLevelProvince    = "province"
+	RegencyLevelCity        = "city"
+	RegencyLevelDistrict    = "district"
+	RegencyLevelSubdistrict = "subdistrict"
+
+	RegencyStillUsed = "%s is still used in active supplier or customer addresses or expedition services"
+
+	RegencyTreeParentRequired  = "parent_id is required for level %s"
+	RegencyTreeParentNotNeeded = "parent_id is not allowed for level province"
+	RegencySearchLimitDefault  = 20
+	RegencySearchLimitMax      = 100
+	RegencyPathSeparator       = ", "
+
+	// Official region codes
+	RegencyCodeInvalidFormat = "code %s is not a valid %s code"
+	RegencyCodeAlreadyExists = "%s with code %s already exists"
+	RegencyCodeNotFound      = "region with code %s not found"
+
+	// Regency import
+	RegencyImportFileNotFound      = "File not found. Use the 'file' field to upload the dataset"
+	RegencyImportInvalidFileFormat = "File must be in .csv, .xlsx or .xls format"
+	RegencyImportFileOpenFailed    = "failed to open dataset file"
+	RegencyImportFileReadFailed    = "failed to read dataset file"
+	RegencyImportInsufficientRows  = "Dataset must have at least header row and one data row"
+	RegencyImportInvalidRows       = "Dataset has invalid rows, nothing was imported"
+	RegencyImportRowCodeRequired   = "code cannot be empty"
+	RegencyImportRowNameRequired   = "name cannot be empty"
+	RegencyImportRowCodeInvalid    = "code %s is not a valid region code"
+	RegencyImportRowCodeDuplicated = "code %s is duplicated on row %d"
+	RegencyImportRowParentMissing  = "parent code %s is not in the dataset"
+	RegencyImportTemplateFailed    = "Failed to create template"
+	RegencyImportActionAdded       = "added"
+	RegencyImportActionRenamed     = "renamed"
+	RegencyImportActionLinked      = "linked"
+	RegencyImportActionRemoved     = "removed"
+	RegencyImportSuccess           = "Successfully imported regency dataset"
+	RegencyImportDryRunSuccess     = "Regency dataset checked, nothing was imported (dry run)"
+
+	// Regency postal code & coordinates
+	RegencyPostalCodeInvalid      = "postal code %s must be 5 digits"
+	RegencyPostalCodeNotFound     = "no subdistrict found with postal code %s"
+	RegencyCoordinatePairRequired = "latitude and longitude must be sent together"
+	RegencyCoordinateInvalid      = "latitude must be between -90 and 90, longitude between -180 and 180"
+	RegencyNearestLevelInvalid    = "nearest lookup only supports level district or subdistrict"
+	RegencyNearestNotFound        = "no %s with coordinates found within %d km"
+	RegencyNearestLimitDefault    = 1
+	RegencyNearestLimitMax        = 20
+	RegencyNearestRadiusDefault   = 25  // km
+	RegencyNearestRadiusMax       = 200 // km
+
+	// Regency postal code & coordinates import
+	RegencyGeoImportRowCodeNotFound      = "region with code %s not found"
+	RegencyGeoImportRowLevelInvalid      = "code %s is not a district or subdistrict code"
+	RegencyGeoImportRowPostalCodeLevel   = "postal code is only stored on subdistricts"
+	RegencyGeoImportRowCoordinateInvalid = "latitude and longitude must be valid coordinates and filled together"
+	RegencyGeoImportRowEmpty             = "postal code or coordinates must be filled"
+	RegencyGeoImportSuccess              = "Successfully imported postal codes and coordinates"
+	RegencyGeoImportDryRunSuccess        = "Postal codes and coordinates checked, nothing was imported (dry run)"
+
+	// Regency boundaries
+	RegencyLevelInvalid               = "level must be one of province, city, district, subdistrict"
+	RegencyRegionNotFound             = "%s with id %s not found"
+	RegencyBoundaryNotFound           = "boundary of %s %s not found"
+	RegencyBoundaryInvalidType        = "GeoJSON type %s is not supported, use Polygon, MultiPolygon, Feature or FeatureCollection"
+	RegencyBoundaryInvalidGeometry    = "invalid GeoJSON geometry: %s"
+	RegencyBoundaryEmpty              = "GeoJSON has no polygon"
+	RegencyBoundaryRingTooShort       = "a polygon ring needs at least 4 positions"
+	RegencyBoundaryRingNotClosed      = "a polygon ring must end with its first position"
+	RegencyBoundaryPositionInvalid    = "position %v is not a valid [longitude, latitude]"
+	RegencyBoundarySaveSuccess        = "Successfully saved region boundary"
+	RegencyBoundaryDeleteSuccess      = "Successfully deleted region boundary"
+	RegencyLocateNotFound             = "no region boundary contains the coordinate"
+	RegencyLocateMatchedBoundary      = "boundary"
+	RegencyLocateMatchedNearestCenter = "nearest_centroid"
+
 	// Success messages
 	ProvinceDeleteSuccess    = "Successfully deleted Province"
 	CityDeleteSuccess        = "Successfully deleted City"
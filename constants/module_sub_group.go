@@ -6,6 +6,9 @@ const (
 	SubGroupCreateFailedIDNotSet = "failed to create sub-group: ID not set"
 	SubGroupGroupNotFound        = "Goods group not found"
 	SubGroupNotFound             = "sub-group with id %s not found"
+	SubGroupMoveSameGroup        = "Sub-group is already in the target group"
+	SubGroupMergeIntoItself      = "Sub-group cannot be merged into itself"
+	SubGroupMergeNameConflict    = "Target sub-group already has types named: %s"
 
 	// Success messages
 	SubGroupDeleteSuccess    = "Successfully deleted Sub Group"
@@ -0,0 +1,19 @@
+package constants
+
+const (
+	SupplierContactTypeTelp  = "telp"
+	SupplierContactTypePhone = "hp"
+)
+
+const (
+	// Supplier validation errors
+	SupplierNameAlreadyExists        = "Supplier name already exists"
+	SupplierCreateFailedIDNotSet     = "failed to create supplier: ID not set"
+	SupplierNotFound                 = "supplier with id %s not found"
+	SupplierExpeditionNotFound       = "expedition arrives not found"
+	SupplierAddressRegencyNotFound   = "address region not found or does not belong to the selected parent region"
+	SupplierAddressRegencyIncomplete = "address region requires its parent region (province > city > district > subdistrict)"
+
+	// Success messages
+	SupplierDeleteSuccess = "Successfully deleted Supplier"
+)
@@ -0,0 +1,63 @@
+package constants
+
+const (
+	// Translatable entities (translations.entity_type)
+	TranslationEntityParameter   = "parameter"
+	TranslationEntityGroup       = "group"
+	TranslationEntitySubGroup    = "sub_group"
+	TranslationEntityType        = "type"
+	TranslationEntityProvince    = "province"
+	TranslationEntityCity        = "city"
+	TranslationEntityDistrict    = "district"
+	TranslationEntitySubdistrict = "subdistrict"
+
+	// Translatable fields (translations.field)
+	TranslationFieldName = "name"
+
+	// Locales, the entity tables hold the values in the base locale
+	TranslationLocaleBase    = "id"
+	TranslationLocaleEnglish = "en"
+
+	// Translation validation errors
+	TranslationNotFound          = "translation with id %s not found"
+	TranslationAlreadyExists     = "translation already exists for this entity, field and locale"
+	TranslationEntityTypeInvalid = "entity_type must be one of: %s"
+	TranslationFieldInvalid      = "field must be one of: %s"
+	TranslationLocaleInvalid     = "locale must be one of: %s"
+	TranslationEntityNotFound    = "%s with id %s not found"
+	TranslationEntityCodeMissing = "%s with code '%s' was not found"
+
+	// Translation import
+	TranslationImportFileNotFound          = "File not found. Use the 'file' field to upload the Excel file"
+	TranslationImportInvalidFileFormat     = "File must be in .xlsx or .xls format"
+	TranslationImportFileOpenFailed        = "Failed to open file"
+	TranslationImportExcelOpenFailed       = "failed to open Excel file"
+	TranslationImportExcelReadFailed       = "failed to read Excel file"
+	TranslationImportExcelInsufficientRows = "Excel file must have at least header row and one data row"
+	TranslationImportFailedPartial         = "Failed to import some rows"
+	TranslationImportFailed                = "Failed to import all rows"
+	TranslationImportTemplateCreateFailed  = "Failed to create template"
+	TranslationImportFieldRequired         = "%s cannot be empty"
+	TranslationImportEntityRequired        = "entity_id or entity_code is required"
+	TranslationImportEntityIDInvalid       = "entity_id is not a valid UUID"
+	TranslationImportRowDuplicated         = "Duplicated with row %d"
+	TranslationImportBatchSaveFailed       = "Error saving rows in batch"
+)
+
+// TranslationEntityTypes are the entities whose fields can be translated
+var TranslationEntityTypes = []string{
+	TranslationEntityParameter,
+	TranslationEntityGroup,
+	TranslationEntitySubGroup,
+	TranslationEntityType,
+	TranslationEntityProvince,
+	TranslationEntityCity,
+	TranslationEntityDistrict,
+	TranslationEntitySubdistrict,
+}
+
+// TranslationFields are the fields that can be translated, for every entity
+var TranslationFields = []string{TranslationFieldName}
+
+// TranslationLocales are the locales translations can be written in, the base locale has no translations
+var TranslationLocales = []string{TranslationLocaleEnglish}
@@ -6,6 +6,9 @@ const (
 	TypeCreateFailedIDNotSet = "failed to create type: ID not set"
 	TypeSubGroupNotFound     = "Sub-group not found"
 	TypeNotFound             = "type with id %s not found"
+	TypeMoveSameSubGroup     = "Type is already in the target sub-group"
+	TypeMergeIntoItself      = "Type cannot be merged into itself"
+	TypeMergeNameConflict    = "Target type already has backings named: %s"
 
 	// Success messages
 	TypeDeleteSuccess       = "Successfully deleted Type"
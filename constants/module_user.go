@@ -50,3 +50,97 @@ const (
 	ModuleTypeUser = "user"
 	FileTypeAvatar = "avatar"
 )
+
+const (
+	// Personal data request (PDP law) types & statuses
+	UserDataRequestTypeExport     = "export"
+	UserDataRequestTypeErasure    = "erasure"
+	UserDataRequestStatusPending  = "pending"
+	UserDataRequestStatusRunning  = "processing"
+	UserDataRequestStatusComplete = "completed"
+	UserDataRequestStatusFailed   = "failed"
+
+	// Personal data request storage & anonymization
+	UserDataExportStoragePath = "user-data-exports"
+	UserErasedFullName        = "Deleted User"
+	UserErasedUsernamePrefix  = "deleted_"
+	UserErasedEmailDomain     = "anonymized.invalid"
+
+	// Personal data request errors
+	UserDataRequestNotFound       = "user data request with id %s not found"
+	UserDataRequestAlreadyQueued  = "There is already a %s request being processed for this user"
+	UserDataRequestDispatchFailed = "Failed to queue user data request, please try again later"
+	UserDataRequestInvalidState   = "User data request is not in pending state"
+	UserDataRequestCannotErase    = "User data cannot be erased because deletable is false"
+	UserDataRequestExportNotReady = "User data export is not ready yet"
+	UserDataRequestCreatedMessage = "User data request has been queued"
+)
+
+const (
+	// User lifecycle (scheduled deactivation & dormant accounts) actions & reasons
+	UserLifecycleActionBlock      = "block"
+	UserLifecycleActionDeactivate = "deactivate"
+	UserLifecycleReasonDormant    = "dormant"
+	UserLifecycleReasonScheduled  = "scheduled"
+
+	// User lifecycle defaults (overridable from config "user.*")
+	UserDormantBlockDaysDefault         = 90
+	UserDormantDeactivateDaysDefault    = 180
+	UserLifecycleWarningDaysDefault     = 7
+	UserLifecycleIntervalMinutesDefault = 60
+	UserLifecycleReportDaysDefault      = 30
+	UserLifecycleReportDaysMax          = 365
+
+	// User lifecycle emails
+	UserLifecycleWarningSubject    = "Your account will be %s soon"
+	UserLifecycleWarningMessage    = "Your account will be %s on %s because %s. Please log in before that date or contact your administrator if this is not expected."
+	UserLifecycleWarningDormant    = "it has not been used for a long time"
+	UserLifecycleWarningScheduled  = "it has been scheduled by an administrator"
+	UserLifecycleAppliedSubject    = "Your account has been %s"
+	UserLifecycleAppliedMessage    = "Your account has been %s because %s. Please contact your administrator to regain access."
+	UserLifecycleWarningDateFormat = "02 January 2006"
+
+	// User lifecycle errors
+	UserDeactivateAtMustBeFuture   = "deactivate_at must be a future time"
+	UserLifecycleReportInvalidDays = "days must be between 1 and %d"
+)
+
+const (
+	// User status history actions
+	UserStatusActionBlock      = "block"
+	UserStatusActionUnblock    = "unblock"
+	UserStatusActionActivate   = "activate"
+	UserStatusActionDeactivate = "deactivate"
+	UserStatusChangedBySystem  = "system"
+
+	// User status history reasons for automatic changes
+	UserStatusReasonAutoUnblock = "Automatic unblock after the block period ended"
+	UserStatusReasonDormant     = "Account has not been used for a long time"
+	UserStatusReasonScheduled   = "Scheduled deactivation"
+
+	// User status change emails
+	UserStatusChangedSubject       = "Your account has been %s"
+	UserStatusChangedMessage       = "Your account has been %s by an administrator. Reason: %s"
+	UserStatusChangedUntilMessage  = " It will be unblocked automatically on %s."
+	UserStatusChangedUntilFormat   = "02 January 2006 15:04 MST"
+	UserStatusAutoUnblockedMessage = "Your account has been unblocked automatically because the block period has ended."
+
+	// User status change errors
+	UserUnblockAtMustBeFuture = "unblock_at must be a future time"
+	UserUnblockAtOnlyOnBlock  = "unblock_at can only be set when blocking a user"
+)
+
+const (
+	// User bulk operations
+	UserBulkActionAssignRole         = "assign_role"
+	UserBulkActionBlock              = "block"
+	UserBulkActionUnblock            = "unblock"
+	UserBulkActionActivate           = "activate"
+	UserBulkActionDeactivate         = "deactivate"
+	UserBulkActionForcePasswordReset = "force_password_reset"
+	UserBulkActionDelete             = "delete"
+	UserBulkSelfNotAllowed           = "cannot %s your own account"
+	UserBulkProtectedUser            = "user is protected and cannot be changed"
+	UserForcePasswordResetSubject    = "Password reset required"
+	UserForcePasswordResetMessage    = "An administrator requires you to change your password. Please sign in and set a new password."
+)
@@ -11,6 +11,10 @@ const (
 	ContentType             = "application/json"
 	FieldContentType        = "Content-Type"
 	FieldContentDisposition = "Content-Disposition"
+	FieldETag               = "ETag"
+	FieldIfNoneMatch        = "If-None-Match"
+	FieldAcceptLanguage     = "Accept-Language"
+	FieldContentLanguage    = "Content-Language"
 
 	ErrorJson = "Error decoding JSON : "
 
@@ -76,3 +80,10 @@ const (
 
 	ErrMalformedUserContext = "Could not process user data from context"
 )
+
+const (
+	// Phone numbers are stored in E.164 using the Indonesian country code
+	PhoneCountryCodeIndonesia = "62"
+	PhoneNumberEmpty          = "phone number cannot be empty"
+	PhoneNumberInvalid        = "phone number %s is not a valid Indonesian number"
+)
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS user_data_requests_created_at_index;
+DROP INDEX IF EXISTS user_data_requests_status_index;
+DROP INDEX IF EXISTS user_data_requests_request_type_index;
+DROP INDEX IF EXISTS user_data_requests_user_id_index;
+DROP INDEX IF EXISTS user_data_requests_id_index;
+
+DROP TABLE IF EXISTS user_data_requests;
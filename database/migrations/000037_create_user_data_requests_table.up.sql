@@ -0,0 +1,22 @@
+-- Personal data requests (PDP law): export & erasure audit trail
+CREATE TABLE IF NOT EXISTS user_data_requests (
+  id UUID DEFAULT uuid_generate_v7() PRIMARY KEY NOT NULL,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+  request_type VARCHAR(50) NOT NULL,
+  status VARCHAR(50) NOT NULL DEFAULT 'pending',
+  file_path TEXT,
+  error_message TEXT,
+  requested_by VARCHAR(255),
+  processed_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+);
+
+COMMENT ON COLUMN user_data_requests.request_type IS 'export / erasure';
+COMMENT ON COLUMN user_data_requests.status IS 'pending / processing / completed / failed';
+
+CREATE INDEX IF NOT EXISTS user_data_requests_id_index ON user_data_requests (id);
+CREATE INDEX IF NOT EXISTS user_data_requests_user_id_index ON user_data_requests (user_id);
+CREATE INDEX IF NOT EXISTS user_data_requests_request_type_index ON user_data_requests (request_type);
+CREATE INDEX IF NOT EXISTS user_data_requests_status_index ON user_data_requests (status);
+CREATE INDEX IF NOT EXISTS user_data_requests_created_at_index ON user_data_requests (created_at);
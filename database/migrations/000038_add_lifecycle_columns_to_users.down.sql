@@ -0,0 +1,11 @@
+-- Rollback: Remove lifecycle columns from users table
+
+-- Drop indexes first
+DROP INDEX IF EXISTS users_last_login_at_index;
+DROP INDEX IF EXISTS users_deactivate_at_index;
+
+-- Drop columns
+ALTER TABLE users
+DROP COLUMN IF EXISTS last_login_at,
+DROP COLUMN IF EXISTS deactivate_at,
+DROP COLUMN IF EXISTS dormant_warned_at;
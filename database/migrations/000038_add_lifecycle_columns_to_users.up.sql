@@ -0,0 +1,12 @@
+-- Add lifecycle columns to users table
+-- last_login_at: updated on every successful login, used by the dormant-account policy
+-- deactivate_at: scheduled deactivation (e.g. employee leaving the company)
+-- dormant_warned_at: last time a warning email was sent before a scheduled / dormant action
+
+ALTER TABLE users 
+ADD COLUMN IF NOT EXISTS last_login_at TIMESTAMP,
+ADD COLUMN IF NOT EXISTS deactivate_at TIMESTAMP,
+ADD COLUMN IF NOT EXISTS dormant_warned_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS users_last_login_at_index ON users (last_login_at);
+CREATE INDEX IF NOT EXISTS users_deactivate_at_index ON users (deactivate_at);
@@ -0,0 +1,11 @@
+-- Drop indexes first
+DROP INDEX IF EXISTS users_auto_unblock_at_index;
+DROP INDEX IF EXISTS user_status_histories_id_index;
+DROP INDEX IF EXISTS user_status_histories_user_id_index;
+DROP INDEX IF EXISTS user_status_histories_created_at_index;
+
+-- Drop columns & table
+ALTER TABLE users
+DROP COLUMN IF EXISTS auto_unblock_at;
+
+DROP TABLE IF EXISTS user_status_histories;
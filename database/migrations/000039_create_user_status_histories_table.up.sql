@@ -0,0 +1,23 @@
+-- User status change history (block / unblock / activate / deactivate) with reason
+CREATE TABLE IF NOT EXISTS user_status_histories (
+  id UUID DEFAULT uuid_generate_v7() PRIMARY KEY NOT NULL,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  action VARCHAR(50) NOT NULL,
+  reason TEXT NOT NULL,
+  unblock_at TIMESTAMP,
+  created_by VARCHAR(255),
+  created_at TIMESTAMP NOT NULL
+);
+
+COMMENT ON COLUMN user_status_histories.action IS 'block / unblock / activate / deactivate';
+COMMENT ON COLUMN user_status_histories.created_by IS 'user id of the actor, or "system" for automatic changes';
+
+CREATE INDEX IF NOT EXISTS user_status_histories_id_index ON user_status_histories (id);
+CREATE INDEX IF NOT EXISTS user_status_histories_user_id_index ON user_status_histories (user_id);
+CREATE INDEX IF NOT EXISTS user_status_histories_created_at_index ON user_status_histories (created_at);
+
+-- Optional automatic unblock time of a blocked user
+ALTER TABLE users 
+ADD COLUMN IF NOT EXISTS auto_unblock_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS users_auto_unblock_at_index ON users (auto_unblock_at);
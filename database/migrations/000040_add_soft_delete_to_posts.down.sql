@@ -0,0 +1,9 @@
+-- Rollback: Remove soft delete columns from posts table
+
+-- Drop indexes first
+DROP INDEX IF EXISTS posts_deleted_at_index;
+
+-- Drop columns
+ALTER TABLE posts
+DROP COLUMN IF EXISTS deleted_at,
+DROP COLUMN IF EXISTS deleted_by;
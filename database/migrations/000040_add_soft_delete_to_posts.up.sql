@@ -0,0 +1,8 @@
+-- Add soft delete columns to posts table so deleted posts go to the recycle bin
+-- instead of being removed permanently
+
+ALTER TABLE posts
+ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP,
+ADD COLUMN IF NOT EXISTS deleted_by VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS posts_deleted_at_index ON posts (deleted_at);
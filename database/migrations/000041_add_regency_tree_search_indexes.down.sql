@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS subdistricts_district_id_index;
+DROP INDEX IF EXISTS districts_city_id_index;
+DROP INDEX IF EXISTS cities_province_id_index;
+DROP INDEX IF EXISTS district_name_trgm_idx;
+DROP INDEX IF EXISTS province_name_trgm_idx;
@@ -0,0 +1,9 @@
+-- Trigram indexes for the cross level regency search (cities and subdistricts are covered by 000024)
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE INDEX IF NOT EXISTS province_name_trgm_idx ON provinces USING gin (LOWER(REPLACE(name, ' ', '')) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS district_name_trgm_idx ON districts USING gin (LOWER(REPLACE(name, ' ', '')) gin_trgm_ops);
+
+-- Parent lookups used by the lazy tree
+CREATE INDEX IF NOT EXISTS cities_province_id_index ON cities (province_id);
+CREATE INDEX IF NOT EXISTS districts_city_id_index ON districts (city_id);
+CREATE INDEX IF NOT EXISTS subdistricts_district_id_index ON subdistricts (district_id);
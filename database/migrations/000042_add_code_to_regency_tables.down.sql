@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS subdistricts_code_unique;
+DROP INDEX IF EXISTS districts_code_unique;
+DROP INDEX IF EXISTS cities_code_unique;
+DROP INDEX IF EXISTS provinces_code_unique;
+
+ALTER TABLE subdistricts DROP COLUMN IF EXISTS code;
+ALTER TABLE districts DROP COLUMN IF EXISTS code;
+ALTER TABLE cities DROP COLUMN IF EXISTS code;
+ALTER TABLE provinces DROP COLUMN IF EXISTS code;
@@ -0,0 +1,11 @@
+-- Official Kemendagri / BPS region codes, e.g. 32 (province), 32.73 (city), 32.73.01 (district), 32.73.01.1001 (subdistrict)
+ALTER TABLE provinces ADD COLUMN IF NOT EXISTS code VARCHAR(20);
+ALTER TABLE cities ADD COLUMN IF NOT EXISTS code VARCHAR(20);
+ALTER TABLE districts ADD COLUMN IF NOT EXISTS code VARCHAR(20);
+ALTER TABLE subdistricts ADD COLUMN IF NOT EXISTS code VARCHAR(20);
+
+-- Unique per level (excluding soft-deleted records)
+CREATE UNIQUE INDEX IF NOT EXISTS provinces_code_unique ON provinces (code) WHERE deleted_at IS NULL AND code IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS cities_code_unique ON cities (code) WHERE deleted_at IS NULL AND code IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS districts_code_unique ON districts (code) WHERE deleted_at IS NULL AND code IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS subdistricts_code_unique ON subdistricts (code) WHERE deleted_at IS NULL AND code IS NOT NULL;
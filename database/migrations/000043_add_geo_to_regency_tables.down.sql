@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_districts_lat_long;
+DROP INDEX IF EXISTS idx_subdistricts_lat_long;
+DROP INDEX IF EXISTS idx_subdistricts_postal_code;
+
+ALTER TABLE districts DROP COLUMN IF EXISTS longitude;
+ALTER TABLE districts DROP COLUMN IF EXISTS latitude;
+ALTER TABLE subdistricts DROP COLUMN IF EXISTS longitude;
+ALTER TABLE subdistricts DROP COLUMN IF EXISTS latitude;
+ALTER TABLE subdistricts DROP COLUMN IF EXISTS postal_code;
@@ -0,0 +1,13 @@
+-- Postal code and centroid of subdistricts (kelurahan / desa), centroid of districts (kecamatan)
+ALTER TABLE subdistricts ADD COLUMN IF NOT EXISTS postal_code VARCHAR(10);
+ALTER TABLE subdistricts ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION;
+ALTER TABLE subdistricts ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+ALTER TABLE districts ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION;
+ALTER TABLE districts ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+
+-- Postal code lookup
+CREATE INDEX IF NOT EXISTS idx_subdistricts_postal_code ON subdistricts (postal_code) WHERE deleted_at IS NULL AND postal_code IS NOT NULL;
+
+-- Bounding box of the nearest-region lookup
+CREATE INDEX IF NOT EXISTS idx_subdistricts_lat_long ON subdistricts (latitude, longitude) WHERE deleted_at IS NULL AND latitude IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_districts_lat_long ON districts (latitude, longitude) WHERE deleted_at IS NULL AND latitude IS NOT NULL;
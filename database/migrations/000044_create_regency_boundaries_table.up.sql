@@ -0,0 +1,22 @@
+-- GeoJSON boundary of a province / city / district / subdistrict, normalized to a MultiPolygon.
+-- The bounding box narrows the point-in-polygon lookup without requiring PostGIS.
+CREATE TABLE IF NOT EXISTS regency_boundaries (
+  id UUID DEFAULT uuid_generate_v7() PRIMARY KEY NOT NULL,
+  level VARCHAR(20) NOT NULL,
+  region_id UUID NOT NULL,
+  geometry JSONB NOT NULL,
+  min_latitude DOUBLE PRECISION NOT NULL,
+  max_latitude DOUBLE PRECISION NOT NULL,
+  min_longitude DOUBLE PRECISION NOT NULL,
+  max_longitude DOUBLE PRECISION NOT NULL,
+  created_by VARCHAR(255),
+  updated_by VARCHAR(255),
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+);
+
+COMMENT ON COLUMN regency_boundaries.level IS 'province / city / district / subdistrict';
+COMMENT ON COLUMN regency_boundaries.region_id IS 'id of the province, city, district or subdistrict';
+
+CREATE UNIQUE INDEX IF NOT EXISTS regency_boundaries_level_region_unique ON regency_boundaries (level, region_id);
+CREATE INDEX IF NOT EXISTS regency_boundaries_bbox_index ON regency_boundaries (level, min_latitude, max_latitude, min_longitude, max_longitude);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS supplier_contacts;
+DROP TABLE IF EXISTS suppliers;
+DROP FUNCTION IF EXISTS generate_supplier_code();
+DROP SEQUENCE IF EXISTS supplier_code_seq;
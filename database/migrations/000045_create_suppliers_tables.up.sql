@@ -0,0 +1,84 @@
+-- Create sequence for supplier_code starting from 1
+CREATE SEQUENCE IF NOT EXISTS supplier_code_seq START WITH 1 INCREMENT BY 1;
+
+-- Function to generate formatted supplier_code: "0" + seq if 1 digit, else just seq
+CREATE OR REPLACE FUNCTION generate_supplier_code()
+RETURNS VARCHAR AS $$
+DECLARE
+  seq_val BIGINT;
+  formatted_code VARCHAR;
+BEGIN
+  seq_val := nextval('supplier_code_seq');
+  IF seq_val < 10 THEN
+    formatted_code := '0' || seq_val::VARCHAR;
+  ELSE
+    formatted_code := seq_val::VARCHAR;
+  END IF;
+  RETURN formatted_code;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Create table suppliers
+CREATE TABLE IF NOT EXISTS suppliers (
+  id UUID DEFAULT uuid_generate_v7() PRIMARY KEY NOT NULL,
+  supplier_code VARCHAR(255) NOT NULL UNIQUE DEFAULT generate_supplier_code(),
+  supplier_name VARCHAR(255),
+  address VARCHAR(255),
+  province_id UUID REFERENCES provinces(id) ON DELETE SET NULL,
+  city_id UUID REFERENCES cities(id) ON DELETE SET NULL,
+  district_id UUID REFERENCES districts(id) ON DELETE SET NULL,
+  subdistrict_id UUID REFERENCES subdistricts(id) ON DELETE SET NULL,
+  expedition_arrives_id UUID REFERENCES expeditions(id) ON DELETE SET NULL,
+  notes TEXT,
+  created_at TIMESTAMP,
+  created_by VARCHAR(255),
+  updated_at TIMESTAMP,
+  updated_by VARCHAR(255),
+  deleted_at TIMESTAMP,
+  deleted_by VARCHAR(255)
+);
+
+COMMENT ON COLUMN suppliers.expedition_arrives_id IS 'default expedition used for goods arriving from this supplier';
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS suppliers_supplier_code_index ON suppliers (supplier_code);
+CREATE INDEX IF NOT EXISTS suppliers_supplier_name_index ON suppliers (supplier_name);
+CREATE INDEX IF NOT EXISTS suppliers_city_id_index ON suppliers (city_id);
+CREATE INDEX IF NOT EXISTS suppliers_expedition_arrives_id_index ON suppliers (expedition_arrives_id);
+CREATE INDEX IF NOT EXISTS suppliers_created_at_index ON suppliers (created_at);
+CREATE INDEX IF NOT EXISTS suppliers_updated_at_index ON suppliers (updated_at);
+CREATE INDEX IF NOT EXISTS suppliers_deleted_at_index ON suppliers (deleted_at);
+
+-- Trigram indexes for search (supplier_name, supplier_code, address)
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE INDEX IF NOT EXISTS suppliers_supplier_name_trgm_idx ON suppliers USING gin (LOWER(REPLACE(supplier_name, ' ', '')) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS suppliers_supplier_code_trgm_idx ON suppliers USING gin (LOWER(REPLACE(supplier_code, ' ', '')) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS suppliers_address_trgm_idx ON suppliers USING gin (LOWER(REPLACE(address, ' ', '')) gin_trgm_ops);
+
+-- Create supplier_contacts table
+CREATE TABLE IF NOT EXISTS supplier_contacts (
+  id UUID DEFAULT uuid_generate_v7() PRIMARY KEY NOT NULL,
+  supplier_id UUID NOT NULL REFERENCES suppliers(id) ON DELETE CASCADE,
+  phone_type VARCHAR(50) NOT NULL,
+  phone_number VARCHAR(50) NOT NULL,
+  area_code VARCHAR(255),
+  is_primary BOOLEAN DEFAULT false,
+  created_at TIMESTAMP,
+  created_by VARCHAR(255),
+  updated_at TIMESTAMP,
+  updated_by VARCHAR(255),
+  deleted_at TIMESTAMP,
+  deleted_by VARCHAR(255)
+);
+
+COMMENT ON COLUMN supplier_contacts.phone_type IS 'telp / hp';
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS supplier_contacts_supplier_id_index ON supplier_contacts (supplier_id);
+CREATE INDEX IF NOT EXISTS supplier_contacts_phone_number_index ON supplier_contacts (phone_number);
+CREATE INDEX IF NOT EXISTS supplier_contacts_deleted_at_index ON supplier_contacts (deleted_at);
+
+-- Constraint: Only one primary contact per supplier and phone type (excluding soft-deleted records)
+CREATE UNIQUE INDEX IF NOT EXISTS supplier_contacts_supplier_primary_unique
+ON supplier_contacts (supplier_id, phone_type)
+WHERE is_primary = true AND deleted_at IS NULL;
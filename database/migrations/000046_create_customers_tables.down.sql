@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS customer_contacts;
+DROP TABLE IF EXISTS customer_addresses;
+DROP TABLE IF EXISTS customers;
+DROP FUNCTION IF EXISTS generate_customer_code();
+DROP SEQUENCE IF EXISTS customer_code_seq;
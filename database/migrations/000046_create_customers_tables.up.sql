@@ -0,0 +1,119 @@
+-- Create sequence for customer_code starting from 1
+CREATE SEQUENCE IF NOT EXISTS customer_code_seq START WITH 1 INCREMENT BY 1;
+
+-- Function to generate formatted customer_code: "0" + seq if 1 digit, else just seq
+CREATE OR REPLACE FUNCTION generate_customer_code()
+RETURNS VARCHAR AS $$
+DECLARE
+  seq_val BIGINT;
+  formatted_code VARCHAR;
+BEGIN
+  seq_val := nextval('customer_code_seq');
+  IF seq_val < 10 THEN
+    formatted_code := '0' || seq_val::VARCHAR;
+  ELSE
+    formatted_code := seq_val::VARCHAR;
+  END IF;
+  RETURN formatted_code;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Create table customers
+CREATE TABLE IF NOT EXISTS customers (
+  id UUID DEFAULT uuid_generate_v7() PRIMARY KEY NOT NULL,
+  customer_code VARCHAR(255) NOT NULL UNIQUE DEFAULT generate_customer_code(),
+  customer_name VARCHAR(255),
+  customer_category_id UUID REFERENCES parameters(id) ON DELETE SET NULL,
+  expedition_send_id UUID REFERENCES expeditions(id) ON DELETE SET NULL,
+  payment_term_days INTEGER NOT NULL DEFAULT 0,
+  credit_limit NUMERIC(18, 2) NOT NULL DEFAULT 0,
+  notes TEXT,
+  created_at TIMESTAMP,
+  created_by VARCHAR(255),
+  updated_at TIMESTAMP,
+  updated_by VARCHAR(255),
+  deleted_at TIMESTAMP,
+  deleted_by VARCHAR(255)
+);
+
+COMMENT ON COLUMN customers.customer_category_id IS 'parameter with type customer_category';
+COMMENT ON COLUMN customers.expedition_send_id IS 'preferred expedition used to send goods to this customer';
+COMMENT ON COLUMN customers.payment_term_days IS 'number of days before an invoice is due, 0 means cash';
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS customers_customer_code_index ON customers (customer_code);
+CREATE INDEX IF NOT EXISTS customers_customer_name_index ON customers (customer_name);
+CREATE INDEX IF NOT EXISTS customers_customer_category_id_index ON customers (customer_category_id);
+CREATE INDEX IF NOT EXISTS customers_expedition_send_id_index ON customers (expedition_send_id);
+CREATE INDEX IF NOT EXISTS customers_created_at_index ON customers (created_at);
+CREATE INDEX IF NOT EXISTS customers_updated_at_index ON customers (updated_at);
+CREATE INDEX IF NOT EXISTS customers_deleted_at_index ON customers (deleted_at);
+
+-- Trigram indexes for search (customer_name, customer_code)
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE INDEX IF NOT EXISTS customers_customer_name_trgm_idx ON customers USING gin (LOWER(REPLACE(customer_name, ' ', '')) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS customers_customer_code_trgm_idx ON customers USING gin (LOWER(REPLACE(customer_code, ' ', '')) gin_trgm_ops);
+
+-- Create customer_addresses table
+CREATE TABLE IF NOT EXISTS customer_addresses (
+  id UUID DEFAULT uuid_generate_v7() PRIMARY KEY NOT NULL,
+  customer_id UUID NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+  address_type VARCHAR(50) NOT NULL,
+  address VARCHAR(255) NOT NULL,
+  province_id UUID REFERENCES provinces(id) ON DELETE SET NULL,
+  city_id UUID REFERENCES cities(id) ON DELETE SET NULL,
+  district_id UUID REFERENCES districts(id) ON DELETE SET NULL,
+  subdistrict_id UUID REFERENCES subdistricts(id) ON DELETE SET NULL,
+  postal_code VARCHAR(10),
+  is_primary BOOLEAN DEFAULT false,
+  created_at TIMESTAMP,
+  created_by VARCHAR(255),
+  updated_at TIMESTAMP,
+  updated_by VARCHAR(255),
+  deleted_at TIMESTAMP,
+  deleted_by VARCHAR(255)
+);
+
+COMMENT ON COLUMN customer_addresses.address_type IS 'billing / shipping';
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS customer_addresses_customer_id_index ON customer_addresses (customer_id);
+CREATE INDEX IF NOT EXISTS customer_addresses_province_id_index ON customer_addresses (province_id);
+CREATE INDEX IF NOT EXISTS customer_addresses_city_id_index ON customer_addresses (city_id);
+CREATE INDEX IF NOT EXISTS customer_addresses_district_id_index ON customer_addresses (district_id);
+CREATE INDEX IF NOT EXISTS customer_addresses_subdistrict_id_index ON customer_addresses (subdistrict_id);
+CREATE INDEX IF NOT EXISTS customer_addresses_deleted_at_index ON customer_addresses (deleted_at);
+CREATE INDEX IF NOT EXISTS customer_addresses_address_trgm_idx ON customer_addresses USING gin (LOWER(REPLACE(address, ' ', '')) gin_trgm_ops);
+
+-- Constraint: Only one primary address per customer and address type (excluding soft-deleted records)
+CREATE UNIQUE INDEX IF NOT EXISTS customer_addresses_customer_primary_unique
+ON customer_addresses (customer_id, address_type)
+WHERE is_primary = true AND deleted_at IS NULL;
+
+-- Create customer_contacts table
+CREATE TABLE IF NOT EXISTS customer_contacts (
+  id UUID DEFAULT uuid_generate_v7() PRIMARY KEY NOT NULL,
+  customer_id UUID NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+  phone_type VARCHAR(50) NOT NULL,
+  phone_number VARCHAR(50) NOT NULL,
+  area_code VARCHAR(255),
+  is_primary BOOLEAN DEFAULT false,
+  created_at TIMESTAMP,
+  created_by VARCHAR(255),
+  updated_at TIMESTAMP,
+  updated_by VARCHAR(255),
+  deleted_at TIMESTAMP,
+  deleted_by VARCHAR(255)
+);
+
+COMMENT ON COLUMN customer_contacts.phone_type IS 'telp / hp';
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS customer_contacts_customer_id_index ON customer_contacts (customer_id);
+CREATE INDEX IF NOT EXISTS customer_contacts_phone_number_index ON customer_contacts (phone_number);
+CREATE INDEX IF NOT EXISTS customer_contacts_deleted_at_index ON customer_contacts (deleted_at);
+
+-- Constraint: Only one primary contact per customer and phone type (excluding soft-deleted records)
+CREATE UNIQUE INDEX IF NOT EXISTS customer_contacts_customer_primary_unique
+ON customer_contacts (customer_id, phone_type)
+WHERE is_primary = true AND deleted_at IS NULL;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS expedition_tariffs;
+DROP TABLE IF EXISTS expedition_coverages;
@@ -0,0 +1,56 @@
+-- Create expedition_coverages table
+CREATE TABLE IF NOT EXISTS expedition_coverages (
+  id UUID DEFAULT uuid_generate_v7() PRIMARY KEY NOT NULL,
+  expedition_id UUID NOT NULL REFERENCES expeditions(id) ON DELETE CASCADE,
+  city_id UUID NOT NULL REFERENCES cities(id) ON DELETE CASCADE,
+  district_id UUID REFERENCES districts(id) ON DELETE CASCADE,
+  created_at TIMESTAMP,
+  created_by VARCHAR(255),
+  updated_at TIMESTAMP,
+  updated_by VARCHAR(255),
+  deleted_at TIMESTAMP,
+  deleted_by VARCHAR(255)
+);
+
+COMMENT ON COLUMN expedition_coverages.district_id IS 'served district, NULL means the whole city is served';
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS expedition_coverages_expedition_id_index ON expedition_coverages (expedition_id);
+CREATE INDEX IF NOT EXISTS expedition_coverages_city_id_index ON expedition_coverages (city_id);
+CREATE INDEX IF NOT EXISTS expedition_coverages_district_id_index ON expedition_coverages (district_id);
+CREATE INDEX IF NOT EXISTS expedition_coverages_deleted_at_index ON expedition_coverages (deleted_at);
+
+-- Constraint: an area is covered once per expedition (excluding soft-deleted records)
+CREATE UNIQUE INDEX IF NOT EXISTS expedition_coverages_area_unique
+ON expedition_coverages (expedition_id, city_id, COALESCE(district_id, '00000000-0000-0000-0000-000000000000'::uuid))
+WHERE deleted_at IS NULL;
+
+-- Create expedition_tariffs table
+CREATE TABLE IF NOT EXISTS expedition_tariffs (
+  id UUID DEFAULT uuid_generate_v7() PRIMARY KEY NOT NULL,
+  expedition_id UUID NOT NULL REFERENCES expeditions(id) ON DELETE CASCADE,
+  origin_city_id UUID NOT NULL REFERENCES cities(id) ON DELETE CASCADE,
+  destination_city_id UUID NOT NULL REFERENCES cities(id) ON DELETE CASCADE,
+  price_per_kg NUMERIC(18, 2) NOT NULL DEFAULT 0,
+  min_weight NUMERIC(10, 2) NOT NULL DEFAULT 0,
+  lead_time_days INTEGER NOT NULL DEFAULT 0,
+  created_at TIMESTAMP,
+  created_by VARCHAR(255),
+  updated_at TIMESTAMP,
+  updated_by VARCHAR(255),
+  deleted_at TIMESTAMP,
+  deleted_by VARCHAR(255)
+);
+
+COMMENT ON COLUMN expedition_tariffs.min_weight IS 'minimum chargeable weight in kg';
+COMMENT ON COLUMN expedition_tariffs.lead_time_days IS 'number of days from pick up to arrival';
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS expedition_tariffs_expedition_id_index ON expedition_tariffs (expedition_id);
+CREATE INDEX IF NOT EXISTS expedition_tariffs_route_index ON expedition_tariffs (origin_city_id, destination_city_id);
+CREATE INDEX IF NOT EXISTS expedition_tariffs_deleted_at_index ON expedition_tariffs (deleted_at);
+
+-- Constraint: one tariff per expedition route (excluding soft-deleted records)
+CREATE UNIQUE INDEX IF NOT EXISTS expedition_tariffs_route_unique
+ON expedition_tariffs (expedition_id, origin_city_id, destination_city_id)
+WHERE deleted_at IS NULL;
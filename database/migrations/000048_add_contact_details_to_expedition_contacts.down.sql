@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS expedition_contacts_expedition_primary_unique;
+CREATE UNIQUE INDEX IF NOT EXISTS expedition_contacts_expedition_primary_unique
+ON expedition_contacts (expedition_id)
+WHERE is_primary = true AND deleted_at IS NULL;
+
+DROP INDEX IF EXISTS expedition_contacts_normalized_number_index;
+ALTER TABLE expedition_contacts ADD CONSTRAINT expedition_contacts_phone_number_key UNIQUE (phone_number);
+
+ALTER TABLE expedition_contacts DROP COLUMN IF EXISTS normalized_number;
+ALTER TABLE expedition_contacts DROP COLUMN IF EXISTS email;
+ALTER TABLE expedition_contacts DROP COLUMN IF EXISTS contact_name;
+ALTER TABLE expedition_contacts DROP COLUMN IF EXISTS area_code;
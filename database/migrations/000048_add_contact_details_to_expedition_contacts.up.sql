@@ -0,0 +1,35 @@
+-- Contact person details and normalized (E.164) phone number for expedition contacts
+ALTER TABLE expedition_contacts ADD COLUMN IF NOT EXISTS area_code VARCHAR(255);
+ALTER TABLE expedition_contacts ADD COLUMN IF NOT EXISTS contact_name VARCHAR(255);
+ALTER TABLE expedition_contacts ADD COLUMN IF NOT EXISTS email VARCHAR(255);
+ALTER TABLE expedition_contacts ADD COLUMN IF NOT EXISTS normalized_number VARCHAR(20);
+
+COMMENT ON COLUMN expedition_contacts.normalized_number IS 'E.164 number (+62...) used to detect duplicates';
+
+-- Backfill normalized numbers: area code is only combined with local numbers
+UPDATE expedition_contacts ec
+SET normalized_number = CASE
+    WHEN n.digits LIKE '+%' THEN n.digits
+    WHEN n.digits LIKE '0%' THEN '+62' || substr(n.digits, 2)
+    WHEN n.digits LIKE '62%' THEN '+' || n.digits
+    ELSE '+62' || n.digits
+  END
+FROM (
+  SELECT id, regexp_replace(
+    CASE
+      WHEN phone_number ~ '^\s*[+0]' OR COALESCE(TRIM(area_code), '') = '' THEN phone_number
+      ELSE area_code || phone_number
+    END, '[\s\-().]', '', 'g') AS digits
+  FROM expedition_contacts
+) n
+WHERE n.id = ec.id AND ec.normalized_number IS NULL;
+
+-- Phone numbers are now unique per expedition on their normalized form (checked by the application)
+ALTER TABLE expedition_contacts DROP CONSTRAINT IF EXISTS expedition_contacts_phone_number_key;
+CREATE INDEX IF NOT EXISTS expedition_contacts_normalized_number_index ON expedition_contacts (expedition_id, normalized_number);
+
+-- Only one primary contact per expedition and phone type
+DROP INDEX IF EXISTS expedition_contacts_expedition_primary_unique;
+CREATE UNIQUE INDEX IF NOT EXISTS expedition_contacts_expedition_primary_unique
+ON expedition_contacts (expedition_id, phone_type)
+WHERE is_primary = true AND deleted_at IS NULL;
@@ -0,0 +1,7 @@
+-- Drop indexes first
+DROP INDEX IF EXISTS taxonomy_operations_id_index;
+DROP INDEX IF EXISTS taxonomy_operations_source_id_index;
+DROP INDEX IF EXISTS taxonomy_operations_target_id_index;
+DROP INDEX IF EXISTS taxonomy_operations_created_at_index;
+
+DROP TABLE IF EXISTS taxonomy_operations;
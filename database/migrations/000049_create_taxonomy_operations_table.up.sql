@@ -0,0 +1,25 @@
+-- Audit log of move / merge operations on the goods taxonomy (sub-group, type, backing)
+CREATE TABLE IF NOT EXISTS taxonomy_operations (
+  id UUID DEFAULT uuid_generate_v7() PRIMARY KEY NOT NULL,
+  resource VARCHAR(50) NOT NULL,
+  operation VARCHAR(50) NOT NULL,
+  source_id UUID NOT NULL,
+  target_id UUID NOT NULL,
+  previous_parent_id UUID,
+  previous_code VARCHAR(255),
+  new_code VARCHAR(255),
+  regenerate_codes BOOLEAN NOT NULL DEFAULT FALSE,
+  affected_children INT NOT NULL DEFAULT 0,
+  created_by VARCHAR(255),
+  created_at TIMESTAMP NOT NULL
+);
+
+COMMENT ON COLUMN taxonomy_operations.resource IS 'sub_group / type / backing';
+COMMENT ON COLUMN taxonomy_operations.operation IS 'move / merge';
+COMMENT ON COLUMN taxonomy_operations.target_id IS 'new parent for move, surviving record for merge';
+COMMENT ON COLUMN taxonomy_operations.affected_children IS 'number of active children re-pointed or re-coded by the operation';
+
+CREATE INDEX IF NOT EXISTS taxonomy_operations_id_index ON taxonomy_operations (id);
+CREATE INDEX IF NOT EXISTS taxonomy_operations_source_id_index ON taxonomy_operations (source_id);
+CREATE INDEX IF NOT EXISTS taxonomy_operations_target_id_index ON taxonomy_operations (target_id);
+CREATE INDEX IF NOT EXISTS taxonomy_operations_created_at_index ON taxonomy_operations (created_at);
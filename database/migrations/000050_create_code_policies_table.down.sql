@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS code_counters;
+DROP TABLE IF EXISTS code_policies;
@@ -0,0 +1,53 @@
+-- Code generation policies per master data entity (used by helpers/codegen)
+CREATE TABLE IF NOT EXISTS code_policies (
+  entity VARCHAR(50) PRIMARY KEY NOT NULL,
+  prefix VARCHAR(20) NOT NULL DEFAULT '',
+  separator VARCHAR(5) NOT NULL DEFAULT '.',
+  padding INT NOT NULL DEFAULT 2,
+  inherit_parent BOOLEAN NOT NULL DEFAULT FALSE,
+  yearly_reset BOOLEAN NOT NULL DEFAULT FALSE,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_by VARCHAR(255)
+);
+
+COMMENT ON COLUMN code_policies.entity IS 'group / sub_group / type / backing / expedition / supplier / customer';
+COMMENT ON COLUMN code_policies.inherit_parent IS 'prepend the parent code, e.g. GG.SS.TTT';
+COMMENT ON COLUMN code_policies.yearly_reset IS 'add the year to the code and restart numbering every year';
+
+-- Last allocated number per entity and scope (parent code and / or year, empty when numbering never restarts)
+CREATE TABLE IF NOT EXISTS code_counters (
+  entity VARCHAR(50) NOT NULL,
+  scope VARCHAR(255) NOT NULL DEFAULT '',
+  last_value BIGINT NOT NULL DEFAULT 0,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (entity, scope)
+);
+
+-- Default policies keep the current 2-digit format ("01", "02", ...)
+INSERT INTO code_policies (entity, prefix, separator, padding, inherit_parent, yearly_reset)
+VALUES
+  ('group', '', '.', 2, FALSE, FALSE),
+  ('sub_group', '', '.', 2, FALSE, FALSE),
+  ('type', '', '.', 2, FALSE, FALSE),
+  ('backing', '', '.', 2, FALSE, FALSE),
+  ('expedition', '', '.', 2, FALSE, FALSE),
+  ('supplier', '', '.', 2, FALSE, FALSE),
+  ('customer', '', '.', 2, FALSE, FALSE)
+ON CONFLICT (entity) DO NOTHING;
+
+-- Continue numbering after the codes generated by the sequences so far (soft-deleted rows included, codes are unique)
+INSERT INTO code_counters (entity, scope, last_value)
+SELECT 'group', '', COALESCE(MAX(CAST(group_code AS BIGINT)), 0) FROM groups WHERE group_code ~ '^[0-9]+$'
+UNION ALL
+SELECT 'sub_group', '', COALESCE(MAX(CAST(subgroup_code AS BIGINT)), 0) FROM sub_groups WHERE subgroup_code ~ '^[0-9]+$'
+UNION ALL
+SELECT 'type', '', COALESCE(MAX(CAST(type_code AS BIGINT)), 0) FROM types WHERE type_code ~ '^[0-9]+$'
+UNION ALL
+SELECT 'backing', '', COALESCE(MAX(CAST(backing_code AS BIGINT)), 0) FROM backings WHERE backing_code ~ '^[0-9]+$'
+UNION ALL
+SELECT 'expedition', '', COALESCE(MAX(CAST(expedition_code AS BIGINT)), 0) FROM expeditions WHERE expedition_code ~ '^[0-9]+$'
+UNION ALL
+SELECT 'supplier', '', COALESCE(MAX(CAST(supplier_code AS BIGINT)), 0) FROM suppliers WHERE supplier_code ~ '^[0-9]+$'
+UNION ALL
+SELECT 'customer', '', COALESCE(MAX(CAST(customer_code AS BIGINT)), 0) FROM customers WHERE customer_code ~ '^[0-9]+$'
+ON CONFLICT (entity, scope) DO NOTHING;
@@ -0,0 +1,5 @@
+DELETE FROM code_counters WHERE entity = 'item';
+DELETE FROM code_policies WHERE entity = 'item';
+DROP TABLE IF EXISTS item_barcodes;
+DROP TABLE IF EXISTS item_units;
+DROP TABLE IF EXISTS items;
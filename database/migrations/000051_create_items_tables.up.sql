@@ -0,0 +1,78 @@
+-- Create table items, the SKUs of a backing (group > sub-group > type > backing > item)
+CREATE TABLE IF NOT EXISTS items (
+  id UUID DEFAULT uuid_generate_v7() PRIMARY KEY NOT NULL,
+  item_code VARCHAR(255) NOT NULL UNIQUE,
+  name VARCHAR(255) NOT NULL,
+  backing_id UUID NOT NULL REFERENCES backings(id),
+  base_uom VARCHAR(20) NOT NULL DEFAULT 'pcs',
+  length NUMERIC(18, 2),
+  width NUMERIC(18, 2),
+  height NUMERIC(18, 2),
+  weight NUMERIC(18, 3),
+  is_active BOOLEAN NOT NULL DEFAULT true,
+  created_at TIMESTAMP,
+  created_by VARCHAR(255),
+  updated_at TIMESTAMP,
+  updated_by VARCHAR(255),
+  deleted_at TIMESTAMP,
+  deleted_by VARCHAR(255)
+);
+
+COMMENT ON COLUMN items.item_code IS 'SKU code, generated from the item code policy when not given';
+COMMENT ON COLUMN items.base_uom IS 'smallest unit of measure of the item, every other unit converts to it';
+COMMENT ON COLUMN items.length IS 'length in cm';
+COMMENT ON COLUMN items.width IS 'width in cm';
+COMMENT ON COLUMN items.height IS 'height in cm';
+COMMENT ON COLUMN items.weight IS 'weight of one base unit in kg';
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS items_item_code_index ON items (item_code);
+CREATE INDEX IF NOT EXISTS items_name_index ON items (name);
+CREATE INDEX IF NOT EXISTS items_backing_id_index ON items (backing_id);
+CREATE INDEX IF NOT EXISTS items_is_active_index ON items (is_active);
+CREATE INDEX IF NOT EXISTS items_created_at_index ON items (created_at);
+CREATE INDEX IF NOT EXISTS items_updated_at_index ON items (updated_at);
+CREATE INDEX IF NOT EXISTS items_deleted_at_index ON items (deleted_at);
+
+-- Trigram indexes for search (name, item_code)
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE INDEX IF NOT EXISTS items_name_trgm_idx ON items USING gin (LOWER(REPLACE(name, ' ', '')) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS items_item_code_trgm_idx ON items USING gin (LOWER(REPLACE(item_code, ' ', '')) gin_trgm_ops);
+
+-- Create item_units table
+CREATE TABLE IF NOT EXISTS item_units (
+  id UUID DEFAULT uuid_generate_v7() PRIMARY KEY NOT NULL,
+  item_id UUID NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+  uom VARCHAR(20) NOT NULL,
+  conversion NUMERIC(18, 4) NOT NULL,
+  created_at TIMESTAMP,
+  created_by VARCHAR(255),
+  UNIQUE (item_id, uom)
+);
+
+COMMENT ON COLUMN item_units.conversion IS 'number of base units in one unit, e.g. 12 for a box of 12 pcs';
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS item_units_item_id_index ON item_units (item_id);
+
+-- Create item_barcodes table
+CREATE TABLE IF NOT EXISTS item_barcodes (
+  id UUID DEFAULT uuid_generate_v7() PRIMARY KEY NOT NULL,
+  item_id UUID NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+  barcode VARCHAR(100) NOT NULL,
+  uom VARCHAR(20),
+  created_at TIMESTAMP,
+  created_by VARCHAR(255)
+);
+
+COMMENT ON COLUMN item_barcodes.uom IS 'unit the barcode is printed on, NULL for the base unit';
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS item_barcodes_item_id_index ON item_barcodes (item_id);
+CREATE INDEX IF NOT EXISTS item_barcodes_barcode_index ON item_barcodes (barcode);
+CREATE INDEX IF NOT EXISTS item_barcodes_barcode_trgm_idx ON item_barcodes USING gin (LOWER(REPLACE(barcode, ' ', '')) gin_trgm_ops);
+
+-- SKU codes follow the code policies like the rest of the catalog
+INSERT INTO code_policies (entity, prefix, separator, padding, inherit_parent, yearly_reset)
+VALUES ('item', '', '.', 2, FALSE, FALSE)
+ON CONFLICT (entity) DO NOTHING;
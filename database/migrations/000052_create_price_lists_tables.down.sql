@@ -0,0 +1,3 @@
+DELETE FROM parameters_to_module WHERE module_type = 'price_list';
+DROP TABLE IF EXISTS price_list_items;
+DROP TABLE IF EXISTS price_lists;
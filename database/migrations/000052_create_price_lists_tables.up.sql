@@ -0,0 +1,52 @@
+-- Create table price_lists, named sets of item prices
+CREATE TABLE IF NOT EXISTS price_lists (
+  id UUID DEFAULT uuid_generate_v7() PRIMARY KEY NOT NULL,
+  code VARCHAR(255) NOT NULL UNIQUE,
+  name VARCHAR(255) NOT NULL,
+  description TEXT,
+  priority INT NOT NULL DEFAULT 0,
+  is_active BOOLEAN NOT NULL DEFAULT true,
+  created_at TIMESTAMP,
+  created_by VARCHAR(255),
+  updated_at TIMESTAMP,
+  updated_by VARCHAR(255),
+  deleted_at TIMESTAMP,
+  deleted_by VARCHAR(255)
+);
+
+COMMENT ON COLUMN price_lists.priority IS 'the list with the highest priority wins when several lists have a price for the item';
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS price_lists_code_index ON price_lists (code);
+CREATE INDEX IF NOT EXISTS price_lists_name_index ON price_lists (name);
+CREATE INDEX IF NOT EXISTS price_lists_is_active_index ON price_lists (is_active);
+CREATE INDEX IF NOT EXISTS price_lists_created_at_index ON price_lists (created_at);
+CREATE INDEX IF NOT EXISTS price_lists_deleted_at_index ON price_lists (deleted_at);
+
+-- Create table price_list_items, the effective dated and tiered prices of a price list
+CREATE TABLE IF NOT EXISTS price_list_items (
+  id UUID DEFAULT uuid_generate_v7() PRIMARY KEY NOT NULL,
+  price_list_id UUID NOT NULL REFERENCES price_lists(id) ON DELETE CASCADE,
+  item_id UUID NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+  uom VARCHAR(20) NOT NULL,
+  min_qty NUMERIC(18, 4) NOT NULL DEFAULT 1,
+  price NUMERIC(18, 2) NOT NULL DEFAULT 0,
+  effective_from DATE NOT NULL,
+  effective_to DATE,
+  created_at TIMESTAMP,
+  created_by VARCHAR(255),
+  updated_at TIMESTAMP,
+  updated_by VARCHAR(255),
+  deleted_at TIMESTAMP,
+  deleted_by VARCHAR(255)
+);
+
+COMMENT ON COLUMN price_list_items.uom IS 'unit of measure the price is for, the base unit or one of the units of the item';
+COMMENT ON COLUMN price_list_items.min_qty IS 'quantity break, the price applies from this quantity';
+COMMENT ON COLUMN price_list_items.effective_to IS 'last day the price applies, NULL when open ended';
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS price_list_items_price_list_id_index ON price_list_items (price_list_id);
+CREATE INDEX IF NOT EXISTS price_list_items_item_id_index ON price_list_items (item_id);
+CREATE INDEX IF NOT EXISTS price_list_items_lookup_index ON price_list_items (item_id, uom, effective_from);
+CREATE INDEX IF NOT EXISTS price_list_items_deleted_at_index ON price_list_items (deleted_at);
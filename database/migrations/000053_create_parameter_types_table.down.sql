@@ -0,0 +1,2 @@
+ALTER TABLE parameters ALTER COLUMN value TYPE VARCHAR(255) USING LEFT(value, 255);
+DROP TABLE IF EXISTS parameter_types;
@@ -0,0 +1,50 @@
+-- Registry of parameter types (parameters.type): value kind, allowed values and allowed parents
+CREATE TABLE IF NOT EXISTS parameter_types (
+  code VARCHAR(255) PRIMARY KEY NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  value_kind VARCHAR(20) NOT NULL DEFAULT 'string',
+  value_required BOOLEAN NOT NULL DEFAULT FALSE,
+  enum_values JSONB,
+  json_schema JSONB,
+  allowed_parent_types JSONB NOT NULL DEFAULT '[]'::jsonb,
+  description TEXT,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  created_by VARCHAR(255),
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_by VARCHAR(255)
+);
+
+COMMENT ON COLUMN parameter_types.code IS 'value of parameters.type';
+COMMENT ON COLUMN parameter_types.value_kind IS 'string / int / decimal / bool / date / enum / json';
+COMMENT ON COLUMN parameter_types.enum_values IS 'allowed values of an enum type, array of strings';
+COMMENT ON COLUMN parameter_types.json_schema IS 'optional JSON Schema the typed value must match';
+COMMENT ON COLUMN parameter_types.allowed_parent_types IS 'types the parent of a parameter may have, empty when the parameter has no parent';
+
+-- JSON values do not fit in 255 characters
+ALTER TABLE parameters ALTER COLUMN value TYPE TEXT;
+
+-- Types seeded so far
+INSERT INTO parameter_types (code, name, value_kind, allowed_parent_types)
+VALUES
+  ('delivery_option', 'Delivery Option', 'string', '[]'::jsonb),
+  ('expedition_paid_by', 'Expedition Paid By', 'string', '[]'::jsonb),
+  ('expedition_calculation', 'Expedition Calculation', 'string', '[]'::jsonb),
+  ('identity_type', 'Identity Type', 'string', '[]'::jsonb),
+  ('lang', 'Language', 'string', '[]'::jsonb),
+  ('topic', 'Topic', 'string', '["topic"]'::jsonb),
+  ('customer_category', 'Customer Category', 'string', '[]'::jsonb)
+ON CONFLICT (code) DO NOTHING;
+
+-- Register the other types already in use as free text, keeping the parent types they already have
+INSERT INTO parameter_types (code, name, value_kind, allowed_parent_types)
+SELECT t.type,
+       INITCAP(REPLACE(t.type, '_', ' ')),
+       'string',
+       COALESCE((
+         SELECT jsonb_agg(DISTINCT parent.type)
+         FROM parameters child
+         JOIN parameters parent ON parent.id = child.parent_id
+         WHERE child.type = t.type AND parent.type IS NOT NULL AND parent.type <> ''
+       ), '[]'::jsonb)
+FROM (SELECT DISTINCT type FROM parameters WHERE type IS NOT NULL AND type <> '') t
+ON CONFLICT (code) DO NOTHING;
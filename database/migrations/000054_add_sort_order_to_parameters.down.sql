@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_parameters_parent_sort_order;
+
+ALTER TABLE parameters DROP COLUMN IF EXISTS sort_order;
@@ -0,0 +1,22 @@
+-- Parameters without parent were stored with either NULL or the zero UUID as parent_id, keep NULL only
+UPDATE parameters SET parent_id = NULL WHERE parent_id = '00000000-0000-0000-0000-000000000000';
+
+-- Position of a parameter among its siblings: the children of its parent, or the parameters of its type without parent
+ALTER TABLE parameters ADD COLUMN IF NOT EXISTS sort_order INTEGER NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN parameters.sort_order IS 'position among the siblings, starting at 1';
+
+-- Existing siblings are ordered by name
+UPDATE parameters p
+SET sort_order = s.position
+FROM (
+  SELECT id, ROW_NUMBER() OVER (
+    PARTITION BY parent_id, CASE WHEN parent_id IS NULL THEN COALESCE(type, '') END
+    ORDER BY name
+  ) AS position
+  FROM parameters
+  WHERE deleted_at IS NULL
+) s
+WHERE p.id = s.id;
+
+CREATE INDEX IF NOT EXISTS idx_parameters_parent_sort_order ON parameters (parent_id, sort_order) WHERE deleted_at IS NULL;
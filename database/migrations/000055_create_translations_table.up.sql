@@ -0,0 +1,20 @@
+-- Localized values of translatable fields of master data, the entity tables keep the base (Indonesian) value
+CREATE TABLE IF NOT EXISTS translations (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v7(),
+  entity_type VARCHAR(50) NOT NULL,
+  entity_id UUID NOT NULL,
+  field VARCHAR(50) NOT NULL,
+  locale VARCHAR(10) NOT NULL,
+  value TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  created_by VARCHAR(255),
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_by VARCHAR(255),
+  CONSTRAINT uq_translations_entity_field_locale UNIQUE (entity_type, entity_id, field, locale)
+);
+
+COMMENT ON COLUMN translations.entity_type IS 'parameter / group / sub_group / type / province / city / district / subdistrict';
+COMMENT ON COLUMN translations.field IS 'translated column of the entity, e.g. name';
+COMMENT ON COLUMN translations.locale IS 'language of the value, e.g. en';
+
+CREATE INDEX IF NOT EXISTS idx_translations_locale ON translations (locale, entity_type, field);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_posts_publish_at;
+DROP INDEX IF EXISTS idx_posts_status;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS published_by;
+ALTER TABLE posts DROP COLUMN IF EXISTS published_at;
+ALTER TABLE posts DROP COLUMN IF EXISTS publish_at;
+ALTER TABLE posts DROP COLUMN IF EXISTS status;
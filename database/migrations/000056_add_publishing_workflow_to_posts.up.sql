@@ -0,0 +1,15 @@
+-- Publishing workflow: draft -> review -> published -> archived
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'draft';
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP NULL;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS published_at TIMESTAMP NULL;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS published_by UUID NULL;
+
+COMMENT ON COLUMN posts.status IS 'draft, review, published or archived';
+COMMENT ON COLUMN posts.publish_at IS 'scheduled publication of an approved post in review, published by the scheduler';
+COMMENT ON COLUMN posts.published_at IS 'when the post was last published';
+
+-- Existing posts were live as soon as they were created
+UPDATE posts SET status = 'published', published_at = created_at WHERE status = 'draft';
+
+CREATE INDEX IF NOT EXISTS idx_posts_status ON posts (status) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_posts_publish_at ON posts (publish_at) WHERE status = 'review' AND deleted_at IS NULL;
@@ -0,0 +1,47 @@
+-- Full snapshot of a post after every create, update and restore
+CREATE TABLE IF NOT EXISTS post_revisions (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v7(),
+  post_id UUID NOT NULL REFERENCES posts(id),
+  revision_number INT NOT NULL,
+  action VARCHAR(20) NOT NULL,
+  restored_from UUID NULL REFERENCES post_revisions(id),
+  title VARCHAR(255) NOT NULL,
+  description TEXT NOT NULL,
+  short_description VARCHAR(255) NOT NULL,
+  lang_id UUID NULL,
+  topic_ids JSONB NOT NULL DEFAULT '[]'::jsonb,
+  thumbnail_file_id UUID NULL,
+  thumbnail_url TEXT NULL,
+  edited_by UUID NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT uq_post_revisions_post_number UNIQUE (post_id, revision_number)
+);
+
+COMMENT ON COLUMN post_revisions.action IS 'create, update or restore';
+COMMENT ON COLUMN post_revisions.restored_from IS 'revision whose snapshot was restored when action is restore';
+COMMENT ON COLUMN post_revisions.topic_ids IS 'sorted ids of the topic parameters of the post';
+COMMENT ON COLUMN post_revisions.thumbnail_file_id IS 'file assigned as thumbnail, re-assigned on restore';
+
+-- Existing posts start their history with their current content
+INSERT INTO post_revisions (post_id, revision_number, action, title, description, short_description, lang_id, topic_ids, thumbnail_file_id, thumbnail_url, edited_by, created_at)
+SELECT p.id, 1, 'create', p.title, p.description, p.short_description,
+  (SELECT ptm.parameter_id FROM parameters_to_module ptm
+    JOIN parameters prm ON prm.id = ptm.parameter_id
+    WHERE ptm.module_type = 'post' AND ptm.module_id = p.id AND prm.type = 'lang'
+    ORDER BY ptm.created_at DESC
+    LIMIT 1),
+  (SELECT COALESCE(jsonb_agg(ptm.parameter_id::text ORDER BY ptm.parameter_id::text), '[]'::jsonb) FROM parameters_to_module ptm
+    JOIN parameters prm ON prm.id = ptm.parameter_id
+    WHERE ptm.module_type = 'post' AND ptm.module_id = p.id AND prm.type = 'topic'),
+  th.id, th.file_path, p.created_by, p.updated_at
+FROM posts p
+LEFT JOIN LATERAL (
+  SELECT f.id, f.file_path FROM files_to_module ftm
+    JOIN files f ON f.id = ftm.file_id AND f.deleted_at IS NULL
+    WHERE ftm.module_type = 'post' AND ftm.module_id = p.id AND ftm.type = 'thumbnail'
+    ORDER BY ftm.created_at DESC
+    LIMIT 1
+) th ON TRUE
+WHERE p.deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_post_revisions_post_id ON post_revisions (post_id, revision_number DESC);
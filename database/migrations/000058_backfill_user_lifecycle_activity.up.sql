@@ -0,0 +1,13 @@
+-- Activity anchor of the dormant-account policy
+-- last_login_at was added without history: start it at the latest session of the user, or now when there is none,
+-- so existing accounts are not blocked / deactivated on the first run of the scheduler
+UPDATE users usr
+SET last_login_at = COALESCE(
+	(SELECT MAX(jt.created_at) FROM jwt_tokens jt WHERE jt.user_id = usr.id),
+	NOW()
+)
+WHERE usr.last_login_at IS NULL;
+
+-- reactivated_at: last manual activation / unblock (or automatic unblock), restarts the dormant period
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS reactivated_at TIMESTAMP;
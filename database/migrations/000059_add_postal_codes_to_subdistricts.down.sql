@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS idx_subdistricts_postal_codes;
+
+ALTER TABLE subdistricts ADD COLUMN IF NOT EXISTS postal_code VARCHAR(10);
+
+-- only the first postal code survives the rollback
+UPDATE subdistricts
+SET postal_code = postal_codes->>0
+WHERE jsonb_array_length(postal_codes) > 0;
+
+ALTER TABLE subdistricts DROP COLUMN IF EXISTS postal_codes;
+
+CREATE INDEX IF NOT EXISTS idx_subdistricts_postal_code ON subdistricts (postal_code) WHERE deleted_at IS NULL AND postal_code IS NOT NULL;
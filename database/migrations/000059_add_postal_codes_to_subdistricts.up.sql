@@ -0,0 +1,12 @@
+-- A subdistrict can be served by several postal codes, keep them as a JSON array of 5 digit codes
+ALTER TABLE subdistricts ADD COLUMN IF NOT EXISTS postal_codes JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+UPDATE subdistricts
+SET postal_codes = jsonb_build_array(postal_code)
+WHERE postal_code IS NOT NULL AND postal_code <> '';
+
+DROP INDEX IF EXISTS idx_subdistricts_postal_code;
+ALTER TABLE subdistricts DROP COLUMN IF EXISTS postal_code;
+
+-- Postal code lookup (postal_codes @> '["40151"]')
+CREATE INDEX IF NOT EXISTS idx_subdistricts_postal_codes ON subdistricts USING GIN (postal_codes jsonb_path_ops) WHERE deleted_at IS NULL;
@@ -0,0 +1,39 @@
+-- Seed Permission Groups "Export User Data" & "Erase User Data" for Module "Users"
+INSERT INTO "permission_groups" ("id", "created_at", "updated_at", "name", "deletable", "description", "module") 
+VALUES 
+    ('3d9a6f21-7c4e-4b8a-9e15-2f6c8a4d7b01', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Export User Data', false, 'Have Full Access for Export Personal Data of User Sub-Module', 'Users'),
+    ('b84e2c97-5a13-4f6d-8c72-9e1b3d5f7a02', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Erase User Data', false, 'Have Full Access for Erase Personal Data of User Sub-Module', 'Users')
+ON CONFLICT (id) DO NOTHING;
+
+-- Seed Permissions "user.data-export" & "user.data-erasure"
+INSERT INTO "permissions" (
+    "id",
+    "created_at",
+    "updated_at",
+    "name",
+    "deletable"
+)
+VALUES
+    ('6f2b8d14-9e3a-4c7f-a5d1-8b4e2c6f9a03', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'user.data-export', false),
+    ('c17e4a59-2d8b-4e3f-9a6c-5d1f7b3e8a04', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'user.data-erasure', false)
+ON CONFLICT (id) DO NOTHING;
+
+-- Seed Permissions Modules (Permission Groups <-> Permissions)
+INSERT INTO "permissions_modules" (
+    "permission_group_id",
+    "permission_id"
+)
+VALUES
+    ('3d9a6f21-7c4e-4b8a-9e15-2f6c8a4d7b01', '6f2b8d14-9e3a-4c7f-a5d1-8b4e2c6f9a03'),
+    ('b84e2c97-5a13-4f6d-8c72-9e1b3d5f7a02', 'c17e4a59-2d8b-4e3f-9a6c-5d1f7b3e8a04')
+ON CONFLICT DO NOTHING;
+
+-- Assign Permission Groups to Super Admin Role
+INSERT INTO "modules_roles" (
+    "permission_group_id",
+    "role_id"
+)
+VALUES
+    ('3d9a6f21-7c4e-4b8a-9e15-2f6c8a4d7b01', 'a43a5e5f-a172-42d1-a70e-8834bf653eb0'),
+    ('b84e2c97-5a13-4f6d-8c72-9e1b3d5f7a02', 'a43a5e5f-a172-42d1-a70e-8834bf653eb0')
+ON CONFLICT DO NOTHING;
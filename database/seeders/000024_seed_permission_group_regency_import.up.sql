@@ -0,0 +1,35 @@
+-- Seed Permission Group "Import" for Module "Regency"
+INSERT INTO "permission_groups" ("id", "created_at", "updated_at", "name", "deletable", "description", "module") 
+VALUES 
+    ('7e1c4a92-5b3d-4f8e-a6c1-2d9b8e4f1a05', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Import', false, 'Have Full Access for Import Regency Module', 'Regency')
+ON CONFLICT (id) DO NOTHING;
+
+-- Seed Permission "province.import"
+INSERT INTO "permissions" (
+    "id",
+    "created_at",
+    "updated_at",
+    "name",
+    "deletable"
+)
+VALUES
+    ('d4a8f3b6-1e7c-4c2a-9b5d-6f3e1a8c4b06', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'province.import', false)
+ON CONFLICT (id) DO NOTHING;
+
+-- Seed Permissions Modules (Permission Groups <-> Permissions)
+INSERT INTO "permissions_modules" (
+    "permission_group_id",
+    "permission_id"
+)
+VALUES
+    ('7e1c4a92-5b3d-4f8e-a6c1-2d9b8e4f1a05', 'd4a8f3b6-1e7c-4c2a-9b5d-6f3e1a8c4b06')
+ON CONFLICT DO NOTHING;
+
+-- Assign Permission Group to Super Admin Role
+INSERT INTO "modules_roles" (
+    "permission_group_id",
+    "role_id"
+)
+VALUES
+    ('7e1c4a92-5b3d-4f8e-a6c1-2d9b8e4f1a05', 'a43a5e5f-a172-42d1-a70e-8834bf653eb0')
+ON CONFLICT DO NOTHING;
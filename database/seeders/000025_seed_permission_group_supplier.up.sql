@@ -0,0 +1,122 @@
+-- Seed Permission Groups for Module "Supplier"
+INSERT INTO "permission_groups" ("id", "created_at", "updated_at", "name", "deletable", "description", "module") 
+VALUES 
+-- Supplier
+    ('98254f4e-c4c2-4290-8b62-905af0c8b4b6', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'View', false, 'Have Full Access for View Supplier Sub-Module', 'Supplier'),
+    ('c1c5e91c-5e24-4126-b485-691b1a348bf1', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Create', false, 'Have Full Access for Create Supplier Sub-Module', 'Supplier'),
+    ('3f6d7ebc-2998-47ac-b0f9-cb8daa6a4f4f', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Update', false, 'Have Full Access for Update Supplier Sub-Module', 'Supplier'),
+    ('4c8f099a-d3a0-4262-80f1-28ca04adecdb', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Delete', false, 'Have Full Access for Delete Supplier Sub-Module', 'Supplier'),
+    ('c14efce9-ec15-4023-bc86-19bcb0ec4d4a', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Export', false, 'Have Full Access for Export Supplier Sub-Module', 'Supplier')
+ON CONFLICT (id) DO NOTHING;
+
+-- Seed Permissions for Module "Supplier"
+INSERT INTO "permissions" (
+    "id",
+    "created_at",
+    "updated_at",
+    "name",
+    "deletable"
+)
+VALUES
+-- Supplier Permissions
+    (
+        '1f73c42b-92c6-444c-a884-15aa6b1371b3',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'supplier.view',
+        false
+    ),
+    (
+        '75767c8c-9d32-4ae2-9ede-ca7d712cc166',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'supplier.create',
+        false
+    ),
+    (
+        'a98836f2-5b42-4220-8484-78914db8f520',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'supplier.update',
+        false
+    ),
+    (
+        'fb4327ce-628c-400a-81df-492a6e93b0ae',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'supplier.delete',
+        false
+    ),
+    (
+        '1d5bbe15-9512-483f-8b78-6a94023ce7d4',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'supplier.export',
+        false
+    )
+ON CONFLICT (id) DO NOTHING;
+
+-- Seed Permissions Modules (Permission Groups <-> Permissions) for Module "Supplier"
+INSERT INTO "permissions_modules" (
+    "permission_group_id",
+    "permission_id"
+)
+VALUES
+-- Supplier Permission Scope
+    -- View permission group -> supplier.view
+    (
+        '98254f4e-c4c2-4290-8b62-905af0c8b4b6',
+        '1f73c42b-92c6-444c-a884-15aa6b1371b3'
+    ),
+    -- Create permission group -> supplier.create
+    (
+        'c1c5e91c-5e24-4126-b485-691b1a348bf1',
+        '75767c8c-9d32-4ae2-9ede-ca7d712cc166'
+    ),
+    -- Update permission group -> supplier.update
+    (
+        '3f6d7ebc-2998-47ac-b0f9-cb8daa6a4f4f',
+        'a98836f2-5b42-4220-8484-78914db8f520'
+    ),
+    -- Delete permission group -> supplier.delete
+    (
+        '4c8f099a-d3a0-4262-80f1-28ca04adecdb',
+        'fb4327ce-628c-400a-81df-492a6e93b0ae'
+    ),
+    -- Export permission group -> supplier.export
+    (
+        'c14efce9-ec15-4023-bc86-19bcb0ec4d4a',
+        '1d5bbe15-9512-483f-8b78-6a94023ce7d4'
+    )
+ON CONFLICT DO NOTHING;
+
+-- Assign Permission Groups to Super Admin Role
+INSERT INTO "modules_roles" (
+    "permission_group_id",
+    "role_id"
+)
+VALUES
+-- Supplier Module to Super Admin Role Scope BEGIN
+    (   
+        '98254f4e-c4c2-4290-8b62-905af0c8b4b6',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        'c1c5e91c-5e24-4126-b485-691b1a348bf1',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        '3f6d7ebc-2998-47ac-b0f9-cb8daa6a4f4f',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        '4c8f099a-d3a0-4262-80f1-28ca04adecdb',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        'c14efce9-ec15-4023-bc86-19bcb0ec4d4a',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    )
+ON CONFLICT DO NOTHING;
+-- Supplier Module to Super Admin Role Scope END
+
@@ -0,0 +1,122 @@
+-- Seed Permission Groups for Module "Customer"
+INSERT INTO "permission_groups" ("id", "created_at", "updated_at", "name", "deletable", "description", "module") 
+VALUES 
+-- Customer
+    ('b2c72038-db0e-4afd-9b10-bd066e73bc0c', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'View', false, 'Have Full Access for View Customer Sub-Module', 'Customer'),
+    ('82d3cf61-67af-400f-998a-da8f02103bf0', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Create', false, 'Have Full Access for Create Customer Sub-Module', 'Customer'),
+    ('e07f445d-802a-44fd-b08a-39c219c8e5b5', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Update', false, 'Have Full Access for Update Customer Sub-Module', 'Customer'),
+    ('161a0374-6a68-4e14-a315-136a5f30795a', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Delete', false, 'Have Full Access for Delete Customer Sub-Module', 'Customer'),
+    ('67a9c2bb-00ef-40cb-bfa5-1095678bee6a', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Export', false, 'Have Full Access for Export Customer Sub-Module', 'Customer')
+ON CONFLICT (id) DO NOTHING;
+
+-- Seed Permissions for Module "Customer"
+INSERT INTO "permissions" (
+    "id",
+    "created_at",
+    "updated_at",
+    "name",
+    "deletable"
+)
+VALUES
+-- Customer Permissions
+    (
+        'b325b810-e4c5-4662-84d7-78db31a9e349',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'customer.view',
+        false
+    ),
+    (
+        '946576b6-541b-49ee-918f-f39a371c0935',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'customer.create',
+        false
+    ),
+    (
+        '65e108d8-d99d-42a0-9981-f8acccdd5b7c',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'customer.update',
+        false
+    ),
+    (
+        '6f2452d6-be86-4f09-809b-4f312ffc7531',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'customer.delete',
+        false
+    ),
+    (
+        'f640e678-20d9-45f1-b296-aa31df00b798',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'customer.export',
+        false
+    )
+ON CONFLICT (id) DO NOTHING;
+
+-- Seed Permissions Modules (Permission Groups <-> Permissions) for Module "Customer"
+INSERT INTO "permissions_modules" (
+    "permission_group_id",
+    "permission_id"
+)
+VALUES
+-- Customer Permission Scope
+    -- View permission group -> customer.view
+    (
+        'b2c72038-db0e-4afd-9b10-bd066e73bc0c',
+        'b325b810-e4c5-4662-84d7-78db31a9e349'
+    ),
+    -- Create permission group -> customer.create
+    (
+        '82d3cf61-67af-400f-998a-da8f02103bf0',
+        '946576b6-541b-49ee-918f-f39a371c0935'
+    ),
+    -- Update permission group -> customer.update
+    (
+        'e07f445d-802a-44fd-b08a-39c219c8e5b5',
+        '65e108d8-d99d-42a0-9981-f8acccdd5b7c'
+    ),
+    -- Delete permission group -> customer.delete
+    (
+        '161a0374-6a68-4e14-a315-136a5f30795a',
+        '6f2452d6-be86-4f09-809b-4f312ffc7531'
+    ),
+    -- Export permission group -> customer.export
+    (
+        '67a9c2bb-00ef-40cb-bfa5-1095678bee6a',
+        'f640e678-20d9-45f1-b296-aa31df00b798'
+    )
+ON CONFLICT DO NOTHING;
+
+-- Assign Permission Groups to Super Admin Role
+INSERT INTO "modules_roles" (
+    "permission_group_id",
+    "role_id"
+)
+VALUES
+-- Customer Module to Super Admin Role Scope BEGIN
+    (   
+        'b2c72038-db0e-4afd-9b10-bd066e73bc0c',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        '82d3cf61-67af-400f-998a-da8f02103bf0',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        'e07f445d-802a-44fd-b08a-39c219c8e5b5',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        '161a0374-6a68-4e14-a315-136a5f30795a',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        '67a9c2bb-00ef-40cb-bfa5-1095678bee6a',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    )
+ON CONFLICT DO NOTHING;
+-- Customer Module to Super Admin Role Scope END
+
@@ -0,0 +1,18 @@
+-- Seed Parameters for Customer Categories
+INSERT INTO "parameters" (
+    "id",
+    "code",
+    "name",
+    "value",
+    "type",
+    "description",
+    "created_at",
+    "updated_at",
+    "deleted_at"
+)
+VALUES
+-- customer_category
+    (uuid_generate_v7(), 'CUSTOMER_CATEGORY_RETAIL', 'Retail', 'retail', 'customer_category', NULL, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, NULL),
+    (uuid_generate_v7(), 'CUSTOMER_CATEGORY_WHOLESALE', 'Wholesale', 'wholesale', 'customer_category', NULL, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, NULL),
+    (uuid_generate_v7(), 'CUSTOMER_CATEGORY_DISTRIBUTOR', 'Distributor', 'distributor', 'customer_category', NULL, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, NULL)
+ON CONFLICT (code) DO NOTHING;
@@ -0,0 +1,70 @@
+-- Seed Permission Groups for Module "Code Policy"
+INSERT INTO "permission_groups" ("id", "created_at", "updated_at", "name", "deletable", "description", "module") 
+VALUES 
+-- Code Policy
+    ('df1407a3-6693-4692-890e-117806afced0', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'View', false, 'Have Full Access for View Code Policy Sub-Module', 'Code Policy'),
+    ('076780bf-ce7b-40c6-a188-a698d8f98071', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Update', false, 'Have Full Access for Update Code Policy Sub-Module', 'Code Policy')
+ON CONFLICT (id) DO NOTHING;
+
+-- Seed Permissions for Module "Code Policy"
+INSERT INTO "permissions" (
+    "id",
+    "created_at",
+    "updated_at",
+    "name",
+    "deletable"
+)
+VALUES
+-- Code Policy Permissions
+    (
+        '72c0d01c-cb1d-4752-821b-37647c7031a5',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'code-policy.view',
+        false
+    ),
+    (
+        '095c38f9-d201-4091-8ee8-4aabbf62eda8',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'code-policy.update',
+        false
+    )
+ON CONFLICT (id) DO NOTHING;
+
+-- Seed Permissions Modules (Permission Groups <-> Permissions) for Module "Code Policy"
+INSERT INTO "permissions_modules" (
+    "permission_group_id",
+    "permission_id"
+)
+VALUES
+-- Code Policy Permission Scope
+    -- View permission group -> code-policy.view
+    (
+        'df1407a3-6693-4692-890e-117806afced0',
+        '72c0d01c-cb1d-4752-821b-37647c7031a5'
+    ),
+    -- Update permission group -> code-policy.update
+    (
+        '076780bf-ce7b-40c6-a188-a698d8f98071',
+        '095c38f9-d201-4091-8ee8-4aabbf62eda8'
+    )
+ON CONFLICT DO NOTHING;
+
+-- Assign Permission Groups to Super Admin Role
+INSERT INTO "modules_roles" (
+    "permission_group_id",
+    "role_id"
+)
+VALUES
+-- Code Policy Module to Super Admin Role Scope BEGIN
+    (   
+        'df1407a3-6693-4692-890e-117806afced0',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        '076780bf-ce7b-40c6-a188-a698d8f98071',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    )
+ON CONFLICT DO NOTHING;
+-- Code Policy Module to Super Admin Role Scope END
@@ -0,0 +1,122 @@
+-- Seed Permission Groups for Module "Item"
+INSERT INTO "permission_groups" ("id", "created_at", "updated_at", "name", "deletable", "description", "module") 
+VALUES 
+-- Item
+    ('e38fd1b5-5071-4fbb-98da-5cafba6533b2', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'View', false, 'Have Full Access for View Item Sub-Module', 'Item'),
+    ('ae59071c-7cbe-47e9-b713-ff472c28ea53', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Create', false, 'Have Full Access for Create Item Sub-Module', 'Item'),
+    ('604e0431-f668-4a4f-858d-eaba477a3de9', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Update', false, 'Have Full Access for Update Item Sub-Module', 'Item'),
+    ('6efe52da-8de0-4a94-8d89-c8028bbb56b7', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Delete', false, 'Have Full Access for Delete Item Sub-Module', 'Item'),
+    ('1e058245-35d2-4faf-a89a-c9ee27a980a4', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Export', false, 'Have Full Access for Export Item Sub-Module', 'Item')
+ON CONFLICT (id) DO NOTHING;
+
+-- Seed Permissions for Module "Item"
+INSERT INTO "permissions" (
+    "id",
+    "created_at",
+    "updated_at",
+    "name",
+    "deletable"
+)
+VALUES
+-- Item Permissions
+    (
+        'a003bbb3-f866-445e-8e4e-0041b6390d01',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'item.view',
+        false
+    ),
+    (
+        '414fdb09-22c5-4b31-a471-34719be4d6a0',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'item.create',
+        false
+    ),
+    (
+        'a3593a4b-61c4-4f80-95b0-39a1d8e029d5',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'item.update',
+        false
+    ),
+    (
+        '338a0918-a66c-4416-a027-b7ac98bc02db',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'item.delete',
+        false
+    ),
+    (
+        'c96aa6d9-9a48-4fb6-ab38-f1edb93d2bdc',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'item.export',
+        false
+    )
+ON CONFLICT (id) DO NOTHING;
+
+-- Seed Permissions Modules (Permission Groups <-> Permissions) for Module "Item"
+INSERT INTO "permissions_modules" (
+    "permission_group_id",
+    "permission_id"
+)
+VALUES
+-- Item Permission Scope
+    -- View permission group -> item.view
+    (
+        'e38fd1b5-5071-4fbb-98da-5cafba6533b2',
+        'a003bbb3-f866-445e-8e4e-0041b6390d01'
+    ),
+    -- Create permission group -> item.create
+    (
+        'ae59071c-7cbe-47e9-b713-ff472c28ea53',
+        '414fdb09-22c5-4b31-a471-34719be4d6a0'
+    ),
+    -- Update permission group -> item.update
+    (
+        '604e0431-f668-4a4f-858d-eaba477a3de9',
+        'a3593a4b-61c4-4f80-95b0-39a1d8e029d5'
+    ),
+    -- Delete permission group -> item.delete
+    (
+        '6efe52da-8de0-4a94-8d89-c8028bbb56b7',
+        '338a0918-a66c-4416-a027-b7ac98bc02db'
+    ),
+    -- Export permission group -> item.export
+    (
+        '1e058245-35d2-4faf-a89a-c9ee27a980a4',
+        'c96aa6d9-9a48-4fb6-ab38-f1edb93d2bdc'
+    )
+ON CONFLICT DO NOTHING;
+
+-- Assign Permission Groups to Super Admin Role
+INSERT INTO "modules_roles" (
+    "permission_group_id",
+    "role_id"
+)
+VALUES
+-- Item Module to Super Admin Role Scope BEGIN
+    (   
+        'e38fd1b5-5071-4fbb-98da-5cafba6533b2',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        'ae59071c-7cbe-47e9-b713-ff472c28ea53',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        '604e0431-f668-4a4f-858d-eaba477a3de9',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        '6efe52da-8de0-4a94-8d89-c8028bbb56b7',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        '1e058245-35d2-4faf-a89a-c9ee27a980a4',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    )
+ON CONFLICT DO NOTHING;
+-- Item Module to Super Admin Role Scope END
+
@@ -0,0 +1,122 @@
+-- Seed Permission Groups for Module "Price List"
+INSERT INTO "permission_groups" ("id", "created_at", "updated_at", "name", "deletable", "description", "module") 
+VALUES 
+-- Price List
+    ('e46bd8fa-349c-4fc8-b340-fb3b6c918c43', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'View', false, 'Have Full Access for View Price List Sub-Module', 'Price List'),
+    ('be084de7-4681-458d-b23b-7c8403f2f8ce', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Create', false, 'Have Full Access for Create Price List Sub-Module', 'Price List'),
+    ('1dc33b48-b154-4175-9605-7c5a894975c0', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Update', false, 'Have Full Access for Update Price List Sub-Module', 'Price List'),
+    ('245e88a8-fc09-479c-ac59-3f3585b6f689', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Delete', false, 'Have Full Access for Delete Price List Sub-Module', 'Price List'),
+    ('8eeb2229-a8d9-4387-bbd7-91529c848c07', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Export', false, 'Have Full Access for Export Price List Sub-Module', 'Price List')
+ON CONFLICT (id) DO NOTHING;
+
+-- Seed Permissions for Module "Price List"
+INSERT INTO "permissions" (
+    "id",
+    "created_at",
+    "updated_at",
+    "name",
+    "deletable"
+)
+VALUES
+-- Price List Permissions
+    (
+        '045ae82e-15a3-4e7f-a07b-1fd82dc1d338',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'price-list.view',
+        false
+    ),
+    (
+        '7946997c-348f-4cd0-b3b7-6cbd7d923190',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'price-list.create',
+        false
+    ),
+    (
+        '6bd3af20-7940-4d66-93e0-2447f04067aa',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'price-list.update',
+        false
+    ),
+    (
+        '1b86b7cb-ae69-4086-9449-67242c6ba178',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'price-list.delete',
+        false
+    ),
+    (
+        'e63c981a-618f-472f-ad35-b305d097b1af',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'price-list.export',
+        false
+    )
+ON CONFLICT (id) DO NOTHING;
+
+-- Seed Permissions Modules (Permission Groups <-> Permissions) for Module "Price List"
+INSERT INTO "permissions_modules" (
+    "permission_group_id",
+    "permission_id"
+)
+VALUES
+-- Price List Permission Scope
+    -- View permission group -> price-list.view
+    (
+        'e46bd8fa-349c-4fc8-b340-fb3b6c918c43',
+        '045ae82e-15a3-4e7f-a07b-1fd82dc1d338'
+    ),
+    -- Create permission group -> price-list.create
+    (
+        'be084de7-4681-458d-b23b-7c8403f2f8ce',
+        '7946997c-348f-4cd0-b3b7-6cbd7d923190'
+    ),
+    -- Update permission group -> price-list.update
+    (
+        '1dc33b48-b154-4175-9605-7c5a894975c0',
+        '6bd3af20-7940-4d66-93e0-2447f04067aa'
+    ),
+    -- Delete permission group -> price-list.delete
+    (
+        '245e88a8-fc09-479c-ac59-3f3585b6f689',
+        '1b86b7cb-ae69-4086-9449-67242c6ba178'
+    ),
+    -- Export permission group -> price-list.export
+    (
+        '8eeb2229-a8d9-4387-bbd7-91529c848c07',
+        'e63c981a-618f-472f-ad35-b305d097b1af'
+    )
+ON CONFLICT DO NOTHING;
+
+-- Assign Permission Groups to Super Admin Role
+INSERT INTO "modules_roles" (
+    "permission_group_id",
+    "role_id"
+)
+VALUES
+-- Price List Module to Super Admin Role Scope BEGIN
+    (   
+        'e46bd8fa-349c-4fc8-b340-fb3b6c918c43',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        'be084de7-4681-458d-b23b-7c8403f2f8ce',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        '1dc33b48-b154-4175-9605-7c5a894975c0',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        '245e88a8-fc09-479c-ac59-3f3585b6f689',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        '8eeb2229-a8d9-4387-bbd7-91529c848c07',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    )
+ON CONFLICT DO NOTHING;
+-- Price List Module to Super Admin Role Scope END
+
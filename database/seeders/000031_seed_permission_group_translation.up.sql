@@ -0,0 +1,121 @@
+-- Seed Permission Groups for Module "Translation"
+INSERT INTO "permission_groups" ("id", "created_at", "updated_at", "name", "deletable", "description", "module") 
+VALUES 
+-- Translation
+    ('7891f765-6f1b-4952-913b-755f86e122b3', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'View', false, 'Have Full Access for View Translation Sub-Module', 'Translation'),
+    ('9861255f-c1b4-4eef-9ecf-2c7bd56b39ad', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Create', false, 'Have Full Access for Create Translation Sub-Module', 'Translation'),
+    ('944db1a1-f389-42d7-9735-690c9824cf85', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Update', false, 'Have Full Access for Update Translation Sub-Module', 'Translation'),
+    ('fa1da0a5-6eb7-4d5a-b2e8-302ad9dcfaa9', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Delete', false, 'Have Full Access for Delete Translation Sub-Module', 'Translation'),
+    ('63e3f1b3-0319-4166-b27d-e242e3e6018c', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Export', false, 'Have Full Access for Export Translation Sub-Module', 'Translation')
+ON CONFLICT (id) DO NOTHING;
+
+-- Seed Permissions for Module "Translation"
+INSERT INTO "permissions" (
+    "id",
+    "created_at",
+    "updated_at",
+    "name",
+    "deletable"
+)
+VALUES
+-- Translation Permissions
+    (
+        '8174d17c-8707-44b3-a3d4-5631673dec2f',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'translation.view',
+        false
+    ),
+    (
+        '34b841af-214f-47c9-9972-a6d47b13df78',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'translation.create',
+        false
+    ),
+    (
+        'a55596e2-0222-4764-a669-845e56343a23',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'translation.update',
+        false
+    ),
+    (
+        '7fb546df-0ed0-42d8-9f3c-a39e55fb1bd9',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'translation.delete',
+        false
+    ),
+    (
+        '010ea675-24b9-4e0d-b6e3-c4d760099a62',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        'translation.export',
+        false
+    )
+ON CONFLICT (id) DO NOTHING;
+
+-- Seed Permissions Modules (Permission Groups <-> Permissions) for Module "Translation"
+INSERT INTO "permissions_modules" (
+    "permission_group_id",
+    "permission_id"
+)
+VALUES
+-- Translation Permission Scope
+    -- View permission group -> translation.view
+    (
+        '7891f765-6f1b-4952-913b-755f86e122b3',
+        '8174d17c-8707-44b3-a3d4-5631673dec2f'
+    ),
+    -- Create permission group -> translation.create
+    (
+        '9861255f-c1b4-4eef-9ecf-2c7bd56b39ad',
+        '34b841af-214f-47c9-9972-a6d47b13df78'
+    ),
+    -- Update permission group -> translation.update
+    (
+        '944db1a1-f389-42d7-9735-690c9824cf85',
+        'a55596e2-0222-4764-a669-845e56343a23'
+    ),
+    -- Delete permission group -> translation.delete
+    (
+        'fa1da0a5-6eb7-4d5a-b2e8-302ad9dcfaa9',
+        '7fb546df-0ed0-42d8-9f3c-a39e55fb1bd9'
+    ),
+    -- Export permission group -> translation.export
+    (
+        '63e3f1b3-0319-4166-b27d-e242e3e6018c',
+        '010ea675-24b9-4e0d-b6e3-c4d760099a62'
+    )
+ON CONFLICT DO NOTHING;
+
+-- Assign Permission Groups to Super Admin Role
+INSERT INTO "modules_roles" (
+    "permission_group_id",
+    "role_id"
+)
+VALUES
+-- Translation Module to Super Admin Role Scope BEGIN
+    (   
+        '7891f765-6f1b-4952-913b-755f86e122b3',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        '9861255f-c1b4-4eef-9ecf-2c7bd56b39ad',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        '944db1a1-f389-42d7-9735-690c9824cf85',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        'fa1da0a5-6eb7-4d5a-b2e8-302ad9dcfaa9',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    ),
+    (   
+        '63e3f1b3-0319-4166-b27d-e242e3e6018c',
+        'a43a5e5f-a172-42d1-a70e-8834bf653eb0'
+    )
+ON CONFLICT DO NOTHING;
+-- Translation Module to Super Admin Role Scope END
@@ -0,0 +1,29 @@
+-- Seed Permission Group "Publish" for Module "Post" (editors: review, publish, schedule, unpublish and archive posts)
+INSERT INTO "permission_groups" 
+    ("id", "created_at", "updated_at", "name", "deletable", "description", "module") 
+VALUES 
+    ('c4d8e2a6-5f1b-4e9c-a7d3-6b2f8e4c1a77', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Publish', false, 'Have Full Access for Publish Post Sub-Module', 'Post')
+ON CONFLICT (id) DO NOTHING;
+
+-- Seed Permission "post.publish"
+INSERT INTO "permissions" 
+    ("id", "created_at", "updated_at", "name", "deletable")
+VALUES
+    ('5e9a3d7c-2b6f-4c8e-9d1a-4f7b2e8c5d88', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'post.publish', false)
+ON CONFLICT (id) DO NOTHING;
+
+-- Map Permission Groups <-> Permissions
+INSERT INTO "permissions_modules" 
+    ("permission_group_id", "permission_id")
+VALUES
+    ('c4d8e2a6-5f1b-4e9c-a7d3-6b2f8e4c1a77', '5e9a3d7c-2b6f-4c8e-9d1a-4f7b2e8c5d88')
+ON CONFLICT DO NOTHING;
+
+-- Assign Permission Groups to Super Admin Role
+INSERT INTO "modules_roles" (
+    "permission_group_id",
+    "role_id"
+)
+VALUES
+    ('c4d8e2a6-5f1b-4e9c-a7d3-6b2f8e4c1a77', 'a43a5e5f-a172-42d1-a70e-8834bf653eb0')
+ON CONFLICT DO NOTHING;
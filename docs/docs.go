@@ -956,6 +956,158 @@ const docTemplate = `{
                 }
             }
         },
+        "/v1/backing/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete a duplicate backing in favour of the target backing, in one transaction. The operation is recorded in the taxonomy audit log.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Backing"
+                ],
+                "summary": "Merge backing into another backing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Duplicate (source) backing UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target backing. Fields: target_id (required, UUID)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqMergeBacking"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully merged, returns the target backing",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespBacking"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error or merge into itself",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/backing/{id}/move": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Re-parent a backing to another type in one transaction. Name must be unique within the target type. When regenerate_code is true the backing gets a new code. The operation is recorded in the taxonomy audit log.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Backing"
+                ],
+                "summary": "Move backing to another type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Backing UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target type. Fields: type_id (required, UUID), regenerate_code (optional)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqMoveBacking"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully moved backing",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespBacking"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error, same type or duplicate name in target type",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/v1/city": {
             "get": {
                 "security": [
@@ -1393,7 +1545,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete an existing city by ID",
+                "description": "Delete an existing city by ID. A city still used by active supplier or customer addresses cannot be deleted",
                 "consumes": [
                     "application/json"
                 ],
@@ -1441,14 +1593,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/district": {
+        "/v1/code-policy": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a paginated list of districts with optional filters",
+                "description": "Retrieve the code policy of every master data entity (group, sub_group, type, backing, expedition, supplier, customer, item) with an example of the first code it generates",
                 "consumes": [
                     "application/json"
                 ],
@@ -1456,58 +1608,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Regency - District"
-                ],
-                "summary": "Get list of districts with pagination",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Items per page",
-                        "name": "per_page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search keyword",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by city_id",
-                        "name": "city_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Filter by multiple names (WHERE IN)",
-                        "name": "names",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search keyword for filtering by name",
-                        "name": "search",
-                        "in": "query"
-                    }
+                    "Code Policy"
                 ],
+                "summary": "Get code generation policies",
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved districts",
+                        "description": "Successfully retrieved code policies",
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/response.PaginationResponse"
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
                                 },
                                 {
                                     "type": "object",
@@ -1515,7 +1625,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/dto.RespDistrictIndex"
+                                                "$ref": "#/definitions/dto.RespCodePolicy"
                                             }
                                         }
                                     }
@@ -1534,16 +1644,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/code-policy/{entity}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new district with provided city_id and name",
+                "description": "Update the code pattern of an entity: prefix, separator, zero padding, parent code inheritance (e.g. GG.SS.TTT, sub_group / type / backing / item only) and yearly reset. Existing codes are kept, use the migrate endpoint to regenerate them.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1551,23 +1669,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Regency - District"
+                    "Code Policy"
                 ],
-                "summary": "Create a new district",
+                "summary": "Update code generation policy",
                 "parameters": [
                     {
-                        "description": "District creation data",
+                        "type": "string",
+                        "description": "Entity (group, sub_group, type, backing, expedition, supplier, customer, item)",
+                        "name": "entity",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Code policy. Fields: prefix (max 20), separator (max 5), padding (1-10), inherit_parent, yearly_reset",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ReqCreateDistrict"
+                            "$ref": "#/definitions/dto.ReqUpdateCodePolicy"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully created district",
+                        "description": "Successfully updated code policy",
                         "schema": {
                             "allOf": [
                                 {
@@ -1577,7 +1702,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespDistrict"
+                                            "$ref": "#/definitions/dto.RespCodePolicy"
                                         }
                                     }
                                 }
@@ -1585,7 +1710,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error",
+                        "description": "Bad request - validation error or unknown entity",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -1595,110 +1720,46 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
                     }
                 }
             }
         },
-        "/v1/district/export": {
-            "get": {
+        "/v1/code-policy/{entity}/migrate": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Export districts to Excel file (.xlsx) with optional search and filter",
+                "description": "Renumber every record of an entity from 1 in creation order with its current policy, soft-deleted records included, then the children inheriting its code. Runs in one transaction.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                    "application/json"
                 ],
                 "tags": [
-                    "Regency - District"
+                    "Code Policy"
                 ],
-                "summary": "Export districts to Excel",
+                "summary": "Regenerate existing codes",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search keyword",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by city_id",
-                        "name": "city_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Filter by multiple names (WHERE IN)",
-                        "name": "names",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search keyword for filtering by name",
-                        "name": "search",
-                        "in": "query"
+                        "description": "Entity (group, sub_group, type, backing, expedition, supplier, customer, item)",
+                        "name": "entity",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Excel file with districts data",
-                        "schema": {
-                            "type": "file"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/district/{id}": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Retrieve a single district by its ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Regency - District"
-                ],
-                "summary": "Get district by ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "District UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Successfully retrieved district",
+                        "description": "Successfully regenerated codes",
                         "schema": {
                             "allOf": [
                                 {
@@ -1708,7 +1769,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespDistrict"
+                                            "$ref": "#/definitions/dto.RespCodeMigration"
                                         }
                                     }
                                 }
@@ -1716,7 +1777,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid UUID",
+                        "description": "Bad request - unknown entity",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -1727,21 +1788,23 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
-                    "404": {
-                        "description": "District not found",
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/code-policy/{entity}/preview": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing district's information",
+                "description": "Return the code the next created record of an entity would get, without reserving it",
                 "consumes": [
                     "application/json"
                 ],
@@ -1749,30 +1812,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Regency - District"
+                    "Code Policy"
                 ],
-                "summary": "Update district",
+                "summary": "Preview the next code",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "District UUID",
-                        "name": "id",
+                        "description": "Entity (group, sub_group, type, backing, expedition, supplier, customer, item)",
+                        "name": "entity",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated district data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReqUpdateDistrict"
-                        }
+                        "type": "string",
+                        "description": "Parent record UUID, required when the policy inherits the parent code",
+                        "name": "parent_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated district",
+                        "description": "Successfully generated code preview",
                         "schema": {
                             "allOf": [
                                 {
@@ -1782,7 +1842,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespDistrict"
+                                            "$ref": "#/definitions/dto.RespCodePreview"
                                         }
                                     }
                                 }
@@ -1790,60 +1850,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "District not found",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Delete an existing district by ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Regency - District"
-                ],
-                "summary": "Delete district",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "District UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Successfully deleted district",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request - invalid UUID",
+                        "description": "Bad request - unknown entity, invalid or missing parent",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -1854,8 +1861,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
-                    "404": {
-                        "description": "District not found",
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -1863,14 +1870,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/expedition": {
+        "/v1/customer": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a paginated list of expeditions with optional search and filters. Supports multiple filter values for each field. Only returns non-deleted expeditions. Requires 'api.master-data.expedition.view' permission.",
+                "description": "Retrieve a paginated list of customers with optional search and filters. Supports multiple filter values for each field. Only returns non-deleted customers. Requires 'api.master-data.customer.view' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1878,9 +1885,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Expedition"
+                    "Customer"
                 ],
-                "summary": "Get list of expeditions with pagination",
+                "summary": "Get list of customers with pagination",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1896,7 +1903,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Sort column (allowed: id, expedition_code, expedition_name, address, created_at, updated_at)",
+                        "description": "Sort column (allowed: id, customer_code, customer_name, customer_category_name, expedition_send_name, payment_term_days, credit_limit, shipping_city_name, phone_number, telp_number, created_at, updated_at)",
                         "name": "sort_by",
                         "in": "query"
                     },
@@ -1908,7 +1915,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Search keyword (searches in expedition_name, expedition_code, address, and phone numbers from contacts)",
+                        "description": "Search keyword (searches in customer_name, customer_code, addresses, address city, category, expedition name and phone numbers from contacts)",
                         "name": "search",
                         "in": "query"
                     },
@@ -1918,8 +1925,8 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "description": "Filter by expedition codes (multiple values)",
-                        "name": "expedition_codes",
+                        "description": "Filter by customer codes (multiple values)",
+                        "name": "customer_codes",
                         "in": "query"
                     },
                     {
@@ -1928,8 +1935,8 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "description": "Filter by expedition names (multiple values)",
-                        "name": "expedition_names",
+                        "description": "Filter by customer names (multiple values)",
+                        "name": "customer_names",
                         "in": "query"
                     },
                     {
@@ -1938,8 +1945,38 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "description": "Filter by addresses (multiple values)",
-                        "name": "addresses",
+                        "description": "Filter by customer category IDs (multiple values)",
+                        "name": "customer_category_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by preferred expedition IDs (multiple values)",
+                        "name": "expedition_send_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by address province IDs (multiple values)",
+                        "name": "province_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by address city IDs (multiple values)",
+                        "name": "city_ids",
                         "in": "query"
                     },
                     {
@@ -1965,7 +2002,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved expeditions",
+                        "description": "Successfully retrieved customers",
                         "schema": {
                             "allOf": [
                                 {
@@ -1977,7 +2014,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/dto.RespExpeditionIndex"
+                                                "$ref": "#/definitions/dto.RespCustomerIndex"
                                             }
                                         }
                                     }
@@ -2011,7 +2048,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new expedition with provided information. Accepts JSON body with telp_numbers (array of objects where area_code optional and phone_number required, contoh: [{\u0026quot;area_code\u0026quot;:\u0026quot;022\u0026quot;,\u0026quot;phone_number\u0026quot;:\u0026quot;1112223355\u0026quot;}, {\u0026quot;area_code\u0026quot;:\u0026quot;022\u0026quot;,\u0026quot;phone_number\u0026quot;:\u0026quot;1112223366\u0026quot;}]) and phone_numbers arrays. First index of telp_numbers array will automatically become primary telp, and first index of phone_numbers array will automatically become primary hp. Phone numbers can be duplicated across different expeditions. Expedition code is automatically generated by the system. Requires 'api.master-data.expedition.create' permission.",
+                "description": "Create a new customer with provided information. Accepts JSON body with addresses (array of billing/shipping addresses, contoh: [{\u0026quot;address_type\u0026quot;:\u0026quot;billing\u0026quot;,\u0026quot;address\u0026quot;:\u0026quot;Jl. Asia Afrika 1\u0026quot;,\u0026quot;province_id\u0026quot;:\u0026quot;...\u0026quot;,\u0026quot;city_id\u0026quot;:\u0026quot;...\u0026quot;}]), telp_numbers (array of objects where area_code optional and phone_number required) and phone_numbers arrays. First address of each address_type, first index of telp_numbers and first index of phone_numbers automatically become primary. Address regions must follow the province \u003e city \u003e district \u003e subdistrict hierarchy, customer_category_id must be a customer_category parameter and expedition_send_id is the preferred expedition for shipments to this customer. Customer code is automatically generated by the system. Requires 'api.master-data.customer.create' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2019,23 +2056,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Expedition"
+                    "Customer"
                 ],
-                "summary": "Create a new expedition",
+                "summary": "Create a new customer",
                 "parameters": [
                     {
-                        "description": "Expedition creation data. Fields: expedition_name (required), address (max 255 chars), telp_numbers (array, first index becomes primary telp), phone_numbers (array, first index becomes primary hp), notes (optional)",
+                        "description": "Customer creation data. Fields: customer_name (required), customer_category_id, expedition_send_id, payment_term_days (0-365), credit_limit (min 0), addresses (array), telp_numbers (array, first index becomes primary telp), phone_numbers (array, first index becomes primary hp), notes (optional)",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ReqCreateExpedition"
+                            "$ref": "#/definitions/dto.ReqCreateCustomer"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully created expedition with full details including contacts",
+                        "description": "Successfully created customer with full details including addresses and contacts",
                         "schema": {
                             "allOf": [
                                 {
@@ -2045,7 +2082,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespExpedition"
+                                            "$ref": "#/definitions/dto.RespCustomer"
                                         }
                                     }
                                 }
@@ -2053,7 +2090,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error or duplicate phone number/expedition name",
+                        "description": "Bad request - validation error, duplicate customer name or invalid category/expedition/address region",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -2073,14 +2110,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/expedition/export": {
+        "/v1/customer/export": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Export expeditions to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination. Supports multiple filter values for each field. Only exports non-deleted expeditions. Excel file includes: Kode Ekspedisi, Nama Ekspedisi, Alamat, Telp, Phone, Update Date. Requires 'api.master-data.expedition.export' permission.",
+                "description": "Export customers to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination. Supports multiple filter values for each field. Only exports non-deleted customers. Excel file includes: Kode Customer, Nama Customer, Kategori, Ekspedisi, Termin (Hari), Limit Kredit, Alamat Penagihan, Alamat Pengiriman (primary addresses), No HP, No Telp, Update Date. Requires 'api.master-data.customer.export' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2088,13 +2125,13 @@ const docTemplate = `{
                     "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Expedition"
+                    "Customer"
                 ],
-                "summary": "Export expeditions to Excel",
+                "summary": "Export customers to Excel",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search keyword (searches in expedition_name, expedition_code, address, and phone numbers from contacts)",
+                        "description": "Search keyword (searches in customer_name, customer_code, addresses, address city, category, expedition name and phone numbers from contacts)",
                         "name": "search",
                         "in": "query"
                     },
@@ -2104,8 +2141,8 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "description": "Filter by expedition codes (multiple values)",
-                        "name": "expedition_codes",
+                        "description": "Filter by customer codes (multiple values)",
+                        "name": "customer_codes",
                         "in": "query"
                     },
                     {
@@ -2114,8 +2151,8 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "description": "Filter by expedition names (multiple values)",
-                        "name": "expedition_names",
+                        "description": "Filter by customer names (multiple values)",
+                        "name": "customer_names",
                         "in": "query"
                     },
                     {
@@ -2124,8 +2161,38 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "description": "Filter by addresses (multiple values)",
-                        "name": "addresses",
+                        "description": "Filter by customer category IDs (multiple values)",
+                        "name": "customer_category_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by preferred expedition IDs (multiple values)",
+                        "name": "expedition_send_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by address province IDs (multiple values)",
+                        "name": "province_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by address city IDs (multiple values)",
+                        "name": "city_ids",
                         "in": "query"
                     },
                     {
@@ -2151,7 +2218,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Excel file (expeditions.xlsx) with expeditions data",
+                        "description": "Excel file (customers.xlsx) with customers data",
                         "schema": {
                             "type": "file"
                         }
@@ -2177,14 +2244,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/expedition/{id}": {
+        "/v1/customer/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a single expedition by its UUID. The response includes full expedition details with all contacts (telp_numbers and phone_numbers arrays). Only returns non-deleted expeditions. Requires 'api.master-data.expedition.view' permission.",
+                "description": "Retrieve a single customer by its UUID. The response includes full customer details with all addresses (resolved region names) and contacts (telp_numbers and phone_numbers arrays). Only returns non-deleted customers. Requires 'api.master-data.customer.view' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2192,13 +2259,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Expedition"
+                    "Customer"
                 ],
-                "summary": "Get expedition by ID",
+                "summary": "Get customer by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Expedition UUID",
+                        "description": "Customer UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2206,7 +2273,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved expedition with full details including contacts",
+                        "description": "Successfully retrieved customer with full details including addresses and contacts",
                         "schema": {
                             "allOf": [
                                 {
@@ -2216,7 +2283,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespExpedition"
+                                            "$ref": "#/definitions/dto.RespCustomer"
                                         }
                                     }
                                 }
@@ -2242,7 +2309,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Expedition not found",
+                        "description": "Customer not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -2255,7 +2322,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing expedition's information. Accepts JSON body with telp_numbers (array of objects where area_code optional and phone_number required, contoh: [{\u0026quot;area_code\u0026quot;:\u0026quot;022\u0026quot;,\u0026quot;phone_number\u0026quot;:\u0026quot;1112223355\u0026quot;}, {\u0026quot;area_code\u0026quot;:\u0026quot;022\u0026quot;,\u0026quot;phone_number\u0026quot;:\u0026quot;1112223366\u0026quot;}]) and phone_numbers arrays. First index of telp_numbers array will automatically become primary telp, and first index of phone_numbers array will automatically become primary hp. Phone numbers can be duplicated across different expeditions. Existing contacts will be hard deleted before new ones are created. The response includes full expedition details with all contacts. Requires 'api.master-data.expedition.update' permission.",
+                "description": "Update an existing customer's information. Accepts JSON body with addresses, telp_numbers (array of objects where area_code optional and phone_number required) and phone_numbers arrays. First address of each address_type, first index of telp_numbers and first index of phone_numbers automatically become primary. Address regions must follow the province \u003e city \u003e district \u003e subdistrict hierarchy. Existing addresses and contacts will be hard deleted before new ones are created. The response includes full customer details with all addresses and contacts. Requires 'api.master-data.customer.update' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2263,30 +2330,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Expedition"
+                    "Customer"
                 ],
-                "summary": "Update expedition",
+                "summary": "Update customer",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Expedition UUID",
+                        "description": "Customer UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated expedition data. Fields: expedition_name (required), address (max 255 chars), telp_numbers (array, first index becomes primary telp), phone_numbers (array, first index becomes primary hp), notes (optional)",
+                        "description": "Updated customer data. Fields: customer_name (required), customer_category_id, expedition_send_id, payment_term_days (0-365), credit_limit (min 0), addresses (array), telp_numbers (array, first index becomes primary telp), phone_numbers (array, first index becomes primary hp), notes (optional)",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ReqUpdateExpedition"
+                            "$ref": "#/definitions/dto.ReqUpdateCustomer"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated expedition with full details including contacts",
+                        "description": "Successfully updated customer with full details including addresses and contacts",
                         "schema": {
                             "allOf": [
                                 {
@@ -2296,7 +2363,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespExpedition"
+                                            "$ref": "#/definitions/dto.RespCustomer"
                                         }
                                     }
                                 }
@@ -2304,7 +2371,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error or duplicate phone number",
+                        "description": "Bad request - validation error, duplicate customer name or invalid category/expedition/address region",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -2322,7 +2389,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Expedition not found",
+                        "description": "Customer not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -2335,7 +2402,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete an existing expedition by ID. The expedition will be marked as deleted (deleted_at is set) but remains in the database. Requires 'api.master-data.expedition.delete' permission.",
+                "description": "Soft delete an existing customer by ID. The customer will be marked as deleted (deleted_at is set) but remains in the database. Requires 'api.master-data.customer.delete' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2343,13 +2410,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Expedition"
+                    "Customer"
                 ],
-                "summary": "Soft delete expedition",
+                "summary": "Soft delete customer",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Expedition UUID",
+                        "description": "Customer UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2357,7 +2424,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully soft deleted expedition",
+                        "description": "Successfully soft deleted customer",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -2381,7 +2448,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Expedition not found",
+                        "description": "Customer not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -2389,14 +2456,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/group": {
+        "/v1/district": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a paginated list of goods groups with optional filters",
+                "description": "Retrieve a paginated list of districts with optional filters",
                 "consumes": [
                     "application/json"
                 ],
@@ -2404,9 +2471,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Golongan"
+                    "Regency - District"
                 ],
-                "summary": "Get list of groups with pagination",
+                "summary": "Get list of districts with pagination",
                 "parameters": [
                     {
                         "type": "integer",
@@ -2428,24 +2495,30 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Search keyword for filtering by name and group_code",
-                        "name": "search",
+                        "description": "Filter by city_id",
+                        "name": "city_id",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "name": "sort_by",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by multiple names (WHERE IN)",
+                        "name": "names",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "name": "sort_order",
+                        "description": "Search keyword for filtering by name",
+                        "name": "search",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved groups",
+                        "description": "Successfully retrieved districts",
                         "schema": {
                             "allOf": [
                                 {
@@ -2457,7 +2530,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/dto.RespGroupIndex"
+                                                "$ref": "#/definitions/dto.RespDistrictIndex"
                                             }
                                         }
                                     }
@@ -2485,7 +2558,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new goods group with provided name",
+                "description": "Create a new district with provided city_id and name",
                 "consumes": [
                     "application/json"
                 ],
@@ -2493,23 +2566,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Golongan"
+                    "Regency - District"
                 ],
-                "summary": "Create a new group",
+                "summary": "Create a new district",
                 "parameters": [
                     {
-                        "description": "Group creation data",
+                        "description": "District creation data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ReqCreateGroup"
+                            "$ref": "#/definitions/dto.ReqCreateDistrict"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully created group",
+                        "description": "Successfully created district",
                         "schema": {
                             "allOf": [
                                 {
@@ -2519,7 +2592,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespGroup"
+                                            "$ref": "#/definitions/dto.RespDistrict"
                                         }
                                     }
                                 }
@@ -2541,14 +2614,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/group/export": {
+        "/v1/district/export": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Export goods groups to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination.",
+                "description": "Export districts to Excel file (.xlsx) with optional search and filter",
                 "consumes": [
                     "application/json"
                 ],
@@ -2556,30 +2629,42 @@ const docTemplate = `{
                     "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Golongan"
+                    "Regency - District"
                 ],
-                "summary": "Export groups to Excel",
+                "summary": "Export districts to Excel",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search keyword for filtering by name and group_code",
+                        "description": "Search keyword",
                         "name": "search",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "name": "sort_by",
+                        "description": "Filter by city_id",
+                        "name": "city_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by multiple names (WHERE IN)",
+                        "name": "names",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "name": "sort_order",
+                        "description": "Search keyword for filtering by name",
+                        "name": "search",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Excel file with groups data",
+                        "description": "Excel file with districts data",
                         "schema": {
                             "type": "file"
                         }
@@ -2599,14 +2684,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/group/{id}": {
+        "/v1/district/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a single goods group by its ID",
+                "description": "Retrieve a single district by its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2614,13 +2699,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Golongan"
+                    "Regency - District"
                 ],
-                "summary": "Get group by ID",
+                "summary": "Get district by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group UUID",
+                        "description": "District UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2628,7 +2713,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved group",
+                        "description": "Successfully retrieved district",
                         "schema": {
                             "allOf": [
                                 {
@@ -2638,7 +2723,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespGroup"
+                                            "$ref": "#/definitions/dto.RespDistrict"
                                         }
                                     }
                                 }
@@ -2658,7 +2743,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Group not found",
+                        "description": "District not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -2671,7 +2756,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing goods group's information",
+                "description": "Update an existing district's information",
                 "consumes": [
                     "application/json"
                 ],
@@ -2679,30 +2764,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Golongan"
+                    "Regency - District"
                 ],
-                "summary": "Update group",
+                "summary": "Update district",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group UUID",
+                        "description": "District UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated group data",
+                        "description": "Updated district data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ReqUpdateGroup"
+                            "$ref": "#/definitions/dto.ReqUpdateDistrict"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated group",
+                        "description": "Successfully updated district",
                         "schema": {
                             "allOf": [
                                 {
@@ -2712,7 +2797,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespGroup"
+                                            "$ref": "#/definitions/dto.RespDistrict"
                                         }
                                     }
                                 }
@@ -2732,7 +2817,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Group not found",
+                        "description": "District not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -2745,7 +2830,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete an existing goods group by ID",
+                "description": "Delete an existing district by ID. A district still used by active supplier or customer addresses cannot be deleted",
                 "consumes": [
                     "application/json"
                 ],
@@ -2753,13 +2838,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Golongan"
+                    "Regency - District"
                 ],
-                "summary": "Delete group",
+                "summary": "Delete district",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group UUID",
+                        "description": "District UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2767,7 +2852,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully deleted group",
+                        "description": "Successfully deleted district",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -2785,7 +2870,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Group not found",
+                        "description": "District not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -2793,14 +2878,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/parameter": {
+        "/v1/expedition": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a paginated list of parameters with optional filters",
+                "description": "Retrieve a paginated list of expeditions with optional search and filters. Supports multiple filter values for each field. Only returns non-deleted expeditions. Requires 'api.master-data.expedition.view' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2808,25 +2893,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Parameter"
+                    "Expedition"
                 ],
-                "summary": "Get list of parameters with pagination",
+                "summary": "Get list of expeditions with pagination",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Page number",
+                        "description": "Page number (default: 1)",
                         "name": "page",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Items per page",
+                        "description": "Items per page (default: 10)",
                         "name": "per_page",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Search keyword",
+                        "description": "Sort column (allowed: id, expedition_code, expedition_name, address, created_at, updated_at)",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order: asc or desc (default: desc)",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keyword (searches in expedition_name, expedition_code, address, and phone numbers from contacts)",
                         "name": "search",
                         "in": "query"
                     },
@@ -2836,8 +2933,8 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "description": "Filter by parameter types (array)",
-                        "name": "types",
+                        "description": "Filter by expedition codes (multiple values)",
+                        "name": "expedition_codes",
                         "in": "query"
                     },
                     {
@@ -2846,14 +2943,44 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "description": "Filter by parameter names (array)",
-                        "name": "names",
+                        "description": "Filter by expedition names (multiple values)",
+                        "name": "expedition_names",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by addresses (multiple values)",
+                        "name": "addresses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by telp numbers (multiple values)",
+                        "name": "telp_numbers",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by phone numbers (multiple values)",
+                        "name": "phone_numbers",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved parameters",
+                        "description": "Successfully retrieved expeditions",
                         "schema": {
                             "allOf": [
                                 {
@@ -2865,7 +2992,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/dto.RespParameterIndex"
+                                                "$ref": "#/definitions/dto.RespExpeditionIndex"
                                             }
                                         }
                                     }
@@ -2874,7 +3001,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad request - invalid query parameters",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -2884,6 +3011,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
                     }
                 }
             },
@@ -2893,7 +3026,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new parameter with provided code, name, value, and description",
+                "description": "Create a new expedition with provided information. Accepts JSON body with telp_numbers (array of objects where area_code optional and phone_number required, contoh: [{\u0026quot;area_code\u0026quot;:\u0026quot;022\u0026quot;,\u0026quot;phone_number\u0026quot;:\u0026quot;1112223355\u0026quot;}, {\u0026quot;area_code\u0026quot;:\u0026quot;022\u0026quot;,\u0026quot;phone_number\u0026quot;:\u0026quot;1112223366\u0026quot;}]) and phone_numbers arrays. First index of telp_numbers array will automatically become primary telp, and first index of phone_numbers array will automatically become primary hp. Phone numbers can be duplicated across different expeditions. Expedition code is automatically generated by the system. Requires 'api.master-data.expedition.create' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2901,23 +3034,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Parameter"
+                    "Expedition"
                 ],
-                "summary": "Create a new parameter",
+                "summary": "Create a new expedition",
                 "parameters": [
                     {
-                        "description": "Parameter creation data",
+                        "description": "Expedition creation data. Fields: expedition_name (required), address (max 255 chars), telp_numbers (array, first index becomes primary telp), phone_numbers (array, first index becomes primary hp), notes (optional)",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ReqCreateParameter"
+                            "$ref": "#/definitions/dto.ReqCreateExpedition"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully created parameter",
+                        "description": "Successfully created expedition with full details including contacts",
                         "schema": {
                             "allOf": [
                                 {
@@ -2927,7 +3060,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespParameter"
+                                            "$ref": "#/definitions/dto.RespExpedition"
                                         }
                                     }
                                 }
@@ -2935,7 +3068,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error",
+                        "description": "Bad request - validation error or duplicate phone number/expedition name",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -2945,18 +3078,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
                     }
                 }
             }
         },
-        "/v1/parameter/export": {
+        "/v1/expedition/coverages/import/template": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Export parameters to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination.",
+                "description": "Download Excel template file for importing expedition coverage areas. Template contains columns: city_code, district_code with example data.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2964,52 +3103,24 @@ const docTemplate = `{
                     "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Parameter"
-                ],
-                "summary": "Export parameters to Excel",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Search keyword",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Filter by parameter types (array)",
-                        "name": "types",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Filter by parameter names (array)",
-                        "name": "names",
-                        "in": "query"
-                    }
+                    "Expedition - Coverage"
                 ],
+                "summary": "Download expedition coverage import Excel template",
                 "responses": {
                     "200": {
-                        "description": "Excel file with parameters data",
+                        "description": "Excel template file",
                         "schema": {
                             "type": "file"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -3017,54 +3128,91 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/parameter/{id}": {
+        "/v1/expedition/export": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a single parameter by its ID",
+                "description": "Export expeditions to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination. Supports multiple filter values for each field. Only exports non-deleted expeditions. Excel file includes: Kode Ekspedisi, Nama Ekspedisi, Alamat, Telp, Phone, Update Date. Requires 'api.master-data.expedition.export' permission.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Parameter"
+                    "Expedition"
                 ],
-                "summary": "Get parameter by ID",
+                "summary": "Export expeditions to Excel",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Parameter UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Successfully retrieved parameter",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.NonPaginationResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/dto.RespParameter"
-                                        }
-                                    }
-                                }
-                            ]
+                        "description": "Search keyword (searches in expedition_name, expedition_code, address, and phone numbers from contacts)",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by expedition codes (multiple values)",
+                        "name": "expedition_codes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by expedition names (multiple values)",
+                        "name": "expedition_names",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by addresses (multiple values)",
+                        "name": "addresses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by telp numbers (multiple values)",
+                        "name": "telp_numbers",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by phone numbers (multiple values)",
+                        "name": "phone_numbers",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Excel file (expeditions.xlsx) with expeditions data",
+                        "schema": {
+                            "type": "file"
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid UUID",
+                        "description": "Bad request - invalid query parameters",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -3075,52 +3223,45 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
-                    "404": {
-                        "description": "Parameter not found",
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/expedition/import": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing parameter's information",
+                "description": "Create expeditions from an Excel file (.xlsx or .xls) with columns: expedition_code (optional, generated when empty), expedition_name, address, phone_numbers, telp_numbers, notes. Several numbers can be put in one cell separated by comma, semicolon or new line, the first one becomes primary. Names must not be used by another expedition and numbers must be valid Indonesian numbers. Valid rows are imported even when other rows fail, failed rows are returned with HTTP 400. Requires 'api.master-data.expedition.create' permission.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Parameter"
+                    "Expedition"
                 ],
-                "summary": "Update parameter",
+                "summary": "Import expeditions from Excel file",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Parameter UUID",
-                        "name": "id",
-                        "in": "path",
+                        "type": "file",
+                        "description": "Excel file (.xlsx or .xls) with columns: expedition_code, expedition_name, address, phone_numbers, telp_numbers, notes",
+                        "name": "file",
+                        "in": "formData",
                         "required": true
-                    },
-                    {
-                        "description": "Updated parameter data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReqUpdateParameter"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated parameter",
+                        "description": "Successfully imported all expeditions",
                         "schema": {
                             "allOf": [
                                 {
@@ -3130,7 +3271,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespParameter"
+                                            "$ref": "#/definitions/dto.ResImportExpeditions"
                                         }
                                     }
                                 }
@@ -3138,9 +3279,21 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error",
+                        "description": "Bad request - one or more rows failed validation. Response contains details for each row including row number, expedition name, status, and error message",
                         "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ResImportExpeditions"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "401": {
@@ -3149,51 +3302,38 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
-                    "404": {
-                        "description": "Parameter not found",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/expedition/import/template": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete an existing parameter by ID",
+                "description": "Download Excel template file for importing expeditions. Template contains columns: expedition_code, expedition_name, address, phone_numbers, telp_numbers, notes with example data.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Parameter"
-                ],
-                "summary": "Delete parameter",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Parameter UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Expedition"
                 ],
+                "summary": "Download expedition import Excel template",
                 "responses": {
                     "200": {
-                        "description": "Successfully deleted parameter",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request - invalid UUID",
+                        "description": "Excel template file",
                         "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
+                            "type": "file"
                         }
                     },
                     "401": {
@@ -3202,8 +3342,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
-                    "404": {
-                        "description": "Parameter not found",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -3211,9 +3351,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/post": {
+        "/v1/expedition/quote": {
             "get": {
-                "description": "Retrieve a paginated list of posts with optional filtering",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Return the expeditions able to ship a parcel from the origin city to the destination city, cheapest first then fastest. An expedition is a candidate when it has a tariff for the route and covers both cities (and the destination district when given). Cost is price_per_kg times the greater of the weight and the tariff minimum weight, estimated_arrival is today plus the lead time. Requires 'api.master-data.expedition.view' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3221,83 +3366,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Post"
+                    "Expedition - Tariff"
                 ],
-                "summary": "Get paginated list of posts",
+                "summary": "Quote expeditions for a shipment",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "per_page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sort column",
-                        "name": "sort_by",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sort order (asc/desc)",
-                        "name": "sort_order",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search query",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "name": "lang_ids",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "name": "search",
-                        "in": "query"
+                        "description": "Origin city UUID",
+                        "name": "origin_city_id",
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "name": "sort_by",
-                        "in": "query"
+                        "description": "Destination city UUID",
+                        "name": "destination_city_id",
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "name": "sort_order",
+                        "description": "Destination district UUID, must belong to the destination city",
+                        "name": "destination_district_id",
                         "in": "query"
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "name": "topic_ids",
-                        "in": "query"
+                        "type": "number",
+                        "description": "Parcel weight in kg",
+                        "name": "weight",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Successfully retrieved candidate expeditions",
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/response.PaginationResponse"
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
                                 },
                                 {
                                     "type": "object",
@@ -3305,7 +3412,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/dto.RespPostIndex"
+                                                "$ref": "#/definitions/dto.RespExpeditionQuote"
                                             }
                                         }
                                     }
@@ -3314,69 +3421,59 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Bad request - invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/expedition/tariffs/import/template": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a post with optional thumbnail upload",
+                "description": "Download Excel template file for importing expedition tariffs. Template contains columns: origin_city_code, destination_city_code, price_per_kg, min_weight, lead_time_days with example data.",
                 "consumes": [
-                    "application/json",
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Post"
-                ],
-                "summary": "Create post",
-                "parameters": [
-                    {
-                        "description": "Post payload",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReqCreatePost"
-                        }
-                    },
-                    {
-                        "type": "file",
-                        "description": "Thumbnail file",
-                        "name": "thumbnail",
-                        "in": "formData"
-                    }
+                    "Expedition - Tariff"
                 ],
+                "summary": "Download expedition tariff import Excel template",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Excel template file",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.NonPaginationResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/dto.RespPost"
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "file"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -3384,20 +3481,28 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/post/{id}": {
+        "/v1/expedition/{id}": {
             "get": {
-                "description": "Retrieve post detail and its parameters",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a single expedition by its UUID. The response includes full expedition details with all contacts (telp_numbers and phone_numbers arrays). Only returns non-deleted expeditions. Requires 'api.master-data.expedition.view' permission.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Post"
+                    "Expedition"
                 ],
-                "summary": "Get post by ID",
+                "summary": "Get expedition by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID",
+                        "description": "Expedition UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3405,7 +3510,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Successfully retrieved expedition with full details including contacts",
                         "schema": {
                             "allOf": [
                                 {
@@ -3415,7 +3520,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespPost"
+                                            "$ref": "#/definitions/dto.RespExpedition"
                                         }
                                     }
                                 }
@@ -3423,7 +3528,25 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Expedition not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -3436,45 +3559,38 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update a post with optional thumbnail upload",
+                "description": "Update an existing expedition's information. Accepts JSON body with telp_numbers (array of objects where area_code optional and phone_number required, contoh: [{\u0026quot;area_code\u0026quot;:\u0026quot;022\u0026quot;,\u0026quot;phone_number\u0026quot;:\u0026quot;1112223355\u0026quot;}, {\u0026quot;area_code\u0026quot;:\u0026quot;022\u0026quot;,\u0026quot;phone_number\u0026quot;:\u0026quot;1112223366\u0026quot;}]) and phone_numbers arrays. First index of telp_numbers array will automatically become primary telp, and first index of phone_numbers array will automatically become primary hp. Phone numbers can be duplicated across different expeditions. Existing contacts will be hard deleted before new ones are created. The response includes full expedition details with all contacts. Requires 'api.master-data.expedition.update' permission.",
                 "consumes": [
-                    "application/json",
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Post"
+                    "Expedition"
                 ],
-                "summary": "Update post",
+                "summary": "Update expedition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID",
+                        "description": "Expedition UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Post payload",
-                        "name": "payload",
+                        "description": "Updated expedition data. Fields: expedition_name (required), address (max 255 chars), telp_numbers (array, first index becomes primary telp), phone_numbers (array, first index becomes primary hp), notes (optional)",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ReqUpdatePost"
+                            "$ref": "#/definitions/dto.ReqUpdateExpedition"
                         }
-                    },
-                    {
-                        "type": "file",
-                        "description": "Thumbnail file",
-                        "name": "thumbnail",
-                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Successfully updated expedition with full details including contacts",
                         "schema": {
                             "allOf": [
                                 {
@@ -3484,7 +3600,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespPost"
+                                            "$ref": "#/definitions/dto.RespExpedition"
                                         }
                                     }
                                 }
@@ -3492,7 +3608,25 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Bad request - validation error or duplicate phone number",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Expedition not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -3505,18 +3639,21 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a post by ID",
+                "description": "Soft delete an existing expedition by ID. The expedition will be marked as deleted (deleted_at is set) but remains in the database. An expedition still used by active suppliers or customers cannot be deleted. Requires 'api.master-data.expedition.delete' permission.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Post"
+                    "Expedition"
                 ],
-                "summary": "Delete post",
+                "summary": "Soft delete expedition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID",
+                        "description": "Expedition UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3524,13 +3661,31 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Successfully soft deleted expedition",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Bad request - invalid UUID or expedition still in use",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Expedition not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -3538,14 +3693,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/province": {
+        "/v1/expedition/{id}/contacts": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a paginated list of provinces with optional filters",
+                "description": "Retrieve all contacts of an expedition, primary contacts first. Requires 'api.master-data.expedition.view' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3553,42 +3708,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Regency - Province"
+                    "Expedition - Contact"
                 ],
-                "summary": "Get list of provinces with pagination",
+                "summary": "Get expedition contacts",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Items per page",
-                        "name": "per_page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search keyword",
-                        "name": "search",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "Search keyword for filtering by name",
-                        "name": "search",
-                        "in": "query"
+                        "description": "Expedition UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved provinces",
+                        "description": "Successfully retrieved contacts",
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/response.PaginationResponse"
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
                                 },
                                 {
                                     "type": "object",
@@ -3596,7 +3734,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/dto.RespProvinceIndex"
+                                                "$ref": "#/definitions/dto.RespExpeditionContact"
                                             }
                                         }
                                     }
@@ -3605,7 +3743,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad request - invalid UUID or expedition not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -3615,6 +3753,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
                     }
                 }
             },
@@ -3624,7 +3768,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new province with provided name",
+                "description": "Add a telp or hp contact to an expedition. The number is normalized to E.164 (+62...), the area code is only combined with local telp numbers, and numbers already used by the expedition are rejected. The first contact of a phone type always becomes primary. Requires 'api.master-data.expedition.update' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3632,23 +3776,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Regency - Province"
+                    "Expedition - Contact"
                 ],
-                "summary": "Create a new province",
+                "summary": "Add an expedition contact",
                 "parameters": [
                     {
-                        "description": "Province creation data",
+                        "type": "string",
+                        "description": "Expedition UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Contact data. Fields: phone_type (required, telp/hp), phone_number (required), area_code, contact_name, email, is_primary",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ReqCreateProvince"
+                            "$ref": "#/definitions/dto.ReqCreateExpeditionContact"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully created province",
+                        "description": "Successfully added contact",
                         "schema": {
                             "allOf": [
                                 {
@@ -3658,7 +3809,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespProvince"
+                                            "$ref": "#/definitions/dto.RespExpeditionContact"
                                         }
                                     }
                                 }
@@ -3666,7 +3817,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error",
+                        "description": "Bad request - validation error, invalid or duplicated phone number",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -3676,51 +3827,80 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
                     }
                 }
             }
         },
-        "/v1/province/export": {
-            "get": {
+        "/v1/expedition/{id}/contacts/{contactId}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Export provinces to Excel file (.xlsx) with optional search and filter",
+                "description": "Update the number and contact person of an expedition contact. The phone type and primary flag are kept. Requires 'api.master-data.expedition.update' permission.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                    "application/json"
                 ],
                 "tags": [
-                    "Regency - Province"
+                    "Expedition - Contact"
                 ],
-                "summary": "Export provinces to Excel",
+                "summary": "Update an expedition contact",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search keyword",
-                        "name": "search",
-                        "in": "query"
+                        "description": "Expedition UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Search keyword for filtering by name",
-                        "name": "search",
-                        "in": "query"
+                        "description": "Contact UUID",
+                        "name": "contactId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Contact data. Fields: phone_number (required), area_code, contact_name, email",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqUpdateExpeditionContact"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Excel file with provinces data",
+                        "description": "Successfully updated contact",
                         "schema": {
-                            "type": "file"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespExpeditionContact"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad request - validation error, contact not found, invalid or duplicated phone number",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -3730,18 +3910,22 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
                     }
                 }
-            }
-        },
-        "/v1/province/{id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a single province by its ID",
+                "description": "Soft delete an expedition contact. When the primary contact is removed, the oldest remaining contact of the same phone type becomes primary. Requires 'api.master-data.expedition.update' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3749,39 +3933,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Regency - Province"
+                    "Expedition - Contact"
                 ],
-                "summary": "Get province by ID",
+                "summary": "Remove an expedition contact",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Province UUID",
+                        "description": "Expedition UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Contact UUID",
+                        "name": "contactId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved province",
+                        "description": "Successfully removed contact",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.NonPaginationResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/dto.RespProvince"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid UUID",
+                        "description": "Bad request - invalid UUID or contact not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -3792,21 +3971,23 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
-                    "404": {
-                        "description": "Province not found",
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/expedition/{id}/contacts/{contactId}/primary": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing province's information",
+                "description": "Make a contact the primary contact of its phone type, the previous primary contact of that type is unset. Requires 'api.master-data.expedition.update' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3814,30 +3995,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Regency - Province"
+                    "Expedition - Contact"
                 ],
-                "summary": "Update province",
+                "summary": "Set the primary expedition contact",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Province UUID",
+                        "description": "Expedition UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated province data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReqUpdateProvince"
-                        }
+                        "type": "string",
+                        "description": "Contact UUID",
+                        "name": "contactId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated province",
+                        "description": "Successfully set primary contact",
                         "schema": {
                             "allOf": [
                                 {
@@ -3847,7 +4026,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespProvince"
+                                            "$ref": "#/definitions/dto.RespExpeditionContact"
                                         }
                                     }
                                 }
@@ -3855,7 +4034,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error",
+                        "description": "Bad request - invalid UUID or contact not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -3866,21 +4045,23 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
-                    "404": {
-                        "description": "Province not found",
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/expedition/{id}/coverages": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete an existing province by ID",
+                "description": "Retrieve the cities and districts served by an expedition. A coverage without district serves the whole city. Requires 'api.master-data.expedition.view' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3888,13 +4069,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Regency - Province"
+                    "Expedition - Coverage"
                 ],
-                "summary": "Delete province",
+                "summary": "Get expedition coverage areas",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Province UUID",
+                        "description": "Expedition UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3902,13 +4083,28 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully deleted province",
+                        "description": "Successfully retrieved coverage areas",
                         "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespExpeditionCoverage"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid UUID",
+                        "description": "Bad request - invalid UUID or expedition not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -3919,23 +4115,21 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
-                    "404": {
-                        "description": "Province not found",
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     }
                 }
-            }
-        },
-        "/v1/role-management/role": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a paginated list of roles with optional filtering",
+                "description": "Add a city, or a single district of a city, served by an expedition. Leave district_id empty to serve the whole city. Requires 'api.master-data.expedition.update' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3943,59 +4137,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Role Management"
+                    "Expedition - Coverage"
                 ],
-                "summary": "Get paginated list of roles",
+                "summary": "Add an expedition coverage area",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "per_page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sort column",
-                        "name": "sort_by",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "Sort order (asc/desc)",
-                        "name": "sort_order",
-                        "in": "query"
+                        "description": "Expedition UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Search query",
-                        "name": "search",
-                        "in": "query"
+                        "description": "Coverage data. Fields: city_id (required), district_id (optional, must belong to the city)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqCreateExpeditionCoverage"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved roles",
+                        "description": "Successfully added coverage area",
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/response.PaginationResponse"
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/dto.RespRoleIndex"
-                                            }
+                                            "$ref": "#/definitions/dto.RespExpeditionCoverage"
                                         }
                                     }
                                 }
@@ -4003,7 +4178,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad request - validation error, unknown region or area already covered",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -4013,58 +4188,52 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/expedition/{id}/coverages/export": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new role with provided information",
+                "description": "Export the coverage areas of an expedition to Excel file (.xlsx). Excel file includes: Kode Kota, Kode Kecamatan, Nama Kota, Nama Kecamatan, Update Date. The first two columns follow the import template so the file can be imported back. Requires 'api.master-data.expedition.export' permission.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Role Management"
+                    "Expedition - Coverage"
                 ],
-                "summary": "Create a new role",
+                "summary": "Export expedition coverage areas to Excel",
                 "parameters": [
                     {
-                        "description": "Role creation data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReqCreateRole"
-                        }
+                        "type": "string",
+                        "description": "Expedition UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully created role",
+                        "description": "Excel file (expedition_coverages.xlsx)",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.NonPaginationResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/dto.RespRole"
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "file"
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error",
+                        "description": "Bad request - invalid UUID or expedition not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -4074,31 +4243,53 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
                     }
                 }
             }
         },
-        "/v1/role-management/role/all": {
-            "get": {
+        "/v1/expedition/{id}/coverages/import": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve all roles without pagination",
+                "description": "Add coverage areas from an Excel file (.xlsx or .xls) with columns: city_code, district_code (optional). Regions are matched by official code. Areas already covered are reported as success. Valid rows are imported even when other rows fail, failed rows are returned with HTTP 400. Requires 'api.master-data.expedition.update' permission.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Role Management"
+                    "Expedition - Coverage"
+                ],
+                "summary": "Import expedition coverage areas from Excel file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expedition UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Excel file (.xlsx or .xls) with columns: city_code, district_code",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
                 ],
-                "summary": "Get all roles",
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved all roles",
+                        "description": "Successfully imported all rows",
                         "schema": {
                             "allOf": [
                                 {
@@ -4108,10 +4299,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/dto.RespRole"
-                                            }
+                                            "$ref": "#/definitions/dto.ResImportExpeditionServices"
                                         }
                                     }
                                 }
@@ -4119,9 +4307,21 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad request - one or more rows failed validation. Response contains details for each row including row number, key, status, and error message",
                         "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ResImportExpeditionServices"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "401": {
@@ -4129,18 +4329,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
                     }
                 }
             }
         },
-        "/v1/role-management/role/check-name": {
-            "post": {
+        "/v1/expedition/{id}/coverages/{coverageId}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Check if a role name already exists in the database",
+                "description": "Soft delete a coverage area of an expedition. Requires 'api.master-data.expedition.update' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4148,29 +4354,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Role Management"
+                    "Expedition - Coverage"
                 ],
-                "summary": "Check if role name is duplicated",
+                "summary": "Remove an expedition coverage area",
                 "parameters": [
                     {
-                        "description": "Check duplicated role request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReqCheckDuplicatedRole"
-                        }
+                        "type": "string",
+                        "description": "Expedition UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Coverage UUID",
+                        "name": "coverageId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Role with such name is not found",
+                        "description": "Successfully removed coverage area",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad request - invalid UUID or coverage not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -4181,35 +4392,23 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
-                    "409": {
-                        "description": "Role with such name exists",
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.NonPaginationResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/dto.RespRole"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/role-management/role/module-access": {
+        "/v1/expedition/{id}/tariffs": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve all permission groups organized by module. Returns permission groups with a value field indicating if the permission group is assigned to the specified role. The role_id query parameter is optional.",
+                "description": "Retrieve the tariff table of an expedition: origin city, destination city, price per kg, minimum weight and lead time. Requires 'api.master-data.expedition.view' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4217,20 +4416,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Role Management"
+                    "Expedition - Tariff"
                 ],
-                "summary": "Get all permission groups grouped by module",
+                "summary": "Get expedition tariffs",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Optional role ID to check permission group assignment",
-                        "name": "role_id",
-                        "in": "query"
+                        "description": "Expedition UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved permission groups by module",
+                        "description": "Successfully retrieved tariffs",
                         "schema": {
                             "allOf": [
                                 {
@@ -4242,7 +4442,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/dto.RespPermissionGroupByModule"
+                                                "$ref": "#/definitions/dto.RespExpeditionTariff"
                                             }
                                         }
                                     }
@@ -4251,7 +4451,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error or role not found",
+                        "description": "Bad request - invalid UUID or expedition not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -4261,18 +4461,22 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
                     }
                 }
-            }
-        },
-        "/v1/role-management/role/{id}": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a specific role by its ID",
+                "description": "Add the tariff of a route (origin city to destination city) to an expedition. An expedition has a single tariff per route. Requires 'api.master-data.expedition.update' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4280,21 +4484,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Role Management"
+                    "Expedition - Tariff"
                 ],
-                "summary": "Get role by ID",
+                "summary": "Create an expedition tariff",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Role UUID",
+                        "description": "Expedition UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Tariff data. Fields: origin_city_id (required), destination_city_id (required), price_per_kg (\u003e 0), min_weight (kg, \u003e= 0), lead_time_days (\u003e= 0)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqCreateExpeditionTariff"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved role",
+                        "description": "Successfully created tariff",
                         "schema": {
                             "allOf": [
                                 {
@@ -4304,7 +4517,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespRoleDetail"
+                                            "$ref": "#/definitions/dto.RespExpeditionTariff"
                                         }
                                     }
                                 }
@@ -4312,7 +4525,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid UUID",
+                        "description": "Bad request - validation error, unknown city or route already has a tariff",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -4323,70 +4536,51 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
-                    "404": {
-                        "description": "Role not found",
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/expedition/{id}/tariffs/export": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing role's information",
+                "description": "Export the tariff table of an expedition to Excel file (.xlsx). Excel file includes: Kode Kota Asal, Kode Kota Tujuan, Harga per Kg, Berat Minimum (Kg), Lead Time (Hari), Kota Asal, Kota Tujuan, Update Date. The first five columns follow the import template so the file can be imported back. Requires 'api.master-data.expedition.export' permission.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Role Management"
+                    "Expedition - Tariff"
                 ],
-                "summary": "Update role",
+                "summary": "Export expedition tariffs to Excel",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Role UUID",
+                        "description": "Expedition UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Updated role data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReqUpdateRole"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated role",
+                        "description": "Excel file (expedition_tariffs.xlsx)",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.NonPaginationResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/dto.RespRole"
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "file"
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error",
+                        "description": "Bad request - invalid UUID or expedition not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -4397,43 +4591,52 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
-                    "404": {
-                        "description": "Role not found",
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/expedition/{id}/tariffs/import": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete a role by its ID",
+                "description": "Upsert tariffs from an Excel file (.xlsx or .xls) with columns: origin_city_code, destination_city_code, price_per_kg, min_weight, lead_time_days. Cities are matched by official code, existing routes get the new tariff. Valid rows are imported even when other rows fail, failed rows are returned with HTTP 400. Requires 'api.master-data.expedition.update' permission.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Role Management"
+                    "Expedition - Tariff"
                 ],
-                "summary": "Delete role (soft delete)",
+                "summary": "Import expedition tariffs from Excel file",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Role UUID",
+                        "description": "Expedition UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Excel file (.xlsx or .xls) with columns: origin_city_code, destination_city_code, price_per_kg, min_weight, lead_time_days",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully deleted role",
+                        "description": "Successfully imported all rows",
                         "schema": {
                             "allOf": [
                                 {
@@ -4443,7 +4646,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespRole"
+                                            "$ref": "#/definitions/dto.ResImportExpeditionServices"
                                         }
                                     }
                                 }
@@ -4451,9 +4654,21 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid UUID",
+                        "description": "Bad request - one or more rows failed validation. Response contains details for each row including row number, key, status, and error message",
                         "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ResImportExpeditionServices"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "401": {
@@ -4462,8 +4677,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
-                    "404": {
-                        "description": "Role not found",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -4471,14 +4686,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/sub-group": {
+        "/v1/expedition/{id}/tariffs/{tariffId}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a paginated list of sub-groups with optional search and filters. Supports multiple filter values for subgroup_code, name, and groups_id. Subgroup codes are in 2-digit string format (e.g., \"01\", \"02\", \"05\", \"10\"). Only returns non-deleted sub-groups. Supports sorting by DTO field names (e.g., subgroup_code, name, groups_name, created_at, updated_at).",
+                "description": "Retrieve a single tariff of an expedition. Requires 'api.master-data.expedition.view' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4486,87 +4701,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Sub Golongan"
+                    "Expedition - Tariff"
                 ],
-                "summary": "Get list of sub-groups with pagination",
+                "summary": "Get expedition tariff by ID",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Page number (default: 1)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Items per page (default: 10)",
-                        "name": "per_page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sort by field (e.g., subgroup_code, name, groups_name, created_at, updated_at)",
-                        "name": "sort_by",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "Sort order (ASC or DESC, default: DESC)",
-                        "name": "sort_order",
-                        "in": "query"
+                        "description": "Expedition UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Search keyword (searches in subgroup_code and name)",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Filter by subgroup codes (multiple values, format: ",
-                        "name": "subgroup_codes",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Filter by names (multiple values)",
-                        "name": "names",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Filter by goods group IDs (multiple values, UUIDs)",
-                        "name": "groups_ids",
-                        "in": "query"
+                        "description": "Tariff UUID",
+                        "name": "tariffId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved sub-groups",
+                        "description": "Successfully retrieved tariff",
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/response.PaginationResponse"
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/dto.RespSubGroupIndex"
-                                            }
+                                            "$ref": "#/definitions/dto.RespExpeditionTariff"
                                         }
                                     }
                                 }
@@ -4574,7 +4740,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid query parameters",
+                        "description": "Bad request - invalid UUID or tariff not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -4593,13 +4759,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new sub-group with provided groups_id and name. Name must be unique within the same groups. Subgroup code is automatically generated by the database trigger with 2-digit format (e.g., \"01\", \"02\", \"05\", \"10\"). The code is generated sequentially based on the sequence.",
+                "description": "Update the route, price per kg, minimum weight and lead time of an expedition tariff. Requires 'api.master-data.expedition.update' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4607,23 +4773,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Sub Golongan"
+                    "Expedition - Tariff"
                 ],
-                "summary": "Create a new sub-group",
+                "summary": "Update an expedition tariff",
                 "parameters": [
                     {
-                        "description": "Sub-group creation data. Fields: groups_id (required, UUID), name (required, max 255 chars, uppercase letters only)",
+                        "type": "string",
+                        "description": "Expedition UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tariff UUID",
+                        "name": "tariffId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated tariff data. Fields: origin_city_id (required), destination_city_id (required), price_per_kg (\u003e 0), min_weight (kg, \u003e= 0), lead_time_days (\u003e= 0)",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ReqCreateSubGroup"
+                            "$ref": "#/definitions/dto.ReqUpdateExpeditionTariff"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully created sub-group with auto-generated subgroup_code in 2-digit format",
+                        "description": "Successfully updated tariff",
                         "schema": {
                             "allOf": [
                                 {
@@ -4633,7 +4813,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespSubGroup"
+                                            "$ref": "#/definitions/dto.RespExpeditionTariff"
                                         }
                                     }
                                 }
@@ -4641,7 +4821,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error or duplicate name in groups",
+                        "description": "Bad request - validation error, unknown city, route already has a tariff or tariff not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -4659,85 +4839,49 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/sub-group/export": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Export sub-groups to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination. Supports multiple filter values for each field. Only exports non-deleted sub-groups. Excel file includes: Kode Sub Golongan (2-digit format like \"01\", \"02\"), Nama Sub Golongan, Nama Golongan, Updated Date. Requires 'sub-group.export' permission.",
+                "description": "Soft delete a tariff of an expedition. Requires 'api.master-data.expedition.update' permission.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                    "application/json"
                 ],
                 "tags": [
-                    "Sub Golongan"
+                    "Expedition - Tariff"
                 ],
-                "summary": "Export sub-groups to Excel",
+                "summary": "Delete an expedition tariff",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search keyword (searches in subgroup_code and name)",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Filter by subgroup codes (multiple values, format: ",
-                        "name": "subgroup_codes",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Filter by names (multiple values)",
-                        "name": "names",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Filter by goods group IDs (multiple values, UUIDs)",
-                        "name": "groups_ids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sort by field (e.g., subgroup_code, name, groups_name, created_at, updated_at)",
-                        "name": "sort_by",
-                        "in": "query"
+                        "description": "Expedition UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Sort order (ASC or DESC)",
-                        "name": "sort_order",
-                        "in": "query"
+                        "description": "Tariff UUID",
+                        "name": "tariffId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Excel file (sub-groups.xlsx) with sub-groups data including subgroup_code in 2-digit format",
+                        "description": "Successfully deleted tariff",
                         "schema": {
-                            "type": "file"
+                            "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid query parameters",
+                        "description": "Bad request - invalid UUID or tariff not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -4757,14 +4901,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/sub-group/{id}": {
+        "/v1/group": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a single sub-group by its UUID. The response includes subgroup_code in 2-digit string format (e.g., \"01\", \"02\", \"05\", \"10\") and groups_name to identify which goods group this sub-group belongs to. Only returns non-deleted sub-groups.",
+                "description": "Retrieve a paginated list of goods groups with optional filters",
                 "consumes": [
                     "application/json"
                 ],
@@ -4772,31 +4916,61 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Sub Golongan"
+                    "Golongan"
                 ],
-                "summary": "Get sub-group by ID",
+                "summary": "Get list of groups with pagination",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Sub-group UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Search keyword",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keyword for filtering by name and group_code",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "sort_order",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved sub-group with subgroup_code in 2-digit format and groups_name",
+                        "description": "Successfully retrieved groups",
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                    "$ref": "#/definitions/response.PaginationResponse"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespSubGroup"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespGroupIndex"
+                                            }
                                         }
                                     }
                                 }
@@ -4804,7 +4978,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid UUID",
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -4814,28 +4988,16 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
-                    },
-                    "403": {
-                        "description": "Forbidden - insufficient permissions",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Sub-group not found",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing sub-group's information. Name must be unique within the same groups. Subgroup code cannot be updated as it is auto-generated. The response includes the current subgroup_code in 2-digit format.",
+                "description": "Create a new goods group with provided name",
                 "consumes": [
                     "application/json"
                 ],
@@ -4843,30 +5005,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Sub Golongan"
+                    "Golongan"
                 ],
-                "summary": "Update sub-group",
+                "summary": "Create a new group",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Sub-group UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated sub-group data. Fields: groups_id (required, UUID), name (required, max 255 chars, uppercase letters only). Note: subgroup_code is read-only and cannot be updated.",
+                        "description": "Group creation data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ReqUpdateSubGroup"
+                            "$ref": "#/definitions/dto.ReqCreateGroup"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated sub-group with subgroup_code in 2-digit format",
+                        "description": "Successfully created group",
                         "schema": {
                             "allOf": [
                                 {
@@ -4876,7 +5031,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespSubGroup"
+                                            "$ref": "#/definitions/dto.RespGroup"
                                         }
                                     }
                                 }
@@ -4884,7 +5039,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error or duplicate name in groups",
+                        "description": "Bad request - validation error",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -4894,56 +5049,55 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
-                    },
-                    "403": {
-                        "description": "Forbidden - insufficient permissions",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Sub-group not found",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/group/export": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete an existing sub-group by ID. The sub-group will be marked as deleted (deleted_at is set) but remains in the database. Requires 'api.master-data.sub-group.delete' permission.",
+                "description": "Export goods groups to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Sub Golongan"
+                    "Golongan"
                 ],
-                "summary": "Soft delete sub-group",
+                "summary": "Export groups to Excel",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Sub-group UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Search keyword for filtering by name and group_code",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "sort_order",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully soft deleted sub-group",
+                        "description": "Excel file with groups data",
                         "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
+                            "type": "file"
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid UUID",
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -4953,30 +5107,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
-                    },
-                    "403": {
-                        "description": "Forbidden - insufficient permissions",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Sub-group not found",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
                     }
                 }
             }
         },
-        "/v1/subdistrict": {
+        "/v1/group/tree": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a paginated list of subdistricts with optional filters",
+                "description": "Retrieve the nested Group -\u003e Sub Group -\u003e Type -\u003e Backing hierarchy with child and backing counts per node. Search and filters apply to any level and keep the ancestors of matching nodes.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4984,32 +5126,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Regency - Subdistrict"
+                    "Golongan"
                 ],
-                "summary": "Get list of subdistricts with pagination",
+                "summary": "Get product catalog tree",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Items per page",
-                        "name": "per_page",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Multiple values",
+                        "name": "group_ids",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Search keyword",
+                        "description": "Search keyword on code and name of every level",
                         "name": "search",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by district_id",
-                        "name": "district_id",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Multiple values",
+                        "name": "subgroup_ids",
                         "in": "query"
                     },
                     {
@@ -5018,24 +5162,18 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "description": "Filter by multiple names (WHERE IN)",
-                        "name": "names",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search keyword for filtering by name",
-                        "name": "search",
+                        "description": "Multiple values",
+                        "name": "type_ids",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved subdistricts",
+                        "description": "Successfully retrieved catalog tree",
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/response.PaginationResponse"
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
                                 },
                                 {
                                     "type": "object",
@@ -5043,7 +5181,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/dto.RespSubdistrictIndex"
+                                                "$ref": "#/definitions/dto.RespCatalogTreeNode"
                                             }
                                         }
                                     }
@@ -5064,77 +5202,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Create a new subdistrict with provided district_id and name",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Regency - Subdistrict"
-                ],
-                "summary": "Create a new subdistrict",
-                "parameters": [
-                    {
-                        "description": "Subdistrict creation data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReqCreateSubdistrict"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Successfully created subdistrict",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.NonPaginationResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/dto.RespSubdistrict"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request - validation error",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
-                    }
-                }
             }
         },
-        "/v1/subdistrict/export": {
+        "/v1/group/tree/export": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Export subdistricts to Excel file (.xlsx) with optional search and filter",
+                "description": "Export the catalog tree to Excel file (.xlsx) with one row per backing and its full Group / Sub Group / Type path. Same search and filter logic as the tree endpoint.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5142,20 +5219,24 @@ const docTemplate = `{
                     "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Regency - Subdistrict"
+                    "Golongan"
                 ],
-                "summary": "Export subdistricts to Excel",
+                "summary": "Export product catalog tree to Excel",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Search keyword",
-                        "name": "search",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Multiple values",
+                        "name": "group_ids",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by district_id",
-                        "name": "district_id",
+                        "description": "Search keyword on code and name of every level",
+                        "name": "search",
                         "in": "query"
                     },
                     {
@@ -5164,20 +5245,24 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "description": "Filter by multiple names (WHERE IN)",
-                        "name": "names",
+                        "description": "Multiple values",
+                        "name": "subgroup_ids",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Search keyword for filtering by name",
-                        "name": "search",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Multiple values",
+                        "name": "type_ids",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Excel file with subdistricts data",
+                        "description": "Excel file with catalog tree data",
                         "schema": {
                             "type": "file"
                         }
@@ -5197,14 +5282,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/subdistrict/{id}": {
+        "/v1/group/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a single subdistrict by its ID",
+                "description": "Retrieve a single goods group by its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -5212,13 +5297,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Regency - Subdistrict"
+                    "Golongan"
                 ],
-                "summary": "Get subdistrict by ID",
+                "summary": "Get group by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Subdistrict UUID",
+                        "description": "Group UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -5226,7 +5311,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved subdistrict",
+                        "description": "Successfully retrieved group",
                         "schema": {
                             "allOf": [
                                 {
@@ -5236,7 +5321,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespSubdistrict"
+                                            "$ref": "#/definitions/dto.RespGroup"
                                         }
                                     }
                                 }
@@ -5256,7 +5341,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Subdistrict not found",
+                        "description": "Group not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -5269,7 +5354,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing subdistrict's information",
+                "description": "Update an existing goods group's information",
                 "consumes": [
                     "application/json"
                 ],
@@ -5277,30 +5362,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Regency - Subdistrict"
+                    "Golongan"
                 ],
-                "summary": "Update subdistrict",
+                "summary": "Update group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Subdistrict UUID",
+                        "description": "Group UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated subdistrict data",
+                        "description": "Updated group data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ReqUpdateSubdistrict"
+                            "$ref": "#/definitions/dto.ReqUpdateGroup"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated subdistrict",
+                        "description": "Successfully updated group",
                         "schema": {
                             "allOf": [
                                 {
@@ -5310,7 +5395,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespSubdistrict"
+                                            "$ref": "#/definitions/dto.RespGroup"
                                         }
                                     }
                                 }
@@ -5330,7 +5415,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Subdistrict not found",
+                        "description": "Group not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -5343,7 +5428,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete an existing subdistrict by ID",
+                "description": "Delete an existing goods group by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -5351,13 +5436,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Regency - Subdistrict"
+                    "Golongan"
                 ],
-                "summary": "Delete subdistrict",
+                "summary": "Delete group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Subdistrict UUID",
+                        "description": "Group UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -5365,7 +5450,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully deleted subdistrict",
+                        "description": "Successfully deleted group",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -5383,7 +5468,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Subdistrict not found",
+                        "description": "Group not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -5391,14 +5476,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/type": {
+        "/v1/item": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a paginated list of types with optional search and filters. Supports multiple filter values for type_code, subgroup_id, and name. Only returns non-deleted types. Requires 'api.master-data.type.view' permission.",
+                "description": "Retrieve a paginated list of items with optional search and filters. Supports multiple filter values for each field. Only returns non-deleted items. Requires 'api.master-data.item.view' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5406,9 +5491,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Jenis"
+                    "Item"
                 ],
-                "summary": "Get list of types with pagination",
+                "summary": "Get list of items with pagination",
                 "parameters": [
                     {
                         "type": "integer",
@@ -5424,7 +5509,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Sort column (allowed: id, subgroup_id, type_code, name, created_at, updated_at)",
+                        "description": "Sort column (allowed: id, item_code, name, backing_name, type_name, subgroup_name, group_name, base_uom, is_active, created_at, updated_at)",
                         "name": "sort_by",
                         "in": "query"
                     },
@@ -5436,7 +5521,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Search keyword (searches in type_code and name)",
+                        "description": "Search keyword (searches in item_code, name, backing name and barcodes)",
                         "name": "search",
                         "in": "query"
                     },
@@ -5446,8 +5531,8 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "description": "Filter by type codes (multiple values)",
-                        "name": "type_codes",
+                        "description": "Filter by SKU codes (multiple values)",
+                        "name": "item_codes",
                         "in": "query"
                     },
                     {
@@ -5456,7 +5541,37 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "description": "Filter by subgroup IDs (multiple values, UUIDs)",
+                        "description": "Filter by names (multiple values)",
+                        "name": "names",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by backing IDs (multiple values)",
+                        "name": "backing_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by type IDs (multiple values)",
+                        "name": "type_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by sub-group IDs (multiple values)",
                         "name": "subgroup_ids",
                         "in": "query"
                     },
@@ -5466,7 +5581,7 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "description": "Filter by group IDs (multiple values, UUIDs)",
+                        "description": "Filter by group IDs (multiple values)",
                         "name": "group_ids",
                         "in": "query"
                     },
@@ -5476,14 +5591,34 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "description": "Filter by names (multiple values)",
-                        "name": "names",
+                        "description": "Filter by base units of measure (multiple values)",
+                        "name": "base_uoms",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by barcodes (multiple values)",
+                        "name": "barcodes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "boolean"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by active flag (multiple values)",
+                        "name": "is_active",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved types",
+                        "description": "Successfully retrieved items",
                         "schema": {
                             "allOf": [
                                 {
@@ -5495,7 +5630,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/dto.RespTypeIndex"
+                                                "$ref": "#/definitions/dto.RespItemIndex"
                                             }
                                         }
                                     }
@@ -5529,7 +5664,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new type with provided data. Type code is automatically generated by the system. Requires 'api.master-data.type.create' permission.",
+                "description": "Create a new item (SKU) under a backing, it inherits the type, sub-group and group of the backing. units lists the other units of measure with the number of base units they contain (contoh: [{\u0026quot;uom\u0026quot;:\u0026quot;box\u0026quot;,\u0026quot;conversion\u0026quot;:12}]), barcodes lists the barcodes with the unit they are printed on (empty for the base unit). base_uom defaults to pcs and is_active to true. SKU code is generated from the item code policy when item_code is empty. Requires 'api.master-data.item.create' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5537,23 +5672,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Jenis"
+                    "Item"
                 ],
-                "summary": "Create a new type",
+                "summary": "Create a new item",
                 "parameters": [
                     {
-                        "description": "Type creation data. Fields: subgroup_id (required, UUID), name (required, max 255 chars)",
+                        "description": "Item creation data. Fields: item_code (optional), name (required), backing_id (required), base_uom, units (array), barcodes (array), length, width, height (cm), weight (kg), is_active",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ReqCreateType"
+                            "$ref": "#/definitions/dto.ReqCreateItem"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully created type with auto-generated type_code",
+                        "description": "Successfully created item with full details including units, barcodes and images",
                         "schema": {
                             "allOf": [
                                 {
@@ -5563,7 +5698,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespType"
+                                            "$ref": "#/definitions/dto.RespItem"
                                         }
                                     }
                                 }
@@ -5571,7 +5706,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error or duplicate name in subgroup",
+                        "description": "Bad request - validation error, duplicate SKU code, name or barcode, invalid unit or backing not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -5591,14 +5726,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/type/export": {
+        "/v1/item/export": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Export types to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination. Supports multiple filter values for type_code, subgroup_id, and name. Requires 'api.master-data.type.export' permission.",
+                "description": "Export items to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination. The first columns (Item Code, Name, Backing Code, Base UoM, Units, Barcodes, Length, Width, Height, Weight, Active) follow the import template so the file can be imported again, followed by Backing, Type, Sub-group, Group and Update Date. Requires 'api.master-data.item.export' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5606,13 +5741,13 @@ const docTemplate = `{
                     "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Jenis"
+                    "Item"
                 ],
-                "summary": "Export types to Excel",
+                "summary": "Export items to Excel",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search keyword (searches in type_code and name)",
+                        "description": "Search keyword (searches in item_code, name, backing name and barcodes)",
                         "name": "search",
                         "in": "query"
                     },
@@ -5622,8 +5757,8 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "description": "Filter by type codes (multiple values)",
-                        "name": "type_codes",
+                        "description": "Filter by SKU codes (multiple values)",
+                        "name": "item_codes",
                         "in": "query"
                     },
                     {
@@ -5632,8 +5767,8 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "description": "Filter by subgroup IDs (multiple values, UUIDs)",
-                        "name": "subgroup_ids",
+                        "description": "Filter by names (multiple values)",
+                        "name": "names",
                         "in": "query"
                     },
                     {
@@ -5642,31 +5777,91 @@ const docTemplate = `{
                             "type": "string"
                         },
                         "collectionFormat": "csv",
-                        "description": "Filter by names (multiple values)",
-                        "name": "names",
+                        "description": "Filter by backing IDs (multiple values)",
+                        "name": "backing_ids",
                         "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Excel file (types.xlsx) with types data",
-                        "schema": {
-                            "type": "file"
-                        }
                     },
-                    "400": {
-                        "description": "Bad request - invalid query parameters",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by type IDs (multiple values)",
+                        "name": "type_ids",
+                        "in": "query"
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by sub-group IDs (multiple values)",
+                        "name": "subgroup_ids",
+                        "in": "query"
                     },
-                    "403": {
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by group IDs (multiple values)",
+                        "name": "group_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by base units of measure (multiple values)",
+                        "name": "base_uoms",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by barcodes (multiple values)",
+                        "name": "barcodes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "boolean"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by active flag (multiple values)",
+                        "name": "is_active",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Excel file (items.xlsx) with items data",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
                         "description": "Forbidden - insufficient permissions",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
@@ -5675,14 +5870,133 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/type/{id}": {
+        "/v1/item/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create items from an Excel file (.xlsx or .xls) with columns: item_code (optional, generated when empty), name, backing_code, base_uom (default pcs), units (e.g. \"box=12; carton=144\"), barcodes (e.g. \"8991234567890; 8991234567891:box\"), length, width, height (cm), weight (kg), is_active (yes/no, default yes). A file exported from /v1/item/export can be imported as is. Names must be unique in the backing and barcodes must not be used by another item. Valid rows are imported even when other rows fail, failed rows are returned with HTTP 400. Requires 'api.master-data.item.create' permission.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Import items from Excel file",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Excel file (.xlsx or .xls) with columns: item_code, name, backing_code, base_uom, units, barcodes, length, width, height, weight, is_active",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully imported all items",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ResImportItems"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - one or more rows failed validation. Response contains details for each row including row number, item name, status, and error message",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ResImportItems"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/item/import/template": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a single type by its UUID. The response includes subgroup_name to identify which sub-group this type belongs to. Only returns non-deleted types. Requires 'api.master-data.type.view' permission.",
+                "description": "Download Excel template file for importing items. Template contains columns: item_code, name, backing_code, base_uom, units, barcodes, length, width, height, weight, is_active with example data.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Download item import Excel template",
+                "responses": {
+                    "200": {
+                        "description": "Excel template file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/item/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a single item by its UUID with its backing, type, sub-group and group, units, barcodes and images. Only returns non-deleted items. Requires 'api.master-data.item.view' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5690,13 +6004,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Jenis"
+                    "Item"
                 ],
-                "summary": "Get type by ID",
+                "summary": "Get item by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Type UUID",
+                        "description": "Item UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -5704,7 +6018,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved type with subgroup_name",
+                        "description": "Successfully retrieved item with full details including units, barcodes and images",
                         "schema": {
                             "allOf": [
                                 {
@@ -5714,7 +6028,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespType"
+                                            "$ref": "#/definitions/dto.RespItem"
                                         }
                                     }
                                 }
@@ -5740,7 +6054,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Type not found",
+                        "description": "Item not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -5753,7 +6067,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing type's information. Type code cannot be updated. Requires 'api.master-data.type.update' permission.",
+                "description": "Update an existing item. The SKU code cannot be changed. Existing units and barcodes are replaced by the given ones. is_active keeps its value when omitted. Requires 'api.master-data.item.update' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5761,30 +6075,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Jenis"
+                    "Item"
                 ],
-                "summary": "Update type",
+                "summary": "Update item",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Type UUID",
+                        "description": "Item UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated type data. Fields: subgroup_id (required, UUID), name (required, max 255 chars)",
+                        "description": "Updated item data. Fields: name (required), backing_id (required), base_uom, units (array), barcodes (array), length, width, height (cm), weight (kg), is_active",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ReqUpdateType"
+                            "$ref": "#/definitions/dto.ReqUpdateItem"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated type",
+                        "description": "Successfully updated item with full details including units, barcodes and images",
                         "schema": {
                             "allOf": [
                                 {
@@ -5794,7 +6108,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespType"
+                                            "$ref": "#/definitions/dto.RespItem"
                                         }
                                     }
                                 }
@@ -5802,7 +6116,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error or duplicate name in subgroup",
+                        "description": "Bad request - validation error, duplicate name or barcode, invalid unit or backing not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -5820,7 +6134,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Type not found",
+                        "description": "Item not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -5833,7 +6147,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete an existing type by ID. The type will be marked as deleted (deleted_at is set) but remains in the database. Requires 'api.master-data.type.delete' permission.",
+                "description": "Soft delete an existing item by ID. The item will be marked as deleted (deleted_at is set) but remains in the database, its barcodes become available to other items. Requires 'api.master-data.item.delete' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5841,13 +6155,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Jenis"
+                    "Item"
                 ],
-                "summary": "Soft delete type",
+                "summary": "Soft delete item",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Type UUID",
+                        "description": "Item UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -5855,7 +6169,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully soft deleted type",
+                        "description": "Successfully soft deleted item",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -5879,7 +6193,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Type not found",
+                        "description": "Item not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -5887,33 +6201,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/user-management/register": {
+        "/v1/item/{id}/images": {
             "post": {
-                "description": "Register a new user without authentication",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload one or more images of an item. The files are stored through the file module and assigned to the item with type \"image\", existing images are kept. Requires 'api.master-data.item.update' permission.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Item"
                 ],
-                "summary": "Register a new user (Public)",
+                "summary": "Upload item images",
                 "parameters": [
                     {
-                        "description": "User registration data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReqRegisterUser"
-                        }
+                        "type": "string",
+                        "description": "Item UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image files (repeat the field for several images)",
+                        "name": "images",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully registered user",
+                        "description": "Successfully uploaded images, returns the item with all its images",
                         "schema": {
                             "allOf": [
                                 {
@@ -5923,7 +6247,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespUser"
+                                            "$ref": "#/definitions/dto.RespItem"
                                         }
                                     }
                                 }
@@ -5931,7 +6255,19 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error",
+                        "description": "Bad request - no image, upload failed or item not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -5939,14 +6275,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/user-management/user": {
-            "get": {
+        "/v1/item/{id}/images/{fileId}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a paginated list of users with optional filtering",
+                "description": "Remove an image from an item and delete its file. Requires 'api.master-data.item.update' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5954,70 +6290,114 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Item"
                 ],
-                "summary": "Get paginated list of users",
+                "summary": "Delete an item image",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "per_page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sort column",
-                        "name": "sort_by",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "Sort order (asc/desc)",
-                        "name": "sort_order",
-                        "in": "query"
+                        "description": "Item UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Search query",
-                        "name": "search",
+                        "description": "File UUID of the image",
+                        "name": "fileId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted item image",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID or the file is not an image of the item",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/parameter": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of parameters with optional filters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parameter"
+                ],
+                "summary": "Get list of parameters with pagination",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
                         "in": "query"
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "name": "role_ids",
+                        "type": "integer",
+                        "description": "Items per page",
+                        "name": "per_page",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "name": "role_name",
+                        "description": "Search keyword",
+                        "name": "search",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "name": "sort_by",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by parameter types (array)",
+                        "name": "types",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "name": "sort_order",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by parameter names (array)",
+                        "name": "names",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved users",
+                        "description": "Successfully retrieved parameters",
                         "schema": {
                             "allOf": [
                                 {
@@ -6029,7 +6409,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/dto.RespUserIndex"
+                                                "$ref": "#/definitions/dto.RespParameterIndex"
                                             }
                                         }
                                     }
@@ -6057,7 +6437,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new user with provided information",
+                "description": "Create a new parameter with provided code, name, value, and description. A typed parameter must use a type registered in /v1/parameter-type, its value is checked against the value kind and JSON Schema of the type and stored in canonical form, and its parent must have a type allowed by the type. Untyped parameters keep a free-form value.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6065,23 +6445,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Parameter"
                 ],
-                "summary": "Create a new user",
+                "summary": "Create a new parameter",
                 "parameters": [
                     {
-                        "description": "User creation data",
+                        "description": "Parameter creation data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ReqCreateUser"
+                            "$ref": "#/definitions/dto.ReqCreateParameter"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully created user",
+                        "description": "Successfully created parameter",
                         "schema": {
                             "allOf": [
                                 {
@@ -6091,7 +6471,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespUser"
+                                            "$ref": "#/definitions/dto.RespParameter"
                                         }
                                     }
                                 }
@@ -6113,14 +6493,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/user-management/user/all": {
+        "/v1/parameter-type": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve all users without pagination",
+                "description": "Retrieve every registered parameter type with its value kind (string, int, decimal, bool, date, enum, json), enum values, JSON Schema and allowed parent types",
                 "consumes": [
                     "application/json"
                 ],
@@ -6128,12 +6508,12 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Parameter Type"
                 ],
-                "summary": "Get all users",
+                "summary": "Get parameter types",
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved all users",
+                        "description": "Successfully retrieved parameter types",
                         "schema": {
                             "allOf": [
                                 {
@@ -6145,7 +6525,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/dto.RespUser"
+                                                "$ref": "#/definitions/dto.RespParameterType"
                                             }
                                         }
                                     }
@@ -6164,18 +6544,22 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
                     }
                 }
-            }
-        },
-        "/v1/user-management/user/check-email": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Check if a user email already exists in the database",
+                "description": "Register a parameter type. value_kind decides how the values of its parameters are parsed and stored: string, int, decimal, bool (true / false), date (YYYY-MM-DD), enum (one of enum_values) or json. json_schema is an optional JSON Schema the parsed value must match (type, enum, const, minimum, maximum, exclusiveMinimum, exclusiveMaximum, multipleOf, minLength, maxLength, pattern, format, properties, required, additionalProperties, minProperties, maxProperties, items, minItems, maxItems, uniqueItems). allowed_parent_types lists the types the parent of a parameter may have, the type itself included, empty when its parameters have no parent. Requires 'api.master-data.parameter.create' permission.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6183,29 +6567,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Parameter Type"
                 ],
-                "summary": "Check if email is duplicated",
+                "summary": "Create a parameter type",
                 "parameters": [
                     {
-                        "description": "Check duplicated email request",
+                        "description": "Parameter type. Fields: code (required), name (required), value_kind (required), value_required, enum_values, json_schema, allowed_parent_types, description",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ReqCheckDuplicatedEmail"
+                            "$ref": "#/definitions/dto.ReqCreateParameterType"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User with such email is not found",
+                        "description": "Successfully created parameter type",
                         "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespParameterType"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad request - validation error, duplicate code, invalid enum values, schema or parent type",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -6215,18 +6611,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
                     }
                 }
             }
         },
-        "/v1/user-management/user/check-name": {
-            "post": {
+        "/v1/parameter-type/{code}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Check if a user name already exists in the database",
+                "description": "Retrieve a single parameter type by its code (the value of the type field of the parameters)",
                 "consumes": [
                     "application/json"
                 ],
@@ -6234,29 +6636,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Parameter Type"
                 ],
-                "summary": "Check if user name is duplicated",
+                "summary": "Get parameter type by code",
                 "parameters": [
                     {
-                        "description": "Check duplicated user request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReqCheckDuplicatedUser"
-                        }
+                        "type": "string",
+                        "description": "Parameter type code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User with such name is not found",
+                        "description": "Successfully retrieved parameter type",
                         "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespParameterType"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad request - parameter type not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -6266,40 +6678,53 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
                     }
                 }
-            }
-        },
-        "/v1/user-management/user/import": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Import multiple users from an Excel file (.xlsx or .xls). The Excel file must have columns: email, full_name, username, nik, role_name. Validates for duplicate email, username, and NIK. Returns HTTP 400 if any row fails with detailed error information per row.",
+                "description": "Update a parameter type with the same rules as creation. The code cannot be changed. Every parameter of the type must still match it (value and parent type), otherwise the update is rejected with the first parameter that does not. Requires 'api.master-data.parameter.update' permission.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Parameter Type"
                 ],
-                "summary": "Import users from Excel file",
+                "summary": "Update a parameter type",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "Excel file (.xlsx or .xls) with columns: email, full_name, username, nik, role_name",
-                        "name": "file",
-                        "in": "formData",
+                        "type": "string",
+                        "description": "Parameter type code",
+                        "name": "code",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Parameter type. Fields: name (required), value_kind (required), value_required, enum_values, json_schema, allowed_parent_types, description",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqUpdateParameterType"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully imported all users",
+                        "description": "Successfully updated parameter type",
                         "schema": {
                             "allOf": [
                                 {
@@ -6309,7 +6734,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.ResImportUsers"
+                                            "$ref": "#/definitions/dto.RespParameterType"
                                         }
                                     }
                                 }
@@ -6317,21 +6742,9 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - one or more rows failed validation. Response contains details for each row including row number, username, status, and error message",
+                        "description": "Bad request - validation error, parameter type not found or a parameter no longer matches the type",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.NonPaginationResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/dto.ResImportUsers"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
                     "401": {
@@ -6340,42 +6753,49 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     }
                 }
-            }
-        },
-        "/v1/user-management/user/import/template": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Download Excel template file for importing users. Template contains columns: email, full_name, username, nik, role_name with example data.",
+                "description": "Delete a parameter type no parameter uses (soft-deleted parameters included). The type is removed from the allowed parent types of the other types. Requires 'api.master-data.parameter.delete' permission.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                    "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Parameter Type"
+                ],
+                "summary": "Delete a parameter type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Parameter type code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Download user import Excel template",
                 "responses": {
                     "200": {
-                        "description": "Excel template file",
+                        "description": "Successfully deleted parameter type",
                         "schema": {
-                            "type": "file"
+                            "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad request - parameter type not found or in use",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -6386,8 +6806,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -6395,56 +6815,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/user-management/user/password-confirmation": {
-            "post": {
+        "/v1/parameter/export": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Verify the current authenticated user's password",
+                "description": "Export parameters to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "User Management"
+                    "Parameter"
                 ],
-                "summary": "Confirm current user password",
+                "summary": "Export parameters to Excel",
                 "parameters": [
                     {
-                        "description": "Password confirmation data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReqConfirmationUserPassword"
-                        }
+                        "type": "string",
+                        "description": "Search keyword",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by parameter types (array)",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by parameter names (array)",
+                        "name": "names",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Password confirmed successfully",
+                        "description": "Excel file with parameters data",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.NonPaginationResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/dto.RespUser"
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "file"
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid password",
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -6458,36 +6883,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/user-management/user/{id}": {
-            "get": {
+        "/v1/parameter/import": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a specific user by their ID",
+                "description": "Create or update (by code) parameters from an Excel file (.xlsx or .xls) with columns: code, name, value, type, description, parent_code. A file exported from /v1/parameter/export can be imported as is. The type must be registered in /v1/parameter-type and the value must match it, the parent may be another row of the file or an existing parameter and must have a type allowed by the type of the row. A row fails when its parent row fails. Valid rows are imported even when other rows fail, failed rows are returned with HTTP 400. Requires 'parameter.create' permission.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Parameter"
                 ],
-                "summary": "Get user by ID",
+                "summary": "Import parameters from Excel file",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User UUID",
-                        "name": "id",
-                        "in": "path",
+                        "type": "file",
+                        "description": "Excel file (.xlsx or .xls) with columns: code, name, value, type, description, parent_code",
+                        "name": "file",
+                        "in": "formData",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved user",
+                        "description": "Successfully imported all parameters",
                         "schema": {
                             "allOf": [
                                 {
@@ -6497,7 +6922,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespUserDetail"
+                                            "$ref": "#/definitions/dto.ResImportParameters"
                                         }
                                     }
                                 }
@@ -6505,9 +6930,21 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid UUID",
+                        "description": "Bad request - one or more rows failed validation. Response contains details for each row including row number, parameter code, status, and error message",
                         "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ResImportParameters"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "401": {
@@ -6516,21 +6953,58 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/parameter/import/template": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing user's information",
+                "description": "Download Excel template file for importing parameters. Template contains columns: code, name, value, type, description, parent_code with example data.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Parameter"
+                ],
+                "summary": "Download parameter import Excel template",
+                "responses": {
+                    "200": {
+                        "description": "Excel template file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/parameter/lookup": {
+            "get": {
+                "description": "Public read-only list of parameters for dropdowns, by type and/or by code of the parent, in sibling order (sort_order) and then by name. Served from the parameter cache, which is refreshed on every change. Responds 304 when If-None-Match matches the current ETag",
                 "consumes": [
                     "application/json"
                 ],
@@ -6538,30 +7012,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Parameter"
                 ],
-                "summary": "Update user",
+                "summary": "Lookup parameters",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Parameter type, required when parent_code is empty",
+                        "name": "type",
+                        "in": "query"
                     },
                     {
-                        "description": "Updated user data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReqUpdateUser"
-                        }
+                        "type": "string",
+                        "description": "Code of the parent parameter, required when type is empty",
+                        "name": "parent_code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the list already held by the client",
+                        "name": "If-None-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated user",
+                        "description": "Successfully retrieved parameters",
                         "schema": {
                             "allOf": [
                                 {
@@ -6571,15 +7047,66 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespUser"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespParameterLookup"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "304": {
+                        "description": "List not modified"
+                    },
                     "400": {
-                        "description": "Bad request - validation error",
+                        "description": "Bad request - no filter",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/parameter/reorder": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set the order of the children of a parent, or of the parameters of a type without parent when parent_id is empty. ids must list every sibling exactly once, in the new order.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parameter"
+                ],
+                "summary": "Reorder sibling parameters",
+                "parameters": [
+                    {
+                        "description": "Siblings in their new order. Fields: parent_id (optional, UUID), type (used without parent_id), ids (required)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqReorderParameters"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully reordered parameters",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - ids do not match the siblings",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -6590,21 +7117,23 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/parameter/tree": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete a user account by setting deleted_at timestamp. The user will be marked as deleted but remain in the database. Requires 'api.user-management.user.delete' permission.",
+                "description": "Retrieve the parameters of a type as a tree of parents and children, siblings ordered by sort_order then name. A parameter whose parent has another type is a root. deletable is false when the parameter or one of its descendants is used by another module.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6612,21 +7141,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Parameter"
                 ],
-                "summary": "Soft delete a user",
+                "summary": "Get parameter tree",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User UUID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Parameter type",
+                        "name": "type",
+                        "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully soft deleted user",
+                        "description": "Successfully retrieved parameter tree",
                         "schema": {
                             "allOf": [
                                 {
@@ -6636,7 +7165,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespUser"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespParameterTreeNode"
+                                            }
                                         }
                                     }
                                 }
@@ -6644,7 +7176,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid UUID or user not found",
+                        "description": "Bad request - type is required",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -6654,30 +7186,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
-                    },
-                    "403": {
-                        "description": "Forbidden - insufficient permissions",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "User not found",
-                        "schema": {
-                            "$ref": "#/definitions/response.NonPaginationResponse"
-                        }
                     }
                 }
             }
         },
-        "/v1/user-management/user/{id}/password": {
-            "patch": {
+        "/v1/parameter/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update a user's password by their ID",
+                "description": "Retrieve a single parameter by its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -6685,30 +7205,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Parameter"
                 ],
-                "summary": "Update user password",
+                "summary": "Get parameter by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User UUID",
+                        "description": "Parameter UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Password update data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReqUpdateUserPassword"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated password",
+                        "description": "Successfully retrieved parameter",
                         "schema": {
                             "allOf": [
                                 {
@@ -6718,7 +7229,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dto.RespUser"
+                                            "$ref": "#/definitions/dto.RespParameter"
                                         }
                                     }
                                 }
@@ -6726,7 +7237,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error",
+                        "description": "Bad request - invalid UUID",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
@@ -6738,160 +7249,12193 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Parameter not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing parameter's information. The value and the parent are checked against the type as on create, and the typed children of the parameter must accept its new type. The parent must not be one of the descendants of the parameter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parameter"
+                ],
+                "summary": "Update parameter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Parameter UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated parameter data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqUpdateParameter"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated parameter",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespParameter"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Parameter not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an existing parameter by ID together with its descendants. Fails when the parameter or one of its descendants is used by another module.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parameter"
+                ],
+                "summary": "Delete parameter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Parameter UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted parameter",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Parameter not found",
                         "schema": {
                             "$ref": "#/definitions/response.NonPaginationResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.ReferenceObject": {
+        },
+        "/v1/parameter/{id}/move": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Link a parameter to another parent, or to none when parent_id is empty, at a position among its new siblings (last when position is empty). The parent must not be the parameter or one of its descendants and must be allowed by the type of the parameter. The siblings are renumbered.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parameter"
+                ],
+                "summary": "Move parameter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Parameter UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target parent and position. Fields: parent_id (optional, UUID), position (optional, starting at 1)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqMoveParameter"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully moved parameter",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespParameter"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parent, cycle or parent type not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/post": {
+            "get": {
+                "description": "Retrieve a paginated list of published posts with optional filtering",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Get paginated list of posts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort column",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order (asc/desc)",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "lang_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "ignored by the public index, which lists published posts only",
+                        "name": "statuses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "topic_ids",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.PaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespPostIndex"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a post with optional thumbnail upload, the post starts as a draft",
+                "consumes": [
+                    "application/json",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Create post",
+                "parameters": [
+                    {
+                        "description": "Post payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqCreatePost"
+                        }
+                    },
+                    {
+                        "type": "file",
+                        "description": "Thumbnail file",
+                        "name": "thumbnail",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPost"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/post/manage": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the posts of every author in every status, e.g. statuses=review for the review queue. Requires 'post.publish' permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Get paginated list of every post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by statuses (array)",
+                        "name": "statuses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "lang_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "ignored by the public index, which lists published posts only",
+                        "name": "statuses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "topic_ids",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.PaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespPostIndex"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/post/manage/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the detail of a post in any status. Requires 'post.publish' permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Get any post by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPost"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/post/mine": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the posts of the authenticated author in every status (draft, review, published, archived)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Get paginated list of my posts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by statuses (array)",
+                        "name": "statuses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "lang_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "ignored by the public index, which lists published posts only",
+                        "name": "statuses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "topic_ids",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.PaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespPostIndex"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/post/mine/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the detail of a post of the authenticated author in any status",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Get my post by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPost"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/post/{id}": {
+            "get": {
+                "description": "Retrieve the detail of a published post and its parameters",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Get post by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPost"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update a post with optional thumbnail upload. Authors update their own posts only, a published or in review post goes back to draft; editors ('post.publish' permission) update any post.",
+                "consumes": [
+                    "application/json",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Update post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Post payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqUpdatePost"
+                        }
+                    },
+                    {
+                        "type": "file",
+                        "description": "Thumbnail file",
+                        "name": "thumbnail",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPost"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete a post by ID, the post can be restored from the recycle bin",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Delete post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/post/{id}/archive": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a post from the public index without deleting it, cancelling its schedule. Requires 'post.publish' permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Archive post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPost"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/post/{id}/publish": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Publish a draft or a post in review now. With publish_at in the future the post stays in review and is published by the scheduler at that time. Requires 'post.publish' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Publish or schedule post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Publication time, now when empty",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqPublishPost"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPost"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/post/{id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Send a post in review back to draft, cancelling its schedule. Requires 'post.publish' permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Reject post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPost"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/post/{id}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the revisions of a post, newest first. A revision is recorded on every create, update and restore. Authors see the revisions of their own posts only, editors ('post.publish' permission) those of any post.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Get paginated list of post revisions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by revision_number, action or created_at",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order (asc or desc)",
+                        "name": "sort_order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.PaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespPostRevisionIndex"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/post/{id}/revisions/diff": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the fields whose value differs between the snapshots of two revisions of the post, of an own post for authors",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Diff two post revisions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Revision ID to compare from",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Revision ID to compare to",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPostRevisionDiff"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/post/{id}/revisions/{revisionId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the full snapshot of a revision: content, lang, topics and thumbnail, of an own post for authors",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Get post revision by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Revision ID",
+                        "name": "revisionId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPostRevision"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/post/{id}/revisions/{revisionId}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Roll the post back to the snapshot of a revision (content, lang, topics and thumbnail), recorded as a new revision. Authors restore their own posts only, a published or in review post goes back to draft; editors ('post.publish' permission) restore any post.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Restore post revision",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Revision ID",
+                        "name": "revisionId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPost"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/post/{id}/submit": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move a draft to review, only the author of the post can submit it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Submit post for review",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPost"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/post/{id}/unpublish": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Take a published or archived post back to draft. Requires 'post.publish' permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Unpublish post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPost"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/price-list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of price lists with optional search and filters. Supports multiple filter values for each field. Only returns non-deleted price lists. Requires 'api.master-data.price-list.view' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price List"
+                ],
+                "summary": "Get list of price lists with pagination",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default: 10)",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort column (allowed: id, code, name, priority, is_active, created_at, updated_at)",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order: asc or desc (default: desc)",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keyword (searches in code and name)",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by codes (multiple values)",
+                        "name": "codes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by names (multiple values)",
+                        "name": "names",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by customer category IDs (multiple values)",
+                        "name": "customer_category_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "boolean"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by active flag (multiple values)",
+                        "name": "is_active",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved price lists",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.PaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespPriceListIndex"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a named price list. customer_category_ids assigns the list to customer categories (parameters of type customer_category), a list without category applies to every customer. When several lists have a price the list of the customer category wins, then the highest priority. is_active defaults to true. Requires 'api.master-data.price-list.create' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price List"
+                ],
+                "summary": "Create a new price list",
+                "parameters": [
+                    {
+                        "description": "Price list creation data. Fields: code (required), name (required), description, priority, customer_category_ids (array), is_active",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqCreatePriceList"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully created price list with its customer categories",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPriceList"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error, duplicate code or invalid customer category",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/price-list/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Export price lists to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination. Excel file includes: Code, Name, Description, Priority, Active, Customer Categories (codes), Prices (number of prices), Update Date. The prices of a list are exported with /v1/price-list/{id}/prices/export. Requires 'api.master-data.price-list.export' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Price List"
+                ],
+                "summary": "Export price lists to Excel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search keyword (searches in code and name)",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by codes (multiple values)",
+                        "name": "codes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by names (multiple values)",
+                        "name": "names",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by customer category IDs (multiple values)",
+                        "name": "customer_category_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "boolean"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by active flag (multiple values)",
+                        "name": "is_active",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Excel file (price_lists.xlsx) with price lists data",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/price-list/prices/import/template": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download Excel template file for importing the prices of a price list. Template contains columns: item_code, uom, min_qty, price, effective_from, effective_to with example data.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Price List - Price"
+                ],
+                "summary": "Download price list price import Excel template",
+                "responses": {
+                    "200": {
+                        "description": "Excel template file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/price-list/resolve": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Return the price of a quantity of an item for a customer category on a date. Only active price lists with a price of the item unit effective on the date and a quantity break not above qty are used. A list assigned to the customer category wins over a list for every customer, lists of other categories never apply. Then the highest priority, the highest quantity break and the latest effective_from win. total_price is unit_price times qty rounded to cents. Requires 'api.master-data.price-list.view' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price List"
+                ],
+                "summary": "Resolve the price of an item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item UUID",
+                        "name": "item_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Quantity, in the unit",
+                        "name": "qty",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unit of measure (default: base unit of the item)",
+                        "name": "uom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Customer category UUID, only lists for every customer are used when empty",
+                        "name": "customer_category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date as YYYY-MM-DD (default: today)",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully resolved price",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPriceResolve"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid query parameters, item or unit not found or no price found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/price-list/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a single price list by its UUID with its customer categories. Only returns non-deleted price lists. Requires 'api.master-data.price-list.view' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price List"
+                ],
+                "summary": "Get price list by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Price list UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved price list",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPriceList"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Price list not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing price list. The code cannot be changed. The customer categories are replaced by the given ones. is_active keeps its value when omitted. Requires 'api.master-data.price-list.update' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price List"
+                ],
+                "summary": "Update price list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Price list UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated price list data. Fields: name (required), description, priority, customer_category_ids (array), is_active",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqUpdatePriceList"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated price list with its customer categories",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPriceList"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error or invalid customer category",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Price list not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete an existing price list by ID. The price list will be marked as deleted (deleted_at is set) and its prices are no longer used by the price resolution. Requires 'api.master-data.price-list.delete' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price List"
+                ],
+                "summary": "Soft delete price list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Price list UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully soft deleted price list",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Price list not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/price-list/{id}/prices": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the prices of a price list: item, unit, quantity break, price and effective period, ordered by item code, unit, quantity break and start date. Requires 'api.master-data.price-list.view' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price List - Price"
+                ],
+                "summary": "Get price list prices",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Price list UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved prices",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespPriceListPrice"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID or price list not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add the price of an item unit from a quantity break during a period. uom defaults to the base unit of the item and must be the base unit or one of the units of the item, min_qty defaults to 1. effective_to is the last day of the period, empty for an open ended price. The period must not overlap another price of the same item, unit and quantity break in the list. Requires 'api.master-data.price-list.update' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price List - Price"
+                ],
+                "summary": "Add a price to a price list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Price list UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Price data. Fields: item_id (required), uom, min_qty, price (required), effective_from (required, YYYY-MM-DD), effective_to (YYYY-MM-DD)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqCreatePriceListPrice"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully created price",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPriceListPrice"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error, item or unit not found, invalid or overlapping period",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/price-list/{id}/prices/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Export the prices of a price list to Excel file (.xlsx). Excel file includes: Item Code, UoM, Min Qty, Price, Effective From, Effective To, Item Name, Update Date. The first six columns follow the import template so the file can be imported back. Requires 'api.master-data.price-list.export' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Price List - Price"
+                ],
+                "summary": "Export price list prices to Excel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Price list UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Excel file (price_list_prices.xlsx)",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID or price list not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/price-list/{id}/prices/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upsert the prices of a price list from an Excel file (.xlsx or .xls) with columns: item_code, uom (default base unit), min_qty (default 1), price, effective_from, effective_to (empty or \"-\" for open ended). Dates are written as YYYY-MM-DD. A row with the item, unit, quantity break and effective_from of an existing price updates it, periods must not overlap. Valid rows are imported even when other rows fail, failed rows are returned with HTTP 400. Requires 'api.master-data.price-list.update' permission.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price List - Price"
+                ],
+                "summary": "Import price list prices from Excel file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Price list UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Excel file (.xlsx or .xls) with columns: item_code, uom, min_qty, price, effective_from, effective_to",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully imported all rows",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ResImportPriceListPrices"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - one or more rows failed validation. Response contains details for each row including row number, item code, status, and error message",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ResImportPriceListPrices"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/price-list/{id}/prices/{priceId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a single price of a price list. Requires 'api.master-data.price-list.view' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price List - Price"
+                ],
+                "summary": "Get a price of a price list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Price list UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Price UUID",
+                        "name": "priceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved price",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPriceListPrice"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID, price list or price not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update a price of a price list with the same rules as creation, the period must not overlap another price of the same item, unit and quantity break. Requires 'api.master-data.price-list.update' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price List - Price"
+                ],
+                "summary": "Update a price of a price list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Price list UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Price UUID",
+                        "name": "priceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Price data. Fields: item_id (required), uom, min_qty, price (required), effective_from (required, YYYY-MM-DD), effective_to (YYYY-MM-DD)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqUpdatePriceListPrice"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated price",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespPriceListPrice"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error, price not found, item or unit not found, invalid or overlapping period",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete a price of a price list. Requires 'api.master-data.price-list.update' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price List - Price"
+                ],
+                "summary": "Delete a price of a price list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Price list UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Price UUID",
+                        "name": "priceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted price",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID or price not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/province": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of provinces with optional filters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Province"
+                ],
+                "summary": "Get list of provinces with pagination",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keyword",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keyword for filtering by name",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved provinces",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.PaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespProvinceIndex"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new province with provided name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Province"
+                ],
+                "summary": "Create a new province",
+                "parameters": [
+                    {
+                        "description": "Province creation data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqCreateProvince"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully created province",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespProvince"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/province/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Export provinces to Excel file (.xlsx) with optional search and filter",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Regency - Province"
+                ],
+                "summary": "Export provinces to Excel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search keyword",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keyword for filtering by name",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Excel file with provinces data",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/province/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a single province by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Province"
+                ],
+                "summary": "Get province by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Province UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved province",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespProvince"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Province not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing province's information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Province"
+                ],
+                "summary": "Update province",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Province UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated province data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqUpdateProvince"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated province",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespProvince"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Province not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an existing province by ID. A province still used by active supplier or customer addresses cannot be deleted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Province"
+                ],
+                "summary": "Delete province",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Province UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted province",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Province not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/recycle-bin/{resource}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of soft deleted records of a resource, including who deleted them and when they will be purged",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recycle Bin"
+                ],
+                "summary": "Get list of deleted records with pagination",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resource (users, roles, groups, sub-groups, types, backings, items, price-lists, expeditions, suppliers, customers, parameters, provinces, cities, districts, subdistricts, posts)",
+                        "name": "resource",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by name or code",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by (name, code, deleted_at)",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order (asc, desc)",
+                        "name": "sort_order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved deleted records",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.PaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespTrashItem"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/recycle-bin/{resource}/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Permanently delete a soft deleted record together with its dependent rows. Refused while other data still references it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recycle Bin"
+                ],
+                "summary": "Permanently delete a deleted record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resource",
+                        "name": "resource",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Record UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted record permanently",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID or still referenced",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/recycle-bin/{resource}/{id}/restore": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Restore a soft deleted record. Refused when an active record already uses the same unique values or when the record it belongs to is still deleted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recycle Bin"
+                ],
+                "summary": "Restore a deleted record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resource",
+                        "name": "resource",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Record UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully restored record",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID, conflict or parent deleted",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/regency/boundaries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the boundaries of every region of a level as a GeoJSON FeatureCollection, optionally only the children of a region",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Geo"
+                ],
+                "summary": "Get the boundaries of a level",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "province, city, district or subdistrict",
+                        "name": "level",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the children of this region",
+                        "name": "parent_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved boundaries",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.GeoJSONFeatureCollection"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/regency/boundaries/{level}/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the boundary of a region as a GeoJSON Feature (MultiPolygon) with the region id, level, code, name and path as properties",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Geo"
+                ],
+                "summary": "Get the boundary of a region",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "province, city, district or subdistrict",
+                        "name": "level",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Region UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved boundary",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.GeoJSONFeature"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - boundary not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create or replace the GeoJSON boundary of a province, city, district or subdistrict. The body is a Polygon / MultiPolygon geometry, a Feature holding one, or a FeatureCollection whose polygons are merged. Positions are [longitude, latitude]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Geo"
+                ],
+                "summary": "Upload the boundary of a region",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "province, city, district or subdistrict",
+                        "name": "level",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Region UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "GeoJSON boundary",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqRegencyBoundary"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully saved boundary",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.GeoJSONFeature"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid GeoJSON or unknown region",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the stored GeoJSON boundary of a region, the region itself is kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Geo"
+                ],
+                "summary": "Delete the boundary of a region",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "province, city, district or subdistrict",
+                        "name": "level",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Region UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted boundary",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - boundary not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/regency/code/{code}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a province, city, district or subdistrict by its official Kemendagri / BPS code (e.g. 32.73.01.1001). The level is derived from the code",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Tree"
+                ],
+                "summary": "Get region by official code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Official region code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved region",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespRegencySearchResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid code",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Region not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/regency/geo/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Bulk update postal codes and centroids from a file (.csv, .xlsx or .xls) with columns: code, postal_codes, latitude, longitude. Several postal codes of a subdistrict are separated by comma, semicolon or space and replace the stored ones. Regions are matched by official code (district or subdistrict), empty cells are left untouched. Nothing is written when a row is invalid or when dry_run is true.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Geo"
+                ],
+                "summary": "Import postal codes and coordinates",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "File (.csv, .xlsx or .xls) with columns: code, postal_codes, latitude, longitude",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only validate the file",
+                        "name": "dry_run",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully imported postal codes and coordinates",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespRegencyGeoImport"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - one or more rows are invalid, the report contains the row errors",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespRegencyGeoImport"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/regency/geo/import/template": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download Excel template file for importing postal codes and coordinates. Template contains columns: code, postal_codes, latitude, longitude.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Regency - Geo"
+                ],
+                "summary": "Download postal code and coordinates import template",
+                "responses": {
+                    "200": {
+                        "description": "Excel template file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/regency/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upsert the whole province \u003e city \u003e district \u003e subdistrict hierarchy from an official dataset (.csv, .xlsx or .xls) with columns: code, name. Regions are matched by code, regions without code are linked by name, coded regions missing from the dataset are removed. The report lists added, renamed, linked and removed regions. Nothing is written when a row is invalid or when dry_run is true.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Tree"
+                ],
+                "summary": "Import official regency dataset",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Dataset file (.csv, .xlsx or .xls) with columns: code, name",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only report the changes",
+                        "name": "dry_run",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully imported dataset",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespRegencyImport"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - one or more rows are invalid, the report contains the row errors",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespRegencyImport"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/regency/import/template": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download Excel template file for importing the official regency dataset. Template contains columns: code, name with one example row per level.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Regency - Tree"
+                ],
+                "summary": "Download regency import Excel template",
+                "responses": {
+                    "200": {
+                        "description": "Excel template file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/regency/locate": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Find the deepest region whose boundary contains the coordinate and return it with its chain up to the province. When only the district boundary matches, the subdistrict is picked by the nearest centroid inside that district",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Geo"
+                ],
+                "summary": "Resolve a coordinate to its region",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Latitude (-90 to 90)",
+                        "name": "latitude",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude (-180 to 180)",
+                        "name": "longitude",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully resolved region",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespRegencyLocate"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid coordinate or outside every boundary",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/regency/nearest": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Return the subdistricts (or districts) whose centroid is the nearest to the coordinate, nearest first, with their full hierarchy and distance in meters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Geo"
+                ],
+                "summary": "Find the nearest region to a coordinate",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Latitude (-90 to 90)",
+                        "name": "latitude",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude (-180 to 180)",
+                        "name": "longitude",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "district or subdistrict (default subdistrict)",
+                        "name": "level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of regions returned (default 1, max 20)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Search radius in km (default 25, max 200)",
+                        "name": "radius_km",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved nearest regions",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespRegencyGeo"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid coordinate or nothing within the radius",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/regency/postal-code/{postal_code}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Return every subdistrict served by the postal code with its full hierarchy (district, city, province)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Geo"
+                ],
+                "summary": "Get regions by postal code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "5 digit postal code",
+                        "name": "postal_code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved regions",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespRegencyGeo"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid or unknown postal code",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/regency/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Search provinces, cities, districts and subdistricts at once. Every match comes with its full ancestor path",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Tree"
+                ],
+                "summary": "Search across all regency levels",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search keyword (min 2 characters)",
+                        "name": "search",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Restrict to a level (province, city, district, subdistrict)",
+                        "name": "level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of matches (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully searched regencies",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespRegencySearchResult"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/regency/tree": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve every province with its nested cities, districts and subdistricts. Responds 304 when If-None-Match matches the current ETag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Tree"
+                ],
+                "summary": "Get the full regency tree",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ETag of the tree already held by the client",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved regency tree",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespRegencyTreeNode"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "304": {
+                        "description": "Tree not modified"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/regency/tree/children": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the nodes of a level, filtered by their parent. Provinces have no parent, every other level requires parent_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Tree"
+                ],
+                "summary": "Get one level of the regency tree",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Level of the nodes (province, city, district, subdistrict)",
+                        "name": "level",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Parent UUID, required unless level is province",
+                        "name": "parent_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved regency nodes",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespRegencyTreeNode"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/role-management/role": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of roles with optional filtering",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Role Management"
+                ],
+                "summary": "Get paginated list of roles",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort column",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order (asc/desc)",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved roles",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.PaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespRoleIndex"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new role with provided information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Role Management"
+                ],
+                "summary": "Create a new role",
+                "parameters": [
+                    {
+                        "description": "Role creation data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqCreateRole"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully created role",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespRole"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/role-management/role/all": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve all roles without pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Role Management"
+                ],
+                "summary": "Get all roles",
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved all roles",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespRole"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/role-management/role/check-name": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Check if a role name already exists in the database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Role Management"
+                ],
+                "summary": "Check if role name is duplicated",
+                "parameters": [
+                    {
+                        "description": "Check duplicated role request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqCheckDuplicatedRole"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role with such name is not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Role with such name exists",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespRole"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/role-management/role/module-access": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve all permission groups organized by module. Returns permission groups with a value field indicating if the permission group is assigned to the specified role. The role_id query parameter is optional.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Role Management"
+                ],
+                "summary": "Get all permission groups grouped by module",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Optional role ID to check permission group assignment",
+                        "name": "role_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved permission groups by module",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespPermissionGroupByModule"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error or role not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/role-management/role/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a specific role by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Role Management"
+                ],
+                "summary": "Get role by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Role UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved role",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespRoleDetail"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Role not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing role's information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Role Management"
+                ],
+                "summary": "Update role",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Role UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated role data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqUpdateRole"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated role",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespRole"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Role not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete a role by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Role Management"
+                ],
+                "summary": "Delete role (soft delete)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Role UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted role",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespRole"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Role not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sub-group": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of sub-groups with optional search and filters. Supports multiple filter values for subgroup_code, name, and groups_id. Subgroup codes are in 2-digit string format (e.g., \"01\", \"02\", \"05\", \"10\"). Only returns non-deleted sub-groups. Supports sorting by DTO field names (e.g., subgroup_code, name, groups_name, created_at, updated_at).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sub Golongan"
+                ],
+                "summary": "Get list of sub-groups with pagination",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default: 10)",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by field (e.g., subgroup_code, name, groups_name, created_at, updated_at)",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order (ASC or DESC, default: DESC)",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keyword (searches in subgroup_code and name)",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by subgroup codes (multiple values, format: ",
+                        "name": "subgroup_codes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by names (multiple values)",
+                        "name": "names",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by goods group IDs (multiple values, UUIDs)",
+                        "name": "groups_ids",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved sub-groups",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.PaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespSubGroupIndex"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new sub-group with provided groups_id and name. Name must be unique within the same groups. Subgroup code is automatically generated by the database trigger with 2-digit format (e.g., \"01\", \"02\", \"05\", \"10\"). The code is generated sequentially based on the sequence.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sub Golongan"
+                ],
+                "summary": "Create a new sub-group",
+                "parameters": [
+                    {
+                        "description": "Sub-group creation data. Fields: groups_id (required, UUID), name (required, max 255 chars, uppercase letters only)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqCreateSubGroup"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully created sub-group with auto-generated subgroup_code in 2-digit format",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespSubGroup"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error or duplicate name in groups",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sub-group/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Export sub-groups to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination. Supports multiple filter values for each field. Only exports non-deleted sub-groups. Excel file includes: Kode Sub Golongan (2-digit format like \"01\", \"02\"), Nama Sub Golongan, Nama Golongan, Updated Date. Requires 'sub-group.export' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Sub Golongan"
+                ],
+                "summary": "Export sub-groups to Excel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search keyword (searches in subgroup_code and name)",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by subgroup codes (multiple values, format: ",
+                        "name": "subgroup_codes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by names (multiple values)",
+                        "name": "names",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by goods group IDs (multiple values, UUIDs)",
+                        "name": "groups_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by field (e.g., subgroup_code, name, groups_name, created_at, updated_at)",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order (ASC or DESC)",
+                        "name": "sort_order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Excel file (sub-groups.xlsx) with sub-groups data including subgroup_code in 2-digit format",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sub-group/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a single sub-group by its UUID. The response includes subgroup_code in 2-digit string format (e.g., \"01\", \"02\", \"05\", \"10\") and groups_name to identify which goods group this sub-group belongs to. Only returns non-deleted sub-groups.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sub Golongan"
+                ],
+                "summary": "Get sub-group by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sub-group UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved sub-group with subgroup_code in 2-digit format and groups_name",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespSubGroup"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Sub-group not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing sub-group's information. Name must be unique within the same groups. Subgroup code cannot be updated as it is auto-generated. The response includes the current subgroup_code in 2-digit format.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sub Golongan"
+                ],
+                "summary": "Update sub-group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sub-group UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated sub-group data. Fields: groups_id (required, UUID), name (required, max 255 chars, uppercase letters only). Note: subgroup_code is read-only and cannot be updated.",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqUpdateSubGroup"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated sub-group with subgroup_code in 2-digit format",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespSubGroup"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error or duplicate name in groups",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Sub-group not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete an existing sub-group by ID. The sub-group will be marked as deleted (deleted_at is set) but remains in the database. Requires 'api.master-data.sub-group.delete' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sub Golongan"
+                ],
+                "summary": "Soft delete sub-group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sub-group UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully soft deleted sub-group",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Sub-group not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sub-group/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move all active types of a duplicate sub-group into the target sub-group and soft delete the duplicate, in one transaction. Fails when the target already has types with the same names. When regenerate_codes is true the moved types and their backings get new codes. The operation is recorded in the taxonomy audit log.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sub Golongan"
+                ],
+                "summary": "Merge sub-group into another sub-group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Duplicate (source) sub-group UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target sub-group. Fields: target_id (required, UUID), regenerate_codes (optional)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqMergeSubGroup"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully merged, returns the target sub-group",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespSubGroup"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error, merge into itself or conflicting type names",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sub-group/{id}/move": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Re-parent a sub-group and its types to another group in one transaction. Name must be unique within the target group. When regenerate_codes is true the sub-group, its types and their backings get new codes. The operation is recorded in the taxonomy audit log.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sub Golongan"
+                ],
+                "summary": "Move sub-group to another group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sub-group UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target group. Fields: groups_id (required, UUID), regenerate_codes (optional)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqMoveSubGroup"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully moved sub-group",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespSubGroup"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error, same group or duplicate name in target group",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/subdistrict": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of subdistricts with optional filters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Subdistrict"
+                ],
+                "summary": "Get list of subdistricts with pagination",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keyword",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by district_id",
+                        "name": "district_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by multiple names (WHERE IN)",
+                        "name": "names",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keyword for filtering by name",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved subdistricts",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.PaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespSubdistrictIndex"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new subdistrict with provided district_id and name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Subdistrict"
+                ],
+                "summary": "Create a new subdistrict",
+                "parameters": [
+                    {
+                        "description": "Subdistrict creation data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqCreateSubdistrict"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully created subdistrict",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespSubdistrict"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/subdistrict/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Export subdistricts to Excel file (.xlsx) with optional search and filter",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Regency - Subdistrict"
+                ],
+                "summary": "Export subdistricts to Excel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search keyword",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by district_id",
+                        "name": "district_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by multiple names (WHERE IN)",
+                        "name": "names",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keyword for filtering by name",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Excel file with subdistricts data",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/subdistrict/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a single subdistrict by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Subdistrict"
+                ],
+                "summary": "Get subdistrict by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subdistrict UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved subdistrict",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespSubdistrict"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Subdistrict not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing subdistrict's information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Subdistrict"
+                ],
+                "summary": "Update subdistrict",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subdistrict UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated subdistrict data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqUpdateSubdistrict"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated subdistrict",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespSubdistrict"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Subdistrict not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an existing subdistrict by ID. A subdistrict still used by active supplier or customer addresses cannot be deleted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regency - Subdistrict"
+                ],
+                "summary": "Delete subdistrict",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subdistrict UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted subdistrict",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Subdistrict not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/supplier": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of suppliers with optional search and filters. Supports multiple filter values for each field. Only returns non-deleted suppliers. Requires 'api.master-data.supplier.view' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Supplier"
+                ],
+                "summary": "Get list of suppliers with pagination",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default: 10)",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort column (allowed: id, supplier_code, supplier_name, address, city_name, expedition_arrives_name, phone_number, telp_number, created_at, updated_at)",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order: asc or desc (default: desc)",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keyword (searches in supplier_name, supplier_code, address, city name, expedition name and phone numbers from contacts)",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by supplier codes (multiple values)",
+                        "name": "supplier_codes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by supplier names (multiple values)",
+                        "name": "supplier_names",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by province IDs (multiple values)",
+                        "name": "province_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by city IDs (multiple values)",
+                        "name": "city_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by default expedition IDs (multiple values)",
+                        "name": "expedition_arrives_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by addresses (multiple values)",
+                        "name": "addresses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by telp numbers (multiple values)",
+                        "name": "telp_numbers",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by phone numbers (multiple values)",
+                        "name": "phone_numbers",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved suppliers",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.PaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespSupplierIndex"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new supplier with provided information. Accepts JSON body with telp_numbers (array of objects where area_code optional and phone_number required, contoh: [{\u0026quot;area_code\u0026quot;:\u0026quot;022\u0026quot;,\u0026quot;phone_number\u0026quot;:\u0026quot;1112223355\u0026quot;}, {\u0026quot;area_code\u0026quot;:\u0026quot;022\u0026quot;,\u0026quot;phone_number\u0026quot;:\u0026quot;1112223366\u0026quot;}]) and phone_numbers arrays. First index of telp_numbers array will automatically become primary telp, and first index of phone_numbers array will automatically become primary hp. Address regions must follow the province \u003e city \u003e district \u003e subdistrict hierarchy and expedition_arrives_id is the default expedition for goods from this supplier. Supplier code is automatically generated by the system. Requires 'api.master-data.supplier.create' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Supplier"
+                ],
+                "summary": "Create a new supplier",
+                "parameters": [
+                    {
+                        "description": "Supplier creation data. Fields: supplier_name (required), address (max 255 chars), province_id, city_id, district_id, subdistrict_id, expedition_arrives_id (optional), telp_numbers (array, first index becomes primary telp), phone_numbers (array, first index becomes primary hp), notes (optional)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqCreateSupplier"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully created supplier with full details including contacts",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespSupplier"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error, duplicate supplier name or invalid address region/expedition",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/supplier/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Export suppliers to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination. Supports multiple filter values for each field. Only exports non-deleted suppliers. Excel file includes: Kode Supplier, Nama Supplier, Alamat, Provinsi, Kota, Kecamatan, Kelurahan, Ekspedisi, No HP, No Telp, Update Date. Requires 'api.master-data.supplier.export' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Supplier"
+                ],
+                "summary": "Export suppliers to Excel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search keyword (searches in supplier_name, supplier_code, address, city name, expedition name and phone numbers from contacts)",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by supplier codes (multiple values)",
+                        "name": "supplier_codes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by supplier names (multiple values)",
+                        "name": "supplier_names",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by province IDs (multiple values)",
+                        "name": "province_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by city IDs (multiple values)",
+                        "name": "city_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by default expedition IDs (multiple values)",
+                        "name": "expedition_arrives_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by addresses (multiple values)",
+                        "name": "addresses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by telp numbers (multiple values)",
+                        "name": "telp_numbers",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by phone numbers (multiple values)",
+                        "name": "phone_numbers",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Excel file (suppliers.xlsx) with suppliers data",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/supplier/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a single supplier by its UUID. The response includes full supplier details with all contacts (telp_numbers and phone_numbers arrays). Only returns non-deleted suppliers. Requires 'api.master-data.supplier.view' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Supplier"
+                ],
+                "summary": "Get supplier by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Supplier UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved supplier with full details including contacts",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespSupplier"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Supplier not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing supplier's information. Accepts JSON body with telp_numbers (array of objects where area_code optional and phone_number required, contoh: [{\u0026quot;area_code\u0026quot;:\u0026quot;022\u0026quot;,\u0026quot;phone_number\u0026quot;:\u0026quot;1112223355\u0026quot;}, {\u0026quot;area_code\u0026quot;:\u0026quot;022\u0026quot;,\u0026quot;phone_number\u0026quot;:\u0026quot;1112223366\u0026quot;}]) and phone_numbers arrays. First index of telp_numbers array will automatically become primary telp, and first index of phone_numbers array will automatically become primary hp. Address regions must follow the province \u003e city \u003e district \u003e subdistrict hierarchy. Existing contacts will be hard deleted before new ones are created. The response includes full supplier details with all contacts. Requires 'api.master-data.supplier.update' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Supplier"
+                ],
+                "summary": "Update supplier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Supplier UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated supplier data. Fields: supplier_name (required), address (max 255 chars), province_id, city_id, district_id, subdistrict_id, expedition_arrives_id (optional), telp_numbers (array, first index becomes primary telp), phone_numbers (array, first index becomes primary hp), notes (optional)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqUpdateSupplier"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated supplier with full details including contacts",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespSupplier"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error, duplicate supplier name or invalid address region/expedition",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Supplier not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete an existing supplier by ID. The supplier will be marked as deleted (deleted_at is set) but remains in the database. Requires 'api.master-data.supplier.delete' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Supplier"
+                ],
+                "summary": "Soft delete supplier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Supplier UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully soft deleted supplier",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Supplier not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/translation": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of translations with the code and base value of the translated entity and optional filters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Get list of translations with pagination",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keyword on the value, the base value and the entity code",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by entity types (array)",
+                        "name": "entity_types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by entity IDs (array)",
+                        "name": "entity_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by fields (array)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by locales (array)",
+                        "name": "locales",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved translations",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.PaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespTranslation"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create the translation of a field of a master data entity in a locale. The entity type must be one of parameter, group, sub_group, type, province, city, district, subdistrict, the field must be name and the locale must be a supported non-base locale (en). Index and detail responses of the entity show the translation when requested with a matching Accept-Language header.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Create a new translation",
+                "parameters": [
+                    {
+                        "description": "Translation creation data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqCreateTranslation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully created translation",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespTranslation"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/translation/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Export translations to Excel file (.xlsx) with optional search and filter. The file can be edited and imported back, the Base Value column is informative only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Export translations to Excel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search keyword",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by entity types (array)",
+                        "name": "entity_types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by locales (array)",
+                        "name": "locales",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Excel file with translations data",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/translation/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create or update (by entity, field and locale) translations from an Excel file (.xlsx or .xls) with columns: entity_type, entity_id, entity_code, field, locale, value. The entity is found by entity_id when given, otherwise by entity_code. A file exported from /v1/translation/export can be imported as is. Valid rows are imported even when other rows fail, failed rows are returned with HTTP 400. Requires 'translation.create' permission.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Import translations from Excel file",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Excel file (.xlsx or .xls) with columns: entity_type, entity_id, entity_code, field, locale, value",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully imported all translations",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ResImportTranslations"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - one or more rows failed validation. Response contains details for each row including row number, entity type, entity code, status, and error message",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ResImportTranslations"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/translation/import/template": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download Excel template file for importing translations. Template contains columns: entity_type, entity_id, entity_code, field, locale, value with example data.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Download translation import Excel template",
+                "responses": {
+                    "200": {
+                        "description": "Excel template file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/translation/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a single translation by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Get translation by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Translation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved translation",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespTranslation"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Translation not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the value of an existing translation. The entity, field and locale of a translation cannot be changed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Update translation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Translation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated translation value",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqUpdateTranslation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated translation",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespTranslation"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Translation not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an existing translation by ID, the entity falls back to its base value in that locale",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Delete translation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Translation UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted translation",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Translation not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/type": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of types with optional search and filters. Supports multiple filter values for type_code, subgroup_id, and name. Only returns non-deleted types. Requires 'api.master-data.type.view' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jenis"
+                ],
+                "summary": "Get list of types with pagination",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default: 10)",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort column (allowed: id, subgroup_id, type_code, name, created_at, updated_at)",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order: asc or desc (default: desc)",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keyword (searches in type_code and name)",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by type codes (multiple values)",
+                        "name": "type_codes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by subgroup IDs (multiple values, UUIDs)",
+                        "name": "subgroup_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by group IDs (multiple values, UUIDs)",
+                        "name": "group_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by names (multiple values)",
+                        "name": "names",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.PaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespTypeIndex"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new type with provided data. Type code is automatically generated by the system. Requires 'api.master-data.type.create' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jenis"
+                ],
+                "summary": "Create a new type",
+                "parameters": [
+                    {
+                        "description": "Type creation data. Fields: subgroup_id (required, UUID), name (required, max 255 chars)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqCreateType"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully created type with auto-generated type_code",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespType"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error or duplicate name in subgroup",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/type/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Export types to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination. Supports multiple filter values for type_code, subgroup_id, and name. Requires 'api.master-data.type.export' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Jenis"
+                ],
+                "summary": "Export types to Excel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search keyword (searches in type_code and name)",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by type codes (multiple values)",
+                        "name": "type_codes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by subgroup IDs (multiple values, UUIDs)",
+                        "name": "subgroup_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by names (multiple values)",
+                        "name": "names",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Excel file (types.xlsx) with types data",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/type/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a single type by its UUID. The response includes subgroup_name to identify which sub-group this type belongs to. Only returns non-deleted types. Requires 'api.master-data.type.view' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jenis"
+                ],
+                "summary": "Get type by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Type UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved type with subgroup_name",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespType"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Type not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing type's information. Type code cannot be updated. Requires 'api.master-data.type.update' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jenis"
+                ],
+                "summary": "Update type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Type UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated type data. Fields: subgroup_id (required, UUID), name (required, max 255 chars)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqUpdateType"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated type",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespType"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error or duplicate name in subgroup",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Type not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete an existing type by ID. The type will be marked as deleted (deleted_at is set) but remains in the database. Requires 'api.master-data.type.delete' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jenis"
+                ],
+                "summary": "Soft delete type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Type UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully soft deleted type",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Type not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/type/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move all active backings of a duplicate type into the target type and soft delete the duplicate, in one transaction. Fails when the target already has backings with the same names. When regenerate_codes is true the moved backings get new codes. The operation is recorded in the taxonomy audit log.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jenis"
+                ],
+                "summary": "Merge type into another type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Duplicate (source) type UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target type. Fields: target_id (required, UUID), regenerate_codes (optional)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqMergeType"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully merged, returns the target type",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespType"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error, merge into itself or conflicting backing names",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/type/{id}/move": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Re-parent a type and its backings to another sub-group in one transaction. Name must be unique within the target sub-group. When regenerate_codes is true the type and its backings get new codes. The operation is recorded in the taxonomy audit log.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jenis"
+                ],
+                "summary": "Move type to another sub-group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Type UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target sub-group. Fields: subgroup_id (required, UUID), regenerate_codes (optional)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqMoveType"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully moved type",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespType"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error, same sub-group or duplicate name in target sub-group",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/register": {
+            "post": {
+                "description": "Register a new user without authentication",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Register a new user (Public)",
+                "parameters": [
+                    {
+                        "description": "User registration data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqRegisterUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully registered user",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespUser"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of users with optional filtering",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Get paginated list of users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort column",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order (asc/desc)",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "role_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "role_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "sort_order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved users",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.PaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespUserIndex"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new user with provided information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Create a new user",
+                "parameters": [
+                    {
+                        "description": "User creation data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqCreateUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully created user",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespUser"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/all": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve all users without pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Get all users",
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved all users",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespUser"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/bulk/assign-role": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Assign the same role to many users. The requester's own account and non deletable users are skipped. Each user is processed independently and the response reports the outcome per user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Bulk assign role",
+                "parameters": [
+                    {
+                        "description": "Bulk assign role request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqBulkAssignRole"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bulk operation report",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespBulkUserOperation"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/bulk/assign-status": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Activate or deactivate many users with a mandatory reason. Same side effects as the single assign-status endpoint. Deactivating skips the requester's own account and non deletable users. Each user is processed independently and the response reports the outcome per user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Bulk activate or deactivate users",
+                "parameters": [
+                    {
+                        "description": "Bulk activate request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqBulkActivateUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bulk operation report",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespBulkUserOperation"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/bulk/block": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Block or unblock many users with a mandatory reason, optionally until unblock_at. Same side effects as the single block endpoint: tokens revoked, status history recorded and email sent. Blocking skips the requester's own account and non deletable users. Each user is processed independently and the response reports the outcome per user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Bulk block or unblock users",
+                "parameters": [
+                    {
+                        "description": "Bulk block request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqBulkBlockUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bulk operation report",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespBulkUserOperation"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/bulk/delete": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete many users. Non deletable users and the requester's own account are skipped. Each user is processed independently and the response reports the outcome per user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Bulk soft delete users",
+                "parameters": [
+                    {
+                        "description": "Bulk user ids",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqBulkUserIds"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bulk operation report",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespBulkUserOperation"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/bulk/force-password-reset": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Require many users to change their password on the next login, revoke their tokens and notify them by email. Each user is processed independently and the response reports the outcome per user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Bulk force password reset",
+                "parameters": [
+                    {
+                        "description": "Bulk user ids",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqBulkUserIds"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bulk operation report",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespBulkUserOperation"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/check-email": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Check if a user email already exists in the database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Check if email is duplicated",
+                "parameters": [
+                    {
+                        "description": "Check duplicated email request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqCheckDuplicatedEmail"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User with such email is not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/check-name": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Check if a user name already exists in the database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Check if user name is duplicated",
+                "parameters": [
+                    {
+                        "description": "Check duplicated user request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqCheckDuplicatedUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User with such name is not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/data-requests/{request_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the status of a single export / erasure request. Completed exports include a presigned download URL.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Get a personal data request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Data request UUID",
+                        "name": "request_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved data request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespUserDataRequest"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data request not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Import multiple users from an Excel file (.xlsx or .xls). The Excel file must have columns: email, full_name, username, nik, role_name. Validates for duplicate email, username, and NIK. Returns HTTP 400 if any row fails with detailed error information per row.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Import users from Excel file",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Excel file (.xlsx or .xls) with columns: email, full_name, username, nik, role_name",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully imported all users",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ResImportUsers"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - one or more rows failed validation. Response contains details for each row including row number, username, status, and error message",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ResImportUsers"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/import/template": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download Excel template file for importing users. Template contains columns: email, full_name, username, nik, role_name with example data.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Download user import Excel template",
+                "responses": {
+                    "200": {
+                        "description": "Excel template file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/lifecycle/report": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List accounts that will be blocked or deactivated within the next N days, either by scheduled deactivation or by the dormant-account policy, ordered by due date.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Report of accounts affected by lifecycle policy",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "description": "Look-ahead window in days",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved report",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespUserLifecycleReport"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid days",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/password-confirmation": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Verify the current authenticated user's password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Confirm current user password",
+                "parameters": [
+                    {
+                        "description": "Password confirmation data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqConfirmationUserPassword"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Password confirmed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespUser"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid password",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a specific user by their ID, including the history of block / unblock / activate / deactivate changes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Get user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved user",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespUserDetail"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing user's information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Update user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated user data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqUpdateUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated user",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespUser"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete a user account by setting deleted_at timestamp. The user will be marked as deleted but remain in the database. Requires 'api.user-management.user.delete' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Soft delete a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully soft deleted user",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespUser"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID or user not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/{id}/assign-status": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Activate or deactivate a user account with a mandatory reason and revoke all their tokens. The change is recorded in the user status history and the user is notified by email.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Activate a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Activate user request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqActivateUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully activated user",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespUserDetail"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/{id}/block": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Block or unblock a user account with a mandatory reason and revoke all their tokens. When blocking, unblock_at optionally sets the time the user is unblocked automatically. The change is recorded in the user status history and the user is notified by email.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Block a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Block user request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqBlockUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully blocked user",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespUserDetail"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error or unblock_at in the past",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/{id}/data-erasure": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Queue a background job that revokes sessions, removes uploaded files and anonymizes the user's name, email, NIK and username. The user row is kept so created_by / updated_by references stay valid. Requires 'user.data-erasure' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Request personal data erasure of a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Erasure request queued",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespUserDataRequest"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID, user not found, user not deletable or request already queued",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/{id}/data-export": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Queue a background job that packages the user's profile, password history metadata, sessions, uploaded files and authored posts into a ZIP archive in storage. Requires 'user.data-export' permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Request personal data export of a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Export request queued",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespUserDataRequest"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID, user not found or request already queued",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/{id}/data-requests": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the export / erasure request history of a user, newest first. Completed exports include a presigned download URL.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "List personal data requests of a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved data requests",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.RespUserDataRequest"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/{id}/deactivate-at": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set the time a user will be deactivated automatically (e.g. last working day). Send null to clear the schedule. The user receives a warning email before the deactivation.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Schedule user deactivation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Deactivation schedule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqScheduleUserDeactivation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully scheduled deactivation",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespUserDetail"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID, user not found or deactivate_at in the past",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/{id}/force-password-reset": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Require a user to change the password on the next login, revoke all their tokens and notify them by email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Force user password reset",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully forced password reset",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespUserDetail"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid UUID or user not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user-management/user/{id}/password": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update a user's password by their ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Update user password",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Password update data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReqUpdateUserPassword"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated password",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.NonPaginationResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.RespUser"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.NonPaginationResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.CustomerAddressItem": {
+            "type": "object",
+            "required": [
+                "address",
+                "address_type"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "address_type": {
+                    "type": "string",
+                    "enum": [
+                        "billing",
+                        "shipping"
+                    ]
+                },
+                "city_id": {
+                    "type": "string"
+                },
+                "district_id": {
+                    "type": "string"
+                },
+                "postal_code": {
+                    "type": "string",
+                    "maxLength": 10
+                },
+                "province_id": {
+                    "type": "string"
+                },
+                "subdistrict_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GeoJSONFeature": {
+            "type": "object",
+            "properties": {
+                "geometry": {
+                    "type": "object"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "properties": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GeoJSONFeatureCollection": {
+            "type": "object",
+            "properties": {
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GeoJSONFeature"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ItemBarcodeItem": {
+            "type": "object",
+            "required": [
+                "barcode"
+            ],
+            "properties": {
+                "barcode": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "uom": {
+                    "description": "empty for the base unit",
+                    "type": "string",
+                    "maxLength": 20
+                }
+            }
+        },
+        "dto.ItemUnitItem": {
+            "type": "object",
+            "required": [
+                "conversion",
+                "uom"
+            ],
+            "properties": {
+                "conversion": {
+                    "description": "number of base units in one unit",
+                    "type": "number"
+                },
+                "uom": {
+                    "type": "string",
+                    "maxLength": 20
+                }
+            }
+        },
+        "dto.ReferenceObject": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqActivateUser": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "is_active": {
+                    "type": "boolean"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "dto.ReqAuthUser": {
+            "type": "object",
+            "required": [
+                "login",
+                "password"
+            ],
+            "properties": {
+                "login": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqBlockUser": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "is_block": {
+                    "type": "boolean"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "unblock_at": {
+                    "description": "optional, only when blocking: the user is unblocked automatically at this time",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqBulkActivateUser": {
+            "type": "object",
+            "required": [
+                "reason",
+                "user_ids"
+            ],
+            "properties": {
+                "is_active": {
+                    "type": "boolean"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "user_ids": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.ReqBulkAssignRole": {
+            "type": "object",
+            "required": [
+                "role_id",
+                "user_ids"
+            ],
+            "properties": {
+                "role_id": {
+                    "type": "string"
+                },
+                "user_ids": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.ReqBulkBlockUser": {
+            "type": "object",
+            "required": [
+                "reason",
+                "user_ids"
+            ],
+            "properties": {
+                "is_block": {
+                    "type": "boolean"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "unblock_at": {
+                    "description": "optional, only when blocking: the user is unblocked automatically at this time",
+                    "type": "string"
+                },
+                "user_ids": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.ReqBulkUserIds": {
+            "type": "object",
+            "required": [
+                "user_ids"
+            ],
+            "properties": {
+                "user_ids": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.ReqCheckDuplicatedEmail": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "excluded_user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqCheckDuplicatedRole": {
+            "type": "object",
+            "required": [
+                "role_name"
+            ],
+            "properties": {
+                "excluded_role_info_id": {
+                    "type": "string"
+                },
+                "role_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqCheckDuplicatedUser": {
+            "type": "object",
+            "required": [
+                "username"
+            ],
+            "properties": {
+                "excluded_user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqConfirmationUserPassword": {
+            "type": "object",
+            "required": [
+                "password"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqCreateBacking": {
+            "type": "object",
+            "required": [
+                "name",
+                "type_id"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "type_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqCreateCity": {
+            "type": "object",
+            "required": [
+                "name",
+                "province_id"
+            ],
+            "properties": {
+                "area_code": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "code": {
+                    "description": "Official Kemendagri / BPS region code",
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "province_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqCreateCustomer": {
+            "type": "object",
+            "required": [
+                "customer_name"
+            ],
+            "properties": {
+                "addresses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CustomerAddressItem"
+                    }
+                },
+                "credit_limit": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "customer_category_id": {
+                    "type": "string"
+                },
+                "customer_name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "expedition_send_id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "payment_term_days": {
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": 0
+                },
+                "phone_numbers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "telp_numbers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rendyfutsuy_base-go_modules_customer_dto.TelpNumberItem"
+                    }
+                }
+            }
+        },
+        "dto.ReqCreateDistrict": {
+            "type": "object",
+            "required": [
+                "city_id",
+                "name"
+            ],
+            "properties": {
+                "city_id": {
+                    "type": "string"
+                },
+                "code": {
+                    "description": "Official Kemendagri / BPS region code",
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "latitude": {
+                    "description": "Centroid latitude, sent together with longitude",
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "longitude": {
+                    "description": "Centroid longitude, sent together with latitude",
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.ReqCreateExpedition": {
+            "type": "object",
+            "required": [
+                "expedition_name"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "expedition_name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "phone_numbers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "telp_numbers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rendyfutsuy_base-go_modules_expedition_dto.TelpNumberItem"
+                    }
+                }
+            }
+        },
+        "dto.ReqCreateExpeditionContact": {
+            "type": "object",
+            "required": [
+                "phone_number",
+                "phone_type"
+            ],
+            "properties": {
+                "area_code": {
+                    "description": "Only combined with local numbers, e.g. \"021\" + \"555\"",
+                    "type": "string",
+                    "maxLength": 10
+                },
+                "contact_name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "is_primary": {
+                    "type": "boolean"
+                },
+                "phone_number": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "phone_type": {
+                    "type": "string",
+                    "enum": [
+                        "telp",
+                        "hp"
+                    ]
+                }
+            }
+        },
+        "dto.ReqCreateExpeditionCoverage": {
+            "type": "object",
+            "required": [
+                "city_id"
+            ],
+            "properties": {
+                "city_id": {
+                    "type": "string"
+                },
+                "district_id": {
+                    "description": "Empty means the whole city is served",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqCreateExpeditionTariff": {
+            "type": "object",
+            "required": [
+                "destination_city_id",
+                "origin_city_id"
+            ],
+            "properties": {
+                "destination_city_id": {
+                    "type": "string"
+                },
+                "lead_time_days": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "min_weight": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "origin_city_id": {
+                    "type": "string"
+                },
+                "price_per_kg": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.ReqCreateGroup": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.ReqCreateItem": {
+            "type": "object",
+            "required": [
+                "backing_id",
+                "name"
+            ],
+            "properties": {
+                "backing_id": {
+                    "type": "string"
+                },
+                "barcodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ItemBarcodeItem"
+                    }
+                },
+                "base_uom": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "height": {
+                    "type": "number"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "item_code": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "length": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "units": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ItemUnitItem"
+                    }
+                },
+                "weight": {
+                    "type": "number"
+                },
+                "width": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.ReqCreateParameter": {
+            "type": "object",
+            "required": [
+                "code",
+                "name"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "desc": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqCreateParameterType": {
+            "type": "object",
+            "required": [
+                "code",
+                "name",
+                "value_kind"
+            ],
+            "properties": {
+                "allowed_parent_types": {
+                    "description": "empty when the parameters have no parent",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "code": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "description": {
+                    "type": "string"
+                },
+                "enum_values": {
+                    "description": "required for value_kind enum",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "json_schema": {
+                    "description": "optional JSON Schema the typed value must match",
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "value_kind": {
+                    "type": "string",
+                    "enum": [
+                        "string",
+                        "int",
+                        "decimal",
+                        "bool",
+                        "date",
+                        "enum",
+                        "json"
+                    ]
+                },
+                "value_required": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.ReqCreatePost": {
+            "type": "object",
+            "required": [
+                "description",
+                "short_description",
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "lang_id": {
+                    "type": "string"
+                },
+                "short_description": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "thumbnailURL": {
+                    "description": "mutate from thumbnail file",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "topic_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.ReqCreatePriceList": {
+            "type": "object",
+            "required": [
+                "code",
+                "customer_category_ids",
+                "name"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "customer_category_ids": {
+                    "description": "empty for a list that applies to every customer",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "priority": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "dto.ReqCreatePriceListPrice": {
+            "type": "object",
+            "required": [
+                "effective_from",
+                "item_id"
+            ],
+            "properties": {
+                "effective_from": {
+                    "type": "string"
+                },
+                "effective_to": {
+                    "description": "open ended when empty",
+                    "type": "string"
+                },
+                "item_id": {
+                    "type": "string"
+                },
+                "min_qty": {
+                    "description": "defaults to 1",
+                    "type": "number"
+                },
+                "price": {
+                    "description": "price of one unit",
+                    "type": "number",
+                    "minimum": 0
+                },
+                "uom": {
+                    "description": "defaults to the base unit of the item",
+                    "type": "string",
+                    "maxLength": 20
+                }
+            }
+        },
+        "dto.ReqCreateProvince": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "code": {
+                    "description": "Official Kemendagri / BPS region code",
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "dto.ReqCreateRole": {
+            "type": "object",
+            "required": [
+                "accesses",
+                "role_name"
+            ],
+            "properties": {
+                "accesses": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "role_name": {
+                    "type": "string",
+                    "maxLength": 80
+                }
+            }
+        },
+        "dto.ReqCreateSubGroup": {
+            "type": "object",
+            "required": [
+                "groups_id",
+                "name"
+            ],
+            "properties": {
+                "groups_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.ReqCreateSubdistrict": {
+            "type": "object",
+            "required": [
+                "district_id",
+                "name"
+            ],
+            "properties": {
+                "code": {
+                    "description": "Official Kemendagri / BPS region code",
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "district_id": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "description": "Centroid latitude, sent together with longitude",
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "longitude": {
+                    "description": "Centroid longitude, sent together with latitude",
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "postal_codes": {
+                    "description": "5 digit postal codes, an empty list clears them",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.ReqCreateSupplier": {
+            "type": "object",
+            "required": [
+                "supplier_name"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "city_id": {
+                    "type": "string"
+                },
+                "district_id": {
+                    "type": "string"
+                },
+                "expedition_arrives_id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "phone_numbers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "province_id": {
+                    "type": "string"
+                },
+                "subdistrict_id": {
+                    "type": "string"
+                },
+                "supplier_name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "telp_numbers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rendyfutsuy_base-go_modules_supplier_dto.TelpNumberItem"
+                    }
+                }
+            }
+        },
+        "dto.ReqCreateTranslation": {
+            "type": "object",
+            "required": [
+                "entity_id",
+                "entity_type",
+                "field",
+                "locale",
+                "value"
+            ],
+            "properties": {
+                "entity_id": {
+                    "type": "string"
+                },
+                "entity_type": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "field": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "locale": {
+                    "type": "string",
+                    "maxLength": 10
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqCreateType": {
+            "type": "object",
+            "required": [
+                "name",
+                "subgroup_id"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "subgroup_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqCreateUser": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "nik",
+                "password",
+                "password_confirmation",
+                "role_id",
+                "username"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 80
+                },
+                "nik": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8
+                },
+                "password_confirmation": {
+                    "type": "string"
+                },
+                "role_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqMergeBacking": {
+            "type": "object",
+            "required": [
+                "target_id"
+            ],
+            "properties": {
+                "target_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqMergeSubGroup": {
+            "type": "object",
+            "required": [
+                "target_id"
+            ],
+            "properties": {
+                "regenerate_codes": {
+                    "description": "new codes for the moved types and their backings",
+                    "type": "boolean"
+                },
+                "target_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqMergeType": {
+            "type": "object",
+            "required": [
+                "target_id"
+            ],
+            "properties": {
+                "regenerate_codes": {
+                    "description": "new codes for the moved backings",
+                    "type": "boolean"
+                },
+                "target_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqMoveBacking": {
+            "type": "object",
+            "required": [
+                "type_id"
+            ],
+            "properties": {
+                "regenerate_code": {
+                    "description": "new code for the backing",
+                    "type": "boolean"
+                },
+                "type_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqMoveParameter": {
+            "type": "object",
+            "properties": {
+                "parent_id": {
+                    "type": "string"
+                },
+                "position": {
+                    "description": "starting at 1, last when empty",
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "dto.ReqMoveSubGroup": {
+            "type": "object",
+            "required": [
+                "groups_id"
+            ],
+            "properties": {
+                "groups_id": {
+                    "type": "string"
+                },
+                "regenerate_codes": {
+                    "description": "new codes for the sub-group, its types and their backings",
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.ReqMoveType": {
+            "type": "object",
+            "required": [
+                "subgroup_id"
+            ],
+            "properties": {
+                "regenerate_codes": {
+                    "description": "new codes for the type and its backings",
+                    "type": "boolean"
+                },
+                "subgroup_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqPublishPost": {
+            "type": "object",
+            "properties": {
+                "publish_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqRegencyBoundary": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "coordinates": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReqRegencyBoundary"
+                    }
+                },
+                "geometry": {
+                    "$ref": "#/definitions/dto.ReqRegencyBoundary"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqRegisterUser": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "nik",
+                "password",
+                "password_confirmation",
+                "username"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 80
+                },
+                "nik": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8
+                },
+                "password_confirmation": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqReorderParameters": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqResetPassword": {
+            "type": "object",
+            "required": [
+                "password",
+                "password_confirmation"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "maxLength": 25,
+                    "minLength": 8
+                },
+                "password_confirmation": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqResetPasswordRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqScheduleUserDeactivation": {
+            "type": "object",
+            "properties": {
+                "deactivate_at": {
+                    "description": "null clears the schedule",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqUpdateBacking": {
+            "type": "object",
+            "required": [
+                "name",
+                "type_id"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "type_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqUpdateCity": {
+            "type": "object",
+            "required": [
+                "name",
+                "province_id"
+            ],
+            "properties": {
+                "area_code": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "code": {
+                    "description": "Official Kemendagri / BPS region code",
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "province_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqUpdateCodePolicy": {
+            "type": "object",
+            "required": [
+                "padding"
+            ],
+            "properties": {
+                "inherit_parent": {
+                    "description": "only for entities with a parent (sub_group, type, backing)",
+                    "type": "boolean"
+                },
+                "padding": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 1
+                },
+                "prefix": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "separator": {
+                    "type": "string",
+                    "maxLength": 5
+                },
+                "yearly_reset": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.ReqUpdateCustomer": {
+            "type": "object",
+            "required": [
+                "customer_name"
+            ],
+            "properties": {
+                "addresses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CustomerAddressItem"
+                    }
+                },
+                "credit_limit": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "customer_category_id": {
+                    "type": "string"
+                },
+                "customer_name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "expedition_send_id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "payment_term_days": {
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": 0
+                },
+                "phone_numbers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "telp_numbers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rendyfutsuy_base-go_modules_customer_dto.TelpNumberItem"
+                    }
+                }
+            }
+        },
+        "dto.ReqUpdateDistrict": {
+            "type": "object",
+            "required": [
+                "city_id",
+                "name"
+            ],
+            "properties": {
+                "city_id": {
+                    "type": "string"
+                },
+                "code": {
+                    "description": "Official Kemendagri / BPS region code",
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "latitude": {
+                    "description": "Centroid latitude, sent together with longitude",
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "longitude": {
+                    "description": "Centroid longitude, sent together with latitude",
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.ReqUpdateExpedition": {
+            "type": "object",
+            "required": [
+                "expedition_name"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "expedition_name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "phone_numbers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "telp_numbers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rendyfutsuy_base-go_modules_expedition_dto.TelpNumberItem"
+                    }
+                }
+            }
+        },
+        "dto.ReqUpdateExpeditionContact": {
+            "type": "object",
+            "required": [
+                "phone_number"
+            ],
+            "properties": {
+                "area_code": {
+                    "type": "string",
+                    "maxLength": 10
+                },
+                "contact_name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "phone_number": {
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "dto.ReqUpdateExpeditionTariff": {
+            "type": "object",
+            "required": [
+                "destination_city_id",
+                "origin_city_id"
+            ],
+            "properties": {
+                "destination_city_id": {
+                    "type": "string"
+                },
+                "lead_time_days": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "min_weight": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "origin_city_id": {
+                    "type": "string"
+                },
+                "price_per_kg": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.ReqUpdateGroup": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.ReqUpdateItem": {
+            "type": "object",
+            "required": [
+                "backing_id",
+                "name"
+            ],
+            "properties": {
+                "backing_id": {
+                    "type": "string"
+                },
+                "barcodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ItemBarcodeItem"
+                    }
+                },
+                "base_uom": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "height": {
+                    "type": "number"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "length": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "units": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ItemUnitItem"
+                    }
+                },
+                "weight": {
+                    "type": "number"
+                },
+                "width": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.ReqUpdateParameter": {
+            "type": "object",
+            "required": [
+                "code",
+                "name"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "desc": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqUpdateParameterType": {
+            "type": "object",
+            "required": [
+                "name",
+                "value_kind"
+            ],
+            "properties": {
+                "allowed_parent_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "enum_values": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "json_schema": {
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "value_kind": {
+                    "type": "string",
+                    "enum": [
+                        "string",
+                        "int",
+                        "decimal",
+                        "bool",
+                        "date",
+                        "enum",
+                        "json"
+                    ]
+                },
+                "value_required": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.ReqUpdatePassword": {
+            "type": "object",
+            "required": [
+                "new_password",
+                "password_confirmation"
+            ],
+            "properties": {
+                "new_password": {
+                    "type": "string"
+                },
+                "password_confirmation": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqUpdatePost": {
+            "type": "object",
+            "required": [
+                "description",
+                "short_description",
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "lang_id": {
+                    "type": "string"
+                },
+                "remove_thumbnail": {
+                    "type": "boolean",
+                    "default": false
+                },
+                "short_description": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "thumbnailURL": {
+                    "description": "mutate from thumbnail file",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "topic_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.ReqUpdatePriceList": {
+            "type": "object",
+            "required": [
+                "customer_category_ids",
+                "name"
+            ],
+            "properties": {
+                "customer_category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "priority": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "dto.ReqUpdatePriceListPrice": {
+            "type": "object",
+            "required": [
+                "effective_from",
+                "item_id"
+            ],
+            "properties": {
+                "effective_from": {
+                    "type": "string"
+                },
+                "effective_to": {
+                    "type": "string"
+                },
+                "item_id": {
+                    "type": "string"
+                },
+                "min_qty": {
+                    "type": "number"
+                },
+                "price": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "uom": {
+                    "type": "string",
+                    "maxLength": 20
+                }
+            }
+        },
+        "dto.ReqUpdateProfile": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqUpdateProvince": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "code": {
+                    "description": "Official Kemendagri / BPS region code",
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "dto.ReqUpdateRole": {
+            "type": "object",
+            "required": [
+                "accesses",
+                "role_name"
+            ],
+            "properties": {
+                "accesses": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "role_name": {
+                    "type": "string",
+                    "maxLength": 80
+                }
+            }
+        },
+        "dto.ReqUpdateSubGroup": {
+            "type": "object",
+            "required": [
+                "groups_id",
+                "name"
+            ],
+            "properties": {
+                "groups_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.ReqUpdateSubdistrict": {
+            "type": "object",
+            "required": [
+                "district_id",
+                "name"
+            ],
+            "properties": {
+                "code": {
+                    "description": "Official Kemendagri / BPS region code",
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "district_id": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "description": "Centroid latitude, sent together with longitude",
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "longitude": {
+                    "description": "Centroid longitude, sent together with latitude",
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "postal_codes": {
+                    "description": "5 digit postal codes, an empty list clears them",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.ReqUpdateSupplier": {
+            "type": "object",
+            "required": [
+                "supplier_name"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "city_id": {
+                    "type": "string"
+                },
+                "district_id": {
+                    "type": "string"
+                },
+                "expedition_arrives_id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "phone_numbers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "province_id": {
+                    "type": "string"
+                },
+                "subdistrict_id": {
+                    "type": "string"
+                },
+                "supplier_name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "telp_numbers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rendyfutsuy_base-go_modules_supplier_dto.TelpNumberItem"
+                    }
+                }
+            }
+        },
+        "dto.ReqUpdateTranslation": {
+            "type": "object",
+            "required": [
+                "value"
+            ],
+            "properties": {
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqUpdateType": {
+            "type": "object",
+            "required": [
+                "name",
+                "subgroup_id"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "subgroup_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqUpdateUser": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "role_id",
+                "username"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 80
+                },
+                "password": {
+                    "type": "string"
+                },
+                "password_confirmation": {
+                    "type": "string"
+                },
+                "role_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReqUpdateUserPassword": {
+            "type": "object",
+            "required": [
+                "new_password",
+                "password_confirmation"
+            ],
+            "properties": {
+                "new_password": {
+                    "type": "string"
+                },
+                "password_confirmation": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ResImportExpeditionExcel": {
+            "type": "object",
+            "properties": {
+                "error_message": {
+                    "description": "Message error jika status failed",
+                    "type": "string"
+                },
+                "expedition_name": {
+                    "description": "Nama ekspedisi",
+                    "type": "string"
+                },
+                "row": {
+                    "description": "Nomor baris di Excel",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Status row: \"success\" atau \"failed\"",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ResImportExpeditionServiceExcel": {
+            "type": "object",
+            "properties": {
+                "error_message": {
+                    "description": "Message error jika status failed",
+                    "type": "string"
+                },
+                "key": {
+                    "description": "Area or route of the row, e.g. \"32.73\" or \"32.73 \u003e 31.71\"",
+                    "type": "string"
+                },
+                "row": {
+                    "description": "Nomor baris di Excel",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Status row: \"success\" atau \"failed\"",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ResImportExpeditionServices": {
+            "type": "object",
+            "properties": {
+                "failed_count": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ResImportExpeditionServiceExcel"
+                    }
+                },
+                "success_count": {
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ResImportExpeditions": {
+            "type": "object",
+            "properties": {
+                "failed_count": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ResImportExpeditionExcel"
+                    }
+                },
+                "success_count": {
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ResImportItemExcel": {
+            "type": "object",
+            "properties": {
+                "error_message": {
+                    "description": "Message error jika status failed",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Nama item",
+                    "type": "string"
+                },
+                "row": {
+                    "description": "Nomor baris di Excel",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Status row: \"success\" atau \"failed\"",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ResImportItems": {
+            "type": "object",
+            "properties": {
+                "failed_count": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ResImportItemExcel"
+                    }
+                },
+                "success_count": {
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ResImportParameterExcel": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Kode parameter",
+                    "type": "string"
+                },
+                "error_message": {
+                    "description": "Message error jika status failed",
+                    "type": "string"
+                },
+                "row": {
+                    "description": "Nomor baris di Excel",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Status row: \"success\" atau \"failed\"",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ResImportParameters": {
+            "type": "object",
+            "properties": {
+                "failed_count": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ResImportParameterExcel"
+                    }
+                },
+                "success_count": {
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ResImportPriceListPriceExcel": {
+            "type": "object",
+            "properties": {
+                "error_message": {
+                    "description": "Message error jika status failed",
+                    "type": "string"
+                },
+                "item_code": {
+                    "description": "Kode item",
+                    "type": "string"
+                },
+                "row": {
+                    "description": "Nomor baris di Excel",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Status row: \"success\" atau \"failed\"",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ResImportPriceListPrices": {
+            "type": "object",
+            "properties": {
+                "failed_count": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ResImportPriceListPriceExcel"
+                    }
+                },
+                "success_count": {
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ResImportTranslationExcel": {
+            "type": "object",
+            "properties": {
+                "entity_code": {
+                    "description": "Entity ID or code of the row",
+                    "type": "string"
+                },
+                "entity_type": {
+                    "description": "Entity type of the row",
+                    "type": "string"
+                },
+                "error_message": {
+                    "description": "Errors of a failed row",
+                    "type": "string"
+                },
+                "row": {
+                    "description": "Excel row number",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "\"success\" or \"failed\"",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ResImportTranslations": {
+            "type": "object",
+            "properties": {
+                "failed_count": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ResImportTranslationExcel"
+                    }
+                },
+                "success_count": {
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ResImportUserExcel": {
+            "type": "object",
+            "properties": {
+                "error_message": {
+                    "description": "Message error jika status failed",
+                    "type": "string"
+                },
+                "row": {
+                    "description": "Nomor baris di Excel",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Status row: \"success\" atau \"failed\"",
+                    "type": "string"
+                },
+                "username": {
+                    "description": "Username user",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ResImportUsers": {
+            "type": "object",
+            "properties": {
+                "failed_count": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ResImportUserExcel"
+                    }
+                },
+                "success_count": {
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.RespBacking": {
+            "type": "object",
+            "properties": {
+                "backing_code": {
+                    "description": "omit when creating new backing",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "deletable": {
+                    "type": "boolean"
+                },
+                "groups_id": {
+                    "type": "string"
+                },
+                "groups_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "subgroup_id": {
+                    "type": "string"
+                },
+                "subgroup_name": {
+                    "type": "string"
+                },
+                "type_id": {
+                    "type": "string"
+                },
+                "type_name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RespBackingIndex": {
+            "type": "object",
+            "properties": {
+                "backing_code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deletable": {
+                    "type": "boolean"
+                },
+                "group_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "subgroup_name": {
+                    "type": "string"
+                },
+                "type_id": {
+                    "type": "string"
+                },
+                "type_name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RespBulkUserOperation": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RespBulkUserOperationItem"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.RespBulkUserOperationItem": {
+            "type": "object",
+            "properties": {
+                "full_name": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RespCatalogTreeNode": {
             "type": "object",
             "properties": {
+                "backing_count": {
+                    "description": "number of backings below this node",
+                    "type": "integer"
+                },
+                "child_count": {
+                    "description": "number of direct children",
+                    "type": "integer"
+                },
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RespCatalogTreeNode"
+                    }
+                },
+                "code": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
+                "level": {
+                    "description": "group, sub_group, type or backing",
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 }
             }
         },
-        "dto.ReqAuthUser": {
+        "dto.RespCity": {
             "type": "object",
-            "required": [
-                "login",
-                "password"
-            ],
             "properties": {
-                "login": {
+                "area_code": {
                     "type": "string"
                 },
-                "password": {
+                "code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "province": {
+                    "$ref": "#/definitions/dto.RespProvince"
+                },
+                "province_id": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.ReqCheckDuplicatedEmail": {
+        "dto.RespCityAreaCode": {
             "type": "object",
-            "required": [
-                "email"
-            ],
             "properties": {
-                "email": {
+                "area_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RespCityIndex": {
+            "type": "object",
+            "properties": {
+                "area_code": {
                     "type": "string"
                 },
-                "excluded_user_id": {
+                "code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "province_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RespCodeMigration": {
+            "type": "object",
+            "properties": {
+                "entities": {
+                    "description": "the entity itself and the children inheriting its code",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RespCodeMigrationEntity"
+                    }
+                }
+            }
+        },
+        "dto.RespCodeMigrationEntity": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "entity": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RespCodePolicy": {
+            "type": "object",
+            "properties": {
+                "entity": {
+                    "type": "string"
+                },
+                "example": {
+                    "description": "first code of the current year, using example codes for the parents",
+                    "type": "string"
+                },
+                "inherit_parent": {
+                    "type": "boolean"
+                },
+                "padding": {
+                    "type": "integer"
+                },
+                "parent": {
+                    "description": "entity whose code can be inherited",
+                    "type": "string"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "separator": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                },
+                "yearly_reset": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.RespCodePreview": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "code the next created record gets, not reserved",
+                    "type": "string"
+                },
+                "entity": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RespCustomer": {
+            "type": "object",
+            "properties": {
+                "addresses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RespCustomerAddress"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "credit_limit": {
+                    "type": "number"
+                },
+                "customer_category": {
+                    "$ref": "#/definitions/dto.RespCustomerReference"
+                },
+                "customer_code": {
+                    "type": "string"
+                },
+                "customer_name": {
+                    "type": "string"
+                },
+                "expedition_send": {
+                    "$ref": "#/definitions/dto.RespCustomerReference"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "payment_term_days": {
+                    "type": "integer"
+                },
+                "phone_numbers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "telp_numbers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rendyfutsuy_base-go_modules_customer_dto.TelpNumberItem"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RespCustomerAddress": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "address_type": {
+                    "type": "string"
+                },
+                "city": {
+                    "$ref": "#/definitions/dto.RespCustomerReference"
+                },
+                "district": {
+                    "$ref": "#/definitions/dto.RespCustomerReference"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_primary": {
+                    "type": "boolean"
+                },
+                "postal_code": {
+                    "type": "string"
+                },
+                "province": {
+                    "$ref": "#/definitions/dto.RespCustomerReference"
+                },
+                "subdistrict": {
+                    "$ref": "#/definitions/dto.RespCustomerReference"
+                }
+            }
+        },
+        "dto.RespCustomerIndex": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "credit_limit": {
+                    "type": "number"
+                },
+                "customer_category_name": {
+                    "type": "string"
+                },
+                "customer_code": {
+                    "type": "string"
+                },
+                "customer_name": {
+                    "type": "string"
+                },
+                "expedition_send_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "payment_term_days": {
+                    "type": "integer"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "shipping_city_name": {
+                    "type": "string"
+                },
+                "telp_number": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.ReqCheckDuplicatedRole": {
+        "dto.RespCustomerReference": {
             "type": "object",
-            "required": [
-                "role_name"
-            ],
             "properties": {
-                "excluded_role_info_id": {
+                "id": {
                     "type": "string"
                 },
-                "role_name": {
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "dto.ReqCheckDuplicatedUser": {
+        "dto.RespDistrict": {
             "type": "object",
-            "required": [
-                "username"
-            ],
             "properties": {
-                "excluded_user_id": {
+                "city": {
+                    "$ref": "#/definitions/dto.RespCity"
+                },
+                "city_id": {
                     "type": "string"
                 },
-                "username": {
+                "code": {
                     "type": "string"
-                }
-            }
-        },
-        "dto.ReqConfirmationUserPassword": {
-            "type": "object",
-            "required": [
-                "password"
-            ],
-            "properties": {
-                "password": {
+                },
+                "created_at": {
                     "type": "string"
-                }
-            }
-        },
-        "dto.ReqCreateBacking": {
-            "type": "object",
-            "required": [
-                "name",
-                "type_id"
-            ],
-            "properties": {
-                "name": {
-                    "type": "string",
-                    "maxLength": 255
                 },
-                "type_id": {
+                "id": {
                     "type": "string"
-                }
-            }
-        },
-        "dto.ReqCreateCity": {
-            "type": "object",
-            "required": [
-                "name",
-                "province_id"
-            ],
-            "properties": {
-                "area_code": {
-                    "type": "string",
-                    "maxLength": 50
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
                 },
                 "name": {
-                    "type": "string",
-                    "maxLength": 255
+                    "type": "string"
                 },
-                "province_id": {
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.ReqCreateDistrict": {
+        "dto.RespDistrictIndex": {
             "type": "object",
-            "required": [
-                "city_id",
-                "name"
-            ],
             "properties": {
                 "city_id": {
                     "type": "string"
                 },
+                "code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
                 "name": {
-                    "type": "string",
-                    "maxLength": 255
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "dto.ReqCreateExpedition": {
+        "dto.RespExpedition": {
             "type": "object",
-            "required": [
-                "expedition_name"
-            ],
             "properties": {
                 "address": {
-                    "type": "string",
-                    "maxLength": 255
+                    "type": "string"
+                },
+                "contacts": {
+                    "description": "All contacts with contact person details",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RespExpeditionContact"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "deletable": {
+                    "type": "boolean"
+                },
+                "expedition_code": {
+                    "type": "string"
                 },
                 "expedition_name": {
-                    "type": "string",
-                    "maxLength": 255
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
                 },
                 "notes": {
                     "type": "string"
@@ -6905,670 +19449,816 @@ const docTemplate = `{
                 "telp_numbers": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.TelpNumberItem"
+                        "$ref": "#/definitions/github_com_rendyfutsuy_base-go_modules_expedition_dto.TelpNumberItem"
                     }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
                 }
             }
         },
-        "dto.ReqCreateGroup": {
-            "type": "object",
-            "required": [
-                "name"
-            ],
-            "properties": {
-                "name": {
-                    "type": "string",
-                    "maxLength": 255
-                }
-            }
-        },
-        "dto.ReqCreateParameter": {
+        "dto.RespExpeditionContact": {
             "type": "object",
-            "required": [
-                "code",
-                "name"
-            ],
             "properties": {
-                "code": {
-                    "type": "string",
-                    "maxLength": 255
+                "area_code": {
+                    "type": "string"
                 },
-                "desc": {
+                "contact_name": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255
+                "created_at": {
+                    "type": "string"
                 },
-                "parent_id": {
+                "email": {
                     "type": "string"
                 },
-                "type": {
+                "expedition_id": {
                     "type": "string"
                 },
-                "value": {
+                "id": {
+                    "type": "string"
+                },
+                "is_primary": {
+                    "type": "boolean"
+                },
+                "normalized_number": {
+                    "description": "E.164, e.g. +6221555",
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "phone_type": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.ReqCreatePost": {
+        "dto.RespExpeditionCoverage": {
             "type": "object",
-            "required": [
-                "description",
-                "short_description",
-                "title"
-            ],
             "properties": {
-                "description": {
+                "city_code": {
                     "type": "string"
                 },
-                "lang_id": {
+                "city_id": {
                     "type": "string"
                 },
-                "short_description": {
-                    "type": "string",
-                    "maxLength": 255
+                "city_name": {
+                    "type": "string"
                 },
-                "thumbnailURL": {
-                    "description": "mutate from thumbnail file",
+                "created_at": {
                     "type": "string"
                 },
-                "title": {
-                    "type": "string",
-                    "maxLength": 255
+                "district_code": {
+                    "type": "string"
                 },
-                "topic_ids": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                }
-            }
-        },
-        "dto.ReqCreateProvince": {
-            "type": "object",
-            "required": [
-                "name"
-            ],
-            "properties": {
-                "name": {
-                    "type": "string",
-                    "maxLength": 100
-                }
-            }
-        },
-        "dto.ReqCreateRole": {
-            "type": "object",
-            "required": [
-                "accesses",
-                "role_name"
-            ],
-            "properties": {
-                "accesses": {
-                    "type": "array",
-                    "minItems": 1,
-                    "items": {
-                        "type": "string"
-                    }
+                "district_id": {
+                    "type": "string"
                 },
-                "description": {
+                "district_name": {
                     "type": "string"
                 },
-                "role_name": {
-                    "type": "string",
-                    "maxLength": 80
-                }
-            }
-        },
-        "dto.ReqCreateSubGroup": {
-            "type": "object",
-            "required": [
-                "groups_id",
-                "name"
-            ],
-            "properties": {
-                "groups_id": {
+                "expedition_id": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255
-                }
-            }
-        },
-        "dto.ReqCreateSubdistrict": {
-            "type": "object",
-            "required": [
-                "district_id",
-                "name"
-            ],
-            "properties": {
-                "district_id": {
+                "id": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "dto.ReqCreateType": {
+        "dto.RespExpeditionIndex": {
             "type": "object",
-            "required": [
-                "name",
-                "subgroup_id"
-            ],
             "properties": {
-                "name": {
-                    "type": "string",
-                    "maxLength": 255
+                "address": {
+                    "type": "string"
                 },
-                "subgroup_id": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deletable": {
+                    "type": "boolean"
+                },
+                "expedition_code": {
+                    "type": "string"
+                },
+                "expedition_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "telp_number": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.ReqCreateUser": {
+        "dto.RespExpeditionQuote": {
             "type": "object",
-            "required": [
-                "email",
-                "name",
-                "nik",
-                "password",
-                "password_confirmation",
-                "role_id",
-                "username"
-            ],
             "properties": {
-                "email": {
-                    "type": "string"
+                "chargeable_weight": {
+                    "description": "The greater of the parcel weight and the minimum weight",
+                    "type": "number"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 80
+                "cost": {
+                    "type": "number"
                 },
-                "nik": {
+                "estimated_arrival": {
+                    "description": "Date (YYYY-MM-DD) the parcel arrives when shipped today",
                     "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 8
+                "expedition_code": {
+                    "type": "string"
                 },
-                "password_confirmation": {
+                "expedition_id": {
                     "type": "string"
                 },
-                "role_id": {
+                "expedition_name": {
                     "type": "string"
                 },
-                "username": {
+                "lead_time_days": {
+                    "type": "integer"
+                },
+                "min_weight": {
+                    "type": "number"
+                },
+                "price_per_kg": {
+                    "type": "number"
+                },
+                "tariff_id": {
                     "type": "string"
                 }
             }
         },
-        "dto.ReqRegisterUser": {
+        "dto.RespExpeditionTariff": {
             "type": "object",
-            "required": [
-                "email",
-                "name",
-                "nik",
-                "password",
-                "password_confirmation",
-                "username"
-            ],
             "properties": {
-                "email": {
+                "created_at": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 80
+                "destination_city_code": {
+                    "type": "string"
                 },
-                "nik": {
+                "destination_city_id": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 8
+                "destination_city_name": {
+                    "type": "string"
                 },
-                "password_confirmation": {
+                "expedition_id": {
                     "type": "string"
                 },
-                "username": {
+                "id": {
                     "type": "string"
-                }
-            }
-        },
-        "dto.ReqResetPassword": {
-            "type": "object",
-            "required": [
-                "password",
-                "password_confirmation"
-            ],
-            "properties": {
-                "password": {
-                    "type": "string",
-                    "maxLength": 25,
-                    "minLength": 8
                 },
-                "password_confirmation": {
+                "lead_time_days": {
+                    "type": "integer"
+                },
+                "min_weight": {
+                    "type": "number"
+                },
+                "origin_city_code": {
+                    "type": "string"
+                },
+                "origin_city_id": {
+                    "type": "string"
+                },
+                "origin_city_name": {
+                    "type": "string"
+                },
+                "price_per_kg": {
+                    "type": "number"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.ReqResetPasswordRequest": {
+        "dto.RespGroup": {
             "type": "object",
-            "required": [
-                "email"
-            ],
             "properties": {
-                "email": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deletable": {
+                    "description": "true if group is not used in any active sub-group",
+                    "type": "boolean"
+                },
+                "group_code": {
+                    "description": "omit when creating new group",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.ReqUpdateBacking": {
+        "dto.RespGroupIndex": {
             "type": "object",
-            "required": [
-                "name",
-                "type_id"
-            ],
             "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deletable": {
+                    "description": "true if group is not used in any active sub-group",
+                    "type": "boolean"
+                },
+                "group_code": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
                 "name": {
-                    "type": "string",
-                    "maxLength": 255
+                    "type": "string"
                 },
-                "type_id": {
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.ReqUpdateCity": {
+        "dto.RespItem": {
             "type": "object",
-            "required": [
-                "name",
-                "province_id"
-            ],
             "properties": {
-                "area_code": {
-                    "type": "string",
-                    "maxLength": 50
+                "backing": {
+                    "$ref": "#/definitions/dto.RespItemReference"
+                },
+                "barcodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ItemBarcodeItem"
+                    }
+                },
+                "base_uom": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "group": {
+                    "$ref": "#/definitions/dto.RespItemReference"
+                },
+                "height": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RespItemImage"
+                    }
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "item_code": {
+                    "type": "string"
+                },
+                "length": {
+                    "type": "number"
                 },
                 "name": {
-                    "type": "string",
-                    "maxLength": 255
+                    "type": "string"
                 },
-                "province_id": {
+                "subgroup": {
+                    "$ref": "#/definitions/dto.RespItemReference"
+                },
+                "type": {
+                    "$ref": "#/definitions/dto.RespItemReference"
+                },
+                "units": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ItemUnitItem"
+                    }
+                },
+                "updated_at": {
                     "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                },
+                "weight": {
+                    "type": "number"
+                },
+                "width": {
+                    "type": "number"
                 }
             }
         },
-        "dto.ReqUpdateDistrict": {
+        "dto.RespItemImage": {
             "type": "object",
-            "required": [
-                "city_id",
-                "name"
-            ],
             "properties": {
-                "city_id": {
+                "file_id": {
+                    "type": "string"
+                },
+                "file_path": {
                     "type": "string"
                 },
                 "name": {
-                    "type": "string",
-                    "maxLength": 255
+                    "type": "string"
                 }
             }
         },
-        "dto.ReqUpdateExpedition": {
+        "dto.RespItemIndex": {
             "type": "object",
-            "required": [
-                "expedition_name"
-            ],
             "properties": {
-                "address": {
-                    "type": "string",
-                    "maxLength": 255
+                "backing_id": {
+                    "type": "string"
                 },
-                "expedition_name": {
-                    "type": "string",
-                    "maxLength": 255
+                "backing_name": {
+                    "type": "string"
+                },
+                "base_uom": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "group_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "item_code": {
+                    "type": "string"
                 },
-                "notes": {
+                "name": {
                     "type": "string"
                 },
-                "phone_numbers": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "subgroup_name": {
+                    "type": "string"
                 },
-                "telp_numbers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.TelpNumberItem"
-                    }
+                "type_name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "dto.ReqUpdateGroup": {
+        "dto.RespItemReference": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
                 "name": {
-                    "type": "string",
-                    "maxLength": 255
+                    "type": "string"
                 }
             }
         },
-        "dto.ReqUpdateParameter": {
+        "dto.RespParameter": {
             "type": "object",
-            "required": [
-                "code",
-                "name"
-            ],
             "properties": {
                 "code": {
-                    "type": "string",
-                    "maxLength": 255
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deletable": {
+                    "type": "boolean"
                 },
                 "desc": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255
+                "id": {
+                    "type": "string"
                 },
-                "parent_id": {
+                "name": {
                     "type": "string"
                 },
+                "parent": {
+                    "$ref": "#/definitions/dto.RespParameterParent"
+                },
+                "sort_order": {
+                    "type": "integer"
+                },
                 "type": {
                     "type": "string"
                 },
+                "updated_at": {
+                    "type": "string"
+                },
                 "value": {
                     "type": "string"
                 }
             }
         },
-        "dto.ReqUpdatePassword": {
+        "dto.RespParameterIndex": {
             "type": "object",
-            "required": [
-                "new_password",
-                "password_confirmation"
-            ],
             "properties": {
-                "new_password": {
+                "code": {
                     "type": "string"
                 },
-                "password_confirmation": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deletable": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "value": {
                     "type": "string"
                 }
             }
         },
-        "dto.ReqUpdatePost": {
+        "dto.RespParameterLookup": {
             "type": "object",
-            "required": [
-                "description",
-                "short_description",
-                "title"
-            ],
             "properties": {
-                "description": {
+                "code": {
                     "type": "string"
                 },
-                "lang_id": {
+                "id": {
                     "type": "string"
                 },
-                "remove_thumbnail": {
-                    "type": "boolean",
-                    "default": false
-                },
-                "short_description": {
-                    "type": "string",
-                    "maxLength": 255
+                "name": {
+                    "type": "string"
                 },
-                "thumbnailURL": {
-                    "description": "mutate from thumbnail file",
+                "parent_code": {
                     "type": "string"
                 },
-                "title": {
-                    "type": "string",
-                    "maxLength": 255
+                "type": {
+                    "type": "string"
                 },
-                "topic_ids": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "value": {
+                    "type": "string"
                 }
             }
         },
-        "dto.ReqUpdateProfile": {
+        "dto.RespParameterParent": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
+                "id": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 }
             }
         },
-        "dto.ReqUpdateProvince": {
+        "dto.RespParameterTreeNode": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RespParameterTreeNode"
+                    }
+                },
+                "code": {
+                    "type": "string"
+                },
+                "deletable": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
                 "name": {
-                    "type": "string",
-                    "maxLength": 100
+                    "type": "string"
+                },
+                "sort_order": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
                 }
             }
         },
-        "dto.ReqUpdateRole": {
+        "dto.RespParameterType": {
             "type": "object",
-            "required": [
-                "accesses",
-                "role_name"
-            ],
             "properties": {
-                "accesses": {
+                "allowed_parent_types": {
                     "type": "array",
-                    "minItems": 1,
                     "items": {
                         "type": "string"
                     }
                 },
+                "code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
-                "role_name": {
-                    "type": "string",
-                    "maxLength": 80
+                "enum_values": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "json_schema": {
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                },
+                "value_kind": {
+                    "type": "string"
+                },
+                "value_required": {
+                    "type": "boolean"
                 }
             }
         },
-        "dto.ReqUpdateSubGroup": {
+        "dto.RespPermissionGroup": {
             "type": "object",
-            "required": [
-                "groups_id",
-                "name"
-            ],
             "properties": {
-                "groups_id": {
+                "id": {
                     "type": "string"
                 },
                 "name": {
-                    "type": "string",
-                    "maxLength": 255
+                    "type": "string"
+                },
+                "value": {
+                    "type": "boolean"
                 }
             }
         },
-        "dto.ReqUpdateSubdistrict": {
+        "dto.RespPermissionGroupByModule": {
             "type": "object",
-            "required": [
-                "district_id",
-                "name"
-            ],
             "properties": {
-                "district_id": {
-                    "type": "string"
+                "accesses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RespPermissionGroup"
+                    }
                 },
                 "name": {
-                    "type": "string",
-                    "maxLength": 255
+                    "type": "string"
                 }
             }
         },
-        "dto.ReqUpdateType": {
+        "dto.RespPost": {
             "type": "object",
-            "required": [
-                "name",
-                "subgroup_id"
-            ],
             "properties": {
-                "name": {
-                    "type": "string",
-                    "maxLength": 255
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lang": {
+                    "$ref": "#/definitions/dto.ReferenceObject"
+                },
+                "publish_at": {
+                    "type": "string"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "short_description": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
                 },
-                "subgroup_id": {
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "topics": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReferenceObject"
+                    }
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.ReqUpdateUser": {
+        "dto.RespPostIndex": {
             "type": "object",
-            "required": [
-                "email",
-                "name",
-                "role_id",
-                "username"
-            ],
             "properties": {
-                "email": {
+                "created_at": {
                     "type": "string"
                 },
-                "gender": {
+                "id": {
                     "type": "string"
                 },
-                "is_active": {
-                    "type": "boolean"
+                "publish_at": {
+                    "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 80
+                "published_at": {
+                    "type": "string"
                 },
-                "password": {
+                "short_description": {
                     "type": "string"
                 },
-                "password_confirmation": {
+                "status": {
                     "type": "string"
                 },
-                "role_id": {
+                "thumbnail_url": {
                     "type": "string"
                 },
-                "username": {
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "dto.ReqUpdateUserPassword": {
+        "dto.RespPostRevision": {
             "type": "object",
-            "required": [
-                "new_password",
-                "password_confirmation"
-            ],
             "properties": {
-                "new_password": {
+                "action": {
                     "type": "string"
                 },
-                "password_confirmation": {
+                "created_at": {
                     "type": "string"
-                }
-            }
-        },
-        "dto.ResImportUserExcel": {
-            "type": "object",
-            "properties": {
-                "error_message": {
-                    "description": "Message error jika status failed",
+                },
+                "description": {
                     "type": "string"
                 },
-                "row": {
-                    "description": "Nomor baris di Excel",
+                "edited_by": {
+                    "type": "string"
+                },
+                "edited_by_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lang_id": {
+                    "type": "string"
+                },
+                "restored_from": {
+                    "type": "string"
+                },
+                "revision_number": {
                     "type": "integer"
                 },
-                "status": {
-                    "description": "Status row: \"success\" atau \"failed\"",
+                "short_description": {
                     "type": "string"
                 },
-                "username": {
-                    "description": "Username user",
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "title": {
                     "type": "string"
+                },
+                "topic_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "dto.ResImportUsers": {
+        "dto.RespPostRevisionChange": {
             "type": "object",
             "properties": {
-                "failed_count": {
-                    "type": "integer"
+                "field": {
+                    "type": "string"
                 },
-                "results": {
+                "from": {},
+                "to": {}
+            }
+        },
+        "dto.RespPostRevisionDiff": {
+            "type": "object",
+            "properties": {
+                "changes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.ResImportUserExcel"
+                        "$ref": "#/definitions/dto.RespPostRevisionChange"
                     }
                 },
-                "success_count": {
-                    "type": "integer"
+                "from": {
+                    "$ref": "#/definitions/dto.RespPostRevisionIndex"
                 },
-                "total_rows": {
-                    "type": "integer"
+                "to": {
+                    "$ref": "#/definitions/dto.RespPostRevisionIndex"
                 }
             }
         },
-        "dto.RespBacking": {
+        "dto.RespPostRevisionIndex": {
             "type": "object",
             "properties": {
-                "backing_code": {
-                    "description": "omit when creating new backing",
+                "action": {
                     "type": "string"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "created_by": {
+                "edited_by": {
                     "type": "string"
                 },
-                "deletable": {
-                    "type": "boolean"
+                "edited_by_name": {
+                    "type": "string"
                 },
-                "groups_id": {
+                "id": {
                     "type": "string"
                 },
-                "groups_name": {
+                "restored_from": {
                     "type": "string"
                 },
-                "id": {
+                "revision_number": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RespPriceList": {
+            "type": "object",
+            "properties": {
+                "code": {
                     "type": "string"
                 },
-                "name": {
+                "created_at": {
                     "type": "string"
                 },
-                "subgroup_id": {
+                "created_by": {
                     "type": "string"
                 },
-                "subgroup_name": {
+                "customer_categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RespPriceListCategory"
+                    }
+                },
+                "description": {
                     "type": "string"
                 },
-                "type_id": {
+                "id": {
                     "type": "string"
                 },
-                "type_name": {
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
                     "type": "string"
                 },
+                "priority": {
+                    "type": "integer"
+                },
                 "updated_at": {
                     "type": "string"
                 },
@@ -7577,19 +20267,10 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.RespBackingIndex": {
+        "dto.RespPriceListCategory": {
             "type": "object",
             "properties": {
-                "backing_code": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "deletable": {
-                    "type": "boolean"
-                },
-                "group_name": {
+                "code": {
                     "type": "string"
                 },
                 "id": {
@@ -7597,40 +20278,69 @@ const docTemplate = `{
                 },
                 "name": {
                     "type": "string"
+                }
+            }
+        },
+        "dto.RespPriceListIndex": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
                 },
-                "subgroup_name": {
+                "created_at": {
                     "type": "string"
                 },
-                "type_id": {
+                "id": {
                     "type": "string"
                 },
-                "type_name": {
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
                     "type": "string"
                 },
+                "priority": {
+                    "type": "integer"
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.RespCity": {
+        "dto.RespPriceListPrice": {
             "type": "object",
             "properties": {
-                "area_code": {
+                "created_at": {
                     "type": "string"
                 },
-                "created_at": {
+                "effective_from": {
+                    "type": "string"
+                },
+                "effective_to": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "name": {
+                "item_code": {
                     "type": "string"
                 },
-                "province": {
-                    "$ref": "#/definitions/dto.RespProvince"
+                "item_id": {
+                    "type": "string"
                 },
-                "province_id": {
+                "item_name": {
+                    "type": "string"
+                },
+                "min_qty": {
+                    "type": "number"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "price_list_id": {
+                    "type": "string"
+                },
+                "uom": {
                     "type": "string"
                 },
                 "updated_at": {
@@ -7638,44 +20348,78 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.RespCityAreaCode": {
+        "dto.RespPriceListReference": {
             "type": "object",
             "properties": {
-                "area_code": {
+                "code": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.RespCityIndex": {
+        "dto.RespPriceResolve": {
             "type": "object",
             "properties": {
-                "area_code": {
+                "category_price": {
+                    "description": "false when the price comes from a list for every customer",
+                    "type": "boolean"
+                },
+                "customer_category_id": {
                     "type": "string"
                 },
-                "created_at": {
+                "date": {
                     "type": "string"
                 },
-                "id": {
+                "effective_from": {
                     "type": "string"
                 },
-                "name": {
+                "effective_to": {
                     "type": "string"
                 },
-                "province_id": {
+                "item_code": {
                     "type": "string"
                 },
-                "updated_at": {
+                "item_id": {
+                    "type": "string"
+                },
+                "item_name": {
+                    "type": "string"
+                },
+                "min_qty": {
+                    "type": "number"
+                },
+                "price_list": {
+                    "$ref": "#/definitions/dto.RespPriceListReference"
+                },
+                "price_list_item_id": {
+                    "type": "string"
+                },
+                "qty": {
+                    "type": "number"
+                },
+                "total_price": {
+                    "type": "number"
+                },
+                "unit_price": {
+                    "type": "number"
+                },
+                "uom": {
                     "type": "string"
                 }
             }
         },
-        "dto.RespDistrict": {
+        "dto.RespProvince": {
             "type": "object",
             "properties": {
-                "city": {
-                    "$ref": "#/definitions/dto.RespCity"
-                },
-                "city_id": {
+                "code": {
                     "type": "string"
                 },
                 "created_at": {
@@ -7692,10 +20436,10 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.RespDistrictIndex": {
+        "dto.RespProvinceIndex": {
             "type": "object",
             "properties": {
-                "city_id": {
+                "code": {
                     "type": "string"
                 },
                 "created_at": {
@@ -7712,296 +20456,323 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.RespExpedition": {
+        "dto.RespRegencyAncestor": {
             "type": "object",
             "properties": {
-                "address": {
+                "id": {
                     "type": "string"
                 },
-                "created_at": {
+                "level": {
                     "type": "string"
                 },
-                "created_by": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RespRegencyGeo": {
+            "type": "object",
+            "properties": {
+                "ancestors": {
+                    "description": "Ancestors are ordered from the province down to the direct parent",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RespRegencyAncestor"
+                    }
+                },
+                "code": {
                     "type": "string"
                 },
-                "deletable": {
-                    "type": "boolean"
+                "distance_meters": {
+                    "description": "DistanceMeters is only set on the nearest-region lookup",
+                    "type": "number"
                 },
-                "expedition_code": {
+                "id": {
                     "type": "string"
                 },
-                "expedition_name": {
+                "latitude": {
+                    "type": "number"
+                },
+                "level": {
                     "type": "string"
                 },
-                "id": {
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
                     "type": "string"
                 },
-                "notes": {
+                "path": {
+                    "description": "Path is the full name from the match up to its province, e.g. \"Kec. X, Kota Y, Prov. Z\"",
                     "type": "string"
                 },
-                "phone_numbers": {
+                "postal_codes": {
+                    "description": "PostalCodes is null on districts",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
+                }
+            }
+        },
+        "dto.RespRegencyGeoImport": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
                 },
-                "telp_numbers": {
+                "errors": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.TelpNumberItem"
+                        "$ref": "#/definitions/dto.RespRegencyImportRowError"
                     }
                 },
-                "updated_at": {
-                    "type": "string"
+                "total_rows": {
+                    "type": "integer"
                 },
-                "updated_by": {
-                    "type": "string"
+                "updated": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.RespExpeditionIndex": {
+        "dto.RespRegencyImport": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
+                "added": {
+                    "type": "integer"
                 },
-                "created_at": {
-                    "type": "string"
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RespRegencyImportChange"
+                    }
                 },
-                "deletable": {
+                "dry_run": {
                     "type": "boolean"
                 },
-                "expedition_code": {
-                    "type": "string"
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RespRegencyImportRowError"
+                    }
                 },
-                "expedition_name": {
-                    "type": "string"
+                "linked": {
+                    "type": "integer"
                 },
-                "id": {
-                    "type": "string"
+                "removed": {
+                    "type": "integer"
                 },
-                "phone_number": {
-                    "type": "string"
+                "renamed": {
+                    "type": "integer"
                 },
-                "telp_number": {
-                    "type": "string"
+                "total_rows": {
+                    "type": "integer"
                 },
-                "updated_at": {
-                    "type": "string"
+                "unchanged": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.RespGroup": {
+        "dto.RespRegencyImportChange": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "action": {
                     "type": "string"
                 },
-                "deletable": {
-                    "description": "true if group is not used in any active sub-group",
-                    "type": "boolean"
-                },
-                "group_code": {
-                    "description": "omit when creating new group",
+                "code": {
                     "type": "string"
                 },
-                "id": {
+                "level": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "updated_at": {
+                "old_name": {
                     "type": "string"
                 }
             }
         },
-        "dto.RespGroupIndex": {
+        "dto.RespRegencyImportRowError": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "deletable": {
-                    "description": "true if group is not used in any active sub-group",
-                    "type": "boolean"
-                },
-                "group_code": {
-                    "type": "string"
-                },
-                "id": {
+                "code": {
                     "type": "string"
                 },
-                "name": {
+                "error_message": {
                     "type": "string"
                 },
-                "updated_at": {
-                    "type": "string"
+                "row": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.RespParameter": {
+        "dto.RespRegencyLocate": {
             "type": "object",
             "properties": {
-                "code": {
+                "boundary_level": {
+                    "description": "BoundaryLevel is the level of the boundary that contains the coordinate",
                     "type": "string"
                 },
-                "created_at": {
-                    "type": "string"
+                "latitude": {
+                    "type": "number"
                 },
-                "deletable": {
-                    "type": "boolean"
+                "longitude": {
+                    "type": "number"
                 },
-                "desc": {
+                "matched_by": {
+                    "description": "MatchedBy is boundary, or nearest_centroid when the subdistrict was picked by centroid inside the matched district",
                     "type": "string"
                 },
-                "id": {
-                    "type": "string"
+                "region": {
+                    "description": "Region is the deepest region resolved, with its ancestors up to the province",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.RespRegencySearchResult"
+                        }
+                    ]
+                }
+            }
+        },
+        "dto.RespRegencySearchResult": {
+            "type": "object",
+            "properties": {
+                "ancestors": {
+                    "description": "Ancestors are ordered from the province down to the direct parent",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RespRegencyAncestor"
+                    }
                 },
-                "name": {
+                "code": {
                     "type": "string"
                 },
-                "parent": {
-                    "$ref": "#/definitions/dto.RespParameterParent"
+                "id": {
+                    "type": "string"
                 },
-                "type": {
+                "level": {
                     "type": "string"
                 },
-                "updated_at": {
+                "name": {
                     "type": "string"
                 },
-                "value": {
+                "path": {
+                    "description": "Path is the full name from the match up to its province, e.g. \"Kec. X, Kota Y, Prov. Z\"",
                     "type": "string"
                 }
             }
         },
-        "dto.RespParameterIndex": {
+        "dto.RespRegencyTreeNode": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RespRegencyTreeNode"
+                    }
                 },
-                "deletable": {
+                "has_children": {
                     "type": "boolean"
                 },
                 "id": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
-                },
-                "type": {
+                "level": {
                     "type": "string"
                 },
-                "updated_at": {
-                    "type": "string"
-                },
-                "value": {
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "dto.RespParameterParent": {
+        "dto.RespRole": {
             "type": "object",
             "properties": {
                 "id": {
                     "type": "string"
                 },
-                "name": {
+                "role_name": {
                     "type": "string"
                 }
             }
         },
-        "dto.RespPermissionGroup": {
+        "dto.RespRoleDetail": {
             "type": "object",
             "properties": {
-                "id": {
+                "created_at": {
                     "type": "string"
                 },
-                "name": {
+                "deletable": {
+                    "type": "boolean"
+                },
+                "description": {
                     "type": "string"
                 },
-                "value": {
-                    "type": "boolean"
-                }
-            }
-        },
-        "dto.RespPermissionGroupByModule": {
-            "type": "object",
-            "properties": {
-                "accesses": {
+                "id": {
+                    "type": "string"
+                },
+                "modules": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.RespPermissionGroup"
+                        "$ref": "#/definitions/dto.RespPermissionGroupByModule"
                     }
                 },
-                "name": {
+                "role_name": {
                     "type": "string"
+                },
+                "total_user": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "$ref": "#/definitions/utils.NullTime"
                 }
             }
         },
-        "dto.RespPost": {
+        "dto.RespRoleIndex": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "description": {
-                    "type": "string"
+                "deletable": {
+                    "type": "boolean"
                 },
                 "id": {
                     "type": "string"
                 },
-                "lang": {
-                    "$ref": "#/definitions/dto.ReferenceObject"
-                },
-                "short_description": {
-                    "type": "string"
-                },
-                "thumbnail_url": {
-                    "type": "string"
-                },
-                "title": {
-                    "type": "string"
-                },
-                "topics": {
+                "modules": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.ReferenceObject"
+                        "type": "string"
                     }
                 },
-                "updated_at": {
+                "role_name": {
                     "type": "string"
+                },
+                "total_user": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "$ref": "#/definitions/utils.NullTime"
                 }
             }
         },
-        "dto.RespPostIndex": {
+        "dto.RespSubGroup": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "id": {
+                "created_by": {
                     "type": "string"
                 },
-                "short_description": {
-                    "type": "string"
+                "deletable": {
+                    "type": "boolean"
                 },
-                "thumbnail_url": {
+                "groups_id": {
                     "type": "string"
                 },
-                "title": {
-                    "type": "string"
-                }
-            }
-        },
-        "dto.RespProvince": {
-            "type": "object",
-            "properties": {
-                "created_at": {
+                "groups_name": {
                     "type": "string"
                 },
                 "id": {
@@ -8010,127 +20781,170 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "subgroup_code": {
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
                 }
             }
         },
-        "dto.RespProvinceIndex": {
+        "dto.RespSubGroupIndex": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string"
                 },
+                "deletable": {
+                    "type": "boolean"
+                },
+                "groups_id": {
+                    "type": "string"
+                },
+                "groups_name": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
+                "subgroup_code": {
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.RespRole": {
+        "dto.RespSubdistrict": {
             "type": "object",
             "properties": {
-                "id": {
+                "code": {
                     "type": "string"
                 },
-                "role_name": {
-                    "type": "string"
-                }
-            }
-        },
-        "dto.RespRoleDetail": {
-            "type": "object",
-            "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "deletable": {
-                    "type": "boolean"
+                "district": {
+                    "$ref": "#/definitions/dto.RespDistrict"
+                },
+                "district_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number"
                 },
-                "description": {
-                    "type": "string"
+                "longitude": {
+                    "type": "number"
                 },
-                "id": {
+                "name": {
                     "type": "string"
                 },
-                "modules": {
+                "postal_codes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.RespPermissionGroupByModule"
+                        "type": "string"
                     }
                 },
-                "role_name": {
-                    "type": "string"
-                },
-                "total_user": {
-                    "type": "integer"
-                },
                 "updated_at": {
-                    "$ref": "#/definitions/utils.NullTime"
+                    "type": "string"
                 }
             }
         },
-        "dto.RespRoleIndex": {
+        "dto.RespSubdistrictIndex": {
             "type": "object",
             "properties": {
+                "code": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
-                "deletable": {
-                    "type": "boolean"
+                "district_id": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "modules": {
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "postal_codes": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "role_name": {
-                    "type": "string"
-                },
-                "total_user": {
-                    "type": "integer"
-                },
                 "updated_at": {
-                    "$ref": "#/definitions/utils.NullTime"
+                    "type": "string"
                 }
             }
         },
-        "dto.RespSubGroup": {
+        "dto.RespSupplier": {
             "type": "object",
             "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "city": {
+                    "$ref": "#/definitions/dto.RespSupplierRegion"
+                },
                 "created_at": {
                     "type": "string"
                 },
                 "created_by": {
                     "type": "string"
                 },
-                "deletable": {
-                    "type": "boolean"
+                "district": {
+                    "$ref": "#/definitions/dto.RespSupplierRegion"
                 },
-                "groups_id": {
-                    "type": "string"
+                "expedition_arrives": {
+                    "$ref": "#/definitions/dto.RespSupplierRegion"
                 },
-                "groups_name": {
+                "id": {
                     "type": "string"
                 },
-                "id": {
+                "notes": {
                     "type": "string"
                 },
-                "name": {
+                "phone_numbers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "province": {
+                    "$ref": "#/definitions/dto.RespSupplierRegion"
+                },
+                "subdistrict": {
+                    "$ref": "#/definitions/dto.RespSupplierRegion"
+                },
+                "supplier_code": {
                     "type": "string"
                 },
-                "subgroup_code": {
+                "supplier_name": {
                     "type": "string"
                 },
+                "telp_numbers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rendyfutsuy_base-go_modules_supplier_dto.TelpNumberItem"
+                    }
+                },
                 "updated_at": {
                     "type": "string"
                 },
@@ -8139,28 +20953,34 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.RespSubGroupIndex": {
+        "dto.RespSupplierIndex": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "address": {
                     "type": "string"
                 },
-                "deletable": {
-                    "type": "boolean"
+                "city_name": {
+                    "type": "string"
                 },
-                "groups_id": {
+                "created_at": {
                     "type": "string"
                 },
-                "groups_name": {
+                "expedition_arrives_name": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "name": {
+                "phone_number": {
                     "type": "string"
                 },
-                "subgroup_code": {
+                "supplier_code": {
+                    "type": "string"
+                },
+                "supplier_name": {
+                    "type": "string"
+                },
+                "telp_number": {
                     "type": "string"
                 },
                 "updated_at": {
@@ -8168,36 +20988,65 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.RespSubdistrict": {
+        "dto.RespSupplierRegion": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RespTranslation": {
             "type": "object",
             "properties": {
+                "base_value": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
-                "district": {
-                    "$ref": "#/definitions/dto.RespDistrict"
+                "entity_code": {
+                    "type": "string"
                 },
-                "district_id": {
+                "entity_id": {
+                    "type": "string"
+                },
+                "entity_type": {
+                    "type": "string"
+                },
+                "field": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "name": {
+                "locale": {
                     "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
+                },
+                "value": {
+                    "type": "string"
                 }
             }
         },
-        "dto.RespSubdistrictIndex": {
+        "dto.RespTrashItem": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "code": {
                     "type": "string"
                 },
-                "district_id": {
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "string"
+                },
+                "deleted_by_name": {
                     "type": "string"
                 },
                 "id": {
@@ -8206,7 +21055,10 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
-                "updated_at": {
+                "purge_at": {
+                    "type": "string"
+                },
+                "resource": {
                     "type": "string"
                 }
             }
@@ -8289,12 +21141,53 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.RespUserDataRequest": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "download_url": {
+                    "type": "string"
+                },
+                "error_message": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "processed_at": {
+                    "type": "string"
+                },
+                "request_type": {
+                    "type": "string"
+                },
+                "requested_by": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.RespUserDetail": {
             "type": "object",
             "properties": {
+                "auto_unblock_at": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
+                "deactivate_at": {
+                    "type": "string"
+                },
                 "deletable": {
                     "type": "boolean"
                 },
@@ -8304,6 +21197,16 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "is_active": {
+                    "description": "status",
+                    "type": "boolean"
+                },
+                "is_blocked": {
+                    "type": "boolean"
+                },
+                "last_login_at": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -8316,6 +21219,12 @@ const docTemplate = `{
                 "role_name": {
                     "type": "string"
                 },
+                "status_histories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RespUserStatusHistory"
+                    }
+                },
                 "updated_at": {
                     "type": "string"
                 },
@@ -8353,16 +21262,66 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.TelpNumberItem": {
+        "dto.RespUserLifecycleReport": {
             "type": "object",
-            "required": [
-                "phone_number"
-            ],
             "properties": {
-                "area_code": {
+                "action": {
                     "type": "string"
                 },
-                "phone_number": {
+                "deactivate_at": {
+                    "type": "string"
+                },
+                "due_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_blocked": {
+                    "type": "boolean"
+                },
+                "last_login_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "role_name": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RespUserStatusHistory": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "created_by_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "unblock_at": {
                     "type": "string"
                 }
             }
@@ -8402,6 +21361,48 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_rendyfutsuy_base-go_modules_customer_dto.TelpNumberItem": {
+            "type": "object",
+            "required": [
+                "phone_number"
+            ],
+            "properties": {
+                "area_code": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rendyfutsuy_base-go_modules_expedition_dto.TelpNumberItem": {
+            "type": "object",
+            "required": [
+                "phone_number"
+            ],
+            "properties": {
+                "area_code": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rendyfutsuy_base-go_modules_supplier_dto.TelpNumberItem": {
+            "type": "object",
+            "required": [
+                "phone_number"
+            ],
+            "properties": {
+                "area_code": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                }
+            }
+        },
         "http.GeneralResponse": {
             "type": "object",
             "properties": {
@@ -0,0 +1,233 @@
+// Package codegen allocates master data codes from the per-entity policies in code_policies.
+//
+// A code is built as prefix + [parent code + separator] + [year + separator] + zero padded number,
+// e.g. "01.02.003" for a type inheriting the code "01.02" of its sub-group.
+// Numbers come from code_counters, one row per entity and scope (parent code and / or year),
+// incremented with an upsert inside the caller's transaction. The counter row stays locked until
+// the transaction ends and rolls back with it, so concurrent creates never share a number and a
+// failed create does not leave a gap.
+package codegen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	"gorm.io/gorm"
+)
+
+// DefaultPolicy is used when an entity has no row in code_policies
+func DefaultPolicy(entity string) models.CodePolicy {
+	return models.CodePolicy{
+		Entity:    entity,
+		Separator: constants.CodePolicyDefaultSeparator,
+		Padding:   constants.CodePolicyDefaultPadding,
+	}
+}
+
+// GetPolicy returns the code policy of an entity, or the default policy when none is stored
+func GetPolicy(tx *gorm.DB, entity string) (models.CodePolicy, error) {
+	var policies []models.CodePolicy
+	if err := tx.Where("entity = ?", entity).Limit(1).Find(&policies).Error; err != nil {
+		return models.CodePolicy{}, err
+	}
+	if len(policies) == 0 {
+		return DefaultPolicy(entity), nil
+	}
+	return policies[0], nil
+}
+
+// Head returns the part of the code before the number
+func Head(policy models.CodePolicy, parentCode string, year int) string {
+	head := policy.Prefix
+	if policy.InheritParent && parentCode != "" {
+		head += parentCode + policy.Separator
+	}
+	if policy.YearlyReset {
+		head += strconv.Itoa(year) + policy.Separator
+	}
+	return head
+}
+
+// Format builds the code holding number value
+func Format(policy models.CodePolicy, parentCode string, year int, value int64) string {
+	return Head(policy, parentCode, year) + fmt.Sprintf("%0*d", policy.Padding, value)
+}
+
+// Scope returns the counter scope: numbering restarts per parent code when inheriting it
+// and per year when resetting yearly
+func Scope(policy models.CodePolicy, parentCode string, year int) string {
+	var parts []string
+	if policy.InheritParent {
+		parts = append(parts, parentCode)
+	}
+	if policy.YearlyReset {
+		parts = append(parts, strconv.Itoa(year))
+	}
+	return strings.Join(parts, "|")
+}
+
+// Allocate reserves the next code of an entity. parentID is the parent record (e.g. the sub-group of a type)
+// and may be nil for entities without parent. It must run in the transaction that stores the code.
+func Allocate(tx *gorm.DB, entity string, parentID *uuid.UUID) (string, error) {
+	e, policy, err := load(tx, entity)
+	if err != nil {
+		return "", err
+	}
+	return allocate(tx, e, policy, parentID, uuid.Nil)
+}
+
+// Preview returns the code the next Allocate would return, without reserving it
+func Preview(db *gorm.DB, entity string, parentID *uuid.UUID) (string, error) {
+	e, policy, err := load(db, entity)
+	if err != nil {
+		return "", err
+	}
+	parentCode, err := parentCodeOf(db, e, policy, parentID)
+	if err != nil {
+		return "", err
+	}
+
+	year := time.Now().Year()
+	var counters []models.CodeCounter
+	if err := db.Where("entity = ? AND scope = ?", e.Key, Scope(policy, parentCode, year)).Limit(1).Find(&counters).Error; err != nil {
+		return "", err
+	}
+	var value int64
+	if len(counters) > 0 {
+		value = counters[0].LastValue
+	}
+
+	for {
+		value++
+		code := Format(policy, parentCode, year, value)
+		taken, err := isTaken(db, e, code, uuid.Nil)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return code, nil
+		}
+	}
+}
+
+// Recode gives the records ids new codes in the given order, using their current parent
+func Recode(tx *gorm.DB, entity string, ids []uuid.UUID, updatedBy string, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	e, policy, err := load(tx, entity)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		var parentID *uuid.UUID
+		if e.Parent != "" {
+			var parents []uuid.UUID
+			if err := tx.Table(e.Table).Where("id = ?", id).Pluck(e.ParentColumn, &parents).Error; err != nil {
+				return err
+			}
+			if len(parents) > 0 {
+				parentID = &parents[0]
+			}
+		}
+
+		code, err := allocate(tx, e, policy, parentID, id)
+		if err != nil {
+			return err
+		}
+		err = tx.Table(e.Table).Where("id = ?", id).Updates(map[string]interface{}{
+			e.CodeColumn: code,
+			"updated_at": at,
+			"updated_by": updatedBy,
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func load(tx *gorm.DB, entity string) (Entity, models.CodePolicy, error) {
+	e, ok := FindEntity(entity)
+	if !ok {
+		return Entity{}, models.CodePolicy{}, fmt.Errorf(constants.CodePolicyEntityNotFound, entity)
+	}
+	policy, err := GetPolicy(tx, entity)
+	if err != nil {
+		return Entity{}, models.CodePolicy{}, err
+	}
+	return e, policy, nil
+}
+
+// allocate bumps the counter of the scope until the code is not used by another record.
+// Codes set outside the generator (imports, seeders, legacy sequences) are skipped instead of failing on the unique index.
+func allocate(tx *gorm.DB, e Entity, policy models.CodePolicy, parentID *uuid.UUID, excludeID uuid.UUID) (string, error) {
+	parentCode, err := parentCodeOf(tx, e, policy, parentID)
+	if err != nil {
+		return "", err
+	}
+
+	year := time.Now().Year()
+	scope := Scope(policy, parentCode, year)
+	for {
+		var value int64
+		err := tx.Raw(`
+			INSERT INTO code_counters (entity, scope, last_value, updated_at)
+			VALUES (?, ?, 1, ?)
+			ON CONFLICT (entity, scope) DO UPDATE
+			SET last_value = code_counters.last_value + 1, updated_at = EXCLUDED.updated_at
+			RETURNING last_value
+		`, e.Key, scope, time.Now().UTC()).Scan(&value).Error
+		if err != nil {
+			return "", err
+		}
+
+		code := Format(policy, parentCode, year, value)
+		taken, err := isTaken(tx, e, code, excludeID)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return code, nil
+		}
+	}
+}
+
+// parentCodeOf returns the code of the parent record when the policy inherits it
+func parentCodeOf(tx *gorm.DB, e Entity, policy models.CodePolicy, parentID *uuid.UUID) (string, error) {
+	if !policy.InheritParent || e.Parent == "" {
+		return "", nil
+	}
+	if parentID == nil || *parentID == uuid.Nil {
+		return "", fmt.Errorf(constants.CodePolicyParentRequired, e.Key)
+	}
+
+	parent, _ := FindEntity(e.Parent)
+	var codes []string
+	if err := tx.Table(parent.Table).Where("id = ?", *parentID).Pluck(parent.CodeColumn, &codes).Error; err != nil {
+		return "", err
+	}
+	if len(codes) == 0 {
+		return "", fmt.Errorf(constants.CodePolicyParentNotFound, parent.Key, parentID.String())
+	}
+	return codes[0], nil
+}
+
+// isTaken checks the code against every record, soft-deleted ones included (the code columns are unique)
+func isTaken(tx *gorm.DB, e Entity, code string, excludeID uuid.UUID) (bool, error) {
+	var count int64
+	q := tx.Table(e.Table).Where(e.CodeColumn+" = ?", code)
+	if excludeID != uuid.Nil {
+		q = q.Where("id <> ?", excludeID)
+	}
+	if err := q.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,46 @@
+package codegen
+
+import "github.com/rendyfutsuy/base-go/constants"
+
+// Entity describes where a master data entity keeps its code and which entity is its parent
+type Entity struct {
+	Key          string
+	Table        string
+	CodeColumn   string
+	Parent       string // key of the parent entity, empty when the entity has no parent
+	ParentColumn string // column referencing the parent record
+}
+
+// Entities lists every entity with a generated code.
+// Parents come before their children so codes can be regenerated top down.
+var Entities = []Entity{
+	{Key: constants.CodeEntityGroup, Table: "groups", CodeColumn: "group_code"},
+	{Key: constants.CodeEntitySubGroup, Table: "sub_groups", CodeColumn: "subgroup_code", Parent: constants.CodeEntityGroup, ParentColumn: "groups_id"},
+	{Key: constants.CodeEntityType, Table: "types", CodeColumn: "type_code", Parent: constants.CodeEntitySubGroup, ParentColumn: "subgroup_id"},
+	{Key: constants.CodeEntityBacking, Table: "backings", CodeColumn: "backing_code", Parent: constants.CodeEntityType, ParentColumn: "type_id"},
+	{Key: constants.CodeEntityExpedition, Table: "expeditions", CodeColumn: "expedition_code"},
+	{Key: constants.CodeEntitySupplier, Table: "suppliers", CodeColumn: "supplier_code"},
+	{Key: constants.CodeEntityCustomer, Table: "customers", CodeColumn: "customer_code"},
+	{Key: constants.CodeEntityItem, Table: "items", CodeColumn: "item_code", Parent: constants.CodeEntityBacking, ParentColumn: "backing_id"},
+}
+
+// FindEntity returns the entity registered under key
+func FindEntity(key string) (Entity, bool) {
+	for _, e := range Entities {
+		if e.Key == key {
+			return e, true
+		}
+	}
+	return Entity{}, false
+}
+
+// Children returns the entities whose parent is key
+func Children(key string) []Entity {
+	var children []Entity
+	for _, e := range Entities {
+		if e.Parent == key {
+			children = append(children, e)
+		}
+	}
+	return children
+}
@@ -0,0 +1,99 @@
+// Package i18n selects the locale of a request and the localized values of translated master data fields.
+//
+// The entity tables hold the values in the base locale (constants.TranslationLocaleBase), the other locales
+// live in the translations table. Column returns the SQL expression selecting the translation of a field in
+// the locale of the request, falling back to the base value when there is none.
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rendyfutsuy/base-go/constants"
+)
+
+type localeKey struct{}
+
+// WithLocale returns a context carrying the locale of the request
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// LocaleFromContext returns the locale of the request, the base locale when none was set
+func LocaleFromContext(ctx context.Context) string {
+	if locale, ok := ctx.Value(localeKey{}).(string); ok && locale != "" {
+		return locale
+	}
+	return constants.TranslationLocaleBase
+}
+
+// Supported reports whether translations can be written in the locale
+func Supported(locale string) bool {
+	for _, supported := range constants.TranslationLocales {
+		if supported == locale {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseAcceptLanguage returns the supported locale preferred by an Accept-Language header
+// ("en-US,en;q=0.9,id;q=0.8" -> "en"), the base locale when no supported locale is accepted
+func ParseAcceptLanguage(header string) string {
+	type candidate struct {
+		locale  string
+		quality float64
+	}
+	candidates := make([]candidate, 0)
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.ToLower(strings.TrimSpace(fields[0]))
+		if tag == "" {
+			continue
+		}
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err == nil {
+					quality = q
+				}
+			}
+		}
+		if quality <= 0 {
+			continue
+		}
+		// only the language matters, en-US and en-GB both select en
+		locale := strings.SplitN(tag, "-", 2)[0]
+		candidates = append(candidates, candidate{locale: locale, quality: quality})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+	for _, c := range candidates {
+		if c.locale == constants.TranslationLocaleBase || Supported(c.locale) {
+			return c.locale
+		}
+	}
+	return constants.TranslationLocaleBase
+}
+
+// Column returns the SQL expression of a translated field in the locale of the request: the translation of the
+// entity identified by idColumn, or column when there is none. In the base locale it returns column unchanged.
+// entityType and field are constants and the locale is a supported one, so they are safe to inline.
+func Column(ctx context.Context, entityType, field, idColumn, column string) string {
+	locale := LocaleFromContext(ctx)
+	if !Supported(locale) {
+		return column
+	}
+	return fmt.Sprintf(`COALESCE((SELECT tr.value FROM translations tr WHERE tr.entity_type = '%s' AND tr.entity_id = %s AND tr.field = '%s' AND tr.locale = '%s'), %s)`,
+		entityType, idColumn, field, locale, column)
+}
+
+// NameColumn returns Column for the name field
+func NameColumn(ctx context.Context, entityType, idColumn, column string) string {
+	return Column(ctx, entityType, constants.TranslationFieldName, idColumn, column)
+}
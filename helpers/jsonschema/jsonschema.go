@@ -0,0 +1,444 @@
+// Package jsonschema validates decoded JSON values against a subset of JSON Schema.
+//
+// Supported keywords: type, enum, const, minimum, maximum, exclusiveMinimum, exclusiveMaximum,
+// multipleOf, minLength, maxLength, pattern, format (date, date-time, email, uuid, uri), properties,
+// required, additionalProperties, minProperties, maxProperties, items, minItems, maxItems and uniqueItems.
+// Annotations ($schema, $id, $comment, title, description, default, examples) are ignored.
+// Any other keyword (e.g. $ref or oneOf) is rejected by Compile, so a schema never checks less than it says.
+// Values are the ones produced by encoding/json: nil, bool, float64, string, []interface{} and map[string]interface{}.
+package jsonschema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+)
+
+// Schema is a compiled schema
+type Schema struct {
+	always               *bool // set for the boolean schemas true and false
+	types                []string
+	enum                 []interface{}
+	constValue           interface{}
+	hasConst             bool
+	minimum              *float64
+	maximum              *float64
+	exclusiveMinimum     *float64
+	exclusiveMaximum     *float64
+	multipleOf           *float64
+	minLength            *int
+	maxLength            *int
+	pattern              *regexp.Regexp
+	format               string
+	properties           map[string]*Schema
+	required             []string
+	additionalProperties *Schema
+	minProperties        *int
+	maxProperties        *int
+	items                *Schema
+	minItems             *int
+	maxItems             *int
+	uniqueItems          bool
+}
+
+var annotationKeywords = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "title": true, "description": true, "default": true, "examples": true,
+}
+
+var supportedTypes = map[string]bool{
+	"null": true, "boolean": true, "integer": true, "number": true, "string": true, "array": true, "object": true,
+}
+
+var supportedFormats = map[string]bool{
+	"date": true, "date-time": true, "email": true, "uuid": true, "uri": true,
+}
+
+// Compile parses a JSON schema document
+func Compile(raw []byte) (*Schema, error) {
+	var doc interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	return compile(doc)
+}
+
+func compile(doc interface{}) (*Schema, error) {
+	if b, ok := doc.(bool); ok {
+		return &Schema{always: &b}, nil
+	}
+	m, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("schema must be an object or a boolean")
+	}
+
+	s := &Schema{}
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys) // report the same keyword first on every call
+
+	for _, key := range keys {
+		value := m[key]
+		var err error
+		switch key {
+		case "type":
+			s.types, err = compileTypes(value)
+		case "enum":
+			list, ok := value.([]interface{})
+			if !ok || len(list) == 0 {
+				err = fmt.Errorf(constants.JSONSchemaKeywordInvalid, key)
+			}
+			s.enum = list
+		case "const":
+			s.constValue, s.hasConst = value, true
+		case "minimum":
+			s.minimum, err = compileNumber(key, value)
+		case "maximum":
+			s.maximum, err = compileNumber(key, value)
+		case "exclusiveMinimum":
+			s.exclusiveMinimum, err = compileNumber(key, value)
+		case "exclusiveMaximum":
+			s.exclusiveMaximum, err = compileNumber(key, value)
+		case "multipleOf":
+			s.multipleOf, err = compileNumber(key, value)
+			if err == nil && *s.multipleOf <= 0 {
+				err = fmt.Errorf(constants.JSONSchemaKeywordInvalid, key)
+			}
+		case "minLength":
+			s.minLength, err = compileCount(key, value)
+		case "maxLength":
+			s.maxLength, err = compileCount(key, value)
+		case "minItems":
+			s.minItems, err = compileCount(key, value)
+		case "maxItems":
+			s.maxItems, err = compileCount(key, value)
+		case "minProperties":
+			s.minProperties, err = compileCount(key, value)
+		case "maxProperties":
+			s.maxProperties, err = compileCount(key, value)
+		case "pattern":
+			pattern, ok := value.(string)
+			if !ok {
+				err = fmt.Errorf(constants.JSONSchemaKeywordInvalid, key)
+				break
+			}
+			s.pattern, err = regexp.Compile(pattern)
+		case "format":
+			format, ok := value.(string)
+			if !ok {
+				err = fmt.Errorf(constants.JSONSchemaKeywordInvalid, key)
+			} else if !supportedFormats[format] {
+				err = fmt.Errorf(constants.JSONSchemaFormatNotSupported, format)
+			}
+			s.format = format
+		case "uniqueItems":
+			unique, ok := value.(bool)
+			if !ok {
+				err = fmt.Errorf(constants.JSONSchemaKeywordInvalid, key)
+			}
+			s.uniqueItems = unique
+		case "required":
+			s.required, err = compileStrings(key, value)
+		case "properties":
+			props, ok := value.(map[string]interface{})
+			if !ok {
+				err = fmt.Errorf(constants.JSONSchemaKeywordInvalid, key)
+				break
+			}
+			s.properties = make(map[string]*Schema, len(props))
+			for name, prop := range props {
+				if s.properties[name], err = compile(prop); err != nil {
+					err = fmt.Errorf("properties.%s: %w", name, err)
+					break
+				}
+			}
+		case "additionalProperties":
+			if s.additionalProperties, err = compile(value); err != nil {
+				err = fmt.Errorf("additionalProperties: %w", err)
+			}
+		case "items":
+			if s.items, err = compile(value); err != nil {
+				err = fmt.Errorf("items: %w", err)
+			}
+		default:
+			if !annotationKeywords[key] {
+				err = fmt.Errorf(constants.JSONSchemaKeywordNotSupported, key)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func compileTypes(value interface{}) ([]string, error) {
+	var types []string
+	switch v := value.(type) {
+	case string:
+		types = []string{v}
+	case []interface{}:
+		for _, t := range v {
+			name, ok := t.(string)
+			if !ok {
+				return nil, fmt.Errorf(constants.JSONSchemaKeywordInvalid, "type")
+			}
+			types = append(types, name)
+		}
+	}
+	if len(types) == 0 {
+		return nil, fmt.Errorf(constants.JSONSchemaKeywordInvalid, "type")
+	}
+	for _, t := range types {
+		if !supportedTypes[t] {
+			return nil, fmt.Errorf(constants.JSONSchemaKeywordInvalid, "type")
+		}
+	}
+	return types, nil
+}
+
+func compileNumber(key string, value interface{}) (*float64, error) {
+	n, ok := value.(float64)
+	if !ok {
+		return nil, fmt.Errorf(constants.JSONSchemaKeywordInvalid, key)
+	}
+	return &n, nil
+}
+
+func compileCount(key string, value interface{}) (*int, error) {
+	n, ok := value.(float64)
+	if !ok || n < 0 || n != math.Trunc(n) {
+		return nil, fmt.Errorf(constants.JSONSchemaKeywordInvalid, key)
+	}
+	count := int(n)
+	return &count, nil
+}
+
+func compileStrings(key string, value interface{}) ([]string, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf(constants.JSONSchemaKeywordInvalid, key)
+	}
+	res := make([]string, 0, len(list))
+	for _, entry := range list {
+		s, ok := entry.(string)
+		if !ok {
+			return nil, fmt.Errorf(constants.JSONSchemaKeywordInvalid, key)
+		}
+		res = append(res, s)
+	}
+	return res, nil
+}
+
+// Validate checks a decoded value, path names the value in the error (e.g. "value" gives "value.port must be ...")
+func (s *Schema) Validate(value interface{}, path string) error {
+	if s.always != nil {
+		if *s.always {
+			return nil
+		}
+		return fmt.Errorf(constants.JSONSchemaFalse, path)
+	}
+
+	if len(s.types) > 0 && !matchesAnyType(value, s.types) {
+		return fmt.Errorf(constants.JSONSchemaTypeMismatch, path, strings.Join(s.types, " or "))
+	}
+	if s.enum != nil && !containsValue(s.enum, value) {
+		return fmt.Errorf(constants.JSONSchemaEnumMismatch, path)
+	}
+	if s.hasConst && !reflect.DeepEqual(s.constValue, value) {
+		return fmt.Errorf(constants.JSONSchemaConstMismatch, path)
+	}
+
+	switch v := value.(type) {
+	case float64:
+		return s.validateNumber(v, path)
+	case string:
+		return s.validateString(v, path)
+	case []interface{}:
+		return s.validateArray(v, path)
+	case map[string]interface{}:
+		return s.validateObject(v, path)
+	}
+	return nil
+}
+
+func (s *Schema) validateNumber(v float64, path string) error {
+	if s.minimum != nil && v < *s.minimum {
+		return fmt.Errorf(constants.JSONSchemaMinimum, path, *s.minimum)
+	}
+	if s.maximum != nil && v > *s.maximum {
+		return fmt.Errorf(constants.JSONSchemaMaximum, path, *s.maximum)
+	}
+	if s.exclusiveMinimum != nil && v <= *s.exclusiveMinimum {
+		return fmt.Errorf(constants.JSONSchemaExclusiveMinimum, path, *s.exclusiveMinimum)
+	}
+	if s.exclusiveMaximum != nil && v >= *s.exclusiveMaximum {
+		return fmt.Errorf(constants.JSONSchemaExclusiveMaximum, path, *s.exclusiveMaximum)
+	}
+	if s.multipleOf != nil {
+		quotient := v / *s.multipleOf
+		if math.Abs(quotient-math.Round(quotient)) > 1e-9 {
+			return fmt.Errorf(constants.JSONSchemaMultipleOf, path, *s.multipleOf)
+		}
+	}
+	return nil
+}
+
+func (s *Schema) validateString(v string, path string) error {
+	length := utf8.RuneCountInString(v)
+	if s.minLength != nil && length < *s.minLength {
+		return fmt.Errorf(constants.JSONSchemaMinLength, path, *s.minLength)
+	}
+	if s.maxLength != nil && length > *s.maxLength {
+		return fmt.Errorf(constants.JSONSchemaMaxLength, path, *s.maxLength)
+	}
+	if s.pattern != nil && !s.pattern.MatchString(v) {
+		return fmt.Errorf(constants.JSONSchemaPattern, path, s.pattern.String())
+	}
+	if s.format != "" && !matchesFormat(v, s.format) {
+		return fmt.Errorf(constants.JSONSchemaFormat, path, s.format)
+	}
+	return nil
+}
+
+func (s *Schema) validateArray(v []interface{}, path string) error {
+	if s.minItems != nil && len(v) < *s.minItems {
+		return fmt.Errorf(constants.JSONSchemaMinItems, path, *s.minItems)
+	}
+	if s.maxItems != nil && len(v) > *s.maxItems {
+		return fmt.Errorf(constants.JSONSchemaMaxItems, path, *s.maxItems)
+	}
+	if s.uniqueItems {
+		for i := range v {
+			if containsValue(v[:i], v[i]) {
+				return fmt.Errorf(constants.JSONSchemaUniqueItems, path)
+			}
+		}
+	}
+	if s.items != nil {
+		for i, item := range v {
+			if err := s.items.Validate(item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Schema) validateObject(v map[string]interface{}, path string) error {
+	if s.minProperties != nil && len(v) < *s.minProperties {
+		return fmt.Errorf(constants.JSONSchemaMinProperties, path, *s.minProperties)
+	}
+	if s.maxProperties != nil && len(v) > *s.maxProperties {
+		return fmt.Errorf(constants.JSONSchemaMaxProperties, path, *s.maxProperties)
+	}
+	for _, name := range s.required {
+		if _, ok := v[name]; !ok {
+			return fmt.Errorf(constants.JSONSchemaRequired, path+"."+name)
+		}
+	}
+
+	names := make([]string, 0, len(v))
+	for name := range v {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		propPath := path + "." + name
+		if prop, ok := s.properties[name]; ok {
+			if err := prop.Validate(v[name], propPath); err != nil {
+				return err
+			}
+			continue
+		}
+		if s.additionalProperties == nil {
+			continue
+		}
+		if s.additionalProperties.always != nil && !*s.additionalProperties.always {
+			return fmt.Errorf(constants.JSONSchemaAdditionalProperty, propPath)
+		}
+		if err := s.additionalProperties.Validate(v[name], propPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func matchesAnyType(value interface{}, types []string) bool {
+	for _, t := range types {
+		if matchesType(value, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesType(value interface{}, t string) bool {
+	switch t {
+	case "null":
+		return value == nil
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		n, ok := value.(float64)
+		return ok && n == math.Trunc(n)
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	}
+	return false
+}
+
+func containsValue(list []interface{}, value interface{}) bool {
+	for _, entry := range list {
+		if reflect.DeepEqual(entry, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesFormat(v string, format string) bool {
+	switch format {
+	case "date":
+		_, err := time.Parse("2006-01-02", v)
+		return err == nil
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, v)
+		return err == nil
+	case "email":
+		addr, err := mail.ParseAddress(v)
+		return err == nil && addr.Address == v
+	case "uuid":
+		_, err := uuid.Parse(v)
+		return err == nil
+	case "uri":
+		u, err := url.Parse(v)
+		return err == nil && u.Scheme != ""
+	}
+	return false
+}
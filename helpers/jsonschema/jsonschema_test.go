@@ -0,0 +1,185 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type validateCase struct {
+	name     string
+	value    string // JSON document
+	expected string // error message, empty when valid
+}
+
+// runValidateCases compiles the schema and validates each JSON value against it
+func runValidateCases(t *testing.T, schema string, tests []validateCase) {
+	t.Helper()
+
+	s, err := Compile([]byte(schema))
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var value interface{}
+			require.NoError(t, json.Unmarshal([]byte(tt.value), &value))
+
+			err := s.Validate(value, "value")
+			if tt.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		schema   string
+		expected string
+	}{
+		{`{"type": "string", "title": "Name", "$comment": "annotations are ignored"}`, ""},
+		{`true`, ""},
+		{`{"$ref": "#/definitions/a"}`, fmt.Sprintf(constants.JSONSchemaKeywordNotSupported, "$ref")},
+		{`{"oneOf": []}`, fmt.Sprintf(constants.JSONSchemaKeywordNotSupported, "oneOf")},
+		{`{"type": "decimal"}`, fmt.Sprintf(constants.JSONSchemaKeywordInvalid, "type")},
+		{`{"format": "ipv4"}`, fmt.Sprintf(constants.JSONSchemaFormatNotSupported, "ipv4")},
+		{`{"multipleOf": 0}`, fmt.Sprintf(constants.JSONSchemaKeywordInvalid, "multipleOf")},
+		{`{"minLength": 1.5}`, fmt.Sprintf(constants.JSONSchemaKeywordInvalid, "minLength")},
+		{`{"pattern": "("}`, "error parsing regexp: missing closing ): `(`"},
+		{`{"required": [1]}`, fmt.Sprintf(constants.JSONSchemaKeywordInvalid, "required")},
+		{`{"properties": {"port": {"type": 1}}}`, "properties.port: " + fmt.Sprintf(constants.JSONSchemaKeywordInvalid, "type")},
+		{`{"items": {"uniqueItems": "yes"}}`, "items: " + fmt.Sprintf(constants.JSONSchemaKeywordInvalid, "uniqueItems")},
+		{`"string"`, "schema must be an object or a boolean"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schema, func(t *testing.T) {
+			_, err := Compile([]byte(tt.schema))
+			if tt.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func TestValidatePattern(t *testing.T) {
+	runValidateCases(t, `{"type": "string", "pattern": "^[A-Z]{3}-\\d{2}$"}`, []validateCase{
+		{"match", `"ABC-12"`, ""},
+		{"no match", `"abc-12"`, fmt.Sprintf(constants.JSONSchemaPattern, "value", `^[A-Z]{3}-\d{2}$`)},
+		{"wrong type", `12`, fmt.Sprintf(constants.JSONSchemaTypeMismatch, "value", "string")},
+	})
+
+	// a pattern is not anchored unless it says so
+	runValidateCases(t, `{"pattern": "\\d"}`, []validateCase{
+		{"contains a digit", `"room 5"`, ""},
+		{"no digit", `"room"`, fmt.Sprintf(constants.JSONSchemaPattern, "value", `\d`)},
+		{"not a string", `true`, ""},
+	})
+}
+
+func TestValidateFormat(t *testing.T) {
+	tests := []struct {
+		format  string
+		valid   string
+		invalid string
+	}{
+		{"date", `"2026-02-28"`, `"2026-02-30"`},
+		{"date-time", `"2026-02-28T10:00:00+07:00"`, `"2026-02-28 10:00:00"`},
+		{"email", `"john@example.com"`, `"John <john@example.com>"`},
+		{"uuid", `"0b9d3c1e-6f1a-4a57-9c1e-2f6d1b5e8a10"`, `"0b9d3c1e"`},
+		{"uri", `"https://example.com/a?b=c"`, `"example.com/a"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			runValidateCases(t, fmt.Sprintf(`{"format": %q}`, tt.format), []validateCase{
+				{"valid", tt.valid, ""},
+				{"invalid", tt.invalid, fmt.Sprintf(constants.JSONSchemaFormat, "value", tt.format)},
+			})
+		})
+	}
+}
+
+func TestValidateObject(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"host": {"type": "string", "minLength": 1},
+			"port": {"type": "integer", "minimum": 1, "maximum": 65535}
+		},
+		"required": ["host", "port"],
+		"additionalProperties": false
+	}`
+
+	runValidateCases(t, schema, []validateCase{
+		{"valid", `{"host": "localhost", "port": 5432}`, ""},
+		{"missing required", `{"host": "localhost"}`, fmt.Sprintf(constants.JSONSchemaRequired, "value.port")},
+		{"invalid property", `{"host": "localhost", "port": 70000}`, fmt.Sprintf(constants.JSONSchemaMaximum, "value.port", 65535)},
+		{"non integer property", `{"host": "localhost", "port": 1.5}`, fmt.Sprintf(constants.JSONSchemaTypeMismatch, "value.port", "integer")},
+		{"additional property", `{"host": "localhost", "port": 5432, "user": "admin"}`, fmt.Sprintf(constants.JSONSchemaAdditionalProperty, "value.user")},
+		{"not an object", `[]`, fmt.Sprintf(constants.JSONSchemaTypeMismatch, "value", "object")},
+	})
+
+	// additionalProperties as a schema checks the extra properties only
+	runValidateCases(t, `{"properties": {"name": {"type": "string"}}, "additionalProperties": {"type": "number"}, "maxProperties": 3}`, []validateCase{
+		{"numeric extras", `{"name": "a", "width": 2, "height": 3}`, ""},
+		{"non numeric extra", `{"name": "a", "color": "red"}`, fmt.Sprintf(constants.JSONSchemaTypeMismatch, "value.color", "number")},
+		{"too many properties", `{"name": "a", "w": 1, "h": 2, "d": 3}`, fmt.Sprintf(constants.JSONSchemaMaxProperties, "value", 3)},
+	})
+}
+
+func TestValidateArray(t *testing.T) {
+	schema := `{
+		"type": "array",
+		"items": {"type": "string", "enum": ["red", "green", "blue"]},
+		"minItems": 1,
+		"maxItems": 3,
+		"uniqueItems": true
+	}`
+
+	runValidateCases(t, schema, []validateCase{
+		{"valid", `["red", "blue"]`, ""},
+		{"empty", `[]`, fmt.Sprintf(constants.JSONSchemaMinItems, "value", 1)},
+		{"too many", `["red", "green", "blue", "red"]`, fmt.Sprintf(constants.JSONSchemaMaxItems, "value", 3)},
+		{"duplicated", `["red", "red"]`, fmt.Sprintf(constants.JSONSchemaUniqueItems, "value")},
+		{"invalid item", `["red", "pink"]`, fmt.Sprintf(constants.JSONSchemaEnumMismatch, "value[1]")},
+	})
+
+	// uniqueItems compares objects and numbers by value
+	runValidateCases(t, `{"uniqueItems": true}`, []validateCase{
+		{"distinct objects", `[{"a": 1}, {"a": 2}]`, ""},
+		{"equal objects", `[{"a": 1, "b": [1]}, {"b": [1], "a": 1}]`, fmt.Sprintf(constants.JSONSchemaUniqueItems, "value")},
+		{"equal numbers", `[1, 1.0]`, fmt.Sprintf(constants.JSONSchemaUniqueItems, "value")},
+	})
+}
+
+func TestValidateMultipleOf(t *testing.T) {
+	runValidateCases(t, `{"type": "number", "multipleOf": 0.01}`, []validateCase{
+		{"cents", `19.99`, ""},
+		{"integer", `20`, ""},
+		{"binary fraction error is tolerated", `0.3`, ""},
+		{"sub cent", `19.995`, fmt.Sprintf(constants.JSONSchemaMultipleOf, "value", 0.01)},
+	})
+
+	runValidateCases(t, `{"type": "integer", "multipleOf": 5, "exclusiveMinimum": 0, "exclusiveMaximum": 100}`, []validateCase{
+		{"multiple", `15`, ""},
+		{"not a multiple", `12`, fmt.Sprintf(constants.JSONSchemaMultipleOf, "value", 5)},
+		{"exclusive minimum", `0`, fmt.Sprintf(constants.JSONSchemaExclusiveMinimum, "value", 0)},
+		{"exclusive maximum", `100`, fmt.Sprintf(constants.JSONSchemaExclusiveMaximum, "value", 100)},
+	})
+}
+
+func TestValidateBooleanSchema(t *testing.T) {
+	runValidateCases(t, `{"properties": {"legacy": false}}`, []validateCase{
+		{"absent", `{}`, ""},
+		{"present", `{"legacy": 1}`, fmt.Sprintf(constants.JSONSchemaFalse, "value.legacy")},
+	})
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/i18n"
+)
+
+// Locale selects the locale of a read request from its Accept-Language header, so index and detail responses
+// show the translated names of master data. Writes always work on the base values.
+func Locale(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			return next(c)
+		}
+		locale := i18n.ParseAcceptLanguage(req.Header.Get(constants.FieldAcceptLanguage))
+		c.SetRequest(req.WithContext(i18n.WithLocale(req.Context(), locale)))
+		c.Response().Header().Set(constants.FieldContentLanguage, locale)
+		c.Response().Header().Add(echo.HeaderVary, constants.FieldAcceptLanguage)
+		return next(c)
+	}
+}
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected string
+	}{
+		{"", constants.TranslationLocaleBase},
+		{"en", constants.TranslationLocaleEnglish},
+		{"en-US,en;q=0.9", constants.TranslationLocaleEnglish},
+		{"id-ID,id;q=0.9,en;q=0.8", constants.TranslationLocaleBase},
+		{"fr;q=1.0,en;q=0.5", constants.TranslationLocaleEnglish},
+		{"id;q=0.4,en-GB;q=0.7", constants.TranslationLocaleEnglish},
+		{"en;q=0,fr", constants.TranslationLocaleBase},
+		{"*", constants.TranslationLocaleBase},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			assert.Equal(t, tt.expected, i18n.ParseAcceptLanguage(tt.header))
+		})
+	}
+}
+
+func TestLocaleMiddleware_GetSelectsLocale(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(constants.FieldAcceptLanguage, "en-US,en;q=0.9")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	var locale string
+	h := Locale(func(c echo.Context) error {
+		locale = i18n.LocaleFromContext(c.Request().Context())
+		return c.String(http.StatusOK, "test")
+	})
+
+	assert.NoError(t, h(c))
+	assert.Equal(t, constants.TranslationLocaleEnglish, locale)
+	assert.Equal(t, constants.TranslationLocaleEnglish, rec.Header().Get(constants.FieldContentLanguage))
+	assert.Contains(t, rec.Header().Values(echo.HeaderVary), constants.FieldAcceptLanguage)
+}
+
+func TestLocaleMiddleware_WriteKeepsBaseLocale(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPut, "/", nil)
+	req.Header.Set(constants.FieldAcceptLanguage, "en")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	var locale string
+	h := Locale(func(c echo.Context) error {
+		locale = i18n.LocaleFromContext(c.Request().Context())
+		return c.String(http.StatusOK, "test")
+	})
+
+	assert.NoError(t, h(c))
+	assert.Equal(t, constants.TranslationLocaleBase, locale)
+	assert.Empty(t, rec.Header().Get(constants.FieldContentLanguage))
+}
+
+func TestI18nNameColumn(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "gg.name", i18n.NameColumn(ctx, constants.TranslationEntityGroup, "gg.id", "gg.name"))
+
+	ctx = i18n.WithLocale(ctx, constants.TranslationLocaleEnglish)
+	assert.Equal(t,
+		`COALESCE((SELECT tr.value FROM translations tr WHERE tr.entity_type = 'group' AND tr.entity_id = gg.id AND tr.field = 'name' AND tr.locale = 'en'), gg.name)`,
+		i18n.NameColumn(ctx, constants.TranslationEntityGroup, "gg.id", "gg.name"))
+}
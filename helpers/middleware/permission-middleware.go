@@ -69,6 +69,9 @@ func (a *MiddlewarePermission) PermissionValidation(args []string) echo.Middlewa
 				return c.JSON(http.StatusForbidden, response.SetErrorResponse(http.StatusForbidden, "Forbidden: Insufficient permissions"))
 			}
 
+			// keep the permissions for the handlers whose behaviour depends on them, see HasPermission
+			c.Set("permissions", permissions)
+
 			return next(c)
 		}
 	}
@@ -110,3 +113,14 @@ func (a *MiddlewarePermission) assertUserHaveRequiredPermissions(userPermissions
 	}
 	return false
 }
+
+// HasPermission reports whether the user of the request has the permission, only set behind PermissionValidation
+func HasPermission(c echo.Context, permission string) bool {
+	permissions, _ := c.Get("permissions").([]string)
+	for _, p := range permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,165 @@
+// Package paramvalue checks parameter values against their type in the parameter type registry (parameter_types).
+//
+// Values are stored as text. Normalize parses a value with the value kind of its type and returns the
+// canonical text ("007" -> "7" for int, "YES" -> "true" for bool, compact JSON for json, ...) so a value
+// has a single spelling. When the type has a JSON Schema, the parsed value (number, boolean, string or
+// JSON document) must match it.
+package paramvalue
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/jsonschema"
+	"github.com/rendyfutsuy/base-go/models"
+)
+
+var decimalRegex = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)$`)
+
+// ValidKind reports whether kind is a known value kind
+func ValidKind(kind string) bool {
+	for _, k := range constants.ParameterValueKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// CompileSchema returns the compiled JSON Schema of a type, nil when the type has none
+func CompileSchema(pt models.ParameterType) (*jsonschema.Schema, error) {
+	if !pt.JSONSchema.Valid || len(pt.JSONSchema.JsonRawMessage) == 0 || string(pt.JSONSchema.JsonRawMessage) == "null" {
+		return nil, nil
+	}
+	return jsonschema.Compile(pt.JSONSchema.JsonRawMessage)
+}
+
+// Normalize checks value against the parameter type and returns its canonical text, nil when the value is empty
+func Normalize(pt models.ParameterType, value *string) (*string, error) {
+	raw := ""
+	if value != nil {
+		raw = *value
+	}
+	if pt.ValueKind != constants.ParameterValueKindString {
+		raw = strings.TrimSpace(raw)
+	}
+	if raw == "" {
+		if pt.ValueRequired {
+			return nil, fmt.Errorf(constants.ParameterValueRequired, pt.Code)
+		}
+		return nil, nil
+	}
+
+	canonical, typed, err := parse(pt, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := CompileSchema(pt)
+	if err != nil {
+		return nil, fmt.Errorf(constants.ParameterTypeSchemaInvalid, err)
+	}
+	if schema != nil {
+		if err := schema.Validate(typed, "value"); err != nil {
+			return nil, fmt.Errorf(constants.ParameterValueSchemaFailed, pt.Code, err)
+		}
+	}
+	return &canonical, nil
+}
+
+// parse returns the canonical text of a value and the value as decoded JSON for the schema
+func parse(pt models.ParameterType, raw string) (string, interface{}, error) {
+	switch pt.ValueKind {
+	case constants.ParameterValueKindInt:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return "", nil, fmt.Errorf(constants.ParameterValueInvalidInt, pt.Code)
+		}
+		return strconv.FormatInt(n, 10), float64(n), nil
+	case constants.ParameterValueKindDecimal:
+		if !decimalRegex.MatchString(raw) {
+			return "", nil, fmt.Errorf(constants.ParameterValueInvalidDec, pt.Code)
+		}
+		// the schema works on float64, the stored text keeps every digit
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return "", nil, fmt.Errorf(constants.ParameterValueInvalidDec, pt.Code)
+		}
+		return canonicalDecimal(raw), f, nil
+	case constants.ParameterValueKindBool:
+		switch strings.ToLower(raw) {
+		case "true", "1", "yes":
+			return "true", true, nil
+		case "false", "0", "no":
+			return "false", false, nil
+		}
+		return "", nil, fmt.Errorf(constants.ParameterValueInvalidBool, pt.Code)
+	case constants.ParameterValueKindDate:
+		d, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			return "", nil, fmt.Errorf(constants.ParameterValueInvalidDate, pt.Code)
+		}
+		canonical := d.Format("2006-01-02")
+		return canonical, canonical, nil
+	case constants.ParameterValueKindEnum:
+		for _, allowed := range pt.EnumValues.Strings {
+			if strings.EqualFold(allowed, raw) {
+				return allowed, allowed, nil
+			}
+		}
+		return "", nil, fmt.Errorf(constants.ParameterValueInvalidEnum, strings.Join(pt.EnumValues.Strings, ", "), pt.Code)
+	case constants.ParameterValueKindJSON:
+		var doc interface{}
+		if err := json.Unmarshal([]byte(raw), &doc); err != nil {
+			return "", nil, fmt.Errorf(constants.ParameterValueInvalidJSON, pt.Code)
+		}
+		buf := new(bytes.Buffer)
+		if err := json.Compact(buf, []byte(raw)); err != nil {
+			return "", nil, fmt.Errorf(constants.ParameterValueInvalidJSON, pt.Code)
+		}
+		return buf.String(), doc, nil
+	}
+	return raw, raw, nil
+}
+
+// canonicalDecimal spells a decimal matching decimalRegex without "+" sign, leading zeros of the integer part
+// and trailing zeros of the fraction ("+007.50" -> "7.5", "-0.0" -> "0"), without going through float64
+func canonicalDecimal(raw string) string {
+	negative := strings.HasPrefix(raw, "-")
+	raw = strings.TrimLeft(raw, "+-")
+
+	integer, fraction, _ := strings.Cut(raw, ".")
+	integer = strings.TrimLeft(integer, "0")
+	fraction = strings.TrimRight(fraction, "0")
+	if integer == "" {
+		integer = "0"
+	}
+
+	canonical := integer
+	if fraction != "" {
+		canonical += "." + fraction
+	}
+	if negative && canonical != "0" {
+		canonical = "-" + canonical
+	}
+	return canonical
+}
+
+// ParentAllowed reports whether a parameter of type pt may have a parent of type parentType
+func ParentAllowed(pt models.ParameterType, parentType *string) bool {
+	if parentType == nil {
+		return false
+	}
+	for _, allowed := range pt.AllowedParentTypes.Strings {
+		if allowed == *parentType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package paramvalue
+
+import (
+	"testing"
+
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeDecimal(t *testing.T) {
+	pt := models.ParameterType{Code: "rate", ValueKind: constants.ParameterValueKindDecimal}
+
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{"1.50", "1.5"},
+		{"+007.250", "7.25"},
+		{"-0012", "-12"},
+		{".5", "0.5"},
+		{"5.", "5"},
+		{"-0.000", "0"},
+		{"000", "0"},
+		// past the 15-16 significant digits of float64
+		{"12345678901234567890.123456789012345678", "12345678901234567890.123456789012345678"},
+		{"0.10000000000000000000001", "0.10000000000000000000001"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			value := tt.value
+			res, err := Normalize(pt, &value)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, *res)
+		})
+	}
+
+	for _, value := range []string{"1e5", "1.2.3", "abc", "-", "."} {
+		t.Run("invalid "+value, func(t *testing.T) {
+			v := value
+			_, err := Normalize(pt, &v)
+
+			assert.Error(t, err)
+		})
+	}
+}
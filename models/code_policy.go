@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+)
+
+// CodePolicy represents code_policies table (code pattern of one master data entity)
+type CodePolicy struct {
+	Entity        string    `gorm:"column:entity;type:varchar(50);primary_key" json:"entity"`
+	Prefix        string    `gorm:"column:prefix;type:varchar(20);not null" json:"prefix"`
+	Separator     string    `gorm:"column:separator;type:varchar(5);not null" json:"separator"`
+	Padding       int       `gorm:"column:padding;not null" json:"padding"`
+	InheritParent bool      `gorm:"column:inherit_parent;not null" json:"inherit_parent"` // prepend the parent code, e.g. GG.SS.TTT
+	YearlyReset   bool      `gorm:"column:yearly_reset;not null" json:"yearly_reset"`     // add the year and restart numbering every year
+	UpdatedAt     time.Time `gorm:"column:updated_at;not null" json:"updated_at"`
+	UpdatedBy     string    `gorm:"column:updated_by;type:varchar(255)" json:"updated_by"`
+}
+
+func (CodePolicy) TableName() string {
+	return "code_policies"
+}
+
+// CodeCounter represents code_counters table (last allocated number per entity and scope)
+type CodeCounter struct {
+	Entity    string    `gorm:"column:entity;type:varchar(50);primary_key" json:"entity"`
+	Scope     string    `gorm:"column:scope;type:varchar(255);primary_key" json:"scope"` // parent code and / or year the numbering restarts on
+	LastValue int64     `gorm:"column:last_value;not null" json:"last_value"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null" json:"updated_at"`
+}
+
+func (CodeCounter) TableName() string {
+	return "code_counters"
+}
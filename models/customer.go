@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Customer represents customers table
+type Customer struct {
+	ID                 uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id" validate:"required"`
+	CustomerCode       string         `gorm:"column:customer_code;type:varchar(255);unique;not null" json:"customer_code"`
+	CustomerName       string         `gorm:"column:customer_name;type:varchar(255)" json:"customer_name"`
+	CustomerCategoryID *uuid.UUID     `gorm:"column:customer_category_id;type:uuid" json:"customer_category_id"`
+	ExpeditionSendID   *uuid.UUID     `gorm:"column:expedition_send_id;type:uuid" json:"expedition_send_id"`
+	PaymentTermDays    int            `gorm:"column:payment_term_days;not null;default:0" json:"payment_term_days"`
+	CreditLimit        float64        `gorm:"column:credit_limit;type:numeric(18,2);not null;default:0" json:"credit_limit"`
+	Notes              *string        `gorm:"column:notes;type:text" json:"notes"`
+	CreatedAt          time.Time      `gorm:"column:created_at;not null" json:"created_at"`
+	CreatedBy          string         `gorm:"column:created_by;type:varchar(255)" json:"created_by"`
+	UpdatedAt          time.Time      `gorm:"column:updated_at;not null" json:"updated_at"`
+	UpdatedBy          string         `gorm:"column:updated_by;type:varchar(255)" json:"updated_by"`
+	DeletedAt          gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at"`
+	DeletedBy          *string        `gorm:"column:deleted_by;type:varchar(255)" json:"deleted_by"`
+
+	// Fetched mutators for category and expedition names (from joins)
+	CustomerCategoryName *string `gorm:"column:customer_category_name;<-:false" json:"customer_category_name"`
+	ExpeditionSendName   *string `gorm:"column:expedition_send_name;<-:false" json:"expedition_send_name"`
+
+	// Fetched mutators for primary shipping city and primary contacts (from joins)
+	ShippingCityName   *string `gorm:"column:shipping_city_name;<-:false" json:"shipping_city_name"`
+	PrimaryTelpNumber  *string `gorm:"column:primary_telp_number;<-:false" json:"primary_telp_number"`
+	PrimaryPhoneNumber *string `gorm:"column:primary_phone_number;<-:false" json:"primary_phone_number"`
+}
+
+func (Customer) TableName() string {
+	return "customers"
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CustomerAddress represents customer_addresses table
+type CustomerAddress struct {
+	ID            uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id" validate:"required"`
+	CustomerID    uuid.UUID      `gorm:"column:customer_id;type:uuid;not null" json:"customer_id" validate:"required"`
+	AddressType   string         `gorm:"column:address_type;type:varchar(50);not null" json:"address_type" validate:"required"` // billing / shipping
+	Address       string         `gorm:"column:address;type:varchar(255);not null" json:"address" validate:"required"`
+	ProvinceID    *uuid.UUID     `gorm:"column:province_id;type:uuid" json:"province_id"`
+	CityID        *uuid.UUID     `gorm:"column:city_id;type:uuid" json:"city_id"`
+	DistrictID    *uuid.UUID     `gorm:"column:district_id;type:uuid" json:"district_id"`
+	SubdistrictID *uuid.UUID     `gorm:"column:subdistrict_id;type:uuid" json:"subdistrict_id"`
+	PostalCode    *string        `gorm:"column:postal_code;type:varchar(10)" json:"postal_code"`
+	IsPrimary     bool           `gorm:"column:is_primary;default:false" json:"is_primary"`
+	CreatedAt     time.Time      `gorm:"column:created_at;not null" json:"created_at"`
+	CreatedBy     string         `gorm:"column:created_by;type:varchar(255)" json:"created_by"`
+	UpdatedAt     time.Time      `gorm:"column:updated_at;not null" json:"updated_at"`
+	UpdatedBy     string         `gorm:"column:updated_by;type:varchar(255)" json:"updated_by"`
+	DeletedAt     gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at"`
+	DeletedBy     *string        `gorm:"column:deleted_by;type:varchar(255)" json:"deleted_by"`
+
+	// Fetched mutators for region names (from joins)
+	ProvinceName    *string `gorm:"column:province_name;<-:false" json:"province_name"`
+	CityName        *string `gorm:"column:city_name;<-:false" json:"city_name"`
+	DistrictName    *string `gorm:"column:district_name;<-:false" json:"district_name"`
+	SubdistrictName *string `gorm:"column:subdistrict_name;<-:false" json:"subdistrict_name"`
+}
+
+func (CustomerAddress) TableName() string {
+	return "customer_addresses"
+}
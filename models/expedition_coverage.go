@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ExpeditionCoverage represents expedition_coverages table
+type ExpeditionCoverage struct {
+	ID           uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id" validate:"required"`
+	ExpeditionID uuid.UUID      `gorm:"column:expedition_id;type:uuid;not null" json:"expedition_id" validate:"required"`
+	CityID       uuid.UUID      `gorm:"column:city_id;type:uuid;not null" json:"city_id" validate:"required"`
+	DistrictID   *uuid.UUID     `gorm:"column:district_id;type:uuid" json:"district_id"` // NULL means the whole city
+	CreatedAt    time.Time      `gorm:"column:created_at;not null" json:"created_at"`
+	CreatedBy    string         `gorm:"column:created_by;type:varchar(255)" json:"created_by"`
+	UpdatedAt    time.Time      `gorm:"column:updated_at;not null" json:"updated_at"`
+	UpdatedBy    string         `gorm:"column:updated_by;type:varchar(255)" json:"updated_by"`
+	DeletedAt    gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at"`
+	DeletedBy    *string        `gorm:"column:deleted_by;type:varchar(255)" json:"deleted_by"`
+
+	// Fetched mutators (from joins)
+	CityCode     *string `gorm:"column:city_code;<-:false" json:"city_code"`
+	CityName     *string `gorm:"column:city_name;<-:false" json:"city_name"`
+	DistrictCode *string `gorm:"column:district_code;<-:false" json:"district_code"`
+	DistrictName *string `gorm:"column:district_name;<-:false" json:"district_name"`
+}
+
+func (ExpeditionCoverage) TableName() string {
+	return "expedition_coverages"
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ExpeditionTariff represents expedition_tariffs table
+type ExpeditionTariff struct {
+	ID                uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id" validate:"required"`
+	ExpeditionID      uuid.UUID      `gorm:"column:expedition_id;type:uuid;not null" json:"expedition_id" validate:"required"`
+	OriginCityID      uuid.UUID      `gorm:"column:origin_city_id;type:uuid;not null" json:"origin_city_id" validate:"required"`
+	DestinationCityID uuid.UUID      `gorm:"column:destination_city_id;type:uuid;not null" json:"destination_city_id" validate:"required"`
+	PricePerKg        float64        `gorm:"column:price_per_kg;type:numeric(18,2);not null;default:0" json:"price_per_kg"`
+	MinWeight         float64        `gorm:"column:min_weight;type:numeric(10,2);not null;default:0" json:"min_weight"`
+	LeadTimeDays      int            `gorm:"column:lead_time_days;not null;default:0" json:"lead_time_days"`
+	CreatedAt         time.Time      `gorm:"column:created_at;not null" json:"created_at"`
+	CreatedBy         string         `gorm:"column:created_by;type:varchar(255)" json:"created_by"`
+	UpdatedAt         time.Time      `gorm:"column:updated_at;not null" json:"updated_at"`
+	UpdatedBy         string         `gorm:"column:updated_by;type:varchar(255)" json:"updated_by"`
+	DeletedAt         gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at"`
+	DeletedBy         *string        `gorm:"column:deleted_by;type:varchar(255)" json:"deleted_by"`
+
+	// Fetched mutators (from joins)
+	OriginCityCode      *string `gorm:"column:origin_city_code;<-:false" json:"origin_city_code"`
+	OriginCityName      *string `gorm:"column:origin_city_name;<-:false" json:"origin_city_name"`
+	DestinationCityCode *string `gorm:"column:destination_city_code;<-:false" json:"destination_city_code"`
+	DestinationCityName *string `gorm:"column:destination_city_name;<-:false" json:"destination_city_name"`
+}
+
+func (ExpeditionTariff) TableName() string {
+	return "expedition_tariffs"
+}
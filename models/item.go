@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Item represents items table, a SKU of a backing
+type Item struct {
+	ID        uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id" validate:"required"`
+	ItemCode  string         `gorm:"column:item_code;type:varchar(255);unique;not null" json:"item_code"`
+	Name      string         `gorm:"column:name;type:varchar(255);not null" json:"name" validate:"required"`
+	BackingID uuid.UUID      `gorm:"column:backing_id;type:uuid;not null" json:"backing_id" validate:"required"`
+	BaseUom   string         `gorm:"column:base_uom;type:varchar(20);not null" json:"base_uom"`
+	Length    *float64       `gorm:"column:length;type:numeric(18,2)" json:"length"`
+	Width     *float64       `gorm:"column:width;type:numeric(18,2)" json:"width"`
+	Height    *float64       `gorm:"column:height;type:numeric(18,2)" json:"height"`
+	Weight    *float64       `gorm:"column:weight;type:numeric(18,3)" json:"weight"`
+	IsActive  bool           `gorm:"column:is_active;not null" json:"is_active"`
+	CreatedAt time.Time      `gorm:"column:created_at;not null" json:"created_at"`
+	CreatedBy string         `gorm:"column:created_by;type:varchar(255)" json:"created_by"`
+	UpdatedAt time.Time      `gorm:"column:updated_at;not null" json:"updated_at"`
+	UpdatedBy string         `gorm:"column:updated_by;type:varchar(255)" json:"updated_by"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at"`
+	DeletedBy *string        `gorm:"column:deleted_by;type:varchar(255)" json:"deleted_by"`
+
+	// Read-only fields from join (not stored in database), inherited from the backing
+	BackingCode  string    `gorm:"column:backing_code;<-:false" json:"backing_code"`
+	BackingName  string    `gorm:"column:backing_name;<-:false" json:"backing_name"`
+	TypeID       uuid.UUID `gorm:"column:type_id;<-:false" json:"type_id"`
+	TypeName     string    `gorm:"column:type_name;<-:false" json:"type_name"`
+	SubgroupID   uuid.UUID `gorm:"column:subgroup_id;<-:false" json:"subgroup_id"`
+	SubgroupName string    `gorm:"column:subgroup_name;<-:false" json:"subgroup_name"`
+	GroupID      uuid.UUID `gorm:"column:groups_id;<-:false" json:"groups_id"`
+	GroupName    string    `gorm:"column:group_name;<-:false" json:"group_name"`
+}
+
+func (Item) TableName() string {
+	return "items"
+}
+
+// ItemUnit represents item_units table, a unit of measure the item is sold or stored in besides its base unit
+type ItemUnit struct {
+	ID         uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id"`
+	ItemID     uuid.UUID `gorm:"column:item_id;type:uuid;not null" json:"item_id"`
+	Uom        string    `gorm:"column:uom;type:varchar(20);not null" json:"uom"`
+	Conversion float64   `gorm:"column:conversion;type:numeric(18,4);not null" json:"conversion"` // number of base units in one unit
+	CreatedAt  time.Time `gorm:"column:created_at;not null" json:"created_at"`
+	CreatedBy  string    `gorm:"column:created_by;type:varchar(255)" json:"created_by"`
+}
+
+func (ItemUnit) TableName() string {
+	return "item_units"
+}
+
+// ItemBarcode represents item_barcodes table
+type ItemBarcode struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id"`
+	ItemID    uuid.UUID `gorm:"column:item_id;type:uuid;not null" json:"item_id"`
+	Barcode   string    `gorm:"column:barcode;type:varchar(100);not null" json:"barcode"`
+	Uom       *string   `gorm:"column:uom;type:varchar(20)" json:"uom"` // unit the barcode is printed on, empty for the base unit
+	CreatedAt time.Time `gorm:"column:created_at;not null" json:"created_at"`
+	CreatedBy string    `gorm:"column:created_by;type:varchar(255)" json:"created_by"`
+}
+
+func (ItemBarcode) TableName() string {
+	return "item_barcodes"
+}
+
+// ItemImage is an image file assigned to an item through files_to_module
+type ItemImage struct {
+	FileID    uuid.UUID `gorm:"column:file_id" json:"file_id"`
+	Name      string    `gorm:"column:name" json:"name"`
+	FilePath  *string   `gorm:"column:file_path" json:"file_path"`
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+}
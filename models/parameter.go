@@ -12,14 +12,17 @@ type Parameter struct {
 	ID          uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id" validate:"required"`
 	Code        string         `gorm:"column:code;type:varchar(255);unique;not null" json:"code"`
 	Name        string         `gorm:"column:name;type:varchar(255);not null" json:"name" validate:"required"`
-	Value       *string        `gorm:"column:value;type:varchar(255)" json:"value"`
+	Value       *string        `gorm:"column:value;type:text" json:"value"`
 	Type        *string        `gorm:"column:type;type:varchar(255)" json:"type"`
 	Description *string        `gorm:"column:description;type:text" json:"desc"`
 	CreatedAt   time.Time      `gorm:"column:created_at;not null" json:"created_at"`
 	UpdatedAt   time.Time      `gorm:"column:updated_at;not null" json:"updated_at"`
 	DeletedAt   gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at"`
-	ParentID    uuid.UUID      `gorm:"column:parent_id;type:uuid" json:"parent_id"`
+	ParentID    uuid.UUID      `gorm:"column:parent_id;type:uuid" json:"parent_id"` // uuid.Nil when the parameter has no parent (NULL)
+	SortOrder   int            `gorm:"column:sort_order;not null;default:0" json:"sort_order"`
 	ParentName  string         `gorm:"column:parent_name;<-:false" json:"parent_name"`
+	ParentCode  string         `gorm:"column:parent_code;<-:false" json:"parent_code"`
+	ValueKind   string         `gorm:"column:value_kind;<-:false" json:"value_kind"` // value kind of the parameter type
 	Deletable   bool           `gorm:"column:deletable;<-:false" json:"deletable"`
 }
 
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/rendyfutsuy/base-go/utils"
+)
+
+// ParameterType represents parameter_types table (registry of the values of parameters.type)
+type ParameterType struct {
+	Code               string                   `gorm:"column:code;type:varchar(255);primary_key" json:"code"`
+	Name               string                   `gorm:"column:name;type:varchar(255);not null" json:"name"`
+	ValueKind          string                   `gorm:"column:value_kind;type:varchar(20);not null" json:"value_kind"` // string, int, decimal, bool, date, enum or json
+	ValueRequired      bool                     `gorm:"column:value_required;not null" json:"value_required"`
+	EnumValues         utils.NullStringArray    `gorm:"column:enum_values;type:jsonb" json:"enum_values"`                            // allowed values of an enum type
+	JSONSchema         utils.NullJSONRawMessage `gorm:"column:json_schema;type:jsonb" json:"json_schema"`                            // optional schema the typed value must match
+	AllowedParentTypes utils.NullStringArray    `gorm:"column:allowed_parent_types;type:jsonb;not null" json:"allowed_parent_types"` // empty when the parameter has no parent
+	Description        *string                  `gorm:"column:description;type:text" json:"description"`
+	CreatedAt          time.Time                `gorm:"column:created_at;not null" json:"created_at"`
+	CreatedBy          string                   `gorm:"column:created_by;type:varchar(255)" json:"created_by"`
+	UpdatedAt          time.Time                `gorm:"column:updated_at;not null" json:"updated_at"`
+	UpdatedBy          string                   `gorm:"column:updated_by;type:varchar(255)" json:"updated_by"`
+}
+
+func (ParameterType) TableName() string {
+	return "parameter_types"
+}
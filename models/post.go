@@ -9,15 +9,21 @@ import (
 )
 
 type Post struct {
-	ID               uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id"`
-	CreatedBy        uuid.UUID `gorm:"column:created_by;type:uuid;not null" json:"created_by"`
-	Title            string    `gorm:"column:title;type:varchar(255);not null" json:"title"`
-	Description      string    `gorm:"column:description;type:text;not null" json:"description"`
-	ShortDescription string    `gorm:"column:short_description;type:varchar(255);not null" json:"short_description"`
-	ThumbnailURL     *string   `gorm:"column:deletable;<-:false" json:"thumbnail_url"`
-	Files            []File    `gorm:"many2many:files_to_module;joinForeignKey:ID;joinReferences:FileID" json:"files"`
-	CreatedAt        time.Time `gorm:"column:created_at;not null" json:"created_at"`
-	UpdatedAt        time.Time `gorm:"column:updated_at;not null" json:"updated_at"`
+	ID               uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id"`
+	CreatedBy        uuid.UUID      `gorm:"column:created_by;type:uuid;not null" json:"created_by"`
+	Title            string         `gorm:"column:title;type:varchar(255);not null" json:"title"`
+	Description      string         `gorm:"column:description;type:text;not null" json:"description"`
+	ShortDescription string         `gorm:"column:short_description;type:varchar(255);not null" json:"short_description"`
+	Status           string         `gorm:"column:status;type:varchar(20);not null;default:draft" json:"status"`
+	PublishAt        *time.Time     `gorm:"column:publish_at" json:"publish_at"`
+	PublishedAt      *time.Time     `gorm:"column:published_at" json:"published_at"`
+	PublishedBy      *uuid.UUID     `gorm:"column:published_by;type:uuid" json:"published_by"`
+	ThumbnailURL     *string        `gorm:"column:deletable;<-:false" json:"thumbnail_url"`
+	Files            []File         `gorm:"many2many:files_to_module;joinForeignKey:ID;joinReferences:FileID" json:"files"`
+	CreatedAt        time.Time      `gorm:"column:created_at;not null" json:"created_at"`
+	UpdatedAt        time.Time      `gorm:"column:updated_at;not null" json:"updated_at"`
+	DeletedAt        gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at"`
+	DeletedBy        *string        `gorm:"column:deleted_by;type:varchar(255)" json:"deleted_by"`
 }
 
 func (Post) TableName() string {
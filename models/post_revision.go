@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/utils"
+)
+
+// PostRevision is a full snapshot of a post taken after every create, update and restore
+type PostRevision struct {
+	ID               uuid.UUID             `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id"`
+	PostID           uuid.UUID             `gorm:"column:post_id;type:uuid;not null" json:"post_id"`
+	RevisionNumber   int                   `gorm:"column:revision_number;not null" json:"revision_number"`
+	Action           string                `gorm:"column:action;type:varchar(20);not null" json:"action"`
+	RestoredFrom     *uuid.UUID            `gorm:"column:restored_from;type:uuid" json:"restored_from"`
+	Title            string                `gorm:"column:title;type:varchar(255);not null" json:"title"`
+	Description      string                `gorm:"column:description;type:text;not null" json:"description"`
+	ShortDescription string                `gorm:"column:short_description;type:varchar(255);not null" json:"short_description"`
+	LangID           *uuid.UUID            `gorm:"column:lang_id;type:uuid" json:"lang_id"`
+	TopicIDs         utils.NullStringArray `gorm:"column:topic_ids;type:jsonb;not null" json:"topic_ids"`
+	ThumbnailFileID  *uuid.UUID            `gorm:"column:thumbnail_file_id;type:uuid" json:"thumbnail_file_id"`
+	ThumbnailURL     *string               `gorm:"column:thumbnail_url;type:text" json:"thumbnail_url"`
+	EditedBy         *uuid.UUID            `gorm:"column:edited_by;type:uuid" json:"edited_by"`
+	EditedByName     *string               `gorm:"column:edited_by_name;<-:false" json:"edited_by_name"` // Read-only: full name of the editor
+	CreatedAt        time.Time             `gorm:"column:created_at;not null" json:"created_at"`
+}
+
+func (PostRevision) TableName() string {
+	return "post_revisions"
+}
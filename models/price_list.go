@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PriceList represents price_lists table, a named set of item prices
+type PriceList struct {
+	ID          uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id" validate:"required"`
+	Code        string         `gorm:"column:code;type:varchar(255);unique;not null" json:"code" validate:"required"`
+	Name        string         `gorm:"column:name;type:varchar(255);not null" json:"name" validate:"required"`
+	Description *string        `gorm:"column:description;type:text" json:"description"`
+	Priority    int            `gorm:"column:priority;not null" json:"priority"` // the highest priority wins when several lists have a price
+	IsActive    bool           `gorm:"column:is_active;not null" json:"is_active"`
+	CreatedAt   time.Time      `gorm:"column:created_at;not null" json:"created_at"`
+	CreatedBy   string         `gorm:"column:created_by;type:varchar(255)" json:"created_by"`
+	UpdatedAt   time.Time      `gorm:"column:updated_at;not null" json:"updated_at"`
+	UpdatedBy   string         `gorm:"column:updated_by;type:varchar(255)" json:"updated_by"`
+	DeletedAt   gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at"`
+	DeletedBy   *string        `gorm:"column:deleted_by;type:varchar(255)" json:"deleted_by"`
+}
+
+func (PriceList) TableName() string {
+	return "price_lists"
+}
+
+// PriceListItem represents price_list_items table, the price of an item unit from a minimum quantity during a period
+type PriceListItem struct {
+	ID            uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id" validate:"required"`
+	PriceListID   uuid.UUID      `gorm:"column:price_list_id;type:uuid;not null" json:"price_list_id" validate:"required"`
+	ItemID        uuid.UUID      `gorm:"column:item_id;type:uuid;not null" json:"item_id" validate:"required"`
+	Uom           string         `gorm:"column:uom;type:varchar(20);not null" json:"uom"`
+	MinQty        float64        `gorm:"column:min_qty;type:numeric(18,4);not null" json:"min_qty"` // quantity break, the price applies from this quantity
+	Price         float64        `gorm:"column:price;type:numeric(18,2);not null" json:"price"`
+	EffectiveFrom time.Time      `gorm:"column:effective_from;type:date;not null" json:"effective_from"`
+	EffectiveTo   *time.Time     `gorm:"column:effective_to;type:date" json:"effective_to"` // open ended when empty
+	CreatedAt     time.Time      `gorm:"column:created_at;not null" json:"created_at"`
+	CreatedBy     string         `gorm:"column:created_by;type:varchar(255)" json:"created_by"`
+	UpdatedAt     time.Time      `gorm:"column:updated_at;not null" json:"updated_at"`
+	UpdatedBy     string         `gorm:"column:updated_by;type:varchar(255)" json:"updated_by"`
+	DeletedAt     gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at"`
+	DeletedBy     *string        `gorm:"column:deleted_by;type:varchar(255)" json:"deleted_by"`
+
+	// Fetched mutators (from joins)
+	ItemCode string `gorm:"column:item_code;<-:false" json:"item_code"`
+	ItemName string `gorm:"column:item_name;<-:false" json:"item_name"`
+}
+
+func (PriceListItem) TableName() string {
+	return "price_list_items"
+}
@@ -4,12 +4,14 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/utils"
 	"gorm.io/gorm"
 )
 
 // Province represents province table
 type Province struct {
 	ID        uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id" validate:"required"`
+	Code      *string        `gorm:"column:code;type:varchar(20)" json:"code"`
 	Name      string         `gorm:"column:name;type:varchar(100);not null;uniqueIndex" json:"name" validate:"required"`
 	CreatedAt time.Time      `gorm:"column:created_at;not null" json:"created_at"`
 	UpdatedAt time.Time      `gorm:"column:updated_at;not null" json:"updated_at"`
@@ -25,6 +27,7 @@ type City struct {
 	ID         uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id" validate:"required"`
 	ProvinceID uuid.UUID      `gorm:"column:province_id;type:uuid;not null" json:"province_id" validate:"required"`
 	Province   Province       `gorm:"foreignKey:ProvinceID" json:"province,omitempty"`
+	Code       *string        `gorm:"column:code;type:varchar(20)" json:"code"`
 	Name       string         `gorm:"column:name;type:varchar(255);not null" json:"name" validate:"required"`
 	AreaCode   *string        `gorm:"column:area_code;type:varchar(50)" json:"area_code"`
 	CreatedAt  time.Time      `gorm:"column:created_at;not null" json:"created_at"`
@@ -41,7 +44,10 @@ type District struct {
 	ID        uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id" validate:"required"`
 	CityID    uuid.UUID      `gorm:"column:city_id;type:uuid;not null" json:"city_id" validate:"required"`
 	City      City           `gorm:"foreignKey:CityID" json:"city,omitempty"`
+	Code      *string        `gorm:"column:code;type:varchar(20)" json:"code"`
 	Name      string         `gorm:"column:name;type:varchar(255);not null" json:"name" validate:"required"`
+	Latitude  *float64       `gorm:"column:latitude;type:double precision" json:"latitude"`
+	Longitude *float64       `gorm:"column:longitude;type:double precision" json:"longitude"`
 	CreatedAt time.Time      `gorm:"column:created_at;not null" json:"created_at"`
 	UpdatedAt time.Time      `gorm:"column:updated_at;not null" json:"updated_at"`
 	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at"`
@@ -53,13 +59,17 @@ func (District) TableName() string {
 
 // Subdistrict represents subdistrict table
 type Subdistrict struct {
-	ID         uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id" validate:"required"`
-	DistrictID uuid.UUID      `gorm:"column:district_id;type:uuid;not null" json:"district_id" validate:"required"`
-	District   District       `gorm:"foreignKey:DistrictID" json:"district,omitempty"`
-	Name       string         `gorm:"column:name;type:varchar(255);not null" json:"name" validate:"required"`
-	CreatedAt  time.Time      `gorm:"column:created_at;not null" json:"created_at"`
-	UpdatedAt  time.Time      `gorm:"column:updated_at;not null" json:"updated_at"`
-	DeletedAt  gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at"`
+	ID          uuid.UUID             `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id" validate:"required"`
+	DistrictID  uuid.UUID             `gorm:"column:district_id;type:uuid;not null" json:"district_id" validate:"required"`
+	District    District              `gorm:"foreignKey:DistrictID" json:"district,omitempty"`
+	Code        *string               `gorm:"column:code;type:varchar(20)" json:"code"`
+	Name        string                `gorm:"column:name;type:varchar(255);not null" json:"name" validate:"required"`
+	PostalCodes utils.NullStringArray `gorm:"column:postal_codes;type:jsonb" json:"postal_codes"`
+	Latitude    *float64              `gorm:"column:latitude;type:double precision" json:"latitude"`
+	Longitude   *float64              `gorm:"column:longitude;type:double precision" json:"longitude"`
+	CreatedAt   time.Time             `gorm:"column:created_at;not null" json:"created_at"`
+	UpdatedAt   time.Time             `gorm:"column:updated_at;not null" json:"updated_at"`
+	DeletedAt   gorm.DeletedAt        `gorm:"column:deleted_at;index" json:"deleted_at"`
 }
 
 func (Subdistrict) TableName() string {
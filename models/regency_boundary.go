@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RegencyBoundary represents regency_boundaries table (GeoJSON boundary of a region, stored as a MultiPolygon)
+type RegencyBoundary struct {
+	ID           uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id"`
+	Level        string    `gorm:"column:level;type:varchar(20);not null" json:"level"` // province / city / district / subdistrict
+	RegionID     uuid.UUID `gorm:"column:region_id;type:uuid;not null" json:"region_id"`
+	Geometry     string    `gorm:"column:geometry;type:jsonb;not null" json:"geometry"`
+	MinLatitude  float64   `gorm:"column:min_latitude;not null" json:"min_latitude"`
+	MaxLatitude  float64   `gorm:"column:max_latitude;not null" json:"max_latitude"`
+	MinLongitude float64   `gorm:"column:min_longitude;not null" json:"min_longitude"`
+	MaxLongitude float64   `gorm:"column:max_longitude;not null" json:"max_longitude"`
+	CreatedBy    string    `gorm:"column:created_by;type:varchar(255)" json:"created_by"`
+	UpdatedBy    string    `gorm:"column:updated_by;type:varchar(255)" json:"updated_by"`
+	CreatedAt    time.Time `gorm:"column:created_at;not null" json:"created_at"`
+	UpdatedAt    time.Time `gorm:"column:updated_at;not null" json:"updated_at"`
+}
+
+func (RegencyBoundary) TableName() string {
+	return "regency_boundaries"
+}
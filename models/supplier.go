@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Supplier represents suppliers table
+type Supplier struct {
+	ID                  uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id" validate:"required"`
+	SupplierCode        string         `gorm:"column:supplier_code;type:varchar(255);unique;not null" json:"supplier_code"`
+	SupplierName        string         `gorm:"column:supplier_name;type:varchar(255)" json:"supplier_name"`
+	Address             string         `gorm:"column:address;type:varchar(255)" json:"address"`
+	ProvinceID          *uuid.UUID     `gorm:"column:province_id;type:uuid" json:"province_id"`
+	CityID              *uuid.UUID     `gorm:"column:city_id;type:uuid" json:"city_id"`
+	DistrictID          *uuid.UUID     `gorm:"column:district_id;type:uuid" json:"district_id"`
+	SubdistrictID       *uuid.UUID     `gorm:"column:subdistrict_id;type:uuid" json:"subdistrict_id"`
+	ExpeditionArrivesID *uuid.UUID     `gorm:"column:expedition_arrives_id;type:uuid" json:"expedition_arrives_id"`
+	Notes               *string        `gorm:"column:notes;type:text" json:"notes"`
+	CreatedAt           time.Time      `gorm:"column:created_at;not null" json:"created_at"`
+	CreatedBy           string         `gorm:"column:created_by;type:varchar(255)" json:"created_by"`
+	UpdatedAt           time.Time      `gorm:"column:updated_at;not null" json:"updated_at"`
+	UpdatedBy           string         `gorm:"column:updated_by;type:varchar(255)" json:"updated_by"`
+	DeletedAt           gorm.DeletedAt `gorm:"column:deleted_at;index" json:"deleted_at"`
+	DeletedBy           *string        `gorm:"column:deleted_by;type:varchar(255)" json:"deleted_by"`
+
+	// Fetched mutators for address and expedition names (from joins)
+	ProvinceName          *string `gorm:"column:province_name;<-:false" json:"province_name"`
+	CityName              *string `gorm:"column:city_name;<-:false" json:"city_name"`
+	DistrictName          *string `gorm:"column:district_name;<-:false" json:"district_name"`
+	SubdistrictName       *string `gorm:"column:subdistrict_name;<-:false" json:"subdistrict_name"`
+	ExpeditionArrivesName *string `gorm:"column:expedition_arrives_name;<-:false" json:"expedition_arrives_name"`
+
+	// Fetched mutators for primary contacts (from joins)
+	PrimaryTelpNumber  *string `gorm:"column:primary_telp_number;<-:false" json:"primary_telp_number"`
+	PrimaryPhoneNumber *string `gorm:"column:primary_phone_number;<-:false" json:"primary_phone_number"`
+}
+
+func (Supplier) TableName() string {
+	return "suppliers"
+}
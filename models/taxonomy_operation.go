@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaxonomyOperation represents taxonomy_operations table (move / merge audit of sub-groups, types and backings)
+type TaxonomyOperation struct {
+	ID               uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id"`
+	Resource         string     `gorm:"column:resource;type:varchar(50);not null" json:"resource"`   // sub_group / type / backing
+	Operation        string     `gorm:"column:operation;type:varchar(50);not null" json:"operation"` // move / merge
+	SourceID         uuid.UUID  `gorm:"column:source_id;type:uuid;not null" json:"source_id"`
+	TargetID         uuid.UUID  `gorm:"column:target_id;type:uuid;not null" json:"target_id"` // new parent for move, surviving record for merge
+	PreviousParentID *uuid.UUID `gorm:"column:previous_parent_id;type:uuid" json:"previous_parent_id"`
+	PreviousCode     *string    `gorm:"column:previous_code;type:varchar(255)" json:"previous_code"`
+	NewCode          *string    `gorm:"column:new_code;type:varchar(255)" json:"new_code"`
+	RegenerateCodes  bool       `gorm:"column:regenerate_codes;not null" json:"regenerate_codes"`
+	AffectedChildren int        `gorm:"column:affected_children;not null" json:"affected_children"`
+	CreatedBy        string     `gorm:"column:created_by;type:varchar(255)" json:"created_by"`
+	CreatedAt        time.Time  `gorm:"column:created_at;not null" json:"created_at"`
+}
+
+func (TaxonomyOperation) TableName() string {
+	return "taxonomy_operations"
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Translation represents translations table, the localized value of a field of a master data entity
+type Translation struct {
+	ID         uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id"`
+	EntityType string    `gorm:"column:entity_type;type:varchar(50);not null" json:"entity_type"`
+	EntityID   uuid.UUID `gorm:"column:entity_id;type:uuid;not null" json:"entity_id"`
+	Field      string    `gorm:"column:field;type:varchar(50);not null" json:"field"`
+	Locale     string    `gorm:"column:locale;type:varchar(10);not null" json:"locale"`
+	Value      string    `gorm:"column:value;type:text;not null" json:"value"`
+	CreatedAt  time.Time `gorm:"column:created_at;not null" json:"created_at"`
+	CreatedBy  string    `gorm:"column:created_by;type:varchar(255)" json:"created_by"`
+	UpdatedAt  time.Time `gorm:"column:updated_at;not null" json:"updated_at"`
+	UpdatedBy  string    `gorm:"column:updated_by;type:varchar(255)" json:"updated_by"`
+
+	// Read-only fields from join (not stored in database)
+	EntityCode string `gorm:"column:entity_code;<-:false" json:"entity_code"`
+	BaseValue  string `gorm:"column:base_value;<-:false" json:"base_value"` // value of the field in the entity table
+}
+
+func (Translation) TableName() string {
+	return "translations"
+}
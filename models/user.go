@@ -29,6 +29,11 @@ type User struct {
 	Counter           int            `gorm:"column:counter;default:0" json:"counter"`
 	IsFirstTimeLogin  bool           `gorm:"column:is_first_time_login" json:"is_first_time_login"`
 	Deletable         bool           `gorm:"column:deletable;default:true;not null" json:"deletable"`
+	LastLoginAt       *time.Time     `gorm:"column:last_login_at" json:"last_login_at"`
+	DeactivateAt      *time.Time     `gorm:"column:deactivate_at" json:"deactivate_at"`
+	DormantWarnedAt   *time.Time     `gorm:"column:dormant_warned_at" json:"dormant_warned_at"`
+	AutoUnblockAt     *time.Time     `gorm:"column:auto_unblock_at" json:"auto_unblock_at"`
+	ReactivatedAt     *time.Time     `gorm:"column:reactivated_at" json:"reactivated_at"`
 	// Files relation (pivot)
 	Files []File `gorm:"many2many:files_to_module;joinForeignKey:ID;joinReferences:FileID" json:"-"`
 
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserDataRequest represents user_data_requests table (personal data export / erasure audit)
+type UserDataRequest struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id"`
+	UserID       uuid.UUID  `gorm:"column:user_id;type:uuid;not null" json:"user_id"`
+	RequestType  string     `gorm:"column:request_type;type:varchar(50);not null" json:"request_type"` // export / erasure
+	Status       string     `gorm:"column:status;type:varchar(50);not null" json:"status"`             // pending / processing / completed / failed
+	FilePath     *string    `gorm:"column:file_path;type:text" json:"file_path"`
+	ErrorMessage *string    `gorm:"column:error_message;type:text" json:"error_message"`
+	RequestedBy  string     `gorm:"column:requested_by;type:varchar(255)" json:"requested_by"`
+	ProcessedAt  *time.Time `gorm:"column:processed_at" json:"processed_at"`
+	CreatedAt    time.Time  `gorm:"column:created_at;not null" json:"created_at"`
+	UpdatedAt    time.Time  `gorm:"column:updated_at;not null" json:"updated_at"`
+}
+
+func (UserDataRequest) TableName() string {
+	return "user_data_requests"
+}
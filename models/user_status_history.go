@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserStatusHistory represents user_status_histories table (who blocked / activated whom and why)
+type UserStatusHistory struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v7()" json:"id"`
+	UserID    uuid.UUID  `gorm:"column:user_id;type:uuid;not null" json:"user_id"`
+	Action    string     `gorm:"column:action;type:varchar(50);not null" json:"action"` // block / unblock / activate / deactivate
+	Reason    string     `gorm:"column:reason;type:text;not null" json:"reason"`
+	UnblockAt *time.Time `gorm:"column:unblock_at" json:"unblock_at"`
+	CreatedBy string     `gorm:"column:created_by;type:varchar(255)" json:"created_by"`
+	CreatedAt time.Time  `gorm:"column:created_at;not null" json:"created_at"`
+
+	// mutator - not stored in DB
+	CreatedByName string `gorm:"column:created_by_name;<-:false" json:"created_by_name"` // Read-only: used for fetch, ignored on insert/update
+}
+
+func (UserStatusHistory) TableName() string {
+	return "user_status_histories"
+}
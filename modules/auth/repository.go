@@ -20,6 +20,7 @@ type Repository interface {
 	AddPasswordHistory(ctx context.Context, hashedPassword string, userId uuid.UUID) error
 	AssertPasswordAttemptPassed(ctx context.Context, userId uuid.UUID) (bool, error)
 	ResetPasswordAttempt(ctx context.Context, userId uuid.UUID) error
+	UpdateLastLogin(ctx context.Context, userId uuid.UUID) error
 
 	// for reset password
 	RequestResetPassword(ctx context.Context, email string) error
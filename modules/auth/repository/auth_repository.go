@@ -228,6 +228,14 @@ func (repo *authRepository) ResetPasswordAttempt(ctx context.Context, userId uui
 		}).Error
 }
 
+// UpdateLastLogin stamps the user's last successful login, used by the dormant-account policy.
+func (repo *authRepository) UpdateLastLogin(ctx context.Context, userId uuid.UUID) error {
+	return repo.DB.WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ?", userId).
+		UpdateColumn("last_login_at", time.Now().UTC()).Error
+}
+
 // AddPasswordHistory inserts a new password history for a user into the database.
 //
 // Parameters:
@@ -0,0 +1,55 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/rendyfutsuy/base-go/utils/services"
+)
+
+const (
+	TypeEmailAccountNotification = "email:account-notification"
+)
+
+// AccountNotificationEmailPayload informs a user about a change (or upcoming change) of their account status
+type AccountNotificationEmailPayload struct {
+	UserID  uuid.UUID
+	Email   string
+	Name    string
+	Subject string
+	Message string
+}
+
+func NewAccountNotificationEmailTask(p AccountNotificationEmailPayload) (*asynq.Task, error) {
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeEmailAccountNotification, payload), nil
+}
+
+func HandleAccountNotificationEmailTask(ctx context.Context, t *asynq.Task, emailService *services.EmailService) error {
+	var p AccountNotificationEmailPayload
+	if err := json.Unmarshal(t.Payload(), &p); err != nil {
+		utils.Logger.Error(err.Error())
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+	log.Printf("Sending Account Notification Email: user_id=%s, email=%s", p.UserID.String(), p.Email)
+	if err := emailService.SendAccountNotificationEmail(p.Email, p.Name, p.Subject, p.Message); err != nil {
+		utils.Logger.Error(err.Error())
+		return fmt.Errorf("failed to send account notification email: %v", err)
+	}
+	utils.Logger.Info(fmt.Sprintf("Account notification email sent successfully: user_id=%s, email=%s", p.UserID.String(), p.Email))
+	return nil
+}
+
+func RegisterAccountNotificationEmailHandler(mux *asynq.ServeMux, emailService *services.EmailService) {
+	mux.HandleFunc(TypeEmailAccountNotification, func(ctx context.Context, t *asynq.Task) error {
+		return HandleAccountNotificationEmailTask(ctx, t, emailService)
+	})
+}
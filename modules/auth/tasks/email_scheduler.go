@@ -12,7 +12,7 @@ import (
 // RunEmailScheduler initializes Asynq server and registers all email-related handlers.
 //
 // It sets up Redis client, configures queues, initializes EmailService,
-// registers Reset Password and Verification email handlers, and runs the server & scheduler.
+// registers Reset Password, Verification and Account Notification email handlers, and runs the server & scheduler.
 func RunEmailScheduler() error {
 	utils.InitConfig("config.json")
 	var newRelicApp *newrelic.Application
@@ -42,6 +42,13 @@ func RunEmailScheduler() error {
 			}
 			return emailService.SendVerificationEmail(p.Email, p.Code)
 		},
+		TypeEmailAccountNotification: func(body []byte) error {
+			var p AccountNotificationEmailPayload
+			if err := json.Unmarshal(body, &p); err != nil {
+				return err
+			}
+			return emailService.SendAccountNotificationEmail(p.Email, p.Name, p.Subject, p.Message)
+		},
 	}
 	if err := q.Run(workers); err != nil {
 		return err
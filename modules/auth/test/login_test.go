@@ -120,6 +120,11 @@ func (m *MockAuthRepository) ResetPasswordAttempt(ctx context.Context, userId uu
 	return args.Error(0)
 }
 
+func (m *MockAuthRepository) UpdateLastLogin(ctx context.Context, userId uuid.UUID) error {
+	args := m.Called(ctx, userId)
+	return args.Error(0)
+}
+
 func (m *MockAuthRepository) RequestResetPassword(ctx context.Context, email string) error {
 	args := m.Called(ctx, email)
 	return args.Error(0)
@@ -208,6 +213,7 @@ func TestAuthenticate(t *testing.T) {
 					mock.AnythingOfType("string"),
 					mock.AnythingOfType("time.Duration"),
 				).Return(nil).Once()
+				mockRepo.On("UpdateLastLogin", ctx, testUserID).Return(nil).Once()
 			},
 			expectedError: false,
 			description:   "Valid credentials should return access token",
@@ -82,6 +82,11 @@ func (u *authUsecase) Authenticate(ctx context.Context, login string, password s
 		return auth.AuthenticateResult{}, fmt.Errorf("failed to save session: %w", err)
 	}
 
+	// 10) stamp last login for dormant-account policy
+	if err := u.authRepo.UpdateLastLogin(ctx, user.ID); err != nil {
+		utils.Logger.Warn("failed to update last login", zap.Error(err))
+	}
+
 	return auth.AuthenticateResult{
 		AccessToken:      accessToken,
 		RefreshToken:     refreshToken,
@@ -66,6 +66,10 @@ func NewBackingHandler(e *echo.Echo, uc backing.Usecase, mwP _reqContext.IMiddle
 
 	// Delete
 	r.DELETE("/:id", h.Delete, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToDelete))
+
+	// Move to another type / merge into another backing
+	r.POST("/:id/move", h.Move, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.POST("/:id/merge", h.Merge, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
 }
 
 // Create godoc
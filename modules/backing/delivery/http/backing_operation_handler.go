@@ -0,0 +1,100 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/backing/dto"
+)
+
+// Move godoc
+// @Summary		Move backing to another type
+// @Description	Re-parent a backing to another type in one transaction. Name must be unique within the target type. When regenerate_code is true the backing gets a new code. The operation is recorded in the taxonomy audit log.
+// @Tags			Backing
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string					true	"Backing UUID"
+// @Param			request	body	dto.ReqMoveBacking		true	"Target type. Fields: type_id (required, UUID), regenerate_code (optional)"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespBacking}	"Successfully moved backing"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, same type or duplicate name in target type"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/backing/{id}/move [post]
+func (h *BackingHandler) Move(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("id")
+	req := new(dto.ReqMoveBacking)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// Get user ID from context
+	user := c.Get("user")
+	userID := ""
+	if user != nil {
+		if userModel, ok := user.(models.User); ok {
+			userID = userModel.ID.String()
+		}
+	}
+
+	res, err := h.Usecase.Move(ctx, id, req, userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespBacking(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Merge godoc
+// @Summary		Merge backing into another backing
+// @Description	Soft delete a duplicate backing in favour of the target backing, in one transaction. The operation is recorded in the taxonomy audit log.
+// @Tags			Backing
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string					true	"Duplicate (source) backing UUID"
+// @Param			request	body	dto.ReqMergeBacking	true	"Target backing. Fields: target_id (required, UUID)"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespBacking}	"Successfully merged, returns the target backing"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error or merge into itself"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/backing/{id}/merge [post]
+func (h *BackingHandler) Merge(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("id")
+	req := new(dto.ReqMergeBacking)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// Get user ID from context
+	user := c.Get("user")
+	userID := ""
+	if user != nil {
+		if userModel, ok := user.(models.User); ok {
+			userID = userModel.ID.String()
+		}
+	}
+
+	res, err := h.Usecase.Merge(ctx, id, req, userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespBacking(*res))
+	return c.JSON(http.StatusOK, resp)
+}
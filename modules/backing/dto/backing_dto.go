@@ -17,6 +17,17 @@ type ReqUpdateBacking struct {
 	Name   string    `form:"name" json:"name" validate:"required,max=255"`
 }
 
+// ReqMoveBacking moves a backing to another type
+type ReqMoveBacking struct {
+	TypeID         uuid.UUID `form:"type_id" json:"type_id" validate:"required"`
+	RegenerateCode bool      `form:"regenerate_code" json:"regenerate_code"` // new code for the backing
+}
+
+// ReqMergeBacking merges a duplicate backing into the target, the source is soft-deleted
+type ReqMergeBacking struct {
+	TargetID uuid.UUID `form:"target_id" json:"target_id" validate:"required"`
+}
+
 type RespBacking struct {
 	ID           uuid.UUID `json:"id"`
 	TypeID       uuid.UUID `json:"type_id"`
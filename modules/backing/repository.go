@@ -17,4 +17,21 @@ type Repository interface {
 	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqBackingIndexFilter) ([]models.Backing, int, error)
 	GetAll(ctx context.Context, filter dto.ReqBackingIndexFilter) ([]models.Backing, error)
 	ExistsByNameInType(ctx context.Context, typeID uuid.UUID, name string, excludeID uuid.UUID) (bool, error)
+	ExistsInItems(ctx context.Context, backingID uuid.UUID) (bool, error)
+	GetConflictingItemNames(ctx context.Context, sourceID uuid.UUID, targetID uuid.UUID) ([]string, error)
+	Move(ctx context.Context, id uuid.UUID, params MoveBackingParams) error
+	Merge(ctx context.Context, sourceID uuid.UUID, params MergeBackingParams) error
+}
+
+// MoveBackingParams re-parents a backing to another type
+type MoveBackingParams struct {
+	TypeID         uuid.UUID
+	RegenerateCode bool // new code for the backing
+	MovedBy        string
+}
+
+// MergeBackingParams soft-deletes a duplicate backing in favour of TargetID
+type MergeBackingParams struct {
+	TargetID uuid.UUID
+	MergedBy string
 }
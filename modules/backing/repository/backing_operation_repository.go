@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/codegen"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/backing"
+	"gorm.io/gorm"
+)
+
+func (r *backingRepository) Move(ctx context.Context, id uuid.UUID, params backing.MoveBackingParams) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		current := &models.Backing{}
+		if err := tx.Select("id", "type_id", "backing_code").Where("id = ? AND deleted_at IS NULL", id).First(current).Error; err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		updates := map[string]interface{}{
+			"type_id":    params.TypeID,
+			"updated_at": now,
+			"updated_by": params.MovedBy,
+		}
+		if err := tx.Model(&models.Backing{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+			return err
+		}
+		if params.RegenerateCode {
+			if err := codegen.Recode(tx, constants.CodeEntityBacking, []uuid.UUID{id}, params.MovedBy, now); err != nil {
+				return err
+			}
+		}
+
+		var newCode string
+		if err := tx.Model(&models.Backing{}).Where("id = ?", id).Pluck("backing_code", &newCode).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&models.TaxonomyOperation{
+			Resource:         constants.CatalogTreeLevelBacking,
+			Operation:        constants.TaxonomyOperationMove,
+			SourceID:         id,
+			TargetID:         params.TypeID,
+			PreviousParentID: &current.TypeID,
+			PreviousCode:     &current.BackingCode,
+			NewCode:          &newCode,
+			RegenerateCodes:  params.RegenerateCode,
+			CreatedBy:        params.MovedBy,
+			CreatedAt:        now,
+		}).Error
+	})
+}
+
+// GetConflictingItemNames returns the names of the active items of sourceID already used by active items of targetID
+func (r *backingRepository) GetConflictingItemNames(ctx context.Context, sourceID uuid.UUID, targetID uuid.UUID) ([]string, error) {
+	var names []string
+	err := r.DB.WithContext(ctx).Table("items it").
+		Where("it.backing_id = ? AND it.deleted_at IS NULL", sourceID).
+		Where("EXISTS (SELECT 1 FROM items ii WHERE ii.backing_id = ? AND ii.name = it.name AND ii.deleted_at IS NULL)", targetID).
+		Order("it.name").
+		Pluck("it.name", &names).Error
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// Merge re-points the active items of the duplicate backing to the target backing, then soft-deletes the duplicate
+func (r *backingRepository) Merge(ctx context.Context, sourceID uuid.UUID, params backing.MergeBackingParams) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		source := &models.Backing{}
+		if err := tx.Select("id", "type_id", "backing_code").Where("id = ? AND deleted_at IS NULL", sourceID).First(source).Error; err != nil {
+			return err
+		}
+
+		var itemIDs []uuid.UUID
+		if err := tx.Model(&models.Item{}).Where("backing_id = ? AND deleted_at IS NULL", sourceID).Pluck("id", &itemIDs).Error; err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		if len(itemIDs) > 0 {
+			err := tx.Model(&models.Item{}).Where("id IN (?)", itemIDs).Updates(map[string]interface{}{
+				"backing_id": params.TargetID,
+				"updated_at": now,
+				"updated_by": params.MergedBy,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		err := tx.Model(&models.Backing{}).Where("id = ?", sourceID).Updates(map[string]interface{}{
+			"deleted_at": now,
+			"deleted_by": params.MergedBy,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&models.TaxonomyOperation{
+			Resource:         constants.CatalogTreeLevelBacking,
+			Operation:        constants.TaxonomyOperationMerge,
+			SourceID:         sourceID,
+			TargetID:         params.TargetID,
+			PreviousParentID: &source.TypeID,
+			PreviousCode:     &source.BackingCode,
+			AffectedChildren: len(itemIDs),
+			CreatedBy:        params.MergedBy,
+			CreatedAt:        now,
+		}).Error
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/codegen"
 	"github.com/rendyfutsuy/base-go/helpers/request"
 	"github.com/rendyfutsuy/base-go/models"
 	"github.com/rendyfutsuy/base-go/modules/backing/dto"
@@ -34,8 +35,16 @@ func (r *backingRepository) Create(ctx context.Context, typeID uuid.UUID, name s
 		UpdatedAt: now,
 		UpdatedBy: createdBy,
 	}
-	// Omit backing_code to let database generate it using DEFAULT generate_backing_code()
-	if err := r.DB.WithContext(ctx).Omit("backing_code").Create(b).Error; err != nil {
+	// backing_code is allocated from the backing code policy in the same transaction
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		code, err := codegen.Allocate(tx, constants.CodeEntityBacking, &typeID)
+		if err != nil {
+			return err
+		}
+		b.BackingCode = code
+		return tx.Create(b).Error
+	})
+	if err != nil {
 		return nil, err
 	}
 	// if b not update, return error
@@ -117,6 +126,18 @@ func (r *backingRepository) ExistsByNameInType(ctx context.Context, typeID uuid.
 	return count > 0, nil
 }
 
+func (r *backingRepository) ExistsInItems(ctx context.Context, backingID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.DB.WithContext(ctx).
+		Model(&models.Item{}).
+		Where("backing_id = ? AND deleted_at IS NULL", backingID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
 func (r *backingRepository) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqBackingIndexFilter) ([]models.Backing, int, error) {
 	var backings []models.Backing
 	query := r.DB.WithContext(ctx).
@@ -80,6 +80,22 @@ func (m *mockBackingUsecase) Export(ctx context.Context, filter dto.ReqBackingIn
 	return args.Get(0).([]byte), args.Error(1)
 }
 
+func (m *mockBackingUsecase) Move(ctx context.Context, id string, req *dto.ReqMoveBacking, authId string) (*models.Backing, error) {
+	args := m.Called(ctx, id, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Backing), args.Error(1)
+}
+
+func (m *mockBackingUsecase) Merge(ctx context.Context, id string, req *dto.ReqMergeBacking, authId string) (*models.Backing, error) {
+	args := m.Called(ctx, id, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Backing), args.Error(1)
+}
+
 type noopMiddlewareAuth struct{}
 
 func (n *noopMiddlewareAuth) AuthorizationCheck(next echo.HandlerFunc) echo.HandlerFunc {
@@ -129,6 +145,8 @@ func TestNewBackingHandler_RegisterRoutes(t *testing.T) {
 	require.NotNil(t, findRoute(e.Routes(), http.MethodPost, "/v1/backing"))
 	require.NotNil(t, findRoute(e.Routes(), http.MethodGet, "/v1/backing/:id"))
 	require.NotNil(t, findRoute(e.Routes(), http.MethodGet, "/v1/backing/export"))
+	require.NotNil(t, findRoute(e.Routes(), http.MethodPost, "/v1/backing/:id/move"))
+	require.NotNil(t, findRoute(e.Routes(), http.MethodPost, "/v1/backing/:id/merge"))
 }
 
 func findRoute(routes []*echo.Route, method, path string) *echo.Route {
@@ -312,3 +330,29 @@ func TestBackingHandler_ExportValidateError(t *testing.T) {
 	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
 	assert.NotEmpty(t, resp.Message)
 }
+
+func TestBackingHandler_MoveSuccess(t *testing.T) {
+	e := newEcho()
+	backingID := uuid.New().String()
+	typeID := uuid.New()
+	body := fmt.Sprintf(`{"type_id":"%s","regenerate_code":true}`, typeID)
+	req := httptest.NewRequest(http.MethodPost, "/v1/backing/"+backingID+"/move", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(backingID)
+	userID := uuid.New()
+	c.Set("user", models.User{ID: userID})
+
+	mockUC := new(mockBackingUsecase)
+	handler := &backingHttp.BackingHandler{Usecase: mockUC}
+
+	mockUC.On("Move", mock.Anything, backingID, &dto.ReqMoveBacking{TypeID: typeID, RegenerateCode: true}, userID.String()).
+		Return(&models.Backing{ID: uuid.MustParse(backingID), TypeID: typeID, Name: "PUTIH"}, nil).Once()
+
+	err := handler.Move(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
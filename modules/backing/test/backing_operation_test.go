@@ -0,0 +1,99 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/backing"
+	backingDto "github.com/rendyfutsuy/base-go/modules/backing/dto"
+	"github.com/rendyfutsuy/base-go/modules/backing/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestMoveBacking(t *testing.T) {
+	ctx := context.Background()
+	backingID := uuid.New()
+	fromTypeID := uuid.New()
+	toTypeID := uuid.New()
+	current := &models.Backing{ID: backingID, TypeID: fromTypeID, BackingCode: "05", Name: "PUTIH"}
+
+	t.Run("success move with regenerated code", func(t *testing.T) {
+		mockRepo := new(MockBackingRepository)
+		mockTypeRepo := new(MockTypeRepository)
+		mockRepo.On("GetByID", ctx, backingID).Return(current, nil).Once()
+		mockTypeRepo.On("GetByID", ctx, toTypeID).Return(&models.Type{ID: toTypeID}, nil).Once()
+		mockRepo.On("ExistsByNameInType", ctx, toTypeID, "PUTIH", backingID).Return(false, nil).Once()
+		mockRepo.On("Move", ctx, backingID, backing.MoveBackingParams{TypeID: toTypeID, RegenerateCode: true, MovedBy: "test-auth-id"}).Return(nil).Once()
+		mockRepo.On("GetByID", ctx, backingID).Return(&models.Backing{ID: backingID, TypeID: toTypeID, BackingCode: "12", Name: "PUTIH"}, nil).Once()
+
+		req := &backingDto.ReqMoveBacking{TypeID: toTypeID, RegenerateCode: true}
+		result, err := usecase.NewBackingUsecase(mockRepo, mockTypeRepo).Move(ctx, backingID.String(), req, "test-auth-id")
+		require.NoError(t, err)
+		assert.Equal(t, "12", result.BackingCode)
+		mockRepo.AssertExpectations(t)
+		mockTypeRepo.AssertExpectations(t)
+	})
+
+	t.Run("error when target type not found", func(t *testing.T) {
+		mockRepo := new(MockBackingRepository)
+		mockTypeRepo := new(MockTypeRepository)
+		mockRepo.On("GetByID", ctx, backingID).Return(current, nil).Once()
+		mockTypeRepo.On("GetByID", ctx, toTypeID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		req := &backingDto.ReqMoveBacking{TypeID: toTypeID}
+		result, err := usecase.NewBackingUsecase(mockRepo, mockTypeRepo).Move(ctx, backingID.String(), req, "test-auth-id")
+		assert.EqualError(t, err, constants.BackingTypeNotFound)
+		assert.Nil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error when already in target type", func(t *testing.T) {
+		mockRepo := new(MockBackingRepository)
+		mockRepo.On("GetByID", ctx, backingID).Return(current, nil).Once()
+
+		req := &backingDto.ReqMoveBacking{TypeID: fromTypeID}
+		result, err := usecase.NewBackingUsecase(mockRepo, new(MockTypeRepository)).Move(ctx, backingID.String(), req, "test-auth-id")
+		assert.EqualError(t, err, constants.BackingMoveSameType)
+		assert.Nil(t, result)
+	})
+}
+
+func TestMergeBacking(t *testing.T) {
+	ctx := context.Background()
+	sourceID := uuid.New()
+	targetID := uuid.New()
+
+	mockRepo := new(MockBackingRepository)
+	mockRepo.On("GetByID", ctx, sourceID).Return(&models.Backing{ID: sourceID}, nil).Once()
+	mockRepo.On("GetByID", ctx, targetID).Return(&models.Backing{ID: targetID}, nil).Once()
+	mockRepo.On("GetConflictingItemNames", ctx, sourceID, targetID).Return([]string{}, nil).Once()
+	mockRepo.On("Merge", ctx, sourceID, backing.MergeBackingParams{TargetID: targetID, MergedBy: "test-auth-id"}).Return(nil).Once()
+	mockRepo.On("GetByID", ctx, targetID).Return(&models.Backing{ID: targetID, Name: "PUTIH"}, nil).Once()
+
+	result, err := usecase.NewBackingUsecase(mockRepo, new(MockTypeRepository)).Merge(ctx, sourceID.String(), &backingDto.ReqMergeBacking{TargetID: targetID}, "test-auth-id")
+	require.NoError(t, err)
+	assert.Equal(t, targetID, result.ID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestMergeBackingItemNameConflict(t *testing.T) {
+	ctx := context.Background()
+	sourceID := uuid.New()
+	targetID := uuid.New()
+
+	mockRepo := new(MockBackingRepository)
+	mockRepo.On("GetByID", ctx, sourceID).Return(&models.Backing{ID: sourceID}, nil).Once()
+	mockRepo.On("GetByID", ctx, targetID).Return(&models.Backing{ID: targetID}, nil).Once()
+	mockRepo.On("GetConflictingItemNames", ctx, sourceID, targetID).Return([]string{"Sampul Plastik A4"}, nil).Once()
+
+	result, err := usecase.NewBackingUsecase(mockRepo, new(MockTypeRepository)).Merge(ctx, sourceID.String(), &backingDto.ReqMergeBacking{TargetID: targetID}, "test-auth-id")
+	assert.EqualError(t, err, fmt.Sprintf(constants.BackingMergeNameConflict, "Sampul Plastik A4"))
+	assert.Nil(t, result)
+	mockRepo.AssertExpectations(t)
+}
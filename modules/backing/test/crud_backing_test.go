@@ -3,6 +3,8 @@ package test
 import (
 	"context"
 	"errors"
+	"github.com/rendyfutsuy/base-go/modules/backing"
+	typemodule "github.com/rendyfutsuy/base-go/modules/type"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
 	"github.com/rendyfutsuy/base-go/helpers/request"
 	"github.com/rendyfutsuy/base-go/models"
 	backingDto "github.com/rendyfutsuy/base-go/modules/backing/dto"
@@ -135,6 +138,47 @@ func (m *MockTypeRepository) ExistsInBackings(ctx context.Context, typeID uuid.U
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockTypeRepository) GetConflictingBackingNames(ctx context.Context, sourceID uuid.UUID, targetID uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, sourceID, targetID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockTypeRepository) Move(ctx context.Context, id uuid.UUID, params typemodule.MoveTypeParams) error {
+	args := m.Called(ctx, id, params)
+	return args.Error(0)
+}
+
+func (m *MockTypeRepository) Merge(ctx context.Context, sourceID uuid.UUID, params typemodule.MergeTypeParams) error {
+	args := m.Called(ctx, sourceID, params)
+	return args.Error(0)
+}
+
+func (m *MockBackingRepository) Move(ctx context.Context, id uuid.UUID, params backing.MoveBackingParams) error {
+	args := m.Called(ctx, id, params)
+	return args.Error(0)
+}
+
+func (m *MockBackingRepository) ExistsInItems(ctx context.Context, backingID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, backingID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBackingRepository) GetConflictingItemNames(ctx context.Context, sourceID uuid.UUID, targetID uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, sourceID, targetID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockBackingRepository) Merge(ctx context.Context, sourceID uuid.UUID, params backing.MergeBackingParams) error {
+	args := m.Called(ctx, sourceID, params)
+	return args.Error(0)
+}
+
 func TestCreateBacking(t *testing.T) {
 	e := echo.New()
 	ctx := context.Background()
@@ -416,6 +460,7 @@ func TestDeleteBacking(t *testing.T) {
 			id:     validID.String(),
 			authId: "test-auth-id",
 			setupMock: func(m *MockBackingRepository, mt *MockTypeRepository) {
+				m.On("ExistsInItems", ctx, validID).Return(false, nil).Once()
 				m.On("Delete", ctx, validID).Return(nil).Once()
 			},
 			expectedError: nil,
@@ -434,10 +479,20 @@ func TestDeleteBacking(t *testing.T) {
 			id:     validID.String(),
 			authId: "test-auth-id",
 			setupMock: func(m *MockBackingRepository, mt *MockTypeRepository) {
+				m.On("ExistsInItems", ctx, validID).Return(false, nil).Once()
 				m.On("Delete", ctx, validID).Return(errors.New("delete failed")).Once()
 			},
 			expectedError: errors.New("delete failed"),
 		},
+		{
+			name:   "error when backing is still used in items",
+			id:     validID.String(),
+			authId: "test-auth-id",
+			setupMock: func(m *MockBackingRepository, mt *MockTypeRepository) {
+				m.On("ExistsInItems", ctx, validID).Return(true, nil).Once()
+			},
+			expectedError: errors.New(constants.BackingStillUsedInItems),
+		},
 	}
 
 	for _, tt := range tests {
@@ -16,4 +16,6 @@ type Usecase interface {
 	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqBackingIndexFilter) ([]models.Backing, int, error)
 	GetAll(ctx context.Context, filter dto.ReqBackingIndexFilter) ([]models.Backing, error)
 	Export(ctx context.Context, filter dto.ReqBackingIndexFilter) ([]byte, error)
+	Move(ctx context.Context, id string, req *dto.ReqMoveBacking, authId string) (*models.Backing, error)
+	Merge(ctx context.Context, id string, req *dto.ReqMergeBacking, authId string) (*models.Backing, error)
 }
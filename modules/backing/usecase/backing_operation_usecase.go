@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/backing"
+	"github.com/rendyfutsuy/base-go/modules/backing/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"gorm.io/gorm"
+)
+
+// Move re-parents a backing to another type
+func (u *backingUsecase) Move(ctx context.Context, id string, reqBody *dto.ReqMoveBacking, authId string) (*models.Backing, error) {
+	bid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := u.repo.GetByID(ctx, bid)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.BackingNotFound, id)
+		}
+		return nil, err
+	}
+	if current.TypeID == reqBody.TypeID {
+		return nil, errors.New(constants.BackingMoveSameType)
+	}
+
+	if _, err := u.typeRepo.GetByID(ctx, reqBody.TypeID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New(constants.BackingTypeNotFound)
+		}
+		return nil, err
+	}
+
+	exists, err := u.repo.ExistsByNameInType(ctx, reqBody.TypeID, current.Name, bid)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New(constants.BackingNameAlreadyExistsInType)
+	}
+
+	if err := u.repo.Move(ctx, bid, mod.MoveBackingParams{
+		TypeID:         reqBody.TypeID,
+		RegenerateCode: reqBody.RegenerateCode,
+		MovedBy:        authId,
+	}); err != nil {
+		return nil, err
+	}
+	return u.repo.GetByID(ctx, bid)
+}
+
+// Merge soft-deletes a duplicate backing in favour of the target
+func (u *backingUsecase) Merge(ctx context.Context, id string, reqBody *dto.ReqMergeBacking, authId string) (*models.Backing, error) {
+	bid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	if bid == reqBody.TargetID {
+		return nil, errors.New(constants.BackingMergeIntoItself)
+	}
+
+	for _, checkID := range []uuid.UUID{bid, reqBody.TargetID} {
+		if _, err := u.repo.GetByID(ctx, checkID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, fmt.Errorf(constants.BackingNotFound, checkID)
+			}
+			return nil, err
+		}
+	}
+
+	conflicts, err := u.repo.GetConflictingItemNames(ctx, bid, reqBody.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if len(conflicts) > 0 {
+		return nil, fmt.Errorf(constants.BackingMergeNameConflict, strings.Join(conflicts, ", "))
+	}
+
+	if err := u.repo.Merge(ctx, bid, mod.MergeBackingParams{
+		TargetID: reqBody.TargetID,
+		MergedBy: authId,
+	}); err != nil {
+		return nil, err
+	}
+	return u.repo.GetByID(ctx, reqBody.TargetID)
+}
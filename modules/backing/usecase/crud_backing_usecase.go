@@ -92,6 +92,16 @@ func (u *backingUsecase) Delete(ctx context.Context, id string, userID string) e
 	if err != nil {
 		return err
 	}
+
+	// Check if backing is still used in items
+	exists, err := u.repo.ExistsInItems(ctx, bid)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errors.New(constants.BackingStillUsedInItems)
+	}
+
 	return u.repo.Delete(ctx, bid)
 }
 
@@ -0,0 +1,181 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/helpers/middleware"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/code_policy"
+	"github.com/rendyfutsuy/base-go/modules/code_policy/dto"
+)
+
+type ResponseError struct {
+	Message string `json:"message"`
+}
+
+type CodePolicyHandler struct {
+	Usecase              code_policy.Usecase
+	validator            *validator.Validate
+	middlewareAuth       middleware.IMiddlewareAuth
+	middlewarePermission middleware.IMiddlewarePermission
+}
+
+func NewCodePolicyHandler(e *echo.Echo, uc code_policy.Usecase, auth middleware.IMiddlewareAuth, middlewarePermission middleware.IMiddlewarePermission) {
+	h := &CodePolicyHandler{Usecase: uc, validator: validator.New(), middlewareAuth: auth, middlewarePermission: middlewarePermission}
+
+	r := e.Group("/v1/code-policy")
+	r.Use(h.middlewareAuth.AuthorizationCheck)
+
+	// Permissions
+	// View:   code-policy.view
+	// Update: code-policy.update
+	permissionToView := []string{"code-policy.view"}
+	permissionToUpdate := []string{"code-policy.update"}
+
+	// List the policy of every entity
+	r.GET("", h.GetAll, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+
+	// Preview the next code of an entity
+	r.GET("/:entity/preview", h.Preview, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+
+	// Update
+	r.PUT("/:entity", h.Update, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+
+	// Regenerate the existing codes with the current policy
+	r.POST("/:entity/migrate", h.Migrate, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+}
+
+// GetAll godoc
+// @Summary		Get code generation policies
+// @Description	Retrieve the code policy of every master data entity (group, sub_group, type, backing, expedition, supplier, customer, item) with an example of the first code it generates
+// @Tags			Code Policy
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Success		200	{object}	response.NonPaginationResponse{data=[]dto.RespCodePolicy}	"Successfully retrieved code policies"
+// @Failure		400	{object}	response.NonPaginationResponse	"Bad request"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403	{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/code-policy [get]
+func (h *CodePolicyHandler) GetAll(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	res, err := h.Usecase.GetAll(ctx)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Update godoc
+// @Summary		Update code generation policy
+// @Description	Update the code pattern of an entity: prefix, separator, zero padding, parent code inheritance (e.g. GG.SS.TTT, sub_group / type / backing / item only) and yearly reset. Existing codes are kept, use the migrate endpoint to regenerate them.
+// @Tags			Code Policy
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			entity	path	string						true	"Entity (group, sub_group, type, backing, expedition, supplier, customer, item)"
+// @Param			request	body	dto.ReqUpdateCodePolicy		true	"Code policy. Fields: prefix (max 20), separator (max 5), padding (1-10), inherit_parent, yearly_reset"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespCodePolicy}	"Successfully updated code policy"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error or unknown entity"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/code-policy/{entity} [put]
+func (h *CodePolicyHandler) Update(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	entity := c.Param("entity")
+	req := new(dto.ReqUpdateCodePolicy)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// Get user ID from context
+	user := c.Get("user")
+	userID := ""
+	if user != nil {
+		if userModel, ok := user.(models.User); ok {
+			userID = userModel.ID.String()
+		}
+	}
+
+	res, err := h.Usecase.Update(ctx, entity, req, userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Preview godoc
+// @Summary		Preview the next code
+// @Description	Return the code the next created record of an entity would get, without reserving it
+// @Tags			Code Policy
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			entity		path		string	true	"Entity (group, sub_group, type, backing, expedition, supplier, customer, item)"
+// @Param			parent_id	query		string	false	"Parent record UUID, required when the policy inherits the parent code"
+// @Success		200			{object}	response.NonPaginationResponse{data=dto.RespCodePreview}	"Successfully generated code preview"
+// @Failure		400			{object}	response.NonPaginationResponse	"Bad request - unknown entity, invalid or missing parent"
+// @Failure		401			{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403			{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/code-policy/{entity}/preview [get]
+func (h *CodePolicyHandler) Preview(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	res, err := h.Usecase.Preview(ctx, c.Param("entity"), c.QueryParam("parent_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Migrate godoc
+// @Summary		Regenerate existing codes
+// @Description	Renumber every record of an entity from 1 in creation order with its current policy, soft-deleted records included, then the children inheriting its code. Runs in one transaction.
+// @Tags			Code Policy
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			entity	path		string	true	"Entity (group, sub_group, type, backing, expedition, supplier, customer, item)"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespCodeMigration}	"Successfully regenerated codes"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - unknown entity"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/code-policy/{entity}/migrate [post]
+func (h *CodePolicyHandler) Migrate(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	// Get user ID from context
+	user := c.Get("user")
+	userID := ""
+	if user != nil {
+		if userModel, ok := user.(models.User); ok {
+			userID = userModel.ID.String()
+		}
+	}
+
+	res, err := h.Usecase.Migrate(ctx, c.Param("entity"), userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
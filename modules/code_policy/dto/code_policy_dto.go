@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/rendyfutsuy/base-go/models"
+)
+
+type ReqUpdateCodePolicy struct {
+	Prefix        string `json:"prefix" validate:"max=20"`
+	Separator     string `json:"separator" validate:"max=5"`
+	Padding       int    `json:"padding" validate:"required,min=1,max=10"`
+	InheritParent bool   `json:"inherit_parent"` // only for entities with a parent (sub_group, type, backing)
+	YearlyReset   bool   `json:"yearly_reset"`
+}
+
+type RespCodePolicy struct {
+	Entity        string    `json:"entity"`
+	Parent        string    `json:"parent,omitempty"` // entity whose code can be inherited
+	Prefix        string    `json:"prefix"`
+	Separator     string    `json:"separator"`
+	Padding       int       `json:"padding"`
+	InheritParent bool      `json:"inherit_parent"`
+	YearlyReset   bool      `json:"yearly_reset"`
+	Example       string    `json:"example"` // first code of the current year, using example codes for the parents
+	UpdatedAt     time.Time `json:"updated_at"`
+	UpdatedBy     string    `json:"updated_by"`
+}
+
+func ToRespCodePolicy(m models.CodePolicy, parent string, example string) RespCodePolicy {
+	return RespCodePolicy{
+		Entity:        m.Entity,
+		Parent:        parent,
+		Prefix:        m.Prefix,
+		Separator:     m.Separator,
+		Padding:       m.Padding,
+		InheritParent: m.InheritParent,
+		YearlyReset:   m.YearlyReset,
+		Example:       example,
+		UpdatedAt:     m.UpdatedAt,
+		UpdatedBy:     m.UpdatedBy,
+	}
+}
+
+type RespCodePreview struct {
+	Entity   string  `json:"entity"`
+	ParentID *string `json:"parent_id,omitempty"`
+	Code     string  `json:"code"` // code the next created record gets, not reserved
+}
+
+type RespCodeMigrationEntity struct {
+	Entity string `json:"entity"`
+	Count  int    `json:"count"`
+}
+
+type RespCodeMigration struct {
+	Entities []RespCodeMigrationEntity `json:"entities"` // the entity itself and the children inheriting its code
+}
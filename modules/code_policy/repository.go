@@ -0,0 +1,26 @@
+package code_policy
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/models"
+)
+
+type MigrateCodesParams struct {
+	Entity     string
+	MigratedBy string
+}
+
+// MigratedCodes is the number of records of an entity that got a new code
+type MigratedCodes struct {
+	Entity string
+	Count  int
+}
+
+type Repository interface {
+	GetAll(ctx context.Context) ([]models.CodePolicy, error)
+	Update(ctx context.Context, policy models.CodePolicy) (*models.CodePolicy, error)
+	Preview(ctx context.Context, entity string, parentID *uuid.UUID) (string, error)
+	Migrate(ctx context.Context, params MigrateCodesParams) ([]MigratedCodes, error)
+}
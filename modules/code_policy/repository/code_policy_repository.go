@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/helpers/codegen"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/code_policy"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type codePolicyRepository struct {
+	DB *gorm.DB
+}
+
+func NewCodePolicyRepository(db *gorm.DB) *codePolicyRepository {
+	return &codePolicyRepository{
+		DB: db,
+	}
+}
+
+func (r *codePolicyRepository) GetAll(ctx context.Context) ([]models.CodePolicy, error) {
+	var policies []models.CodePolicy
+	if err := r.DB.WithContext(ctx).Order("entity").Find(&policies).Error; err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// Update stores the policy, creating it when the entity still used the default policy
+func (r *codePolicyRepository) Update(ctx context.Context, policy models.CodePolicy) (*models.CodePolicy, error) {
+	err := r.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "entity"}},
+		DoUpdates: clause.AssignmentColumns([]string{"prefix", "separator", "padding", "inherit_parent", "yearly_reset", "updated_at", "updated_by"}),
+	}).Create(&policy).Error
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (r *codePolicyRepository) Preview(ctx context.Context, entity string, parentID *uuid.UUID) (string, error) {
+	return codegen.Preview(r.DB.WithContext(ctx), entity, parentID)
+}
+
+// Migrate renumbers every record of the entity (soft-deleted ones included) from 1 in creation order
+// using the current policy, then does the same for the children inheriting its code, in one transaction.
+func (r *codePolicyRepository) Migrate(ctx context.Context, params code_policy.MigrateCodesParams) ([]code_policy.MigratedCodes, error) {
+	var result []code_policy.MigratedCodes
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return migrateEntity(tx, params.Entity, params.MigratedBy, time.Now().UTC(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func migrateEntity(tx *gorm.DB, entity string, migratedBy string, now time.Time, result *[]code_policy.MigratedCodes) error {
+	e, ok := codegen.FindEntity(entity)
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+
+	var ids []uuid.UUID
+	if err := tx.Table(e.Table).Order("created_at, id").Pluck("id", &ids).Error; err != nil {
+		return err
+	}
+
+	// Release the current codes first so renumbering does not collide with them
+	if err := tx.Exec(fmt.Sprintf("UPDATE %s SET %s = '~' || id::TEXT", e.Table, e.CodeColumn)).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("entity = ?", e.Key).Delete(&models.CodeCounter{}).Error; err != nil {
+		return err
+	}
+	if err := codegen.Recode(tx, e.Key, ids, migratedBy, now); err != nil {
+		return err
+	}
+	*result = append(*result, code_policy.MigratedCodes{Entity: e.Key, Count: len(ids)})
+
+	for _, child := range codegen.Children(e.Key) {
+		policy, err := codegen.GetPolicy(tx, child.Key)
+		if err != nil {
+			return err
+		}
+		if !policy.InheritParent {
+			continue
+		}
+		if err := migrateEntity(tx, child.Key, migratedBy, now, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,342 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/codegen"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/code_policy"
+	codePolicyHttp "github.com/rendyfutsuy/base-go/modules/code_policy/delivery/http"
+	"github.com/rendyfutsuy/base-go/modules/code_policy/dto"
+	"github.com/rendyfutsuy/base-go/modules/code_policy/usecase"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockCodePolicyRepository is a mock implementation of code_policy.Repository
+type MockCodePolicyRepository struct {
+	mock.Mock
+}
+
+func (m *MockCodePolicyRepository) GetAll(ctx context.Context) ([]models.CodePolicy, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.CodePolicy), args.Error(1)
+}
+
+func (m *MockCodePolicyRepository) Update(ctx context.Context, policy models.CodePolicy) (*models.CodePolicy, error) {
+	args := m.Called(ctx, policy.Entity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.CodePolicy), args.Error(1)
+}
+
+func (m *MockCodePolicyRepository) Preview(ctx context.Context, entity string, parentID *uuid.UUID) (string, error) {
+	args := m.Called(ctx, entity, parentID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockCodePolicyRepository) Migrate(ctx context.Context, params code_policy.MigrateCodesParams) ([]code_policy.MigratedCodes, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]code_policy.MigratedCodes), args.Error(1)
+}
+
+// mockCodePolicyUsecase is a mock implementation of code_policy.Usecase
+type mockCodePolicyUsecase struct {
+	mock.Mock
+}
+
+func (m *mockCodePolicyUsecase) GetAll(ctx context.Context) ([]dto.RespCodePolicy, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.RespCodePolicy), args.Error(1)
+}
+
+func (m *mockCodePolicyUsecase) Update(ctx context.Context, entity string, reqBody *dto.ReqUpdateCodePolicy, userID string) (*dto.RespCodePolicy, error) {
+	args := m.Called(ctx, entity, reqBody, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.RespCodePolicy), args.Error(1)
+}
+
+func (m *mockCodePolicyUsecase) Preview(ctx context.Context, entity string, parentID string) (*dto.RespCodePreview, error) {
+	args := m.Called(ctx, entity, parentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.RespCodePreview), args.Error(1)
+}
+
+func (m *mockCodePolicyUsecase) Migrate(ctx context.Context, entity string, userID string) (*dto.RespCodeMigration, error) {
+	args := m.Called(ctx, entity, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.RespCodeMigration), args.Error(1)
+}
+
+func newEcho() *echo.Echo {
+	e := echo.New()
+	v := validator.New()
+	utils.RegisterCustomValidator(v)
+	e.Validator = &utils.CustomValidator{Validator: v}
+	return e
+}
+
+func TestFormat(t *testing.T) {
+	t.Run("Default policy keeps the legacy 2-digit codes", func(t *testing.T) {
+		policy := codegen.DefaultPolicy(constants.CodeEntityGroup)
+		assert.Equal(t, "07", codegen.Format(policy, "", 2026, 7))
+		assert.Equal(t, "123", codegen.Format(policy, "", 2026, 123))
+		assert.Equal(t, "", codegen.Scope(policy, "", 2026))
+	})
+
+	t.Run("Inherited parent code", func(t *testing.T) {
+		policy := models.CodePolicy{Separator: ".", Padding: 3, InheritParent: true}
+		assert.Equal(t, "01.02.005", codegen.Format(policy, "01.02", 2026, 5))
+		assert.Equal(t, "01.02", codegen.Scope(policy, "01.02", 2026))
+	})
+
+	t.Run("Prefix and yearly reset", func(t *testing.T) {
+		policy := models.CodePolicy{Prefix: "EXP-", Separator: "/", Padding: 4, YearlyReset: true}
+		assert.Equal(t, "EXP-2026/0042", codegen.Format(policy, "", 2026, 42))
+		assert.Equal(t, "2026", codegen.Scope(policy, "", 2026))
+	})
+
+	t.Run("Inherited parent code with yearly reset", func(t *testing.T) {
+		policy := models.CodePolicy{Separator: ".", Padding: 2, InheritParent: true, YearlyReset: true}
+		assert.Equal(t, "01.2026.01", codegen.Format(policy, "01", 2026, 1))
+		assert.Equal(t, "01|2026", codegen.Scope(policy, "01", 2026))
+	})
+}
+
+func TestEntities(t *testing.T) {
+	e, ok := codegen.FindEntity(constants.CodeEntityType)
+	assert.True(t, ok)
+	assert.Equal(t, "types", e.Table)
+	assert.Equal(t, constants.CodeEntitySubGroup, e.Parent)
+
+	_, ok = codegen.FindEntity("unknown")
+	assert.False(t, ok)
+
+	children := codegen.Children(constants.CodeEntitySubGroup)
+	require.Len(t, children, 1)
+	assert.Equal(t, constants.CodeEntityType, children[0].Key)
+	assert.Empty(t, codegen.Children(constants.CodeEntityExpedition))
+}
+
+func TestGetAll(t *testing.T) {
+	mockRepo := new(MockCodePolicyRepository)
+	uc := usecase.NewCodePolicyUsecase(mockRepo)
+	ctx := context.Background()
+
+	mockRepo.On("GetAll", ctx).Return([]models.CodePolicy{
+		{Entity: constants.CodeEntityGroup, Separator: ".", Padding: 2},
+		{Entity: constants.CodeEntitySubGroup, Separator: ".", Padding: 2, InheritParent: true},
+		{Entity: constants.CodeEntityType, Separator: ".", Padding: 3, InheritParent: true},
+	}, nil).Once()
+
+	res, err := uc.GetAll(ctx)
+
+	assert.NoError(t, err)
+	require.Len(t, res, len(codegen.Entities))
+	assert.Equal(t, "01", res[0].Example)
+	assert.Equal(t, "01.01", res[1].Example)
+	assert.Equal(t, constants.CodeEntityGroup, res[1].Parent)
+	assert.Equal(t, "01.01.001", res[2].Example)
+	// backing has no stored policy and falls back to the default
+	assert.Equal(t, constants.CodeEntityBacking, res[3].Entity)
+	assert.Equal(t, constants.CodePolicyDefaultPadding, res[3].Padding)
+	assert.Equal(t, "01", res[3].Example)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Positive case - policy updated", func(t *testing.T) {
+		mockRepo := new(MockCodePolicyRepository)
+		uc := usecase.NewCodePolicyUsecase(mockRepo)
+		saved := &models.CodePolicy{Entity: constants.CodeEntityBacking, Prefix: "B", Separator: "-", Padding: 4, InheritParent: true, UpdatedBy: "user-1"}
+
+		mockRepo.On("Update", ctx, constants.CodeEntityBacking).Return(saved, nil).Once()
+		mockRepo.On("GetAll", ctx).Return([]models.CodePolicy{}, nil).Once()
+
+		res, err := uc.Update(ctx, constants.CodeEntityBacking, &dto.ReqUpdateCodePolicy{Prefix: "B", Separator: "-", Padding: 4, InheritParent: true}, "user-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "B01-0001", res.Example)
+		assert.Equal(t, constants.CodeEntityType, res.Parent)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Negative case - unknown entity", func(t *testing.T) {
+		mockRepo := new(MockCodePolicyRepository)
+		uc := usecase.NewCodePolicyUsecase(mockRepo)
+
+		res, err := uc.Update(ctx, "warehouse", &dto.ReqUpdateCodePolicy{Padding: 2}, "user-1")
+
+		assert.Nil(t, res)
+		assert.EqualError(t, err, fmt.Sprintf(constants.CodePolicyEntityNotFound, "warehouse"))
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Negative case - inherit parent on root entity", func(t *testing.T) {
+		mockRepo := new(MockCodePolicyRepository)
+		uc := usecase.NewCodePolicyUsecase(mockRepo)
+
+		res, err := uc.Update(ctx, constants.CodeEntityExpedition, &dto.ReqUpdateCodePolicy{Padding: 2, InheritParent: true}, "user-1")
+
+		assert.Nil(t, res)
+		assert.EqualError(t, err, fmt.Sprintf(constants.CodePolicyInheritWithoutParent, constants.CodeEntityExpedition))
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPreview(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Positive case - with parent", func(t *testing.T) {
+		mockRepo := new(MockCodePolicyRepository)
+		uc := usecase.NewCodePolicyUsecase(mockRepo)
+		parentID := uuid.New()
+
+		mockRepo.On("Preview", ctx, constants.CodeEntityType, &parentID).Return("01.02.003", nil).Once()
+
+		res, err := uc.Preview(ctx, constants.CodeEntityType, parentID.String())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "01.02.003", res.Code)
+		assert.Equal(t, parentID.String(), *res.ParentID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Negative case - invalid parent id", func(t *testing.T) {
+		mockRepo := new(MockCodePolicyRepository)
+		uc := usecase.NewCodePolicyUsecase(mockRepo)
+
+		res, err := uc.Preview(ctx, constants.CodeEntityType, "not-a-uuid")
+
+		assert.Nil(t, res)
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "Preview", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Positive case - entity and inheriting children", func(t *testing.T) {
+		mockRepo := new(MockCodePolicyRepository)
+		uc := usecase.NewCodePolicyUsecase(mockRepo)
+		params := code_policy.MigrateCodesParams{Entity: constants.CodeEntitySubGroup, MigratedBy: "user-1"}
+
+		mockRepo.On("Migrate", ctx, params).Return([]code_policy.MigratedCodes{
+			{Entity: constants.CodeEntitySubGroup, Count: 3},
+			{Entity: constants.CodeEntityType, Count: 10},
+		}, nil).Once()
+
+		res, err := uc.Migrate(ctx, constants.CodeEntitySubGroup, "user-1")
+
+		assert.NoError(t, err)
+		require.Len(t, res.Entities, 2)
+		assert.Equal(t, 10, res.Entities[1].Count)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Negative case - repository error", func(t *testing.T) {
+		mockRepo := new(MockCodePolicyRepository)
+		uc := usecase.NewCodePolicyUsecase(mockRepo)
+
+		mockRepo.On("Migrate", ctx, mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		res, err := uc.Migrate(ctx, constants.CodeEntityGroup, "user-1")
+
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "db error")
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestCodePolicyHandler_UpdateSuccess(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodPut, "/v1/code-policy/type", strings.NewReader(`{"separator":".","padding":3,"inherit_parent":true}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("entity")
+	c.SetParamValues(constants.CodeEntityType)
+	userID := uuid.New()
+	c.Set("user", models.User{ID: userID})
+
+	mockUC := new(mockCodePolicyUsecase)
+	handler := &codePolicyHttp.CodePolicyHandler{Usecase: mockUC}
+
+	mockUC.On("Update", mock.Anything, constants.CodeEntityType, mock.AnythingOfType("*dto.ReqUpdateCodePolicy"), userID.String()).
+		Return(&dto.RespCodePolicy{Entity: constants.CodeEntityType, Padding: 3, InheritParent: true, UpdatedAt: time.Now()}, nil).Once()
+
+	err := handler.Update(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestCodePolicyHandler_UpdateValidationError(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodPut, "/v1/code-policy/type", strings.NewReader(`{"padding":20}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("entity")
+	c.SetParamValues(constants.CodeEntityType)
+
+	handler := &codePolicyHttp.CodePolicyHandler{Usecase: new(mockCodePolicyUsecase)}
+
+	err := handler.Update(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestCodePolicyHandler_PreviewSuccess(t *testing.T) {
+	e := newEcho()
+	parentID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodGet, "/v1/code-policy/backing/preview?parent_id="+parentID, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("entity")
+	c.SetParamValues(constants.CodeEntityBacking)
+
+	mockUC := new(mockCodePolicyUsecase)
+	handler := &codePolicyHttp.CodePolicyHandler{Usecase: mockUC}
+
+	mockUC.On("Preview", mock.Anything, constants.CodeEntityBacking, parentID).
+		Return(&dto.RespCodePreview{Entity: constants.CodeEntityBacking, ParentID: &parentID, Code: "12"}, nil).Once()
+
+	err := handler.Preview(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"code":"12"`)
+	mockUC.AssertExpectations(t)
+}
@@ -0,0 +1,14 @@
+package code_policy
+
+import (
+	"context"
+
+	"github.com/rendyfutsuy/base-go/modules/code_policy/dto"
+)
+
+type Usecase interface {
+	GetAll(ctx context.Context) ([]dto.RespCodePolicy, error)
+	Update(ctx context.Context, entity string, reqBody *dto.ReqUpdateCodePolicy, userID string) (*dto.RespCodePolicy, error)
+	Preview(ctx context.Context, entity string, parentID string) (*dto.RespCodePreview, error)
+	Migrate(ctx context.Context, entity string, userID string) (*dto.RespCodeMigration, error)
+}
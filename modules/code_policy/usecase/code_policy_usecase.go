@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/codegen"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/code_policy"
+	"github.com/rendyfutsuy/base-go/modules/code_policy/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+)
+
+type codePolicyUsecase struct {
+	repo mod.Repository
+}
+
+func NewCodePolicyUsecase(repo mod.Repository) mod.Usecase {
+	return &codePolicyUsecase{repo: repo}
+}
+
+func (u *codePolicyUsecase) GetAll(ctx context.Context) ([]dto.RespCodePolicy, error) {
+	stored, err := u.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := policiesByEntity(stored)
+	year := time.Now().Year()
+	res := make([]dto.RespCodePolicy, 0, len(codegen.Entities))
+	for _, e := range codegen.Entities {
+		res = append(res, dto.ToRespCodePolicy(policies[e.Key], e.Parent, exampleCode(policies, e.Key, year)))
+	}
+	return res, nil
+}
+
+func (u *codePolicyUsecase) Update(ctx context.Context, entity string, reqBody *dto.ReqUpdateCodePolicy, userID string) (*dto.RespCodePolicy, error) {
+	e, ok := codegen.FindEntity(entity)
+	if !ok {
+		return nil, fmt.Errorf(constants.CodePolicyEntityNotFound, entity)
+	}
+	if reqBody.InheritParent && e.Parent == "" {
+		return nil, fmt.Errorf(constants.CodePolicyInheritWithoutParent, entity)
+	}
+
+	saved, err := u.repo.Update(ctx, models.CodePolicy{
+		Entity:        e.Key,
+		Prefix:        reqBody.Prefix,
+		Separator:     reqBody.Separator,
+		Padding:       reqBody.Padding,
+		InheritParent: reqBody.InheritParent,
+		YearlyReset:   reqBody.YearlyReset,
+		UpdatedAt:     time.Now().UTC(),
+		UpdatedBy:     userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// reload the other policies, the example depends on the parents
+	stored, err := u.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policies := policiesByEntity(stored)
+	policies[e.Key] = *saved
+
+	res := dto.ToRespCodePolicy(*saved, e.Parent, exampleCode(policies, e.Key, time.Now().Year()))
+	return &res, nil
+}
+
+func (u *codePolicyUsecase) Preview(ctx context.Context, entity string, parentID string) (*dto.RespCodePreview, error) {
+	if _, ok := codegen.FindEntity(entity); !ok {
+		return nil, fmt.Errorf(constants.CodePolicyEntityNotFound, entity)
+	}
+
+	res := &dto.RespCodePreview{Entity: entity}
+	var pid *uuid.UUID
+	if parentID != "" {
+		id, err := utils.StringToUUID(parentID)
+		if err != nil {
+			return nil, err
+		}
+		pid = &id
+		res.ParentID = &parentID
+	}
+
+	code, err := u.repo.Preview(ctx, entity, pid)
+	if err != nil {
+		return nil, err
+	}
+	res.Code = code
+	return res, nil
+}
+
+func (u *codePolicyUsecase) Migrate(ctx context.Context, entity string, userID string) (*dto.RespCodeMigration, error) {
+	if _, ok := codegen.FindEntity(entity); !ok {
+		return nil, fmt.Errorf(constants.CodePolicyEntityNotFound, entity)
+	}
+
+	migrated, err := u.repo.Migrate(ctx, mod.MigrateCodesParams{Entity: entity, MigratedBy: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &dto.RespCodeMigration{Entities: make([]dto.RespCodeMigrationEntity, 0, len(migrated))}
+	for _, m := range migrated {
+		res.Entities = append(res.Entities, dto.RespCodeMigrationEntity{Entity: m.Entity, Count: m.Count})
+	}
+	return res, nil
+}
+
+// policiesByEntity returns the policy of every entity, the default one when not stored
+func policiesByEntity(stored []models.CodePolicy) map[string]models.CodePolicy {
+	policies := make(map[string]models.CodePolicy, len(codegen.Entities))
+	for _, e := range codegen.Entities {
+		policies[e.Key] = codegen.DefaultPolicy(e.Key)
+	}
+	for _, p := range stored {
+		policies[p.Entity] = p
+	}
+	return policies
+}
+
+// exampleCode returns the first code of an entity, built on the first code of its parents when inheriting them
+func exampleCode(policies map[string]models.CodePolicy, entity string, year int) string {
+	e, _ := codegen.FindEntity(entity)
+	policy := policies[entity]
+	parentCode := ""
+	if policy.InheritParent && e.Parent != "" {
+		parentCode = exampleCode(policies, e.Parent, year)
+	}
+	return codegen.Format(policy, parentCode, year, 1)
+}
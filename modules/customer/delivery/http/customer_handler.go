@@ -0,0 +1,371 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/middleware"
+	_reqContext "github.com/rendyfutsuy/base-go/helpers/middleware/request"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/customer"
+	"github.com/rendyfutsuy/base-go/modules/customer/dto"
+)
+
+type ResponseError struct {
+	Message string `json:"message"`
+}
+
+type Response struct {
+	Message string `json:"message"`
+}
+
+type CustomerHandler struct {
+	Usecase              customer.Usecase
+	validator            *validator.Validate
+	mwPageRequest        _reqContext.IMiddlewarePageRequest
+	middlewareAuth       middleware.IMiddlewareAuth
+	middlewarePermission middleware.IMiddlewarePermission
+}
+
+func NewCustomerHandler(e *echo.Echo, uc customer.Usecase, mwP _reqContext.IMiddlewarePageRequest, auth middleware.IMiddlewareAuth, middlewarePermission middleware.IMiddlewarePermission) {
+	h := &CustomerHandler{Usecase: uc, validator: validator.New(), mwPageRequest: mwP, middlewareAuth: auth, middlewarePermission: middlewarePermission}
+
+	r := e.Group("/v1/customer")
+	r.Use(h.middlewareAuth.AuthorizationCheck)
+
+	// Permissions
+	// View:   customer.view
+	// Create: customer.create
+	// Update: customer.update
+	// Delete: customer.delete
+	// Export: customer.export
+	permissionToView := []string{"customer.view"}
+	permissionToCreate := []string{"customer.create"}
+	permissionToUpdate := []string{"customer.update"}
+	permissionToDelete := []string{"customer.delete"}
+	permissionToExport := []string{"customer.export"}
+
+	// Index with pagination + search
+	r.GET("", h.GetIndex, middleware.RequireActivatedUser, h.mwPageRequest.PageRequestCtx, h.middlewarePermission.PermissionValidation(permissionToView))
+
+	// Export (no pagination, same filters) - must be before /:id to avoid route conflict
+	r.GET("/export", h.Export, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToExport))
+
+	// Get by ID (detail) - must be after /export to avoid route conflict
+	r.GET("/:id", h.GetByID, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+
+	// Create
+	r.POST("", h.Create, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToCreate))
+
+	// Update
+	r.PUT("/:id", h.Update, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+
+	// Delete
+	r.DELETE("/:id", h.Delete, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToDelete))
+}
+
+// Create godoc
+// @Summary		Create a new customer
+// @Description	Create a new customer with provided information. Accepts JSON body with addresses (array of billing/shipping addresses, contoh: [{&quot;address_type&quot;:&quot;billing&quot;,&quot;address&quot;:&quot;Jl. Asia Afrika 1&quot;,&quot;province_id&quot;:&quot;...&quot;,&quot;city_id&quot;:&quot;...&quot;}]), telp_numbers (array of objects where area_code optional and phone_number required) and phone_numbers arrays. First address of each address_type, first index of telp_numbers and first index of phone_numbers automatically become primary. Address regions must follow the province > city > district > subdistrict hierarchy, customer_category_id must be a customer_category parameter and expedition_send_id is the preferred expedition for shipments to this customer. Customer code is automatically generated by the system. Requires 'api.master-data.customer.create' permission.
+// @Tags			Customer
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	body		dto.ReqCreateCustomer	true	"Customer creation data. Fields: customer_name (required), customer_category_id, expedition_send_id, payment_term_days (0-365), credit_limit (min 0), addresses (array), telp_numbers (array, first index becomes primary telp), phone_numbers (array, first index becomes primary hp), notes (optional)"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespCustomer}	"Successfully created customer with full details including addresses and contacts"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, duplicate customer name or invalid category/expedition/address region"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/customer [post]
+func (h *CustomerHandler) Create(c echo.Context) error {
+	req := new(dto.ReqCreateCustomer)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := bindCustomerAddressesFromForm(c, &req.Addresses); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := bindCustomerTelpNumbersFromForm(c, &req.TelpNumbers); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	user := c.Get("user")
+	userID := ""
+	if user != nil {
+		if userModel, ok := user.(models.User); ok {
+			userID = userModel.ID.String()
+		}
+	}
+	res, err := h.Usecase.Create(c.Request().Context(), req, userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	return h.respondDetail(c, res.ID.String())
+}
+
+// Update godoc
+// @Summary		Update customer
+// @Description	Update an existing customer's information. Accepts JSON body with addresses, telp_numbers (array of objects where area_code optional and phone_number required) and phone_numbers arrays. First address of each address_type, first index of telp_numbers and first index of phone_numbers automatically become primary. Address regions must follow the province > city > district > subdistrict hierarchy. Existing addresses and contacts will be hard deleted before new ones are created. The response includes full customer details with all addresses and contacts. Requires 'api.master-data.customer.update' permission.
+// @Tags			Customer
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string					true	"Customer UUID"
+// @Param			request	body	dto.ReqUpdateCustomer	true	"Updated customer data. Fields: customer_name (required), customer_category_id, expedition_send_id, payment_term_days (0-365), credit_limit (min 0), addresses (array), telp_numbers (array, first index becomes primary telp), phone_numbers (array, first index becomes primary hp), notes (optional)"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespCustomer}	"Successfully updated customer with full details including addresses and contacts"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, duplicate customer name or invalid category/expedition/address region"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Failure		404		{object}	response.NonPaginationResponse	"Customer not found"
+// @Router			/v1/customer/{id} [put]
+func (h *CustomerHandler) Update(c echo.Context) error {
+	id := c.Param("id")
+	req := new(dto.ReqUpdateCustomer)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := bindCustomerAddressesFromForm(c, &req.Addresses); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := bindCustomerTelpNumbersFromForm(c, &req.TelpNumbers); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	user := c.Get("user")
+	userID := ""
+	if user != nil {
+		if userModel, ok := user.(models.User); ok {
+			userID = userModel.ID.String()
+		}
+	}
+	_, err := h.Usecase.Update(c.Request().Context(), id, req, userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	return h.respondDetail(c, id)
+}
+
+// Delete godoc
+// @Summary		Soft delete customer
+// @Description	Soft delete an existing customer by ID. The customer will be marked as deleted (deleted_at is set) but remains in the database. Requires 'api.master-data.customer.delete' permission.
+// @Tags			Customer
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string	true	"Customer UUID"
+// @Success		200		{object}	response.NonPaginationResponse	"Successfully soft deleted customer"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid UUID"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Failure		404		{object}	response.NonPaginationResponse	"Customer not found"
+// @Router			/v1/customer/{id} [delete]
+func (h *CustomerHandler) Delete(c echo.Context) error {
+	id := c.Param("id")
+	user := c.Get("user")
+	userID := ""
+	if user != nil {
+		if userModel, ok := user.(models.User); ok {
+			userID = userModel.ID.String()
+		}
+	}
+	if err := h.Usecase.Delete(c.Request().Context(), id, userID); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(Response{Message: constants.CustomerDeleteSuccess})
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetIndex godoc
+// @Summary		Get list of customers with pagination
+// @Description	Retrieve a paginated list of customers with optional search and filters. Supports multiple filter values for each field. Only returns non-deleted customers. Requires 'api.master-data.customer.view' permission.
+// @Tags			Customer
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			page					query		int			false	"Page number (default: 1)"
+// @Param			per_page				query		int			false	"Items per page (default: 10)"
+// @Param			sort_by					query		string		false	"Sort column (allowed: id, customer_code, customer_name, customer_category_name, expedition_send_name, payment_term_days, credit_limit, shipping_city_name, phone_number, telp_number, created_at, updated_at)"
+// @Param			sort_order				query		string		false	"Sort order: asc or desc (default: desc)"
+// @Param			search					query		string		false	"Search keyword (searches in customer_name, customer_code, addresses, address city, category, expedition name and phone numbers from contacts)"
+// @Param			customer_codes			query		[]string	false	"Filter by customer codes (multiple values)"
+// @Param			customer_names			query		[]string	false	"Filter by customer names (multiple values)"
+// @Param			customer_category_ids	query		[]string	false	"Filter by customer category IDs (multiple values)"
+// @Param			expedition_send_ids		query		[]string	false	"Filter by preferred expedition IDs (multiple values)"
+// @Param			province_ids			query		[]string	false	"Filter by address province IDs (multiple values)"
+// @Param			city_ids				query		[]string	false	"Filter by address city IDs (multiple values)"
+// @Param			telp_numbers			query		[]string	false	"Filter by telp numbers (multiple values)"
+// @Param			phone_numbers			query		[]string	false	"Filter by phone numbers (multiple values)"
+// @Success		200						{object}	response.PaginationResponse{data=[]dto.RespCustomerIndex}	"Successfully retrieved customers"
+// @Failure		400						{object}	response.NonPaginationResponse	"Bad request - invalid query parameters"
+// @Failure		401						{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403						{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/customer [get]
+func (h *CustomerHandler) GetIndex(c echo.Context) error {
+	pageRequest := c.Get("page_request").(*request.PageRequest)
+
+	// validate filter req.
+	// initialize filter
+	filter := new(dto.ReqCustomerIndexFilter)
+
+	// Bind form-data to the DTO
+	if err := c.Bind(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// Validate the request if necessary
+	if err := c.Validate(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, total, err := h.Usecase.GetIndex(c.Request().Context(), *pageRequest, *filter)
+
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	respCustomer := []dto.RespCustomerIndex{}
+
+	for _, v := range res {
+		respCustomer = append(respCustomer, dto.ToRespCustomerIndex(v))
+	}
+
+	respPag := response.PaginationResponse{}
+	respPag, err = respPag.SetResponse(respCustomer, total, pageRequest.PerPage, pageRequest.Page)
+
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, respPag)
+}
+
+// GetByID godoc
+// @Summary		Get customer by ID
+// @Description	Retrieve a single customer by its UUID. The response includes full customer details with all addresses (resolved region names) and contacts (telp_numbers and phone_numbers arrays). Only returns non-deleted customers. Requires 'api.master-data.customer.view' permission.
+// @Tags			Customer
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string	true	"Customer UUID"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespCustomer}	"Successfully retrieved customer with full details including addresses and contacts"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid UUID"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Failure		404		{object}	response.NonPaginationResponse	"Customer not found"
+// @Router			/v1/customer/{id} [get]
+func (h *CustomerHandler) GetByID(c echo.Context) error {
+	return h.respondDetail(c, c.Param("id"))
+}
+
+// Export godoc
+// @Summary		Export customers to Excel
+// @Description	Export customers to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination. Supports multiple filter values for each field. Only exports non-deleted customers. Excel file includes: Kode Customer, Nama Customer, Kategori, Ekspedisi, Termin (Hari), Limit Kredit, Alamat Penagihan, Alamat Pengiriman (primary addresses), No HP, No Telp, Update Date. Requires 'api.master-data.customer.export' permission.
+// @Tags			Customer
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Param			search					query		string		false	"Search keyword (searches in customer_name, customer_code, addresses, address city, category, expedition name and phone numbers from contacts)"
+// @Param			customer_codes			query		[]string	false	"Filter by customer codes (multiple values)"
+// @Param			customer_names			query		[]string	false	"Filter by customer names (multiple values)"
+// @Param			customer_category_ids	query		[]string	false	"Filter by customer category IDs (multiple values)"
+// @Param			expedition_send_ids		query		[]string	false	"Filter by preferred expedition IDs (multiple values)"
+// @Param			province_ids			query		[]string	false	"Filter by address province IDs (multiple values)"
+// @Param			city_ids				query		[]string	false	"Filter by address city IDs (multiple values)"
+// @Param			telp_numbers			query		[]string	false	"Filter by telp numbers (multiple values)"
+// @Param			phone_numbers			query		[]string	false	"Filter by phone numbers (multiple values)"
+// @Success		200						{file}		binary	"Excel file (customers.xlsx) with customers data"
+// @Failure		400						{object}	response.NonPaginationResponse	"Bad request - invalid query parameters"
+// @Failure		401						{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403						{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/customer/export [get]
+func (h *CustomerHandler) Export(c echo.Context) error {
+	// validate filter req.
+	// initialize filter
+	filter := new(dto.ReqCustomerIndexFilter)
+
+	// Bind form-data to the DTO
+	if err := c.Bind(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// Validate the request if necessary
+	if err := c.Validate(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	excelBytes, err := h.Usecase.Export(c.Request().Context(), *filter)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, constants.ExcelContent)
+	c.Response().Header().Set(constants.FieldContentDisposition, constants.ExcelContentDisposition("customers.xlsx"))
+	return c.Blob(http.StatusOK, constants.ExcelContent, excelBytes)
+}
+
+// respondDetail loads the customer with its addresses and contacts and writes the detail response
+func (h *CustomerHandler) respondDetail(c echo.Context, id string) error {
+	res, err := h.Usecase.GetByID(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	addresses, err := h.Usecase.GetAddressesByCustomerID(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	contacts, err := h.Usecase.GetContactsByCustomerID(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespCustomer(*res, addresses, contacts))
+	return c.JSON(http.StatusOK, resp)
+}
+
+func bindCustomerAddressesFromForm(c echo.Context, addresses *[]dto.CustomerAddressItem) error {
+	raw := strings.TrimSpace(c.FormValue("addresses"))
+	if raw == "" {
+		return nil
+	}
+
+	var parsed []dto.CustomerAddressItem
+	if err := json.Unmarshal([]byte(raw), &parsed); err != nil {
+		return fmt.Errorf("invalid addresses format: %w", err)
+	}
+
+	*addresses = parsed
+	return nil
+}
+
+func bindCustomerTelpNumbersFromForm(c echo.Context, telpNumbers *[]dto.TelpNumberItem) error {
+	raw := strings.TrimSpace(c.FormValue("telp_numbers"))
+	if raw == "" {
+		return nil
+	}
+
+	var parsed []dto.TelpNumberItem
+	if err := json.Unmarshal([]byte(raw), &parsed); err != nil {
+		return fmt.Errorf("invalid telp_numbers format: %w", err)
+	}
+
+	*telpNumbers = parsed
+	return nil
+}
@@ -0,0 +1,221 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+)
+
+// TelpNumberItem represents telp number with area code
+type TelpNumberItem struct {
+	AreaCode    *string `json:"area_code" form:"area_code"`
+	PhoneNumber string  `json:"phone_number" form:"phone_number" validate:"required"`
+}
+
+// CustomerAddressItem represents a billing or shipping address resolved to the regency hierarchy
+type CustomerAddressItem struct {
+	AddressType   string     `json:"address_type" validate:"required,oneof=billing shipping"`
+	Address       string     `json:"address" validate:"required,max=255"`
+	ProvinceID    *uuid.UUID `json:"province_id"`
+	CityID        *uuid.UUID `json:"city_id"`
+	DistrictID    *uuid.UUID `json:"district_id"`
+	SubdistrictID *uuid.UUID `json:"subdistrict_id"`
+	PostalCode    *string    `json:"postal_code" validate:"omitempty,max=10"`
+}
+
+// Regency returns the regency hierarchy of the address
+func (a CustomerAddressItem) Regency() CustomerRegency {
+	return CustomerRegency{ProvinceID: a.ProvinceID, CityID: a.CityID, DistrictID: a.DistrictID, SubdistrictID: a.SubdistrictID}
+}
+
+// CustomerRegency holds the regency hierarchy of a customer address
+type CustomerRegency struct {
+	ProvinceID    *uuid.UUID
+	CityID        *uuid.UUID
+	DistrictID    *uuid.UUID
+	SubdistrictID *uuid.UUID
+}
+
+type ReqCreateCustomer struct {
+	CustomerName       string                `form:"customer_name" json:"customer_name" validate:"required,max=255"`
+	CustomerCategoryID *uuid.UUID            `form:"customer_category_id" json:"customer_category_id"`
+	ExpeditionSendID   *uuid.UUID            `form:"expedition_send_id" json:"expedition_send_id"`
+	PaymentTermDays    int                   `form:"payment_term_days" json:"payment_term_days" validate:"min=0,max=365"`
+	CreditLimit        float64               `form:"credit_limit" json:"credit_limit" validate:"min=0"`
+	Addresses          []CustomerAddressItem `form:"-" json:"addresses" validate:"omitempty,dive"`
+	TelpNumbers        []TelpNumberItem      `form:"-" json:"telp_numbers" validate:"omitempty"`
+	PhoneNumbers       []string              `form:"phone_numbers" json:"phone_numbers" validate:"omitempty"`
+	Notes              *string               `form:"notes" json:"notes,omitempty"`
+}
+
+type ReqUpdateCustomer struct {
+	CustomerName       string                `form:"customer_name" json:"customer_name" validate:"required,max=255"`
+	CustomerCategoryID *uuid.UUID            `form:"customer_category_id" json:"customer_category_id"`
+	ExpeditionSendID   *uuid.UUID            `form:"expedition_send_id" json:"expedition_send_id"`
+	PaymentTermDays    int                   `form:"payment_term_days" json:"payment_term_days" validate:"min=0,max=365"`
+	CreditLimit        float64               `form:"credit_limit" json:"credit_limit" validate:"min=0"`
+	Addresses          []CustomerAddressItem `form:"-" json:"addresses" validate:"omitempty,dive"`
+	TelpNumbers        []TelpNumberItem      `form:"-" json:"telp_numbers"`
+	PhoneNumbers       []string              `form:"phone_numbers" json:"phone_numbers"`
+	Notes              *string               `form:"notes" json:"notes,omitempty"`
+}
+
+// RespCustomerReference represents a referenced record (region, category or expedition)
+type RespCustomerReference struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+func toRespCustomerReference(id *uuid.UUID, name *string) *RespCustomerReference {
+	if id == nil {
+		return nil
+	}
+	ref := &RespCustomerReference{ID: *id}
+	if name != nil {
+		ref.Name = *name
+	}
+	return ref
+}
+
+type RespCustomerAddress struct {
+	ID          uuid.UUID              `json:"id"`
+	AddressType string                 `json:"address_type"`
+	Address     string                 `json:"address"`
+	Province    *RespCustomerReference `json:"province"`
+	City        *RespCustomerReference `json:"city"`
+	District    *RespCustomerReference `json:"district"`
+	Subdistrict *RespCustomerReference `json:"subdistrict"`
+	PostalCode  *string                `json:"postal_code"`
+	IsPrimary   bool                   `json:"is_primary"`
+}
+
+type RespCustomer struct {
+	ID               uuid.UUID              `json:"id"`
+	CustomerCode     string                 `json:"customer_code"`
+	CustomerName     string                 `json:"customer_name"`
+	CustomerCategory *RespCustomerReference `json:"customer_category"`
+	ExpeditionSend   *RespCustomerReference `json:"expedition_send"`
+	PaymentTermDays  int                    `json:"payment_term_days"`
+	CreditLimit      float64                `json:"credit_limit"`
+	Addresses        []RespCustomerAddress  `json:"addresses"`
+	TelpNumbers      []TelpNumberItem       `json:"telp_numbers"`
+	PhoneNumbers     []string               `json:"phone_numbers"`
+	Notes            *string                `json:"notes,omitempty"`
+	CreatedAt        string                 `json:"created_at"`
+	CreatedBy        string                 `json:"created_by"`
+	UpdatedAt        string                 `json:"updated_at"`
+	UpdatedBy        string                 `json:"updated_by"`
+}
+
+func ToRespCustomer(m models.Customer, addresses []models.CustomerAddress, contacts []models.CustomerContact) RespCustomer {
+	respAddresses := []RespCustomerAddress{}
+	for _, address := range addresses {
+		respAddresses = append(respAddresses, RespCustomerAddress{
+			ID:          address.ID,
+			AddressType: address.AddressType,
+			Address:     address.Address,
+			Province:    toRespCustomerReference(address.ProvinceID, address.ProvinceName),
+			City:        toRespCustomerReference(address.CityID, address.CityName),
+			District:    toRespCustomerReference(address.DistrictID, address.DistrictName),
+			Subdistrict: toRespCustomerReference(address.SubdistrictID, address.SubdistrictName),
+			PostalCode:  address.PostalCode,
+			IsPrimary:   address.IsPrimary,
+		})
+	}
+
+	// Map contacts to response
+	telpNumbers := []TelpNumberItem{}
+	phoneNumbers := []string{}
+	for _, contact := range contacts {
+		if contact.PhoneType == constants.CustomerContactTypeTelp {
+			telpNumbers = append(telpNumbers, TelpNumberItem{
+				AreaCode:    contact.AreaCode,
+				PhoneNumber: contact.PhoneNumber,
+			})
+		} else {
+			phoneNumbers = append(phoneNumbers, contact.PhoneNumber)
+		}
+	}
+
+	return RespCustomer{
+		ID:               m.ID,
+		CustomerCode:     m.CustomerCode,
+		CustomerName:     m.CustomerName,
+		CustomerCategory: toRespCustomerReference(m.CustomerCategoryID, m.CustomerCategoryName),
+		ExpeditionSend:   toRespCustomerReference(m.ExpeditionSendID, m.ExpeditionSendName),
+		PaymentTermDays:  m.PaymentTermDays,
+		CreditLimit:      m.CreditLimit,
+		Addresses:        respAddresses,
+		TelpNumbers:      telpNumbers,
+		PhoneNumbers:     phoneNumbers,
+		Notes:            m.Notes,
+		CreatedAt:        m.CreatedAt.Format("2006-01-02 15:04:05"),
+		CreatedBy:        m.CreatedBy,
+		UpdatedAt:        m.UpdatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedBy:        m.UpdatedBy,
+	}
+}
+
+type RespCustomerIndex struct {
+	ID                   uuid.UUID `json:"id"`
+	CustomerCode         string    `json:"customer_code"`
+	CustomerName         string    `json:"customer_name"`
+	CustomerCategoryName *string   `json:"customer_category_name"`
+	ExpeditionSendName   *string   `json:"expedition_send_name"`
+	PaymentTermDays      int       `json:"payment_term_days"`
+	CreditLimit          float64   `json:"credit_limit"`
+	ShippingCityName     *string   `json:"shipping_city_name"`
+	PhoneNumber          *string   `json:"phone_number"`
+	TelpNumber           *string   `json:"telp_number"`
+	CreatedAt            string    `json:"created_at"`
+	UpdatedAt            string    `json:"updated_at"`
+}
+
+func ToRespCustomerIndex(m models.Customer) RespCustomerIndex {
+	return RespCustomerIndex{
+		ID:                   m.ID,
+		CustomerCode:         m.CustomerCode,
+		CustomerName:         m.CustomerName,
+		CustomerCategoryName: m.CustomerCategoryName,
+		ExpeditionSendName:   m.ExpeditionSendName,
+		PaymentTermDays:      m.PaymentTermDays,
+		CreditLimit:          m.CreditLimit,
+		ShippingCityName:     m.ShippingCityName,
+		PhoneNumber:          m.PrimaryPhoneNumber,
+		TelpNumber:           m.PrimaryTelpNumber,
+		CreatedAt:            m.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:            m.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// CustomerExport represents customer data for export with all addresses and phone numbers
+type CustomerExport struct {
+	CustomerCode         string
+	CustomerName         string
+	CustomerCategoryName *string
+	ExpeditionSendName   *string
+	PaymentTermDays      int
+	CreditLimit          float64
+	BillingAddresses     []string // All billing addresses, primary first
+	ShippingAddresses    []string // All shipping addresses, primary first
+	PhoneNumbers         []string // All HP phone numbers
+	TelpNumbers          []string // All Telp phone numbers
+	UpdatedAt            time.Time
+}
+
+// ReqCustomerIndexFilter for filtering customer index and export
+type ReqCustomerIndexFilter struct {
+	Search              string   `query:"search" json:"search"` // Search keyword for filtering by customer_name, customer_code and addresses
+	CustomerCodes       []string `query:"customer_codes" json:"customer_codes"`
+	CustomerNames       []string `query:"customer_names" json:"customer_names"`
+	CustomerCategoryIDs []string `query:"customer_category_ids" json:"customer_category_ids" validate:"omitempty,dive,uuid"`
+	ExpeditionSendIDs   []string `query:"expedition_send_ids" json:"expedition_send_ids" validate:"omitempty,dive,uuid"`
+	ProvinceIDs         []string `query:"province_ids" json:"province_ids" validate:"omitempty,dive,uuid"`
+	CityIDs             []string `query:"city_ids" json:"city_ids" validate:"omitempty,dive,uuid"`
+	TelpNumbers         []string `query:"telp_numbers" json:"telp_numbers"`
+	PhoneNumbers        []string `query:"phone_numbers" json:"phone_numbers"`
+	SortBy              string   `query:"sort_by" json:"sort_by"`
+	SortOrder           string   `query:"sort_order" json:"sort_order"`
+}
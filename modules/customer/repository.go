@@ -0,0 +1,53 @@
+package customer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/customer/dto"
+)
+
+// CreateCustomerParams contains parameters for creating a customer
+type CreateCustomerParams struct {
+	CustomerName       string
+	CustomerCategoryID *uuid.UUID
+	ExpeditionSendID   *uuid.UUID
+	PaymentTermDays    int
+	CreditLimit        float64
+	Addresses          []dto.CustomerAddressItem
+	TelpNumbers        []dto.TelpNumberItem
+	PhoneNumbers       []string
+	Notes              *string
+	CreatedBy          string
+}
+
+// UpdateCustomerParams contains parameters for updating a customer
+type UpdateCustomerParams struct {
+	CustomerName       string
+	CustomerCategoryID *uuid.UUID
+	ExpeditionSendID   *uuid.UUID
+	PaymentTermDays    int
+	CreditLimit        float64
+	Addresses          []dto.CustomerAddressItem
+	TelpNumbers        []dto.TelpNumberItem
+	PhoneNumbers       []string
+	Notes              *string
+	UpdatedBy          string
+}
+
+type Repository interface {
+	Create(ctx context.Context, params CreateCustomerParams) (*models.Customer, error)
+	Update(ctx context.Context, id uuid.UUID, params UpdateCustomerParams) (*models.Customer, error)
+	Delete(ctx context.Context, id uuid.UUID, deletedBy string) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Customer, error)
+	GetAddressesByCustomerID(ctx context.Context, customerID uuid.UUID) ([]models.CustomerAddress, error)
+	GetContactsByCustomerID(ctx context.Context, customerID uuid.UUID) ([]models.CustomerContact, error)
+	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqCustomerIndexFilter) ([]models.Customer, int, error)
+	GetAll(ctx context.Context, filter dto.ReqCustomerIndexFilter) ([]models.Customer, error)
+	GetAllForExport(ctx context.Context, filter dto.ReqCustomerIndexFilter) ([]dto.CustomerExport, error)
+	ExistsByCustomerName(ctx context.Context, customerName string, excludeID uuid.UUID) (bool, error)
+	ExistsExpeditionByID(ctx context.Context, expeditionID uuid.UUID) (bool, error)
+	ExistsRegencyHierarchy(ctx context.Context, regency dto.CustomerRegency) (bool, error)
+}
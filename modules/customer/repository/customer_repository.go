@@ -0,0 +1,609 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/codegen"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/customer"
+	"github.com/rendyfutsuy/base-go/modules/customer/dto"
+	rsearchcustomer "github.com/rendyfutsuy/base-go/modules/customer/repository/searches"
+	"gorm.io/gorm"
+)
+
+// customerSummaryColumns selects the primary shipping city, primary telp (with area code) and primary hp of a customer
+const customerSummaryColumns = `
+	(SELECT ct.name FROM customer_addresses ca_ship
+	 JOIN cities ct ON ct.id = ca_ship.city_id
+	 WHERE ca_ship.customer_id = cu.id AND ca_ship.address_type = 'shipping' AND ca_ship.is_primary = true AND ca_ship.deleted_at IS NULL
+	 LIMIT 1) as shipping_city_name,
+	(SELECT CASE
+		WHEN cc_telp.area_code IS NULL OR cc_telp.area_code = '' THEN cc_telp.phone_number
+		ELSE cc_telp.area_code || '-' || cc_telp.phone_number
+	END
+	 FROM customer_contacts cc_telp
+	 WHERE cc_telp.customer_id = cu.id AND cc_telp.phone_type = 'telp' AND cc_telp.is_primary = true AND cc_telp.deleted_at IS NULL
+	 LIMIT 1) as primary_telp_number,
+	(SELECT cc_hp.phone_number FROM customer_contacts cc_hp WHERE cc_hp.customer_id = cu.id AND cc_hp.phone_type = 'hp' AND cc_hp.is_primary = true AND cc_hp.deleted_at IS NULL LIMIT 1) as primary_phone_number`
+
+type customerRepository struct {
+	DB *gorm.DB
+}
+
+func NewCustomerRepository(db *gorm.DB) *customerRepository {
+	return &customerRepository{
+		DB: db,
+	}
+}
+
+// customerQuery joins the category parameter and the preferred expedition of a customer
+func (r *customerRepository) customerQuery(ctx context.Context) *gorm.DB {
+	return r.DB.WithContext(ctx).Table("customers cu").
+		Joins("LEFT JOIN parameters pc ON pc.id = cu.customer_category_id AND pc.deleted_at IS NULL").
+		Joins("LEFT JOIN expeditions e ON e.id = cu.expedition_send_id AND e.deleted_at IS NULL").
+		Where("cu.deleted_at IS NULL")
+}
+
+// buildCustomerAddresses maps address items to addresses, the first address of each type becomes primary
+func buildCustomerAddresses(customerID uuid.UUID, items []dto.CustomerAddressItem, actor string, now time.Time) []models.CustomerAddress {
+	addresses := make([]models.CustomerAddress, 0, len(items))
+	hasPrimary := map[string]bool{}
+
+	for _, item := range items {
+		addresses = append(addresses, models.CustomerAddress{
+			CustomerID:    customerID,
+			AddressType:   item.AddressType,
+			Address:       item.Address,
+			ProvinceID:    item.ProvinceID,
+			CityID:        item.CityID,
+			DistrictID:    item.DistrictID,
+			SubdistrictID: item.SubdistrictID,
+			PostalCode:    item.PostalCode,
+			IsPrimary:     !hasPrimary[item.AddressType],
+			CreatedAt:     now,
+			CreatedBy:     actor,
+			UpdatedAt:     now,
+			UpdatedBy:     actor,
+		})
+		hasPrimary[item.AddressType] = true
+	}
+
+	return addresses
+}
+
+// buildCustomerContacts maps telp and hp numbers to contacts, the first index of each type becomes primary
+func buildCustomerContacts(customerID uuid.UUID, telpNumbers []dto.TelpNumberItem, phoneNumbers []string, actor string, now time.Time) []models.CustomerContact {
+	contacts := make([]models.CustomerContact, 0)
+
+	for i, telp := range telpNumbers {
+		if telp.PhoneNumber != "" {
+			contacts = append(contacts, models.CustomerContact{
+				CustomerID:  customerID,
+				PhoneType:   constants.CustomerContactTypeTelp,
+				PhoneNumber: telp.PhoneNumber,
+				AreaCode:    telp.AreaCode,
+				IsPrimary:   i == 0, // First telp is always primary
+				CreatedAt:   now,
+				CreatedBy:   actor,
+				UpdatedAt:   now,
+				UpdatedBy:   actor,
+			})
+		}
+	}
+
+	for i, phoneNumber := range phoneNumbers {
+		if phoneNumber != "" {
+			contacts = append(contacts, models.CustomerContact{
+				CustomerID:  customerID,
+				PhoneType:   constants.CustomerContactTypePhone,
+				PhoneNumber: phoneNumber,
+				IsPrimary:   i == 0, // First hp is always primary
+				CreatedAt:   now,
+				CreatedBy:   actor,
+				UpdatedAt:   now,
+				UpdatedBy:   actor,
+			})
+		}
+	}
+
+	return contacts
+}
+
+// createCustomerChildren inserts the addresses and contacts of a customer within the given transaction
+func createCustomerChildren(tx *gorm.DB, customerID uuid.UUID, addressItems []dto.CustomerAddressItem, telpNumbers []dto.TelpNumberItem, phoneNumbers []string, actor string, now time.Time) error {
+	addresses := buildCustomerAddresses(customerID, addressItems, actor, now)
+	if len(addresses) > 0 {
+		if err := tx.Create(&addresses).Error; err != nil {
+			return err
+		}
+	}
+
+	contacts := buildCustomerContacts(customerID, telpNumbers, phoneNumbers, actor, now)
+	if len(contacts) > 0 {
+		if err := tx.Create(&contacts).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *customerRepository) Create(ctx context.Context, params customer.CreateCustomerParams) (*models.Customer, error) {
+	now := time.Now().UTC()
+	cus := &models.Customer{
+		CustomerName:       params.CustomerName,
+		CustomerCategoryID: params.CustomerCategoryID,
+		ExpeditionSendID:   params.ExpeditionSendID,
+		PaymentTermDays:    params.PaymentTermDays,
+		CreditLimit:        params.CreditLimit,
+		Notes:              params.Notes,
+		CreatedAt:          now,
+		CreatedBy:          params.CreatedBy,
+		UpdatedAt:          now,
+		UpdatedBy:          params.CreatedBy,
+	}
+
+	// Start transaction
+	tx := r.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// customer_code is allocated from the customer code policy in the same transaction
+	code, err := codegen.Allocate(tx, constants.CodeEntityCustomer, nil)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	cus.CustomerCode = code
+	if err := tx.Create(cus).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if cus.ID == uuid.Nil {
+		tx.Rollback()
+		return nil, errors.New(constants.CustomerCreateFailedIDNotSet)
+	}
+
+	if err := createCustomerChildren(tx, cus.ID, params.Addresses, params.TelpNumbers, params.PhoneNumbers, params.CreatedBy, now); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// Commit transaction
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return cus, nil
+}
+
+func (r *customerRepository) Update(ctx context.Context, id uuid.UUID, params customer.UpdateCustomerParams) (*models.Customer, error) {
+	now := time.Now().UTC()
+	updates := map[string]interface{}{
+		"customer_name":        params.CustomerName,
+		"customer_category_id": params.CustomerCategoryID,
+		"expedition_send_id":   params.ExpeditionSendID,
+		"payment_term_days":    params.PaymentTermDays,
+		"credit_limit":         params.CreditLimit,
+		"updated_at":           now,
+		"updated_by":           params.UpdatedBy,
+	}
+	if params.Notes != nil {
+		updates["notes"] = *params.Notes
+	} else {
+		updates["notes"] = nil
+	}
+
+	// Start transaction
+	tx := r.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	cus := &models.Customer{}
+	err := tx.Model(&models.Customer{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(updates).
+		Take(cus).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// Always hard delete existing addresses and contacts before creating new ones
+	if err := tx.Unscoped().Where("customer_id = ?", id).
+		Delete(&models.CustomerAddress{}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Unscoped().Where("customer_id = ?", id).
+		Delete(&models.CustomerContact{}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := createCustomerChildren(tx, cus.ID, params.Addresses, params.TelpNumbers, params.PhoneNumbers, params.UpdatedBy, now); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// Commit transaction
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return cus, nil
+}
+
+func (r *customerRepository) Delete(ctx context.Context, id uuid.UUID, deletedBy string) error {
+	updates := map[string]interface{}{
+		"deleted_at": time.Now().UTC(),
+		"deleted_by": deletedBy,
+	}
+	return r.DB.WithContext(ctx).Model(&models.Customer{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(updates).Error
+}
+
+func (r *customerRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Customer, error) {
+	cus := &models.Customer{}
+	err := r.customerQuery(ctx).
+		Select(`
+			cu.*,
+			pc.name as customer_category_name,
+			e.expedition_name as expedition_send_name
+		`).
+		Where("cu.id = ?", id).
+		Scan(cus).Error
+	if err != nil {
+		return nil, err
+	}
+	// Scan() doesn't return error for record not found, so check if ID is nil
+	if cus.ID == uuid.Nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return cus, nil
+}
+
+func (r *customerRepository) ExistsByCustomerName(ctx context.Context, customerName string, excludeID uuid.UUID) (bool, error) {
+	var count int64
+	q := r.DB.WithContext(ctx).Unscoped().Model(&models.Customer{}).Where("customer_name = ?", customerName)
+	if excludeID != uuid.Nil {
+		q = q.Where("id <> ?", excludeID)
+	}
+	if err := q.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *customerRepository) ExistsExpeditionByID(ctx context.Context, expeditionID uuid.UUID) (bool, error) {
+	var count int64
+	if err := r.DB.WithContext(ctx).Model(&models.Expedition{}).
+		Where("id = ? AND deleted_at IS NULL", expeditionID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// ExistsRegencyHierarchy checks that the deepest given region exists and belongs to the given parent regions
+func (r *customerRepository) ExistsRegencyHierarchy(ctx context.Context, regency dto.CustomerRegency) (bool, error) {
+	q := r.DB.WithContext(ctx)
+	switch {
+	case regency.SubdistrictID != nil:
+		q = q.Table("subdistricts sd").
+			Joins("JOIN districts d ON d.id = sd.district_id AND d.deleted_at IS NULL").
+			Joins("JOIN cities c ON c.id = d.city_id AND c.deleted_at IS NULL").
+			Where("sd.id = ? AND sd.deleted_at IS NULL", *regency.SubdistrictID)
+	case regency.DistrictID != nil:
+		q = q.Table("districts d").
+			Joins("JOIN cities c ON c.id = d.city_id AND c.deleted_at IS NULL").
+			Where("d.id = ? AND d.deleted_at IS NULL", *regency.DistrictID)
+	case regency.CityID != nil:
+		q = q.Table("cities c").
+			Where("c.id = ? AND c.deleted_at IS NULL", *regency.CityID)
+	case regency.ProvinceID != nil:
+		q = q.Table("provinces p").
+			Where("p.id = ? AND p.deleted_at IS NULL", *regency.ProvinceID)
+	default:
+		return true, nil
+	}
+
+	if regency.SubdistrictID != nil && regency.DistrictID != nil {
+		q = q.Where("d.id = ?", *regency.DistrictID)
+	}
+	if (regency.SubdistrictID != nil || regency.DistrictID != nil) && regency.CityID != nil {
+		q = q.Where("c.id = ?", *regency.CityID)
+	}
+	if (regency.SubdistrictID != nil || regency.DistrictID != nil || regency.CityID != nil) && regency.ProvinceID != nil {
+		q = q.Where("c.province_id = ?", *regency.ProvinceID)
+	}
+
+	var count int64
+	if err := q.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *customerRepository) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqCustomerIndexFilter) ([]models.Customer, int, error) {
+	var customers []models.Customer
+	query := r.customerQuery(ctx).
+		Select(`
+			cu.id,
+			cu.customer_code,
+			cu.customer_name,
+			cu.customer_category_id,
+			cu.expedition_send_id,
+			cu.payment_term_days,
+			cu.credit_limit,
+			cu.created_at,
+			cu.updated_at,
+			pc.name as customer_category_name,
+			e.expedition_name as expedition_send_name,` + customerSummaryColumns)
+
+	// Apply search from PageRequest
+	query = request.ApplySearchConditionFromInterface(query, req.Search, rsearchcustomer.NewCustomerSearchHelper())
+
+	// Apply filters with multiple values support
+	query = r.ApplyFilters(query, filter)
+
+	// Pagination
+	total, err := request.ApplyPagination(query, req, request.PaginationConfig{
+		DefaultSortBy:      "cu.created_at",
+		DefaultSortOrder:   "DESC",
+		MaxPerPage:         100,
+		SortMapping:        mapCustomerIndexSortColumn,
+		NaturalSortColumns: []string{"cu.customer_name"}, // Enable natural sorting for customer_name
+	}, &customers)
+	if err != nil {
+		return nil, 0, err
+	}
+	return customers, total, nil
+}
+
+func (r *customerRepository) GetAll(ctx context.Context, filter dto.ReqCustomerIndexFilter) ([]models.Customer, error) {
+	var customers []models.Customer
+	query := r.customerQuery(ctx).
+		Select(`
+			cu.id,
+			cu.customer_code,
+			cu.customer_name,
+			cu.customer_category_id,
+			cu.expedition_send_id,
+			cu.payment_term_days,
+			cu.credit_limit,
+			cu.notes,
+			cu.created_at,
+			cu.updated_at,
+			pc.name as customer_category_name,
+			e.expedition_name as expedition_send_name,` + customerSummaryColumns)
+
+	// Apply search from filter
+	query = request.ApplySearchConditionFromInterface(query, filter.Search, rsearchcustomer.NewCustomerSearchHelper())
+
+	// Apply filters with multiple values support
+	query = r.ApplyFilters(query, filter)
+
+	// Determine sorting with natural sorting support
+	sortExpression := request.BuildSortExpressionForExport(
+		filter.SortBy,
+		filter.SortOrder,
+		"cu.created_at",
+		"DESC",
+		mapCustomerIndexSortColumn,
+		[]string{"cu.customer_name"}, // Enable natural sorting for customer_name
+	)
+
+	// Order results
+	if err := query.Order(sortExpression).Find(&customers).Error; err != nil {
+		return nil, err
+	}
+	return customers, nil
+}
+
+func (r *customerRepository) GetAllForExport(ctx context.Context, filter dto.ReqCustomerIndexFilter) ([]dto.CustomerExport, error) {
+	// First, get all customers
+	type CustomerBase struct {
+		ID                   uuid.UUID
+		CustomerCode         string
+		CustomerName         string
+		CustomerCategoryName *string
+		ExpeditionSendName   *string
+		PaymentTermDays      int
+		CreditLimit          float64
+		UpdatedAt            time.Time
+	}
+
+	var customersBase []CustomerBase
+	query := r.customerQuery(ctx).
+		Select(`
+			cu.id,
+			cu.customer_code,
+			cu.customer_name,
+			pc.name as customer_category_name,
+			e.expedition_name as expedition_send_name,
+			cu.payment_term_days,
+			cu.credit_limit,
+			cu.updated_at
+		`)
+
+	// Apply search from filter
+	query = request.ApplySearchConditionFromInterface(query, filter.Search, rsearchcustomer.NewCustomerSearchHelper())
+
+	// Apply filters with multiple values support
+	query = r.ApplyFilters(query, filter)
+
+	// Determine sorting with natural sorting support
+	sortExpression := request.BuildSortExpressionForExport(
+		filter.SortBy,
+		filter.SortOrder,
+		"cu.created_at",
+		"DESC",
+		mapCustomerIndexSortColumn,
+		[]string{"cu.customer_name"},
+	)
+
+	// Order results
+	if err := query.Order(sortExpression).Find(&customersBase).Error; err != nil {
+		return nil, err
+	}
+
+	// Get customer IDs
+	customerIDs := make([]uuid.UUID, len(customersBase))
+	for i, cus := range customersBase {
+		customerIDs[i] = cus.ID
+	}
+
+	// Fetch all addresses with their region names for all customers
+	var addresses []struct {
+		CustomerID   uuid.UUID
+		AddressType  string
+		Address      string
+		CityName     *string
+		ProvinceName *string
+		PostalCode   *string
+	}
+	// Fetch all HP and Telp phone numbers for all customers
+	var contacts []struct {
+		CustomerID  uuid.UUID
+		PhoneType   string
+		PhoneNumber string
+		AreaCode    *string
+	}
+	if len(customerIDs) > 0 {
+		if err := r.DB.WithContext(ctx).Table("customer_addresses ca").
+			Select("ca.customer_id, ca.address_type, ca.address, c.name as city_name, p.name as province_name, ca.postal_code").
+			Joins("LEFT JOIN cities c ON c.id = ca.city_id").
+			Joins("LEFT JOIN provinces p ON p.id = ca.province_id").
+			Where("ca.customer_id IN (?) AND ca.deleted_at IS NULL", customerIDs).
+			Order("ca.is_primary DESC, ca.created_at ASC").
+			Find(&addresses).Error; err != nil {
+			return nil, err
+		}
+
+		if err := r.DB.WithContext(ctx).Table("customer_contacts").
+			Select("customer_id, phone_type, phone_number, area_code").
+			Where("customer_id IN (?) AND deleted_at IS NULL", customerIDs).
+			Order("is_primary DESC, created_at ASC").
+			Find(&contacts).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	// Group addresses by customer_id and address_type
+	billingAddressesMap := make(map[uuid.UUID][]string)
+	shippingAddressesMap := make(map[uuid.UUID][]string)
+	for _, address := range addresses {
+		formatted := formatCustomerAddress(address.Address, address.CityName, address.ProvinceName, address.PostalCode)
+		switch address.AddressType {
+		case constants.CustomerAddressTypeBilling:
+			billingAddressesMap[address.CustomerID] = append(billingAddressesMap[address.CustomerID], formatted)
+		case constants.CustomerAddressTypeShipping:
+			shippingAddressesMap[address.CustomerID] = append(shippingAddressesMap[address.CustomerID], formatted)
+		}
+	}
+
+	// Group phone numbers by customer_id and phone_type
+	phoneNumbersMap := make(map[uuid.UUID][]string)
+	telpNumbersMap := make(map[uuid.UUID][]string)
+	for _, contact := range contacts {
+		formatted := formatContactNumber(contact.AreaCode, contact.PhoneNumber)
+		switch contact.PhoneType {
+		case constants.CustomerContactTypePhone:
+			phoneNumbersMap[contact.CustomerID] = append(phoneNumbersMap[contact.CustomerID], formatted)
+		case constants.CustomerContactTypeTelp:
+			telpNumbersMap[contact.CustomerID] = append(telpNumbersMap[contact.CustomerID], formatted)
+		}
+	}
+
+	// Map to CustomerExport
+	customers := make([]dto.CustomerExport, len(customersBase))
+	for i, cus := range customersBase {
+		customers[i] = dto.CustomerExport{
+			CustomerCode:         cus.CustomerCode,
+			CustomerName:         cus.CustomerName,
+			CustomerCategoryName: cus.CustomerCategoryName,
+			ExpeditionSendName:   cus.ExpeditionSendName,
+			PaymentTermDays:      cus.PaymentTermDays,
+			CreditLimit:          cus.CreditLimit,
+			BillingAddresses:     billingAddressesMap[cus.ID],
+			ShippingAddresses:    shippingAddressesMap[cus.ID],
+			PhoneNumbers:         phoneNumbersMap[cus.ID],
+			TelpNumbers:          telpNumbersMap[cus.ID],
+			UpdatedAt:            cus.UpdatedAt,
+		}
+	}
+
+	return customers, nil
+}
+
+func (r *customerRepository) GetAddressesByCustomerID(ctx context.Context, customerID uuid.UUID) ([]models.CustomerAddress, error) {
+	var addresses []models.CustomerAddress
+	err := r.DB.WithContext(ctx).Table("customer_addresses ca").
+		Select(`
+			ca.*,
+			p.name as province_name,
+			c.name as city_name,
+			d.name as district_name,
+			sd.name as subdistrict_name
+		`).
+		Joins("LEFT JOIN provinces p ON p.id = ca.province_id").
+		Joins("LEFT JOIN cities c ON c.id = ca.city_id").
+		Joins("LEFT JOIN districts d ON d.id = ca.district_id").
+		Joins("LEFT JOIN subdistricts sd ON sd.id = ca.subdistrict_id").
+		Where("ca.customer_id = ? AND ca.deleted_at IS NULL", customerID).
+		Order("ca.address_type ASC, ca.is_primary DESC, ca.created_at ASC").
+		Find(&addresses).Error
+	return addresses, err
+}
+
+func (r *customerRepository) GetContactsByCustomerID(ctx context.Context, customerID uuid.UUID) ([]models.CustomerContact, error) {
+	var contacts []models.CustomerContact
+	err := r.DB.WithContext(ctx).
+		Where("customer_id = ? AND deleted_at IS NULL", customerID).
+		Order("is_primary DESC, created_at ASC").
+		Find(&contacts).Error
+	return contacts, err
+}
+
+// Implement customer.Repository interface
+var _ customer.Repository = (*customerRepository)(nil)
+
+func formatContactNumber(areaCode *string, phoneNumber string) string {
+	if phoneNumber == "" {
+		return ""
+	}
+	if areaCode == nil {
+		return phoneNumber
+	}
+	ac := strings.TrimSpace(*areaCode)
+	if ac == "" {
+		return phoneNumber
+	}
+	return ac + "-" + strings.TrimSpace(phoneNumber)
+}
+
+// formatCustomerAddress joins the street address with its city, province and postal code
+func formatCustomerAddress(address string, cityName, provinceName, postalCode *string) string {
+	parts := []string{strings.TrimSpace(address)}
+	for _, part := range []*string{cityName, provinceName, postalCode} {
+		if part != nil && strings.TrimSpace(*part) != "" {
+			parts = append(parts, strings.TrimSpace(*part))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/modules/customer/dto"
+	"gorm.io/gorm"
+)
+
+// applyCustomerFilters applies all filters from ReqCustomerIndexFilter to the query
+func applyCustomerFilters(query *gorm.DB, filter dto.ReqCustomerIndexFilter) *gorm.DB {
+	if len(filter.CustomerCodes) > 0 {
+		query = query.Where("cu.customer_code IN (?)", filter.CustomerCodes)
+	}
+	if len(filter.CustomerNames) > 0 {
+		query = query.Where("cu.customer_name IN (?)", filter.CustomerNames)
+	}
+	if len(filter.CustomerCategoryIDs) > 0 {
+		query = query.Where("cu.customer_category_id IN (?)", filter.CustomerCategoryIDs)
+	}
+	if len(filter.ExpeditionSendIDs) > 0 {
+		query = query.Where("cu.expedition_send_id IN (?)", filter.ExpeditionSendIDs)
+	}
+	if len(filter.ProvinceIDs) > 0 {
+		query = query.Where("EXISTS (SELECT 1 FROM customer_addresses ca WHERE ca.customer_id = cu.id AND ca.deleted_at IS NULL AND ca.province_id IN (?))", filter.ProvinceIDs)
+	}
+	if len(filter.CityIDs) > 0 {
+		query = query.Where("EXISTS (SELECT 1 FROM customer_addresses ca WHERE ca.customer_id = cu.id AND ca.deleted_at IS NULL AND ca.city_id IN (?))", filter.CityIDs)
+	}
+	if len(filter.TelpNumbers) > 0 {
+		query = query.Where("EXISTS (SELECT 1 FROM customer_contacts cc WHERE cc.customer_id = cu.id AND cc.deleted_at IS NULL AND cc.phone_type = 'telp' AND cc.phone_number IN (?))", filter.TelpNumbers)
+	}
+	if len(filter.PhoneNumbers) > 0 {
+		query = query.Where("EXISTS (SELECT 1 FROM customer_contacts cc WHERE cc.customer_id = cu.id AND cc.deleted_at IS NULL AND cc.phone_type = 'hp' AND cc.phone_number IN (?))", filter.PhoneNumbers)
+	}
+	return query
+}
+
+// ApplyFilters applies filters to the query
+// Implements NeedFilterPredefine interface
+func (r *customerRepository) ApplyFilters(query *gorm.DB, filter interface{}) *gorm.DB {
+	customerFilter, ok := filter.(dto.ReqCustomerIndexFilter)
+	if !ok {
+		return query
+	}
+
+	return applyCustomerFilters(query, customerFilter)
+}
+
+// Compile-time check to ensure customerRepository implements NeedFilterPredefine interface
+var _ request.NeedFilterPredefine = (*customerRepository)(nil)
@@ -0,0 +1,29 @@
+package searches
+
+import "github.com/rendyfutsuy/base-go/helpers/request"
+
+// initialize, value for search and map the function & variable need for it
+type CustomerSearchHelper struct{ request.SearchPredefineBase }
+
+func (CustomerSearchHelper) GetSearchColumns() []string {
+	return []string{
+		"cu.customer_code",
+		"cu.customer_name",
+	}
+}
+
+func (CustomerSearchHelper) GetSearchExistsSubqueries() []string {
+	return []string{
+		"EXISTS (SELECT 1 FROM customer_contacts cc WHERE cc.customer_id = cu.id AND cc.deleted_at IS NULL AND cc.phone_number ILIKE ?)",
+		"EXISTS (SELECT 1 FROM customer_addresses ca WHERE ca.customer_id = cu.id AND ca.deleted_at IS NULL AND REPLACE(ca.address, ' ', '') ILIKE ?)",
+		"EXISTS (SELECT 1 FROM customer_addresses ca JOIN cities ct ON ct.id = ca.city_id AND ct.deleted_at IS NULL WHERE ca.customer_id = cu.id AND ca.deleted_at IS NULL AND REPLACE(ct.name, ' ', '') ILIKE ?)",
+		"EXISTS (SELECT 1 FROM parameters pr WHERE pr.id = cu.customer_category_id AND pr.deleted_at IS NULL AND REPLACE(pr.name, ' ', '') ILIKE ?)",
+		"EXISTS (SELECT 1 FROM expeditions ex WHERE ex.id = cu.expedition_send_id AND ex.deleted_at IS NULL AND REPLACE(ex.expedition_name, ' ', '') ILIKE ?)",
+	}
+}
+
+var _ request.NeedSearchPredefine = CustomerSearchHelper{}
+
+func NewCustomerSearchHelper() CustomerSearchHelper {
+	return CustomerSearchHelper{SearchPredefineBase: request.SearchPredefineBase{Threshold: nil}}
+}
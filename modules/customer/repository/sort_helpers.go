@@ -0,0 +1,38 @@
+package repository
+
+import "strings"
+
+func normalizeCustomerSortKey(sortBy string) string {
+	sortBy = strings.TrimSpace(sortBy)
+	if sortBy == "" {
+		return ""
+	}
+	sortBy = strings.ReplaceAll(sortBy, "-", "_")
+	sortBy = strings.ReplaceAll(sortBy, " ", "_")
+	return strings.ToLower(sortBy)
+}
+
+func mapCustomerIndexSortColumn(sortBy string) string {
+	normalized := normalizeCustomerSortKey(sortBy)
+	if normalized == "" {
+		return ""
+	}
+
+	mapping := map[string]string{
+		"id":                     "cu.id",
+		"customer_id":            "cu.id",
+		"customer_code":          "cu.customer_code",
+		"customer_name":          "cu.customer_name",
+		"customer_category_name": "pc.name",
+		"expedition_send_name":   "e.expedition_name",
+		"payment_term_days":      "cu.payment_term_days",
+		"credit_limit":           "cu.credit_limit",
+		"shipping_city_name":     "shipping_city_name",
+		"phone_number":           "primary_phone_number",
+		"telp_number":            "primary_telp_number",
+		"created_at":             "cu.created_at",
+		"updated_at":             "cu.updated_at",
+	}
+
+	return mapping[normalized]
+}
@@ -0,0 +1,475 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	customerMod "github.com/rendyfutsuy/base-go/modules/customer"
+	customerDto "github.com/rendyfutsuy/base-go/modules/customer/dto"
+	"github.com/rendyfutsuy/base-go/modules/customer/usecase"
+	paramMod "github.com/rendyfutsuy/base-go/modules/parameter"
+	paramDto "github.com/rendyfutsuy/base-go/modules/parameter/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+	"gorm.io/gorm"
+)
+
+// MockCustomerRepository is a mock implementation of customer.Repository
+type MockCustomerRepository struct {
+	mock.Mock
+}
+
+func (m *MockCustomerRepository) Create(ctx context.Context, params customerMod.CreateCustomerParams) (*models.Customer, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Customer), args.Error(1)
+}
+
+func (m *MockCustomerRepository) Update(ctx context.Context, id uuid.UUID, params customerMod.UpdateCustomerParams) (*models.Customer, error) {
+	args := m.Called(ctx, id, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Customer), args.Error(1)
+}
+
+func (m *MockCustomerRepository) Delete(ctx context.Context, id uuid.UUID, deletedBy string) error {
+	args := m.Called(ctx, id, deletedBy)
+	return args.Error(0)
+}
+
+func (m *MockCustomerRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Customer, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Customer), args.Error(1)
+}
+
+func (m *MockCustomerRepository) GetAddressesByCustomerID(ctx context.Context, customerID uuid.UUID) ([]models.CustomerAddress, error) {
+	args := m.Called(ctx, customerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.CustomerAddress), args.Error(1)
+}
+
+func (m *MockCustomerRepository) GetContactsByCustomerID(ctx context.Context, customerID uuid.UUID) ([]models.CustomerContact, error) {
+	args := m.Called(ctx, customerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.CustomerContact), args.Error(1)
+}
+
+func (m *MockCustomerRepository) GetIndex(ctx context.Context, req request.PageRequest, filter customerDto.ReqCustomerIndexFilter) ([]models.Customer, int, error) {
+	args := m.Called(ctx, req, filter)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]models.Customer), args.Int(1), args.Error(2)
+}
+
+func (m *MockCustomerRepository) GetAll(ctx context.Context, filter customerDto.ReqCustomerIndexFilter) ([]models.Customer, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Customer), args.Error(1)
+}
+
+func (m *MockCustomerRepository) GetAllForExport(ctx context.Context, filter customerDto.ReqCustomerIndexFilter) ([]customerDto.CustomerExport, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]customerDto.CustomerExport), args.Error(1)
+}
+
+func (m *MockCustomerRepository) ExistsByCustomerName(ctx context.Context, customerName string, excludeID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, customerName, excludeID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCustomerRepository) ExistsExpeditionByID(ctx context.Context, expeditionID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, expeditionID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCustomerRepository) ExistsRegencyHierarchy(ctx context.Context, regency customerDto.CustomerRegency) (bool, error) {
+	args := m.Called(ctx, regency)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockParameterRepository only implements the lookup used to validate customer categories
+type MockParameterRepository struct {
+	mock.Mock
+}
+
+func (m *MockParameterRepository) Create(ctx context.Context, code, name string, value, typeVal, desc *string) (*models.Parameter, error) {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) Update(ctx context.Context, id uuid.UUID, code, name string, value, typeVal, desc *string) (*models.Parameter, error) {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) SetParent(ctx context.Context, id uuid.UUID, parentID uuid.UUID) error {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Parameter, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Parameter), args.Error(1)
+}
+func (m *MockParameterRepository) GetIndex(ctx context.Context, req request.PageRequest, filter paramDto.ReqParameterIndexFilter) ([]models.Parameter, int, error) {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) GetAll(ctx context.Context, filter paramDto.ReqParameterIndexFilter) ([]models.Parameter, error) {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) ExistsByCode(ctx context.Context, code string, excludeID uuid.UUID) (bool, error) {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) ExistsByName(ctx context.Context, name string, excludeID uuid.UUID) (bool, error) {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) AssignParametersToModule(ctx context.Context, moduleType string, moduleID uuid.UUID, parameterIDs []uuid.UUID) error {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) GetByModule(ctx context.Context, moduleType string, moduleID uuid.UUID) ([]models.Parameter, error) {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) RemoveParametersFromModule(ctx context.Context, moduleType string, moduleID uuid.UUID) error {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) GetChildTypes(ctx context.Context, id uuid.UUID) ([]string, error) {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) GetByCodes(ctx context.Context, codes []string) ([]models.Parameter, error) {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) ImportParameters(ctx context.Context, params []paramMod.ImportParameterParams) error {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) GetLookupEntries(ctx context.Context) ([]models.Parameter, error) {
+	panic("not implemented")
+}
+
+func (m *MockParameterRepository) GetTree(ctx context.Context, typeCode string) ([]models.Parameter, error) {
+	panic("not implemented")
+}
+
+func (m *MockParameterRepository) GetAncestorIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	panic("not implemented")
+}
+
+func (m *MockParameterRepository) GetSiblings(ctx context.Context, parentID uuid.UUID, typeVal *string) ([]models.Parameter, error) {
+	panic("not implemented")
+}
+
+func (m *MockParameterRepository) Move(ctx context.Context, id uuid.UUID, parentID uuid.UUID, position int) error {
+	panic("not implemented")
+}
+
+func (m *MockParameterRepository) Reorder(ctx context.Context, ids []uuid.UUID) error {
+	panic("not implemented")
+}
+
+func TestCreateCustomer(t *testing.T) {
+	ctx := context.Background()
+	notes := "Test notes"
+	provinceID := uuid.New()
+	cityID := uuid.New()
+	districtID := uuid.New()
+	expeditionID := uuid.New()
+	categoryID := uuid.New()
+	categoryType := constants.CustomerCategoryParameterType
+	otherType := "topic"
+
+	addresses := []customerDto.CustomerAddressItem{
+		{AddressType: constants.CustomerAddressTypeBilling, Address: "Jl. Ahmad Yani No. 123", ProvinceID: &provinceID, CityID: &cityID},
+		{AddressType: constants.CustomerAddressTypeShipping, Address: "Jl. Soekarno Hatta No. 5", ProvinceID: &provinceID, CityID: &cityID, DistrictID: &districtID},
+	}
+
+	tests := []struct {
+		name          string
+		req           *customerDto.ReqCreateCustomer
+		setupMock     func(*MockCustomerRepository, *MockParameterRepository)
+		expectedError error
+	}{
+		{
+			name: "success create customer with category, expedition and addresses",
+			req: &customerDto.ReqCreateCustomer{
+				CustomerName:       "Toko Sinar Jaya",
+				CustomerCategoryID: &categoryID,
+				ExpeditionSendID:   &expeditionID,
+				PaymentTermDays:    30,
+				CreditLimit:        15000000,
+				Addresses:          addresses,
+				TelpNumbers:        []customerDto.TelpNumberItem{{PhoneNumber: "1234567"}},
+				PhoneNumbers:       []string{"081234567890"},
+				Notes:              &notes,
+			},
+			setupMock: func(m *MockCustomerRepository, p *MockParameterRepository) {
+				m.On("ExistsByCustomerName", mock.Anything, "Toko Sinar Jaya", uuid.Nil).Return(false, nil).Once()
+				p.On("GetByID", mock.Anything, categoryID).Return(&models.Parameter{ID: categoryID, Type: &categoryType}, nil).Once()
+				m.On("ExistsExpeditionByID", mock.Anything, expeditionID).Return(true, nil).Once()
+				m.On("ExistsRegencyHierarchy", mock.Anything, addresses[0].Regency()).Return(true, nil).Once()
+				m.On("ExistsRegencyHierarchy", mock.Anything, addresses[1].Regency()).Return(true, nil).Once()
+				m.On("Create", mock.Anything, customerMod.CreateCustomerParams{
+					CustomerName:       "Toko Sinar Jaya",
+					CustomerCategoryID: &categoryID,
+					ExpeditionSendID:   &expeditionID,
+					PaymentTermDays:    30,
+					CreditLimit:        15000000,
+					Addresses:          addresses,
+					TelpNumbers:        []customerDto.TelpNumberItem{{PhoneNumber: "1234567"}},
+					PhoneNumbers:       []string{"081234567890"},
+					Notes:              &notes,
+					CreatedBy:          "test-auth-id",
+				}).Return(&models.Customer{ID: uuid.New(), CustomerCode: "01", CustomerName: "Toko Sinar Jaya"}, nil).Once()
+			},
+		},
+		{
+			name: "error when customer name already exists",
+			req:  &customerDto.ReqCreateCustomer{CustomerName: "Toko Sinar Jaya"},
+			setupMock: func(m *MockCustomerRepository, p *MockParameterRepository) {
+				m.On("ExistsByCustomerName", mock.Anything, "Toko Sinar Jaya", uuid.Nil).Return(true, nil).Once()
+			},
+			expectedError: errors.New(constants.CustomerNameAlreadyExists),
+		},
+		{
+			name: "error when category is not a customer_category parameter",
+			req:  &customerDto.ReqCreateCustomer{CustomerName: "Toko Sinar Jaya", CustomerCategoryID: &categoryID},
+			setupMock: func(m *MockCustomerRepository, p *MockParameterRepository) {
+				m.On("ExistsByCustomerName", mock.Anything, "Toko Sinar Jaya", uuid.Nil).Return(false, nil).Once()
+				p.On("GetByID", mock.Anything, categoryID).Return(&models.Parameter{ID: categoryID, Type: &otherType}, nil).Once()
+			},
+			expectedError: errors.New(constants.CustomerCategoryInvalid),
+		},
+		{
+			name: "error when expedition does not exist",
+			req:  &customerDto.ReqCreateCustomer{CustomerName: "Toko Sinar Jaya", ExpeditionSendID: &expeditionID},
+			setupMock: func(m *MockCustomerRepository, p *MockParameterRepository) {
+				m.On("ExistsByCustomerName", mock.Anything, "Toko Sinar Jaya", uuid.Nil).Return(false, nil).Once()
+				m.On("ExistsExpeditionByID", mock.Anything, expeditionID).Return(false, nil).Once()
+			},
+			expectedError: errors.New(constants.CustomerExpeditionNotFound),
+		},
+		{
+			name: "error when address region is set without its parent",
+			req: &customerDto.ReqCreateCustomer{CustomerName: "Toko Sinar Jaya", Addresses: []customerDto.CustomerAddressItem{
+				addresses[0],
+				{AddressType: constants.CustomerAddressTypeShipping, Address: "Jl. Merdeka", DistrictID: &districtID},
+			}},
+			setupMock: func(m *MockCustomerRepository, p *MockParameterRepository) {
+				m.On("ExistsByCustomerName", mock.Anything, "Toko Sinar Jaya", uuid.Nil).Return(false, nil).Once()
+				m.On("ExistsRegencyHierarchy", mock.Anything, addresses[0].Regency()).Return(true, nil).Once()
+			},
+			expectedError: fmt.Errorf(constants.CustomerAddressRegencyIncomplete, 2),
+		},
+		{
+			name: "error when address region does not belong to its parent",
+			req:  &customerDto.ReqCreateCustomer{CustomerName: "Toko Sinar Jaya", Addresses: addresses[:1]},
+			setupMock: func(m *MockCustomerRepository, p *MockParameterRepository) {
+				m.On("ExistsByCustomerName", mock.Anything, "Toko Sinar Jaya", uuid.Nil).Return(false, nil).Once()
+				m.On("ExistsRegencyHierarchy", mock.Anything, addresses[0].Regency()).Return(false, nil).Once()
+			},
+			expectedError: fmt.Errorf(constants.CustomerAddressRegencyNotFound, 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockCustomerRepository)
+			mockParamRepo := new(MockParameterRepository)
+			tt.setupMock(mockRepo, mockParamRepo)
+			uc := usecase.NewCustomerUsecase(mockRepo, mockParamRepo)
+
+			result, err := uc.Create(ctx, tt.req, "test-auth-id")
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "01", result.CustomerCode)
+			}
+			mockRepo.AssertExpectations(t)
+			mockParamRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateCustomer(t *testing.T) {
+	ctx := context.Background()
+	customerID := uuid.New()
+
+	tests := []struct {
+		name          string
+		id            string
+		req           *customerDto.ReqUpdateCustomer
+		setupMock     func(*MockCustomerRepository)
+		expectedError error
+	}{
+		{
+			name: "success update customer",
+			id:   customerID.String(),
+			req:  &customerDto.ReqUpdateCustomer{CustomerName: "Toko Sinar Terang", PaymentTermDays: 45, PhoneNumbers: []string{"081234567890"}},
+			setupMock: func(m *MockCustomerRepository) {
+				m.On("ExistsByCustomerName", mock.Anything, "Toko Sinar Terang", customerID).Return(false, nil).Once()
+				m.On("Update", mock.Anything, customerID, customerMod.UpdateCustomerParams{
+					CustomerName:    "Toko Sinar Terang",
+					PaymentTermDays: 45,
+					PhoneNumbers:    []string{"081234567890"},
+					UpdatedBy:       "test-auth-id",
+				}).Return(&models.Customer{ID: customerID, CustomerName: "Toko Sinar Terang"}, nil).Once()
+			},
+		},
+		{
+			name: "error when customer not found",
+			id:   customerID.String(),
+			req:  &customerDto.ReqUpdateCustomer{CustomerName: "Toko Sinar Terang"},
+			setupMock: func(m *MockCustomerRepository) {
+				m.On("ExistsByCustomerName", mock.Anything, "Toko Sinar Terang", customerID).Return(false, nil).Once()
+				m.On("Update", mock.Anything, customerID, mock.Anything).Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: fmt.Errorf(constants.CustomerNotFound, customerID.String()),
+		},
+		{
+			name:          "error when id is not a uuid",
+			id:            "invalid-uuid",
+			req:           &customerDto.ReqUpdateCustomer{CustomerName: "Toko Sinar Terang"},
+			setupMock:     func(m *MockCustomerRepository) {},
+			expectedError: errors.New("requested param is string"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockCustomerRepository)
+			tt.setupMock(mockRepo)
+			uc := usecase.NewCustomerUsecase(mockRepo, new(MockParameterRepository))
+
+			result, err := uc.Update(ctx, tt.id, tt.req, "test-auth-id")
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "Toko Sinar Terang", result.CustomerName)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteCustomer(t *testing.T) {
+	ctx := context.Background()
+	customerID := uuid.New()
+
+	mockRepo := new(MockCustomerRepository)
+	mockRepo.On("Delete", mock.Anything, customerID, "test-auth-id").Return(nil).Once()
+	uc := usecase.NewCustomerUsecase(mockRepo, new(MockParameterRepository))
+
+	assert.NoError(t, uc.Delete(ctx, customerID.String(), "test-auth-id"))
+	assert.Error(t, uc.Delete(ctx, "invalid-uuid", "test-auth-id"))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestToRespCustomer(t *testing.T) {
+	cityID := uuid.New()
+	cityName := "Bandung"
+	categoryID := uuid.New()
+	categoryName := "Retail"
+	areaCode := "022"
+	customer := models.Customer{ID: uuid.New(), CustomerCode: "01", CustomerCategoryID: &categoryID, CustomerCategoryName: &categoryName, CreditLimit: 5000000}
+	addresses := []models.CustomerAddress{
+		{ID: uuid.New(), AddressType: constants.CustomerAddressTypeShipping, Address: "Jl. Merdeka", CityID: &cityID, CityName: &cityName, IsPrimary: true},
+	}
+	contacts := []models.CustomerContact{
+		{PhoneType: constants.CustomerContactTypeTelp, PhoneNumber: "1234567", AreaCode: &areaCode, IsPrimary: true},
+		{PhoneType: constants.CustomerContactTypePhone, PhoneNumber: "081234567890", IsPrimary: true},
+	}
+
+	resp := customerDto.ToRespCustomer(customer, addresses, contacts)
+
+	require.NotNil(t, resp.CustomerCategory)
+	assert.Equal(t, categoryName, resp.CustomerCategory.Name)
+	assert.Nil(t, resp.ExpeditionSend)
+	assert.Equal(t, float64(5000000), resp.CreditLimit)
+	require.Len(t, resp.Addresses, 1)
+	require.NotNil(t, resp.Addresses[0].City)
+	assert.Equal(t, cityName, resp.Addresses[0].City.Name)
+	assert.Nil(t, resp.Addresses[0].Province)
+	assert.True(t, resp.Addresses[0].IsPrimary)
+	assert.Equal(t, []customerDto.TelpNumberItem{{AreaCode: &areaCode, PhoneNumber: "1234567"}}, resp.TelpNumbers)
+	assert.Equal(t, []string{"081234567890"}, resp.PhoneNumbers)
+}
+
+func TestExportCustomer(t *testing.T) {
+	ctx := context.Background()
+	categoryName := "Retail"
+	expeditionName := "JNE"
+
+	t.Run("success export customers", func(t *testing.T) {
+		mockRepo := new(MockCustomerRepository)
+		mockRepo.On("GetAllForExport", ctx, customerDto.ReqCustomerIndexFilter{}).Return([]customerDto.CustomerExport{
+			{
+				CustomerCode:         "01",
+				CustomerName:         "Toko Sinar Jaya",
+				CustomerCategoryName: &categoryName,
+				ExpeditionSendName:   &expeditionName,
+				PaymentTermDays:      30,
+				CreditLimit:          15000000,
+				BillingAddresses:     []string{"Jl. Ahmad Yani No. 123, Bandung"},
+				PhoneNumbers:         []string{"081234567890", "081234567891"},
+				TelpNumbers:          []string{"022-1234567"},
+				UpdatedAt:            time.Now(),
+			},
+		}, nil).Once()
+		uc := usecase.NewCustomerUsecase(mockRepo, new(MockParameterRepository))
+
+		result, err := uc.Export(ctx, customerDto.ReqCustomerIndexFilter{})
+		require.NoError(t, err)
+
+		f, err := excelize.OpenReader(bytes.NewReader(result))
+		require.NoError(t, err)
+		rows, err := f.GetRows("Customers")
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		assert.Equal(t, []string{"Kode Customer", "Nama Customer", "Kategori", "Ekspedisi", "Termin (Hari)", "Limit Kredit", "Alamat Penagihan", "Alamat Pengiriman", "No HP", "", "No Telp", "Update Date"}, rows[0])
+		assert.Equal(t, []string{"01", "Toko Sinar Jaya", "Retail", "JNE", "30", "15000000", "Jl. Ahmad Yani No. 123, Bandung", "-", "081234567890", "081234567891", "022-1234567"}, rows[1][:11])
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error when repository fails", func(t *testing.T) {
+		mockRepo := new(MockCustomerRepository)
+		mockRepo.On("GetAllForExport", ctx, customerDto.ReqCustomerIndexFilter{}).Return(nil, errors.New("database error")).Once()
+		uc := usecase.NewCustomerUsecase(mockRepo, new(MockParameterRepository))
+
+		result, err := uc.Export(ctx, customerDto.ReqCustomerIndexFilter{})
+
+		assert.EqualError(t, err, "database error")
+		assert.Nil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+}
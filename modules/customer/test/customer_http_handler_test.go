@@ -0,0 +1,575 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/middleware"
+	reqMw "github.com/rendyfutsuy/base-go/helpers/middleware/request"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	customerMod "github.com/rendyfutsuy/base-go/modules/customer"
+	customerHttp "github.com/rendyfutsuy/base-go/modules/customer/delivery/http"
+	"github.com/rendyfutsuy/base-go/modules/customer/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCustomerUsecase struct {
+	mock.Mock
+}
+
+func (m *mockCustomerUsecase) Create(ctx context.Context, req *dto.ReqCreateCustomer, authId string) (*models.Customer, error) {
+	args := m.Called(ctx, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Customer), args.Error(1)
+}
+
+func (m *mockCustomerUsecase) Update(ctx context.Context, id string, req *dto.ReqUpdateCustomer, authId string) (*models.Customer, error) {
+	args := m.Called(ctx, id, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Customer), args.Error(1)
+}
+
+func (m *mockCustomerUsecase) Delete(ctx context.Context, id string, authId string) error {
+	args := m.Called(ctx, id, authId)
+	return args.Error(0)
+}
+
+func (m *mockCustomerUsecase) GetByID(ctx context.Context, id string) (*models.Customer, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Customer), args.Error(1)
+}
+
+func (m *mockCustomerUsecase) GetAddressesByCustomerID(ctx context.Context, id string) ([]models.CustomerAddress, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.CustomerAddress), args.Error(1)
+}
+
+func (m *mockCustomerUsecase) GetContactsByCustomerID(ctx context.Context, id string) ([]models.CustomerContact, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.CustomerContact), args.Error(1)
+}
+
+func (m *mockCustomerUsecase) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqCustomerIndexFilter) ([]models.Customer, int, error) {
+	args := m.Called(ctx, req, filter)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]models.Customer), args.Int(1), args.Error(2)
+}
+
+func (m *mockCustomerUsecase) GetAll(ctx context.Context, filter dto.ReqCustomerIndexFilter) ([]models.Customer, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Customer), args.Error(1)
+}
+
+func (m *mockCustomerUsecase) Export(ctx context.Context, filter dto.ReqCustomerIndexFilter) ([]byte, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+type mockMiddlewareAuth struct {
+	mock.Mock
+}
+
+func (m *mockMiddlewareAuth) AuthorizationCheck(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return next(c)
+	}
+}
+
+type mockMiddlewarePermission struct {
+	mock.Mock
+}
+
+func (m *mockMiddlewarePermission) PermissionValidation(args []string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			return next(c)
+		}
+	}
+}
+
+type mockMiddlewarePageRequest struct {
+	mock.Mock
+}
+
+func (m *mockMiddlewarePageRequest) PageRequestCtx(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		pageReq := &request.PageRequest{
+			Page:      1,
+			PerPage:   10,
+			SortBy:    "id",
+			SortOrder: "desc",
+		}
+		c.Set("page_request", pageReq)
+		return next(c)
+	}
+}
+
+func (m *mockMiddlewarePageRequest) PageRequestCtxWithoutLimitation(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return next(c)
+	}
+}
+
+type customValidator struct {
+	validator *validator.Validate
+}
+
+func (cv *customValidator) Validate(i interface{}) error {
+	return utils.ValidateRequest(i, cv.validator)
+}
+
+func newEcho() *echo.Echo {
+	e := echo.New()
+	v := validator.New()
+	utils.RegisterCustomValidator(v)
+	e.Validator = &customValidator{validator: v}
+	return e
+}
+
+func setHandlerValidator(handler *customerHttp.CustomerHandler, v *validator.Validate) {
+	val := reflect.ValueOf(handler).Elem()
+	field := val.FieldByName("validator")
+	if field.IsValid() && field.CanSet() {
+		field.Set(reflect.ValueOf(v))
+	}
+}
+
+func newCustomerHandler(mockUC customerMod.Usecase, mockAuthMw middleware.IMiddlewareAuth, mockPermMw middleware.IMiddlewarePermission, mockPageReqMw reqMw.IMiddlewarePageRequest) *customerHttp.CustomerHandler {
+	handler := &customerHttp.CustomerHandler{
+		Usecase: mockUC,
+	}
+	val := reflect.ValueOf(handler).Elem()
+
+	authField := val.FieldByName("middlewareAuth")
+	if authField.IsValid() && authField.CanSet() {
+		authField.Set(reflect.ValueOf(mockAuthMw))
+	}
+
+	permField := val.FieldByName("middlewarePermission")
+	if permField.IsValid() && permField.CanSet() {
+		permField.Set(reflect.ValueOf(mockPermMw))
+	}
+
+	pageReqField := val.FieldByName("mwPageRequest")
+	if pageReqField.IsValid() && pageReqField.CanSet() {
+		pageReqField.Set(reflect.ValueOf(mockPageReqMw))
+	}
+
+	setHandlerValidator(handler, validator.New())
+	return handler
+}
+
+func TestCustomerHandler_CreateSuccess(t *testing.T) {
+	e := newEcho()
+	reqBody := `{"customer_name":"TEST SUPPLIER","payment_term_days":30}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/customer", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", models.User{ID: uuid.New()})
+
+	mockUC := new(mockCustomerUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newCustomerHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	customerID := uuid.New()
+	createdCus := &models.Customer{
+		ID:           customerID,
+		CustomerCode: "CUS001",
+		CustomerName: "TEST SUPPLIER",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	mockUC.On("Create", mock.Anything, mock.AnythingOfType("*dto.ReqCreateCustomer"), mock.AnythingOfType("string")).
+		Return(createdCus, nil).Once()
+	mockUC.On("GetByID", mock.Anything, customerID.String()).
+		Return(createdCus, nil).Once()
+	mockUC.On("GetAddressesByCustomerID", mock.Anything, customerID.String()).
+		Return([]models.CustomerAddress{}, nil).Once()
+	mockUC.On("GetContactsByCustomerID", mock.Anything, customerID.String()).
+		Return([]models.CustomerContact{}, nil).Once()
+
+	err := handler.Create(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Status  int `json:"status"`
+		Message string
+		Data    dto.RespCustomer `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "TEST SUPPLIER", resp.Data.CustomerName)
+	mockUC.AssertExpectations(t)
+}
+
+func TestCustomerHandler_CreateValidationError(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodPost, "/v1/customer", strings.NewReader(`{"customer_name":""}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", models.User{ID: uuid.New()})
+
+	mockUC := new(mockCustomerUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newCustomerHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	err := handler.Create(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestCustomerHandler_CreateUsecaseError(t *testing.T) {
+	e := newEcho()
+	reqBody := `{"customer_name":"TEST SUPPLIER","payment_term_days":30}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/customer", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", models.User{ID: uuid.New()})
+
+	mockUC := new(mockCustomerUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newCustomerHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	mockUC.On("Create", mock.Anything, mock.AnythingOfType("*dto.ReqCreateCustomer"), mock.AnythingOfType("string")).
+		Return(nil, errors.New("usecase error")).Once()
+
+	err := handler.Create(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestCustomerHandler_UpdateSuccess(t *testing.T) {
+	e := newEcho()
+	customerID := uuid.New().String()
+	reqBody := `{"customer_name":"UPDATED SUPPLIER","credit_limit":1000000}`
+	req := httptest.NewRequest(http.MethodPut, "/v1/customer/"+customerID, strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(customerID)
+
+	mockUC := new(mockCustomerUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newCustomerHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	updatedCus := &models.Customer{
+		ID:           uuid.MustParse(customerID),
+		CustomerCode: "CUS001",
+		CustomerName: "UPDATED SUPPLIER",
+		UpdatedAt:    time.Now(),
+	}
+
+	mockUC.On("Update", mock.Anything, customerID, mock.AnythingOfType("*dto.ReqUpdateCustomer"), mock.AnythingOfType("string")).
+		Return(updatedCus, nil).Once()
+	mockUC.On("GetByID", mock.Anything, customerID).
+		Return(updatedCus, nil).Once()
+	mockUC.On("GetAddressesByCustomerID", mock.Anything, customerID).
+		Return([]models.CustomerAddress{}, nil).Once()
+	mockUC.On("GetContactsByCustomerID", mock.Anything, customerID).
+		Return([]models.CustomerContact{}, nil).Once()
+
+	err := handler.Update(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestCustomerHandler_UpdateValidationError(t *testing.T) {
+	e := newEcho()
+	customerID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodPut, "/v1/customer/"+customerID, strings.NewReader(`{"customer_name":""}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(customerID)
+
+	mockUC := new(mockCustomerUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newCustomerHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	err := handler.Update(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestCustomerHandler_DeleteSuccess(t *testing.T) {
+	e := newEcho()
+	customerID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodDelete, "/v1/customer/"+customerID, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(customerID)
+
+	mockUC := new(mockCustomerUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newCustomerHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	mockUC.On("Delete", mock.Anything, customerID, mock.AnythingOfType("string")).
+		Return(nil).Once()
+
+	err := handler.Delete(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestCustomerHandler_DeleteUsecaseError(t *testing.T) {
+	e := newEcho()
+	customerID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodDelete, "/v1/customer/"+customerID, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(customerID)
+
+	mockUC := new(mockCustomerUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newCustomerHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	mockUC.On("Delete", mock.Anything, customerID, mock.AnythingOfType("string")).
+		Return(errors.New("not found")).Once()
+
+	err := handler.Delete(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestCustomerHandler_GetIndexSuccess(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer?page=1&per_page=10", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockCustomerUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newCustomerHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	mockPageReqMw.PageRequestCtx(func(c echo.Context) error {
+		c.Set("page_request", &request.PageRequest{Page: 1, PerPage: 10})
+		return nil
+	})(c)
+
+	customers := []models.Customer{
+		{
+			ID:           uuid.New(),
+			CustomerCode: "CUS001",
+			CustomerName: "TEST SUPPLIER",
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+
+	mockUC.On("GetIndex", mock.Anything, mock.AnythingOfType("request.PageRequest"), mock.AnythingOfType("dto.ReqCustomerIndexFilter")).
+		Return(customers, 1, nil).Once()
+
+	err := handler.GetIndex(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestCustomerHandler_GetByIDSuccess(t *testing.T) {
+	e := newEcho()
+	customerID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/"+customerID, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(customerID)
+
+	mockUC := new(mockCustomerUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newCustomerHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	customer := &models.Customer{
+		ID:           uuid.MustParse(customerID),
+		CustomerCode: "CUS001",
+		CustomerName: "TEST SUPPLIER",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	mockUC.On("GetByID", mock.Anything, customerID).
+		Return(customer, nil).Once()
+	mockUC.On("GetAddressesByCustomerID", mock.Anything, customerID).
+		Return([]models.CustomerAddress{}, nil).Once()
+	mockUC.On("GetContactsByCustomerID", mock.Anything, customerID).
+		Return([]models.CustomerContact{}, nil).Once()
+
+	err := handler.GetByID(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestCustomerHandler_GetByIDUsecaseError(t *testing.T) {
+	e := newEcho()
+	customerID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/"+customerID, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(customerID)
+
+	mockUC := new(mockCustomerUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newCustomerHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	mockUC.On("GetByID", mock.Anything, customerID).
+		Return(nil, errors.New("not found")).Once()
+
+	err := handler.GetByID(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestCustomerHandler_ExportSuccess(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/export", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockCustomerUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newCustomerHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	excelBytes := []byte("PK\x03\x04") // Excel file signature
+
+	mockUC.On("Export", mock.Anything, mock.AnythingOfType("dto.ReqCustomerIndexFilter")).
+		Return(excelBytes, nil).Once()
+
+	err := handler.Export(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, constants.ExcelContent, rec.Header().Get(echo.HeaderContentType))
+	mockUC.AssertExpectations(t)
+}
+
+func TestCustomerHandler_ExportUsecaseError(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/export", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockCustomerUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newCustomerHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	mockUC.On("Export", mock.Anything, mock.AnythingOfType("dto.ReqCustomerIndexFilter")).
+		Return(nil, errors.New("export error")).Once()
+
+	err := handler.Export(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestCustomerHandler_GetIndexInvalidFilter(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer?city_ids=not-a-uuid", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("page_request", &request.PageRequest{Page: 1, PerPage: 10})
+
+	mockUC := new(mockCustomerUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newCustomerHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	err := handler.GetIndex(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertNotCalled(t, "GetIndex", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCustomerHandler_CreateInvalidAddressType(t *testing.T) {
+	e := newEcho()
+	reqBody := `{"customer_name":"TEST CUSTOMER","addresses":[{"address_type":"office","address":"Jl. Merdeka"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/customer", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", models.User{ID: uuid.New()})
+
+	mockUC := new(mockCustomerUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newCustomerHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	err := handler.Create(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
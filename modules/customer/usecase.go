@@ -0,0 +1,21 @@
+package customer
+
+import (
+	"context"
+
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/customer/dto"
+)
+
+type Usecase interface {
+	Create(ctx context.Context, req *dto.ReqCreateCustomer, authId string) (*models.Customer, error)
+	Update(ctx context.Context, id string, req *dto.ReqUpdateCustomer, authId string) (*models.Customer, error)
+	Delete(ctx context.Context, id string, authId string) error
+	GetByID(ctx context.Context, id string) (*models.Customer, error)
+	GetAddressesByCustomerID(ctx context.Context, id string) ([]models.CustomerAddress, error)
+	GetContactsByCustomerID(ctx context.Context, id string) ([]models.CustomerContact, error)
+	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqCustomerIndexFilter) ([]models.Customer, int, error)
+	GetAll(ctx context.Context, filter dto.ReqCustomerIndexFilter) ([]models.Customer, error)
+	Export(ctx context.Context, filter dto.ReqCustomerIndexFilter) ([]byte, error)
+}
@@ -0,0 +1,338 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/customer"
+	"github.com/rendyfutsuy/base-go/modules/customer/dto"
+	paramMod "github.com/rendyfutsuy/base-go/modules/parameter"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/xuri/excelize/v2"
+	"gorm.io/gorm"
+)
+
+type customerUsecase struct {
+	repo      mod.Repository
+	paramRepo paramMod.Repository
+}
+
+func NewCustomerUsecase(repo mod.Repository, paramRepo paramMod.Repository) mod.Usecase {
+	return &customerUsecase{repo: repo, paramRepo: paramRepo}
+}
+
+// validateReferences makes sure the category, preferred expedition and every address region hierarchy are valid
+func (u *customerUsecase) validateReferences(ctx context.Context, categoryID, expeditionSendID *uuid.UUID, addresses []dto.CustomerAddressItem) error {
+	if categoryID != nil {
+		p, err := u.paramRepo.GetByID(ctx, *categoryID)
+		if err != nil {
+			return err
+		}
+		if p == nil || p.Type == nil || *p.Type != constants.CustomerCategoryParameterType {
+			return errors.New(constants.CustomerCategoryInvalid)
+		}
+	}
+
+	if expeditionSendID != nil {
+		exists, err := u.repo.ExistsExpeditionByID(ctx, *expeditionSendID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.New(constants.CustomerExpeditionNotFound)
+		}
+	}
+
+	for i, address := range addresses {
+		// A region can only be set together with all of its parent regions
+		if (address.SubdistrictID != nil && address.DistrictID == nil) ||
+			(address.DistrictID != nil && address.CityID == nil) ||
+			(address.CityID != nil && address.ProvinceID == nil) {
+			return fmt.Errorf(constants.CustomerAddressRegencyIncomplete, i+1)
+		}
+
+		exists, err := u.repo.ExistsRegencyHierarchy(ctx, address.Regency())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf(constants.CustomerAddressRegencyNotFound, i+1)
+		}
+	}
+
+	return nil
+}
+
+func (u *customerUsecase) Create(ctx context.Context, reqBody *dto.ReqCreateCustomer, authId string) (*models.Customer, error) {
+	// Check if customer name already exists
+	exists, err := u.repo.ExistsByCustomerName(ctx, reqBody.CustomerName, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New(constants.CustomerNameAlreadyExists)
+	}
+
+	if err := u.validateReferences(ctx, reqBody.CustomerCategoryID, reqBody.ExpeditionSendID, reqBody.Addresses); err != nil {
+		return nil, err
+	}
+
+	return u.repo.Create(ctx, mod.CreateCustomerParams{
+		CustomerName:       reqBody.CustomerName,
+		CustomerCategoryID: reqBody.CustomerCategoryID,
+		ExpeditionSendID:   reqBody.ExpeditionSendID,
+		PaymentTermDays:    reqBody.PaymentTermDays,
+		CreditLimit:        reqBody.CreditLimit,
+		Addresses:          reqBody.Addresses,
+		TelpNumbers:        reqBody.TelpNumbers,
+		PhoneNumbers:       reqBody.PhoneNumbers,
+		Notes:              reqBody.Notes,
+		CreatedBy:          authId,
+	})
+}
+
+func (u *customerUsecase) Update(ctx context.Context, id string, reqBody *dto.ReqUpdateCustomer, authId string) (*models.Customer, error) {
+	cid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if customer name already exists (excluding current id)
+	exists, err := u.repo.ExistsByCustomerName(ctx, reqBody.CustomerName, cid)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New(constants.CustomerNameAlreadyExists)
+	}
+
+	if err := u.validateReferences(ctx, reqBody.CustomerCategoryID, reqBody.ExpeditionSendID, reqBody.Addresses); err != nil {
+		return nil, err
+	}
+
+	res, err := u.repo.Update(ctx, cid, mod.UpdateCustomerParams{
+		CustomerName:       reqBody.CustomerName,
+		CustomerCategoryID: reqBody.CustomerCategoryID,
+		ExpeditionSendID:   reqBody.ExpeditionSendID,
+		PaymentTermDays:    reqBody.PaymentTermDays,
+		CreditLimit:        reqBody.CreditLimit,
+		Addresses:          reqBody.Addresses,
+		TelpNumbers:        reqBody.TelpNumbers,
+		PhoneNumbers:       reqBody.PhoneNumbers,
+		Notes:              reqBody.Notes,
+		UpdatedBy:          authId,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.CustomerNotFound, id)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *customerUsecase) Delete(ctx context.Context, id string, authId string) error {
+	cid, err := utils.StringToUUID(id)
+	if err != nil {
+		return err
+	}
+	return u.repo.Delete(ctx, cid, authId)
+}
+
+func (u *customerUsecase) GetByID(ctx context.Context, id string) (*models.Customer, error) {
+	cid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.GetByID(ctx, cid)
+}
+
+func (u *customerUsecase) GetAddressesByCustomerID(ctx context.Context, id string) ([]models.CustomerAddress, error) {
+	cid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.GetAddressesByCustomerID(ctx, cid)
+}
+
+func (u *customerUsecase) GetContactsByCustomerID(ctx context.Context, id string) ([]models.CustomerContact, error) {
+	cid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.GetContactsByCustomerID(ctx, cid)
+}
+
+func (u *customerUsecase) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqCustomerIndexFilter) ([]models.Customer, int, error) {
+	return u.repo.GetIndex(ctx, req, filter)
+}
+
+func (u *customerUsecase) GetAll(ctx context.Context, filter dto.ReqCustomerIndexFilter) ([]models.Customer, error) {
+	return u.repo.GetAll(ctx, filter)
+}
+
+func (u *customerUsecase) Export(ctx context.Context, filter dto.ReqCustomerIndexFilter) ([]byte, error) {
+	// Use GetAllForExport for export with all addresses and phone numbers
+	list, err := u.repo.GetAllForExport(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find maximum number of phone numbers and telp numbers to determine header width
+	maxPhoneNumbers := 1
+	maxTelpNumbers := 1
+	for _, customer := range list {
+		if len(customer.PhoneNumbers) > maxPhoneNumbers {
+			maxPhoneNumbers = len(customer.PhoneNumbers)
+		}
+		if len(customer.TelpNumbers) > maxTelpNumbers {
+			maxTelpNumbers = len(customer.TelpNumbers)
+		}
+	}
+
+	// Create Excel file
+	f := excelize.NewFile()
+	sheet := "Customers"
+	f.SetSheetName("Sheet1", sheet)
+
+	colToLetter := func(col int) string {
+		name, _ := excelize.ColumnNumberToName(col + 1)
+		return name
+	}
+
+	// Header columns: fixed columns, No HP (merged), No Telp (merged), Update Date
+	fixedHeaders := []string{"Kode Customer", "Nama Customer", "Kategori", "Ekspedisi", "Termin (Hari)", "Limit Kredit", "Alamat Penagihan", "Alamat Pengiriman"}
+	for i, header := range fixedHeaders {
+		f.SetCellValue(sheet, colToLetter(i)+"1", header)
+	}
+
+	noHPStartCell := colToLetter(len(fixedHeaders)) + "1"
+	noHPEndCell := colToLetter(len(fixedHeaders)+maxPhoneNumbers-1) + "1"
+	f.SetCellValue(sheet, noHPStartCell, "No HP")
+	if maxPhoneNumbers > 1 {
+		if err := f.MergeCell(sheet, noHPStartCell, noHPEndCell); err != nil {
+			return nil, err
+		}
+	}
+
+	noTelpStartCell := colToLetter(len(fixedHeaders)+maxPhoneNumbers) + "1"
+	noTelpEndCell := colToLetter(len(fixedHeaders)+maxPhoneNumbers+maxTelpNumbers-1) + "1"
+	f.SetCellValue(sheet, noTelpStartCell, "No Telp")
+	if maxTelpNumbers > 1 {
+		if err := f.MergeCell(sheet, noTelpStartCell, noTelpEndCell); err != nil {
+			return nil, err
+		}
+	}
+
+	totalCols := len(fixedHeaders) + maxPhoneNumbers + maxTelpNumbers + 1
+	f.SetCellValue(sheet, colToLetter(totalCols-1)+"1", "Update Date")
+
+	// Rows
+	for i, customer := range list {
+		row := i + 2
+		col := 0
+
+		setCell := func(value interface{}) {
+			f.SetCellValue(sheet, colToLetter(col)+strconv.Itoa(row), value)
+			col++
+		}
+		setOptional := func(value *string) {
+			if value == nil || *value == "" {
+				setCell("-")
+				return
+			}
+			setCell(*value)
+		}
+		setPrimary := func(values []string) {
+			// Only the primary address is exported, it is always ordered first
+			if len(values) == 0 {
+				setCell("-")
+				return
+			}
+			setCell(values[0])
+		}
+		setNumbers := func(numbers []string, width int) {
+			for j := 0; j < width; j++ {
+				if j < len(numbers) {
+					setCell(numbers[j])
+				} else {
+					setCell("-")
+				}
+			}
+		}
+
+		setCell(customer.CustomerCode)
+		setCell(customer.CustomerName)
+		setOptional(customer.CustomerCategoryName)
+		setOptional(customer.ExpeditionSendName)
+		setCell(customer.PaymentTermDays)
+		setCell(customer.CreditLimit)
+		setPrimary(customer.BillingAddresses)
+		setPrimary(customer.ShippingAddresses)
+		setNumbers(customer.PhoneNumbers, maxPhoneNumbers)
+		setNumbers(customer.TelpNumbers, maxTelpNumbers)
+		setCell(customer.UpdatedAt.Local().Format("2006/01/02"))
+	}
+
+	// Define border configuration
+	borderDefinition := []excelize.Border{
+		{Type: "left", Color: "000000", Style: 1},
+		{Type: "top", Color: "000000", Style: 1},
+		{Type: "bottom", Color: "000000", Style: 1},
+		{Type: "right", Color: "000000", Style: 1},
+	}
+
+	borderStyle, err := f.NewStyle(&excelize.Style{
+		Border: borderDefinition,
+	})
+	if err != nil {
+		return nil, err
+	}
+	endCell := colToLetter(totalCols-1) + strconv.Itoa(len(list)+1)
+	if err := f.SetCellStyle(sheet, "A1", endCell, borderStyle); err != nil {
+		return nil, err
+	}
+
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font:   &excelize.Font{Bold: true},
+		Border: borderDefinition,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := f.SetCellStyle(sheet, "A1", colToLetter(totalCols-1)+"1", headerStyle); err != nil {
+		return nil, err
+	}
+
+	// Center the merged "No HP" and "No Telp" headers
+	mergedHeaderStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{Bold: true},
+		Alignment: &excelize.Alignment{
+			Horizontal: "center",
+			Vertical:   "center",
+		},
+		Border: borderDefinition,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := f.SetCellStyle(sheet, noHPStartCell, noHPEndCell, mergedHeaderStyle); err != nil {
+		return nil, err
+	}
+	if err := f.SetCellStyle(sheet, noTelpStartCell, noTelpEndCell, mergedHeaderStyle); err != nil {
+		return nil, err
+	}
+
+	// Write to buffer and return bytes
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
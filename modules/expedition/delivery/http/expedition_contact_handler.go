@@ -0,0 +1,156 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/modules/expedition/dto"
+)
+
+// GetContacts godoc
+// @Summary		Get expedition contacts
+// @Description	Retrieve all contacts of an expedition, primary contacts first. Requires 'api.master-data.expedition.view' permission.
+// @Tags			Expedition - Contact
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		string	true	"Expedition UUID"
+// @Success		200	{object}	response.NonPaginationResponse{data=[]dto.RespExpeditionContact}	"Successfully retrieved contacts"
+// @Failure		400	{object}	response.NonPaginationResponse	"Bad request - invalid UUID or expedition not found"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403	{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/expedition/{id}/contacts [get]
+func (h *ExpeditionHandler) GetContacts(c echo.Context) error {
+	res, err := h.Usecase.GetContacts(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	respContacts := []dto.RespExpeditionContact{}
+	for _, v := range res {
+		respContacts = append(respContacts, dto.ToRespExpeditionContact(v))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(respContacts)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// CreateContact godoc
+// @Summary		Add an expedition contact
+// @Description	Add a telp or hp contact to an expedition. The number is normalized to E.164 (+62...), the area code is only combined with local telp numbers, and numbers already used by the expedition are rejected. The first contact of a phone type always becomes primary. Requires 'api.master-data.expedition.update' permission.
+// @Tags			Expedition - Contact
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string							true	"Expedition UUID"
+// @Param			request	body		dto.ReqCreateExpeditionContact	true	"Contact data. Fields: phone_type (required, telp/hp), phone_number (required), area_code, contact_name, email, is_primary"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespExpeditionContact}	"Successfully added contact"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, invalid or duplicated phone number"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/expedition/{id}/contacts [post]
+func (h *ExpeditionHandler) CreateContact(c echo.Context) error {
+	req := new(dto.ReqCreateExpeditionContact)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := h.Usecase.CreateContact(c.Request().Context(), c.Param("id"), req, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespExpeditionContact(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// UpdateContact godoc
+// @Summary		Update an expedition contact
+// @Description	Update the number and contact person of an expedition contact. The phone type and primary flag are kept. Requires 'api.master-data.expedition.update' permission.
+// @Tags			Expedition - Contact
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id			path		string							true	"Expedition UUID"
+// @Param			contactId	path		string							true	"Contact UUID"
+// @Param			request		body		dto.ReqUpdateExpeditionContact	true	"Contact data. Fields: phone_number (required), area_code, contact_name, email"
+// @Success		200			{object}	response.NonPaginationResponse{data=dto.RespExpeditionContact}	"Successfully updated contact"
+// @Failure		400			{object}	response.NonPaginationResponse	"Bad request - validation error, contact not found, invalid or duplicated phone number"
+// @Failure		401			{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403			{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/expedition/{id}/contacts/{contactId} [put]
+func (h *ExpeditionHandler) UpdateContact(c echo.Context) error {
+	req := new(dto.ReqUpdateExpeditionContact)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := h.Usecase.UpdateContact(c.Request().Context(), c.Param("id"), c.Param("contactId"), req, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespExpeditionContact(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// SetPrimaryContact godoc
+// @Summary		Set the primary expedition contact
+// @Description	Make a contact the primary contact of its phone type, the previous primary contact of that type is unset. Requires 'api.master-data.expedition.update' permission.
+// @Tags			Expedition - Contact
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id			path		string	true	"Expedition UUID"
+// @Param			contactId	path		string	true	"Contact UUID"
+// @Success		200			{object}	response.NonPaginationResponse{data=dto.RespExpeditionContact}	"Successfully set primary contact"
+// @Failure		400			{object}	response.NonPaginationResponse	"Bad request - invalid UUID or contact not found"
+// @Failure		401			{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403			{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/expedition/{id}/contacts/{contactId}/primary [patch]
+func (h *ExpeditionHandler) SetPrimaryContact(c echo.Context) error {
+	res, err := h.Usecase.SetPrimaryContact(c.Request().Context(), c.Param("id"), c.Param("contactId"), authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespExpeditionContact(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DeleteContact godoc
+// @Summary		Remove an expedition contact
+// @Description	Soft delete an expedition contact. When the primary contact is removed, the oldest remaining contact of the same phone type becomes primary. Requires 'api.master-data.expedition.update' permission.
+// @Tags			Expedition - Contact
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id			path		string	true	"Expedition UUID"
+// @Param			contactId	path		string	true	"Contact UUID"
+// @Success		200			{object}	response.NonPaginationResponse	"Successfully removed contact"
+// @Failure		400			{object}	response.NonPaginationResponse	"Bad request - invalid UUID or contact not found"
+// @Failure		401			{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403			{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/expedition/{id}/contacts/{contactId} [delete]
+func (h *ExpeditionHandler) DeleteContact(c echo.Context) error {
+	if err := h.Usecase.DeleteContact(c.Request().Context(), c.Param("id"), c.Param("contactId"), authUserID(c)); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(Response{Message: constants.ExpeditionContactDeleteSuccess})
+	return c.JSON(http.StatusOK, resp)
+}
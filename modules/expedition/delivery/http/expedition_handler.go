@@ -58,6 +58,17 @@ func NewExpeditionHandler(e *echo.Echo, uc expedition.Usecase, mwP _reqContext.I
 	// Export (no pagination, same filters) - must be before /:id to avoid route conflict
 	r.GET("/export", h.Export, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToExport))
 
+	// Import from Excel - template must be before /:id to avoid route conflict
+	r.GET("/import/template", h.DownloadImportTemplate, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToCreate))
+	r.POST("/import", h.ImportExpeditions, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToCreate))
+
+	// Quote candidate expeditions for a route - must be before /:id to avoid route conflict
+	r.GET("/quote", h.Quote, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+
+	// Coverage & tariff import templates
+	r.GET("/coverages/import/template", h.DownloadCoverageImportTemplate, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.GET("/tariffs/import/template", h.DownloadTariffImportTemplate, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+
 	// Get by ID (detail) - must be after /export to avoid route conflict
 	r.GET("/:id", h.GetByID, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
 
@@ -69,6 +80,29 @@ func NewExpeditionHandler(e *echo.Echo, uc expedition.Usecase, mwP _reqContext.I
 
 	// Delete
 	r.DELETE("/:id", h.Delete, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToDelete))
+
+	// Contacts
+	r.GET("/:id/contacts", h.GetContacts, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+	r.POST("/:id/contacts", h.CreateContact, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.PUT("/:id/contacts/:contactId", h.UpdateContact, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.PATCH("/:id/contacts/:contactId/primary", h.SetPrimaryContact, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.DELETE("/:id/contacts/:contactId", h.DeleteContact, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+
+	// Coverage areas (export and import must be before /:coverageId to avoid route conflict)
+	r.GET("/:id/coverages", h.GetCoverages, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+	r.GET("/:id/coverages/export", h.ExportCoverages, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToExport))
+	r.POST("/:id/coverages/import", h.ImportCoverages, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.POST("/:id/coverages", h.CreateCoverage, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.DELETE("/:id/coverages/:coverageId", h.DeleteCoverage, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+
+	// Tariffs (export and import must be before /:tariffId to avoid route conflict)
+	r.GET("/:id/tariffs", h.GetTariffs, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+	r.GET("/:id/tariffs/export", h.ExportTariffs, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToExport))
+	r.POST("/:id/tariffs/import", h.ImportTariffs, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.GET("/:id/tariffs/:tariffId", h.GetTariffByID, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+	r.POST("/:id/tariffs", h.CreateTariff, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.PUT("/:id/tariffs/:tariffId", h.UpdateTariff, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.DELETE("/:id/tariffs/:tariffId", h.DeleteTariff, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
 }
 
 // Create godoc
@@ -175,14 +209,14 @@ func (h *ExpeditionHandler) Update(c echo.Context) error {
 
 // Delete godoc
 // @Summary		Soft delete expedition
-// @Description	Soft delete an existing expedition by ID. The expedition will be marked as deleted (deleted_at is set) but remains in the database. Requires 'api.master-data.expedition.delete' permission.
+// @Description	Soft delete an existing expedition by ID. The expedition will be marked as deleted (deleted_at is set) but remains in the database. An expedition still used by active suppliers or customers cannot be deleted. Requires 'api.master-data.expedition.delete' permission.
 // @Tags			Expedition
 // @Accept			json
 // @Produce		json
 // @Security		BearerAuth
 // @Param			id		path	string	true	"Expedition UUID"
 // @Success		200		{object}	response.NonPaginationResponse	"Successfully soft deleted expedition"
-// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid UUID"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid UUID or expedition still in use"
 // @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
 // @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
 // @Failure		404		{object}	response.NonPaginationResponse	"Expedition not found"
@@ -0,0 +1,59 @@
+package http
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+)
+
+// ImportExpeditions godoc
+// @Summary		Import expeditions from Excel file
+// @Description	Create expeditions from an Excel file (.xlsx or .xls) with columns: expedition_code (optional, generated when empty), expedition_name, address, phone_numbers, telp_numbers, notes. Several numbers can be put in one cell separated by comma, semicolon or new line, the first one becomes primary. Names must not be used by another expedition and numbers must be valid Indonesian numbers. Valid rows are imported even when other rows fail, failed rows are returned with HTTP 400. Requires 'api.master-data.expedition.create' permission.
+// @Tags			Expedition
+// @Accept			multipart/form-data
+// @Produce		json
+// @Security		BearerAuth
+// @Param			file	formData	file	true	"Excel file (.xlsx or .xls) with columns: expedition_code, expedition_name, address, phone_numbers, telp_numbers, notes"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.ResImportExpeditions}	"Successfully imported all expeditions"
+// @Failure		400		{object}	response.NonPaginationResponse{data=dto.ResImportExpeditions}	"Bad request - one or more rows failed validation. Response contains details for each row including row number, expedition name, status, and error message"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500		{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/expedition/import [post]
+func (h *ExpeditionHandler) ImportExpeditions(c echo.Context) error {
+	tempFilePath, status, err := saveExpeditionImportFile(c, "import_expeditions")
+	if err != nil {
+		return c.JSON(status, response.SetErrorResponse(status, err.Error()))
+	}
+	defer os.Remove(tempFilePath)
+
+	res, err := h.Usecase.ImportFromExcel(c.Request().Context(), tempFilePath, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	return respondExpeditionImport(c, res, res.SuccessCount, res.FailedCount)
+}
+
+// DownloadImportTemplate godoc
+// @Summary		Download expedition import Excel template
+// @Description	Download Excel template file for importing expeditions. Template contains columns: expedition_code, expedition_name, address, phone_numbers, telp_numbers, notes with example data.
+// @Tags			Expedition
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Success		200	{file}		file	"Excel template file"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500	{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/expedition/import/template [get]
+func (h *ExpeditionHandler) DownloadImportTemplate(c echo.Context) error {
+	// generated code with several numbers, then an explicit code without contacts
+	examples := [][]string{
+		{"", "JNE", "Jl. Tomang Raya No. 11, Jakarta", "081234567890, 081298765432", "021-5669999", "Regular and YES services"},
+		{"99", "SiCepat", "Jl. Kebon Jeruk No. 5, Jakarta", "", "", ""},
+	}
+
+	headers := []string{"Expedition Code", "Expedition Name", "Address", "Phone Numbers", "Telp Numbers", "Notes"}
+	return writeExpeditionTemplate(c, "Import Expeditions", headers, []float64{18, 25, 40, 30, 25, 30}, examples, "expedition_import_template.xlsx")
+}
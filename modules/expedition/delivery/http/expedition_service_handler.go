@@ -0,0 +1,545 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/expedition/dto"
+	"github.com/xuri/excelize/v2"
+)
+
+// authUserID returns the id of the authenticated user, empty when there is none
+func authUserID(c echo.Context) string {
+	if userModel, ok := c.Get("user").(models.User); ok {
+		return userModel.ID.String()
+	}
+	return ""
+}
+
+// GetCoverages godoc
+// @Summary		Get expedition coverage areas
+// @Description	Retrieve the cities and districts served by an expedition. A coverage without district serves the whole city. Requires 'api.master-data.expedition.view' permission.
+// @Tags			Expedition - Coverage
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		string	true	"Expedition UUID"
+// @Success		200	{object}	response.NonPaginationResponse{data=[]dto.RespExpeditionCoverage}	"Successfully retrieved coverage areas"
+// @Failure		400	{object}	response.NonPaginationResponse	"Bad request - invalid UUID or expedition not found"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403	{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/expedition/{id}/coverages [get]
+func (h *ExpeditionHandler) GetCoverages(c echo.Context) error {
+	res, err := h.Usecase.GetCoverages(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	respCoverages := []dto.RespExpeditionCoverage{}
+	for _, v := range res {
+		respCoverages = append(respCoverages, dto.ToRespExpeditionCoverage(v))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(respCoverages)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// CreateCoverage godoc
+// @Summary		Add an expedition coverage area
+// @Description	Add a city, or a single district of a city, served by an expedition. Leave district_id empty to serve the whole city. Requires 'api.master-data.expedition.update' permission.
+// @Tags			Expedition - Coverage
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string							true	"Expedition UUID"
+// @Param			request	body		dto.ReqCreateExpeditionCoverage	true	"Coverage data. Fields: city_id (required), district_id (optional, must belong to the city)"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespExpeditionCoverage}	"Successfully added coverage area"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, unknown region or area already covered"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/expedition/{id}/coverages [post]
+func (h *ExpeditionHandler) CreateCoverage(c echo.Context) error {
+	req := new(dto.ReqCreateExpeditionCoverage)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := h.Usecase.CreateCoverage(c.Request().Context(), c.Param("id"), req, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespExpeditionCoverage(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DeleteCoverage godoc
+// @Summary		Remove an expedition coverage area
+// @Description	Soft delete a coverage area of an expedition. Requires 'api.master-data.expedition.update' permission.
+// @Tags			Expedition - Coverage
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id			path		string	true	"Expedition UUID"
+// @Param			coverageId	path		string	true	"Coverage UUID"
+// @Success		200			{object}	response.NonPaginationResponse	"Successfully removed coverage area"
+// @Failure		400			{object}	response.NonPaginationResponse	"Bad request - invalid UUID or coverage not found"
+// @Failure		401			{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403			{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/expedition/{id}/coverages/{coverageId} [delete]
+func (h *ExpeditionHandler) DeleteCoverage(c echo.Context) error {
+	if err := h.Usecase.DeleteCoverage(c.Request().Context(), c.Param("id"), c.Param("coverageId"), authUserID(c)); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(Response{Message: constants.ExpeditionCoverageDeleteSuccess})
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ExportCoverages godoc
+// @Summary		Export expedition coverage areas to Excel
+// @Description	Export the coverage areas of an expedition to Excel file (.xlsx). Excel file includes: Kode Kota, Kode Kecamatan, Nama Kota, Nama Kecamatan, Update Date. The first two columns follow the import template so the file can be imported back. Requires 'api.master-data.expedition.export' permission.
+// @Tags			Expedition - Coverage
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Param			id	path		string	true	"Expedition UUID"
+// @Success		200	{file}		binary	"Excel file (expedition_coverages.xlsx)"
+// @Failure		400	{object}	response.NonPaginationResponse	"Bad request - invalid UUID or expedition not found"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403	{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/expedition/{id}/coverages/export [get]
+func (h *ExpeditionHandler) ExportCoverages(c echo.Context) error {
+	excelBytes, err := h.Usecase.ExportCoverages(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, constants.ExcelContent)
+	c.Response().Header().Set(constants.FieldContentDisposition, constants.ExcelContentDisposition("expedition_coverages.xlsx"))
+	return c.Blob(http.StatusOK, constants.ExcelContent, excelBytes)
+}
+
+// ImportCoverages godoc
+// @Summary		Import expedition coverage areas from Excel file
+// @Description	Add coverage areas from an Excel file (.xlsx or .xls) with columns: city_code, district_code (optional). Regions are matched by official code. Areas already covered are reported as success. Valid rows are imported even when other rows fail, failed rows are returned with HTTP 400. Requires 'api.master-data.expedition.update' permission.
+// @Tags			Expedition - Coverage
+// @Accept			multipart/form-data
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string	true	"Expedition UUID"
+// @Param			file	formData	file	true	"Excel file (.xlsx or .xls) with columns: city_code, district_code"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.ResImportExpeditionServices}	"Successfully imported all rows"
+// @Failure		400		{object}	response.NonPaginationResponse{data=dto.ResImportExpeditionServices}	"Bad request - one or more rows failed validation. Response contains details for each row including row number, key, status, and error message"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500		{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/expedition/{id}/coverages/import [post]
+func (h *ExpeditionHandler) ImportCoverages(c echo.Context) error {
+	tempFilePath, status, err := saveExpeditionImportFile(c, "import_expedition_coverages")
+	if err != nil {
+		return c.JSON(status, response.SetErrorResponse(status, err.Error()))
+	}
+	defer os.Remove(tempFilePath)
+
+	res, err := h.Usecase.ImportCoveragesFromExcel(c.Request().Context(), c.Param("id"), tempFilePath, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	return respondExpeditionImport(c, res, res.SuccessCount, res.FailedCount)
+}
+
+// DownloadCoverageImportTemplate godoc
+// @Summary		Download expedition coverage import Excel template
+// @Description	Download Excel template file for importing expedition coverage areas. Template contains columns: city_code, district_code with example data.
+// @Tags			Expedition - Coverage
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Success		200	{file}		file	"Excel template file"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500	{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/expedition/coverages/import/template [get]
+func (h *ExpeditionHandler) DownloadCoverageImportTemplate(c echo.Context) error {
+	// whole city, then a single district of another city
+	examples := [][]string{
+		{"32.73", ""},
+		{"32.04", "32.04.05"},
+	}
+
+	return writeExpeditionTemplate(c, "Import Coverages", []string{"City Code", "District Code"}, []float64{20, 20}, examples, "expedition_coverage_import_template.xlsx")
+}
+
+// GetTariffs godoc
+// @Summary		Get expedition tariffs
+// @Description	Retrieve the tariff table of an expedition: origin city, destination city, price per kg, minimum weight and lead time. Requires 'api.master-data.expedition.view' permission.
+// @Tags			Expedition - Tariff
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		string	true	"Expedition UUID"
+// @Success		200	{object}	response.NonPaginationResponse{data=[]dto.RespExpeditionTariff}	"Successfully retrieved tariffs"
+// @Failure		400	{object}	response.NonPaginationResponse	"Bad request - invalid UUID or expedition not found"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403	{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/expedition/{id}/tariffs [get]
+func (h *ExpeditionHandler) GetTariffs(c echo.Context) error {
+	res, err := h.Usecase.GetTariffs(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	respTariffs := []dto.RespExpeditionTariff{}
+	for _, v := range res {
+		respTariffs = append(respTariffs, dto.ToRespExpeditionTariff(v))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(respTariffs)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetTariffByID godoc
+// @Summary		Get expedition tariff by ID
+// @Description	Retrieve a single tariff of an expedition. Requires 'api.master-data.expedition.view' permission.
+// @Tags			Expedition - Tariff
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id			path		string	true	"Expedition UUID"
+// @Param			tariffId	path		string	true	"Tariff UUID"
+// @Success		200			{object}	response.NonPaginationResponse{data=dto.RespExpeditionTariff}	"Successfully retrieved tariff"
+// @Failure		400			{object}	response.NonPaginationResponse	"Bad request - invalid UUID or tariff not found"
+// @Failure		401			{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403			{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/expedition/{id}/tariffs/{tariffId} [get]
+func (h *ExpeditionHandler) GetTariffByID(c echo.Context) error {
+	res, err := h.Usecase.GetTariffByID(c.Request().Context(), c.Param("id"), c.Param("tariffId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespExpeditionTariff(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// CreateTariff godoc
+// @Summary		Create an expedition tariff
+// @Description	Add the tariff of a route (origin city to destination city) to an expedition. An expedition has a single tariff per route. Requires 'api.master-data.expedition.update' permission.
+// @Tags			Expedition - Tariff
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string							true	"Expedition UUID"
+// @Param			request	body		dto.ReqCreateExpeditionTariff	true	"Tariff data. Fields: origin_city_id (required), destination_city_id (required), price_per_kg (> 0), min_weight (kg, >= 0), lead_time_days (>= 0)"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespExpeditionTariff}	"Successfully created tariff"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, unknown city or route already has a tariff"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/expedition/{id}/tariffs [post]
+func (h *ExpeditionHandler) CreateTariff(c echo.Context) error {
+	req := new(dto.ReqCreateExpeditionTariff)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := h.Usecase.CreateTariff(c.Request().Context(), c.Param("id"), req, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespExpeditionTariff(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// UpdateTariff godoc
+// @Summary		Update an expedition tariff
+// @Description	Update the route, price per kg, minimum weight and lead time of an expedition tariff. Requires 'api.master-data.expedition.update' permission.
+// @Tags			Expedition - Tariff
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id			path		string							true	"Expedition UUID"
+// @Param			tariffId	path		string							true	"Tariff UUID"
+// @Param			request		body		dto.ReqUpdateExpeditionTariff	true	"Updated tariff data. Fields: origin_city_id (required), destination_city_id (required), price_per_kg (> 0), min_weight (kg, >= 0), lead_time_days (>= 0)"
+// @Success		200			{object}	response.NonPaginationResponse{data=dto.RespExpeditionTariff}	"Successfully updated tariff"
+// @Failure		400			{object}	response.NonPaginationResponse	"Bad request - validation error, unknown city, route already has a tariff or tariff not found"
+// @Failure		401			{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403			{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/expedition/{id}/tariffs/{tariffId} [put]
+func (h *ExpeditionHandler) UpdateTariff(c echo.Context) error {
+	req := new(dto.ReqUpdateExpeditionTariff)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := h.Usecase.UpdateTariff(c.Request().Context(), c.Param("id"), c.Param("tariffId"), req, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespExpeditionTariff(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DeleteTariff godoc
+// @Summary		Delete an expedition tariff
+// @Description	Soft delete a tariff of an expedition. Requires 'api.master-data.expedition.update' permission.
+// @Tags			Expedition - Tariff
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id			path		string	true	"Expedition UUID"
+// @Param			tariffId	path		string	true	"Tariff UUID"
+// @Success		200			{object}	response.NonPaginationResponse	"Successfully deleted tariff"
+// @Failure		400			{object}	response.NonPaginationResponse	"Bad request - invalid UUID or tariff not found"
+// @Failure		401			{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403			{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/expedition/{id}/tariffs/{tariffId} [delete]
+func (h *ExpeditionHandler) DeleteTariff(c echo.Context) error {
+	if err := h.Usecase.DeleteTariff(c.Request().Context(), c.Param("id"), c.Param("tariffId"), authUserID(c)); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(Response{Message: constants.ExpeditionTariffDeleteSuccess})
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ExportTariffs godoc
+// @Summary		Export expedition tariffs to Excel
+// @Description	Export the tariff table of an expedition to Excel file (.xlsx). Excel file includes: Kode Kota Asal, Kode Kota Tujuan, Harga per Kg, Berat Minimum (Kg), Lead Time (Hari), Kota Asal, Kota Tujuan, Update Date. The first five columns follow the import template so the file can be imported back. Requires 'api.master-data.expedition.export' permission.
+// @Tags			Expedition - Tariff
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Param			id	path		string	true	"Expedition UUID"
+// @Success		200	{file}		binary	"Excel file (expedition_tariffs.xlsx)"
+// @Failure		400	{object}	response.NonPaginationResponse	"Bad request - invalid UUID or expedition not found"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403	{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/expedition/{id}/tariffs/export [get]
+func (h *ExpeditionHandler) ExportTariffs(c echo.Context) error {
+	excelBytes, err := h.Usecase.ExportTariffs(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, constants.ExcelContent)
+	c.Response().Header().Set(constants.FieldContentDisposition, constants.ExcelContentDisposition("expedition_tariffs.xlsx"))
+	return c.Blob(http.StatusOK, constants.ExcelContent, excelBytes)
+}
+
+// ImportTariffs godoc
+// @Summary		Import expedition tariffs from Excel file
+// @Description	Upsert tariffs from an Excel file (.xlsx or .xls) with columns: origin_city_code, destination_city_code, price_per_kg, min_weight, lead_time_days. Cities are matched by official code, existing routes get the new tariff. Valid rows are imported even when other rows fail, failed rows are returned with HTTP 400. Requires 'api.master-data.expedition.update' permission.
+// @Tags			Expedition - Tariff
+// @Accept			multipart/form-data
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string	true	"Expedition UUID"
+// @Param			file	formData	file	true	"Excel file (.xlsx or .xls) with columns: origin_city_code, destination_city_code, price_per_kg, min_weight, lead_time_days"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.ResImportExpeditionServices}	"Successfully imported all rows"
+// @Failure		400		{object}	response.NonPaginationResponse{data=dto.ResImportExpeditionServices}	"Bad request - one or more rows failed validation. Response contains details for each row including row number, key, status, and error message"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500		{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/expedition/{id}/tariffs/import [post]
+func (h *ExpeditionHandler) ImportTariffs(c echo.Context) error {
+	tempFilePath, status, err := saveExpeditionImportFile(c, "import_expedition_tariffs")
+	if err != nil {
+		return c.JSON(status, response.SetErrorResponse(status, err.Error()))
+	}
+	defer os.Remove(tempFilePath)
+
+	res, err := h.Usecase.ImportTariffsFromExcel(c.Request().Context(), c.Param("id"), tempFilePath, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	return respondExpeditionImport(c, res, res.SuccessCount, res.FailedCount)
+}
+
+// DownloadTariffImportTemplate godoc
+// @Summary		Download expedition tariff import Excel template
+// @Description	Download Excel template file for importing expedition tariffs. Template contains columns: origin_city_code, destination_city_code, price_per_kg, min_weight, lead_time_days with example data.
+// @Tags			Expedition - Tariff
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Success		200	{file}		file	"Excel template file"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500	{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/expedition/tariffs/import/template [get]
+func (h *ExpeditionHandler) DownloadTariffImportTemplate(c echo.Context) error {
+	examples := [][]string{
+		{"32.73", "31.71", "5000", "1", "2"},
+	}
+
+	return writeExpeditionTemplate(c, "Import Tariffs", []string{"Origin City Code", "Destination City Code", "Price per Kg", "Min Weight", "Lead Time Days"}, []float64{20, 25, 15, 15, 15}, examples, "expedition_tariff_import_template.xlsx")
+}
+
+// Quote godoc
+// @Summary		Quote expeditions for a shipment
+// @Description	Return the expeditions able to ship a parcel from the origin city to the destination city, cheapest first then fastest. An expedition is a candidate when it has a tariff for the route and covers both cities (and the destination district when given). Cost is price_per_kg times the greater of the weight and the tariff minimum weight, estimated_arrival is today plus the lead time. Requires 'api.master-data.expedition.view' permission.
+// @Tags			Expedition - Tariff
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			origin_city_id			query		string	true	"Origin city UUID"
+// @Param			destination_city_id		query		string	true	"Destination city UUID"
+// @Param			destination_district_id	query		string	false	"Destination district UUID, must belong to the destination city"
+// @Param			weight					query		number	true	"Parcel weight in kg"
+// @Success		200						{object}	response.NonPaginationResponse{data=[]dto.RespExpeditionQuote}	"Successfully retrieved candidate expeditions"
+// @Failure		400						{object}	response.NonPaginationResponse	"Bad request - invalid query parameters"
+// @Failure		401						{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403						{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/expedition/quote [get]
+func (h *ExpeditionHandler) Quote(c echo.Context) error {
+	req := new(dto.ReqExpeditionQuote)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := h.Usecase.Quote(c.Request().Context(), *req)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// respondExpeditionImport answers an import report, HTTP 400 when at least one row failed
+func respondExpeditionImport(c echo.Context, res interface{}, successCount int, failedCount int) error {
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+
+	if failedCount > 0 {
+		resp.Message = constants.ExpeditionImportFailedPartial
+		if successCount == 0 {
+			resp.Message = constants.ExpeditionImportFailed
+		}
+		resp.Status = http.StatusBadRequest
+		return c.JSON(http.StatusBadRequest, resp)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// saveExpeditionImportFile copies the uploaded "file" field to a temporary file keeping its extension,
+// the caller removes the file. The returned status is the one to answer with on error.
+func saveExpeditionImportFile(c echo.Context, prefix string) (string, int, error) {
+	// Get uploaded file
+	file, err := c.FormFile("file")
+	if err != nil {
+		return "", http.StatusBadRequest, errors.New(constants.ExpeditionImportFileNotFound)
+	}
+
+	// Validate file extension
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+	if ext != ".xlsx" && ext != ".xls" {
+		return "", http.StatusBadRequest, errors.New(constants.ExpeditionImportInvalidFileFormat)
+	}
+
+	// Open uploaded file
+	src, err := file.Open()
+	if err != nil {
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.ExpeditionImportFileOpenFailed, err)
+	}
+	defer src.Close()
+
+	tempFilePath := filepath.Join(os.TempDir(), fmt.Sprintf("%s_%d%s", prefix, time.Now().UnixNano(), ext))
+	dst, err := os.Create(tempFilePath)
+	if err != nil {
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.ExpeditionImportFileOpenFailed, err)
+	}
+	defer dst.Close()
+
+	if _, err = io.Copy(dst, src); err != nil {
+		os.Remove(tempFilePath)
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.ExpeditionImportFileOpenFailed, err)
+	}
+
+	return tempFilePath, http.StatusOK, nil
+}
+
+// writeExpeditionTemplate streams a single sheet template with a styled header row and text example rows
+func writeExpeditionTemplate(c echo.Context, sheetName string, headers []string, widths []float64, examples [][]string, fileName string) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	f.SetSheetName("Sheet1", sheetName)
+
+	for i, header := range headers {
+		col, _ := excelize.ColumnNumberToName(i + 1)
+		f.SetCellValue(sheetName, col+"1", header)
+		f.SetColWidth(sheetName, col, col, widths[i])
+	}
+
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{
+			Bold: true,
+			Size: 12,
+		},
+		Fill: excelize.Fill{
+			Type:    "pattern",
+			Color:   []string{"#E8E8E8"},
+			Pattern: 1,
+		},
+		Alignment: &excelize.Alignment{
+			Horizontal: "center",
+			Vertical:   "center",
+		},
+	})
+	if err == nil {
+		lastCol, _ := excelize.ColumnNumberToName(len(headers))
+		f.SetCellStyle(sheetName, "A1", lastCol+"1", headerStyle)
+	}
+
+	// codes are text so leading zeros are kept
+	for i, example := range examples {
+		for j, value := range example {
+			cell, _ := excelize.CoordinatesToCellName(j+1, i+2)
+			f.SetCellStr(sheetName, cell, value)
+		}
+	}
+
+	c.Response().Header().Set(constants.FieldContentType, constants.ExcelContent)
+	c.Response().Header().Set(constants.FieldContentDisposition, constants.ExcelContentDisposition(fileName))
+
+	if err := f.Write(c.Response().Writer); err != nil {
+		return c.JSON(http.StatusInternalServerError, response.SetErrorResponse(http.StatusInternalServerError, fmt.Sprintf("%s: %v", constants.ExpeditionImportTemplateCreateFailed, err)))
+	}
+
+	return nil
+}
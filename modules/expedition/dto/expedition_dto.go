@@ -39,25 +39,28 @@ type ContactResponse struct {
 }
 
 type RespExpedition struct {
-	ID             uuid.UUID        `json:"id"`
-	ExpeditionCode string           `json:"expedition_code"`
-	ExpeditionName string           `json:"expedition_name"`
-	Address        string           `json:"address"`
-	TelpNumbers    []TelpNumberItem `json:"telp_numbers"`
-	PhoneNumbers   []string         `json:"phone_numbers"`
-	Notes          *string          `json:"notes,omitempty"`
-	CreatedAt      string           `json:"created_at"`
-	CreatedBy      string           `json:"created_by"`
-	UpdatedAt      string           `json:"updated_at"`
-	UpdatedBy      string           `json:"updated_by"`
-	Deletable      bool             `json:"deletable"`
+	ID             uuid.UUID               `json:"id"`
+	ExpeditionCode string                  `json:"expedition_code"`
+	ExpeditionName string                  `json:"expedition_name"`
+	Address        string                  `json:"address"`
+	TelpNumbers    []TelpNumberItem        `json:"telp_numbers"`
+	PhoneNumbers   []string                `json:"phone_numbers"`
+	Contacts       []RespExpeditionContact `json:"contacts"` // All contacts with contact person details
+	Notes          *string                 `json:"notes,omitempty"`
+	CreatedAt      string                  `json:"created_at"`
+	CreatedBy      string                  `json:"created_by"`
+	UpdatedAt      string                  `json:"updated_at"`
+	UpdatedBy      string                  `json:"updated_by"`
+	Deletable      bool                    `json:"deletable"`
 }
 
 func ToRespExpedition(m models.Expedition, contacts []models.ExpeditionContact) RespExpedition {
 	// Map contacts to response
 	telpNumbers := []TelpNumberItem{}
 	PhoneNumbers := []string{}
+	respContacts := []RespExpeditionContact{}
 	for _, contact := range contacts {
+		respContacts = append(respContacts, ToRespExpeditionContact(contact))
 		if contact.PhoneType == constants.ExpeditionContactTypeTelp {
 			telpNumbers = append(telpNumbers, TelpNumberItem{
 				AreaCode:    contact.AreaCode,
@@ -75,6 +78,7 @@ func ToRespExpedition(m models.Expedition, contacts []models.ExpeditionContact)
 		Address:        m.Address,
 		TelpNumbers:    telpNumbers,
 		PhoneNumbers:   PhoneNumbers,
+		Contacts:       respContacts,
 		Notes:          m.Notes,
 		CreatedAt:      m.CreatedAt.Format("2006-01-02 15:04:05"),
 		CreatedBy:      m.CreatedBy,
@@ -132,3 +136,49 @@ type ReqExpeditionIndexFilter struct {
 	SortBy                 string   `query:"sort_by" json:"sort_by"`
 	SortOrder              string   `query:"sort_order" json:"sort_order"`
 }
+
+type ReqCreateExpeditionContact struct {
+	PhoneType   string  `form:"phone_type" json:"phone_type" validate:"required,oneof=telp hp"`
+	AreaCode    *string `form:"area_code" json:"area_code,omitempty" validate:"omitempty,max=10"` // Only combined with local numbers, e.g. "021" + "555"
+	PhoneNumber string  `form:"phone_number" json:"phone_number" validate:"required,max=50"`
+	ContactName *string `form:"contact_name" json:"contact_name,omitempty" validate:"omitempty,max=255"`
+	Email       *string `form:"email" json:"email,omitempty" validate:"omitempty,email,max=255"`
+	IsPrimary   bool    `form:"is_primary" json:"is_primary"`
+}
+
+type ReqUpdateExpeditionContact struct {
+	AreaCode    *string `form:"area_code" json:"area_code,omitempty" validate:"omitempty,max=10"`
+	PhoneNumber string  `form:"phone_number" json:"phone_number" validate:"required,max=50"`
+	ContactName *string `form:"contact_name" json:"contact_name,omitempty" validate:"omitempty,max=255"`
+	Email       *string `form:"email" json:"email,omitempty" validate:"omitempty,email,max=255"`
+}
+
+type RespExpeditionContact struct {
+	ID               uuid.UUID `json:"id"`
+	ExpeditionID     uuid.UUID `json:"expedition_id"`
+	PhoneType        string    `json:"phone_type"`
+	AreaCode         *string   `json:"area_code"`
+	PhoneNumber      string    `json:"phone_number"`
+	NormalizedNumber string    `json:"normalized_number"` // E.164, e.g. +6221555
+	ContactName      *string   `json:"contact_name"`
+	Email            *string   `json:"email"`
+	IsPrimary        bool      `json:"is_primary"`
+	CreatedAt        string    `json:"created_at"`
+	UpdatedAt        string    `json:"updated_at"`
+}
+
+func ToRespExpeditionContact(m models.ExpeditionContact) RespExpeditionContact {
+	return RespExpeditionContact{
+		ID:               m.ID,
+		ExpeditionID:     m.ExpeditionID,
+		PhoneType:        m.PhoneType,
+		AreaCode:         m.AreaCode,
+		PhoneNumber:      m.PhoneNumber,
+		NormalizedNumber: m.NormalizedNumber,
+		ContactName:      m.ContactName,
+		Email:            m.Email,
+		IsPrimary:        m.IsPrimary,
+		CreatedAt:        m.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:        m.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,16 @@
+package dto
+
+type ResImportExpeditionExcel struct {
+	Row            int    `json:"row"`                     // Nomor baris di Excel
+	ExpeditionName string `json:"expedition_name"`         // Nama ekspedisi
+	Status         string `json:"status"`                  // Status row: "success" atau "failed"
+	ErrorMessage   string `json:"error_message,omitempty"` // Message error jika status failed
+	Success        bool   `json:"-"`                       // Internal field, tidak ditampilkan di response
+}
+
+type ResImportExpeditions struct {
+	TotalRows    int                        `json:"total_rows"`
+	SuccessCount int                        `json:"success_count"`
+	FailedCount  int                        `json:"failed_count"`
+	Results      []ResImportExpeditionExcel `json:"results"`
+}
@@ -0,0 +1,144 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/models"
+)
+
+type ReqCreateExpeditionCoverage struct {
+	CityID     uuid.UUID  `form:"city_id" json:"city_id" validate:"required"`
+	DistrictID *uuid.UUID `form:"district_id" json:"district_id,omitempty"` // Empty means the whole city is served
+}
+
+type RespExpeditionCoverage struct {
+	ID           uuid.UUID  `json:"id"`
+	ExpeditionID uuid.UUID  `json:"expedition_id"`
+	CityID       uuid.UUID  `json:"city_id"`
+	CityCode     *string    `json:"city_code"`
+	CityName     *string    `json:"city_name"`
+	DistrictID   *uuid.UUID `json:"district_id"`
+	DistrictCode *string    `json:"district_code"`
+	DistrictName *string    `json:"district_name"`
+	CreatedAt    string     `json:"created_at"`
+	UpdatedAt    string     `json:"updated_at"`
+}
+
+func ToRespExpeditionCoverage(m models.ExpeditionCoverage) RespExpeditionCoverage {
+	return RespExpeditionCoverage{
+		ID:           m.ID,
+		ExpeditionID: m.ExpeditionID,
+		CityID:       m.CityID,
+		CityCode:     m.CityCode,
+		CityName:     m.CityName,
+		DistrictID:   m.DistrictID,
+		DistrictCode: m.DistrictCode,
+		DistrictName: m.DistrictName,
+		CreatedAt:    m.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:    m.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+type ReqCreateExpeditionTariff struct {
+	OriginCityID      uuid.UUID `form:"origin_city_id" json:"origin_city_id" validate:"required"`
+	DestinationCityID uuid.UUID `form:"destination_city_id" json:"destination_city_id" validate:"required"`
+	PricePerKg        float64   `form:"price_per_kg" json:"price_per_kg" validate:"gt=0"`
+	MinWeight         float64   `form:"min_weight" json:"min_weight" validate:"gte=0"`
+	LeadTimeDays      int       `form:"lead_time_days" json:"lead_time_days" validate:"gte=0"`
+}
+
+type ReqUpdateExpeditionTariff struct {
+	OriginCityID      uuid.UUID `form:"origin_city_id" json:"origin_city_id" validate:"required"`
+	DestinationCityID uuid.UUID `form:"destination_city_id" json:"destination_city_id" validate:"required"`
+	PricePerKg        float64   `form:"price_per_kg" json:"price_per_kg" validate:"gt=0"`
+	MinWeight         float64   `form:"min_weight" json:"min_weight" validate:"gte=0"`
+	LeadTimeDays      int       `form:"lead_time_days" json:"lead_time_days" validate:"gte=0"`
+}
+
+type RespExpeditionTariff struct {
+	ID                  uuid.UUID `json:"id"`
+	ExpeditionID        uuid.UUID `json:"expedition_id"`
+	OriginCityID        uuid.UUID `json:"origin_city_id"`
+	OriginCityCode      *string   `json:"origin_city_code"`
+	OriginCityName      *string   `json:"origin_city_name"`
+	DestinationCityID   uuid.UUID `json:"destination_city_id"`
+	DestinationCityCode *string   `json:"destination_city_code"`
+	DestinationCityName *string   `json:"destination_city_name"`
+	PricePerKg          float64   `json:"price_per_kg"`
+	MinWeight           float64   `json:"min_weight"`
+	LeadTimeDays        int       `json:"lead_time_days"`
+	CreatedAt           string    `json:"created_at"`
+	UpdatedAt           string    `json:"updated_at"`
+}
+
+func ToRespExpeditionTariff(m models.ExpeditionTariff) RespExpeditionTariff {
+	return RespExpeditionTariff{
+		ID:                  m.ID,
+		ExpeditionID:        m.ExpeditionID,
+		OriginCityID:        m.OriginCityID,
+		OriginCityCode:      m.OriginCityCode,
+		OriginCityName:      m.OriginCityName,
+		DestinationCityID:   m.DestinationCityID,
+		DestinationCityCode: m.DestinationCityCode,
+		DestinationCityName: m.DestinationCityName,
+		PricePerKg:          m.PricePerKg,
+		MinWeight:           m.MinWeight,
+		LeadTimeDays:        m.LeadTimeDays,
+		CreatedAt:           m.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:           m.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// ReqExpeditionQuote asks for the expeditions able to ship a parcel between two cities
+type ReqExpeditionQuote struct {
+	OriginCityID          string  `query:"origin_city_id" json:"origin_city_id" validate:"required,uuid"`
+	DestinationCityID     string  `query:"destination_city_id" json:"destination_city_id" validate:"required,uuid"`
+	DestinationDistrictID string  `query:"destination_district_id" json:"destination_district_id" validate:"omitempty,uuid"` // Only expeditions serving this district
+	Weight                float64 `query:"weight" json:"weight" validate:"gt=0"`                                             // Parcel weight in kg
+}
+
+// ExpeditionQuoteCandidate is the scan target of an expedition tariff matching a quote route.
+// Price and minimum weight are read as the decimal text of their NUMERIC columns so the cost is computed exactly.
+type ExpeditionQuoteCandidate struct {
+	ExpeditionID   uuid.UUID `gorm:"column:expedition_id"`
+	ExpeditionCode string    `gorm:"column:expedition_code"`
+	ExpeditionName string    `gorm:"column:expedition_name"`
+	TariffID       uuid.UUID `gorm:"column:tariff_id"`
+	PricePerKg     string    `gorm:"column:price_per_kg"`
+	MinWeight      string    `gorm:"column:min_weight"`
+	LeadTimeDays   int       `gorm:"column:lead_time_days"`
+}
+
+type RespExpeditionQuote struct {
+	ExpeditionID     uuid.UUID `json:"expedition_id"`
+	ExpeditionCode   string    `json:"expedition_code"`
+	ExpeditionName   string    `json:"expedition_name"`
+	TariffID         uuid.UUID `json:"tariff_id"`
+	PricePerKg       float64   `json:"price_per_kg"`
+	MinWeight        float64   `json:"min_weight"`
+	ChargeableWeight float64   `json:"chargeable_weight"` // The greater of the parcel weight and the minimum weight
+	Cost             float64   `json:"cost"`
+	LeadTimeDays     int       `json:"lead_time_days"`
+	EstimatedArrival string    `json:"estimated_arrival"` // Date (YYYY-MM-DD) the parcel arrives when shipped today
+}
+
+// ExpeditionRegionCode is a city or district resolved from its official code
+type ExpeditionRegionCode struct {
+	ID     uuid.UUID  `gorm:"column:id"`
+	Code   string     `gorm:"column:code"`
+	CityID *uuid.UUID `gorm:"column:city_id"` // Only set for districts
+}
+
+type ResImportExpeditionServiceExcel struct {
+	Row          int    `json:"row"`                     // Nomor baris di Excel
+	Key          string `json:"key"`                     // Area or route of the row, e.g. "32.73" or "32.73 > 31.71"
+	Status       string `json:"status"`                  // Status row: "success" atau "failed"
+	ErrorMessage string `json:"error_message,omitempty"` // Message error jika status failed
+	Success      bool   `json:"-"`                       // Internal field, tidak ditampilkan di response
+}
+
+type ResImportExpeditionServices struct {
+	TotalRows    int                               `json:"total_rows"`
+	SuccessCount int                               `json:"success_count"`
+	FailedCount  int                               `json:"failed_count"`
+	Results      []ResImportExpeditionServiceExcel `json:"results"`
+}
@@ -11,6 +11,7 @@ import (
 
 // CreateExpeditionParams contains parameters for creating an expedition
 type CreateExpeditionParams struct {
+	ExpeditionCode string // Only set by imports, empty lets the database generate the code
 	ExpeditionName string
 	Address        string
 	TelpNumbers    []dto.TelpNumberItem
@@ -29,6 +30,59 @@ type UpdateExpeditionParams struct {
 	UpdatedBy      string
 }
 
+// CreateExpeditionContactParams contains parameters for adding a contact to an expedition
+type CreateExpeditionContactParams struct {
+	ExpeditionID     uuid.UUID
+	PhoneType        string
+	AreaCode         *string
+	PhoneNumber      string
+	NormalizedNumber string
+	ContactName      *string
+	Email            *string
+	IsPrimary        bool // The first contact of a phone type always becomes primary
+	CreatedBy        string
+}
+
+// UpdateExpeditionContactParams contains parameters for updating an expedition contact
+type UpdateExpeditionContactParams struct {
+	AreaCode         *string
+	PhoneNumber      string
+	NormalizedNumber string
+	ContactName      *string
+	Email            *string
+	UpdatedBy        string
+}
+
+// CreateExpeditionCoverageParams contains parameters for creating an expedition coverage
+type CreateExpeditionCoverageParams struct {
+	ExpeditionID uuid.UUID
+	CityID       uuid.UUID
+	DistrictID   *uuid.UUID
+	CreatedBy    string
+}
+
+// CreateExpeditionTariffParams contains parameters for creating an expedition tariff,
+// imports also use it to update the tariff of an existing route
+type CreateExpeditionTariffParams struct {
+	ExpeditionID      uuid.UUID
+	OriginCityID      uuid.UUID
+	DestinationCityID uuid.UUID
+	PricePerKg        float64
+	MinWeight         float64
+	LeadTimeDays      int
+	CreatedBy         string
+}
+
+// UpdateExpeditionTariffParams contains parameters for updating an expedition tariff
+type UpdateExpeditionTariffParams struct {
+	OriginCityID      uuid.UUID
+	DestinationCityID uuid.UUID
+	PricePerKg        float64
+	MinWeight         float64
+	LeadTimeDays      int
+	UpdatedBy         string
+}
+
 type Repository interface {
 	Create(ctx context.Context, params CreateExpeditionParams) (*models.Expedition, error)
 	Update(ctx context.Context, id uuid.UUID, params UpdateExpeditionParams) (*models.Expedition, error)
@@ -39,4 +93,39 @@ type Repository interface {
 	GetAll(ctx context.Context, filter dto.ReqExpeditionIndexFilter) ([]models.Expedition, error)
 	GetAllForExport(ctx context.Context, filter dto.ReqExpeditionIndexFilter) ([]dto.ExpeditionExport, error)
 	ExistsByExpeditionName(ctx context.Context, expeditionName string, excludeID uuid.UUID) (bool, error)
+	ExistsByExpeditionCode(ctx context.Context, expeditionCode string) (bool, error)
+	BulkCreate(ctx context.Context, params []CreateExpeditionParams) error
+	ExistsInSuppliersOrCustomers(ctx context.Context, expeditionID uuid.UUID) (bool, error)
+
+	// Contact methods
+	CreateContact(ctx context.Context, params CreateExpeditionContactParams) (*models.ExpeditionContact, error)
+	UpdateContact(ctx context.Context, expeditionID uuid.UUID, contactID uuid.UUID, params UpdateExpeditionContactParams) (*models.ExpeditionContact, error)
+	DeleteContact(ctx context.Context, expeditionID uuid.UUID, contactID uuid.UUID, deletedBy string) error
+	SetPrimaryContact(ctx context.Context, expeditionID uuid.UUID, contactID uuid.UUID, updatedBy string) (*models.ExpeditionContact, error)
+	GetContactByID(ctx context.Context, expeditionID uuid.UUID, contactID uuid.UUID) (*models.ExpeditionContact, error)
+	ExistsContactNumber(ctx context.Context, expeditionID uuid.UUID, normalizedNumber string, excludeID uuid.UUID) (bool, error)
+
+	// Coverage methods
+	CreateCoverage(ctx context.Context, params CreateExpeditionCoverageParams) (*models.ExpeditionCoverage, error)
+	BulkCreateCoverages(ctx context.Context, params []CreateExpeditionCoverageParams) error
+	DeleteCoverage(ctx context.Context, expeditionID uuid.UUID, coverageID uuid.UUID, deletedBy string) error
+	GetCoverageByID(ctx context.Context, expeditionID uuid.UUID, coverageID uuid.UUID) (*models.ExpeditionCoverage, error)
+	GetCoveragesByExpeditionID(ctx context.Context, expeditionID uuid.UUID) ([]models.ExpeditionCoverage, error)
+	ExistsCoverage(ctx context.Context, expeditionID uuid.UUID, cityID uuid.UUID, districtID *uuid.UUID) (bool, error)
+
+	// Tariff methods
+	CreateTariff(ctx context.Context, params CreateExpeditionTariffParams) (*models.ExpeditionTariff, error)
+	UpdateTariff(ctx context.Context, expeditionID uuid.UUID, tariffID uuid.UUID, params UpdateExpeditionTariffParams) (*models.ExpeditionTariff, error)
+	UpsertTariffs(ctx context.Context, params []CreateExpeditionTariffParams) error
+	DeleteTariff(ctx context.Context, expeditionID uuid.UUID, tariffID uuid.UUID, deletedBy string) error
+	GetTariffByID(ctx context.Context, expeditionID uuid.UUID, tariffID uuid.UUID) (*models.ExpeditionTariff, error)
+	GetTariffsByExpeditionID(ctx context.Context, expeditionID uuid.UUID) ([]models.ExpeditionTariff, error)
+	ExistsTariffRoute(ctx context.Context, expeditionID uuid.UUID, originCityID uuid.UUID, destinationCityID uuid.UUID, excludeID uuid.UUID) (bool, error)
+	GetQuoteCandidates(ctx context.Context, originCityID uuid.UUID, destinationCityID uuid.UUID, destinationDistrictID *uuid.UUID) ([]dto.ExpeditionQuoteCandidate, error)
+
+	// Region methods
+	ExistsCity(ctx context.Context, cityID uuid.UUID) (bool, error)
+	ExistsDistrictInCity(ctx context.Context, cityID uuid.UUID, districtID uuid.UUID) (bool, error)
+	GetCitiesByCodes(ctx context.Context, codes []string) ([]dto.ExpeditionRegionCode, error)
+	GetDistrictsByCodes(ctx context.Context, codes []string) ([]dto.ExpeditionRegionCode, error)
 }
@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/expedition"
+	"gorm.io/gorm"
+)
+
+// unsetPrimaryContacts clears the primary flag of the other active contacts with the same phone type
+func unsetPrimaryContacts(tx *gorm.DB, expeditionID uuid.UUID, phoneType string, exceptID uuid.UUID, updatedBy string) error {
+	return tx.Model(&models.ExpeditionContact{}).
+		Where("expedition_id = ? AND phone_type = ? AND id <> ? AND is_primary = true AND deleted_at IS NULL", expeditionID, phoneType, exceptID).
+		Updates(map[string]interface{}{
+			"is_primary": false,
+			"updated_at": time.Now().UTC(),
+			"updated_by": updatedBy,
+		}).Error
+}
+
+func (r *expeditionRepository) CreateContact(ctx context.Context, params expedition.CreateExpeditionContactParams) (*models.ExpeditionContact, error) {
+	now := time.Now().UTC()
+	contact := &models.ExpeditionContact{
+		ExpeditionID:     params.ExpeditionID,
+		PhoneType:        params.PhoneType,
+		AreaCode:         params.AreaCode,
+		PhoneNumber:      params.PhoneNumber,
+		NormalizedNumber: params.NormalizedNumber,
+		ContactName:      params.ContactName,
+		Email:            params.Email,
+		IsPrimary:        params.IsPrimary,
+		CreatedAt:        now,
+		CreatedBy:        params.CreatedBy,
+		UpdatedAt:        now,
+		UpdatedBy:        params.CreatedBy,
+	}
+
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if !contact.IsPrimary {
+			// The first contact of a phone type becomes primary
+			var count int64
+			if err := tx.Model(&models.ExpeditionContact{}).
+				Where("expedition_id = ? AND phone_type = ? AND is_primary = true AND deleted_at IS NULL", params.ExpeditionID, params.PhoneType).
+				Count(&count).Error; err != nil {
+				return err
+			}
+			contact.IsPrimary = count == 0
+		} else if err := unsetPrimaryContacts(tx, params.ExpeditionID, params.PhoneType, uuid.Nil, params.CreatedBy); err != nil {
+			return err
+		}
+		return tx.Create(contact).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return contact, nil
+}
+
+func (r *expeditionRepository) UpdateContact(ctx context.Context, expeditionID uuid.UUID, contactID uuid.UUID, params expedition.UpdateExpeditionContactParams) (*models.ExpeditionContact, error) {
+	contact := &models.ExpeditionContact{}
+	err := r.DB.WithContext(ctx).Model(&models.ExpeditionContact{}).
+		Where("id = ? AND expedition_id = ? AND deleted_at IS NULL", contactID, expeditionID).
+		Updates(map[string]interface{}{
+			"area_code":         params.AreaCode,
+			"phone_number":      params.PhoneNumber,
+			"normalized_number": params.NormalizedNumber,
+			"contact_name":      params.ContactName,
+			"email":             params.Email,
+			"updated_at":        time.Now().UTC(),
+			"updated_by":        params.UpdatedBy,
+		}).
+		Take(contact).Error
+	if err != nil {
+		return nil, err
+	}
+	return contact, nil
+}
+
+// DeleteContact soft deletes a contact, the oldest remaining contact of the same phone type takes over a removed primary
+func (r *expeditionRepository) DeleteContact(ctx context.Context, expeditionID uuid.UUID, contactID uuid.UUID, deletedBy string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		contact := &models.ExpeditionContact{}
+		if err := tx.Where("id = ? AND expedition_id = ? AND deleted_at IS NULL", contactID, expeditionID).
+			Take(contact).Error; err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		if err := tx.Model(&models.ExpeditionContact{}).
+			Where("id = ?", contactID).
+			Updates(map[string]interface{}{
+				"is_primary": false,
+				"deleted_at": now,
+				"deleted_by": deletedBy,
+			}).Error; err != nil {
+			return err
+		}
+
+		if !contact.IsPrimary {
+			return nil
+		}
+
+		next := &models.ExpeditionContact{}
+		err := tx.Where("expedition_id = ? AND phone_type = ? AND deleted_at IS NULL", expeditionID, contact.PhoneType).
+			Order("created_at ASC").
+			Take(next).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+		return tx.Model(&models.ExpeditionContact{}).
+			Where("id = ?", next.ID).
+			Updates(map[string]interface{}{
+				"is_primary": true,
+				"updated_at": now,
+				"updated_by": deletedBy,
+			}).Error
+	})
+}
+
+func (r *expeditionRepository) SetPrimaryContact(ctx context.Context, expeditionID uuid.UUID, contactID uuid.UUID, updatedBy string) (*models.ExpeditionContact, error) {
+	contact := &models.ExpeditionContact{}
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id = ? AND expedition_id = ? AND deleted_at IS NULL", contactID, expeditionID).
+			Take(contact).Error; err != nil {
+			return err
+		}
+		if contact.IsPrimary {
+			return nil
+		}
+
+		if err := unsetPrimaryContacts(tx, expeditionID, contact.PhoneType, contactID, updatedBy); err != nil {
+			return err
+		}
+		if err := tx.Model(&models.ExpeditionContact{}).
+			Where("id = ?", contactID).
+			Updates(map[string]interface{}{
+				"is_primary": true,
+				"updated_at": time.Now().UTC(),
+				"updated_by": updatedBy,
+			}).Error; err != nil {
+			return err
+		}
+		contact.IsPrimary = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return contact, nil
+}
+
+func (r *expeditionRepository) GetContactByID(ctx context.Context, expeditionID uuid.UUID, contactID uuid.UUID) (*models.ExpeditionContact, error) {
+	contact := &models.ExpeditionContact{}
+	err := r.DB.WithContext(ctx).
+		Where("id = ? AND expedition_id = ? AND deleted_at IS NULL", contactID, expeditionID).
+		Take(contact).Error
+	if err != nil {
+		return nil, err
+	}
+	return contact, nil
+}
+
+func (r *expeditionRepository) ExistsContactNumber(ctx context.Context, expeditionID uuid.UUID, normalizedNumber string, excludeID uuid.UUID) (bool, error) {
+	var count int64
+	q := r.DB.WithContext(ctx).Model(&models.ExpeditionContact{}).
+		Where("expedition_id = ? AND normalized_number = ? AND deleted_at IS NULL", expeditionID, normalizedNumber)
+	if excludeID != uuid.Nil {
+		q = q.Where("id <> ?", excludeID)
+	}
+	if err := q.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
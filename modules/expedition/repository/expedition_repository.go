@@ -8,11 +8,13 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/codegen"
 	"github.com/rendyfutsuy/base-go/helpers/request"
 	"github.com/rendyfutsuy/base-go/models"
 	"github.com/rendyfutsuy/base-go/modules/expedition"
 	"github.com/rendyfutsuy/base-go/modules/expedition/dto"
 	rsearchexpedition "github.com/rendyfutsuy/base-go/modules/expedition/repository/searches"
+	"github.com/rendyfutsuy/base-go/utils"
 	"gorm.io/gorm"
 )
 
@@ -46,8 +48,14 @@ func (r *expeditionRepository) Create(ctx context.Context, params expedition.Cre
 		}
 	}()
 
-	// Omit expedition_code to let database generate it using DEFAULT generate_expedition_code()
-	if err := tx.Omit("expedition_code").Create(exp).Error; err != nil {
+	// expedition_code is allocated from the expedition code policy in the same transaction
+	code, err := codegen.Allocate(tx, constants.CodeEntityExpedition, nil)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	exp.ExpeditionCode = code
+	if err := tx.Create(exp).Error; err != nil {
 		tx.Rollback()
 		return nil, err
 	}
@@ -58,39 +66,10 @@ func (r *expeditionRepository) Create(ctx context.Context, params expedition.Cre
 	}
 
 	// Create contacts
-	contacts := make([]models.ExpeditionContact, 0)
-
-	// Process TelpNumbers: first telp (index 0) becomes primary
-	for i, telp := range params.TelpNumbers {
-		if telp.PhoneNumber != "" {
-			contacts = append(contacts, models.ExpeditionContact{
-				ExpeditionID: exp.ID,
-				PhoneType:    constants.ExpeditionContactTypeTelp,
-				PhoneNumber:  telp.PhoneNumber,
-				AreaCode:     telp.AreaCode,
-				IsPrimary:    i == 0, // First telp is always primary
-				CreatedAt:    now,
-				CreatedBy:    params.CreatedBy,
-				UpdatedAt:    now,
-				UpdatedBy:    params.CreatedBy,
-			})
-		}
-	}
-
-	// Process PhoneNumbers: first hp (index 0) becomes primary
-	for i, phoneNumber := range params.PhoneNumbers {
-		if phoneNumber != "" {
-			contacts = append(contacts, models.ExpeditionContact{
-				ExpeditionID: exp.ID,
-				PhoneType:    constants.ExpeditionContactTypePhone,
-				PhoneNumber:  phoneNumber,
-				IsPrimary:    i == 0, // First hp is always primary
-				CreatedAt:    now,
-				CreatedBy:    params.CreatedBy,
-				UpdatedAt:    now,
-				UpdatedBy:    params.CreatedBy,
-			})
-		}
+	contacts, err := buildExpeditionContacts(exp.ID, params.TelpNumbers, params.PhoneNumbers, params.CreatedBy, now)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
 	}
 
 	if len(contacts) > 0 {
@@ -108,6 +87,69 @@ func (r *expeditionRepository) Create(ctx context.Context, params expedition.Cre
 	return exp, nil
 }
 
+// BulkCreate creates the imported expeditions and their contacts in a single transaction.
+// Expeditions without code get one from the expedition code policy, explicit codes are kept
+// and skipped by the allocations: rows with an explicit code are inserted first so that
+// a generated code never takes a code listed further down the file.
+func (r *expeditionRepository) BulkCreate(ctx context.Context, params []expedition.CreateExpeditionParams) error {
+	if len(params) == 0 {
+		return nil
+	}
+
+	ordered := make([]expedition.CreateExpeditionParams, 0, len(params))
+	for _, p := range params {
+		if p.ExpeditionCode != "" {
+			ordered = append(ordered, p)
+		}
+	}
+	for _, p := range params {
+		if p.ExpeditionCode == "" {
+			ordered = append(ordered, p)
+		}
+	}
+
+	now := time.Now().UTC()
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, p := range ordered {
+			exp := &models.Expedition{
+				ExpeditionCode: p.ExpeditionCode,
+				ExpeditionName: p.ExpeditionName,
+				Address:        p.Address,
+				Notes:          p.Notes,
+				CreatedAt:      now,
+				CreatedBy:      p.CreatedBy,
+				UpdatedAt:      now,
+				UpdatedBy:      p.CreatedBy,
+			}
+
+			if p.ExpeditionCode == "" {
+				code, err := codegen.Allocate(tx, constants.CodeEntityExpedition, nil)
+				if err != nil {
+					return err
+				}
+				exp.ExpeditionCode = code
+			}
+			if err := tx.Create(exp).Error; err != nil {
+				return err
+			}
+			if exp.ID == uuid.Nil {
+				return errors.New(constants.ExpeditionCreateFailedIDNotSet)
+			}
+
+			contacts, err := buildExpeditionContacts(exp.ID, p.TelpNumbers, p.PhoneNumbers, p.CreatedBy, now)
+			if err != nil {
+				return err
+			}
+			if len(contacts) > 0 {
+				if err := tx.Create(&contacts).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
 func (r *expeditionRepository) Update(ctx context.Context, id uuid.UUID, params expedition.UpdateExpeditionParams) (*models.Expedition, error) {
 	updates := map[string]interface{}{
 		"expedition_name": params.ExpeditionName,
@@ -142,6 +184,17 @@ func (r *expeditionRepository) Update(ctx context.Context, id uuid.UUID, params
 		return nil, err
 	}
 
+	// Keep the contact person details of numbers that stay in the payload
+	var existingContacts []models.ExpeditionContact
+	if err := tx.Where("expedition_id = ? AND deleted_at IS NULL", id).Find(&existingContacts).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	existingByNumber := make(map[string]models.ExpeditionContact, len(existingContacts))
+	for _, contact := range existingContacts {
+		existingByNumber[contact.PhoneType+contact.NormalizedNumber] = contact
+	}
+
 	// Update contacts: Always hard delete existing contacts before creating new ones
 	now := time.Now().UTC()
 	if err := tx.Unscoped().Where("expedition_id = ?", id).
@@ -150,38 +203,16 @@ func (r *expeditionRepository) Update(ctx context.Context, id uuid.UUID, params
 		return nil, err
 	}
 
-	contacts := make([]models.ExpeditionContact, 0)
-
-	// Process TelpNumbers: first telp (index 0) becomes primary
-	for i, telp := range params.TelpNumbers {
-		if telp.PhoneNumber != "" {
-			contacts = append(contacts, models.ExpeditionContact{
-				ExpeditionID: exp.ID,
-				PhoneType:    constants.ExpeditionContactTypeTelp,
-				PhoneNumber:  telp.PhoneNumber,
-				AreaCode:     telp.AreaCode,
-				IsPrimary:    i == 0, // First telp is always primary
-				CreatedAt:    now,
-				CreatedBy:    params.UpdatedBy,
-				UpdatedAt:    now,
-				UpdatedBy:    params.UpdatedBy,
-			})
-		}
+	contacts, err := buildExpeditionContacts(exp.ID, params.TelpNumbers, params.PhoneNumbers, params.UpdatedBy, now)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
 	}
 
-	// Process PhoneNumbers: first hp (index 0) becomes primary
-	for i, phoneNumber := range params.PhoneNumbers {
-		if phoneNumber != "" {
-			contacts = append(contacts, models.ExpeditionContact{
-				ExpeditionID: exp.ID,
-				PhoneType:    constants.ExpeditionContactTypePhone,
-				PhoneNumber:  phoneNumber,
-				IsPrimary:    i == 0, // First hp is always primary
-				CreatedAt:    now,
-				CreatedBy:    params.UpdatedBy,
-				UpdatedAt:    now,
-				UpdatedBy:    params.UpdatedBy,
-			})
+	for i, contact := range contacts {
+		if existing, ok := existingByNumber[contact.PhoneType+contact.NormalizedNumber]; ok {
+			contacts[i].ContactName = existing.ContactName
+			contacts[i].Email = existing.Email
 		}
 	}
 
@@ -248,6 +279,35 @@ func (r *expeditionRepository) ExistsByExpeditionName(ctx context.Context, exped
 	return count > 0, nil
 }
 
+// ExistsByExpeditionCode checks the code against every expedition, including soft deleted ones since codes stay unique
+func (r *expeditionRepository) ExistsByExpeditionCode(ctx context.Context, expeditionCode string) (bool, error) {
+	var count int64
+	if err := r.DB.WithContext(ctx).Unscoped().Model(&models.Expedition{}).
+		Where("expedition_code = ?", expeditionCode).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// ExistsInSuppliersOrCustomers checks whether an active supplier or customer still references the expedition
+func (r *expeditionRepository) ExistsInSuppliersOrCustomers(ctx context.Context, expeditionID uuid.UUID) (bool, error) {
+	var exists bool
+	err := r.DB.WithContext(ctx).Raw(`
+		SELECT EXISTS (
+			SELECT 1 FROM suppliers s
+			WHERE s.expedition_arrives_id = ? AND s.deleted_at IS NULL
+		) OR EXISTS (
+			SELECT 1 FROM customers c
+			WHERE c.expedition_send_id = ? AND c.deleted_at IS NULL
+		)
+	`, expeditionID, expeditionID).Scan(&exists).Error
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
 func (r *expeditionRepository) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqExpeditionIndexFilter) ([]models.Expedition, int, error) {
 	var expeditions []models.Expedition
 	query := r.DB.WithContext(ctx).Table("expeditions e").
@@ -444,6 +504,55 @@ func (r *expeditionRepository) GetContactsByExpeditionID(ctx context.Context, ex
 	return contacts, err
 }
 
+// buildExpeditionContacts maps the telp and hp numbers of a create/update payload to contacts,
+// the first number of each phone type becomes primary
+func buildExpeditionContacts(expeditionID uuid.UUID, telpNumbers []dto.TelpNumberItem, phoneNumbers []string, by string, now time.Time) ([]models.ExpeditionContact, error) {
+	contacts := make([]models.ExpeditionContact, 0, len(telpNumbers)+len(phoneNumbers))
+
+	for i, telp := range telpNumbers {
+		if telp.PhoneNumber != "" {
+			normalized, err := utils.NormalizeIndonesianPhoneNumber(telp.AreaCode, telp.PhoneNumber)
+			if err != nil {
+				return nil, err
+			}
+			contacts = append(contacts, models.ExpeditionContact{
+				ExpeditionID:     expeditionID,
+				PhoneType:        constants.ExpeditionContactTypeTelp,
+				PhoneNumber:      telp.PhoneNumber,
+				AreaCode:         telp.AreaCode,
+				NormalizedNumber: normalized,
+				IsPrimary:        i == 0, // First telp is always primary
+				CreatedAt:        now,
+				CreatedBy:        by,
+				UpdatedAt:        now,
+				UpdatedBy:        by,
+			})
+		}
+	}
+
+	for i, phoneNumber := range phoneNumbers {
+		if phoneNumber != "" {
+			normalized, err := utils.NormalizeIndonesianPhoneNumber(nil, phoneNumber)
+			if err != nil {
+				return nil, err
+			}
+			contacts = append(contacts, models.ExpeditionContact{
+				ExpeditionID:     expeditionID,
+				PhoneType:        constants.ExpeditionContactTypePhone,
+				PhoneNumber:      phoneNumber,
+				NormalizedNumber: normalized,
+				IsPrimary:        i == 0, // First hp is always primary
+				CreatedAt:        now,
+				CreatedBy:        by,
+				UpdatedAt:        now,
+				UpdatedBy:        by,
+			})
+		}
+	}
+
+	return contacts, nil
+}
+
 // Implement expedition.Repository interface
 var _ expedition.Repository = (*expeditionRepository)(nil)
 
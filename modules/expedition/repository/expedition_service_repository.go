@@ -0,0 +1,348 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/expedition"
+	"github.com/rendyfutsuy/base-go/modules/expedition/dto"
+	"gorm.io/gorm"
+)
+
+// coverageQuery selects active coverages together with their city and district codes and names
+func (r *expeditionRepository) coverageQuery(ctx context.Context) *gorm.DB {
+	return r.DB.WithContext(ctx).Table("expedition_coverages ec").
+		Select(`
+			ec.*,
+			c.code as city_code,
+			c.name as city_name,
+			d.code as district_code,
+			d.name as district_name
+		`).
+		Joins("JOIN cities c ON c.id = ec.city_id").
+		Joins("LEFT JOIN districts d ON d.id = ec.district_id").
+		Where("ec.deleted_at IS NULL")
+}
+
+// tariffQuery selects active tariffs together with their origin and destination city codes and names
+func (r *expeditionRepository) tariffQuery(ctx context.Context) *gorm.DB {
+	return r.DB.WithContext(ctx).Table("expedition_tariffs et").
+		Select(`
+			et.*,
+			oc.code as origin_city_code,
+			oc.name as origin_city_name,
+			dc.code as destination_city_code,
+			dc.name as destination_city_name
+		`).
+		Joins("JOIN cities oc ON oc.id = et.origin_city_id").
+		Joins("JOIN cities dc ON dc.id = et.destination_city_id").
+		Where("et.deleted_at IS NULL")
+}
+
+func (r *expeditionRepository) CreateCoverage(ctx context.Context, params expedition.CreateExpeditionCoverageParams) (*models.ExpeditionCoverage, error) {
+	now := time.Now().UTC()
+	coverage := &models.ExpeditionCoverage{
+		ExpeditionID: params.ExpeditionID,
+		CityID:       params.CityID,
+		DistrictID:   params.DistrictID,
+		CreatedAt:    now,
+		CreatedBy:    params.CreatedBy,
+		UpdatedAt:    now,
+		UpdatedBy:    params.CreatedBy,
+	}
+	if err := r.DB.WithContext(ctx).Create(coverage).Error; err != nil {
+		return nil, err
+	}
+	return coverage, nil
+}
+
+func (r *expeditionRepository) BulkCreateCoverages(ctx context.Context, params []expedition.CreateExpeditionCoverageParams) error {
+	if len(params) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	coverages := make([]models.ExpeditionCoverage, 0, len(params))
+	for _, p := range params {
+		coverages = append(coverages, models.ExpeditionCoverage{
+			ExpeditionID: p.ExpeditionID,
+			CityID:       p.CityID,
+			DistrictID:   p.DistrictID,
+			CreatedAt:    now,
+			CreatedBy:    p.CreatedBy,
+			UpdatedAt:    now,
+			UpdatedBy:    p.CreatedBy,
+		})
+	}
+	return r.DB.WithContext(ctx).Create(&coverages).Error
+}
+
+func (r *expeditionRepository) DeleteCoverage(ctx context.Context, expeditionID uuid.UUID, coverageID uuid.UUID, deletedBy string) error {
+	res := r.DB.WithContext(ctx).Model(&models.ExpeditionCoverage{}).
+		Where("id = ? AND expedition_id = ? AND deleted_at IS NULL", coverageID, expeditionID).
+		Updates(map[string]interface{}{
+			"deleted_at": time.Now().UTC(),
+			"deleted_by": deletedBy,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *expeditionRepository) GetCoverageByID(ctx context.Context, expeditionID uuid.UUID, coverageID uuid.UUID) (*models.ExpeditionCoverage, error) {
+	coverage := &models.ExpeditionCoverage{}
+	err := r.coverageQuery(ctx).
+		Where("ec.id = ? AND ec.expedition_id = ?", coverageID, expeditionID).
+		Scan(coverage).Error
+	if err != nil {
+		return nil, err
+	}
+	// Scan() doesn't return error for record not found, so check if ID is nil
+	if coverage.ID == uuid.Nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return coverage, nil
+}
+
+func (r *expeditionRepository) GetCoveragesByExpeditionID(ctx context.Context, expeditionID uuid.UUID) ([]models.ExpeditionCoverage, error) {
+	var coverages []models.ExpeditionCoverage
+	err := r.coverageQuery(ctx).
+		Where("ec.expedition_id = ?", expeditionID).
+		Order("c.name ASC, d.name ASC NULLS FIRST").
+		Scan(&coverages).Error
+	return coverages, err
+}
+
+func (r *expeditionRepository) ExistsCoverage(ctx context.Context, expeditionID uuid.UUID, cityID uuid.UUID, districtID *uuid.UUID) (bool, error) {
+	var count int64
+	q := r.DB.WithContext(ctx).Model(&models.ExpeditionCoverage{}).
+		Where("expedition_id = ? AND city_id = ? AND deleted_at IS NULL", expeditionID, cityID)
+	if districtID != nil {
+		q = q.Where("district_id = ?", *districtID)
+	} else {
+		q = q.Where("district_id IS NULL")
+	}
+	if err := q.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *expeditionRepository) CreateTariff(ctx context.Context, params expedition.CreateExpeditionTariffParams) (*models.ExpeditionTariff, error) {
+	now := time.Now().UTC()
+	tariff := &models.ExpeditionTariff{
+		ExpeditionID:      params.ExpeditionID,
+		OriginCityID:      params.OriginCityID,
+		DestinationCityID: params.DestinationCityID,
+		PricePerKg:        params.PricePerKg,
+		MinWeight:         params.MinWeight,
+		LeadTimeDays:      params.LeadTimeDays,
+		CreatedAt:         now,
+		CreatedBy:         params.CreatedBy,
+		UpdatedAt:         now,
+		UpdatedBy:         params.CreatedBy,
+	}
+	if err := r.DB.WithContext(ctx).Create(tariff).Error; err != nil {
+		return nil, err
+	}
+	return tariff, nil
+}
+
+func (r *expeditionRepository) UpdateTariff(ctx context.Context, expeditionID uuid.UUID, tariffID uuid.UUID, params expedition.UpdateExpeditionTariffParams) (*models.ExpeditionTariff, error) {
+	res := r.DB.WithContext(ctx).Model(&models.ExpeditionTariff{}).
+		Where("id = ? AND expedition_id = ? AND deleted_at IS NULL", tariffID, expeditionID).
+		Updates(map[string]interface{}{
+			"origin_city_id":      params.OriginCityID,
+			"destination_city_id": params.DestinationCityID,
+			"price_per_kg":        params.PricePerKg,
+			"min_weight":          params.MinWeight,
+			"lead_time_days":      params.LeadTimeDays,
+			"updated_at":          time.Now().UTC(),
+			"updated_by":          params.UpdatedBy,
+		})
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return r.GetTariffByID(ctx, expeditionID, tariffID)
+}
+
+// UpsertTariffs updates the tariff of every existing route and creates the missing ones in a single transaction
+func (r *expeditionRepository) UpsertTariffs(ctx context.Context, params []expedition.CreateExpeditionTariffParams) error {
+	if len(params) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, p := range params {
+			res := tx.Model(&models.ExpeditionTariff{}).
+				Where("expedition_id = ? AND origin_city_id = ? AND destination_city_id = ? AND deleted_at IS NULL", p.ExpeditionID, p.OriginCityID, p.DestinationCityID).
+				Updates(map[string]interface{}{
+					"price_per_kg":   p.PricePerKg,
+					"min_weight":     p.MinWeight,
+					"lead_time_days": p.LeadTimeDays,
+					"updated_at":     now,
+					"updated_by":     p.CreatedBy,
+				})
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected > 0 {
+				continue
+			}
+
+			if err := tx.Create(&models.ExpeditionTariff{
+				ExpeditionID:      p.ExpeditionID,
+				OriginCityID:      p.OriginCityID,
+				DestinationCityID: p.DestinationCityID,
+				PricePerKg:        p.PricePerKg,
+				MinWeight:         p.MinWeight,
+				LeadTimeDays:      p.LeadTimeDays,
+				CreatedAt:         now,
+				CreatedBy:         p.CreatedBy,
+				UpdatedAt:         now,
+				UpdatedBy:         p.CreatedBy,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *expeditionRepository) DeleteTariff(ctx context.Context, expeditionID uuid.UUID, tariffID uuid.UUID, deletedBy string) error {
+	res := r.DB.WithContext(ctx).Model(&models.ExpeditionTariff{}).
+		Where("id = ? AND expedition_id = ? AND deleted_at IS NULL", tariffID, expeditionID).
+		Updates(map[string]interface{}{
+			"deleted_at": time.Now().UTC(),
+			"deleted_by": deletedBy,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *expeditionRepository) GetTariffByID(ctx context.Context, expeditionID uuid.UUID, tariffID uuid.UUID) (*models.ExpeditionTariff, error) {
+	tariff := &models.ExpeditionTariff{}
+	err := r.tariffQuery(ctx).
+		Where("et.id = ? AND et.expedition_id = ?", tariffID, expeditionID).
+		Scan(tariff).Error
+	if err != nil {
+		return nil, err
+	}
+	// Scan() doesn't return error for record not found, so check if ID is nil
+	if tariff.ID == uuid.Nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return tariff, nil
+}
+
+func (r *expeditionRepository) GetTariffsByExpeditionID(ctx context.Context, expeditionID uuid.UUID) ([]models.ExpeditionTariff, error) {
+	var tariffs []models.ExpeditionTariff
+	err := r.tariffQuery(ctx).
+		Where("et.expedition_id = ?", expeditionID).
+		Order("oc.name ASC, dc.name ASC").
+		Scan(&tariffs).Error
+	return tariffs, err
+}
+
+func (r *expeditionRepository) ExistsTariffRoute(ctx context.Context, expeditionID uuid.UUID, originCityID uuid.UUID, destinationCityID uuid.UUID, excludeID uuid.UUID) (bool, error) {
+	var count int64
+	q := r.DB.WithContext(ctx).Model(&models.ExpeditionTariff{}).
+		Where("expedition_id = ? AND origin_city_id = ? AND destination_city_id = ? AND deleted_at IS NULL", expeditionID, originCityID, destinationCityID)
+	if excludeID != uuid.Nil {
+		q = q.Where("id <> ?", excludeID)
+	}
+	if err := q.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetQuoteCandidates returns the tariffs of active expeditions for the route. The expedition must cover the origin city
+// and the destination city, when a destination district is given it must be covered too (directly or by a whole city coverage).
+func (r *expeditionRepository) GetQuoteCandidates(ctx context.Context, originCityID uuid.UUID, destinationCityID uuid.UUID, destinationDistrictID *uuid.UUID) ([]dto.ExpeditionQuoteCandidate, error) {
+	destinationCoverage := r.DB.Table("expedition_coverages dcv").
+		Select("1").
+		Where("dcv.expedition_id = e.id AND dcv.city_id = ? AND dcv.deleted_at IS NULL", destinationCityID)
+	if destinationDistrictID != nil {
+		destinationCoverage = destinationCoverage.Where("(dcv.district_id IS NULL OR dcv.district_id = ?)", *destinationDistrictID)
+	}
+
+	var candidates []dto.ExpeditionQuoteCandidate
+	err := r.DB.WithContext(ctx).Table("expedition_tariffs et").
+		Select(`
+			e.id as expedition_id,
+			e.expedition_code,
+			e.expedition_name,
+			et.id as tariff_id,
+			et.price_per_kg::text as price_per_kg,
+			et.min_weight::text as min_weight,
+			et.lead_time_days
+		`).
+		Joins("JOIN expeditions e ON e.id = et.expedition_id AND e.deleted_at IS NULL").
+		Where("et.origin_city_id = ? AND et.destination_city_id = ? AND et.deleted_at IS NULL", originCityID, destinationCityID).
+		Where("EXISTS (SELECT 1 FROM expedition_coverages ocv WHERE ocv.expedition_id = e.id AND ocv.city_id = ? AND ocv.deleted_at IS NULL)", originCityID).
+		Where("EXISTS (?)", destinationCoverage).
+		Scan(&candidates).Error
+	return candidates, err
+}
+
+func (r *expeditionRepository) ExistsCity(ctx context.Context, cityID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.DB.WithContext(ctx).Table("cities").
+		Where("id = ? AND deleted_at IS NULL", cityID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *expeditionRepository) ExistsDistrictInCity(ctx context.Context, cityID uuid.UUID, districtID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.DB.WithContext(ctx).Table("districts").
+		Where("id = ? AND city_id = ? AND deleted_at IS NULL", districtID, cityID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *expeditionRepository) GetCitiesByCodes(ctx context.Context, codes []string) ([]dto.ExpeditionRegionCode, error) {
+	var cities []dto.ExpeditionRegionCode
+	if len(codes) == 0 {
+		return cities, nil
+	}
+	err := r.DB.WithContext(ctx).Table("cities").
+		Select("id, code").
+		Where("code IN ? AND deleted_at IS NULL", codes).
+		Scan(&cities).Error
+	return cities, err
+}
+
+func (r *expeditionRepository) GetDistrictsByCodes(ctx context.Context, codes []string) ([]dto.ExpeditionRegionCode, error) {
+	var districts []dto.ExpeditionRegionCode
+	if len(codes) == 0 {
+		return districts, nil
+	}
+	err := r.DB.WithContext(ctx).Table("districts").
+		Select("id, code, city_id").
+		Where("code IN ? AND deleted_at IS NULL", codes).
+		Scan(&districts).Error
+	return districts, err
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
 	"github.com/rendyfutsuy/base-go/helpers/request"
 	"github.com/rendyfutsuy/base-go/models"
 	expeditionMod "github.com/rendyfutsuy/base-go/modules/expedition"
@@ -87,6 +88,11 @@ func (m *MockExpeditionRepository) ExistsByExpeditionName(ctx context.Context, e
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockExpeditionRepository) ExistsInSuppliersOrCustomers(ctx context.Context, expeditionID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, expeditionID)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *MockExpeditionRepository) GetContactsByExpeditionID(ctx context.Context, expeditionID uuid.UUID) ([]models.ExpeditionContact, error) {
 	args := m.Called(ctx, expeditionID)
 	if args.Get(0) == nil {
@@ -95,6 +101,178 @@ func (m *MockExpeditionRepository) GetContactsByExpeditionID(ctx context.Context
 	return args.Get(0).([]models.ExpeditionContact), args.Error(1)
 }
 
+func (m *MockExpeditionRepository) ExistsByExpeditionCode(ctx context.Context, expeditionCode string) (bool, error) {
+	args := m.Called(ctx, expeditionCode)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) BulkCreate(ctx context.Context, params []expeditionMod.CreateExpeditionParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+
+func (m *MockExpeditionRepository) CreateContact(ctx context.Context, params expeditionMod.CreateExpeditionContactParams) (*models.ExpeditionContact, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ExpeditionContact), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) UpdateContact(ctx context.Context, expeditionID uuid.UUID, contactID uuid.UUID, params expeditionMod.UpdateExpeditionContactParams) (*models.ExpeditionContact, error) {
+	args := m.Called(ctx, expeditionID, contactID, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ExpeditionContact), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) DeleteContact(ctx context.Context, expeditionID uuid.UUID, contactID uuid.UUID, deletedBy string) error {
+	args := m.Called(ctx, expeditionID, contactID, deletedBy)
+	return args.Error(0)
+}
+
+func (m *MockExpeditionRepository) SetPrimaryContact(ctx context.Context, expeditionID uuid.UUID, contactID uuid.UUID, updatedBy string) (*models.ExpeditionContact, error) {
+	args := m.Called(ctx, expeditionID, contactID, updatedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ExpeditionContact), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) GetContactByID(ctx context.Context, expeditionID uuid.UUID, contactID uuid.UUID) (*models.ExpeditionContact, error) {
+	args := m.Called(ctx, expeditionID, contactID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ExpeditionContact), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) ExistsContactNumber(ctx context.Context, expeditionID uuid.UUID, normalizedNumber string, excludeID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, expeditionID, normalizedNumber, excludeID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) CreateCoverage(ctx context.Context, params expeditionMod.CreateExpeditionCoverageParams) (*models.ExpeditionCoverage, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ExpeditionCoverage), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) BulkCreateCoverages(ctx context.Context, params []expeditionMod.CreateExpeditionCoverageParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+
+func (m *MockExpeditionRepository) DeleteCoverage(ctx context.Context, expeditionID uuid.UUID, coverageID uuid.UUID, deletedBy string) error {
+	args := m.Called(ctx, expeditionID, coverageID, deletedBy)
+	return args.Error(0)
+}
+
+func (m *MockExpeditionRepository) GetCoverageByID(ctx context.Context, expeditionID uuid.UUID, coverageID uuid.UUID) (*models.ExpeditionCoverage, error) {
+	args := m.Called(ctx, expeditionID, coverageID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ExpeditionCoverage), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) GetCoveragesByExpeditionID(ctx context.Context, expeditionID uuid.UUID) ([]models.ExpeditionCoverage, error) {
+	args := m.Called(ctx, expeditionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ExpeditionCoverage), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) ExistsCoverage(ctx context.Context, expeditionID uuid.UUID, cityID uuid.UUID, districtID *uuid.UUID) (bool, error) {
+	args := m.Called(ctx, expeditionID, cityID, districtID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) CreateTariff(ctx context.Context, params expeditionMod.CreateExpeditionTariffParams) (*models.ExpeditionTariff, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ExpeditionTariff), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) UpdateTariff(ctx context.Context, expeditionID uuid.UUID, tariffID uuid.UUID, params expeditionMod.UpdateExpeditionTariffParams) (*models.ExpeditionTariff, error) {
+	args := m.Called(ctx, expeditionID, tariffID, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ExpeditionTariff), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) UpsertTariffs(ctx context.Context, params []expeditionMod.CreateExpeditionTariffParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+
+func (m *MockExpeditionRepository) DeleteTariff(ctx context.Context, expeditionID uuid.UUID, tariffID uuid.UUID, deletedBy string) error {
+	args := m.Called(ctx, expeditionID, tariffID, deletedBy)
+	return args.Error(0)
+}
+
+func (m *MockExpeditionRepository) GetTariffByID(ctx context.Context, expeditionID uuid.UUID, tariffID uuid.UUID) (*models.ExpeditionTariff, error) {
+	args := m.Called(ctx, expeditionID, tariffID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ExpeditionTariff), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) GetTariffsByExpeditionID(ctx context.Context, expeditionID uuid.UUID) ([]models.ExpeditionTariff, error) {
+	args := m.Called(ctx, expeditionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ExpeditionTariff), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) ExistsTariffRoute(ctx context.Context, expeditionID uuid.UUID, originCityID uuid.UUID, destinationCityID uuid.UUID, excludeID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, expeditionID, originCityID, destinationCityID, excludeID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) GetQuoteCandidates(ctx context.Context, originCityID uuid.UUID, destinationCityID uuid.UUID, destinationDistrictID *uuid.UUID) ([]expeditionDto.ExpeditionQuoteCandidate, error) {
+	args := m.Called(ctx, originCityID, destinationCityID, destinationDistrictID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]expeditionDto.ExpeditionQuoteCandidate), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) ExistsCity(ctx context.Context, cityID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, cityID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) ExistsDistrictInCity(ctx context.Context, cityID uuid.UUID, districtID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, cityID, districtID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) GetCitiesByCodes(ctx context.Context, codes []string) ([]expeditionDto.ExpeditionRegionCode, error) {
+	args := m.Called(ctx, codes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]expeditionDto.ExpeditionRegionCode), args.Error(1)
+}
+
+func (m *MockExpeditionRepository) GetDistrictsByCodes(ctx context.Context, codes []string) ([]expeditionDto.ExpeditionRegionCode, error) {
+	args := m.Called(ctx, codes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]expeditionDto.ExpeditionRegionCode), args.Error(1)
+}
+
 func TestCreateExpedition(t *testing.T) {
 	e := echo.New()
 	ctx := context.Background()
@@ -392,10 +570,20 @@ func TestDeleteExpedition(t *testing.T) {
 			id:     validID.String(),
 			authId: "test-auth-id",
 			setupMock: func(m *MockExpeditionRepository) {
+				m.On("ExistsInSuppliersOrCustomers", ctx, validID).Return(false, nil).Once()
 				m.On("Delete", ctx, validID, "test-auth-id").Return(nil).Once()
 			},
 			expectedError: nil,
 		},
+		{
+			name:   "error when expedition is still used by suppliers or customers",
+			id:     validID.String(),
+			authId: "test-auth-id",
+			setupMock: func(m *MockExpeditionRepository) {
+				m.On("ExistsInSuppliersOrCustomers", ctx, validID).Return(true, nil).Once()
+			},
+			expectedError: errors.New(constants.ExpeditionStillUsed),
+		},
 		{
 			name:   "error when invalid UUID",
 			id:     "invalid-uuid",
@@ -410,6 +598,7 @@ func TestDeleteExpedition(t *testing.T) {
 			id:     validID.String(),
 			authId: "test-auth-id",
 			setupMock: func(m *MockExpeditionRepository) {
+				m.On("ExistsInSuppliersOrCustomers", ctx, validID).Return(false, nil).Once()
 				m.On("Delete", ctx, validID, "test-auth-id").Return(errors.New("delete failed")).Once()
 			},
 			expectedError: errors.New("delete failed"),
@@ -0,0 +1,127 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/expedition/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpeditionHandler_CreateContactSuccess(t *testing.T) {
+	e := newEcho()
+	expeditionID := uuid.New().String()
+	reqBody := `{"phone_type":"telp","area_code":"021","phone_number":"555","contact_name":"Budi","email":"budi@jne.co.id"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/expedition/"+expeditionID+"/contacts", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(expeditionID)
+	c.Set("user", models.User{ID: uuid.New()})
+
+	mockUC := new(mockExpeditionUsecase)
+	handler := newExpeditionHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	contactName := "Budi"
+	mockUC.On("CreateContact", mock.Anything, expeditionID, mock.MatchedBy(func(r *dto.ReqCreateExpeditionContact) bool {
+		return r.PhoneType == constants.ExpeditionContactTypeTelp && r.PhoneNumber == "555" && *r.ContactName == "Budi"
+	}), mock.AnythingOfType("string")).Return(&models.ExpeditionContact{
+		ID:               uuid.New(),
+		PhoneType:        constants.ExpeditionContactTypeTelp,
+		PhoneNumber:      "555",
+		NormalizedNumber: "+6221555",
+		ContactName:      &contactName,
+		IsPrimary:        true,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}, nil).Once()
+
+	err := handler.CreateContact(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Data dto.RespExpeditionContact `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "+6221555", resp.Data.NormalizedNumber)
+	assert.True(t, resp.Data.IsPrimary)
+	mockUC.AssertExpectations(t)
+}
+
+func TestExpeditionHandler_CreateContactValidationError(t *testing.T) {
+	e := newEcho()
+	expeditionID := uuid.New().String()
+	reqBody := `{"phone_type":"fax","phone_number":"021555","email":"not-an-email"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/expedition/"+expeditionID+"/contacts", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(expeditionID)
+
+	mockUC := new(mockExpeditionUsecase)
+	handler := newExpeditionHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	err := handler.CreateContact(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertNotCalled(t, "CreateContact", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestExpeditionHandler_SetPrimaryContactNotFound(t *testing.T) {
+	e := newEcho()
+	expeditionID := uuid.New().String()
+	contactID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodPatch, "/v1/expedition/"+expeditionID+"/contacts/"+contactID+"/primary", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id", "contactId")
+	c.SetParamValues(expeditionID, contactID)
+
+	mockUC := new(mockExpeditionUsecase)
+	handler := newExpeditionHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	mockUC.On("SetPrimaryContact", mock.Anything, expeditionID, contactID, "").
+		Return(nil, fmt.Errorf(constants.ExpeditionContactNotFound, contactID)).Once()
+
+	err := handler.SetPrimaryContact(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), contactID)
+	mockUC.AssertExpectations(t)
+}
+
+func TestExpeditionHandler_DeleteContactSuccess(t *testing.T) {
+	e := newEcho()
+	expeditionID := uuid.New().String()
+	contactID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodDelete, "/v1/expedition/"+expeditionID+"/contacts/"+contactID, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id", "contactId")
+	c.SetParamValues(expeditionID, contactID)
+
+	mockUC := new(mockExpeditionUsecase)
+	handler := newExpeditionHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	mockUC.On("DeleteContact", mock.Anything, expeditionID, contactID, mock.AnythingOfType("string")).Return(nil).Once()
+
+	err := handler.DeleteContact(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), constants.ExpeditionContactDeleteSuccess)
+	mockUC.AssertExpectations(t)
+}
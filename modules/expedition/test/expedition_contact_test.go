@@ -0,0 +1,282 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	expeditionMod "github.com/rendyfutsuy/base-go/modules/expedition"
+	expeditionDto "github.com/rendyfutsuy/base-go/modules/expedition/dto"
+	"github.com/rendyfutsuy/base-go/modules/expedition/usecase"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestNormalizeIndonesianPhoneNumber(t *testing.T) {
+	areaCode := "021"
+	emptyAreaCode := " "
+
+	tests := []struct {
+		name        string
+		areaCode    *string
+		phoneNumber string
+		expected    string
+		expectedErr string
+	}{
+		{name: "local number with trunk prefix", phoneNumber: "021-555", expected: "+6221555"},
+		{name: "area code combined with local number", areaCode: &areaCode, phoneNumber: "555", expected: "+6221555"},
+		{name: "area code ignored for full number", areaCode: &areaCode, phoneNumber: "021-555", expected: "+6221555"},
+		{name: "already in E.164", areaCode: &areaCode, phoneNumber: "+6221555", expected: "+6221555"},
+		{name: "country code without plus", phoneNumber: "6281234567890", expected: "+6281234567890"},
+		{name: "mobile number with separators", phoneNumber: "0812 3456-7890", expected: "+6281234567890"},
+		{name: "number with parentheses", areaCode: &emptyAreaCode, phoneNumber: "(021) 555.1234", expected: "+62215551234"},
+		{name: "foreign number", phoneNumber: "+15551234567", expectedErr: fmt.Sprintf(constants.PhoneNumberInvalid, "+15551234567")},
+		{name: "letters", phoneNumber: "021-ABC", expectedErr: fmt.Sprintf(constants.PhoneNumberInvalid, "021-ABC")},
+		{name: "too short", phoneNumber: "0211", expectedErr: fmt.Sprintf(constants.PhoneNumberInvalid, "0211")},
+		{name: "empty", phoneNumber: " - ", expectedErr: constants.PhoneNumberEmpty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := utils.NormalizeIndonesianPhoneNumber(tt.areaCode, tt.phoneNumber)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Empty(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestCreateExpeditionWithDuplicatedContactNumbers(t *testing.T) {
+	ctx := context.Background()
+	areaCode := "021"
+
+	mockRepo := new(MockExpeditionRepository)
+	mockRepo.On("ExistsByExpeditionName", mock.Anything, "JNE", uuid.Nil).Return(false, nil).Once()
+
+	result, err := usecase.NewExpeditionUsecase(mockRepo).Create(ctx, &expeditionDto.ReqCreateExpedition{
+		ExpeditionName: "JNE",
+		TelpNumbers: []expeditionDto.TelpNumberItem{
+			{PhoneNumber: "021-555"},
+			{AreaCode: &areaCode, PhoneNumber: "555"},
+		},
+	}, "test-auth-id")
+
+	assert.EqualError(t, err, constants.ExpeditionPhoneNumberExistsError("+6221555"))
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateExpeditionContact(t *testing.T) {
+	ctx := context.Background()
+	expeditionID := uuid.New()
+	contactID := uuid.New()
+	areaCode := "021"
+	contactName := "Budi"
+
+	tests := []struct {
+		name          string
+		req           *expeditionDto.ReqCreateExpeditionContact
+		setupMock     func(*MockExpeditionRepository)
+		expectedError error
+	}{
+		{
+			name: "success create telp contact",
+			req: &expeditionDto.ReqCreateExpeditionContact{
+				PhoneType:   constants.ExpeditionContactTypeTelp,
+				AreaCode:    &areaCode,
+				PhoneNumber: "555-1234",
+				ContactName: &contactName,
+			},
+			setupMock: func(m *MockExpeditionRepository) {
+				m.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+				m.On("ExistsContactNumber", mock.Anything, expeditionID, "+62215551234", uuid.Nil).Return(false, nil).Once()
+				m.On("CreateContact", mock.Anything, expeditionMod.CreateExpeditionContactParams{
+					ExpeditionID:     expeditionID,
+					PhoneType:        constants.ExpeditionContactTypeTelp,
+					AreaCode:         &areaCode,
+					PhoneNumber:      "555-1234",
+					NormalizedNumber: "+62215551234",
+					ContactName:      &contactName,
+					CreatedBy:        "test-auth-id",
+				}).Return(&models.ExpeditionContact{ID: contactID, NormalizedNumber: "+62215551234", IsPrimary: true}, nil).Once()
+			},
+		},
+		{
+			name: "area code is ignored for hp contact",
+			req: &expeditionDto.ReqCreateExpeditionContact{
+				PhoneType:   constants.ExpeditionContactTypePhone,
+				AreaCode:    &areaCode,
+				PhoneNumber: "81234567890",
+				IsPrimary:   true,
+			},
+			setupMock: func(m *MockExpeditionRepository) {
+				m.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+				m.On("ExistsContactNumber", mock.Anything, expeditionID, "+6281234567890", uuid.Nil).Return(false, nil).Once()
+				m.On("CreateContact", mock.Anything, expeditionMod.CreateExpeditionContactParams{
+					ExpeditionID:     expeditionID,
+					PhoneType:        constants.ExpeditionContactTypePhone,
+					PhoneNumber:      "81234567890",
+					NormalizedNumber: "+6281234567890",
+					IsPrimary:        true,
+					CreatedBy:        "test-auth-id",
+				}).Return(&models.ExpeditionContact{ID: contactID, NormalizedNumber: "+6281234567890", IsPrimary: true}, nil).Once()
+			},
+		},
+		{
+			name: "error when number already used by the expedition",
+			req: &expeditionDto.ReqCreateExpeditionContact{
+				PhoneType:   constants.ExpeditionContactTypeTelp,
+				PhoneNumber: "+6221555",
+			},
+			setupMock: func(m *MockExpeditionRepository) {
+				m.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+				m.On("ExistsContactNumber", mock.Anything, expeditionID, "+6221555", uuid.Nil).Return(true, nil).Once()
+			},
+			expectedError: errors.New(constants.ExpeditionPhoneNumberExistsError("+6221555")),
+		},
+		{
+			name: "error when number is invalid",
+			req: &expeditionDto.ReqCreateExpeditionContact{
+				PhoneType:   constants.ExpeditionContactTypePhone,
+				PhoneNumber: "+15551234567",
+			},
+			setupMock: func(m *MockExpeditionRepository) {
+				m.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+			},
+			expectedError: fmt.Errorf(constants.PhoneNumberInvalid, "+15551234567"),
+		},
+		{
+			name: "error when expedition not found",
+			req: &expeditionDto.ReqCreateExpeditionContact{
+				PhoneType:   constants.ExpeditionContactTypePhone,
+				PhoneNumber: "081234567890",
+			},
+			setupMock: func(m *MockExpeditionRepository) {
+				m.On("GetByID", mock.Anything, expeditionID).Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: fmt.Errorf(constants.ExpeditionNotFound, expeditionID.String()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockExpeditionRepository)
+			tt.setupMock(mockRepo)
+
+			result, err := usecase.NewExpeditionUsecase(mockRepo).CreateContact(ctx, expeditionID.String(), tt.req, "test-auth-id")
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, contactID, result.ID)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateExpeditionContact(t *testing.T) {
+	ctx := context.Background()
+	expeditionID := uuid.New()
+	contactID := uuid.New()
+	email := "cs@jne.co.id"
+
+	t.Run("success update excludes the contact itself from duplicate check", func(t *testing.T) {
+		mockRepo := new(MockExpeditionRepository)
+		mockRepo.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+		mockRepo.On("GetContactByID", mock.Anything, expeditionID, contactID).
+			Return(&models.ExpeditionContact{ID: contactID, PhoneType: constants.ExpeditionContactTypePhone}, nil).Once()
+		mockRepo.On("ExistsContactNumber", mock.Anything, expeditionID, "+6281234567890", contactID).Return(false, nil).Once()
+		mockRepo.On("UpdateContact", mock.Anything, expeditionID, contactID, expeditionMod.UpdateExpeditionContactParams{
+			PhoneNumber:      "0812-3456-7890",
+			NormalizedNumber: "+6281234567890",
+			Email:            &email,
+			UpdatedBy:        "test-auth-id",
+		}).Return(&models.ExpeditionContact{ID: contactID, Email: &email}, nil).Once()
+
+		result, err := usecase.NewExpeditionUsecase(mockRepo).UpdateContact(ctx, expeditionID.String(), contactID.String(), &expeditionDto.ReqUpdateExpeditionContact{
+			PhoneNumber: "0812-3456-7890",
+			Email:       &email,
+		}, "test-auth-id")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &email, result.Email)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error when contact not found", func(t *testing.T) {
+		mockRepo := new(MockExpeditionRepository)
+		mockRepo.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+		mockRepo.On("GetContactByID", mock.Anything, expeditionID, contactID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		result, err := usecase.NewExpeditionUsecase(mockRepo).UpdateContact(ctx, expeditionID.String(), contactID.String(), &expeditionDto.ReqUpdateExpeditionContact{
+			PhoneNumber: "081234567890",
+		}, "test-auth-id")
+
+		assert.EqualError(t, err, fmt.Sprintf(constants.ExpeditionContactNotFound, contactID.String()))
+		assert.Nil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestDeleteAndSetPrimaryExpeditionContact(t *testing.T) {
+	ctx := context.Background()
+	expeditionID := uuid.New()
+	contactID := uuid.New()
+
+	t.Run("success delete contact", func(t *testing.T) {
+		mockRepo := new(MockExpeditionRepository)
+		mockRepo.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+		mockRepo.On("DeleteContact", mock.Anything, expeditionID, contactID, "test-auth-id").Return(nil).Once()
+
+		err := usecase.NewExpeditionUsecase(mockRepo).DeleteContact(ctx, expeditionID.String(), contactID.String(), "test-auth-id")
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error when deleted contact not found", func(t *testing.T) {
+		mockRepo := new(MockExpeditionRepository)
+		mockRepo.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+		mockRepo.On("DeleteContact", mock.Anything, expeditionID, contactID, "test-auth-id").Return(gorm.ErrRecordNotFound).Once()
+
+		err := usecase.NewExpeditionUsecase(mockRepo).DeleteContact(ctx, expeditionID.String(), contactID.String(), "test-auth-id")
+		assert.EqualError(t, err, fmt.Sprintf(constants.ExpeditionContactNotFound, contactID.String()))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success set primary contact", func(t *testing.T) {
+		mockRepo := new(MockExpeditionRepository)
+		mockRepo.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+		mockRepo.On("SetPrimaryContact", mock.Anything, expeditionID, contactID, "test-auth-id").
+			Return(&models.ExpeditionContact{ID: contactID, IsPrimary: true}, nil).Once()
+
+		result, err := usecase.NewExpeditionUsecase(mockRepo).SetPrimaryContact(ctx, expeditionID.String(), contactID.String(), "test-auth-id")
+		assert.NoError(t, err)
+		assert.True(t, result.IsPrimary)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error when contact id is invalid", func(t *testing.T) {
+		mockRepo := new(MockExpeditionRepository)
+		mockRepo.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+
+		result, err := usecase.NewExpeditionUsecase(mockRepo).SetPrimaryContact(ctx, expeditionID.String(), "invalid-uuid", "test-auth-id")
+		assert.EqualError(t, err, "requested param is string")
+		assert.Nil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+}
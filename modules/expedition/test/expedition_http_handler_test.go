@@ -1,9 +1,11 @@
 package test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -93,6 +95,149 @@ func (m *mockExpeditionUsecase) Export(ctx context.Context, filter dto.ReqExpedi
 	return args.Get(0).([]byte), args.Error(1)
 }
 
+func (m *mockExpeditionUsecase) ImportFromExcel(ctx context.Context, filePath string, authId string) (*dto.ResImportExpeditions, error) {
+	args := m.Called(ctx, filePath, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ResImportExpeditions), args.Error(1)
+}
+
+func (m *mockExpeditionUsecase) GetContacts(ctx context.Context, id string) ([]models.ExpeditionContact, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ExpeditionContact), args.Error(1)
+}
+
+func (m *mockExpeditionUsecase) CreateContact(ctx context.Context, id string, req *dto.ReqCreateExpeditionContact, authId string) (*models.ExpeditionContact, error) {
+	args := m.Called(ctx, id, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ExpeditionContact), args.Error(1)
+}
+
+func (m *mockExpeditionUsecase) UpdateContact(ctx context.Context, id string, contactId string, req *dto.ReqUpdateExpeditionContact, authId string) (*models.ExpeditionContact, error) {
+	args := m.Called(ctx, id, contactId, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ExpeditionContact), args.Error(1)
+}
+
+func (m *mockExpeditionUsecase) DeleteContact(ctx context.Context, id string, contactId string, authId string) error {
+	args := m.Called(ctx, id, contactId, authId)
+	return args.Error(0)
+}
+
+func (m *mockExpeditionUsecase) SetPrimaryContact(ctx context.Context, id string, contactId string, authId string) (*models.ExpeditionContact, error) {
+	args := m.Called(ctx, id, contactId, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ExpeditionContact), args.Error(1)
+}
+
+func (m *mockExpeditionUsecase) GetCoverages(ctx context.Context, id string) ([]models.ExpeditionCoverage, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ExpeditionCoverage), args.Error(1)
+}
+
+func (m *mockExpeditionUsecase) CreateCoverage(ctx context.Context, id string, req *dto.ReqCreateExpeditionCoverage, authId string) (*models.ExpeditionCoverage, error) {
+	args := m.Called(ctx, id, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ExpeditionCoverage), args.Error(1)
+}
+
+func (m *mockExpeditionUsecase) DeleteCoverage(ctx context.Context, id string, coverageId string, authId string) error {
+	args := m.Called(ctx, id, coverageId, authId)
+	return args.Error(0)
+}
+
+func (m *mockExpeditionUsecase) ExportCoverages(ctx context.Context, id string) ([]byte, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *mockExpeditionUsecase) ImportCoveragesFromExcel(ctx context.Context, id string, filePath string, authId string) (*dto.ResImportExpeditionServices, error) {
+	args := m.Called(ctx, id, filePath, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ResImportExpeditionServices), args.Error(1)
+}
+
+func (m *mockExpeditionUsecase) GetTariffs(ctx context.Context, id string) ([]models.ExpeditionTariff, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ExpeditionTariff), args.Error(1)
+}
+
+func (m *mockExpeditionUsecase) GetTariffByID(ctx context.Context, id string, tariffId string) (*models.ExpeditionTariff, error) {
+	args := m.Called(ctx, id, tariffId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ExpeditionTariff), args.Error(1)
+}
+
+func (m *mockExpeditionUsecase) CreateTariff(ctx context.Context, id string, req *dto.ReqCreateExpeditionTariff, authId string) (*models.ExpeditionTariff, error) {
+	args := m.Called(ctx, id, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ExpeditionTariff), args.Error(1)
+}
+
+func (m *mockExpeditionUsecase) UpdateTariff(ctx context.Context, id string, tariffId string, req *dto.ReqUpdateExpeditionTariff, authId string) (*models.ExpeditionTariff, error) {
+	args := m.Called(ctx, id, tariffId, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ExpeditionTariff), args.Error(1)
+}
+
+func (m *mockExpeditionUsecase) DeleteTariff(ctx context.Context, id string, tariffId string, authId string) error {
+	args := m.Called(ctx, id, tariffId, authId)
+	return args.Error(0)
+}
+
+func (m *mockExpeditionUsecase) ExportTariffs(ctx context.Context, id string) ([]byte, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *mockExpeditionUsecase) ImportTariffsFromExcel(ctx context.Context, id string, filePath string, authId string) (*dto.ResImportExpeditionServices, error) {
+	args := m.Called(ctx, id, filePath, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ResImportExpeditionServices), args.Error(1)
+}
+
+func (m *mockExpeditionUsecase) Quote(ctx context.Context, req dto.ReqExpeditionQuote) ([]dto.RespExpeditionQuote, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.RespExpeditionQuote), args.Error(1)
+}
+
 type mockMiddlewareAuth struct {
 	mock.Mock
 }
@@ -525,3 +670,72 @@ func TestExpeditionHandler_ExportUsecaseError(t *testing.T) {
 // TestExpeditionHandler_ExportValidateError is removed because
 // expedition_codes filter doesn't have validation that would fail
 // The filter accepts any string values, so validation won't fail
+
+func TestExpeditionHandler_ImportExpeditionsSuccess(t *testing.T) {
+	e := newEcho()
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", "expeditions.xlsx")
+	require.NoError(t, err)
+	_, err = part.Write([]byte("content is read by the usecase"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/expedition/import", body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockExpeditionUsecase)
+	handler := newExpeditionHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	mockUC.On("ImportFromExcel", mock.Anything, mock.AnythingOfType("string"), "").Return(&dto.ResImportExpeditions{
+		TotalRows:    1,
+		SuccessCount: 1,
+		Results:      []dto.ResImportExpeditionExcel{{Row: 2, ExpeditionName: "JNE", Status: "success"}},
+	}, nil).Once()
+
+	err = handler.ImportExpeditions(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Data dto.ResImportExpeditions `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, 1, resp.Data.SuccessCount)
+	assert.Equal(t, "JNE", resp.Data.Results[0].ExpeditionName)
+	mockUC.AssertExpectations(t)
+}
+
+func TestExpeditionHandler_ImportExpeditionsMissingFile(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodPost, "/v1/expedition/import", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockExpeditionUsecase)
+	handler := newExpeditionHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	err := handler.ImportExpeditions(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), constants.ExpeditionImportFileNotFound)
+	mockUC.AssertNotCalled(t, "ImportFromExcel", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestExpeditionHandler_DownloadImportTemplate(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodGet, "/v1/expedition/import/template", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler := newExpeditionHandler(new(mockExpeditionUsecase), new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	err := handler.DownloadImportTemplate(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, constants.ExcelContent, rec.Header().Get(echo.HeaderContentType))
+	assert.NotEmpty(t, rec.Body.Bytes())
+}
@@ -0,0 +1,235 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/expedition/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpeditionHandler_CreateCoverageSuccess(t *testing.T) {
+	e := newEcho()
+	expeditionID := uuid.New().String()
+	cityID := uuid.New()
+	reqBody := `{"city_id":"` + cityID.String() + `"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/expedition/"+expeditionID+"/coverages", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(expeditionID)
+	c.Set("user", models.User{ID: uuid.New()})
+
+	mockUC := new(mockExpeditionUsecase)
+	handler := newExpeditionHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	cityName := "KOTA BANDUNG"
+	mockUC.On("CreateCoverage", mock.Anything, expeditionID, mock.AnythingOfType("*dto.ReqCreateExpeditionCoverage"), mock.AnythingOfType("string")).
+		Return(&models.ExpeditionCoverage{ID: uuid.New(), CityID: cityID, CityName: &cityName, CreatedAt: time.Now(), UpdatedAt: time.Now()}, nil).Once()
+
+	err := handler.CreateCoverage(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Data dto.RespExpeditionCoverage `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, cityID, resp.Data.CityID)
+	assert.Nil(t, resp.Data.DistrictID)
+	mockUC.AssertExpectations(t)
+}
+
+func TestExpeditionHandler_CreateCoverageUsecaseError(t *testing.T) {
+	e := newEcho()
+	expeditionID := uuid.New().String()
+	reqBody := `{"city_id":"` + uuid.New().String() + `"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/expedition/"+expeditionID+"/coverages", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(expeditionID)
+
+	mockUC := new(mockExpeditionUsecase)
+	handler := newExpeditionHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	mockUC.On("CreateCoverage", mock.Anything, expeditionID, mock.AnythingOfType("*dto.ReqCreateExpeditionCoverage"), "").
+		Return(nil, errors.New(constants.ExpeditionCoverageAlreadyExists)).Once()
+
+	err := handler.CreateCoverage(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), constants.ExpeditionCoverageAlreadyExists)
+	mockUC.AssertExpectations(t)
+}
+
+func TestExpeditionHandler_CreateTariffValidationError(t *testing.T) {
+	e := newEcho()
+	expeditionID := uuid.New().String()
+	reqBody := `{"origin_city_id":"` + uuid.New().String() + `","destination_city_id":"` + uuid.New().String() + `","price_per_kg":0}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/expedition/"+expeditionID+"/tariffs", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(expeditionID)
+
+	mockUC := new(mockExpeditionUsecase)
+	handler := newExpeditionHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	err := handler.CreateTariff(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertNotCalled(t, "CreateTariff", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestExpeditionHandler_DeleteTariffSuccess(t *testing.T) {
+	e := newEcho()
+	expeditionID := uuid.New().String()
+	tariffID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodDelete, "/v1/expedition/"+expeditionID+"/tariffs/"+tariffID, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id", "tariffId")
+	c.SetParamValues(expeditionID, tariffID)
+
+	mockUC := new(mockExpeditionUsecase)
+	handler := newExpeditionHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	mockUC.On("DeleteTariff", mock.Anything, expeditionID, tariffID, mock.AnythingOfType("string")).Return(nil).Once()
+
+	err := handler.DeleteTariff(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), constants.ExpeditionTariffDeleteSuccess)
+	mockUC.AssertExpectations(t)
+}
+
+func TestExpeditionHandler_QuoteSuccess(t *testing.T) {
+	e := newEcho()
+	originCityID := uuid.New().String()
+	destinationCityID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodGet, "/v1/expedition/quote?origin_city_id="+originCityID+"&destination_city_id="+destinationCityID+"&weight=2.5", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockExpeditionUsecase)
+	handler := newExpeditionHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	mockUC.On("Quote", mock.Anything, dto.ReqExpeditionQuote{
+		OriginCityID:      originCityID,
+		DestinationCityID: destinationCityID,
+		Weight:            2.5,
+	}).Return([]dto.RespExpeditionQuote{{ExpeditionName: "JNE", Cost: 12500, LeadTimeDays: 2}}, nil).Once()
+
+	err := handler.Quote(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Data []dto.RespExpeditionQuote `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, float64(12500), resp.Data[0].Cost)
+	mockUC.AssertExpectations(t)
+}
+
+func TestExpeditionHandler_QuoteValidationError(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodGet, "/v1/expedition/quote?origin_city_id=invalid&weight=0", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockExpeditionUsecase)
+	handler := newExpeditionHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	err := handler.Quote(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertNotCalled(t, "Quote", mock.Anything, mock.Anything)
+}
+
+func TestExpeditionHandler_ImportTariffsPartialFailure(t *testing.T) {
+	e := newEcho()
+	expeditionID := uuid.New().String()
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", "tariffs.xlsx")
+	require.NoError(t, err)
+	_, err = part.Write([]byte("content is read by the usecase"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/expedition/"+expeditionID+"/tariffs/import", body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(expeditionID)
+
+	mockUC := new(mockExpeditionUsecase)
+	handler := newExpeditionHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	mockUC.On("ImportTariffsFromExcel", mock.Anything, expeditionID, mock.AnythingOfType("string"), mock.AnythingOfType("string")).
+		Return(&dto.ResImportExpeditionServices{
+			TotalRows:    2,
+			SuccessCount: 1,
+			FailedCount:  1,
+			Results: []dto.ResImportExpeditionServiceExcel{
+				{Row: 2, Key: "32.73 > 31.71", Status: "success"},
+				{Row: 3, Key: "32.73 > 99.99", Status: "failed", ErrorMessage: "City with code '99.99' was not found"},
+			},
+		}, nil).Once()
+
+	err = handler.ImportTariffs(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), constants.ExpeditionImportFailedPartial)
+	mockUC.AssertExpectations(t)
+}
+
+func TestExpeditionHandler_ImportCoveragesInvalidFileFormat(t *testing.T) {
+	e := newEcho()
+	expeditionID := uuid.New().String()
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", "coverages.csv")
+	require.NoError(t, err)
+	_, err = part.Write([]byte("city_code,district_code"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/expedition/"+expeditionID+"/coverages/import", body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(expeditionID)
+
+	mockUC := new(mockExpeditionUsecase)
+	handler := newExpeditionHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	err = handler.ImportCoverages(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), constants.ExpeditionImportInvalidFileFormat)
+	mockUC.AssertNotCalled(t, "ImportCoveragesFromExcel", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
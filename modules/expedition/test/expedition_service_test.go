@@ -0,0 +1,497 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	expeditionMod "github.com/rendyfutsuy/base-go/modules/expedition"
+	expeditionDto "github.com/rendyfutsuy/base-go/modules/expedition/dto"
+	"github.com/rendyfutsuy/base-go/modules/expedition/usecase"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// writeImportFile writes an Excel file with a header row followed by the given rows
+func writeImportFile(t *testing.T, headers []string, rows [][]string) string {
+	t.Helper()
+	f := excelize.NewFile()
+	defer f.Close()
+
+	for i, header := range headers {
+		cell, _ := excelize.CoordinatesToCellName(i+1, 1)
+		require.NoError(t, f.SetCellStr("Sheet1", cell, header))
+	}
+	for i, row := range rows {
+		for j, value := range row {
+			cell, _ := excelize.CoordinatesToCellName(j+1, i+2)
+			require.NoError(t, f.SetCellStr("Sheet1", cell, value))
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "import.xlsx")
+	require.NoError(t, f.SaveAs(path))
+	return path
+}
+
+func TestCreateExpeditionCoverage(t *testing.T) {
+	ctx := context.Background()
+	expeditionID := uuid.New()
+	cityID := uuid.New()
+	districtID := uuid.New()
+	coverageID := uuid.New()
+
+	tests := []struct {
+		name          string
+		id            string
+		req           *expeditionDto.ReqCreateExpeditionCoverage
+		setupMock     func(*MockExpeditionRepository)
+		expectedError error
+	}{
+		{
+			name: "success create district coverage",
+			id:   expeditionID.String(),
+			req:  &expeditionDto.ReqCreateExpeditionCoverage{CityID: cityID, DistrictID: &districtID},
+			setupMock: func(m *MockExpeditionRepository) {
+				m.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+				m.On("ExistsCity", mock.Anything, cityID).Return(true, nil).Once()
+				m.On("ExistsDistrictInCity", mock.Anything, cityID, districtID).Return(true, nil).Once()
+				m.On("ExistsCoverage", mock.Anything, expeditionID, cityID, &districtID).Return(false, nil).Once()
+				m.On("CreateCoverage", mock.Anything, expeditionMod.CreateExpeditionCoverageParams{
+					ExpeditionID: expeditionID,
+					CityID:       cityID,
+					DistrictID:   &districtID,
+					CreatedBy:    "test-auth-id",
+				}).Return(&models.ExpeditionCoverage{ID: coverageID}, nil).Once()
+				m.On("GetCoverageByID", mock.Anything, expeditionID, coverageID).Return(&models.ExpeditionCoverage{ID: coverageID, CityID: cityID, DistrictID: &districtID}, nil).Once()
+			},
+		},
+		{
+			name: "error when expedition not found",
+			id:   expeditionID.String(),
+			req:  &expeditionDto.ReqCreateExpeditionCoverage{CityID: cityID},
+			setupMock: func(m *MockExpeditionRepository) {
+				m.On("GetByID", mock.Anything, expeditionID).Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: fmt.Errorf(constants.ExpeditionNotFound, expeditionID.String()),
+		},
+		{
+			name: "error when city not found",
+			id:   expeditionID.String(),
+			req:  &expeditionDto.ReqCreateExpeditionCoverage{CityID: cityID},
+			setupMock: func(m *MockExpeditionRepository) {
+				m.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+				m.On("ExistsCity", mock.Anything, cityID).Return(false, nil).Once()
+			},
+			expectedError: errors.New(constants.ExpeditionCoverageCityNotFound),
+		},
+		{
+			name: "error when district belongs to another city",
+			id:   expeditionID.String(),
+			req:  &expeditionDto.ReqCreateExpeditionCoverage{CityID: cityID, DistrictID: &districtID},
+			setupMock: func(m *MockExpeditionRepository) {
+				m.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+				m.On("ExistsCity", mock.Anything, cityID).Return(true, nil).Once()
+				m.On("ExistsDistrictInCity", mock.Anything, cityID, districtID).Return(false, nil).Once()
+			},
+			expectedError: errors.New(constants.ExpeditionCoverageDistrictNotFound),
+		},
+		{
+			name: "error when area already covered",
+			id:   expeditionID.String(),
+			req:  &expeditionDto.ReqCreateExpeditionCoverage{CityID: cityID},
+			setupMock: func(m *MockExpeditionRepository) {
+				m.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+				m.On("ExistsCity", mock.Anything, cityID).Return(true, nil).Once()
+				m.On("ExistsCoverage", mock.Anything, expeditionID, cityID, (*uuid.UUID)(nil)).Return(true, nil).Once()
+			},
+			expectedError: errors.New(constants.ExpeditionCoverageAlreadyExists),
+		},
+		{
+			name:          "error when expedition id is invalid",
+			id:            "invalid-uuid",
+			req:           &expeditionDto.ReqCreateExpeditionCoverage{CityID: cityID},
+			setupMock:     func(m *MockExpeditionRepository) {},
+			expectedError: errors.New("requested param is string"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockExpeditionRepository)
+			tt.setupMock(mockRepo)
+
+			usecaseInstance := usecase.NewExpeditionUsecase(mockRepo)
+			result, err := usecaseInstance.CreateCoverage(ctx, tt.id, tt.req, "test-auth-id")
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, coverageID, result.ID)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteExpeditionCoverage(t *testing.T) {
+	ctx := context.Background()
+	expeditionID := uuid.New()
+	coverageID := uuid.New()
+
+	t.Run("success delete coverage", func(t *testing.T) {
+		mockRepo := new(MockExpeditionRepository)
+		mockRepo.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+		mockRepo.On("DeleteCoverage", mock.Anything, expeditionID, coverageID, "test-auth-id").Return(nil).Once()
+
+		err := usecase.NewExpeditionUsecase(mockRepo).DeleteCoverage(ctx, expeditionID.String(), coverageID.String(), "test-auth-id")
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error when coverage not found", func(t *testing.T) {
+		mockRepo := new(MockExpeditionRepository)
+		mockRepo.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+		mockRepo.On("DeleteCoverage", mock.Anything, expeditionID, coverageID, "test-auth-id").Return(gorm.ErrRecordNotFound).Once()
+
+		err := usecase.NewExpeditionUsecase(mockRepo).DeleteCoverage(ctx, expeditionID.String(), coverageID.String(), "test-auth-id")
+		assert.EqualError(t, err, fmt.Sprintf(constants.ExpeditionCoverageNotFound, coverageID.String()))
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestCreateExpeditionTariff(t *testing.T) {
+	ctx := context.Background()
+	expeditionID := uuid.New()
+	originCityID := uuid.New()
+	destinationCityID := uuid.New()
+	tariffID := uuid.New()
+	req := &expeditionDto.ReqCreateExpeditionTariff{
+		OriginCityID:      originCityID,
+		DestinationCityID: destinationCityID,
+		PricePerKg:        5000,
+		MinWeight:         1,
+		LeadTimeDays:      2,
+	}
+
+	tests := []struct {
+		name          string
+		setupMock     func(*MockExpeditionRepository)
+		expectedError error
+	}{
+		{
+			name: "success create tariff",
+			setupMock: func(m *MockExpeditionRepository) {
+				m.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+				m.On("ExistsCity", mock.Anything, originCityID).Return(true, nil).Once()
+				m.On("ExistsCity", mock.Anything, destinationCityID).Return(true, nil).Once()
+				m.On("ExistsTariffRoute", mock.Anything, expeditionID, originCityID, destinationCityID, uuid.Nil).Return(false, nil).Once()
+				m.On("CreateTariff", mock.Anything, expeditionMod.CreateExpeditionTariffParams{
+					ExpeditionID:      expeditionID,
+					OriginCityID:      originCityID,
+					DestinationCityID: destinationCityID,
+					PricePerKg:        5000,
+					MinWeight:         1,
+					LeadTimeDays:      2,
+					CreatedBy:         "test-auth-id",
+				}).Return(&models.ExpeditionTariff{ID: tariffID}, nil).Once()
+				m.On("GetTariffByID", mock.Anything, expeditionID, tariffID).Return(&models.ExpeditionTariff{ID: tariffID, PricePerKg: 5000}, nil).Once()
+			},
+		},
+		{
+			name: "error when destination city not found",
+			setupMock: func(m *MockExpeditionRepository) {
+				m.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+				m.On("ExistsCity", mock.Anything, originCityID).Return(true, nil).Once()
+				m.On("ExistsCity", mock.Anything, destinationCityID).Return(false, nil).Once()
+			},
+			expectedError: errors.New(constants.ExpeditionTariffCityNotFound),
+		},
+		{
+			name: "error when route already has a tariff",
+			setupMock: func(m *MockExpeditionRepository) {
+				m.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+				m.On("ExistsCity", mock.Anything, originCityID).Return(true, nil).Once()
+				m.On("ExistsCity", mock.Anything, destinationCityID).Return(true, nil).Once()
+				m.On("ExistsTariffRoute", mock.Anything, expeditionID, originCityID, destinationCityID, uuid.Nil).Return(true, nil).Once()
+			},
+			expectedError: errors.New(constants.ExpeditionTariffAlreadyExists),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockExpeditionRepository)
+			tt.setupMock(mockRepo)
+
+			result, err := usecase.NewExpeditionUsecase(mockRepo).CreateTariff(ctx, expeditionID.String(), req, "test-auth-id")
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tariffID, result.ID)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateExpeditionTariff(t *testing.T) {
+	ctx := context.Background()
+	expeditionID := uuid.New()
+	originCityID := uuid.New()
+	destinationCityID := uuid.New()
+	tariffID := uuid.New()
+	req := &expeditionDto.ReqUpdateExpeditionTariff{
+		OriginCityID:      originCityID,
+		DestinationCityID: destinationCityID,
+		PricePerKg:        6000,
+		LeadTimeDays:      3,
+	}
+
+	t.Run("error when tariff not found", func(t *testing.T) {
+		mockRepo := new(MockExpeditionRepository)
+		mockRepo.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+		mockRepo.On("ExistsCity", mock.Anything, mock.Anything).Return(true, nil).Twice()
+		// The tariff itself is excluded from the duplicate route check
+		mockRepo.On("ExistsTariffRoute", mock.Anything, expeditionID, originCityID, destinationCityID, tariffID).Return(false, nil).Once()
+		mockRepo.On("UpdateTariff", mock.Anything, expeditionID, tariffID, expeditionMod.UpdateExpeditionTariffParams{
+			OriginCityID:      originCityID,
+			DestinationCityID: destinationCityID,
+			PricePerKg:        6000,
+			LeadTimeDays:      3,
+			UpdatedBy:         "test-auth-id",
+		}).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		result, err := usecase.NewExpeditionUsecase(mockRepo).UpdateTariff(ctx, expeditionID.String(), tariffID.String(), req, "test-auth-id")
+		assert.EqualError(t, err, fmt.Sprintf(constants.ExpeditionTariffNotFound, tariffID.String()))
+		assert.Nil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestQuoteExpedition(t *testing.T) {
+	ctx := context.Background()
+	originCityID := uuid.New()
+	destinationCityID := uuid.New()
+	districtID := uuid.New()
+	cheapID := uuid.New()
+	fastID := uuid.New()
+	slowID := uuid.New()
+
+	t.Run("success sorts by cost then lead time and applies minimum weight", func(t *testing.T) {
+		mockRepo := new(MockExpeditionRepository)
+		mockRepo.On("ExistsDistrictInCity", mock.Anything, destinationCityID, districtID).Return(true, nil).Once()
+		mockRepo.On("GetQuoteCandidates", mock.Anything, originCityID, destinationCityID, &districtID).Return([]expeditionDto.ExpeditionQuoteCandidate{
+			// 10 kg minimum: 10 * 1000 = 10000
+			{ExpeditionID: slowID, ExpeditionName: "SLOW", PricePerKg: "1000.00", MinWeight: "10.00", LeadTimeDays: 5},
+			// 2 kg: 2 * 4000 = 8000
+			{ExpeditionID: cheapID, ExpeditionName: "CHEAP", PricePerKg: "4000.00", MinWeight: "1.00", LeadTimeDays: 4},
+			// 2 kg: 2 * 5000 = 10000, faster than SLOW
+			{ExpeditionID: fastID, ExpeditionName: "FAST", PricePerKg: "5000.00", MinWeight: "0.00", LeadTimeDays: 1},
+		}, nil).Once()
+
+		res, err := usecase.NewExpeditionUsecase(mockRepo).Quote(ctx, expeditionDto.ReqExpeditionQuote{
+			OriginCityID:          originCityID.String(),
+			DestinationCityID:     destinationCityID.String(),
+			DestinationDistrictID: districtID.String(),
+			Weight:                2,
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 3)
+
+		assert.Equal(t, cheapID, res[0].ExpeditionID)
+		assert.Equal(t, float64(8000), res[0].Cost)
+		assert.Equal(t, fastID, res[1].ExpeditionID)
+		assert.Equal(t, time.Now().AddDate(0, 0, 1).Format("2006-01-02"), res[1].EstimatedArrival)
+		assert.Equal(t, slowID, res[2].ExpeditionID)
+		assert.Equal(t, float64(10), res[2].ChargeableWeight)
+		assert.Equal(t, float64(10000), res[2].Cost)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success computes the cost on exact decimals and rounds once", func(t *testing.T) {
+		mockRepo := new(MockExpeditionRepository)
+		mockRepo.On("GetQuoteCandidates", mock.Anything, originCityID, destinationCityID, (*uuid.UUID)(nil)).Return([]expeditionDto.ExpeditionQuoteCandidate{
+			// 0.5 kg: 0.5 * 2.01 = 1.005, float64 gives 1.00499999... and rounds down
+			{ExpeditionID: cheapID, ExpeditionName: "CHEAP", PricePerKg: "2.01", MinWeight: "0.00", LeadTimeDays: 1},
+			// 1.1 kg minimum: 1.1 * 0.15 = 0.165
+			{ExpeditionID: slowID, ExpeditionName: "SLOW", PricePerKg: "0.15", MinWeight: "1.10", LeadTimeDays: 2},
+		}, nil).Once()
+
+		res, err := usecase.NewExpeditionUsecase(mockRepo).Quote(ctx, expeditionDto.ReqExpeditionQuote{
+			OriginCityID:      originCityID.String(),
+			DestinationCityID: destinationCityID.String(),
+			Weight:            0.5,
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+
+		assert.Equal(t, slowID, res[0].ExpeditionID)
+		assert.Equal(t, 0.17, res[0].Cost)
+		assert.Equal(t, 1.1, res[0].ChargeableWeight)
+		assert.Equal(t, cheapID, res[1].ExpeditionID)
+		assert.Equal(t, 1.01, res[1].Cost)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error when district is not in the destination city", func(t *testing.T) {
+		mockRepo := new(MockExpeditionRepository)
+		mockRepo.On("ExistsDistrictInCity", mock.Anything, destinationCityID, districtID).Return(false, nil).Once()
+
+		res, err := usecase.NewExpeditionUsecase(mockRepo).Quote(ctx, expeditionDto.ReqExpeditionQuote{
+			OriginCityID:          originCityID.String(),
+			DestinationCityID:     destinationCityID.String(),
+			DestinationDistrictID: districtID.String(),
+			Weight:                2,
+		})
+		assert.EqualError(t, err, constants.ExpeditionQuoteDistrictNotFound)
+		assert.Nil(t, res)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestImportExpeditionCoverages(t *testing.T) {
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+	ctx := context.Background()
+	expeditionID := uuid.New()
+	bandungID := uuid.New()
+	jakartaID := uuid.New()
+	sukasariID := uuid.New()
+
+	path := writeImportFile(t, []string{"City Code", "District Code"}, [][]string{
+		{"32.73", ""},         // row 2: already covered
+		{"32.73", "32.73.01"}, // row 3: new district coverage
+		{"99.99", ""},         // row 4: unknown city
+		{"31.71", "32.73.01"}, // row 5: district of another city
+		{"32.73", "32.73.01"}, // row 6: duplicate of row 3
+		{"", ""},              // blank line is skipped
+		{"31.71", ""},         // row 8: new city coverage
+	})
+
+	mockRepo := new(MockExpeditionRepository)
+	mockRepo.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+	mockRepo.On("GetCitiesByCodes", mock.Anything, mock.Anything).Return([]expeditionDto.ExpeditionRegionCode{
+		{ID: bandungID, Code: "32.73"},
+		{ID: jakartaID, Code: "31.71"},
+	}, nil).Once()
+	mockRepo.On("GetDistrictsByCodes", mock.Anything, mock.Anything).Return([]expeditionDto.ExpeditionRegionCode{
+		{ID: sukasariID, Code: "32.73.01", CityID: &bandungID},
+	}, nil).Once()
+	mockRepo.On("GetCoveragesByExpeditionID", mock.Anything, expeditionID).Return([]models.ExpeditionCoverage{
+		{ExpeditionID: expeditionID, CityID: bandungID},
+	}, nil).Once()
+	mockRepo.On("BulkCreateCoverages", mock.Anything, []expeditionMod.CreateExpeditionCoverageParams{
+		{ExpeditionID: expeditionID, CityID: bandungID, DistrictID: &sukasariID, CreatedBy: "test-auth-id"},
+		{ExpeditionID: expeditionID, CityID: jakartaID, CreatedBy: "test-auth-id"},
+	}).Return(nil).Once()
+
+	res, err := usecase.NewExpeditionUsecase(mockRepo).ImportCoveragesFromExcel(ctx, expeditionID.String(), path, "test-auth-id")
+	require.NoError(t, err)
+
+	assert.Equal(t, 6, res.TotalRows)
+	assert.Equal(t, 3, res.SuccessCount)
+	assert.Equal(t, 3, res.FailedCount)
+
+	assert.Equal(t, 4, res.Results[2].Row)
+	assert.Equal(t, fmt.Sprintf(constants.ExpeditionImportCityCodeNotFound, "99.99"), res.Results[2].ErrorMessage)
+	assert.Equal(t, fmt.Sprintf(constants.ExpeditionImportDistrictCodeNotFound, "32.73.01"), res.Results[3].ErrorMessage)
+	assert.Equal(t, fmt.Sprintf(constants.ExpeditionImportRowDuplicated, 3), res.Results[4].ErrorMessage)
+	assert.Equal(t, 8, res.Results[5].Row)
+	assert.Equal(t, "success", res.Results[5].Status)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestImportExpeditionTariffs(t *testing.T) {
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+	ctx := context.Background()
+	expeditionID := uuid.New()
+	bandungID := uuid.New()
+	jakartaID := uuid.New()
+
+	path := writeImportFile(t, []string{"Origin City Code", "Destination City Code", "Price per Kg", "Min Weight", "Lead Time Days"}, [][]string{
+		{"32.73", "31.71", "5000", "1", "2"}, // row 2: valid
+		{"32.73", "31.71", "6000", "1", "2"}, // row 3: duplicate route
+		{"31.71", "32.73", "0", "-1", "1.5"}, // row 4: invalid numbers
+		{"31.71", "", "4500", "", ""},        // row 5: missing destination
+		{"31.71", "32.73", "4500", "", "3"},  // row 6: valid, empty min weight is 0
+	})
+
+	mockRepo := new(MockExpeditionRepository)
+	mockRepo.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+	mockRepo.On("GetCitiesByCodes", mock.Anything, mock.Anything).Return([]expeditionDto.ExpeditionRegionCode{
+		{ID: bandungID, Code: "32.73"},
+		{ID: jakartaID, Code: "31.71"},
+	}, nil).Once()
+	mockRepo.On("UpsertTariffs", mock.Anything, []expeditionMod.CreateExpeditionTariffParams{
+		{ExpeditionID: expeditionID, OriginCityID: bandungID, DestinationCityID: jakartaID, PricePerKg: 5000, MinWeight: 1, LeadTimeDays: 2, CreatedBy: "test-auth-id"},
+		{ExpeditionID: expeditionID, OriginCityID: jakartaID, DestinationCityID: bandungID, PricePerKg: 4500, MinWeight: 0, LeadTimeDays: 3, CreatedBy: "test-auth-id"},
+	}).Return(nil).Once()
+
+	res, err := usecase.NewExpeditionUsecase(mockRepo).ImportTariffsFromExcel(ctx, expeditionID.String(), path, "test-auth-id")
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, res.TotalRows)
+	assert.Equal(t, 2, res.SuccessCount)
+	assert.Equal(t, 3, res.FailedCount)
+	assert.Equal(t, "32.73 > 31.71", res.Results[0].Key)
+	assert.Equal(t, fmt.Sprintf(constants.ExpeditionImportRowDuplicated, 2), res.Results[1].ErrorMessage)
+	assert.Equal(t, constants.ExpeditionImportPricePerKgInvalid+"; "+
+		fmt.Sprintf(constants.ExpeditionImportNumberInvalid, "min_weight")+"; "+
+		fmt.Sprintf(constants.ExpeditionImportNumberInvalid, "lead_time_days"), res.Results[2].ErrorMessage)
+	assert.Equal(t, constants.ExpeditionImportDestinationRequired, res.Results[3].ErrorMessage)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestImportExpeditionTariffsBatchFailure(t *testing.T) {
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+	ctx := context.Background()
+	expeditionID := uuid.New()
+	bandungID := uuid.New()
+	jakartaID := uuid.New()
+
+	path := writeImportFile(t, []string{"Origin City Code", "Destination City Code", "Price per Kg", "Min Weight", "Lead Time Days"}, [][]string{
+		{"32.73", "31.71", "5000", "1", "2"},
+	})
+
+	mockRepo := new(MockExpeditionRepository)
+	mockRepo.On("GetByID", mock.Anything, expeditionID).Return(&models.Expedition{ID: expeditionID}, nil).Once()
+	mockRepo.On("GetCitiesByCodes", mock.Anything, mock.Anything).Return([]expeditionDto.ExpeditionRegionCode{
+		{ID: bandungID, Code: "32.73"},
+		{ID: jakartaID, Code: "31.71"},
+	}, nil).Once()
+	mockRepo.On("UpsertTariffs", mock.Anything, mock.Anything).Return(errors.New("database error")).Once()
+
+	res, err := usecase.NewExpeditionUsecase(mockRepo).ImportTariffsFromExcel(ctx, expeditionID.String(), path, "test-auth-id")
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, res.SuccessCount)
+	assert.Equal(t, 1, res.FailedCount)
+	assert.Equal(t, constants.ExpeditionImportBatchSaveFailed+": database error", res.Results[0].ErrorMessage)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,115 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	expeditionMod "github.com/rendyfutsuy/base-go/modules/expedition"
+	expeditionDto "github.com/rendyfutsuy/base-go/modules/expedition/dto"
+	"github.com/rendyfutsuy/base-go/modules/expedition/usecase"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var expeditionImportHeaders = []string{"Expedition Code", "Expedition Name", "Address", "Phone Numbers", "Telp Numbers", "Notes"}
+
+func TestImportExpeditions(t *testing.T) {
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+	ctx := context.Background()
+	notes := "Regular and YES services"
+
+	path := writeImportFile(t, expeditionImportHeaders, [][]string{
+		{"", "JNE", "Jl. Tomang Raya No. 11", "081234567890; 0812-9876-5432", "021-5669999", notes}, // row 2: valid, generated code
+		{"99", "SiCepat", "Jl. Kebon Jeruk No. 5", "-", "-", ""},                                    // row 3: valid, explicit code
+		{"", "", "", "", "", ""},                                   // row 4: blank, skipped
+		{"", "JNE", "", "", "", ""},                                // row 5: duplicated name in file
+		{"01", "TIKI", "", "081234567890, +6281234567890", "", ""}, // row 6: code used, duplicated number
+		{"", "POS", "", "12", "", ""},                              // row 7: name used, invalid number
+	})
+
+	mockRepo := new(MockExpeditionRepository)
+	mockRepo.On("ExistsByExpeditionName", mock.Anything, "JNE", uuid.Nil).Return(false, nil).Once()
+	mockRepo.On("ExistsByExpeditionName", mock.Anything, "SiCepat", uuid.Nil).Return(false, nil).Once()
+	mockRepo.On("ExistsByExpeditionName", mock.Anything, "TIKI", uuid.Nil).Return(false, nil).Once()
+	mockRepo.On("ExistsByExpeditionName", mock.Anything, "POS", uuid.Nil).Return(true, nil).Once()
+	mockRepo.On("ExistsByExpeditionCode", mock.Anything, "99").Return(false, nil).Once()
+	mockRepo.On("ExistsByExpeditionCode", mock.Anything, "01").Return(true, nil).Once()
+	mockRepo.On("BulkCreate", mock.Anything, []expeditionMod.CreateExpeditionParams{
+		{
+			ExpeditionName: "JNE",
+			Address:        "Jl. Tomang Raya No. 11",
+			TelpNumbers:    []expeditionDto.TelpNumberItem{{PhoneNumber: "021-5669999"}},
+			PhoneNumbers:   []string{"081234567890", "0812-9876-5432"},
+			Notes:          &notes,
+			CreatedBy:      "test-auth-id",
+		},
+		{
+			ExpeditionCode: "99",
+			ExpeditionName: "SiCepat",
+			Address:        "Jl. Kebon Jeruk No. 5",
+			TelpNumbers:    []expeditionDto.TelpNumberItem{},
+			PhoneNumbers:   []string{},
+			CreatedBy:      "test-auth-id",
+		},
+	}).Return(nil).Once()
+
+	res, err := usecase.NewExpeditionUsecase(mockRepo).ImportFromExcel(ctx, path, "test-auth-id")
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, res.TotalRows)
+	assert.Equal(t, 2, res.SuccessCount)
+	assert.Equal(t, 3, res.FailedCount)
+	assert.Equal(t, "success", res.Results[0].Status)
+	assert.Equal(t, "SiCepat", res.Results[1].ExpeditionName)
+	assert.Equal(t, 5, res.Results[2].Row)
+	assert.Equal(t, fmt.Sprintf(constants.ExpeditionImportRowDuplicated, 2), res.Results[2].ErrorMessage)
+	assert.Equal(t, fmt.Sprintf(constants.ExpeditionImportCodeAlreadyExists, "01")+"; "+
+		constants.ExpeditionPhoneNumberExistsError("+6281234567890"), res.Results[3].ErrorMessage)
+	assert.Equal(t, constants.ExpeditionNameAlreadyExists+"; "+
+		fmt.Sprintf(constants.PhoneNumberInvalid, "12"), res.Results[4].ErrorMessage)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestImportExpeditionsBatchFailure(t *testing.T) {
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+	ctx := context.Background()
+
+	path := writeImportFile(t, expeditionImportHeaders, [][]string{
+		{"", "JNE", "", "081234567890", "", ""},
+	})
+
+	mockRepo := new(MockExpeditionRepository)
+	mockRepo.On("ExistsByExpeditionName", mock.Anything, "JNE", uuid.Nil).Return(false, nil).Once()
+	mockRepo.On("BulkCreate", mock.Anything, mock.Anything).Return(errors.New("insert failed")).Once()
+
+	res, err := usecase.NewExpeditionUsecase(mockRepo).ImportFromExcel(ctx, path, "test-auth-id")
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, res.SuccessCount)
+	assert.Equal(t, 1, res.FailedCount)
+	assert.Equal(t, constants.ExpeditionImportBatchSaveFailed+": insert failed", res.Results[0].ErrorMessage)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestImportExpeditionsInsufficientRows(t *testing.T) {
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+
+	path := writeImportFile(t, expeditionImportHeaders, nil)
+
+	res, err := usecase.NewExpeditionUsecase(new(MockExpeditionRepository)).ImportFromExcel(context.Background(), path, "test-auth-id")
+	assert.EqualError(t, err, constants.ExpeditionImportExcelInsufficientRows)
+	assert.Nil(t, res)
+}
@@ -17,4 +17,29 @@ type Usecase interface {
 	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqExpeditionIndexFilter) ([]models.Expedition, int, error)
 	GetAll(ctx context.Context, filter dto.ReqExpeditionIndexFilter) ([]models.Expedition, error)
 	Export(ctx context.Context, filter dto.ReqExpeditionIndexFilter) ([]byte, error)
+	ImportFromExcel(ctx context.Context, filePath string, authId string) (*dto.ResImportExpeditions, error)
+
+	// Contact methods
+	GetContacts(ctx context.Context, id string) ([]models.ExpeditionContact, error)
+	CreateContact(ctx context.Context, id string, req *dto.ReqCreateExpeditionContact, authId string) (*models.ExpeditionContact, error)
+	UpdateContact(ctx context.Context, id string, contactId string, req *dto.ReqUpdateExpeditionContact, authId string) (*models.ExpeditionContact, error)
+	DeleteContact(ctx context.Context, id string, contactId string, authId string) error
+	SetPrimaryContact(ctx context.Context, id string, contactId string, authId string) (*models.ExpeditionContact, error)
+
+	// Coverage methods
+	GetCoverages(ctx context.Context, id string) ([]models.ExpeditionCoverage, error)
+	CreateCoverage(ctx context.Context, id string, req *dto.ReqCreateExpeditionCoverage, authId string) (*models.ExpeditionCoverage, error)
+	DeleteCoverage(ctx context.Context, id string, coverageId string, authId string) error
+	ExportCoverages(ctx context.Context, id string) ([]byte, error)
+	ImportCoveragesFromExcel(ctx context.Context, id string, filePath string, authId string) (*dto.ResImportExpeditionServices, error)
+
+	// Tariff methods
+	GetTariffs(ctx context.Context, id string) ([]models.ExpeditionTariff, error)
+	GetTariffByID(ctx context.Context, id string, tariffId string) (*models.ExpeditionTariff, error)
+	CreateTariff(ctx context.Context, id string, req *dto.ReqCreateExpeditionTariff, authId string) (*models.ExpeditionTariff, error)
+	UpdateTariff(ctx context.Context, id string, tariffId string, req *dto.ReqUpdateExpeditionTariff, authId string) (*models.ExpeditionTariff, error)
+	DeleteTariff(ctx context.Context, id string, tariffId string, authId string) error
+	ExportTariffs(ctx context.Context, id string) ([]byte, error)
+	ImportTariffsFromExcel(ctx context.Context, id string, filePath string, authId string) (*dto.ResImportExpeditionServices, error)
+	Quote(ctx context.Context, req dto.ReqExpeditionQuote) ([]dto.RespExpeditionQuote, error)
 }
@@ -35,6 +35,10 @@ func (u *expeditionUsecase) Create(ctx context.Context, reqBody *dto.ReqCreateEx
 		return nil, errors.New(constants.ExpeditionNameAlreadyExists)
 	}
 
+	if err := validatePayloadContactNumbers(reqBody.TelpNumbers, reqBody.PhoneNumbers); err != nil {
+		return nil, err
+	}
+
 	return u.repo.Create(ctx, mod.CreateExpeditionParams{
 		ExpeditionName: reqBody.ExpeditionName,
 		Address:        reqBody.Address,
@@ -60,6 +64,10 @@ func (u *expeditionUsecase) Update(ctx context.Context, id string, reqBody *dto.
 		return nil, errors.New(constants.ExpeditionNameAlreadyExists)
 	}
 
+	if err := validatePayloadContactNumbers(reqBody.TelpNumbers, reqBody.PhoneNumbers); err != nil {
+		return nil, err
+	}
+
 	res, err := u.repo.Update(ctx, eid, mod.UpdateExpeditionParams{
 		ExpeditionName: reqBody.ExpeditionName,
 		Address:        reqBody.Address,
@@ -82,6 +90,16 @@ func (u *expeditionUsecase) Delete(ctx context.Context, id string, authId string
 	if err != nil {
 		return err
 	}
+
+	// Check if expedition is still used by active suppliers or customers
+	exists, err := u.repo.ExistsInSuppliersOrCustomers(ctx, eid)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errors.New(constants.ExpeditionStillUsed)
+	}
+
 	return u.repo.Delete(ctx, eid, authId)
 }
 
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/expedition"
+	"github.com/rendyfutsuy/base-go/modules/expedition/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"gorm.io/gorm"
+)
+
+// validatePayloadContactNumbers checks the telp and hp numbers of a create/update payload are valid and not repeated
+func validatePayloadContactNumbers(telpNumbers []dto.TelpNumberItem, phoneNumbers []string) error {
+	seen := make(map[string]bool)
+	check := func(areaCode *string, phoneNumber string) error {
+		if phoneNumber == "" {
+			return nil
+		}
+		normalized, err := utils.NormalizeIndonesianPhoneNumber(areaCode, phoneNumber)
+		if err != nil {
+			return err
+		}
+		if seen[normalized] {
+			return errors.New(constants.ExpeditionPhoneNumberExistsError(normalized))
+		}
+		seen[normalized] = true
+		return nil
+	}
+
+	for _, telp := range telpNumbers {
+		if err := check(telp.AreaCode, telp.PhoneNumber); err != nil {
+			return err
+		}
+	}
+	for _, phoneNumber := range phoneNumbers {
+		if err := check(nil, phoneNumber); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// normalizeContactNumber normalizes a contact number and checks no other contact of the expedition uses it
+func (u *expeditionUsecase) normalizeContactNumber(ctx context.Context, expeditionID uuid.UUID, areaCode *string, phoneNumber string, excludeID uuid.UUID) (string, error) {
+	normalized, err := utils.NormalizeIndonesianPhoneNumber(areaCode, phoneNumber)
+	if err != nil {
+		return "", err
+	}
+
+	exists, err := u.repo.ExistsContactNumber(ctx, expeditionID, normalized, excludeID)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return "", errors.New(constants.ExpeditionPhoneNumberExistsError(normalized))
+	}
+	return normalized, nil
+}
+
+func (u *expeditionUsecase) GetContacts(ctx context.Context, id string) ([]models.ExpeditionContact, error) {
+	eid, err := u.resolveExpeditionID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.GetContactsByExpeditionID(ctx, eid)
+}
+
+func (u *expeditionUsecase) CreateContact(ctx context.Context, id string, reqBody *dto.ReqCreateExpeditionContact, authId string) (*models.ExpeditionContact, error) {
+	eid, err := u.resolveExpeditionID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Telp numbers are the only ones dialed with an area code
+	areaCode := reqBody.AreaCode
+	if reqBody.PhoneType != constants.ExpeditionContactTypeTelp {
+		areaCode = nil
+	}
+
+	normalized, err := u.normalizeContactNumber(ctx, eid, areaCode, reqBody.PhoneNumber, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.repo.CreateContact(ctx, mod.CreateExpeditionContactParams{
+		ExpeditionID:     eid,
+		PhoneType:        reqBody.PhoneType,
+		AreaCode:         areaCode,
+		PhoneNumber:      reqBody.PhoneNumber,
+		NormalizedNumber: normalized,
+		ContactName:      reqBody.ContactName,
+		Email:            reqBody.Email,
+		IsPrimary:        reqBody.IsPrimary,
+		CreatedBy:        authId,
+	})
+}
+
+func (u *expeditionUsecase) UpdateContact(ctx context.Context, id string, contactId string, reqBody *dto.ReqUpdateExpeditionContact, authId string) (*models.ExpeditionContact, error) {
+	eid, err := u.resolveExpeditionID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	cid, err := utils.StringToUUID(contactId)
+	if err != nil {
+		return nil, err
+	}
+
+	contact, err := u.repo.GetContactByID(ctx, eid, cid)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.ExpeditionContactNotFound, contactId)
+		}
+		return nil, err
+	}
+
+	areaCode := reqBody.AreaCode
+	if contact.PhoneType != constants.ExpeditionContactTypeTelp {
+		areaCode = nil
+	}
+
+	normalized, err := u.normalizeContactNumber(ctx, eid, areaCode, reqBody.PhoneNumber, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.repo.UpdateContact(ctx, eid, cid, mod.UpdateExpeditionContactParams{
+		AreaCode:         areaCode,
+		PhoneNumber:      reqBody.PhoneNumber,
+		NormalizedNumber: normalized,
+		ContactName:      reqBody.ContactName,
+		Email:            reqBody.Email,
+		UpdatedBy:        authId,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.ExpeditionContactNotFound, contactId)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *expeditionUsecase) DeleteContact(ctx context.Context, id string, contactId string, authId string) error {
+	eid, err := u.resolveExpeditionID(ctx, id)
+	if err != nil {
+		return err
+	}
+	cid, err := utils.StringToUUID(contactId)
+	if err != nil {
+		return err
+	}
+
+	if err := u.repo.DeleteContact(ctx, eid, cid, authId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf(constants.ExpeditionContactNotFound, contactId)
+		}
+		return err
+	}
+	return nil
+}
+
+func (u *expeditionUsecase) SetPrimaryContact(ctx context.Context, id string, contactId string, authId string) (*models.ExpeditionContact, error) {
+	eid, err := u.resolveExpeditionID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	cid, err := utils.StringToUUID(contactId)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.repo.SetPrimaryContact(ctx, eid, cid, authId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.ExpeditionContactNotFound, contactId)
+		}
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,275 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/expedition"
+	"github.com/rendyfutsuy/base-go/modules/expedition/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"gorm.io/gorm"
+)
+
+func (u *expeditionUsecase) GetCoverages(ctx context.Context, id string) ([]models.ExpeditionCoverage, error) {
+	eid, err := u.resolveExpeditionID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.GetCoveragesByExpeditionID(ctx, eid)
+}
+
+func (u *expeditionUsecase) CreateCoverage(ctx context.Context, id string, reqBody *dto.ReqCreateExpeditionCoverage, authId string) (*models.ExpeditionCoverage, error) {
+	eid, err := u.resolveExpeditionID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := u.repo.ExistsCity(ctx, reqBody.CityID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.New(constants.ExpeditionCoverageCityNotFound)
+	}
+
+	if reqBody.DistrictID != nil {
+		exists, err = u.repo.ExistsDistrictInCity(ctx, reqBody.CityID, *reqBody.DistrictID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, errors.New(constants.ExpeditionCoverageDistrictNotFound)
+		}
+	}
+
+	exists, err = u.repo.ExistsCoverage(ctx, eid, reqBody.CityID, reqBody.DistrictID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New(constants.ExpeditionCoverageAlreadyExists)
+	}
+
+	coverage, err := u.repo.CreateCoverage(ctx, mod.CreateExpeditionCoverageParams{
+		ExpeditionID: eid,
+		CityID:       reqBody.CityID,
+		DistrictID:   reqBody.DistrictID,
+		CreatedBy:    authId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Reload to include city and district names
+	return u.repo.GetCoverageByID(ctx, eid, coverage.ID)
+}
+
+func (u *expeditionUsecase) DeleteCoverage(ctx context.Context, id string, coverageId string, authId string) error {
+	eid, err := u.resolveExpeditionID(ctx, id)
+	if err != nil {
+		return err
+	}
+	cid, err := utils.StringToUUID(coverageId)
+	if err != nil {
+		return err
+	}
+
+	if err := u.repo.DeleteCoverage(ctx, eid, cid, authId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf(constants.ExpeditionCoverageNotFound, coverageId)
+		}
+		return err
+	}
+	return nil
+}
+
+func (u *expeditionUsecase) ExportCoverages(ctx context.Context, id string) ([]byte, error) {
+	coverages, err := u.GetCoverages(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Codes come first so the exported file can be imported back
+	headers := []string{"Kode Kota", "Kode Kecamatan", "Nama Kota", "Nama Kecamatan", "Update Date"}
+	rows := make([][]interface{}, 0, len(coverages))
+	for _, coverage := range coverages {
+		rows = append(rows, []interface{}{
+			optionalExportValue(coverage.CityCode),
+			optionalExportValue(coverage.DistrictCode),
+			optionalExportValue(coverage.CityName),
+			optionalExportValue(coverage.DistrictName),
+			coverage.UpdatedAt.Local().Format("2006/01/02"),
+		})
+	}
+
+	return writeExpeditionServiceSheet("Coverages", headers, rows)
+}
+
+// coverageKey identifies a covered area, the zero UUID stands for the whole city
+func coverageKey(cityID uuid.UUID, districtID *uuid.UUID) string {
+	if districtID == nil {
+		return cityID.String() + "/" + uuid.Nil.String()
+	}
+	return cityID.String() + "/" + districtID.String()
+}
+
+// ImportCoveragesFromExcel adds the areas of an Excel file with columns: city_code, district_code (optional).
+// Areas already covered are reported as success, invalid rows are reported and the valid ones are still imported.
+func (u *expeditionUsecase) ImportCoveragesFromExcel(ctx context.Context, id string, filePath string, authId string) (*dto.ResImportExpeditionServices, error) {
+	eid, err := u.resolveExpeditionID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := readExpeditionImportRows(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Phase 1: Parse all rows and collect codes for batch lookup
+	type parsedRowData struct {
+		CityCode     string
+		DistrictCode string
+		Result       dto.ResImportExpeditionServiceExcel
+	}
+
+	parsedRows := make([]parsedRowData, 0, len(rows))
+	cityCodes := make([]string, 0, len(rows))
+	districtCodes := make([]string, 0, len(rows))
+	for i, row := range rows {
+		// skip blank lines
+		if isBlankExpeditionImportRow(row) {
+			continue
+		}
+
+		parsedRow := parsedRowData{
+			CityCode:     expeditionImportCell(row, 0),
+			DistrictCode: expeditionImportCell(row, 1),
+			Result: dto.ResImportExpeditionServiceExcel{
+				Row: i + 2, // Excel row number (1-indexed, after header)
+			},
+		}
+		parsedRow.Result.Key = parsedRow.CityCode
+		if parsedRow.DistrictCode != "" {
+			parsedRow.Result.Key += " / " + parsedRow.DistrictCode
+			districtCodes = append(districtCodes, parsedRow.DistrictCode)
+		}
+		if parsedRow.CityCode != "" {
+			cityCodes = append(cityCodes, parsedRow.CityCode)
+		}
+		parsedRows = append(parsedRows, parsedRow)
+	}
+
+	// Phase 2: Batch lookup of regions and existing coverages
+	cities, err := u.repo.GetCitiesByCodes(ctx, cityCodes)
+	if err != nil {
+		return nil, err
+	}
+	cityMap := make(map[string]uuid.UUID, len(cities))
+	for _, city := range cities {
+		cityMap[city.Code] = city.ID
+	}
+
+	districts, err := u.repo.GetDistrictsByCodes(ctx, districtCodes)
+	if err != nil {
+		return nil, err
+	}
+	districtMap := make(map[string]dto.ExpeditionRegionCode, len(districts))
+	for _, district := range districts {
+		districtMap[district.Code] = district
+	}
+
+	existing, err := u.repo.GetCoveragesByExpeditionID(ctx, eid)
+	if err != nil {
+		return nil, err
+	}
+	covered := make(map[string]bool, len(existing))
+	for _, coverage := range existing {
+		covered[coverageKey(coverage.CityID, coverage.DistrictID)] = true
+	}
+
+	// Phase 3: Validate each row and prepare the new coverages
+	results := make([]dto.ResImportExpeditionServiceExcel, 0, len(parsedRows))
+	newCoverages := make([]mod.CreateExpeditionCoverageParams, 0, len(parsedRows))
+	newCoverageRowIndices := make([]int, 0, len(parsedRows))
+	seen := make(map[string]int)
+
+	for i := range parsedRows {
+		parsedRow := &parsedRows[i]
+		result := parsedRow.Result
+
+		var allErrors []string
+		cityID, cityFound := cityMap[parsedRow.CityCode]
+		if parsedRow.CityCode == "" {
+			allErrors = append(allErrors, constants.ExpeditionImportCityCodeRequired)
+		} else if !cityFound {
+			allErrors = append(allErrors, fmt.Sprintf(constants.ExpeditionImportCityCodeNotFound, parsedRow.CityCode))
+		}
+
+		var districtID *uuid.UUID
+		if parsedRow.DistrictCode != "" {
+			district, found := districtMap[parsedRow.DistrictCode]
+			if !found || (cityFound && (district.CityID == nil || *district.CityID != cityID)) {
+				allErrors = append(allErrors, fmt.Sprintf(constants.ExpeditionImportDistrictCodeNotFound, parsedRow.DistrictCode))
+			} else {
+				districtID = &district.ID
+			}
+		}
+
+		if len(allErrors) == 0 {
+			key := coverageKey(cityID, districtID)
+			if row, duplicated := seen[key]; duplicated {
+				allErrors = append(allErrors, fmt.Sprintf(constants.ExpeditionImportRowDuplicated, row))
+			} else {
+				seen[key] = result.Row
+			}
+
+			// Area already covered: nothing to insert
+			if len(allErrors) == 0 && covered[key] {
+				result.Success = true
+				result.Status = "success"
+				results = append(results, result)
+				continue
+			}
+		}
+
+		if len(allErrors) > 0 {
+			result.Success = false
+			result.Status = "failed"
+			result.ErrorMessage = strings.Join(allErrors, "; ")
+			results = append(results, result)
+			continue
+		}
+
+		newCoverages = append(newCoverages, mod.CreateExpeditionCoverageParams{
+			ExpeditionID: eid,
+			CityID:       cityID,
+			DistrictID:   districtID,
+			CreatedBy:    authId,
+		})
+		newCoverageRowIndices = append(newCoverageRowIndices, len(results))
+
+		// Mark as success (will be validated after batch insert)
+		result.Success = true
+		result.Status = "success"
+		results = append(results, result)
+	}
+
+	// Phase 4: Batch insert new coverages (single statement)
+	if len(newCoverages) > 0 {
+		if err := u.repo.BulkCreateCoverages(ctx, newCoverages); err != nil {
+			for _, idx := range newCoverageRowIndices {
+				results[idx].Success = false
+				results[idx].Status = "failed"
+				results[idx].ErrorMessage = fmt.Sprintf("%s: %v", constants.ExpeditionImportBatchSaveFailed, err)
+			}
+		}
+	}
+
+	return toResImportExpeditionServices(results), nil
+}
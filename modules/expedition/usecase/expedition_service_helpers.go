@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/modules/expedition/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/xuri/excelize/v2"
+	"gorm.io/gorm"
+)
+
+// resolveExpeditionID parses the expedition id of a contact, coverage or tariff request and checks the expedition is active
+func (u *expeditionUsecase) resolveExpeditionID(ctx context.Context, id string) (uuid.UUID, error) {
+	eid, err := utils.StringToUUID(id)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if _, err := u.repo.GetByID(ctx, eid); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, fmt.Errorf(constants.ExpeditionNotFound, id)
+		}
+		return uuid.Nil, err
+	}
+	return eid, nil
+}
+
+// readExpeditionImportRows returns the data rows (without header) of the first sheet of an Excel file
+func readExpeditionImportRows(filePath string) ([][]string, error) {
+	f, err := excelize.OpenFile(filePath)
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, fmt.Errorf("%s: %v", constants.ExpeditionImportExcelOpenFailed, err)
+	}
+	defer f.Close()
+
+	rows, err := f.GetRows(f.GetSheetName(0))
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, fmt.Errorf("%s: %v", constants.ExpeditionImportExcelReadFailed, err)
+	}
+
+	if len(rows) < 2 {
+		return nil, errors.New(constants.ExpeditionImportExcelInsufficientRows)
+	}
+
+	return rows[1:], nil
+}
+
+// expeditionImportCell returns the trimmed cell of a row, empty when the row is shorter
+func expeditionImportCell(row []string, index int) string {
+	if index < len(row) {
+		return strings.TrimSpace(row[index])
+	}
+	return ""
+}
+
+// isBlankExpeditionImportRow reports whether every cell of the row is empty
+func isBlankExpeditionImportRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// parseExpeditionImportNumber parses a non negative number cell, an empty cell is 0
+func parseExpeditionImportNumber(value string, field string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || number < 0 {
+		return 0, fmt.Errorf(constants.ExpeditionImportNumberInvalid, field)
+	}
+	return number, nil
+}
+
+// toResImportExpeditionServices counts the row results of an import
+func toResImportExpeditionServices(results []dto.ResImportExpeditionServiceExcel) *dto.ResImportExpeditionServices {
+	successCount := 0
+	failedCount := 0
+	for _, result := range results {
+		if result.Status == "success" {
+			successCount++
+		} else {
+			failedCount++
+		}
+	}
+
+	return &dto.ResImportExpeditionServices{
+		TotalRows:    len(results),
+		SuccessCount: successCount,
+		FailedCount:  failedCount,
+		Results:      results,
+	}
+}
+
+// writeExpeditionServiceSheet builds a single sheet workbook with a bold header row and bordered cells
+func writeExpeditionServiceSheet(sheet string, headers []string, rows [][]interface{}) ([]byte, error) {
+	f := excelize.NewFile()
+	defer f.Close()
+	f.SetSheetName("Sheet1", sheet)
+
+	for i, header := range headers {
+		cell, _ := excelize.CoordinatesToCellName(i+1, 1)
+		f.SetCellValue(sheet, cell, header)
+	}
+	for i, row := range rows {
+		for j, value := range row {
+			cell, _ := excelize.CoordinatesToCellName(j+1, i+2)
+			f.SetCellValue(sheet, cell, value)
+		}
+	}
+
+	// Define border configuration
+	borderDefinition := []excelize.Border{
+		{Type: "left", Color: "000000", Style: 1},
+		{Type: "top", Color: "000000", Style: 1},
+		{Type: "bottom", Color: "000000", Style: 1},
+		{Type: "right", Color: "000000", Style: 1},
+	}
+
+	borderStyle, err := f.NewStyle(&excelize.Style{
+		Border: borderDefinition,
+	})
+	if err != nil {
+		return nil, err
+	}
+	endCell, _ := excelize.CoordinatesToCellName(len(headers), len(rows)+1)
+	if err := f.SetCellStyle(sheet, "A1", endCell, borderStyle); err != nil {
+		return nil, err
+	}
+
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font:   &excelize.Font{Bold: true},
+		Border: borderDefinition,
+	})
+	if err != nil {
+		return nil, err
+	}
+	headerEndCell, _ := excelize.CoordinatesToCellName(len(headers), 1)
+	if err := f.SetCellStyle(sheet, "A1", headerEndCell, headerStyle); err != nil {
+		return nil, err
+	}
+
+	// Write to buffer and return bytes
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// optionalExportValue returns "-" for an empty optional value of an export cell
+func optionalExportValue(value *string) string {
+	if value == nil || *value == "" {
+		return "-"
+	}
+	return *value
+}
@@ -0,0 +1,443 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/expedition"
+	"github.com/rendyfutsuy/base-go/modules/expedition/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"gorm.io/gorm"
+)
+
+// validateTariffRoute makes sure both cities exist and the expedition has no other tariff for the route
+func (u *expeditionUsecase) validateTariffRoute(ctx context.Context, expeditionID, originCityID, destinationCityID, excludeID uuid.UUID) error {
+	for _, cityID := range []uuid.UUID{originCityID, destinationCityID} {
+		exists, err := u.repo.ExistsCity(ctx, cityID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.New(constants.ExpeditionTariffCityNotFound)
+		}
+	}
+
+	exists, err := u.repo.ExistsTariffRoute(ctx, expeditionID, originCityID, destinationCityID, excludeID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errors.New(constants.ExpeditionTariffAlreadyExists)
+	}
+	return nil
+}
+
+func (u *expeditionUsecase) GetTariffs(ctx context.Context, id string) ([]models.ExpeditionTariff, error) {
+	eid, err := u.resolveExpeditionID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.GetTariffsByExpeditionID(ctx, eid)
+}
+
+func (u *expeditionUsecase) GetTariffByID(ctx context.Context, id string, tariffId string) (*models.ExpeditionTariff, error) {
+	eid, err := u.resolveExpeditionID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	tid, err := utils.StringToUUID(tariffId)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.repo.GetTariffByID(ctx, eid, tid)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.ExpeditionTariffNotFound, tariffId)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *expeditionUsecase) CreateTariff(ctx context.Context, id string, reqBody *dto.ReqCreateExpeditionTariff, authId string) (*models.ExpeditionTariff, error) {
+	eid, err := u.resolveExpeditionID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.validateTariffRoute(ctx, eid, reqBody.OriginCityID, reqBody.DestinationCityID, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	tariff, err := u.repo.CreateTariff(ctx, mod.CreateExpeditionTariffParams{
+		ExpeditionID:      eid,
+		OriginCityID:      reqBody.OriginCityID,
+		DestinationCityID: reqBody.DestinationCityID,
+		PricePerKg:        reqBody.PricePerKg,
+		MinWeight:         reqBody.MinWeight,
+		LeadTimeDays:      reqBody.LeadTimeDays,
+		CreatedBy:         authId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Reload to include city names
+	return u.repo.GetTariffByID(ctx, eid, tariff.ID)
+}
+
+func (u *expeditionUsecase) UpdateTariff(ctx context.Context, id string, tariffId string, reqBody *dto.ReqUpdateExpeditionTariff, authId string) (*models.ExpeditionTariff, error) {
+	eid, err := u.resolveExpeditionID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	tid, err := utils.StringToUUID(tariffId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.validateTariffRoute(ctx, eid, reqBody.OriginCityID, reqBody.DestinationCityID, tid); err != nil {
+		return nil, err
+	}
+
+	res, err := u.repo.UpdateTariff(ctx, eid, tid, mod.UpdateExpeditionTariffParams{
+		OriginCityID:      reqBody.OriginCityID,
+		DestinationCityID: reqBody.DestinationCityID,
+		PricePerKg:        reqBody.PricePerKg,
+		MinWeight:         reqBody.MinWeight,
+		LeadTimeDays:      reqBody.LeadTimeDays,
+		UpdatedBy:         authId,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.ExpeditionTariffNotFound, tariffId)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *expeditionUsecase) DeleteTariff(ctx context.Context, id string, tariffId string, authId string) error {
+	eid, err := u.resolveExpeditionID(ctx, id)
+	if err != nil {
+		return err
+	}
+	tid, err := utils.StringToUUID(tariffId)
+	if err != nil {
+		return err
+	}
+
+	if err := u.repo.DeleteTariff(ctx, eid, tid, authId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf(constants.ExpeditionTariffNotFound, tariffId)
+		}
+		return err
+	}
+	return nil
+}
+
+func (u *expeditionUsecase) ExportTariffs(ctx context.Context, id string) ([]byte, error) {
+	tariffs, err := u.GetTariffs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Codes and numbers come first so the exported file can be imported back
+	headers := []string{"Kode Kota Asal", "Kode Kota Tujuan", "Harga per Kg", "Berat Minimum (Kg)", "Lead Time (Hari)", "Kota Asal", "Kota Tujuan", "Update Date"}
+	rows := make([][]interface{}, 0, len(tariffs))
+	for _, tariff := range tariffs {
+		rows = append(rows, []interface{}{
+			optionalExportValue(tariff.OriginCityCode),
+			optionalExportValue(tariff.DestinationCityCode),
+			tariff.PricePerKg,
+			tariff.MinWeight,
+			tariff.LeadTimeDays,
+			optionalExportValue(tariff.OriginCityName),
+			optionalExportValue(tariff.DestinationCityName),
+			tariff.UpdatedAt.Local().Format("2006/01/02"),
+		})
+	}
+
+	return writeExpeditionServiceSheet("Tariffs", headers, rows)
+}
+
+// ImportTariffsFromExcel upserts the routes of an Excel file with columns: origin_city_code, destination_city_code,
+// price_per_kg, min_weight, lead_time_days. Existing routes get the new tariff, invalid rows are reported and the
+// valid ones are still imported.
+func (u *expeditionUsecase) ImportTariffsFromExcel(ctx context.Context, id string, filePath string, authId string) (*dto.ResImportExpeditionServices, error) {
+	eid, err := u.resolveExpeditionID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := readExpeditionImportRows(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Phase 1: Parse all rows and collect city codes for batch lookup
+	type parsedRowData struct {
+		OriginCode      string
+		DestinationCode string
+		PricePerKg      string
+		MinWeight       string
+		LeadTimeDays    string
+		Result          dto.ResImportExpeditionServiceExcel
+	}
+
+	parsedRows := make([]parsedRowData, 0, len(rows))
+	cityCodes := make([]string, 0, len(rows)*2)
+	for i, row := range rows {
+		// skip blank lines
+		if isBlankExpeditionImportRow(row) {
+			continue
+		}
+
+		parsedRow := parsedRowData{
+			OriginCode:      expeditionImportCell(row, 0),
+			DestinationCode: expeditionImportCell(row, 1),
+			PricePerKg:      expeditionImportCell(row, 2),
+			MinWeight:       expeditionImportCell(row, 3),
+			LeadTimeDays:    expeditionImportCell(row, 4),
+			Result: dto.ResImportExpeditionServiceExcel{
+				Row: i + 2, // Excel row number (1-indexed, after header)
+			},
+		}
+		parsedRow.Result.Key = parsedRow.OriginCode + " > " + parsedRow.DestinationCode
+		cityCodes = append(cityCodes, parsedRow.OriginCode, parsedRow.DestinationCode)
+		parsedRows = append(parsedRows, parsedRow)
+	}
+
+	// Phase 2: Batch lookup of cities
+	cities, err := u.repo.GetCitiesByCodes(ctx, cityCodes)
+	if err != nil {
+		return nil, err
+	}
+	cityMap := make(map[string]uuid.UUID, len(cities))
+	for _, city := range cities {
+		cityMap[city.Code] = city.ID
+	}
+
+	// resolveCity appends the error of a missing or unknown city code
+	resolveCity := func(code string, requiredMessage string, allErrors *[]string) uuid.UUID {
+		if code == "" {
+			*allErrors = append(*allErrors, requiredMessage)
+			return uuid.Nil
+		}
+		cityID, found := cityMap[code]
+		if !found {
+			*allErrors = append(*allErrors, fmt.Sprintf(constants.ExpeditionImportCityCodeNotFound, code))
+		}
+		return cityID
+	}
+
+	// Phase 3: Validate each row and prepare the tariffs
+	results := make([]dto.ResImportExpeditionServiceExcel, 0, len(parsedRows))
+	tariffs := make([]mod.CreateExpeditionTariffParams, 0, len(parsedRows))
+	tariffRowIndices := make([]int, 0, len(parsedRows))
+	seen := make(map[string]int)
+
+	for i := range parsedRows {
+		parsedRow := &parsedRows[i]
+		result := parsedRow.Result
+
+		// Collect all validation errors (akumulatif)
+		var allErrors []string
+		originCityID := resolveCity(parsedRow.OriginCode, constants.ExpeditionImportOriginCodeRequired, &allErrors)
+		destinationCityID := resolveCity(parsedRow.DestinationCode, constants.ExpeditionImportDestinationRequired, &allErrors)
+
+		pricePerKg, err := strconv.ParseFloat(parsedRow.PricePerKg, 64)
+		if err != nil || pricePerKg <= 0 {
+			allErrors = append(allErrors, constants.ExpeditionImportPricePerKgInvalid)
+		}
+		minWeight, err := parseExpeditionImportNumber(parsedRow.MinWeight, "min_weight")
+		if err != nil {
+			allErrors = append(allErrors, err.Error())
+		}
+		leadTimeDays, err := parseExpeditionImportNumber(parsedRow.LeadTimeDays, "lead_time_days")
+		if err != nil || leadTimeDays != math.Trunc(leadTimeDays) {
+			allErrors = append(allErrors, fmt.Sprintf(constants.ExpeditionImportNumberInvalid, "lead_time_days"))
+		}
+
+		if len(allErrors) == 0 {
+			key := originCityID.String() + "/" + destinationCityID.String()
+			if row, duplicated := seen[key]; duplicated {
+				allErrors = append(allErrors, fmt.Sprintf(constants.ExpeditionImportRowDuplicated, row))
+			} else {
+				seen[key] = result.Row
+			}
+		}
+
+		// If there are any errors, mark as failed with all error messages
+		if len(allErrors) > 0 {
+			result.Success = false
+			result.Status = "failed"
+			result.ErrorMessage = strings.Join(allErrors, "; ")
+			results = append(results, result)
+			continue
+		}
+
+		tariffs = append(tariffs, mod.CreateExpeditionTariffParams{
+			ExpeditionID:      eid,
+			OriginCityID:      originCityID,
+			DestinationCityID: destinationCityID,
+			PricePerKg:        pricePerKg,
+			MinWeight:         minWeight,
+			LeadTimeDays:      int(leadTimeDays),
+			CreatedBy:         authId,
+		})
+		tariffRowIndices = append(tariffRowIndices, len(results))
+
+		// Mark as success (will be validated after batch upsert)
+		result.Success = true
+		result.Status = "success"
+		results = append(results, result)
+	}
+
+	// Phase 4: Batch upsert valid tariffs (single transaction)
+	if len(tariffs) > 0 {
+		if err := u.repo.UpsertTariffs(ctx, tariffs); err != nil {
+			for _, idx := range tariffRowIndices {
+				results[idx].Success = false
+				results[idx].Status = "failed"
+				results[idx].ErrorMessage = fmt.Sprintf("%s: %v", constants.ExpeditionImportBatchSaveFailed, err)
+			}
+		}
+	}
+
+	return toResImportExpeditionServices(results), nil
+}
+
+// parseQuoteDecimal reads a decimal exactly, e.g. the text of a NUMERIC column
+func parseQuoteDecimal(value string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok {
+		return nil, fmt.Errorf("invalid decimal %q", value)
+	}
+	return r, nil
+}
+
+// quoteDecimalFloat converts an exact decimal back to the float64 of the response
+func quoteDecimalFloat(r *big.Rat) float64 {
+	f, _ := r.Float64()
+	return f
+}
+
+// roundQuoteCost rounds a cost half up to cents (the scale of price_per_kg)
+func roundQuoteCost(cost *big.Rat) *big.Rat {
+	cents := new(big.Rat).Mul(cost, big.NewRat(100, 1))
+
+	// (2 * num + den) / (2 * den) is cents + 1/2 truncated, costs of a quote are never negative
+	twiceDen := new(big.Int).Mul(cents.Denom(), big.NewInt(2))
+	rounded := new(big.Int).Mul(cents.Num(), big.NewInt(2))
+	rounded.Add(rounded, cents.Denom())
+	rounded.Quo(rounded, twiceDen)
+
+	return new(big.Rat).SetFrac(rounded, big.NewInt(100))
+}
+
+// Quote returns the expeditions serving the route, cheapest first then fastest.
+// Cost is the price per kg times the parcel weight, or the minimum weight of the tariff when the parcel is lighter.
+// It is computed on exact decimals and rounded to cents once.
+func (u *expeditionUsecase) Quote(ctx context.Context, req dto.ReqExpeditionQuote) ([]dto.RespExpeditionQuote, error) {
+	originCityID, err := utils.StringToUUID(req.OriginCityID)
+	if err != nil {
+		return nil, err
+	}
+	destinationCityID, err := utils.StringToUUID(req.DestinationCityID)
+	if err != nil {
+		return nil, err
+	}
+
+	var destinationDistrictID *uuid.UUID
+	if req.DestinationDistrictID != "" {
+		districtID, err := utils.StringToUUID(req.DestinationDistrictID)
+		if err != nil {
+			return nil, err
+		}
+		exists, err := u.repo.ExistsDistrictInCity(ctx, destinationCityID, districtID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, errors.New(constants.ExpeditionQuoteDistrictNotFound)
+		}
+		destinationDistrictID = &districtID
+	}
+
+	candidates, err := u.repo.GetQuoteCandidates(ctx, originCityID, destinationCityID, destinationDistrictID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the shortest decimal text of the weight, e.g. 1.1 and not 1.100000000000000088817841970012523
+	weight, err := parseQuoteDecimal(strconv.FormatFloat(req.Weight, 'f', -1, 64))
+	if err != nil {
+		return nil, err
+	}
+
+	type pricedQuote struct {
+		quote dto.RespExpeditionQuote
+		cost  *big.Rat
+	}
+
+	today := time.Now()
+	priced := make([]pricedQuote, 0, len(candidates))
+	for _, candidate := range candidates {
+		pricePerKg, err := parseQuoteDecimal(candidate.PricePerKg)
+		if err != nil {
+			return nil, err
+		}
+		minWeight, err := parseQuoteDecimal(candidate.MinWeight)
+		if err != nil {
+			return nil, err
+		}
+
+		chargeableWeight := weight
+		if minWeight.Cmp(weight) > 0 {
+			chargeableWeight = minWeight
+		}
+		cost := roundQuoteCost(new(big.Rat).Mul(pricePerKg, chargeableWeight))
+
+		priced = append(priced, pricedQuote{
+			quote: dto.RespExpeditionQuote{
+				ExpeditionID:     candidate.ExpeditionID,
+				ExpeditionCode:   candidate.ExpeditionCode,
+				ExpeditionName:   candidate.ExpeditionName,
+				TariffID:         candidate.TariffID,
+				PricePerKg:       quoteDecimalFloat(pricePerKg),
+				MinWeight:        quoteDecimalFloat(minWeight),
+				ChargeableWeight: quoteDecimalFloat(chargeableWeight),
+				Cost:             quoteDecimalFloat(cost),
+				LeadTimeDays:     candidate.LeadTimeDays,
+				EstimatedArrival: today.AddDate(0, 0, candidate.LeadTimeDays).Format("2006-01-02"),
+			},
+			cost: cost,
+		})
+	}
+
+	sort.SliceStable(priced, func(i, j int) bool {
+		if c := priced[i].cost.Cmp(priced[j].cost); c != 0 {
+			return c < 0
+		}
+		return priced[i].quote.LeadTimeDays < priced[j].quote.LeadTimeDays
+	})
+
+	quotes := make([]dto.RespExpeditionQuote, 0, len(priced))
+	for _, p := range priced {
+		quotes = append(quotes, p.quote)
+	}
+
+	return quotes, nil
+}
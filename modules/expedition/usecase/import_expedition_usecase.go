@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	mod "github.com/rendyfutsuy/base-go/modules/expedition"
+	"github.com/rendyfutsuy/base-go/modules/expedition/dto"
+)
+
+// Several numbers can be put in one cell separated by comma, semicolon or new line
+var expeditionImportNumberSeparatorRegex = regexp.MustCompile(`[,;\n]+`)
+
+// splitExpeditionImportNumbers returns the numbers of a telp / hp cell, "-" marks an empty cell like in the export
+func splitExpeditionImportNumbers(value string) []string {
+	numbers := make([]string, 0)
+	for _, number := range expeditionImportNumberSeparatorRegex.Split(value, -1) {
+		if number = strings.TrimSpace(number); number != "" && number != "-" {
+			numbers = append(numbers, number)
+		}
+	}
+	return numbers
+}
+
+// ImportFromExcel creates expeditions from the rows of an Excel file with columns:
+// expedition_code (optional), expedition_name, address, phone_numbers, telp_numbers, notes
+func (u *expeditionUsecase) ImportFromExcel(ctx context.Context, filePath string, authId string) (*dto.ResImportExpeditions, error) {
+	rows, err := readExpeditionImportRows(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]dto.ResImportExpeditionExcel, 0, len(rows))
+	validParams := make([]mod.CreateExpeditionParams, 0, len(rows))
+	validResultIndices := make([]int, 0, len(rows))
+	seenNames := make(map[string]int)
+	seenCodes := make(map[string]int)
+
+	for i, row := range rows {
+		if isBlankExpeditionImportRow(row) {
+			continue
+		}
+		rowNum := i + 2 // Excel row number, after the header
+
+		code := expeditionImportCell(row, 0)
+		name := expeditionImportCell(row, 1)
+		address := expeditionImportCell(row, 2)
+		phoneNumbers := splitExpeditionImportNumbers(expeditionImportCell(row, 3))
+		telpNumbers := make([]dto.TelpNumberItem, 0)
+		for _, number := range splitExpeditionImportNumbers(expeditionImportCell(row, 4)) {
+			telpNumbers = append(telpNumbers, dto.TelpNumberItem{PhoneNumber: number})
+		}
+		var notes *string
+		if value := expeditionImportCell(row, 5); value != "" {
+			notes = &value
+		}
+
+		result := dto.ResImportExpeditionExcel{Row: rowNum, ExpeditionName: name}
+
+		// Collect all validation errors (akumulatif)
+		var allErrors []string
+		if name == "" {
+			allErrors = append(allErrors, constants.ExpeditionImportNameRequired)
+		} else if len(name) > 255 {
+			allErrors = append(allErrors, fmt.Sprintf(constants.ExpeditionImportFieldTooLong, "expedition_name"))
+		} else if firstRow, ok := seenNames[name]; ok {
+			allErrors = append(allErrors, fmt.Sprintf(constants.ExpeditionImportRowDuplicated, firstRow))
+		} else {
+			exists, err := u.repo.ExistsByExpeditionName(ctx, name, uuid.Nil)
+			if err != nil {
+				return nil, err
+			}
+			if exists {
+				allErrors = append(allErrors, constants.ExpeditionNameAlreadyExists)
+			}
+			seenNames[name] = rowNum
+		}
+
+		if len(address) > 255 {
+			allErrors = append(allErrors, fmt.Sprintf(constants.ExpeditionImportFieldTooLong, "address"))
+		}
+
+		if code != "" {
+			if len(code) > 255 {
+				allErrors = append(allErrors, fmt.Sprintf(constants.ExpeditionImportFieldTooLong, "expedition_code"))
+			} else if firstRow, ok := seenCodes[code]; ok {
+				allErrors = append(allErrors, fmt.Sprintf(constants.ExpeditionImportRowDuplicated, firstRow))
+			} else {
+				exists, err := u.repo.ExistsByExpeditionCode(ctx, code)
+				if err != nil {
+					return nil, err
+				}
+				if exists {
+					allErrors = append(allErrors, fmt.Sprintf(constants.ExpeditionImportCodeAlreadyExists, code))
+				}
+				seenCodes[code] = rowNum
+			}
+		}
+
+		if err := validatePayloadContactNumbers(telpNumbers, phoneNumbers); err != nil {
+			allErrors = append(allErrors, err.Error())
+		}
+
+		if len(allErrors) > 0 {
+			result.Status = "failed"
+			result.ErrorMessage = strings.Join(allErrors, "; ")
+			results = append(results, result)
+			continue
+		}
+
+		validParams = append(validParams, mod.CreateExpeditionParams{
+			ExpeditionCode: code,
+			ExpeditionName: name,
+			Address:        address,
+			TelpNumbers:    telpNumbers,
+			PhoneNumbers:   phoneNumbers,
+			Notes:          notes,
+			CreatedBy:      authId,
+		})
+		validResultIndices = append(validResultIndices, len(results))
+
+		// Mark as success (will be validated after batch insert)
+		result.Success = true
+		result.Status = "success"
+		results = append(results, result)
+	}
+
+	if len(validParams) > 0 {
+		if err := u.repo.BulkCreate(ctx, validParams); err != nil {
+			// If batch insert fails, mark all pending rows as failed
+			for _, idx := range validResultIndices {
+				results[idx].Success = false
+				results[idx].Status = "failed"
+				results[idx].ErrorMessage = fmt.Sprintf("%s: %v", constants.ExpeditionImportBatchSaveFailed, err)
+			}
+		}
+	}
+
+	return toResImportExpeditions(results), nil
+}
+
+// toResImportExpeditions counts the row results of an expedition import
+func toResImportExpeditions(results []dto.ResImportExpeditionExcel) *dto.ResImportExpeditions {
+	successCount := 0
+	failedCount := 0
+	for _, result := range results {
+		if result.Status == "success" {
+			successCount++
+		} else {
+			failedCount++
+		}
+	}
+
+	return &dto.ResImportExpeditions{
+		TotalRows:    len(results),
+		SuccessCount: successCount,
+		FailedCount:  failedCount,
+		Results:      results,
+	}
+}
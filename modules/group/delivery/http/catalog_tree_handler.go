@@ -0,0 +1,77 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/modules/group/dto"
+)
+
+// GetCatalogTree godoc
+// @Summary		Get product catalog tree
+// @Description	Retrieve the nested Group -> Sub Group -> Type -> Backing hierarchy with child and backing counts per node. Search and filters apply to any level and keep the ancestors of matching nodes.
+// @Tags			Golongan
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			filter	query		dto.ReqCatalogTreeFilter	false	"Filter options"
+// @Success		200		{object}	response.NonPaginationResponse{data=[]dto.RespCatalogTreeNode}	"Successfully retrieved catalog tree"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/group/tree [get]
+func (h *GroupHandler) GetCatalogTree(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	filter := new(dto.ReqCatalogTreeFilter)
+	if err := c.Bind(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := h.Usecase.GetCatalogTree(ctx, *filter)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ExportCatalogTree godoc
+// @Summary		Export product catalog tree to Excel
+// @Description	Export the catalog tree to Excel file (.xlsx) with one row per backing and its full Group / Sub Group / Type path. Same search and filter logic as the tree endpoint.
+// @Tags			Golongan
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Param			filter	query		dto.ReqCatalogTreeFilter	false	"Filter options"
+// @Success		200		{file}		binary	"Excel file with catalog tree data"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/group/tree/export [get]
+func (h *GroupHandler) ExportCatalogTree(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	filter := new(dto.ReqCatalogTreeFilter)
+	if err := c.Bind(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	excelBytes, err := h.Usecase.ExportCatalogTree(ctx, *filter)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, constants.ExcelContent)
+	c.Response().Header().Set(constants.FieldContentDisposition, constants.ExcelContentDisposition("catalog_tree.xlsx"))
+	return c.Blob(http.StatusOK, constants.ExcelContent, excelBytes)
+}
@@ -55,6 +55,10 @@ func NewGroupHandler(e *echo.Echo, uc group.Usecase, mwP _reqContext.IMiddleware
 	// Export (no pagination, same filters) - must be before /:id to avoid route conflict
 	r.GET("/export", h.Export, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToExport))
 
+	// Catalog tree (Group -> SubGroup -> Type -> Backing) - must be before /:id to avoid route conflict
+	r.GET("/tree", h.GetCatalogTree, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+	r.GET("/tree/export", h.ExportCatalogTree, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToExport))
+
 	// Get by ID (detail) - must be after /export to avoid route conflict
 	r.GET("/:id", h.GetByID, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
 
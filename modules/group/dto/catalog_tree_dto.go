@@ -0,0 +1,22 @@
+package dto
+
+import "github.com/google/uuid"
+
+// ReqCatalogTreeFilter filters the catalog tree, a node is kept when it or one of its ancestors / descendants matches
+type ReqCatalogTreeFilter struct {
+	Search      string      `query:"search" json:"search"`             // Search keyword on code and name of every level
+	GroupIDs    []uuid.UUID `query:"group_ids" json:"group_ids"`       // Multiple values
+	SubgroupIDs []uuid.UUID `query:"subgroup_ids" json:"subgroup_ids"` // Multiple values
+	TypeIDs     []uuid.UUID `query:"type_ids" json:"type_ids"`         // Multiple values
+}
+
+// RespCatalogTreeNode is a node of the Group -> SubGroup -> Type -> Backing tree
+type RespCatalogTreeNode struct {
+	ID           uuid.UUID             `json:"id"`
+	Level        string                `json:"level"` // group, sub_group, type or backing
+	Code         string                `json:"code"`
+	Name         string                `json:"name"`
+	ChildCount   int                   `json:"child_count"`   // number of direct children
+	BackingCount int                   `json:"backing_count"` // number of backings below this node
+	Children     []RespCatalogTreeNode `json:"children,omitempty"`
+}
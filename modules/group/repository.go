@@ -18,4 +18,22 @@ type Repository interface {
 	GetAll(ctx context.Context, filter dto.ReqGroupIndexFilter) ([]models.Group, error)
 	ExistsByName(ctx context.Context, name string, excludeID uuid.UUID) (bool, error)
 	ExistsInSubGroups(ctx context.Context, groupID uuid.UUID) (bool, error)
+	GetCatalogTree(ctx context.Context, filter dto.ReqCatalogTreeFilter) ([]CatalogTreeRow, error)
+}
+
+// CatalogTreeRow is one flattened path of the catalog tree.
+// Lower levels are nil when the parent has no active children.
+type CatalogTreeRow struct {
+	GroupID      uuid.UUID  `gorm:"column:group_id"`
+	GroupCode    string     `gorm:"column:group_code"`
+	GroupName    string     `gorm:"column:group_name"`
+	SubgroupID   *uuid.UUID `gorm:"column:subgroup_id"`
+	SubgroupCode *string    `gorm:"column:subgroup_code"`
+	SubgroupName *string    `gorm:"column:subgroup_name"`
+	TypeID       *uuid.UUID `gorm:"column:type_id"`
+	TypeCode     *string    `gorm:"column:type_code"`
+	TypeName     *string    `gorm:"column:type_name"`
+	BackingID    *uuid.UUID `gorm:"column:backing_id"`
+	BackingCode  *string    `gorm:"column:backing_code"`
+	BackingName  *string    `gorm:"column:backing_name"`
 }
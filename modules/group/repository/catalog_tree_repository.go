@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/i18n"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/modules/group"
+	"github.com/rendyfutsuy/base-go/modules/group/dto"
+	rsearchgroup "github.com/rendyfutsuy/base-go/modules/group/repository/searches"
+)
+
+// GetCatalogTree returns the active Group -> SubGroup -> Type -> Backing paths, one row per leaf.
+// Search and filters are applied per row so matching nodes keep their ancestors.
+// Group, sub-group and type names are in the locale of the request, backings have no translations.
+func (r *groupRepository) GetCatalogTree(ctx context.Context, filter dto.ReqCatalogTreeFilter) ([]group.CatalogTreeRow, error) {
+	var rows []group.CatalogTreeRow
+	query := r.DB.WithContext(ctx).Table("groups gg").
+		Select(`
+			gg.id AS group_id,
+			gg.group_code,
+			` + i18n.NameColumn(ctx, constants.TranslationEntityGroup, "gg.id", "gg.name") + ` AS group_name,
+			sg.id AS subgroup_id,
+			sg.subgroup_code,
+			` + i18n.NameColumn(ctx, constants.TranslationEntitySubGroup, "sg.id", "sg.name") + ` AS subgroup_name,
+			t.id AS type_id,
+			t.type_code,
+			` + i18n.NameColumn(ctx, constants.TranslationEntityType, "t.id", "t.name") + ` AS type_name,
+			b.id AS backing_id,
+			b.backing_code,
+			b.name AS backing_name
+		`).
+		Joins("LEFT JOIN sub_groups sg ON sg.groups_id = gg.id AND sg.deleted_at IS NULL").
+		Joins("LEFT JOIN types t ON t.subgroup_id = sg.id AND t.deleted_at IS NULL").
+		Joins("LEFT JOIN backings b ON b.type_id = t.id AND b.deleted_at IS NULL").
+		Where("gg.deleted_at IS NULL")
+
+	query = request.ApplySearchConditionFromInterface(query, filter.Search, rsearchgroup.NewCatalogTreeSearchHelper())
+
+	if len(filter.GroupIDs) > 0 {
+		query = query.Where("gg.id IN (?)", filter.GroupIDs)
+	}
+	if len(filter.SubgroupIDs) > 0 {
+		query = query.Where("sg.id IN (?)", filter.SubgroupIDs)
+	}
+	if len(filter.TypeIDs) > 0 {
+		query = query.Where("t.id IN (?)", filter.TypeIDs)
+	}
+
+	if err := query.Order("gg.group_code, sg.subgroup_code, t.type_code, b.backing_code").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
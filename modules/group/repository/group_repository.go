@@ -7,6 +7,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/codegen"
+	"github.com/rendyfutsuy/base-go/helpers/i18n"
 	"github.com/rendyfutsuy/base-go/helpers/request"
 	"github.com/rendyfutsuy/base-go/models"
 	"github.com/rendyfutsuy/base-go/modules/group/dto"
@@ -33,8 +35,16 @@ func (r *groupRepository) Create(ctx context.Context, name string, createdBy str
 		UpdatedAt: now,
 		UpdatedBy: createdBy,
 	}
-	// Omit group_code to let database generate it using DEFAULT generate_group_code()
-	if err := r.DB.WithContext(ctx).Omit("group_code").Create(gg).Error; err != nil {
+	// group_code is allocated from the group code policy in the same transaction
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		code, err := codegen.Allocate(tx, constants.CodeEntityGroup, nil)
+		if err != nil {
+			return err
+		}
+		gg.GroupCode = code
+		return tx.Create(gg).Error
+	})
+	if err != nil {
 		return nil, err
 	}
 	// if gg not update, return error
@@ -96,7 +106,7 @@ func (r *groupRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Gr
 		Select(`
 			gg.id, 
 			gg.group_code, 
-			gg.name, 
+			`+i18n.NameColumn(ctx, constants.TranslationEntityGroup, "gg.id", "gg.name")+` AS name,
 			gg.created_at, 
 			gg.updated_at,
 			NOT EXISTS (
@@ -132,7 +142,7 @@ func (r *groupRepository) GetIndex(ctx context.Context, req request.PageRequest,
 		Select(`
 			gg.id, 
 			gg.group_code, 
-			gg.name, 
+			` + i18n.NameColumn(ctx, constants.TranslationEntityGroup, "gg.id", "gg.name") + ` AS name,
 			gg.created_at, 
 			gg.updated_at,
 			NOT EXISTS (
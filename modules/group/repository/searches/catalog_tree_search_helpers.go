@@ -0,0 +1,25 @@
+package searches
+
+import "github.com/rendyfutsuy/base-go/helpers/request"
+
+// CatalogTreeSearchHelper searches every level of the catalog tree, so a matching row keeps its whole path
+type CatalogTreeSearchHelper struct{ request.SearchPredefineBase }
+
+func (CatalogTreeSearchHelper) GetSearchColumns() []string {
+	return []string{
+		"gg.name", "gg.group_code",
+		"sg.name", "sg.subgroup_code",
+		"t.name", "t.type_code",
+		"b.name", "b.backing_code",
+	}
+}
+func (CatalogTreeSearchHelper) GetSearchExistsSubqueries() []string {
+	return []string{}
+}
+
+var _ request.NeedSearchPredefine = CatalogTreeSearchHelper{}
+
+func NewCatalogTreeSearchHelper() CatalogTreeSearchHelper {
+	t := 0.50
+	return CatalogTreeSearchHelper{SearchPredefineBase: request.SearchPredefineBase{Threshold: &t}}
+}
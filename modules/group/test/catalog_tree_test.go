@@ -0,0 +1,110 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/modules/group"
+	groupDto "github.com/rendyfutsuy/base-go/modules/group/dto"
+	"github.com/rendyfutsuy/base-go/modules/group/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func catalogTreeRows() ([]group.CatalogTreeRow, uuid.UUID) {
+	str := func(s string) *string { return &s }
+	id := func() *uuid.UUID { v := uuid.New(); return &v }
+
+	kain, kosong := uuid.New(), uuid.New()
+	katun, polos := id(), id()
+	return []group.CatalogTreeRow{
+		{GroupID: kain, GroupCode: "01", GroupName: "KAIN",
+			SubgroupID: katun, SubgroupCode: str("0101"), SubgroupName: str("KATUN"),
+			TypeID: polos, TypeCode: str("010101"), TypeName: str("POLOS"),
+			BackingID: id(), BackingCode: str("01010101"), BackingName: str("PUTIH")},
+		{GroupID: kain, GroupCode: "01", GroupName: "KAIN",
+			SubgroupID: katun, SubgroupCode: str("0101"), SubgroupName: str("KATUN"),
+			TypeID: polos, TypeCode: str("010101"), TypeName: str("POLOS"),
+			BackingID: id(), BackingCode: str("01010102"), BackingName: str("HITAM")},
+		{GroupID: kain, GroupCode: "01", GroupName: "KAIN",
+			SubgroupID: katun, SubgroupCode: str("0101"), SubgroupName: str("KATUN"),
+			TypeID: id(), TypeCode: str("010102"), TypeName: str("MOTIF")},
+		{GroupID: kain, GroupCode: "01", GroupName: "KAIN",
+			SubgroupID: id(), SubgroupCode: str("0102"), SubgroupName: str("SUTRA")},
+		{GroupID: kosong, GroupCode: "02", GroupName: "BENANG"},
+	}, kain
+}
+
+func TestGetCatalogTree(t *testing.T) {
+	ctx := context.Background()
+	rows, kain := catalogTreeRows()
+	filter := groupDto.ReqCatalogTreeFilter{Search: "kain"}
+
+	mockRepo := new(MockGroupRepository)
+	mockRepo.On("GetCatalogTree", ctx, filter).Return(rows, nil).Once()
+
+	tree, err := usecase.NewGroupUsecase(mockRepo).GetCatalogTree(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, tree, 2)
+
+	root := tree[0]
+	assert.Equal(t, kain, root.ID)
+	assert.Equal(t, constants.CatalogTreeLevelGroup, root.Level)
+	assert.Equal(t, 2, root.ChildCount)
+	assert.Equal(t, 2, root.BackingCount)
+
+	katun := root.Children[0]
+	assert.Equal(t, constants.CatalogTreeLevelSubGroup, katun.Level)
+	assert.Equal(t, "0101", katun.Code)
+	assert.Equal(t, 2, katun.ChildCount)
+	assert.Equal(t, 2, katun.BackingCount)
+
+	polos := katun.Children[0]
+	assert.Equal(t, constants.CatalogTreeLevelType, polos.Level)
+	assert.Equal(t, 2, polos.ChildCount)
+	assert.Equal(t, "PUTIH", polos.Children[0].Name)
+	assert.Equal(t, constants.CatalogTreeLevelBacking, polos.Children[0].Level)
+	assert.Equal(t, 1, polos.Children[0].BackingCount)
+
+	assert.Equal(t, 0, katun.Children[1].BackingCount)
+	assert.Empty(t, root.Children[1].Children)
+	assert.Equal(t, 0, tree[1].ChildCount)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetCatalogTreeRepositoryError(t *testing.T) {
+	mockRepo := new(MockGroupRepository)
+	mockRepo.On("GetCatalogTree", mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+
+	tree, err := usecase.NewGroupUsecase(mockRepo).GetCatalogTree(context.Background(), groupDto.ReqCatalogTreeFilter{})
+	assert.EqualError(t, err, "db error")
+	assert.Nil(t, tree)
+}
+
+func TestExportCatalogTree(t *testing.T) {
+	ctx := context.Background()
+	rows, _ := catalogTreeRows()
+
+	mockRepo := new(MockGroupRepository)
+	mockRepo.On("GetCatalogTree", ctx, groupDto.ReqCatalogTreeFilter{}).Return(rows, nil).Once()
+
+	data, err := usecase.NewGroupUsecase(mockRepo).ExportCatalogTree(ctx, groupDto.ReqCatalogTreeFilter{})
+	require.NoError(t, err)
+
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	sheetRows, err := f.GetRows("Catalog")
+	require.NoError(t, err)
+
+	// header + one row per backing
+	require.Len(t, sheetRows, 3)
+	assert.Equal(t, "Kode Backing", sheetRows[0][6])
+	assert.Equal(t, []string{"01", "KAIN", "0101", "KATUN", "010101", "POLOS", "01010102", "HITAM", "KAIN / KATUN / POLOS / HITAM"}, sheetRows[2])
+	mockRepo.AssertExpectations(t)
+}
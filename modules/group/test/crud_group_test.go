@@ -14,6 +14,7 @@ import (
 	"github.com/rendyfutsuy/base-go/constants"
 	"github.com/rendyfutsuy/base-go/helpers/request"
 	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/group"
 	groupDto "github.com/rendyfutsuy/base-go/modules/group/dto"
 	"github.com/rendyfutsuy/base-go/modules/group/usecase"
 	"github.com/stretchr/testify/assert"
@@ -80,6 +81,14 @@ func (m *MockGroupRepository) ExistsInSubGroups(ctx context.Context, groupID uui
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockGroupRepository) GetCatalogTree(ctx context.Context, filter groupDto.ReqCatalogTreeFilter) ([]group.CatalogTreeRow, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]group.CatalogTreeRow), args.Error(1)
+}
+
 func TestCreateGroup(t *testing.T) {
 	e := echo.New()
 	ctx := context.Background()
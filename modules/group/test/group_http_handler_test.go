@@ -84,6 +84,22 @@ func (m *mockGroupUsecase) ExistsInSubGroups(ctx context.Context, id uuid.UUID)
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *mockGroupUsecase) GetCatalogTree(ctx context.Context, filter dto.ReqCatalogTreeFilter) ([]dto.RespCatalogTreeNode, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.RespCatalogTreeNode), args.Error(1)
+}
+
+func (m *mockGroupUsecase) ExportCatalogTree(ctx context.Context, filter dto.ReqCatalogTreeFilter) ([]byte, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
 func newGroupEcho() *echo.Echo {
 	e := echo.New()
 	v := validator.New()
@@ -263,3 +279,48 @@ func TestGroupHandler_ExportValidateError(t *testing.T) {
 	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
 	assert.NotEmpty(t, resp.Message)
 }
+
+func TestGroupHandler_GetCatalogTreeSuccess(t *testing.T) {
+	e := newGroupEcho()
+	groupID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/v1/group/tree?search=kain&group_ids="+groupID.String(), nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockGroupUsecase)
+	handler := &groupHttp.GroupHandler{Usecase: mockUC}
+
+	mockUC.On("GetCatalogTree", mock.Anything, dto.ReqCatalogTreeFilter{Search: "kain", GroupIDs: []uuid.UUID{groupID}}).
+		Return([]dto.RespCatalogTreeNode{{ID: groupID, Level: constants.CatalogTreeLevelGroup, Name: "KAIN", BackingCount: 3}}, nil).Once()
+
+	err := handler.GetCatalogTree(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Data []dto.RespCatalogTreeNode `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, 3, resp.Data[0].BackingCount)
+	mockUC.AssertExpectations(t)
+}
+
+func TestGroupHandler_ExportCatalogTreeSuccess(t *testing.T) {
+	e := newGroupEcho()
+	req := httptest.NewRequest(http.MethodGet, "/v1/group/tree/export", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockGroupUsecase)
+	handler := &groupHttp.GroupHandler{Usecase: mockUC}
+
+	mockUC.On("ExportCatalogTree", mock.Anything, dto.ReqCatalogTreeFilter{}).
+		Return([]byte("excel"), nil).Once()
+
+	err := handler.ExportCatalogTree(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, constants.ExcelContent, rec.Header().Get(echo.HeaderContentType))
+	mockUC.AssertExpectations(t)
+}
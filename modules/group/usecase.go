@@ -18,4 +18,6 @@ type Usecase interface {
 	GetAll(ctx context.Context, filter dto.ReqGroupIndexFilter) ([]models.Group, error)
 	Export(ctx context.Context, filter dto.ReqGroupIndexFilter) ([]byte, error)
 	ExistsInSubGroups(ctx context.Context, groupID uuid.UUID) (bool, error)
+	GetCatalogTree(ctx context.Context, filter dto.ReqCatalogTreeFilter) ([]dto.RespCatalogTreeNode, error)
+	ExportCatalogTree(ctx context.Context, filter dto.ReqCatalogTreeFilter) ([]byte, error)
 }
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	mod "github.com/rendyfutsuy/base-go/modules/group"
+	"github.com/rendyfutsuy/base-go/modules/group/dto"
+	"github.com/xuri/excelize/v2"
+)
+
+func (u *groupUsecase) GetCatalogTree(ctx context.Context, filter dto.ReqCatalogTreeFilter) ([]dto.RespCatalogTreeNode, error) {
+	rows, err := u.repo.GetCatalogTree(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return buildCatalogTree(rows), nil
+}
+
+// buildCatalogTree nests the flattened rows (already ordered by code) and counts the children of every node
+func buildCatalogTree(rows []mod.CatalogTreeRow) []dto.RespCatalogTreeNode {
+	groups := make([]*dto.RespCatalogTreeNode, 0)
+	nodes := make(map[uuid.UUID]*dto.RespCatalogTreeNode)
+	children := make(map[uuid.UUID][]*dto.RespCatalogTreeNode)
+
+	// child registers the node under its parent the first time it is seen
+	child := func(parent uuid.UUID, id uuid.UUID, level string, code, name *string) {
+		if _, ok := nodes[id]; ok {
+			return
+		}
+		node := &dto.RespCatalogTreeNode{ID: id, Level: level, Code: *code, Name: *name}
+		nodes[id] = node
+		children[parent] = append(children[parent], node)
+	}
+
+	for _, row := range rows {
+		if _, ok := nodes[row.GroupID]; !ok {
+			node := &dto.RespCatalogTreeNode{ID: row.GroupID, Level: constants.CatalogTreeLevelGroup, Code: row.GroupCode, Name: row.GroupName}
+			nodes[row.GroupID] = node
+			groups = append(groups, node)
+		}
+		if row.SubgroupID == nil {
+			continue
+		}
+		child(row.GroupID, *row.SubgroupID, constants.CatalogTreeLevelSubGroup, row.SubgroupCode, row.SubgroupName)
+		if row.TypeID == nil {
+			continue
+		}
+		child(*row.SubgroupID, *row.TypeID, constants.CatalogTreeLevelType, row.TypeCode, row.TypeName)
+		if row.BackingID == nil {
+			continue
+		}
+		child(*row.TypeID, *row.BackingID, constants.CatalogTreeLevelBacking, row.BackingCode, row.BackingName)
+	}
+
+	// resolve fills the children and counts of a node bottom-up
+	var resolve func(node *dto.RespCatalogTreeNode) dto.RespCatalogTreeNode
+	resolve = func(node *dto.RespCatalogTreeNode) dto.RespCatalogTreeNode {
+		res := *node
+		if res.Level == constants.CatalogTreeLevelBacking {
+			res.BackingCount = 1
+			return res
+		}
+		for _, c := range children[node.ID] {
+			resolved := resolve(c)
+			res.BackingCount += resolved.BackingCount
+			res.Children = append(res.Children, resolved)
+		}
+		res.ChildCount = len(res.Children)
+		return res
+	}
+
+	tree := make([]dto.RespCatalogTreeNode, 0, len(groups))
+	for _, g := range groups {
+		tree = append(tree, resolve(g))
+	}
+	return tree
+}
+
+// ExportCatalogTree exports one row per backing with its full Group / SubGroup / Type path
+func (u *groupUsecase) ExportCatalogTree(ctx context.Context, filter dto.ReqCatalogTreeFilter) ([]byte, error) {
+	rows, err := u.repo.GetCatalogTree(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create Excel file
+	f := excelize.NewFile()
+	sheet := "Catalog"
+	f.SetSheetName("Sheet1", sheet)
+
+	// Header
+	headers := []string{
+		"Kode Golongan", "Nama Golongan",
+		"Kode Sub Golongan", "Nama Sub Golongan",
+		"Kode Jenis", "Nama Jenis",
+		"Kode Backing", "Nama Backing",
+		"Path",
+	}
+	for i, header := range headers {
+		cell, _ := excelize.CoordinatesToCellName(i+1, 1)
+		f.SetCellValue(sheet, cell, header)
+	}
+
+	// Rows, nodes without any backing below them are not exported
+	row := 1
+	for _, r := range rows {
+		if r.BackingID == nil {
+			continue
+		}
+		row++
+		values := []string{
+			r.GroupCode, r.GroupName,
+			*r.SubgroupCode, *r.SubgroupName,
+			*r.TypeCode, *r.TypeName,
+			*r.BackingCode, *r.BackingName,
+			strings.Join([]string{r.GroupName, *r.SubgroupName, *r.TypeName, *r.BackingName}, " / "),
+		}
+		for i, value := range values {
+			cell, _ := excelize.CoordinatesToCellName(i+1, row)
+			f.SetCellValue(sheet, cell, value)
+		}
+	}
+
+	// Define border configuration
+	borderDefinition := []excelize.Border{
+		{Type: "left", Color: "000000", Style: 1},
+		{Type: "top", Color: "000000", Style: 1},
+		{Type: "bottom", Color: "000000", Style: 1},
+		{Type: "right", Color: "000000", Style: 1},
+	}
+
+	// Create border style
+	borderStyle, err := f.NewStyle(&excelize.Style{
+		Border: borderDefinition,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply border style to all cells
+	lastCol, _ := excelize.ColumnNumberToName(len(headers))
+	if err := f.SetCellStyle(sheet, "A1", lastCol+strconv.Itoa(row), borderStyle); err != nil {
+		return nil, err
+	}
+
+	// Create header style with bold font and border
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{
+			Bold: true,
+		},
+		Border: borderDefinition,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply header style to header row
+	if err := f.SetCellStyle(sheet, "A1", lastCol+"1", headerStyle); err != nil {
+		return nil, err
+	}
+
+	// Write to buffer and return bytes
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,338 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/middleware"
+	_reqContext "github.com/rendyfutsuy/base-go/helpers/middleware/request"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/item"
+	"github.com/rendyfutsuy/base-go/modules/item/dto"
+)
+
+type ResponseError struct {
+	Message string `json:"message"`
+}
+
+type Response struct {
+	Message string `json:"message"`
+}
+
+type ItemHandler struct {
+	Usecase              item.Usecase
+	validator            *validator.Validate
+	mwPageRequest        _reqContext.IMiddlewarePageRequest
+	middlewareAuth       middleware.IMiddlewareAuth
+	middlewarePermission middleware.IMiddlewarePermission
+}
+
+func NewItemHandler(e *echo.Echo, uc item.Usecase, mwP _reqContext.IMiddlewarePageRequest, auth middleware.IMiddlewareAuth, middlewarePermission middleware.IMiddlewarePermission) {
+	h := &ItemHandler{Usecase: uc, validator: validator.New(), mwPageRequest: mwP, middlewareAuth: auth, middlewarePermission: middlewarePermission}
+
+	r := e.Group("/v1/item")
+	r.Use(h.middlewareAuth.AuthorizationCheck)
+
+	// Permissions
+	// View:   item.view
+	// Create: item.create
+	// Update: item.update
+	// Delete: item.delete
+	// Export: item.export
+	permissionToView := []string{"item.view"}
+	permissionToCreate := []string{"item.create"}
+	permissionToUpdate := []string{"item.update"}
+	permissionToDelete := []string{"item.delete"}
+	permissionToExport := []string{"item.export"}
+
+	// Index with pagination + search
+	r.GET("", h.GetIndex, middleware.RequireActivatedUser, h.mwPageRequest.PageRequestCtx, h.middlewarePermission.PermissionValidation(permissionToView))
+
+	// Export (no pagination, same filters) - must be before /:id to avoid route conflict
+	r.GET("/export", h.Export, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToExport))
+
+	// Import from Excel - template must be before /:id to avoid route conflict
+	r.GET("/import/template", h.DownloadImportTemplate, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToCreate))
+	r.POST("/import", h.ImportItems, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToCreate))
+
+	// Get by ID (detail) - must be after /export to avoid route conflict
+	r.GET("/:id", h.GetByID, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+
+	// Create
+	r.POST("", h.Create, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToCreate))
+
+	// Update
+	r.PUT("/:id", h.Update, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+
+	// Delete
+	r.DELETE("/:id", h.Delete, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToDelete))
+
+	// Images
+	r.POST("/:id/images", h.UploadImages, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.DELETE("/:id/images/:fileId", h.DeleteImage, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+}
+
+// Create godoc
+// @Summary		Create a new item
+// @Description	Create a new item (SKU) under a backing, it inherits the type, sub-group and group of the backing. units lists the other units of measure with the number of base units they contain (contoh: [{&quot;uom&quot;:&quot;box&quot;,&quot;conversion&quot;:12}]), barcodes lists the barcodes with the unit they are printed on (empty for the base unit). base_uom defaults to pcs and is_active to true. SKU code is generated from the item code policy when item_code is empty. Requires 'api.master-data.item.create' permission.
+// @Tags			Item
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	body		dto.ReqCreateItem	true	"Item creation data. Fields: item_code (optional), name (required), backing_id (required), base_uom, units (array), barcodes (array), length, width, height (cm), weight (kg), is_active"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespItem}	"Successfully created item with full details including units, barcodes and images"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, duplicate SKU code, name or barcode, invalid unit or backing not found"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/item [post]
+func (h *ItemHandler) Create(c echo.Context) error {
+	req := new(dto.ReqCreateItem)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	user := c.Get("user")
+	userID := ""
+	if user != nil {
+		if userModel, ok := user.(models.User); ok {
+			userID = userModel.ID.String()
+		}
+	}
+	res, err := h.Usecase.Create(c.Request().Context(), req, userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	return h.respondDetail(c, res.ID.String())
+}
+
+// Update godoc
+// @Summary		Update item
+// @Description	Update an existing item. The SKU code cannot be changed. Existing units and barcodes are replaced by the given ones. is_active keeps its value when omitted. Requires 'api.master-data.item.update' permission.
+// @Tags			Item
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string				true	"Item UUID"
+// @Param			request	body	dto.ReqUpdateItem	true	"Updated item data. Fields: name (required), backing_id (required), base_uom, units (array), barcodes (array), length, width, height (cm), weight (kg), is_active"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespItem}	"Successfully updated item with full details including units, barcodes and images"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, duplicate name or barcode, invalid unit or backing not found"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Failure		404		{object}	response.NonPaginationResponse	"Item not found"
+// @Router			/v1/item/{id} [put]
+func (h *ItemHandler) Update(c echo.Context) error {
+	id := c.Param("id")
+	req := new(dto.ReqUpdateItem)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	user := c.Get("user")
+	userID := ""
+	if user != nil {
+		if userModel, ok := user.(models.User); ok {
+			userID = userModel.ID.String()
+		}
+	}
+	_, err := h.Usecase.Update(c.Request().Context(), id, req, userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	return h.respondDetail(c, id)
+}
+
+// Delete godoc
+// @Summary		Soft delete item
+// @Description	Soft delete an existing item by ID. The item will be marked as deleted (deleted_at is set) but remains in the database, its barcodes become available to other items. Requires 'api.master-data.item.delete' permission.
+// @Tags			Item
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string	true	"Item UUID"
+// @Success		200		{object}	response.NonPaginationResponse	"Successfully soft deleted item"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid UUID"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Failure		404		{object}	response.NonPaginationResponse	"Item not found"
+// @Router			/v1/item/{id} [delete]
+func (h *ItemHandler) Delete(c echo.Context) error {
+	id := c.Param("id")
+	user := c.Get("user")
+	userID := ""
+	if user != nil {
+		if userModel, ok := user.(models.User); ok {
+			userID = userModel.ID.String()
+		}
+	}
+	if err := h.Usecase.Delete(c.Request().Context(), id, userID); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(Response{Message: constants.ItemDeleteSuccess})
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetIndex godoc
+// @Summary		Get list of items with pagination
+// @Description	Retrieve a paginated list of items with optional search and filters. Supports multiple filter values for each field. Only returns non-deleted items. Requires 'api.master-data.item.view' permission.
+// @Tags			Item
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			page			query		int			false	"Page number (default: 1)"
+// @Param			per_page		query		int			false	"Items per page (default: 10)"
+// @Param			sort_by			query		string		false	"Sort column (allowed: id, item_code, name, backing_name, type_name, subgroup_name, group_name, base_uom, is_active, created_at, updated_at)"
+// @Param			sort_order		query		string		false	"Sort order: asc or desc (default: desc)"
+// @Param			search			query		string		false	"Search keyword (searches in item_code, name, backing name and barcodes)"
+// @Param			item_codes		query		[]string	false	"Filter by SKU codes (multiple values)"
+// @Param			names			query		[]string	false	"Filter by names (multiple values)"
+// @Param			backing_ids		query		[]string	false	"Filter by backing IDs (multiple values)"
+// @Param			type_ids		query		[]string	false	"Filter by type IDs (multiple values)"
+// @Param			subgroup_ids	query		[]string	false	"Filter by sub-group IDs (multiple values)"
+// @Param			group_ids		query		[]string	false	"Filter by group IDs (multiple values)"
+// @Param			base_uoms		query		[]string	false	"Filter by base units of measure (multiple values)"
+// @Param			barcodes		query		[]string	false	"Filter by barcodes (multiple values)"
+// @Param			is_active		query		[]bool		false	"Filter by active flag (multiple values)"
+// @Success		200				{object}	response.PaginationResponse{data=[]dto.RespItemIndex}	"Successfully retrieved items"
+// @Failure		400				{object}	response.NonPaginationResponse	"Bad request - invalid query parameters"
+// @Failure		401				{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403				{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/item [get]
+func (h *ItemHandler) GetIndex(c echo.Context) error {
+	pageRequest := c.Get("page_request").(*request.PageRequest)
+
+	// validate filter req.
+	// initialize filter
+	filter := new(dto.ReqItemIndexFilter)
+
+	// Bind form-data to the DTO
+	if err := c.Bind(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// Validate the request if necessary
+	if err := c.Validate(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, total, err := h.Usecase.GetIndex(c.Request().Context(), *pageRequest, *filter)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	respItem := []dto.RespItemIndex{}
+	for _, v := range res {
+		respItem = append(respItem, dto.ToRespItemIndex(v))
+	}
+
+	respPag := response.PaginationResponse{}
+	respPag, err = respPag.SetResponse(respItem, total, pageRequest.PerPage, pageRequest.Page)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, respPag)
+}
+
+// GetByID godoc
+// @Summary		Get item by ID
+// @Description	Retrieve a single item by its UUID with its backing, type, sub-group and group, units, barcodes and images. Only returns non-deleted items. Requires 'api.master-data.item.view' permission.
+// @Tags			Item
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string	true	"Item UUID"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespItem}	"Successfully retrieved item with full details including units, barcodes and images"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid UUID"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Failure		404		{object}	response.NonPaginationResponse	"Item not found"
+// @Router			/v1/item/{id} [get]
+func (h *ItemHandler) GetByID(c echo.Context) error {
+	return h.respondDetail(c, c.Param("id"))
+}
+
+// Export godoc
+// @Summary		Export items to Excel
+// @Description	Export items to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination. The first columns (Item Code, Name, Backing Code, Base UoM, Units, Barcodes, Length, Width, Height, Weight, Active) follow the import template so the file can be imported again, followed by Backing, Type, Sub-group, Group and Update Date. Requires 'api.master-data.item.export' permission.
+// @Tags			Item
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Param			search			query		string		false	"Search keyword (searches in item_code, name, backing name and barcodes)"
+// @Param			item_codes		query		[]string	false	"Filter by SKU codes (multiple values)"
+// @Param			names			query		[]string	false	"Filter by names (multiple values)"
+// @Param			backing_ids		query		[]string	false	"Filter by backing IDs (multiple values)"
+// @Param			type_ids		query		[]string	false	"Filter by type IDs (multiple values)"
+// @Param			subgroup_ids	query		[]string	false	"Filter by sub-group IDs (multiple values)"
+// @Param			group_ids		query		[]string	false	"Filter by group IDs (multiple values)"
+// @Param			base_uoms		query		[]string	false	"Filter by base units of measure (multiple values)"
+// @Param			barcodes		query		[]string	false	"Filter by barcodes (multiple values)"
+// @Param			is_active		query		[]bool		false	"Filter by active flag (multiple values)"
+// @Success		200				{file}		binary	"Excel file (items.xlsx) with items data"
+// @Failure		400				{object}	response.NonPaginationResponse	"Bad request - invalid query parameters"
+// @Failure		401				{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403				{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/item/export [get]
+func (h *ItemHandler) Export(c echo.Context) error {
+	// validate filter req.
+	// initialize filter
+	filter := new(dto.ReqItemIndexFilter)
+
+	// Bind form-data to the DTO
+	if err := c.Bind(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// Validate the request if necessary
+	if err := c.Validate(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	excelBytes, err := h.Usecase.Export(c.Request().Context(), *filter)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, constants.ExcelContent)
+	c.Response().Header().Set(constants.FieldContentDisposition, constants.ExcelContentDisposition("items.xlsx"))
+	return c.Blob(http.StatusOK, constants.ExcelContent, excelBytes)
+}
+
+// respondDetail loads the item with its units, barcodes and images and writes the detail response
+func (h *ItemHandler) respondDetail(c echo.Context, id string) error {
+	res, err := h.Usecase.GetByID(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	units, err := h.Usecase.GetUnitsByItemID(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	barcodes, err := h.Usecase.GetBarcodesByItemID(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	images, err := h.Usecase.GetImagesByItemID(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespItem(*res, units, barcodes, images))
+	return c.JSON(http.StatusOK, resp)
+}
+
+func authUserID(c echo.Context) string {
+	if userModel, ok := c.Get("user").(models.User); ok {
+		return userModel.ID.String()
+	}
+	return ""
+}
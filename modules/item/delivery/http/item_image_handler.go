@@ -0,0 +1,76 @@
+package http
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/modules/item/dto"
+)
+
+// UploadImages godoc
+// @Summary		Upload item images
+// @Description	Upload one or more images of an item. The files are stored through the file module and assigned to the item with type "image", existing images are kept. Requires 'api.master-data.item.update' permission.
+// @Tags			Item
+// @Accept			multipart/form-data
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string	true	"Item UUID"
+// @Param			images	formData	file	true	"Image files (repeat the field for several images)"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespItem}	"Successfully uploaded images, returns the item with all its images"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - no image, upload failed or item not found"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/item/{id}/images [post]
+func (h *ItemHandler) UploadImages(c echo.Context) error {
+	id := c.Param("id")
+
+	form, err := c.MultipartForm()
+	if err != nil || len(form.File["images"]) == 0 {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, constants.ItemImageRequired))
+	}
+
+	images := make([]dto.ItemImageUpload, 0, len(form.File["images"]))
+	for _, file := range form.File["images"] {
+		src, err := file.Open()
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+		}
+		data, err := io.ReadAll(src)
+		src.Close()
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+		}
+		images = append(images, dto.ItemImageUpload{Data: data, FileName: file.Filename})
+	}
+
+	if err := h.Usecase.UploadImages(c.Request().Context(), id, images); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	return h.respondDetail(c, id)
+}
+
+// DeleteImage godoc
+// @Summary		Delete an item image
+// @Description	Remove an image from an item and delete its file. Requires 'api.master-data.item.update' permission.
+// @Tags			Item
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string	true	"Item UUID"
+// @Param			fileId	path		string	true	"File UUID of the image"
+// @Success		200		{object}	response.NonPaginationResponse	"Successfully deleted item image"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid UUID or the file is not an image of the item"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/item/{id}/images/{fileId} [delete]
+func (h *ItemHandler) DeleteImage(c echo.Context) error {
+	if err := h.Usecase.DeleteImage(c.Request().Context(), c.Param("id"), c.Param("fileId")); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(Response{Message: constants.ItemImageDeleteSuccess})
+	return c.JSON(http.StatusOK, resp)
+}
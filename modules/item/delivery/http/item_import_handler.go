@@ -0,0 +1,164 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/xuri/excelize/v2"
+)
+
+// ImportItems godoc
+// @Summary		Import items from Excel file
+// @Description	Create items from an Excel file (.xlsx or .xls) with columns: item_code (optional, generated when empty), name, backing_code, base_uom (default pcs), units (e.g. "box=12; carton=144"), barcodes (e.g. "8991234567890; 8991234567891:box"), length, width, height (cm), weight (kg), is_active (yes/no, default yes). A file exported from /v1/item/export can be imported as is. Names must be unique in the backing and barcodes must not be used by another item. Valid rows are imported even when other rows fail, failed rows are returned with HTTP 400. Requires 'api.master-data.item.create' permission.
+// @Tags			Item
+// @Accept			multipart/form-data
+// @Produce		json
+// @Security		BearerAuth
+// @Param			file	formData	file	true	"Excel file (.xlsx or .xls) with columns: item_code, name, backing_code, base_uom, units, barcodes, length, width, height, weight, is_active"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.ResImportItems}	"Successfully imported all items"
+// @Failure		400		{object}	response.NonPaginationResponse{data=dto.ResImportItems}	"Bad request - one or more rows failed validation. Response contains details for each row including row number, item name, status, and error message"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500		{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/item/import [post]
+func (h *ItemHandler) ImportItems(c echo.Context) error {
+	tempFilePath, status, err := saveItemImportFile(c, "import_items")
+	if err != nil {
+		return c.JSON(status, response.SetErrorResponse(status, err.Error()))
+	}
+	defer os.Remove(tempFilePath)
+
+	res, err := h.Usecase.ImportFromExcel(c.Request().Context(), tempFilePath, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+
+	if res.FailedCount > 0 {
+		resp.Message = constants.ItemImportFailedPartial
+		if res.SuccessCount == 0 {
+			resp.Message = constants.ItemImportFailed
+		}
+		resp.Status = http.StatusBadRequest
+		return c.JSON(http.StatusBadRequest, resp)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DownloadImportTemplate godoc
+// @Summary		Download item import Excel template
+// @Description	Download Excel template file for importing items. Template contains columns: item_code, name, backing_code, base_uom, units, barcodes, length, width, height, weight, is_active with example data.
+// @Tags			Item
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Success		200	{file}		file	"Excel template file"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500	{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/item/import/template [get]
+func (h *ItemHandler) DownloadImportTemplate(c echo.Context) error {
+	// generated code with units and barcodes, then an explicit code with only the base unit
+	examples := [][]string{
+		{"", "Sampul Plastik A4", "01", "pcs", "box=12; carton=144", "8991234567890; 8991234567891:box", "30", "21", "0.5", "0.02", "yes"},
+		{"SKU-0002", "Sampul Plastik F4", "01", "lembar", "", "", "", "", "", "", "no"},
+	}
+	headers := []string{"Item Code", "Name", "Backing Code", "Base UoM", "Units", "Barcodes", "Length (cm)", "Width (cm)", "Height (cm)", "Weight (kg)", "Active"}
+	widths := []float64{15, 30, 15, 12, 25, 40, 13, 13, 13, 13, 10}
+
+	f := excelize.NewFile()
+	defer f.Close()
+
+	sheetName := "Import Items"
+	f.SetSheetName("Sheet1", sheetName)
+
+	for i, header := range headers {
+		col, _ := excelize.ColumnNumberToName(i + 1)
+		f.SetCellValue(sheetName, col+"1", header)
+		f.SetColWidth(sheetName, col, col, widths[i])
+	}
+
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{
+			Bold: true,
+			Size: 12,
+		},
+		Fill: excelize.Fill{
+			Type:    "pattern",
+			Color:   []string{"#E8E8E8"},
+			Pattern: 1,
+		},
+		Alignment: &excelize.Alignment{
+			Horizontal: "center",
+			Vertical:   "center",
+		},
+	})
+	if err == nil {
+		lastCol, _ := excelize.ColumnNumberToName(len(headers))
+		f.SetCellStyle(sheetName, "A1", lastCol+"1", headerStyle)
+	}
+
+	// codes are text so leading zeros are kept
+	for i, example := range examples {
+		for j, value := range example {
+			cell, _ := excelize.CoordinatesToCellName(j+1, i+2)
+			f.SetCellStr(sheetName, cell, value)
+		}
+	}
+
+	c.Response().Header().Set(constants.FieldContentType, constants.ExcelContent)
+	c.Response().Header().Set(constants.FieldContentDisposition, constants.ExcelContentDisposition("item_import_template.xlsx"))
+
+	if err := f.Write(c.Response().Writer); err != nil {
+		return c.JSON(http.StatusInternalServerError, response.SetErrorResponse(http.StatusInternalServerError, fmt.Sprintf("%s: %v", constants.ItemImportTemplateCreateFailed, err)))
+	}
+
+	return nil
+}
+
+// saveItemImportFile copies the uploaded "file" field to a temporary file keeping its extension,
+// the caller removes the file. The returned status is the one to answer with on error.
+func saveItemImportFile(c echo.Context, prefix string) (string, int, error) {
+	// Get uploaded file
+	file, err := c.FormFile("file")
+	if err != nil {
+		return "", http.StatusBadRequest, errors.New(constants.ItemImportFileNotFound)
+	}
+
+	// Validate file extension
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+	if ext != ".xlsx" && ext != ".xls" {
+		return "", http.StatusBadRequest, errors.New(constants.ItemImportInvalidFileFormat)
+	}
+
+	// Open uploaded file
+	src, err := file.Open()
+	if err != nil {
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.ItemImportFileOpenFailed, err)
+	}
+	defer src.Close()
+
+	tempFilePath := filepath.Join(os.TempDir(), fmt.Sprintf("%s_%d%s", prefix, time.Now().UnixNano(), ext))
+	dst, err := os.Create(tempFilePath)
+	if err != nil {
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.ItemImportFileOpenFailed, err)
+	}
+	defer dst.Close()
+
+	if _, err = io.Copy(dst, src); err != nil {
+		os.Remove(tempFilePath)
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.ItemImportFileOpenFailed, err)
+	}
+
+	return tempFilePath, http.StatusOK, nil
+}
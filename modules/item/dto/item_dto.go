@@ -0,0 +1,197 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/models"
+)
+
+// ItemUnitItem represents a unit of measure with its conversion to the base unit
+type ItemUnitItem struct {
+	Uom        string  `json:"uom" validate:"required,max=20"`
+	Conversion float64 `json:"conversion" validate:"required,gt=0"` // number of base units in one unit
+}
+
+// ItemBarcodeItem represents a barcode printed on the base unit or on one of the units
+type ItemBarcodeItem struct {
+	Barcode string  `json:"barcode" validate:"required,max=100"`
+	Uom     *string `json:"uom" validate:"omitempty,max=20"` // empty for the base unit
+}
+
+type ReqCreateItem struct {
+	ItemCode  string            `json:"item_code" validate:"omitempty,max=255"`
+	Name      string            `json:"name" validate:"required,max=255"`
+	BackingID uuid.UUID         `json:"backing_id" validate:"required"`
+	BaseUom   string            `json:"base_uom" validate:"omitempty,max=20"`
+	Units     []ItemUnitItem    `json:"units" validate:"omitempty,dive"`
+	Barcodes  []ItemBarcodeItem `json:"barcodes" validate:"omitempty,dive"`
+	Length    *float64          `json:"length" validate:"omitempty,gt=0"`
+	Width     *float64          `json:"width" validate:"omitempty,gt=0"`
+	Height    *float64          `json:"height" validate:"omitempty,gt=0"`
+	Weight    *float64          `json:"weight" validate:"omitempty,gt=0"`
+	IsActive  *bool             `json:"is_active"`
+}
+
+type ReqUpdateItem struct {
+	Name      string            `json:"name" validate:"required,max=255"`
+	BackingID uuid.UUID         `json:"backing_id" validate:"required"`
+	BaseUom   string            `json:"base_uom" validate:"omitempty,max=20"`
+	Units     []ItemUnitItem    `json:"units" validate:"omitempty,dive"`
+	Barcodes  []ItemBarcodeItem `json:"barcodes" validate:"omitempty,dive"`
+	Length    *float64          `json:"length" validate:"omitempty,gt=0"`
+	Width     *float64          `json:"width" validate:"omitempty,gt=0"`
+	Height    *float64          `json:"height" validate:"omitempty,gt=0"`
+	Weight    *float64          `json:"weight" validate:"omitempty,gt=0"`
+	IsActive  *bool             `json:"is_active"`
+}
+
+// ItemImageUpload is an uploaded image file
+type ItemImageUpload struct {
+	Data     []byte
+	FileName string
+}
+
+// RespItemReference represents a level of the catalog the item belongs to
+type RespItemReference struct {
+	ID   uuid.UUID `json:"id"`
+	Code string    `json:"code,omitempty"`
+	Name string    `json:"name"`
+}
+
+type RespItemImage struct {
+	FileID   uuid.UUID `json:"file_id"`
+	Name     string    `json:"name"`
+	FilePath *string   `json:"file_path"`
+}
+
+type RespItem struct {
+	ID        uuid.UUID         `json:"id"`
+	ItemCode  string            `json:"item_code"`
+	Name      string            `json:"name"`
+	Backing   RespItemReference `json:"backing"`
+	Type      RespItemReference `json:"type"`
+	Subgroup  RespItemReference `json:"subgroup"`
+	Group     RespItemReference `json:"group"`
+	BaseUom   string            `json:"base_uom"`
+	Units     []ItemUnitItem    `json:"units"`
+	Barcodes  []ItemBarcodeItem `json:"barcodes"`
+	Length    *float64          `json:"length"`
+	Width     *float64          `json:"width"`
+	Height    *float64          `json:"height"`
+	Weight    *float64          `json:"weight"`
+	IsActive  bool              `json:"is_active"`
+	Images    []RespItemImage   `json:"images"`
+	CreatedAt string            `json:"created_at"`
+	CreatedBy string            `json:"created_by"`
+	UpdatedAt string            `json:"updated_at"`
+	UpdatedBy string            `json:"updated_by"`
+}
+
+func ToRespItem(m models.Item, units []models.ItemUnit, barcodes []models.ItemBarcode, images []models.ItemImage) RespItem {
+	respUnits := []ItemUnitItem{}
+	for _, unit := range units {
+		respUnits = append(respUnits, ItemUnitItem{Uom: unit.Uom, Conversion: unit.Conversion})
+	}
+
+	respBarcodes := []ItemBarcodeItem{}
+	for _, barcode := range barcodes {
+		respBarcodes = append(respBarcodes, ItemBarcodeItem{Barcode: barcode.Barcode, Uom: barcode.Uom})
+	}
+
+	respImages := []RespItemImage{}
+	for _, image := range images {
+		respImages = append(respImages, RespItemImage{FileID: image.FileID, Name: image.Name, FilePath: image.FilePath})
+	}
+
+	return RespItem{
+		ID:        m.ID,
+		ItemCode:  m.ItemCode,
+		Name:      m.Name,
+		Backing:   RespItemReference{ID: m.BackingID, Code: m.BackingCode, Name: m.BackingName},
+		Type:      RespItemReference{ID: m.TypeID, Name: m.TypeName},
+		Subgroup:  RespItemReference{ID: m.SubgroupID, Name: m.SubgroupName},
+		Group:     RespItemReference{ID: m.GroupID, Name: m.GroupName},
+		BaseUom:   m.BaseUom,
+		Units:     respUnits,
+		Barcodes:  respBarcodes,
+		Length:    m.Length,
+		Width:     m.Width,
+		Height:    m.Height,
+		Weight:    m.Weight,
+		IsActive:  m.IsActive,
+		Images:    respImages,
+		CreatedAt: m.CreatedAt.Format("2006-01-02 15:04:05"),
+		CreatedBy: m.CreatedBy,
+		UpdatedAt: m.UpdatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedBy: m.UpdatedBy,
+	}
+}
+
+type RespItemIndex struct {
+	ID           uuid.UUID `json:"id"`
+	ItemCode     string    `json:"item_code"`
+	Name         string    `json:"name"`
+	BackingID    uuid.UUID `json:"backing_id"`
+	BackingName  string    `json:"backing_name"`
+	TypeName     string    `json:"type_name"`
+	SubgroupName string    `json:"subgroup_name"`
+	GroupName    string    `json:"group_name"`
+	BaseUom      string    `json:"base_uom"`
+	IsActive     bool      `json:"is_active"`
+	CreatedAt    string    `json:"created_at"`
+	UpdatedAt    string    `json:"updated_at"`
+}
+
+func ToRespItemIndex(m models.Item) RespItemIndex {
+	return RespItemIndex{
+		ID:           m.ID,
+		ItemCode:     m.ItemCode,
+		Name:         m.Name,
+		BackingID:    m.BackingID,
+		BackingName:  m.BackingName,
+		TypeName:     m.TypeName,
+		SubgroupName: m.SubgroupName,
+		GroupName:    m.GroupName,
+		BaseUom:      m.BaseUom,
+		IsActive:     m.IsActive,
+		CreatedAt:    m.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:    m.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// ItemExport represents item data for export with all units and barcodes
+type ItemExport struct {
+	ItemCode     string
+	Name         string
+	BackingCode  string
+	BackingName  string
+	TypeName     string
+	SubgroupName string
+	GroupName    string
+	BaseUom      string
+	Units        []ItemUnitItem
+	Barcodes     []ItemBarcodeItem
+	Length       *float64
+	Width        *float64
+	Height       *float64
+	Weight       *float64
+	IsActive     bool
+	UpdatedAt    time.Time
+}
+
+// ReqItemIndexFilter for filtering item index and export
+type ReqItemIndexFilter struct {
+	Search      string   `query:"search" json:"search"` // Search keyword for filtering by name, item_code and barcodes
+	ItemCodes   []string `query:"item_codes" json:"item_codes"`
+	Names       []string `query:"names" json:"names"`
+	BackingIDs  []string `query:"backing_ids" json:"backing_ids" validate:"omitempty,dive,uuid"`
+	TypeIDs     []string `query:"type_ids" json:"type_ids" validate:"omitempty,dive,uuid"`
+	SubgroupIDs []string `query:"subgroup_ids" json:"subgroup_ids" validate:"omitempty,dive,uuid"`
+	GroupIDs    []string `query:"group_ids" json:"group_ids" validate:"omitempty,dive,uuid"`
+	BaseUoms    []string `query:"base_uoms" json:"base_uoms"`
+	Barcodes    []string `query:"barcodes" json:"barcodes"`
+	IsActive    []bool   `query:"is_active" json:"is_active"`
+	SortBy      string   `query:"sort_by" json:"sort_by"`
+	SortOrder   string   `query:"sort_order" json:"sort_order"`
+}
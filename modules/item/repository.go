@@ -0,0 +1,60 @@
+package item
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/item/dto"
+)
+
+// CreateItemParams contains parameters for creating an item
+type CreateItemParams struct {
+	ItemCode  string // generated from the item code policy when empty
+	Name      string
+	BackingID uuid.UUID
+	BaseUom   string
+	Units     []dto.ItemUnitItem
+	Barcodes  []dto.ItemBarcodeItem
+	Length    *float64
+	Width     *float64
+	Height    *float64
+	Weight    *float64
+	IsActive  bool
+	CreatedBy string
+}
+
+// UpdateItemParams contains parameters for updating an item
+type UpdateItemParams struct {
+	Name      string
+	BackingID uuid.UUID
+	BaseUom   string
+	Units     []dto.ItemUnitItem
+	Barcodes  []dto.ItemBarcodeItem
+	Length    *float64
+	Width     *float64
+	Height    *float64
+	Weight    *float64
+	IsActive  bool
+	UpdatedBy string
+}
+
+type Repository interface {
+	Create(ctx context.Context, params CreateItemParams) (*models.Item, error)
+	BulkCreate(ctx context.Context, params []CreateItemParams) error
+	Update(ctx context.Context, id uuid.UUID, params UpdateItemParams) (*models.Item, error)
+	Delete(ctx context.Context, id uuid.UUID, deletedBy string) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Item, error)
+	GetUnitsByItemID(ctx context.Context, itemID uuid.UUID) ([]models.ItemUnit, error)
+	GetBarcodesByItemID(ctx context.Context, itemID uuid.UUID) ([]models.ItemBarcode, error)
+	GetImagesByItemID(ctx context.Context, itemID uuid.UUID) ([]models.ItemImage, error)
+	RemoveImage(ctx context.Context, itemID uuid.UUID, fileID uuid.UUID) (bool, error)
+	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqItemIndexFilter) ([]models.Item, int, error)
+	GetAllForExport(ctx context.Context, filter dto.ReqItemIndexFilter) ([]dto.ItemExport, error)
+	ExistsByNameInBacking(ctx context.Context, backingID uuid.UUID, name string, excludeID uuid.UUID) (bool, error)
+	ExistsByItemCode(ctx context.Context, itemCode string) (bool, error)
+	ExistsBackingByID(ctx context.Context, backingID uuid.UUID) (bool, error)
+	GetBackingIDByCode(ctx context.Context, backingCode string) (*uuid.UUID, error)
+	GetUsedBarcodes(ctx context.Context, barcodes []string, excludeItemID uuid.UUID) ([]string, error)
+}
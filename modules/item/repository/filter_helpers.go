@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/modules/item/dto"
+	"gorm.io/gorm"
+)
+
+// applyItemFilters applies all filters from ReqItemIndexFilter to the query
+func applyItemFilters(query *gorm.DB, filter dto.ReqItemIndexFilter) *gorm.DB {
+	if len(filter.ItemCodes) > 0 {
+		query = query.Where("it.item_code IN (?)", filter.ItemCodes)
+	}
+	if len(filter.Names) > 0 {
+		query = query.Where("it.name IN (?)", filter.Names)
+	}
+	if len(filter.BackingIDs) > 0 {
+		query = query.Where("it.backing_id IN (?)", filter.BackingIDs)
+	}
+	if len(filter.TypeIDs) > 0 {
+		query = query.Where("b.type_id IN (?)", filter.TypeIDs)
+	}
+	if len(filter.SubgroupIDs) > 0 {
+		query = query.Where("t.subgroup_id IN (?)", filter.SubgroupIDs)
+	}
+	if len(filter.GroupIDs) > 0 {
+		query = query.Where("sg.groups_id IN (?)", filter.GroupIDs)
+	}
+	if len(filter.BaseUoms) > 0 {
+		query = query.Where("it.base_uom IN (?)", filter.BaseUoms)
+	}
+	if len(filter.Barcodes) > 0 {
+		query = query.Where("EXISTS (SELECT 1 FROM item_barcodes ib WHERE ib.item_id = it.id AND ib.barcode IN (?))", filter.Barcodes)
+	}
+	if len(filter.IsActive) > 0 {
+		query = query.Where("it.is_active IN (?)", filter.IsActive)
+	}
+	return query
+}
+
+// ApplyFilters applies filters to the query
+// Implements NeedFilterPredefine interface
+func (r *itemRepository) ApplyFilters(query *gorm.DB, filter interface{}) *gorm.DB {
+	itemFilter, ok := filter.(dto.ReqItemIndexFilter)
+	if !ok {
+		return query
+	}
+
+	return applyItemFilters(query, itemFilter)
+}
+
+// Compile-time check to ensure itemRepository implements NeedFilterPredefine interface
+var _ request.NeedFilterPredefine = (*itemRepository)(nil)
@@ -0,0 +1,460 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/codegen"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/item"
+	"github.com/rendyfutsuy/base-go/modules/item/dto"
+	rsearchitem "github.com/rendyfutsuy/base-go/modules/item/repository/searches"
+	"gorm.io/gorm"
+)
+
+type itemRepository struct {
+	DB *gorm.DB
+}
+
+func NewItemRepository(db *gorm.DB) *itemRepository {
+	return &itemRepository{
+		DB: db,
+	}
+}
+
+// itemQuery joins the backing of an item and the type, sub-group and group it inherits from the backing
+func (r *itemRepository) itemQuery(ctx context.Context) *gorm.DB {
+	return r.DB.WithContext(ctx).Table("items it").
+		Joins("LEFT JOIN backings b ON b.id = it.backing_id AND b.deleted_at IS NULL").
+		Joins("LEFT JOIN types t ON t.id = b.type_id AND t.deleted_at IS NULL").
+		Joins("LEFT JOIN sub_groups sg ON sg.id = t.subgroup_id AND sg.deleted_at IS NULL").
+		Joins("LEFT JOIN groups gg ON gg.id = sg.groups_id AND gg.deleted_at IS NULL").
+		Where("it.deleted_at IS NULL")
+}
+
+const itemCatalogColumns = `
+			b.backing_code as backing_code,
+			b.name as backing_name,
+			b.type_id as type_id,
+			t.name as type_name,
+			t.subgroup_id as subgroup_id,
+			sg.name as subgroup_name,
+			sg.groups_id as groups_id,
+			gg.name as group_name`
+
+// createItemChildren inserts the units and barcodes of an item within the given transaction
+func createItemChildren(tx *gorm.DB, itemID uuid.UUID, unitItems []dto.ItemUnitItem, barcodeItems []dto.ItemBarcodeItem, actor string, now time.Time) error {
+	units := make([]models.ItemUnit, 0, len(unitItems))
+	for _, unit := range unitItems {
+		units = append(units, models.ItemUnit{
+			ItemID:     itemID,
+			Uom:        unit.Uom,
+			Conversion: unit.Conversion,
+			CreatedAt:  now,
+			CreatedBy:  actor,
+		})
+	}
+	if len(units) > 0 {
+		if err := tx.Create(&units).Error; err != nil {
+			return err
+		}
+	}
+
+	barcodes := make([]models.ItemBarcode, 0, len(barcodeItems))
+	for _, barcode := range barcodeItems {
+		barcodes = append(barcodes, models.ItemBarcode{
+			ItemID:    itemID,
+			Barcode:   barcode.Barcode,
+			Uom:       barcode.Uom,
+			CreatedAt: now,
+			CreatedBy: actor,
+		})
+	}
+	if len(barcodes) > 0 {
+		if err := tx.Create(&barcodes).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createItem inserts an item with its units and barcodes, allocating the SKU code when none is given
+func createItem(tx *gorm.DB, params item.CreateItemParams, now time.Time) (*models.Item, error) {
+	it := &models.Item{
+		ItemCode:  params.ItemCode,
+		Name:      params.Name,
+		BackingID: params.BackingID,
+		BaseUom:   params.BaseUom,
+		Length:    params.Length,
+		Width:     params.Width,
+		Height:    params.Height,
+		Weight:    params.Weight,
+		IsActive:  params.IsActive,
+		CreatedAt: now,
+		CreatedBy: params.CreatedBy,
+		UpdatedAt: now,
+		UpdatedBy: params.CreatedBy,
+	}
+
+	if it.ItemCode == "" {
+		code, err := codegen.Allocate(tx, constants.CodeEntityItem, &params.BackingID)
+		if err != nil {
+			return nil, err
+		}
+		it.ItemCode = code
+	}
+	if err := tx.Create(it).Error; err != nil {
+		return nil, err
+	}
+	if it.ID == uuid.Nil {
+		return nil, errors.New(constants.ItemCreateFailedIDNotSet)
+	}
+
+	if err := createItemChildren(tx, it.ID, params.Units, params.Barcodes, params.CreatedBy, now); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+func (r *itemRepository) Create(ctx context.Context, params item.CreateItemParams) (*models.Item, error) {
+	var it *models.Item
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		created, err := createItem(tx, params, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+		it = created
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// BulkCreate inserts all items in one transaction, used by the Excel import
+func (r *itemRepository) BulkCreate(ctx context.Context, params []item.CreateItemParams) error {
+	if len(params) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, p := range params {
+			if _, err := createItem(tx, p, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *itemRepository) Update(ctx context.Context, id uuid.UUID, params item.UpdateItemParams) (*models.Item, error) {
+	now := time.Now().UTC()
+	updates := map[string]interface{}{
+		"name":       params.Name,
+		"backing_id": params.BackingID,
+		"base_uom":   params.BaseUom,
+		"length":     params.Length,
+		"width":      params.Width,
+		"height":     params.Height,
+		"weight":     params.Weight,
+		"is_active":  params.IsActive,
+		"updated_at": now,
+		"updated_by": params.UpdatedBy,
+	}
+
+	it := &models.Item{}
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.Item{}).
+			Where("id = ? AND deleted_at IS NULL", id).
+			Updates(updates).
+			Take(it).Error
+		if err != nil {
+			return err
+		}
+
+		// Always hard delete existing units and barcodes before creating new ones
+		if err := tx.Where("item_id = ?", id).Delete(&models.ItemUnit{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("item_id = ?", id).Delete(&models.ItemBarcode{}).Error; err != nil {
+			return err
+		}
+
+		return createItemChildren(tx, id, params.Units, params.Barcodes, params.UpdatedBy, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+func (r *itemRepository) Delete(ctx context.Context, id uuid.UUID, deletedBy string) error {
+	updates := map[string]interface{}{
+		"deleted_at": time.Now().UTC(),
+		"deleted_by": deletedBy,
+	}
+	return r.DB.WithContext(ctx).Model(&models.Item{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(updates).Error
+}
+
+func (r *itemRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Item, error) {
+	it := &models.Item{}
+	err := r.itemQuery(ctx).
+		Select(`it.*,`+itemCatalogColumns).
+		Where("it.id = ?", id).
+		Scan(it).Error
+	if err != nil {
+		return nil, err
+	}
+	// Scan() doesn't return error for record not found, so check if ID is nil
+	if it.ID == uuid.Nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return it, nil
+}
+
+func (r *itemRepository) GetUnitsByItemID(ctx context.Context, itemID uuid.UUID) ([]models.ItemUnit, error) {
+	var units []models.ItemUnit
+	err := r.DB.WithContext(ctx).
+		Where("item_id = ?", itemID).
+		Order("conversion ASC, uom ASC").
+		Find(&units).Error
+	return units, err
+}
+
+func (r *itemRepository) GetBarcodesByItemID(ctx context.Context, itemID uuid.UUID) ([]models.ItemBarcode, error) {
+	var barcodes []models.ItemBarcode
+	err := r.DB.WithContext(ctx).
+		Where("item_id = ?", itemID).
+		Order("created_at ASC, barcode ASC").
+		Find(&barcodes).Error
+	return barcodes, err
+}
+
+func (r *itemRepository) GetImagesByItemID(ctx context.Context, itemID uuid.UUID) ([]models.ItemImage, error) {
+	var images []models.ItemImage
+	err := r.DB.WithContext(ctx).Table("files_to_module ftm").
+		Select("f.id as file_id, f.name, f.file_path, ftm.created_at").
+		Joins("JOIN files f ON f.id = ftm.file_id AND f.deleted_at IS NULL").
+		Where("ftm.module_type = ? AND ftm.module_id = ? AND ftm.type = ?", constants.ModuleTypeItem, itemID, constants.FileTypeItemImage).
+		Order("ftm.created_at ASC").
+		Scan(&images).Error
+	return images, err
+}
+
+// RemoveImage detaches an image from the item, it reports false when the file is not an image of the item
+func (r *itemRepository) RemoveImage(ctx context.Context, itemID uuid.UUID, fileID uuid.UUID) (bool, error) {
+	res := r.DB.WithContext(ctx).
+		Where("module_type = ? AND module_id = ? AND file_id = ? AND type = ?", constants.ModuleTypeItem, itemID, fileID, constants.FileTypeItemImage).
+		Delete(&models.FilesToModule{})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (r *itemRepository) ExistsByNameInBacking(ctx context.Context, backingID uuid.UUID, name string, excludeID uuid.UUID) (bool, error) {
+	var count int64
+	q := r.DB.WithContext(ctx).Unscoped().Model(&models.Item{}).Where("backing_id = ? AND name = ?", backingID, name)
+	if excludeID != uuid.Nil {
+		q = q.Where("id <> ?", excludeID)
+	}
+	if err := q.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *itemRepository) ExistsByItemCode(ctx context.Context, itemCode string) (bool, error) {
+	var count int64
+	// codes are unique across soft-deleted items too
+	if err := r.DB.WithContext(ctx).Unscoped().Model(&models.Item{}).
+		Where("item_code = ?", itemCode).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *itemRepository) ExistsBackingByID(ctx context.Context, backingID uuid.UUID) (bool, error) {
+	var count int64
+	if err := r.DB.WithContext(ctx).Model(&models.Backing{}).
+		Where("id = ? AND deleted_at IS NULL", backingID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetBackingIDByCode returns the id of the active backing with the code, nil when there is none
+func (r *itemRepository) GetBackingIDByCode(ctx context.Context, backingCode string) (*uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := r.DB.WithContext(ctx).Model(&models.Backing{}).
+		Where("backing_code = ? AND deleted_at IS NULL", backingCode).
+		Limit(1).
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return &ids[0], nil
+}
+
+// GetUsedBarcodes returns the given barcodes already used by another active item
+func (r *itemRepository) GetUsedBarcodes(ctx context.Context, barcodes []string, excludeItemID uuid.UUID) ([]string, error) {
+	used := make([]string, 0)
+	if len(barcodes) == 0 {
+		return used, nil
+	}
+
+	q := r.DB.WithContext(ctx).Table("item_barcodes ib").
+		Joins("JOIN items it ON it.id = ib.item_id AND it.deleted_at IS NULL").
+		Where("ib.barcode IN (?)", barcodes)
+	if excludeItemID != uuid.Nil {
+		q = q.Where("ib.item_id <> ?", excludeItemID)
+	}
+	if err := q.Distinct().Order("ib.barcode").Pluck("ib.barcode", &used).Error; err != nil {
+		return nil, err
+	}
+	return used, nil
+}
+
+func (r *itemRepository) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqItemIndexFilter) ([]models.Item, int, error) {
+	var items []models.Item
+	query := r.itemQuery(ctx).
+		Select(`
+			it.id,
+			it.item_code,
+			it.name,
+			it.backing_id,
+			it.base_uom,
+			it.is_active,
+			it.created_at,
+			it.updated_at,` + itemCatalogColumns)
+
+	// Apply search from PageRequest
+	query = request.ApplySearchConditionFromInterface(query, req.Search, rsearchitem.NewItemSearchHelper())
+
+	// Apply filters with multiple values support
+	query = r.ApplyFilters(query, filter)
+
+	// Pagination
+	total, err := request.ApplyPagination(query, req, request.PaginationConfig{
+		DefaultSortBy:      "it.created_at",
+		DefaultSortOrder:   "DESC",
+		MaxPerPage:         100,
+		SortMapping:        mapItemIndexSortColumn,
+		NaturalSortColumns: []string{"it.name", "it.item_code"}, // Enable natural sorting for name and SKU code
+	}, &items)
+	if err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
+
+func (r *itemRepository) GetAllForExport(ctx context.Context, filter dto.ReqItemIndexFilter) ([]dto.ItemExport, error) {
+	// First, get all items
+	var itemsBase []models.Item
+	query := r.itemQuery(ctx).
+		Select(`
+			it.id,
+			it.item_code,
+			it.name,
+			it.base_uom,
+			it.length,
+			it.width,
+			it.height,
+			it.weight,
+			it.is_active,
+			it.updated_at,` + itemCatalogColumns)
+
+	// Apply search from filter
+	query = request.ApplySearchConditionFromInterface(query, filter.Search, rsearchitem.NewItemSearchHelper())
+
+	// Apply filters with multiple values support
+	query = r.ApplyFilters(query, filter)
+
+	// Determine sorting with natural sorting support
+	sortExpression := request.BuildSortExpressionForExport(
+		filter.SortBy,
+		filter.SortOrder,
+		"it.created_at",
+		"DESC",
+		mapItemIndexSortColumn,
+		[]string{"it.name", "it.item_code"},
+	)
+
+	// Order results
+	if err := query.Order(sortExpression).Find(&itemsBase).Error; err != nil {
+		return nil, err
+	}
+
+	itemIDs := make([]uuid.UUID, len(itemsBase))
+	for i, it := range itemsBase {
+		itemIDs[i] = it.ID
+	}
+
+	// Fetch all units and barcodes of the items
+	var units []models.ItemUnit
+	var barcodes []models.ItemBarcode
+	if len(itemIDs) > 0 {
+		if err := r.DB.WithContext(ctx).
+			Where("item_id IN (?)", itemIDs).
+			Order("conversion ASC, uom ASC").
+			Find(&units).Error; err != nil {
+			return nil, err
+		}
+		if err := r.DB.WithContext(ctx).
+			Where("item_id IN (?)", itemIDs).
+			Order("created_at ASC, barcode ASC").
+			Find(&barcodes).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	unitsMap := make(map[uuid.UUID][]dto.ItemUnitItem)
+	for _, unit := range units {
+		unitsMap[unit.ItemID] = append(unitsMap[unit.ItemID], dto.ItemUnitItem{Uom: unit.Uom, Conversion: unit.Conversion})
+	}
+	barcodesMap := make(map[uuid.UUID][]dto.ItemBarcodeItem)
+	for _, barcode := range barcodes {
+		barcodesMap[barcode.ItemID] = append(barcodesMap[barcode.ItemID], dto.ItemBarcodeItem{Barcode: barcode.Barcode, Uom: barcode.Uom})
+	}
+
+	// Map to ItemExport
+	items := make([]dto.ItemExport, len(itemsBase))
+	for i, it := range itemsBase {
+		items[i] = dto.ItemExport{
+			ItemCode:     it.ItemCode,
+			Name:         it.Name,
+			BackingCode:  it.BackingCode,
+			BackingName:  it.BackingName,
+			TypeName:     it.TypeName,
+			SubgroupName: it.SubgroupName,
+			GroupName:    it.GroupName,
+			BaseUom:      it.BaseUom,
+			Units:        unitsMap[it.ID],
+			Barcodes:     barcodesMap[it.ID],
+			Length:       it.Length,
+			Width:        it.Width,
+			Height:       it.Height,
+			Weight:       it.Weight,
+			IsActive:     it.IsActive,
+			UpdatedAt:    it.UpdatedAt,
+		}
+	}
+
+	return items, nil
+}
+
+// Implement item.Repository interface
+var _ item.Repository = (*itemRepository)(nil)
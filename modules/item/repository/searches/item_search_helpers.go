@@ -0,0 +1,26 @@
+package searches
+
+import "github.com/rendyfutsuy/base-go/helpers/request"
+
+// initialize, value for search and map the function & variable need for it
+type ItemSearchHelper struct{ request.SearchPredefineBase }
+
+func (ItemSearchHelper) GetSearchColumns() []string {
+	return []string{
+		"it.item_code",
+		"it.name",
+		"b.name",
+	}
+}
+
+func (ItemSearchHelper) GetSearchExistsSubqueries() []string {
+	return []string{
+		"EXISTS (SELECT 1 FROM item_barcodes ib WHERE ib.item_id = it.id AND REPLACE(ib.barcode, ' ', '') ILIKE ?)",
+	}
+}
+
+var _ request.NeedSearchPredefine = ItemSearchHelper{}
+
+func NewItemSearchHelper() ItemSearchHelper {
+	return ItemSearchHelper{SearchPredefineBase: request.SearchPredefineBase{Threshold: nil}}
+}
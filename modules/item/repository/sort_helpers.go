@@ -0,0 +1,38 @@
+package repository
+
+import "strings"
+
+func normalizeItemSortKey(sortBy string) string {
+	sortBy = strings.TrimSpace(sortBy)
+	if sortBy == "" {
+		return ""
+	}
+	sortBy = strings.ReplaceAll(sortBy, "-", "_")
+	sortBy = strings.ReplaceAll(sortBy, " ", "_")
+	return strings.ToLower(sortBy)
+}
+
+func mapItemIndexSortColumn(sortBy string) string {
+	normalized := normalizeItemSortKey(sortBy)
+	if normalized == "" {
+		return ""
+	}
+
+	mapping := map[string]string{
+		"id":            "it.id",
+		"item_id":       "it.id",
+		"item_code":     "it.item_code",
+		"name":          "it.name",
+		"item_name":     "it.name",
+		"backing_name":  "b.name",
+		"type_name":     "t.name",
+		"subgroup_name": "sg.name",
+		"group_name":    "gg.name",
+		"base_uom":      "it.base_uom",
+		"is_active":     "it.is_active",
+		"created_at":    "it.created_at",
+		"updated_at":    "it.updated_at",
+	}
+
+	return mapping[normalized]
+}
@@ -0,0 +1,518 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	fileDto "github.com/rendyfutsuy/base-go/modules/file/dto"
+	itemMod "github.com/rendyfutsuy/base-go/modules/item"
+	itemDto "github.com/rendyfutsuy/base-go/modules/item/dto"
+	"github.com/rendyfutsuy/base-go/modules/item/usecase"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// MockItemRepository is a mock implementation of item.Repository
+type MockItemRepository struct {
+	mock.Mock
+}
+
+func (m *MockItemRepository) Create(ctx context.Context, params itemMod.CreateItemParams) (*models.Item, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) BulkCreate(ctx context.Context, params []itemMod.CreateItemParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+
+func (m *MockItemRepository) Update(ctx context.Context, id uuid.UUID, params itemMod.UpdateItemParams) (*models.Item, error) {
+	args := m.Called(ctx, id, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) Delete(ctx context.Context, id uuid.UUID, deletedBy string) error {
+	args := m.Called(ctx, id, deletedBy)
+	return args.Error(0)
+}
+
+func (m *MockItemRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Item, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) GetUnitsByItemID(ctx context.Context, itemID uuid.UUID) ([]models.ItemUnit, error) {
+	args := m.Called(ctx, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ItemUnit), args.Error(1)
+}
+
+func (m *MockItemRepository) GetBarcodesByItemID(ctx context.Context, itemID uuid.UUID) ([]models.ItemBarcode, error) {
+	args := m.Called(ctx, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ItemBarcode), args.Error(1)
+}
+
+func (m *MockItemRepository) GetImagesByItemID(ctx context.Context, itemID uuid.UUID) ([]models.ItemImage, error) {
+	args := m.Called(ctx, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ItemImage), args.Error(1)
+}
+
+func (m *MockItemRepository) RemoveImage(ctx context.Context, itemID uuid.UUID, fileID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, itemID, fileID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockItemRepository) GetIndex(ctx context.Context, req request.PageRequest, filter itemDto.ReqItemIndexFilter) ([]models.Item, int, error) {
+	args := m.Called(ctx, req, filter)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]models.Item), args.Int(1), args.Error(2)
+}
+
+func (m *MockItemRepository) GetAllForExport(ctx context.Context, filter itemDto.ReqItemIndexFilter) ([]itemDto.ItemExport, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]itemDto.ItemExport), args.Error(1)
+}
+
+func (m *MockItemRepository) ExistsByNameInBacking(ctx context.Context, backingID uuid.UUID, name string, excludeID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, backingID, name, excludeID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockItemRepository) ExistsByItemCode(ctx context.Context, itemCode string) (bool, error) {
+	args := m.Called(ctx, itemCode)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockItemRepository) ExistsBackingByID(ctx context.Context, backingID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, backingID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockItemRepository) GetBackingIDByCode(ctx context.Context, backingCode string) (*uuid.UUID, error) {
+	args := m.Called(ctx, backingCode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*uuid.UUID), args.Error(1)
+}
+
+func (m *MockItemRepository) GetUsedBarcodes(ctx context.Context, barcodes []string, excludeItemID uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, barcodes, excludeItemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// MockFileUsecase is a mock implementation of the file usecase
+type MockFileUsecase struct {
+	mock.Mock
+}
+
+func (m *MockFileUsecase) Upload(ctx context.Context, input fileDto.UploadInput) (*models.File, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.File), args.Error(1)
+}
+
+func (m *MockFileUsecase) AssignFiles(ctx context.Context, input fileDto.AssignFilesToModule) error {
+	args := m.Called(ctx, input)
+	return args.Error(0)
+}
+
+func (m *MockFileUsecase) UnassignFiles(ctx context.Context, input fileDto.UnassignFilesFromModule) error {
+	args := m.Called(ctx, input)
+	return args.Error(0)
+}
+
+func (m *MockFileUsecase) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func TestCreateItem(t *testing.T) {
+	ctx := context.Background()
+	backingID := uuid.New()
+	box := "box"
+	pcs := "PCS"
+	length := 30.0
+
+	tests := []struct {
+		name          string
+		req           *itemDto.ReqCreateItem
+		setupMock     func(*MockItemRepository)
+		expectedError error
+	}{
+		{
+			name: "success create item with generated code, units and barcodes",
+			req: &itemDto.ReqCreateItem{
+				Name:      "Sampul Plastik A4",
+				BackingID: backingID,
+				Units:     []itemDto.ItemUnitItem{{Uom: " box ", Conversion: 12}},
+				Barcodes:  []itemDto.ItemBarcodeItem{{Barcode: "8991234567890", Uom: &pcs}, {Barcode: "8991234567891", Uom: &box}},
+				Length:    &length,
+			},
+			setupMock: func(m *MockItemRepository) {
+				m.On("ExistsBackingByID", mock.Anything, backingID).Return(true, nil).Once()
+				m.On("ExistsByNameInBacking", mock.Anything, backingID, "Sampul Plastik A4", uuid.Nil).Return(false, nil).Once()
+				m.On("GetUsedBarcodes", mock.Anything, []string{"8991234567890", "8991234567891"}, uuid.Nil).Return([]string{}, nil).Once()
+				m.On("Create", mock.Anything, itemMod.CreateItemParams{
+					Name:      "Sampul Plastik A4",
+					BackingID: backingID,
+					BaseUom:   constants.ItemDefaultBaseUom,
+					Units:     []itemDto.ItemUnitItem{{Uom: "box", Conversion: 12}},
+					Barcodes:  []itemDto.ItemBarcodeItem{{Barcode: "8991234567890"}, {Barcode: "8991234567891", Uom: &box}},
+					Length:    &length,
+					IsActive:  true,
+					CreatedBy: "test-auth-id",
+				}).Return(&models.Item{ID: uuid.New(), ItemCode: "010101010001", Name: "Sampul Plastik A4"}, nil).Once()
+			},
+		},
+		{
+			name: "error when item code already exists",
+			req:  &itemDto.ReqCreateItem{ItemCode: "SKU-0001", Name: "Sampul Plastik A4", BackingID: backingID},
+			setupMock: func(m *MockItemRepository) {
+				m.On("ExistsByItemCode", mock.Anything, "SKU-0001").Return(true, nil).Once()
+			},
+			expectedError: fmt.Errorf(constants.ItemCodeAlreadyExists, "SKU-0001"),
+		},
+		{
+			name: "error when backing does not exist",
+			req:  &itemDto.ReqCreateItem{Name: "Sampul Plastik A4", BackingID: backingID},
+			setupMock: func(m *MockItemRepository) {
+				m.On("ExistsBackingByID", mock.Anything, backingID).Return(false, nil).Once()
+			},
+			expectedError: errors.New(constants.ItemBackingNotFound),
+		},
+		{
+			name: "error when name already exists in backing",
+			req:  &itemDto.ReqCreateItem{Name: "Sampul Plastik A4", BackingID: backingID},
+			setupMock: func(m *MockItemRepository) {
+				m.On("ExistsBackingByID", mock.Anything, backingID).Return(true, nil).Once()
+				m.On("ExistsByNameInBacking", mock.Anything, backingID, "Sampul Plastik A4", uuid.Nil).Return(true, nil).Once()
+			},
+			expectedError: errors.New(constants.ItemNameAlreadyExistsInBacking),
+		},
+		{
+			name: "error when barcode is used by another item",
+			req:  &itemDto.ReqCreateItem{Name: "Sampul Plastik A4", BackingID: backingID, Barcodes: []itemDto.ItemBarcodeItem{{Barcode: "8991234567890"}}},
+			setupMock: func(m *MockItemRepository) {
+				m.On("ExistsBackingByID", mock.Anything, backingID).Return(true, nil).Once()
+				m.On("ExistsByNameInBacking", mock.Anything, backingID, "Sampul Plastik A4", uuid.Nil).Return(false, nil).Once()
+				m.On("GetUsedBarcodes", mock.Anything, []string{"8991234567890"}, uuid.Nil).Return([]string{"8991234567890"}, nil).Once()
+			},
+			expectedError: fmt.Errorf(constants.ItemBarcodeAlreadyUsed, "8991234567890"),
+		},
+		{
+			name:          "error when unit is the base unit",
+			req:           &itemDto.ReqCreateItem{Name: "Sampul Plastik A4", BackingID: backingID, Units: []itemDto.ItemUnitItem{{Uom: "PCS", Conversion: 1}}},
+			setupMock:     func(m *MockItemRepository) {},
+			expectedError: fmt.Errorf(constants.ItemUnitIsBaseUnit, "PCS"),
+		},
+		{
+			name:          "error when unit is listed twice",
+			req:           &itemDto.ReqCreateItem{Name: "Sampul Plastik A4", BackingID: backingID, Units: []itemDto.ItemUnitItem{{Uom: "box", Conversion: 12}, {Uom: "Box", Conversion: 10}}},
+			setupMock:     func(m *MockItemRepository) {},
+			expectedError: fmt.Errorf(constants.ItemUnitDuplicated, "Box"),
+		},
+		{
+			name:          "error when barcode unit is not a unit of the item",
+			req:           &itemDto.ReqCreateItem{Name: "Sampul Plastik A4", BackingID: backingID, Barcodes: []itemDto.ItemBarcodeItem{{Barcode: "8991234567891", Uom: &box}}},
+			setupMock:     func(m *MockItemRepository) {},
+			expectedError: fmt.Errorf(constants.ItemBarcodeUnitNotFound, "8991234567891", "box"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockItemRepository)
+			tt.setupMock(mockRepo)
+			uc := usecase.NewItemUsecase(mockRepo, new(MockFileUsecase))
+
+			result, err := uc.Create(ctx, tt.req, "test-auth-id")
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "010101010001", result.ItemCode)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateItem(t *testing.T) {
+	ctx := context.Background()
+	itemID := uuid.New()
+	backingID := uuid.New()
+
+	tests := []struct {
+		name          string
+		id            string
+		req           *itemDto.ReqUpdateItem
+		setupMock     func(*MockItemRepository)
+		expectedError error
+	}{
+		{
+			name: "success update item keeps the active flag when not sent",
+			id:   itemID.String(),
+			req:  &itemDto.ReqUpdateItem{Name: "Sampul Plastik F4", BackingID: backingID, BaseUom: "lembar"},
+			setupMock: func(m *MockItemRepository) {
+				m.On("GetByID", mock.Anything, itemID).Return(&models.Item{ID: itemID, IsActive: false}, nil).Once()
+				m.On("ExistsBackingByID", mock.Anything, backingID).Return(true, nil).Once()
+				m.On("ExistsByNameInBacking", mock.Anything, backingID, "Sampul Plastik F4", itemID).Return(false, nil).Once()
+				m.On("GetUsedBarcodes", mock.Anything, []string{}, itemID).Return([]string{}, nil).Once()
+				m.On("Update", mock.Anything, itemID, itemMod.UpdateItemParams{
+					Name:      "Sampul Plastik F4",
+					BackingID: backingID,
+					BaseUom:   "lembar",
+					Units:     []itemDto.ItemUnitItem{},
+					Barcodes:  []itemDto.ItemBarcodeItem{},
+					IsActive:  false,
+					UpdatedBy: "test-auth-id",
+				}).Return(&models.Item{ID: itemID, Name: "Sampul Plastik F4"}, nil).Once()
+			},
+		},
+		{
+			name: "error when item not found",
+			id:   itemID.String(),
+			req:  &itemDto.ReqUpdateItem{Name: "Sampul Plastik F4", BackingID: backingID},
+			setupMock: func(m *MockItemRepository) {
+				m.On("GetByID", mock.Anything, itemID).Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: fmt.Errorf(constants.ItemNotFound, itemID.String()),
+		},
+		{
+			name:          "error when id is not a uuid",
+			id:            "invalid-uuid",
+			req:           &itemDto.ReqUpdateItem{Name: "Sampul Plastik F4", BackingID: backingID},
+			setupMock:     func(m *MockItemRepository) {},
+			expectedError: errors.New("requested param is string"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockItemRepository)
+			tt.setupMock(mockRepo)
+			uc := usecase.NewItemUsecase(mockRepo, new(MockFileUsecase))
+
+			result, err := uc.Update(ctx, tt.id, tt.req, "test-auth-id")
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "Sampul Plastik F4", result.Name)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteItem(t *testing.T) {
+	ctx := context.Background()
+	itemID := uuid.New()
+
+	mockRepo := new(MockItemRepository)
+	mockRepo.On("Delete", mock.Anything, itemID, "test-auth-id").Return(nil).Once()
+	uc := usecase.NewItemUsecase(mockRepo, new(MockFileUsecase))
+
+	assert.NoError(t, uc.Delete(ctx, itemID.String(), "test-auth-id"))
+	assert.Error(t, uc.Delete(ctx, "invalid-uuid", "test-auth-id"))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestItemImages(t *testing.T) {
+	ctx := context.Background()
+	itemID := uuid.New()
+	fileID := uuid.New()
+
+	t.Run("success upload assigns the files as image", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockFileUC := new(MockFileUsecase)
+		mockRepo.On("GetByID", mock.Anything, itemID).Return(&models.Item{ID: itemID}, nil).Once()
+		mockFileUC.On("Upload", mock.Anything, mock.MatchedBy(func(input fileDto.UploadInput) bool {
+			return input.DestRoot == "items/images" && input.OriginalFileName == "front.png" && *input.ExtraPath == itemID.String()
+		})).Return(&models.File{ID: fileID}, nil).Once()
+		typ := constants.FileTypeItemImage
+		mockFileUC.On("AssignFiles", mock.Anything, fileDto.AssignFilesToModule{
+			ModuleID:   itemID,
+			ModuleType: constants.ModuleTypeItem,
+			Items:      []fileDto.AssignFileItem{{FileID: fileID, Type: &typ}},
+		}).Return(nil).Once()
+
+		err := usecase.NewItemUsecase(mockRepo, mockFileUC).UploadImages(ctx, itemID.String(), []itemDto.ItemImageUpload{{Data: []byte("png"), FileName: "front.png"}})
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockFileUC.AssertExpectations(t)
+	})
+
+	t.Run("error when no image is uploaded", func(t *testing.T) {
+		err := usecase.NewItemUsecase(new(MockItemRepository), new(MockFileUsecase)).UploadImages(ctx, itemID.String(), nil)
+		assert.EqualError(t, err, constants.ItemImageRequired)
+	})
+
+	t.Run("success delete removes the image and its file", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockFileUC := new(MockFileUsecase)
+		mockRepo.On("RemoveImage", mock.Anything, itemID, fileID).Return(true, nil).Once()
+		mockFileUC.On("Delete", mock.Anything, fileID.String()).Return(nil).Once()
+
+		require.NoError(t, usecase.NewItemUsecase(mockRepo, mockFileUC).DeleteImage(ctx, itemID.String(), fileID.String()))
+		mockRepo.AssertExpectations(t)
+		mockFileUC.AssertExpectations(t)
+	})
+
+	t.Run("error when the file is not an image of the item", func(t *testing.T) {
+		mockRepo := new(MockItemRepository)
+		mockRepo.On("RemoveImage", mock.Anything, itemID, fileID).Return(false, nil).Once()
+
+		err := usecase.NewItemUsecase(mockRepo, new(MockFileUsecase)).DeleteImage(ctx, itemID.String(), fileID.String())
+		assert.EqualError(t, err, fmt.Sprintf(constants.ItemImageNotFound, fileID.String()))
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestExportItem(t *testing.T) {
+	ctx := context.Background()
+	box := "box"
+	length := 30.0
+
+	mockRepo := new(MockItemRepository)
+	mockRepo.On("GetAllForExport", ctx, itemDto.ReqItemIndexFilter{}).Return([]itemDto.ItemExport{
+		{
+			ItemCode:     "010101010001",
+			Name:         "Sampul Plastik A4",
+			BackingCode:  "01010101",
+			BackingName:  "PUTIH",
+			TypeName:     "POLOS",
+			SubgroupName: "SAMPUL",
+			GroupName:    "ATK",
+			BaseUom:      "pcs",
+			Units:        []itemDto.ItemUnitItem{{Uom: "box", Conversion: 12}, {Uom: "carton", Conversion: 144}},
+			Barcodes:     []itemDto.ItemBarcodeItem{{Barcode: "8991234567890"}, {Barcode: "8991234567891", Uom: &box}},
+			Length:       &length,
+			IsActive:     true,
+			UpdatedAt:    time.Now(),
+		},
+	}, nil).Once()
+
+	result, err := usecase.NewItemUsecase(mockRepo, new(MockFileUsecase)).Export(ctx, itemDto.ReqItemIndexFilter{})
+	require.NoError(t, err)
+
+	f, err := excelize.OpenReader(bytes.NewReader(result))
+	require.NoError(t, err)
+	rows, err := f.GetRows("Items")
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "Item Code", rows[0][0])
+	assert.Equal(t, []string{"010101010001", "Sampul Plastik A4", "01010101", "pcs", "box=12; carton=144", "8991234567890; 8991234567891:box", "30", "-", "-", "-", "yes", "PUTIH", "POLOS", "SAMPUL", "ATK"}, rows[1][:15])
+	mockRepo.AssertExpectations(t)
+}
+
+// writeItemImportFile writes an import file with a header row and the given rows
+func writeItemImportFile(t *testing.T, rows [][]string) string {
+	f := excelize.NewFile()
+	defer f.Close()
+	require.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]string{"Item Code", "Name", "Backing Code", "Base UoM", "Units", "Barcodes", "Length (cm)", "Width (cm)", "Height (cm)", "Weight (kg)", "Active"}))
+	for i, row := range rows {
+		cell, _ := excelize.CoordinatesToCellName(1, i+2)
+		require.NoError(t, f.SetSheetRow("Sheet1", cell, &row))
+	}
+	path := filepath.Join(t.TempDir(), "items.xlsx")
+	require.NoError(t, f.SaveAs(path))
+	return path
+}
+
+func TestImportItems(t *testing.T) {
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+	ctx := context.Background()
+	backingID := uuid.New()
+	box := "box"
+
+	path := writeItemImportFile(t, [][]string{
+		{"", "Sampul Plastik A4", "01", "pcs", "box=12", "8991234567890; 8991234567891:box", "30", "", "", "", "yes"},
+		{"", "Sampul Plastik A4", "01", "", "", "", "", "", "", "", ""},
+		{"", "Sampul Plastik F4", "99", "", "box", "", "", "", "", "", "maybe"},
+	})
+	defer os.Remove(path)
+
+	mockRepo := new(MockItemRepository)
+	mockRepo.On("GetBackingIDByCode", ctx, "01").Return(&backingID, nil).Once()
+	mockRepo.On("GetBackingIDByCode", ctx, "99").Return(nil, nil).Once()
+	mockRepo.On("ExistsByNameInBacking", ctx, backingID, "Sampul Plastik A4", uuid.Nil).Return(false, nil).Once()
+	mockRepo.On("GetUsedBarcodes", ctx, []string{"8991234567890", "8991234567891"}, uuid.Nil).Return([]string{}, nil).Once()
+	length := 30.0
+	mockRepo.On("BulkCreate", ctx, []itemMod.CreateItemParams{
+		{
+			Name:      "Sampul Plastik A4",
+			BackingID: backingID,
+			BaseUom:   "pcs",
+			Units:     []itemDto.ItemUnitItem{{Uom: "box", Conversion: 12}},
+			Barcodes:  []itemDto.ItemBarcodeItem{{Barcode: "8991234567890"}, {Barcode: "8991234567891", Uom: &box}},
+			Length:    &length,
+			IsActive:  true,
+			CreatedBy: "test-auth-id",
+		},
+	}).Return(nil).Once()
+
+	res, err := usecase.NewItemUsecase(mockRepo, new(MockFileUsecase)).ImportFromExcel(ctx, path, "test-auth-id")
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, res.TotalRows)
+	assert.Equal(t, 1, res.SuccessCount)
+	assert.Equal(t, 2, res.FailedCount)
+	assert.Equal(t, "success", res.Results[0].Status)
+	assert.Equal(t, fmt.Sprintf(constants.ItemImportRowDuplicated, 2), res.Results[1].ErrorMessage)
+	assert.Contains(t, res.Results[2].ErrorMessage, fmt.Sprintf(constants.ItemImportBackingNotFound, "99"))
+	assert.Contains(t, res.Results[2].ErrorMessage, fmt.Sprintf(constants.ItemImportUnitInvalid, "box"))
+	assert.Contains(t, res.Results[2].ErrorMessage, constants.ItemImportIsActiveInvalid)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,473 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/middleware"
+	reqMw "github.com/rendyfutsuy/base-go/helpers/middleware/request"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	itemMod "github.com/rendyfutsuy/base-go/modules/item"
+	itemHttp "github.com/rendyfutsuy/base-go/modules/item/delivery/http"
+	"github.com/rendyfutsuy/base-go/modules/item/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockItemUsecase struct {
+	mock.Mock
+}
+
+func (m *mockItemUsecase) Create(ctx context.Context, req *dto.ReqCreateItem, authId string) (*models.Item, error) {
+	args := m.Called(ctx, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Item), args.Error(1)
+}
+
+func (m *mockItemUsecase) Update(ctx context.Context, id string, req *dto.ReqUpdateItem, authId string) (*models.Item, error) {
+	args := m.Called(ctx, id, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Item), args.Error(1)
+}
+
+func (m *mockItemUsecase) Delete(ctx context.Context, id string, authId string) error {
+	args := m.Called(ctx, id, authId)
+	return args.Error(0)
+}
+
+func (m *mockItemUsecase) GetByID(ctx context.Context, id string) (*models.Item, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Item), args.Error(1)
+}
+
+func (m *mockItemUsecase) GetUnitsByItemID(ctx context.Context, id string) ([]models.ItemUnit, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ItemUnit), args.Error(1)
+}
+
+func (m *mockItemUsecase) GetBarcodesByItemID(ctx context.Context, id string) ([]models.ItemBarcode, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ItemBarcode), args.Error(1)
+}
+
+func (m *mockItemUsecase) GetImagesByItemID(ctx context.Context, id string) ([]models.ItemImage, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ItemImage), args.Error(1)
+}
+
+func (m *mockItemUsecase) UploadImages(ctx context.Context, id string, images []dto.ItemImageUpload) error {
+	args := m.Called(ctx, id, images)
+	return args.Error(0)
+}
+
+func (m *mockItemUsecase) DeleteImage(ctx context.Context, id string, fileID string) error {
+	args := m.Called(ctx, id, fileID)
+	return args.Error(0)
+}
+
+func (m *mockItemUsecase) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqItemIndexFilter) ([]models.Item, int, error) {
+	args := m.Called(ctx, req, filter)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]models.Item), args.Int(1), args.Error(2)
+}
+
+func (m *mockItemUsecase) Export(ctx context.Context, filter dto.ReqItemIndexFilter) ([]byte, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *mockItemUsecase) ImportFromExcel(ctx context.Context, filePath string, authId string) (*dto.ResImportItems, error) {
+	args := m.Called(ctx, filePath, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ResImportItems), args.Error(1)
+}
+
+type mockMiddlewareAuth struct {
+	mock.Mock
+}
+
+func (m *mockMiddlewareAuth) AuthorizationCheck(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return next(c)
+	}
+}
+
+type mockMiddlewarePermission struct {
+	mock.Mock
+}
+
+func (m *mockMiddlewarePermission) PermissionValidation(args []string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			return next(c)
+		}
+	}
+}
+
+type mockMiddlewarePageRequest struct {
+	mock.Mock
+}
+
+func (m *mockMiddlewarePageRequest) PageRequestCtx(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		pageReq := &request.PageRequest{
+			Page:      1,
+			PerPage:   10,
+			SortBy:    "id",
+			SortOrder: "desc",
+		}
+		c.Set("page_request", pageReq)
+		return next(c)
+	}
+}
+
+func (m *mockMiddlewarePageRequest) PageRequestCtxWithoutLimitation(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return next(c)
+	}
+}
+
+type customValidator struct {
+	validator *validator.Validate
+}
+
+func (cv *customValidator) Validate(i interface{}) error {
+	return utils.ValidateRequest(i, cv.validator)
+}
+
+func newEcho() *echo.Echo {
+	e := echo.New()
+	v := validator.New()
+	utils.RegisterCustomValidator(v)
+	e.Validator = &customValidator{validator: v}
+	return e
+}
+
+func newItemHandler(mockUC itemMod.Usecase, mockAuthMw middleware.IMiddlewareAuth, mockPermMw middleware.IMiddlewarePermission, mockPageReqMw reqMw.IMiddlewarePageRequest) *itemHttp.ItemHandler {
+	handler := &itemHttp.ItemHandler{
+		Usecase: mockUC,
+	}
+	val := reflect.ValueOf(handler).Elem()
+
+	authField := val.FieldByName("middlewareAuth")
+	if authField.IsValid() && authField.CanSet() {
+		authField.Set(reflect.ValueOf(mockAuthMw))
+	}
+
+	permField := val.FieldByName("middlewarePermission")
+	if permField.IsValid() && permField.CanSet() {
+		permField.Set(reflect.ValueOf(mockPermMw))
+	}
+
+	pageReqField := val.FieldByName("mwPageRequest")
+	if pageReqField.IsValid() && pageReqField.CanSet() {
+		pageReqField.Set(reflect.ValueOf(mockPageReqMw))
+	}
+
+	return handler
+}
+
+// expectItemDetail sets up the calls used to answer with the item detail
+func expectItemDetail(mockUC *mockItemUsecase, item *models.Item) {
+	id := item.ID.String()
+	mockUC.On("GetByID", mock.Anything, id).Return(item, nil).Once()
+	mockUC.On("GetUnitsByItemID", mock.Anything, id).Return([]models.ItemUnit{{Uom: "box", Conversion: 12}}, nil).Once()
+	mockUC.On("GetBarcodesByItemID", mock.Anything, id).Return([]models.ItemBarcode{{Barcode: "8991234567890"}}, nil).Once()
+	mockUC.On("GetImagesByItemID", mock.Anything, id).Return([]models.ItemImage{}, nil).Once()
+}
+
+func TestItemHandler_CreateSuccess(t *testing.T) {
+	e := newEcho()
+	reqBody := `{"name":"Sampul Plastik A4","backing_id":"` + uuid.New().String() + `","units":[{"uom":"box","conversion":12}],"barcodes":[{"barcode":"8991234567890"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/item", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", models.User{ID: uuid.New()})
+
+	mockUC := new(mockItemUsecase)
+	handler := newItemHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	created := &models.Item{
+		ID:        uuid.New(),
+		ItemCode:  "010101010001",
+		Name:      "Sampul Plastik A4",
+		BaseUom:   "pcs",
+		IsActive:  true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	mockUC.On("Create", mock.Anything, mock.AnythingOfType("*dto.ReqCreateItem"), mock.AnythingOfType("string")).
+		Return(created, nil).Once()
+	expectItemDetail(mockUC, created)
+
+	err := handler.Create(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Status  int `json:"status"`
+		Message string
+		Data    dto.RespItem `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "010101010001", resp.Data.ItemCode)
+	assert.Equal(t, []dto.ItemUnitItem{{Uom: "box", Conversion: 12}}, resp.Data.Units)
+	assert.Len(t, resp.Data.Barcodes, 1)
+	mockUC.AssertExpectations(t)
+}
+
+func TestItemHandler_CreateValidationError(t *testing.T) {
+	e := newEcho()
+	reqBody := `{"name":"Sampul Plastik A4","backing_id":"` + uuid.New().String() + `","units":[{"uom":"box","conversion":0}]}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/item", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockItemUsecase)
+	handler := newItemHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	err := handler.Create(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestItemHandler_CreateUsecaseError(t *testing.T) {
+	e := newEcho()
+	reqBody := `{"name":"Sampul Plastik A4","backing_id":"` + uuid.New().String() + `"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/item", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockItemUsecase)
+	handler := newItemHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	mockUC.On("Create", mock.Anything, mock.AnythingOfType("*dto.ReqCreateItem"), mock.AnythingOfType("string")).
+		Return(nil, errors.New(constants.ItemNameAlreadyExistsInBacking)).Once()
+
+	err := handler.Create(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), constants.ItemNameAlreadyExistsInBacking)
+	mockUC.AssertExpectations(t)
+}
+
+func TestItemHandler_UpdateSuccess(t *testing.T) {
+	e := newEcho()
+	itemID := uuid.New()
+	reqBody := `{"name":"Sampul Plastik F4","backing_id":"` + uuid.New().String() + `","is_active":false}`
+	req := httptest.NewRequest(http.MethodPut, "/v1/item/"+itemID.String(), strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(itemID.String())
+
+	mockUC := new(mockItemUsecase)
+	handler := newItemHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	updated := &models.Item{ID: itemID, Name: "Sampul Plastik F4", CreatedAt: time.Now(), UpdatedAt: time.Now()}
+	mockUC.On("Update", mock.Anything, itemID.String(), mock.MatchedBy(func(req *dto.ReqUpdateItem) bool {
+		return req.IsActive != nil && !*req.IsActive
+	}), mock.AnythingOfType("string")).Return(updated, nil).Once()
+	expectItemDetail(mockUC, updated)
+
+	err := handler.Update(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestItemHandler_DeleteSuccess(t *testing.T) {
+	e := newEcho()
+	itemID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodDelete, "/v1/item/"+itemID, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(itemID)
+
+	mockUC := new(mockItemUsecase)
+	handler := newItemHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	mockUC.On("Delete", mock.Anything, itemID, mock.AnythingOfType("string")).Return(nil).Once()
+
+	err := handler.Delete(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestItemHandler_GetIndexSuccess(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodGet, "/v1/item?page=1&per_page=10&is_active=true", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("page_request", &request.PageRequest{Page: 1, PerPage: 10})
+
+	mockUC := new(mockItemUsecase)
+	handler := newItemHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	items := []models.Item{
+		{ID: uuid.New(), ItemCode: "010101010001", Name: "Sampul Plastik A4", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+	}
+	mockUC.On("GetIndex", mock.Anything, mock.AnythingOfType("request.PageRequest"), mock.MatchedBy(func(filter dto.ReqItemIndexFilter) bool {
+		return len(filter.IsActive) == 1 && filter.IsActive[0]
+	})).Return(items, 1, nil).Once()
+
+	err := handler.GetIndex(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestItemHandler_GetByIDUsecaseError(t *testing.T) {
+	e := newEcho()
+	itemID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodGet, "/v1/item/"+itemID, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(itemID)
+
+	mockUC := new(mockItemUsecase)
+	handler := newItemHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	mockUC.On("GetByID", mock.Anything, itemID).Return(nil, errors.New("not found")).Once()
+
+	err := handler.GetByID(c)
+	require.NoError(t, err)
+	assert.NotEqual(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestItemHandler_ExportSuccess(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodGet, "/v1/item/export", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockItemUsecase)
+	handler := newItemHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	mockUC.On("Export", mock.Anything, mock.AnythingOfType("dto.ReqItemIndexFilter")).Return([]byte("excel"), nil).Once()
+
+	err := handler.Export(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, constants.ExcelContent, rec.Header().Get(echo.HeaderContentType))
+	mockUC.AssertExpectations(t)
+}
+
+func TestItemHandler_UploadImagesSuccess(t *testing.T) {
+	e := newEcho()
+	itemID := uuid.New()
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("images", "front.png")
+	require.NoError(t, err)
+	_, err = part.Write([]byte("png"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/item/"+itemID.String()+"/images", body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(itemID.String())
+
+	mockUC := new(mockItemUsecase)
+	handler := newItemHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	mockUC.On("UploadImages", mock.Anything, itemID.String(), []dto.ItemImageUpload{{Data: []byte("png"), FileName: "front.png"}}).Return(nil).Once()
+	expectItemDetail(mockUC, &models.Item{ID: itemID, CreatedAt: time.Now(), UpdatedAt: time.Now()})
+
+	err = handler.UploadImages(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestItemHandler_UploadImagesWithoutFile(t *testing.T) {
+	e := newEcho()
+	itemID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodPost, "/v1/item/"+itemID+"/images", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(itemID)
+
+	mockUC := new(mockItemUsecase)
+	handler := newItemHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	err := handler.UploadImages(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), constants.ItemImageRequired)
+	mockUC.AssertExpectations(t)
+}
+
+func TestItemHandler_DeleteImageSuccess(t *testing.T) {
+	e := newEcho()
+	itemID := uuid.New().String()
+	fileID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodDelete, "/v1/item/"+itemID+"/images/"+fileID, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id", "fileId")
+	c.SetParamValues(itemID, fileID)
+
+	mockUC := new(mockItemUsecase)
+	handler := newItemHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	mockUC.On("DeleteImage", mock.Anything, itemID, fileID).Return(nil).Once()
+
+	err := handler.DeleteImage(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
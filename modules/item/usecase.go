@@ -0,0 +1,24 @@
+package item
+
+import (
+	"context"
+
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/item/dto"
+)
+
+type Usecase interface {
+	Create(ctx context.Context, req *dto.ReqCreateItem, authId string) (*models.Item, error)
+	Update(ctx context.Context, id string, req *dto.ReqUpdateItem, authId string) (*models.Item, error)
+	Delete(ctx context.Context, id string, authId string) error
+	GetByID(ctx context.Context, id string) (*models.Item, error)
+	GetUnitsByItemID(ctx context.Context, id string) ([]models.ItemUnit, error)
+	GetBarcodesByItemID(ctx context.Context, id string) ([]models.ItemBarcode, error)
+	GetImagesByItemID(ctx context.Context, id string) ([]models.ItemImage, error)
+	UploadImages(ctx context.Context, id string, images []dto.ItemImageUpload) error
+	DeleteImage(ctx context.Context, id string, fileID string) error
+	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqItemIndexFilter) ([]models.Item, int, error)
+	Export(ctx context.Context, filter dto.ReqItemIndexFilter) ([]byte, error)
+	ImportFromExcel(ctx context.Context, filePath string, authId string) (*dto.ResImportItems, error)
+}
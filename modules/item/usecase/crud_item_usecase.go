@@ -0,0 +1,351 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	fileUsecase "github.com/rendyfutsuy/base-go/modules/file/usecase"
+	mod "github.com/rendyfutsuy/base-go/modules/item"
+	"github.com/rendyfutsuy/base-go/modules/item/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/xuri/excelize/v2"
+	"gorm.io/gorm"
+)
+
+type itemUsecase struct {
+	repo   mod.Repository
+	fileUC fileUsecase.Usecase
+}
+
+func NewItemUsecase(repo mod.Repository, fileUC fileUsecase.Usecase) mod.Usecase {
+	return &itemUsecase{repo: repo, fileUC: fileUC}
+}
+
+// normalizeItemUnits trims the base unit, units and barcodes of an item and checks they are consistent:
+// a unit is listed once and is not the base unit, a barcode is listed once and is printed on a unit of the item
+func normalizeItemUnits(baseUom string, units []dto.ItemUnitItem, barcodes []dto.ItemBarcodeItem) (string, []dto.ItemUnitItem, []dto.ItemBarcodeItem, error) {
+	baseUom = strings.TrimSpace(baseUom)
+	if baseUom == "" {
+		baseUom = constants.ItemDefaultBaseUom
+	}
+
+	knownUoms := map[string]bool{strings.ToLower(baseUom): true}
+	normalizedUnits := make([]dto.ItemUnitItem, 0, len(units))
+	for _, unit := range units {
+		uom := strings.TrimSpace(unit.Uom)
+		key := strings.ToLower(uom)
+		if key == strings.ToLower(baseUom) {
+			return "", nil, nil, fmt.Errorf(constants.ItemUnitIsBaseUnit, uom)
+		}
+		if knownUoms[key] {
+			return "", nil, nil, fmt.Errorf(constants.ItemUnitDuplicated, uom)
+		}
+		knownUoms[key] = true
+		normalizedUnits = append(normalizedUnits, dto.ItemUnitItem{Uom: uom, Conversion: unit.Conversion})
+	}
+
+	seenBarcodes := map[string]bool{}
+	normalizedBarcodes := make([]dto.ItemBarcodeItem, 0, len(barcodes))
+	for _, barcode := range barcodes {
+		code := strings.TrimSpace(barcode.Barcode)
+		if seenBarcodes[code] {
+			return "", nil, nil, fmt.Errorf(constants.ItemBarcodeDuplicated, code)
+		}
+		seenBarcodes[code] = true
+
+		var uom *string
+		if barcode.Uom != nil {
+			value := strings.TrimSpace(*barcode.Uom)
+			if !knownUoms[strings.ToLower(value)] && value != "" {
+				return "", nil, nil, fmt.Errorf(constants.ItemBarcodeUnitNotFound, code, value)
+			}
+			// barcodes of the base unit are stored without unit
+			if value != "" && !strings.EqualFold(value, baseUom) {
+				uom = &value
+			}
+		}
+		normalizedBarcodes = append(normalizedBarcodes, dto.ItemBarcodeItem{Barcode: code, Uom: uom})
+	}
+
+	return baseUom, normalizedUnits, normalizedBarcodes, nil
+}
+
+// barcodeValues returns the barcode strings of barcode items
+func barcodeValues(barcodes []dto.ItemBarcodeItem) []string {
+	values := make([]string, 0, len(barcodes))
+	for _, barcode := range barcodes {
+		values = append(values, barcode.Barcode)
+	}
+	return values
+}
+
+// validateReferences makes sure the backing exists, the name is free in the backing and the barcodes are not used by another item
+func (u *itemUsecase) validateReferences(ctx context.Context, backingID uuid.UUID, name string, barcodes []dto.ItemBarcodeItem, excludeID uuid.UUID) error {
+	exists, err := u.repo.ExistsBackingByID(ctx, backingID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.New(constants.ItemBackingNotFound)
+	}
+
+	exists, err = u.repo.ExistsByNameInBacking(ctx, backingID, name, excludeID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errors.New(constants.ItemNameAlreadyExistsInBacking)
+	}
+
+	used, err := u.repo.GetUsedBarcodes(ctx, barcodeValues(barcodes), excludeID)
+	if err != nil {
+		return err
+	}
+	if len(used) > 0 {
+		return fmt.Errorf(constants.ItemBarcodeAlreadyUsed, strings.Join(used, ", "))
+	}
+
+	return nil
+}
+
+func (u *itemUsecase) Create(ctx context.Context, reqBody *dto.ReqCreateItem, authId string) (*models.Item, error) {
+	baseUom, units, barcodes, err := normalizeItemUnits(reqBody.BaseUom, reqBody.Units, reqBody.Barcodes)
+	if err != nil {
+		return nil, err
+	}
+
+	itemCode := strings.TrimSpace(reqBody.ItemCode)
+	if itemCode != "" {
+		exists, err := u.repo.ExistsByItemCode(ctx, itemCode)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, fmt.Errorf(constants.ItemCodeAlreadyExists, itemCode)
+		}
+	}
+
+	if err := u.validateReferences(ctx, reqBody.BackingID, reqBody.Name, barcodes, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	isActive := true
+	if reqBody.IsActive != nil {
+		isActive = *reqBody.IsActive
+	}
+
+	return u.repo.Create(ctx, mod.CreateItemParams{
+		ItemCode:  itemCode,
+		Name:      reqBody.Name,
+		BackingID: reqBody.BackingID,
+		BaseUom:   baseUom,
+		Units:     units,
+		Barcodes:  barcodes,
+		Length:    reqBody.Length,
+		Width:     reqBody.Width,
+		Height:    reqBody.Height,
+		Weight:    reqBody.Weight,
+		IsActive:  isActive,
+		CreatedBy: authId,
+	})
+}
+
+func (u *itemUsecase) Update(ctx context.Context, id string, reqBody *dto.ReqUpdateItem, authId string) (*models.Item, error) {
+	iid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := u.repo.GetByID(ctx, iid)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.ItemNotFound, id)
+		}
+		return nil, err
+	}
+
+	baseUom, units, barcodes, err := normalizeItemUnits(reqBody.BaseUom, reqBody.Units, reqBody.Barcodes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.validateReferences(ctx, reqBody.BackingID, reqBody.Name, barcodes, iid); err != nil {
+		return nil, err
+	}
+
+	isActive := current.IsActive
+	if reqBody.IsActive != nil {
+		isActive = *reqBody.IsActive
+	}
+
+	res, err := u.repo.Update(ctx, iid, mod.UpdateItemParams{
+		Name:      reqBody.Name,
+		BackingID: reqBody.BackingID,
+		BaseUom:   baseUom,
+		Units:     units,
+		Barcodes:  barcodes,
+		Length:    reqBody.Length,
+		Width:     reqBody.Width,
+		Height:    reqBody.Height,
+		Weight:    reqBody.Weight,
+		IsActive:  isActive,
+		UpdatedBy: authId,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.ItemNotFound, id)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *itemUsecase) Delete(ctx context.Context, id string, authId string) error {
+	iid, err := utils.StringToUUID(id)
+	if err != nil {
+		return err
+	}
+	return u.repo.Delete(ctx, iid, authId)
+}
+
+func (u *itemUsecase) GetByID(ctx context.Context, id string) (*models.Item, error) {
+	iid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	res, err := u.repo.GetByID(ctx, iid)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.ItemNotFound, id)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *itemUsecase) GetUnitsByItemID(ctx context.Context, id string) ([]models.ItemUnit, error) {
+	iid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.GetUnitsByItemID(ctx, iid)
+}
+
+func (u *itemUsecase) GetBarcodesByItemID(ctx context.Context, id string) ([]models.ItemBarcode, error) {
+	iid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.GetBarcodesByItemID(ctx, iid)
+}
+
+func (u *itemUsecase) GetImagesByItemID(ctx context.Context, id string) ([]models.ItemImage, error) {
+	iid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.GetImagesByItemID(ctx, iid)
+}
+
+func (u *itemUsecase) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqItemIndexFilter) ([]models.Item, int, error) {
+	return u.repo.GetIndex(ctx, req, filter)
+}
+
+// itemExportHeaders starts with the import columns so an export can be imported again
+var itemExportHeaders = []string{
+	"Item Code", "Name", "Backing Code", "Base UoM", "Units", "Barcodes", "Length (cm)", "Width (cm)", "Height (cm)", "Weight (kg)", "Active",
+	"Backing", "Type", "Sub-group", "Group", "Update Date",
+}
+
+func (u *itemUsecase) Export(ctx context.Context, filter dto.ReqItemIndexFilter) ([]byte, error) {
+	list, err := u.repo.GetAllForExport(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create Excel file
+	f := excelize.NewFile()
+	sheet := "Items"
+	f.SetSheetName("Sheet1", sheet)
+
+	for i, header := range itemExportHeaders {
+		cell, _ := excelize.CoordinatesToCellName(i+1, 1)
+		f.SetCellValue(sheet, cell, header)
+	}
+
+	// Rows
+	for i, it := range list {
+		row := i + 2
+		values := []interface{}{
+			it.ItemCode,
+			it.Name,
+			it.BackingCode,
+			it.BaseUom,
+			formatItemUnits(it.Units),
+			formatItemBarcodes(it.Barcodes),
+			formatItemNumber(it.Length),
+			formatItemNumber(it.Width),
+			formatItemNumber(it.Height),
+			formatItemNumber(it.Weight),
+			formatItemIsActive(it.IsActive),
+			it.BackingName,
+			it.TypeName,
+			it.SubgroupName,
+			it.GroupName,
+			it.UpdatedAt.Local().Format("2006/01/02"),
+		}
+		for j, value := range values {
+			cell, _ := excelize.CoordinatesToCellName(j+1, row)
+			// codes are text so leading zeros are kept
+			if text, ok := value.(string); ok {
+				f.SetCellStr(sheet, cell, text)
+				continue
+			}
+			f.SetCellValue(sheet, cell, value)
+		}
+	}
+
+	// Define border configuration
+	borderDefinition := []excelize.Border{
+		{Type: "left", Color: "000000", Style: 1},
+		{Type: "top", Color: "000000", Style: 1},
+		{Type: "bottom", Color: "000000", Style: 1},
+		{Type: "right", Color: "000000", Style: 1},
+	}
+
+	borderStyle, err := f.NewStyle(&excelize.Style{
+		Border: borderDefinition,
+	})
+	if err != nil {
+		return nil, err
+	}
+	lastCol, _ := excelize.ColumnNumberToName(len(itemExportHeaders))
+	if err := f.SetCellStyle(sheet, "A1", lastCol+strconv.Itoa(len(list)+1), borderStyle); err != nil {
+		return nil, err
+	}
+
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font:   &excelize.Font{Bold: true},
+		Border: borderDefinition,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := f.SetCellStyle(sheet, "A1", lastCol+"1", headerStyle); err != nil {
+		return nil, err
+	}
+
+	// Write to buffer and return bytes
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
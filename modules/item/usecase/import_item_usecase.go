@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	mod "github.com/rendyfutsuy/base-go/modules/item"
+	"github.com/rendyfutsuy/base-go/modules/item/dto"
+)
+
+// ImportFromExcel creates items from the rows of an Excel file with columns:
+// item_code (optional), name, backing_code, base_uom, units, barcodes, length, width, height, weight, is_active
+func (u *itemUsecase) ImportFromExcel(ctx context.Context, filePath string, authId string) (*dto.ResImportItems, error) {
+	rows, err := readItemImportRows(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]dto.ResImportItemExcel, 0, len(rows))
+	validParams := make([]mod.CreateItemParams, 0, len(rows))
+	validResultIndices := make([]int, 0, len(rows))
+	backingIDs := make(map[string]*uuid.UUID)
+	seenNames := make(map[string]int)
+	seenCodes := make(map[string]int)
+	seenBarcodes := make(map[string]int)
+
+	for i, row := range rows {
+		if isBlankItemImportRow(row) {
+			continue
+		}
+		rowNum := i + 2 // Excel row number, after the header
+
+		code := itemImportCell(row, 0)
+		name := itemImportCell(row, 1)
+		backingCode := itemImportCell(row, 2)
+
+		result := dto.ResImportItemExcel{Row: rowNum, Name: name}
+
+		// Collect all validation errors (akumulatif)
+		var allErrors []string
+
+		var backingID *uuid.UUID
+		if backingCode == "" {
+			allErrors = append(allErrors, constants.ItemImportBackingRequired)
+		} else {
+			cached, ok := backingIDs[backingCode]
+			if !ok {
+				cached, err = u.repo.GetBackingIDByCode(ctx, backingCode)
+				if err != nil {
+					return nil, err
+				}
+				backingIDs[backingCode] = cached
+			}
+			if cached == nil {
+				allErrors = append(allErrors, fmt.Sprintf(constants.ItemImportBackingNotFound, backingCode))
+			}
+			backingID = cached
+		}
+
+		if name == "" {
+			allErrors = append(allErrors, constants.ItemImportNameRequired)
+		} else if len(name) > 255 {
+			allErrors = append(allErrors, fmt.Sprintf(constants.ItemImportFieldTooLong, "name", 255))
+		} else if backingID != nil {
+			key := backingID.String() + "|" + name
+			if firstRow, ok := seenNames[key]; ok {
+				allErrors = append(allErrors, fmt.Sprintf(constants.ItemImportRowDuplicated, firstRow))
+			} else {
+				exists, err := u.repo.ExistsByNameInBacking(ctx, *backingID, name, uuid.Nil)
+				if err != nil {
+					return nil, err
+				}
+				if exists {
+					allErrors = append(allErrors, constants.ItemNameAlreadyExistsInBacking)
+				}
+				seenNames[key] = rowNum
+			}
+		}
+
+		if code != "" {
+			if len(code) > 255 {
+				allErrors = append(allErrors, fmt.Sprintf(constants.ItemImportFieldTooLong, "item_code", 255))
+			} else if firstRow, ok := seenCodes[code]; ok {
+				allErrors = append(allErrors, fmt.Sprintf(constants.ItemImportRowDuplicated, firstRow))
+			} else {
+				exists, err := u.repo.ExistsByItemCode(ctx, code)
+				if err != nil {
+					return nil, err
+				}
+				if exists {
+					allErrors = append(allErrors, fmt.Sprintf(constants.ItemCodeAlreadyExists, code))
+				}
+				seenCodes[code] = rowNum
+			}
+		}
+
+		baseUom := itemImportCell(row, 3)
+		if len(baseUom) > 20 {
+			allErrors = append(allErrors, fmt.Sprintf(constants.ItemImportFieldTooLong, "base_uom", 20))
+		}
+		units, err := parseItemImportUnits(itemImportCell(row, 4))
+		if err != nil {
+			allErrors = append(allErrors, err.Error())
+		}
+		barcodes, err := parseItemImportBarcodes(itemImportCell(row, 5))
+		if err != nil {
+			allErrors = append(allErrors, err.Error())
+		}
+		if len(allErrors) == 0 {
+			baseUom, units, barcodes, err = normalizeItemUnits(baseUom, units, barcodes)
+			if err != nil {
+				allErrors = append(allErrors, err.Error())
+			}
+		}
+		if len(allErrors) == 0 && len(barcodes) > 0 {
+			for _, barcode := range barcodes {
+				if firstRow, ok := seenBarcodes[barcode.Barcode]; ok {
+					allErrors = append(allErrors, fmt.Sprintf(constants.ItemImportRowDuplicated, firstRow))
+					break
+				}
+			}
+			used, err := u.repo.GetUsedBarcodes(ctx, barcodeValues(barcodes), uuid.Nil)
+			if err != nil {
+				return nil, err
+			}
+			if len(used) > 0 {
+				allErrors = append(allErrors, fmt.Sprintf(constants.ItemBarcodeAlreadyUsed, strings.Join(used, ", ")))
+			}
+			for _, barcode := range barcodes {
+				if _, ok := seenBarcodes[barcode.Barcode]; !ok {
+					seenBarcodes[barcode.Barcode] = rowNum
+				}
+			}
+		}
+
+		dimensions := make([]*float64, 4)
+		for j, field := range []string{"length", "width", "height", "weight"} {
+			value, err := parseItemImportNumber(itemImportCell(row, 6+j), field)
+			if err != nil {
+				allErrors = append(allErrors, err.Error())
+			}
+			dimensions[j] = value
+		}
+
+		isActive, err := parseItemImportIsActive(itemImportCell(row, 10))
+		if err != nil {
+			allErrors = append(allErrors, err.Error())
+		}
+
+		if len(allErrors) > 0 {
+			result.Status = "failed"
+			result.ErrorMessage = strings.Join(allErrors, "; ")
+			results = append(results, result)
+			continue
+		}
+
+		validParams = append(validParams, mod.CreateItemParams{
+			ItemCode:  code,
+			Name:      name,
+			BackingID: *backingID,
+			BaseUom:   baseUom,
+			Units:     units,
+			Barcodes:  barcodes,
+			Length:    dimensions[0],
+			Width:     dimensions[1],
+			Height:    dimensions[2],
+			Weight:    dimensions[3],
+			IsActive:  isActive,
+			CreatedBy: authId,
+		})
+		validResultIndices = append(validResultIndices, len(results))
+
+		// Mark as success (will be validated after batch insert)
+		result.Success = true
+		result.Status = "success"
+		results = append(results, result)
+	}
+
+	if len(validParams) > 0 {
+		if err := u.repo.BulkCreate(ctx, validParams); err != nil {
+			// If batch insert fails, mark all pending rows as failed
+			for _, idx := range validResultIndices {
+				results[idx].Success = false
+				results[idx].Status = "failed"
+				results[idx].ErrorMessage = fmt.Sprintf("%s: %v", constants.ItemImportBatchSaveFailed, err)
+			}
+		}
+	}
+
+	return toResImportItems(results), nil
+}
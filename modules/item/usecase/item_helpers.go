@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/modules/item/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/xuri/excelize/v2"
+)
+
+// Several units or barcodes can be put in one cell separated by semicolon or new line
+var itemImportListSeparatorRegex = regexp.MustCompile(`[;\n]+`)
+
+// readItemImportRows returns the data rows of the first sheet, without the header row
+func readItemImportRows(filePath string) ([][]string, error) {
+	f, err := excelize.OpenFile(filePath)
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, fmt.Errorf("%s: %v", constants.ItemImportExcelOpenFailed, err)
+	}
+	defer f.Close()
+
+	rows, err := f.GetRows(f.GetSheetName(0))
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, fmt.Errorf("%s: %v", constants.ItemImportExcelReadFailed, err)
+	}
+
+	if len(rows) < 2 {
+		return nil, errors.New(constants.ItemImportExcelInsufficientRows)
+	}
+
+	return rows[1:], nil
+}
+
+// itemImportCell returns the trimmed cell of a row, empty when the row is shorter
+func itemImportCell(row []string, index int) string {
+	if index < len(row) {
+		return strings.TrimSpace(row[index])
+	}
+	return ""
+}
+
+// isBlankItemImportRow reports whether every cell of the row is empty
+func isBlankItemImportRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// splitItemImportList returns the entries of a units / barcodes cell, "-" marks an empty cell like in the export
+func splitItemImportList(value string) []string {
+	entries := make([]string, 0)
+	for _, entry := range itemImportListSeparatorRegex.Split(value, -1) {
+		if entry = strings.TrimSpace(entry); entry != "" && entry != "-" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// parseItemImportUnits parses a units cell written as "box=12; carton=144"
+func parseItemImportUnits(value string) ([]dto.ItemUnitItem, error) {
+	units := make([]dto.ItemUnitItem, 0)
+	for _, entry := range splitItemImportList(value) {
+		uom, conversion, ok := strings.Cut(entry, "=")
+		uom = strings.TrimSpace(uom)
+		if !ok || uom == "" {
+			return nil, fmt.Errorf(constants.ItemImportUnitInvalid, entry)
+		}
+		number, err := strconv.ParseFloat(strings.TrimSpace(conversion), 64)
+		if err != nil || number <= 0 {
+			return nil, fmt.Errorf(constants.ItemImportUnitInvalid, entry)
+		}
+		units = append(units, dto.ItemUnitItem{Uom: uom, Conversion: number})
+	}
+	return units, nil
+}
+
+// parseItemImportBarcodes parses a barcodes cell written as "8991234567890; 8991234567891:box"
+func parseItemImportBarcodes(value string) ([]dto.ItemBarcodeItem, error) {
+	barcodes := make([]dto.ItemBarcodeItem, 0)
+	for _, entry := range splitItemImportList(value) {
+		code, uom, hasUom := strings.Cut(entry, ":")
+		code = strings.TrimSpace(code)
+		uom = strings.TrimSpace(uom)
+		if code == "" || (hasUom && uom == "") {
+			return nil, fmt.Errorf(constants.ItemImportBarcodeInvalid, entry)
+		}
+		barcode := dto.ItemBarcodeItem{Barcode: code}
+		if hasUom {
+			barcode.Uom = &uom
+		}
+		barcodes = append(barcodes, barcode)
+	}
+	return barcodes, nil
+}
+
+// parseItemImportNumber parses an optional dimension or weight cell, an empty cell or "-" is no value
+func parseItemImportNumber(value string, field string) (*float64, error) {
+	if value == "" || value == "-" {
+		return nil, nil
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || number <= 0 {
+		return nil, fmt.Errorf(constants.ItemImportNumberInvalid, field)
+	}
+	return &number, nil
+}
+
+// parseItemImportIsActive parses the active cell, an empty cell is active
+func parseItemImportIsActive(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "", "yes", "y", "true", "1", "active":
+		return true, nil
+	case "no", "n", "false", "0", "inactive":
+		return false, nil
+	}
+	return false, errors.New(constants.ItemImportIsActiveInvalid)
+}
+
+// formatItemUnits writes units the way the import reads them
+func formatItemUnits(units []dto.ItemUnitItem) string {
+	if len(units) == 0 {
+		return "-"
+	}
+	parts := make([]string, 0, len(units))
+	for _, unit := range units {
+		parts = append(parts, unit.Uom+"="+strconv.FormatFloat(unit.Conversion, 'f', -1, 64))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// formatItemBarcodes writes barcodes the way the import reads them
+func formatItemBarcodes(barcodes []dto.ItemBarcodeItem) string {
+	if len(barcodes) == 0 {
+		return "-"
+	}
+	parts := make([]string, 0, len(barcodes))
+	for _, barcode := range barcodes {
+		if barcode.Uom != nil && *barcode.Uom != "" {
+			parts = append(parts, barcode.Barcode+":"+*barcode.Uom)
+			continue
+		}
+		parts = append(parts, barcode.Barcode)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func formatItemNumber(value *float64) interface{} {
+	if value == nil {
+		return "-"
+	}
+	return *value
+}
+
+func formatItemIsActive(isActive bool) string {
+	if isActive {
+		return "yes"
+	}
+	return "no"
+}
+
+// toResImportItems counts the row results of an item import
+func toResImportItems(results []dto.ResImportItemExcel) *dto.ResImportItems {
+	successCount := 0
+	failedCount := 0
+	for _, result := range results {
+		if result.Status == "success" {
+			successCount++
+		} else {
+			failedCount++
+		}
+	}
+
+	return &dto.ResImportItems{
+		TotalRows:    len(results),
+		SuccessCount: successCount,
+		FailedCount:  failedCount,
+		Results:      results,
+	}
+}
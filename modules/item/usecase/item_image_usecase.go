@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rendyfutsuy/base-go/constants"
+	fileDto "github.com/rendyfutsuy/base-go/modules/file/dto"
+	"github.com/rendyfutsuy/base-go/modules/item/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+)
+
+// UploadImages stores the images through the file module and assigns them to the item as "image"
+func (u *itemUsecase) UploadImages(ctx context.Context, id string, images []dto.ItemImageUpload) error {
+	if len(images) == 0 {
+		return errors.New(constants.ItemImageRequired)
+	}
+
+	it, err := u.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	typ := constants.FileTypeItemImage
+	items := make([]fileDto.AssignFileItem, 0, len(images))
+	for _, image := range images {
+		extra := it.ID.String()
+		f, err := u.fileUC.Upload(ctx, fileDto.UploadInput{
+			Data:             image.Data,
+			OriginalFileName: image.FileName,
+			DestRoot:         "items/images",
+			ExtraPath:        &extra,
+			Description:      nil,
+		})
+		if err != nil {
+			utils.Logger.Error(err.Error())
+			return errors.New(constants.ItemImageUploadFailed)
+		}
+		items = append(items, fileDto.AssignFileItem{FileID: f.ID, Type: &typ})
+	}
+
+	return u.fileUC.AssignFiles(ctx, fileDto.AssignFilesToModule{
+		ModuleID:   it.ID,
+		ModuleType: constants.ModuleTypeItem,
+		Items:      items,
+	})
+}
+
+// DeleteImage detaches the image from the item and deletes the file
+func (u *itemUsecase) DeleteImage(ctx context.Context, id string, fileID string) error {
+	iid, err := utils.StringToUUID(id)
+	if err != nil {
+		return err
+	}
+	fid, err := utils.StringToUUID(fileID)
+	if err != nil {
+		return err
+	}
+
+	removed, err := u.repo.RemoveImage(ctx, iid, fid)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return fmt.Errorf(constants.ItemImageNotFound, fileID)
+	}
+
+	return u.fileUC.Delete(ctx, fileID)
+}
@@ -33,6 +33,9 @@ type ParameterHandler struct {
 func NewParameterHandler(e *echo.Echo, uc parameter.Usecase, mwP _reqContext.IMiddlewarePageRequest, auth middleware.IMiddlewareAuth, middlewarePermission middleware.IMiddlewarePermission) {
 	h := &ParameterHandler{Usecase: uc, validator: validator.New(), mwPageRequest: mwP, middlewareAuth: auth, middlewarePermission: middlewarePermission}
 
+	// Public read-only lookup for frontend dropdowns, served from the lookup cache without authentication
+	e.GET("/v1/parameter/lookup", h.Lookup)
+
 	r := e.Group("/v1/parameter")
 	r.Use(h.middlewareAuth.AuthorizationCheck)
 
@@ -54,6 +57,14 @@ func NewParameterHandler(e *echo.Echo, uc parameter.Usecase, mwP _reqContext.IMi
 	// Export (no pagination, same filters) - must be before /:id to avoid route conflict
 	r.GET("/export", h.Export, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToExport))
 
+	// Import from Excel - template must be before /:id to avoid route conflict
+	r.GET("/import/template", h.DownloadImportTemplate, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToCreate))
+	r.POST("/import", h.ImportParameters, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToCreate))
+
+	// Tree per type and ordering of siblings - must be before /:id to avoid route conflict
+	r.GET("/tree", h.GetTree, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+	r.POST("/reorder", h.Reorder, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+
 	// Get by ID (detail) - must be after /export to avoid route conflict
 	r.GET("/:id", h.GetByID, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
 
@@ -63,13 +74,16 @@ func NewParameterHandler(e *echo.Echo, uc parameter.Usecase, mwP _reqContext.IMi
 	// Update
 	r.PUT("/:id", h.Update, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
 
+	// Move to another parent / position
+	r.POST("/:id/move", h.Move, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+
 	// Delete
 	r.DELETE("/:id", h.Delete, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToDelete))
 }
 
 // Create godoc
 // @Summary		Create a new parameter
-// @Description	Create a new parameter with provided code, name, value, and description
+// @Description	Create a new parameter with provided code, name, value, and description. A typed parameter must use a type registered in /v1/parameter-type, its value is checked against the value kind and JSON Schema of the type and stored in canonical form, and its parent must have a type allowed by the type. Untyped parameters keep a free-form value.
 // @Tags			Parameter
 // @Accept			json
 // @Produce		json
@@ -103,7 +117,7 @@ func (h *ParameterHandler) Create(c echo.Context) error {
 
 // Update godoc
 // @Summary		Update parameter
-// @Description	Update an existing parameter's information
+// @Description	Update an existing parameter's information. The value and the parent are checked against the type as on create, and the typed children of the parameter must accept its new type. The parent must not be one of the descendants of the parameter.
 // @Tags			Parameter
 // @Accept			json
 // @Produce		json
@@ -138,7 +152,7 @@ func (h *ParameterHandler) Update(c echo.Context) error {
 
 // Delete godoc
 // @Summary		Delete parameter
-// @Description	Delete an existing parameter by ID
+// @Description	Delete an existing parameter by ID together with its descendants. Fails when the parameter or one of its descendants is used by another module.
 // @Tags			Parameter
 // @Accept			json
 // @Produce		json
@@ -288,3 +302,42 @@ func (h *ParameterHandler) Export(c echo.Context) error {
 	c.Response().Header().Set(constants.FieldContentDisposition, constants.ExcelContentDisposition("parameters.xlsx"))
 	return c.Blob(http.StatusOK, constants.ExcelContent, excelBytes)
 }
+
+// Lookup godoc
+// @Summary		Lookup parameters
+// @Description	Public read-only list of parameters for dropdowns, by type and/or by code of the parent, in sibling order (sort_order) and then by name. Served from the parameter cache, which is refreshed on every change. Responds 304 when If-None-Match matches the current ETag
+// @Tags			Parameter
+// @Accept			json
+// @Produce		json
+// @Param			type			query		string	false	"Parameter type, required when parent_code is empty"
+// @Param			parent_code		query		string	false	"Code of the parent parameter, required when type is empty"
+// @Param			If-None-Match	header		string	false	"ETag of the list already held by the client"
+// @Success		200				{object}	response.NonPaginationResponse{data=[]dto.RespParameterLookup}	"Successfully retrieved parameters"
+// @Success		304				"List not modified"
+// @Failure		400				{object}	response.NonPaginationResponse	"Bad request - no filter"
+// @Router			/v1/parameter/lookup [get]
+func (h *ParameterHandler) Lookup(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	req := new(dto.ReqParameterLookup)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, etag, err := h.Usecase.Lookup(ctx, *req)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// clients revalidate every time, an unchanged list costs a 304
+	c.Response().Header().Set(constants.FieldETag, etag)
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-cache")
+	if c.Request().Header.Get(constants.FieldIfNoneMatch) == etag {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,172 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/xuri/excelize/v2"
+)
+
+// ImportParameters godoc
+// @Summary		Import parameters from Excel file
+// @Description	Create or update (by code) parameters from an Excel file (.xlsx or .xls) with columns: code, name, value, type, description, parent_code. A file exported from /v1/parameter/export can be imported as is. The type must be registered in /v1/parameter-type and the value must match it, the parent may be another row of the file or an existing parameter and must have a type allowed by the type of the row. A row fails when its parent row fails. Valid rows are imported even when other rows fail, failed rows are returned with HTTP 400. Requires 'parameter.create' permission.
+// @Tags			Parameter
+// @Accept			multipart/form-data
+// @Produce		json
+// @Security		BearerAuth
+// @Param			file	formData	file	true	"Excel file (.xlsx or .xls) with columns: code, name, value, type, description, parent_code"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.ResImportParameters}	"Successfully imported all parameters"
+// @Failure		400		{object}	response.NonPaginationResponse{data=dto.ResImportParameters}	"Bad request - one or more rows failed validation. Response contains details for each row including row number, parameter code, status, and error message"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500		{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/parameter/import [post]
+func (h *ParameterHandler) ImportParameters(c echo.Context) error {
+	tempFilePath, status, err := saveParameterImportFile(c, "import_parameters")
+	if err != nil {
+		return c.JSON(status, response.SetErrorResponse(status, err.Error()))
+	}
+	defer os.Remove(tempFilePath)
+
+	res, err := h.Usecase.ImportFromExcel(c.Request().Context(), tempFilePath, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+
+	if res.FailedCount > 0 {
+		resp.Message = constants.ParameterImportFailedPartial
+		if res.SuccessCount == 0 {
+			resp.Message = constants.ParameterImportFailed
+		}
+		resp.Status = http.StatusBadRequest
+		return c.JSON(http.StatusBadRequest, resp)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DownloadImportTemplate godoc
+// @Summary		Download parameter import Excel template
+// @Description	Download Excel template file for importing parameters. Template contains columns: code, name, value, type, description, parent_code with example data.
+// @Tags			Parameter
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Success		200	{file}		file	"Excel template file"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500	{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/parameter/import/template [get]
+func (h *ParameterHandler) DownloadImportTemplate(c echo.Context) error {
+	// a parent topic and a child topic pointing to the row above
+	examples := [][]string{
+		{"TOPIC-NEWS", "News", "", "topic", "News articles", ""},
+		{"TOPIC-NEWS-LOCAL", "Local News", "", "topic", "", "TOPIC-NEWS"},
+	}
+	headers := []string{"Code", "Name", "Value", "Type", "Description", "Parent Code"}
+	widths := []float64{20, 30, 25, 20, 35, 20}
+
+	f := excelize.NewFile()
+	defer f.Close()
+
+	sheetName := "Import Parameters"
+	f.SetSheetName("Sheet1", sheetName)
+
+	for i, header := range headers {
+		col, _ := excelize.ColumnNumberToName(i + 1)
+		f.SetCellValue(sheetName, col+"1", header)
+		f.SetColWidth(sheetName, col, col, widths[i])
+	}
+
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{
+			Bold: true,
+			Size: 12,
+		},
+		Fill: excelize.Fill{
+			Type:    "pattern",
+			Color:   []string{"#E8E8E8"},
+			Pattern: 1,
+		},
+		Alignment: &excelize.Alignment{
+			Horizontal: "center",
+			Vertical:   "center",
+		},
+	})
+	if err == nil {
+		lastCol, _ := excelize.ColumnNumberToName(len(headers))
+		f.SetCellStyle(sheetName, "A1", lastCol+"1", headerStyle)
+	}
+
+	// values are text so they are imported as written
+	for i, example := range examples {
+		for j, value := range example {
+			cell, _ := excelize.CoordinatesToCellName(j+1, i+2)
+			f.SetCellStr(sheetName, cell, value)
+		}
+	}
+
+	c.Response().Header().Set(constants.FieldContentType, constants.ExcelContent)
+	c.Response().Header().Set(constants.FieldContentDisposition, constants.ExcelContentDisposition("parameter_import_template.xlsx"))
+
+	if err := f.Write(c.Response().Writer); err != nil {
+		return c.JSON(http.StatusInternalServerError, response.SetErrorResponse(http.StatusInternalServerError, fmt.Sprintf("%s: %v", constants.ParameterImportTemplateCreateFailed, err)))
+	}
+
+	return nil
+}
+
+// saveParameterImportFile copies the uploaded "file" field to a temporary file keeping its extension,
+// the caller removes the file. The returned status is the one to answer with on error.
+func saveParameterImportFile(c echo.Context, prefix string) (string, int, error) {
+	// Get uploaded file
+	file, err := c.FormFile("file")
+	if err != nil {
+		return "", http.StatusBadRequest, errors.New(constants.ParameterImportFileNotFound)
+	}
+
+	// Validate file extension
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+	if ext != ".xlsx" && ext != ".xls" {
+		return "", http.StatusBadRequest, errors.New(constants.ParameterImportInvalidFileFormat)
+	}
+
+	// Open uploaded file
+	src, err := file.Open()
+	if err != nil {
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.ParameterImportFileOpenFailed, err)
+	}
+	defer src.Close()
+
+	tempFilePath := filepath.Join(os.TempDir(), fmt.Sprintf("%s_%d%s", prefix, time.Now().UnixNano(), ext))
+	dst, err := os.Create(tempFilePath)
+	if err != nil {
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.ParameterImportFileOpenFailed, err)
+	}
+	defer dst.Close()
+
+	if _, err = io.Copy(dst, src); err != nil {
+		os.Remove(tempFilePath)
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.ParameterImportFileOpenFailed, err)
+	}
+
+	return tempFilePath, http.StatusOK, nil
+}
+
+func authUserID(c echo.Context) string {
+	if userModel, ok := c.Get("user").(models.User); ok {
+		return userModel.ID.String()
+	}
+	return ""
+}
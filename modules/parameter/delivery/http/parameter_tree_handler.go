@@ -0,0 +1,109 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/modules/parameter/dto"
+)
+
+// GetTree godoc
+// @Summary		Get parameter tree
+// @Description	Retrieve the parameters of a type as a tree of parents and children, siblings ordered by sort_order then name. A parameter whose parent has another type is a root. deletable is false when the parameter or one of its descendants is used by another module.
+// @Tags			Parameter
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			type	query		string	true	"Parameter type"
+// @Success		200		{object}	response.NonPaginationResponse{data=[]dto.RespParameterTreeNode}	"Successfully retrieved parameter tree"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - type is required"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/parameter/tree [get]
+func (h *ParameterHandler) GetTree(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	req := new(dto.ReqParameterTree)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := h.Usecase.GetTree(ctx, *req)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Move godoc
+// @Summary		Move parameter
+// @Description	Link a parameter to another parent, or to none when parent_id is empty, at a position among its new siblings (last when position is empty). The parent must not be the parameter or one of its descendants and must be allowed by the type of the parameter. The siblings are renumbered.
+// @Tags			Parameter
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string					true	"Parameter UUID"
+// @Param			request	body	dto.ReqMoveParameter	true	"Target parent and position. Fields: parent_id (optional, UUID), position (optional, starting at 1)"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespParameter}	"Successfully moved parameter"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid parent, cycle or parent type not allowed"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/parameter/{id}/move [post]
+func (h *ParameterHandler) Move(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("id")
+	req := new(dto.ReqMoveParameter)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := h.Usecase.Move(ctx, id, req, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespParameter(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Reorder godoc
+// @Summary		Reorder sibling parameters
+// @Description	Set the order of the children of a parent, or of the parameters of a type without parent when parent_id is empty. ids must list every sibling exactly once, in the new order.
+// @Tags			Parameter
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	body	dto.ReqReorderParameters	true	"Siblings in their new order. Fields: parent_id (optional, UUID), type (used without parent_id), ids (required)"
+// @Success		200		{object}	response.NonPaginationResponse	"Successfully reordered parameters"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - ids do not match the siblings"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/parameter/reorder [post]
+func (h *ParameterHandler) Reorder(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	req := new(dto.ReqReorderParameters)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := h.Usecase.Reorder(ctx, req, authUserID(c)); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(Response{Message: constants.ParameterReorderSuccess})
+	return c.JSON(http.StatusOK, resp)
+}
@@ -34,6 +34,7 @@ type RespParameter struct {
 	CreatedAt string               `json:"created_at"`
 	UpdatedAt string               `json:"updated_at"`
 	Parent    *RespParameterParent `json:"parent"`
+	SortOrder int                  `json:"sort_order"`
 	Deletable bool                 `json:"deletable"`
 }
 
@@ -60,6 +61,7 @@ func ToRespParameter(m models.Parameter) RespParameter {
 		CreatedAt: m.CreatedAt.Format(constants.FormatDateTimeISO8601),
 		UpdatedAt: m.UpdatedAt.Format(constants.FormatDateTimeISO8601),
 		Parent:    parent,
+		SortOrder: m.SortOrder,
 		Deletable: m.Deletable,
 	}
 }
@@ -99,3 +101,30 @@ type ReqParameterIndexFilter struct {
 	SortBy    string      `query:"sort_by"`
 	SortOrder string      `query:"sort_order"`
 }
+
+// ReqParameterLookup filters the public lookup, type and parent_code can be combined
+type ReqParameterLookup struct {
+	Type       string `query:"type"`
+	ParentCode string `query:"parent_code"`
+}
+
+// RespParameterLookup is a parameter as shown to the frontend (dropdowns), without audit fields
+type RespParameterLookup struct {
+	ID         uuid.UUID `json:"id"`
+	Code       string    `json:"code"`
+	Name       string    `json:"name"`
+	Value      *string   `json:"value"`
+	Type       *string   `json:"type"`
+	ParentCode string    `json:"parent_code,omitempty"`
+}
+
+func ToRespParameterLookup(m models.Parameter) RespParameterLookup {
+	return RespParameterLookup{
+		ID:         m.ID,
+		Code:       m.Code,
+		Name:       m.Name,
+		Value:      m.Value,
+		Type:       m.Type,
+		ParentCode: m.ParentCode,
+	}
+}
@@ -0,0 +1,16 @@
+package dto
+
+type ResImportParameterExcel struct {
+	Row          int    `json:"row"`                     // Nomor baris di Excel
+	Code         string `json:"code"`                    // Kode parameter
+	Status       string `json:"status"`                  // Status row: "success" atau "failed"
+	ErrorMessage string `json:"error_message,omitempty"` // Message error jika status failed
+	Success      bool   `json:"-"`                       // Internal field, tidak ditampilkan di response
+}
+
+type ResImportParameters struct {
+	TotalRows    int                       `json:"total_rows"`
+	SuccessCount int                       `json:"success_count"`
+	FailedCount  int                       `json:"failed_count"`
+	Results      []ResImportParameterExcel `json:"results"`
+}
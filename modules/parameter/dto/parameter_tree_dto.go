@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/models"
+)
+
+// ReqParameterTree selects the type of the tree
+type ReqParameterTree struct {
+	Type string `query:"type"`
+}
+
+// ReqMoveParameter links a parameter to another parent (none when parent_id is empty) at a position among its siblings
+type ReqMoveParameter struct {
+	ParentID *uuid.UUID `json:"parent_id"`
+	Position *int       `json:"position" validate:"omitempty,min=1"` // starting at 1, last when empty
+}
+
+// ReqReorderParameters lists the siblings of a parent in their new order,
+// without parent_id the siblings are the parameters of the type without parent
+type ReqReorderParameters struct {
+	ParentID *uuid.UUID  `json:"parent_id"`
+	Type     *string     `json:"type"`
+	IDs      []uuid.UUID `json:"ids" validate:"required,min=1"`
+}
+
+// RespParameterTreeNode is a parameter with its children, in sibling order
+type RespParameterTreeNode struct {
+	ID        uuid.UUID               `json:"id"`
+	Code      string                  `json:"code"`
+	Name      string                  `json:"name"`
+	Value     *string                 `json:"value,omitempty"`
+	Type      *string                 `json:"type,omitempty"`
+	SortOrder int                     `json:"sort_order"`
+	Deletable bool                    `json:"deletable"`
+	Children  []RespParameterTreeNode `json:"children"`
+}
+
+func ToRespParameterTreeNode(m models.Parameter) RespParameterTreeNode {
+	return RespParameterTreeNode{
+		ID:        m.ID,
+		Code:      m.Code,
+		Name:      m.Name,
+		Value:     m.Value,
+		Type:      m.Type,
+		SortOrder: m.SortOrder,
+		Deletable: m.Deletable,
+		Children:  []RespParameterTreeNode{},
+	}
+}
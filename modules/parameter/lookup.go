@@ -0,0 +1,32 @@
+package parameter
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/models"
+)
+
+// Lookup reads parameters from a cache of the whole table, for modules that only need to read
+// parameters (validating references, reading settings) and for the public lookup endpoint.
+// Parameters are cached in memory per instance, the cache is dropped on every change made through
+// the parameter usecase and on every instance through Redis, and reloaded at the latest after the TTL.
+type Lookup interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Parameter, error)
+	GetByCode(ctx context.Context, code string) (*models.Parameter, error)
+	ListByType(ctx context.Context, typeCode string) ([]models.Parameter, error)
+	ListChildren(ctx context.Context, parentCode string) ([]models.Parameter, error)
+
+	// Typed getters parse the value of the parameter with the given code,
+	// values are stored in the canonical form of their parameter type
+	GetString(ctx context.Context, code string) (string, error)
+	GetInt(ctx context.Context, code string) (int64, error)
+	GetDecimal(ctx context.Context, code string) (float64, error)
+	GetBool(ctx context.Context, code string) (bool, error)
+	GetDate(ctx context.Context, code string) (time.Time, error)
+	GetJSON(ctx context.Context, code string, dest interface{}) error
+
+	// Invalidate drops the cache of this instance and of the other instances
+	Invalidate(ctx context.Context)
+}
@@ -9,6 +9,16 @@ import (
 	"github.com/rendyfutsuy/base-go/modules/parameter/dto"
 )
 
+// ImportParameterParams is a row of a parameter import, created or updated by code
+type ImportParameterParams struct {
+	Code        string
+	Name        string
+	Value       *string
+	Type        *string
+	Description *string
+	ParentCode  string // empty when the parameter has no parent
+}
+
 type Repository interface {
 	Create(ctx context.Context, code, name string, value, typeVal, desc *string) (*models.Parameter, error)
 	Update(ctx context.Context, id uuid.UUID, code, name string, value, typeVal, desc *string) (*models.Parameter, error)
@@ -22,4 +32,13 @@ type Repository interface {
 	AssignParametersToModule(ctx context.Context, moduleType string, moduleID uuid.UUID, parameterIDs []uuid.UUID) error
 	GetByModule(ctx context.Context, moduleType string, moduleID uuid.UUID) ([]models.Parameter, error)
 	RemoveParametersFromModule(ctx context.Context, moduleType string, moduleID uuid.UUID) error
+	GetChildTypes(ctx context.Context, id uuid.UUID) ([]string, error)
+	GetByCodes(ctx context.Context, codes []string) ([]models.Parameter, error)
+	ImportParameters(ctx context.Context, params []ImportParameterParams) error
+	GetLookupEntries(ctx context.Context) ([]models.Parameter, error)
+	GetTree(ctx context.Context, typeCode string) ([]models.Parameter, error)
+	GetAncestorIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error)
+	GetSiblings(ctx context.Context, parentID uuid.UUID, typeVal *string) ([]models.Parameter, error)
+	Move(ctx context.Context, id uuid.UUID, parentID uuid.UUID, position int) error
+	Reorder(ctx context.Context, ids []uuid.UUID) error
 }
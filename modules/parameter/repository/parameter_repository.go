@@ -3,16 +3,31 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/i18n"
 	"github.com/rendyfutsuy/base-go/helpers/request"
 	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/parameter"
 	"github.com/rendyfutsuy/base-go/modules/parameter/dto"
 	rsearchparam "github.com/rendyfutsuy/base-go/modules/parameter/repository/searches"
 	"gorm.io/gorm"
 )
 
+// parameterDeletableSelect selects whether a parameter can be deleted: deleting a parameter deletes its descendants,
+// so neither the parameter nor any descendant may be used by another module
+const parameterDeletableSelect = `NOT EXISTS (
+	WITH RECURSIVE subtree AS (
+		SELECT p.id
+		UNION
+		SELECT child.id FROM parameters child JOIN subtree ON child.parent_id = subtree.id WHERE child.deleted_at IS NULL
+	)
+	SELECT 1 FROM parameters_to_module ptm JOIN subtree ON subtree.id = ptm.parameter_id
+) AS deletable`
+
 type parameterRepository struct {
 	DB *gorm.DB
 }
@@ -52,7 +67,13 @@ func (r *parameterRepository) Create(ctx context.Context, code, name string, val
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}
-	if err := r.DB.WithContext(ctx).Create(p).Error; err != nil {
+	// a new parameter has no parent yet, it goes last among the parameters of its type without parent
+	sortOrder, err := nextSortOrder(r.DB.WithContext(ctx), uuid.Nil, typeVal)
+	if err != nil {
+		return nil, err
+	}
+	p.SortOrder = sortOrder
+	if err := r.DB.WithContext(ctx).Omit("ParentID").Create(p).Error; err != nil {
 		return nil, err
 	}
 	if p.ID == uuid.Nil {
@@ -96,29 +117,64 @@ func (r *parameterRepository) Update(ctx context.Context, id uuid.UUID, code, na
 	return p, nil
 }
 
+// SetParent links a parameter to its parent, uuid.Nil removes the parent.
+// A parameter that changes parent goes last among its new siblings.
 func (r *parameterRepository) SetParent(ctx context.Context, id uuid.UUID, parentID uuid.UUID) error {
-	updates := map[string]interface{}{
-		"parent_id":  parentID,
-		"updated_at": time.Now().UTC(),
-	}
-	return r.DB.WithContext(ctx).Model(&models.Parameter{}).
-		Where("id = ? AND deleted_at IS NULL", id).
-		Updates(updates).Error
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		current := &models.Parameter{}
+		if err := tx.Select("id, type, parent_id").Where("id = ? AND deleted_at IS NULL", id).First(current).Error; err != nil {
+			return err
+		}
+		updates := map[string]interface{}{
+			"parent_id":  nullableParentID(parentID),
+			"updated_at": time.Now().UTC(),
+		}
+		if current.ParentID != parentID {
+			sortOrder, err := nextSortOrder(tx, parentID, current.Type)
+			if err != nil {
+				return err
+			}
+			updates["sort_order"] = sortOrder
+		}
+		return tx.Model(&models.Parameter{}).Where("id = ?", id).Updates(updates).Error
+	})
 }
 
+// Delete soft deletes a parameter with all its descendants, ParameterInUse when one of them is used by another module
 func (r *parameterRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.DB.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).Delete(&models.Parameter{}).Error
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []uuid.UUID
+		if err := tx.Raw(`WITH RECURSIVE subtree AS (
+				SELECT id FROM parameters WHERE id = ? AND deleted_at IS NULL
+				UNION
+				SELECT child.id FROM parameters child JOIN subtree ON child.parent_id = subtree.id WHERE child.deleted_at IS NULL
+			)
+			SELECT id FROM subtree`, id).Scan(&ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		var used int64
+		if err := tx.Model(&models.ParametersToModule{}).Where("parameter_id IN ?", ids).Count(&used).Error; err != nil {
+			return err
+		}
+		if used > 0 {
+			return fmt.Errorf(constants.ParameterInUse, id)
+		}
+		return tx.Where("id IN ?", ids).Delete(&models.Parameter{}).Error
+	})
 }
 
 func (r *parameterRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Parameter, error) {
 	p := &models.Parameter{}
 	if err := r.DB.WithContext(ctx).
 		Table("parameters p").
-		Select(`p.id, p.code, p.name, p.value, p.type, p.description, p.created_at, p.updated_at, p.parent_id, parent.name AS parent_name,
-			(
-				NOT EXISTS (SELECT 1 FROM parameters_to_module ptm WHERE ptm.parameter_id = p.id)
-				AND NOT EXISTS (SELECT 1 FROM parameters child WHERE child.parent_id = p.id AND child.deleted_at IS NULL)
-			) AS deletable`).
+		Select(`p.id, p.code, `+i18n.NameColumn(ctx, constants.TranslationEntityParameter, "p.id", "p.name")+` AS name, p.value, p.type, p.description,
+			p.created_at, p.updated_at, p.parent_id, p.sort_order, `+
+			i18n.NameColumn(ctx, constants.TranslationEntityParameter, "parent.id", "parent.name")+` AS parent_name, `+
+			parameterDeletableSelect).
 		Joins("LEFT JOIN parameters parent ON parent.id = p.parent_id AND parent.deleted_at IS NULL").
 		Where("p.id = ? AND p.deleted_at IS NULL", id).
 		Scan(p).Error; err != nil {
@@ -153,11 +209,9 @@ func (r *parameterRepository) ExistsByName(ctx context.Context, name string, exc
 
 func (r *parameterRepository) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqParameterIndexFilter) ([]models.Parameter, int, error) {
 	var parameters []models.Parameter
-	query := r.DB.WithContext(ctx).Table("parameters p").Select(`p.id, p.code, p.name, p.value, p.type, p.description, p.created_at, p.updated_at,
-		(
-			NOT EXISTS (SELECT 1 FROM parameters_to_module ptm WHERE ptm.parameter_id = p.id)
-			AND NOT EXISTS (SELECT 1 FROM parameters child WHERE child.parent_id = p.id AND child.deleted_at IS NULL)
-		) AS deletable`).
+	query := r.DB.WithContext(ctx).Table("parameters p").Select(`p.id, p.code, ` + i18n.NameColumn(ctx, constants.TranslationEntityParameter, "p.id", "p.name") + ` AS name,
+		p.value, p.type, p.description, p.created_at, p.updated_at, p.sort_order, ` +
+		parameterDeletableSelect).
 		Where("p.deleted_at IS NULL")
 
 		// Apply search from PageRequest
@@ -184,10 +238,10 @@ func (r *parameterRepository) GetIndex(ctx context.Context, req request.PageRequ
 func (r *parameterRepository) GetAll(ctx context.Context, filter dto.ReqParameterIndexFilter) ([]models.Parameter, error) {
 	var parameters []models.Parameter
 	query := r.DB.WithContext(ctx).Table("parameters p").Select(`p.id, p.code, p.name, p.value, p.type, p.description, p.created_at, p.updated_at,
-		(
-			NOT EXISTS (SELECT 1 FROM parameters_to_module ptm WHERE ptm.parameter_id = p.id)
-			AND NOT EXISTS (SELECT 1 FROM parameters child WHERE child.parent_id = p.id AND child.deleted_at IS NULL)
-		) AS deletable`).
+		p.parent_id, p.sort_order, parent.name AS parent_name, parent.code AS parent_code, pt.value_kind, ` +
+		parameterDeletableSelect).
+		Joins("LEFT JOIN parameters parent ON parent.id = p.parent_id AND parent.deleted_at IS NULL").
+		Joins("LEFT JOIN parameter_types pt ON pt.code = p.type").
 		Where("p.deleted_at IS NULL")
 
 	// Apply search from filter
@@ -218,7 +272,7 @@ func (r *parameterRepository) GetByModule(ctx context.Context, moduleType string
 	var params []models.Parameter
 	if err := r.DB.WithContext(ctx).
 		Table("parameters p").
-		Select("p.id, p.name, p.type").
+		Select("p.id, p.code, p.name, p.type").
 		Joins("JOIN parameters_to_module ptm ON ptm.parameter_id = p.id").
 		Where("ptm.module_type = ? AND ptm.module_id = ? AND p.deleted_at IS NULL", moduleType, moduleID).
 		Find(&params).Error; err != nil {
@@ -232,3 +286,87 @@ func (r *parameterRepository) RemoveParametersFromModule(ctx context.Context, mo
 		Where("module_type = ? AND module_id = ?", moduleType, moduleID).
 		Delete(&models.ParametersToModule{}).Error
 }
+
+// GetChildTypes returns the distinct types of the children of a parameter, empty for children without type
+func (r *parameterRepository) GetChildTypes(ctx context.Context, id uuid.UUID) ([]string, error) {
+	var types []string
+	if err := r.DB.WithContext(ctx).
+		Model(&models.Parameter{}).
+		Distinct().
+		Where("parent_id = ?", id).
+		Pluck("COALESCE(type, '')", &types).Error; err != nil {
+		return nil, err
+	}
+	return types, nil
+}
+
+func (r *parameterRepository) GetByCodes(ctx context.Context, codes []string) ([]models.Parameter, error) {
+	var params []models.Parameter
+	if len(codes) == 0 {
+		return params, nil
+	}
+	if err := r.DB.WithContext(ctx).
+		Table("parameters p").
+		Select("p.id, p.code, p.name, p.value, p.type, p.parent_id").
+		Where("p.code IN ? AND p.deleted_at IS NULL", codes).
+		Find(&params).Error; err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+// ImportParameters creates or updates the parameters by code, then links them to their parents by code,
+// in one transaction so parents may come from the same import
+func (r *parameterRepository) ImportParameters(ctx context.Context, params []mod.ImportParameterParams) error {
+	now := time.Now().UTC()
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, p := range params {
+			err := tx.Exec(`INSERT INTO parameters (code, name, value, type, description, sort_order, created_at, updated_at)
+				VALUES (?, ?, ?, ?, ?, 0, ?, ?)
+				ON CONFLICT (code) DO UPDATE SET name = EXCLUDED.name, value = EXCLUDED.value, type = EXCLUDED.type,
+					description = EXCLUDED.description, updated_at = EXCLUDED.updated_at`,
+				p.Code, p.Name, p.Value, p.Type, p.Description, now, now).Error
+			if err != nil {
+				return err
+			}
+		}
+		for _, p := range params {
+			parent := gorm.Expr("NULL")
+			if p.ParentCode != "" {
+				parent = gorm.Expr("(SELECT id FROM parameters WHERE code = ? AND deleted_at IS NULL)", p.ParentCode)
+			}
+			// new parameters and parameters changing parent go last among their siblings
+			err := tx.Exec(`UPDATE parameters p SET parent_id = n.parent_id,
+					sort_order = CASE WHEN p.sort_order = 0 OR p.parent_id IS DISTINCT FROM n.parent_id THEN (
+						SELECT COALESCE(MAX(s.sort_order), 0) + 1 FROM parameters s
+						WHERE s.id <> p.id AND s.deleted_at IS NULL AND (
+							s.parent_id = n.parent_id OR (n.parent_id IS NULL AND s.parent_id IS NULL AND s.type IS NOT DISTINCT FROM p.type)
+						)
+					) ELSE p.sort_order END
+				FROM (SELECT ?::uuid AS parent_id) n
+				WHERE p.code = ?`, parent, p.Code).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetLookupEntries returns every parameter with the code of its parent, in sibling order, to fill the lookup cache.
+// Names are in the locale of the request.
+func (r *parameterRepository) GetLookupEntries(ctx context.Context) ([]models.Parameter, error) {
+	var params []models.Parameter
+	if err := r.DB.WithContext(ctx).
+		Table("parameters p").
+		Select(`p.id, p.code, ` + i18n.NameColumn(ctx, constants.TranslationEntityParameter, "p.id", "p.name") + ` AS name,
+			p.value, p.type, p.description, p.created_at, p.updated_at, p.parent_id, p.sort_order,
+			` + i18n.NameColumn(ctx, constants.TranslationEntityParameter, "parent.id", "parent.name") + ` AS parent_name, parent.code AS parent_code`).
+		Joins("LEFT JOIN parameters parent ON parent.id = p.parent_id AND parent.deleted_at IS NULL").
+		Where("p.deleted_at IS NULL").
+		Order("p.sort_order ASC, name ASC").
+		Find(&params).Error; err != nil {
+		return nil, err
+	}
+	return params, nil
+}
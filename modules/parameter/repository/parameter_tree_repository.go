@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/i18n"
+	"github.com/rendyfutsuy/base-go/models"
+	"gorm.io/gorm"
+)
+
+// GetTree returns the parameters of a type in sibling order, with the parent and deletable flag of each one
+func (r *parameterRepository) GetTree(ctx context.Context, typeCode string) ([]models.Parameter, error) {
+	var params []models.Parameter
+	if err := r.DB.WithContext(ctx).
+		Table("parameters p").
+		Select(`p.id, p.code, `+i18n.NameColumn(ctx, constants.TranslationEntityParameter, "p.id", "p.name")+` AS name,
+			p.value, p.type, p.description, p.created_at, p.updated_at, p.parent_id, p.sort_order, `+
+			parameterDeletableSelect).
+		Where("p.type = ? AND p.deleted_at IS NULL", typeCode).
+		Order("p.sort_order ASC, p.name ASC").
+		Find(&params).Error; err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+// GetAncestorIDs returns the IDs of the parent, grandparent, ... of a parameter
+func (r *parameterRepository) GetAncestorIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	// UNION (not UNION ALL) stops at an existing cycle instead of looping
+	if err := r.DB.WithContext(ctx).Raw(`WITH RECURSIVE ancestors AS (
+			SELECT parent_id AS id FROM parameters WHERE id = ? AND parent_id IS NOT NULL
+			UNION
+			SELECT p.parent_id FROM parameters p JOIN ancestors a ON p.id = a.id WHERE p.parent_id IS NOT NULL
+		)
+		SELECT id FROM ancestors`, id).Scan(&ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetSiblings returns the children of a parent in sibling order,
+// for uuid.Nil the parameters of the type without parent
+func (r *parameterRepository) GetSiblings(ctx context.Context, parentID uuid.UUID, typeVal *string) ([]models.Parameter, error) {
+	var params []models.Parameter
+	if err := siblingScope(r.DB.WithContext(ctx).Model(&models.Parameter{}), parentID, typeVal).
+		Select("id, code, name, type, parent_id, sort_order").
+		Order("sort_order ASC, name ASC").
+		Find(&params).Error; err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+// Move links a parameter to another parent (uuid.Nil for none) at a position among its new siblings,
+// starting at 1, 0 puts it last. The siblings are renumbered in one transaction.
+func (r *parameterRepository) Move(ctx context.Context, id uuid.UUID, parentID uuid.UUID, position int) error {
+	now := time.Now().UTC()
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		current := &models.Parameter{}
+		if err := tx.Select("id, type, parent_id").Where("id = ? AND deleted_at IS NULL", id).First(current).Error; err != nil {
+			return err
+		}
+
+		var siblingIDs []uuid.UUID
+		if err := siblingScope(tx.Model(&models.Parameter{}), parentID, current.Type).
+			Where("id <> ?", id).
+			Order("sort_order ASC, name ASC").
+			Pluck("id", &siblingIDs).Error; err != nil {
+			return err
+		}
+		if position <= 0 || position > len(siblingIDs) {
+			position = len(siblingIDs) + 1
+		}
+		ordered := make([]uuid.UUID, 0, len(siblingIDs)+1)
+		ordered = append(ordered, siblingIDs[:position-1]...)
+		ordered = append(ordered, id)
+		ordered = append(ordered, siblingIDs[position-1:]...)
+
+		if err := tx.Model(&models.Parameter{}).Where("id = ?", id).Updates(map[string]interface{}{
+			"parent_id":  nullableParentID(parentID),
+			"updated_at": now,
+		}).Error; err != nil {
+			return err
+		}
+		if err := renumberSiblings(tx, ordered); err != nil {
+			return err
+		}
+
+		// close the gap among the former siblings
+		if current.ParentID == parentID {
+			return nil
+		}
+		var formerIDs []uuid.UUID
+		if err := siblingScope(tx.Model(&models.Parameter{}), current.ParentID, current.Type).
+			Order("sort_order ASC, name ASC").
+			Pluck("id", &formerIDs).Error; err != nil {
+			return err
+		}
+		return renumberSiblings(tx, formerIDs)
+	})
+}
+
+// Reorder sets the sort order of siblings to their position in ids
+func (r *parameterRepository) Reorder(ctx context.Context, ids []uuid.UUID) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return renumberSiblings(tx, ids)
+	})
+}
+
+// siblingScope restricts a query to the children of a parent, for uuid.Nil to the parameters of the type without parent
+func siblingScope(query *gorm.DB, parentID uuid.UUID, typeVal *string) *gorm.DB {
+	query = query.Where("deleted_at IS NULL")
+	if parentID != uuid.Nil {
+		return query.Where("parent_id = ?", parentID)
+	}
+	query = query.Where("parent_id IS NULL")
+	if typeVal == nil {
+		return query.Where("type IS NULL")
+	}
+	return query.Where("type = ?", *typeVal)
+}
+
+// nextSortOrder returns the sort order placing a parameter last among the siblings
+func nextSortOrder(db *gorm.DB, parentID uuid.UUID, typeVal *string) (int, error) {
+	var sortOrder int
+	if err := siblingScope(db.Model(&models.Parameter{}), parentID, typeVal).
+		Select("COALESCE(MAX(sort_order), 0) + 1").
+		Scan(&sortOrder).Error; err != nil {
+		return 0, err
+	}
+	return sortOrder, nil
+}
+
+// renumberSiblings sets the sort order of the parameters to their position, starting at 1
+func renumberSiblings(tx *gorm.DB, ids []uuid.UUID) error {
+	for i, id := range ids {
+		if err := tx.Model(&models.Parameter{}).Where("id = ?", id).UpdateColumn("sort_order", i+1).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nullableParentID stores uuid.Nil as NULL
+func nullableParentID(parentID uuid.UUID) interface{} {
+	if parentID == uuid.Nil {
+		return nil
+	}
+	return parentID
+}
@@ -3,20 +3,31 @@ package test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
 	"github.com/rendyfutsuy/base-go/helpers/request"
 	"github.com/rendyfutsuy/base-go/models"
+	paramMod "github.com/rendyfutsuy/base-go/modules/parameter"
 	parameterDto "github.com/rendyfutsuy/base-go/modules/parameter/dto"
 	"github.com/rendyfutsuy/base-go/modules/parameter/usecase"
+	parameterTypeMod "github.com/rendyfutsuy/base-go/modules/parameter_type"
+	"github.com/rendyfutsuy/base-go/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 // MockParameterRepository is a mock implementation of parameter.Repository
@@ -98,6 +109,126 @@ func (m *MockParameterRepository) RemoveParametersFromModule(ctx context.Context
 	args := m.Called(ctx, moduleType, moduleID)
 	return args.Error(0)
 }
+func (m *MockParameterRepository) GetChildTypes(ctx context.Context, id uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+func (m *MockParameterRepository) GetByCodes(ctx context.Context, codes []string) ([]models.Parameter, error) {
+	args := m.Called(ctx, codes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Parameter), args.Error(1)
+}
+func (m *MockParameterRepository) ImportParameters(ctx context.Context, params []paramMod.ImportParameterParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+func (m *MockParameterRepository) GetLookupEntries(ctx context.Context) ([]models.Parameter, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Parameter), args.Error(1)
+}
+
+func (m *MockParameterRepository) GetTree(ctx context.Context, typeCode string) ([]models.Parameter, error) {
+	args := m.Called(ctx, typeCode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Parameter), args.Error(1)
+}
+
+func (m *MockParameterRepository) GetAncestorIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockParameterRepository) GetSiblings(ctx context.Context, parentID uuid.UUID, typeVal *string) ([]models.Parameter, error) {
+	args := m.Called(ctx, parentID, typeVal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Parameter), args.Error(1)
+}
+
+func (m *MockParameterRepository) Move(ctx context.Context, id uuid.UUID, parentID uuid.UUID, position int) error {
+	args := m.Called(ctx, id, parentID, position)
+	return args.Error(0)
+}
+
+func (m *MockParameterRepository) Reorder(ctx context.Context, ids []uuid.UUID) error {
+	args := m.Called(ctx, ids)
+	return args.Error(0)
+}
+
+// MockParameterTypeRepository is a mock implementation of parameter_type.Repository
+type MockParameterTypeRepository struct {
+	mock.Mock
+}
+
+func (m *MockParameterTypeRepository) GetAll(ctx context.Context) ([]models.ParameterType, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ParameterType), args.Error(1)
+}
+func (m *MockParameterTypeRepository) GetByCode(ctx context.Context, code string) (*models.ParameterType, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ParameterType), args.Error(1)
+}
+func (m *MockParameterTypeRepository) GetByCodes(ctx context.Context, codes []string) ([]models.ParameterType, error) {
+	args := m.Called(ctx, codes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ParameterType), args.Error(1)
+}
+func (m *MockParameterTypeRepository) Create(ctx context.Context, parameterType models.ParameterType) (*models.ParameterType, error) {
+	panic("not implemented")
+}
+func (m *MockParameterTypeRepository) Update(ctx context.Context, parameterType models.ParameterType) (*models.ParameterType, error) {
+	panic("not implemented")
+}
+func (m *MockParameterTypeRepository) Delete(ctx context.Context, code string) error {
+	panic("not implemented")
+}
+func (m *MockParameterTypeRepository) CountParameters(ctx context.Context, code string) (int64, error) {
+	panic("not implemented")
+}
+func (m *MockParameterTypeRepository) GetParameterUsages(ctx context.Context, code string) ([]parameterTypeMod.ParameterUsage, error) {
+	panic("not implemented")
+}
+
+// stringParameterType returns a registered free-text type accepting the given parent types
+func stringParameterType(code string, allowedParentTypes ...string) *models.ParameterType {
+	return &models.ParameterType{
+		Code:               code,
+		Name:               code,
+		ValueKind:          constants.ParameterValueKindString,
+		AllowedParentTypes: utils.NullStringArray{Strings: allowedParentTypes, Valid: true},
+	}
+}
+
+// newMockParameterTypeRepository returns a type repository knowing the types used by the CRUD tests
+func newMockParameterTypeRepository() *MockParameterTypeRepository {
+	m := new(MockParameterTypeRepository)
+	for _, code := range []string{"test-type", "updated-type"} {
+		m.On("GetByCode", mock.Anything, code).Return(stringParameterType(code), nil).Maybe()
+	}
+	return m
+}
 
 func TestCreateParameter(t *testing.T) {
 	e := echo.New()
@@ -243,7 +374,7 @@ func TestCreateParameter(t *testing.T) {
 			mockRepo := new(MockParameterRepository)
 			tt.setupMock(mockRepo)
 
-			usecaseInstance := usecase.NewParameterUsecase(mockRepo)
+			usecaseInstance := usecase.NewParameterUsecase(mockRepo, newMockParameterTypeRepository(), usecase.NewParameterLookup(mockRepo, nil, 0))
 
 			req := httptest.NewRequest(http.MethodPost, "/", nil)
 			rec := httptest.NewRecorder()
@@ -311,6 +442,8 @@ func TestUpdateParameter(t *testing.T) {
 			setupMock: func(m *MockParameterRepository) {
 				m.On("ExistsByCode", ctx, "UPDATED001", validID).Return(false, nil).Once()
 				m.On("ExistsByName", ctx, "Updated Parameter", validID).Return(false, nil).Once()
+				m.On("GetByID", ctx, validID).Return(&models.Parameter{ID: validID, Code: "UPDATED001"}, nil).Once()
+				m.On("GetChildTypes", ctx, validID).Return([]string{}, nil).Once()
 				m.On("Update", ctx, validID, "UPDATED001", "Updated Parameter", &value, &typeVal, &desc).Return(&models.Parameter{
 					ID:          validID,
 					Code:        "UPDATED001",
@@ -384,6 +517,7 @@ func TestUpdateParameter(t *testing.T) {
 			setupMock: func(m *MockParameterRepository) {
 				m.On("ExistsByCode", ctx, "TEST001", validID).Return(false, nil).Once()
 				m.On("ExistsByName", ctx, "Updated Parameter", validID).Return(false, nil).Once()
+				m.On("GetChildTypes", ctx, validID).Return([]string{}, nil).Once()
 				m.On("Update", ctx, validID, "TEST001", "Updated Parameter", (*string)(nil), (*string)(nil), (*string)(nil)).Return(nil, errors.New("update failed")).Once()
 			},
 			expectedError:  errors.New("update failed"),
@@ -396,7 +530,7 @@ func TestUpdateParameter(t *testing.T) {
 			mockRepo := new(MockParameterRepository)
 			tt.setupMock(mockRepo)
 
-			usecaseInstance := usecase.NewParameterUsecase(mockRepo)
+			usecaseInstance := usecase.NewParameterUsecase(mockRepo, newMockParameterTypeRepository(), usecase.NewParameterLookup(mockRepo, nil, 0))
 
 			req := httptest.NewRequest(http.MethodPut, "/:id", nil)
 			rec := httptest.NewRecorder()
@@ -471,7 +605,7 @@ func TestDeleteParameter(t *testing.T) {
 			mockRepo := new(MockParameterRepository)
 			tt.setupMock(mockRepo)
 
-			usecaseInstance := usecase.NewParameterUsecase(mockRepo)
+			usecaseInstance := usecase.NewParameterUsecase(mockRepo, newMockParameterTypeRepository(), usecase.NewParameterLookup(mockRepo, nil, 0))
 
 			req := httptest.NewRequest(http.MethodDelete, "/:id", nil)
 			rec := httptest.NewRecorder()
@@ -560,7 +694,7 @@ func TestGetParameterByID(t *testing.T) {
 			mockRepo := new(MockParameterRepository)
 			tt.setupMock(mockRepo)
 
-			usecaseInstance := usecase.NewParameterUsecase(mockRepo)
+			usecaseInstance := usecase.NewParameterUsecase(mockRepo, newMockParameterTypeRepository(), usecase.NewParameterLookup(mockRepo, nil, 0))
 
 			req := httptest.NewRequest(http.MethodGet, "/:id", nil)
 			rec := httptest.NewRecorder()
@@ -690,7 +824,7 @@ func TestGetIndexParameter(t *testing.T) {
 			mockRepo := new(MockParameterRepository)
 			tt.setupMock(mockRepo)
 
-			usecaseInstance := usecase.NewParameterUsecase(mockRepo)
+			usecaseInstance := usecase.NewParameterUsecase(mockRepo, newMockParameterTypeRepository(), usecase.NewParameterLookup(mockRepo, nil, 0))
 
 			req := httptest.NewRequest(http.MethodGet, "/", nil)
 			rec := httptest.NewRecorder()
@@ -807,7 +941,7 @@ func TestExportParameter(t *testing.T) {
 			mockRepo := new(MockParameterRepository)
 			tt.setupMock(mockRepo)
 
-			usecaseInstance := usecase.NewParameterUsecase(mockRepo)
+			usecaseInstance := usecase.NewParameterUsecase(mockRepo, newMockParameterTypeRepository(), usecase.NewParameterLookup(mockRepo, nil, 0))
 
 			u := &url.URL{Path: "/export"}
 			if tt.search != "" {
@@ -839,3 +973,216 @@ func TestExportParameter(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateParameterWithType(t *testing.T) {
+	ctx := context.Background()
+	parentID := uuid.New()
+	priority := "priority"
+	topic := "topic"
+	lang := "lang"
+	unknown := "unknown"
+	rawValue := " 007 "
+	canonicalValue := "7"
+
+	priorityType := &models.ParameterType{Code: "priority", Name: "Priority", ValueKind: constants.ParameterValueKindInt}
+
+	tests := []struct {
+		name          string
+		req           *parameterDto.ReqCreateParameter
+		setupMock     func(*MockParameterRepository, *MockParameterTypeRepository)
+		expectedError string
+	}{
+		{
+			name: "success stores the canonical value of the type",
+			req:  &parameterDto.ReqCreateParameter{Code: "PRIO-1", Name: "Priority One", Value: &rawValue, Type: &priority},
+			setupMock: func(m *MockParameterRepository, tm *MockParameterTypeRepository) {
+				m.On("ExistsByCode", ctx, "PRIO-1", uuid.Nil).Return(false, nil).Once()
+				m.On("ExistsByName", ctx, "Priority One", uuid.Nil).Return(false, nil).Once()
+				tm.On("GetByCode", ctx, "priority").Return(priorityType, nil).Once()
+				m.On("Create", ctx, "PRIO-1", "Priority One", &canonicalValue, &priority, (*string)(nil)).Return(&models.Parameter{ID: uuid.New(), Code: "PRIO-1"}, nil).Once()
+			},
+		},
+		{
+			name: "error when value does not match the value kind",
+			req:  &parameterDto.ReqCreateParameter{Code: "PRIO-1", Name: "Priority One", Value: &topic, Type: &priority},
+			setupMock: func(m *MockParameterRepository, tm *MockParameterTypeRepository) {
+				m.On("ExistsByCode", ctx, "PRIO-1", uuid.Nil).Return(false, nil).Once()
+				m.On("ExistsByName", ctx, "Priority One", uuid.Nil).Return(false, nil).Once()
+				tm.On("GetByCode", ctx, "priority").Return(priorityType, nil).Once()
+			},
+			expectedError: fmt.Sprintf(constants.ParameterValueInvalidInt, "priority"),
+		},
+		{
+			name: "error when type is not registered",
+			req:  &parameterDto.ReqCreateParameter{Code: "X-1", Name: "Unknown One", Type: &unknown},
+			setupMock: func(m *MockParameterRepository, tm *MockParameterTypeRepository) {
+				m.On("ExistsByCode", ctx, "X-1", uuid.Nil).Return(false, nil).Once()
+				m.On("ExistsByName", ctx, "Unknown One", uuid.Nil).Return(false, nil).Once()
+				tm.On("GetByCode", ctx, "unknown").Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: fmt.Sprintf(constants.ParameterTypeNotRegistered, "unknown"),
+		},
+		{
+			name: "error when parent type is not allowed",
+			req:  &parameterDto.ReqCreateParameter{Code: "TOPIC-1", Name: "Topic One", Type: &topic, ParentId: &parentID},
+			setupMock: func(m *MockParameterRepository, tm *MockParameterTypeRepository) {
+				m.On("ExistsByCode", ctx, "TOPIC-1", uuid.Nil).Return(false, nil).Once()
+				m.On("ExistsByName", ctx, "Topic One", uuid.Nil).Return(false, nil).Once()
+				tm.On("GetByCode", ctx, "topic").Return(stringParameterType("topic", "topic"), nil).Once()
+				m.On("GetByID", ctx, parentID).Return(&models.Parameter{ID: parentID, Code: "ID", Type: &lang}, nil).Once()
+			},
+			expectedError: fmt.Sprintf(constants.ParameterParentTypeNotAllowed, "topic", "lang"),
+		},
+		{
+			name: "error when parent does not exist",
+			req:  &parameterDto.ReqCreateParameter{Code: "TOPIC-1", Name: "Topic One", ParentId: &parentID},
+			setupMock: func(m *MockParameterRepository, tm *MockParameterTypeRepository) {
+				m.On("ExistsByCode", ctx, "TOPIC-1", uuid.Nil).Return(false, nil).Once()
+				m.On("ExistsByName", ctx, "Topic One", uuid.Nil).Return(false, nil).Once()
+				m.On("GetByID", ctx, parentID).Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: constants.ParameterParentInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockParameterRepository)
+			mockTypeRepo := new(MockParameterTypeRepository)
+			tt.setupMock(mockRepo, mockTypeRepo)
+
+			result, err := usecase.NewParameterUsecase(mockRepo, mockTypeRepo, usecase.NewParameterLookup(mockRepo, nil, 0)).Create(ctx, tt.req, "test-auth-id")
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockTypeRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateParameterWithType(t *testing.T) {
+	ctx := context.Background()
+	validID := uuid.New()
+	lang := "lang"
+
+	tests := []struct {
+		name          string
+		req           *parameterDto.ReqUpdateParameter
+		setupMock     func(*MockParameterRepository, *MockParameterTypeRepository)
+		expectedError string
+	}{
+		{
+			name: "error when parent is the parameter itself",
+			req:  &parameterDto.ReqUpdateParameter{Code: "TOPIC-1", Name: "Topic One", ParentId: &validID},
+			setupMock: func(m *MockParameterRepository, tm *MockParameterTypeRepository) {
+				m.On("ExistsByCode", ctx, "TOPIC-1", validID).Return(false, nil).Once()
+				m.On("ExistsByName", ctx, "Topic One", validID).Return(false, nil).Once()
+			},
+			expectedError: constants.ParameterParentSelf,
+		},
+		{
+			name: "error when children do not accept the new type",
+			req:  &parameterDto.ReqUpdateParameter{Code: "TOPIC-1", Name: "Topic One", Type: &lang, ParentId: &uuid.Nil},
+			setupMock: func(m *MockParameterRepository, tm *MockParameterTypeRepository) {
+				m.On("ExistsByCode", ctx, "TOPIC-1", validID).Return(false, nil).Once()
+				m.On("ExistsByName", ctx, "Topic One", validID).Return(false, nil).Once()
+				tm.On("GetByCode", ctx, "lang").Return(stringParameterType("lang"), nil).Once()
+				m.On("GetChildTypes", ctx, validID).Return([]string{"topic", ""}, nil).Once()
+				tm.On("GetByCodes", ctx, []string{"topic"}).Return([]models.ParameterType{*stringParameterType("topic", "topic")}, nil).Once()
+			},
+			expectedError: fmt.Sprintf(constants.ParameterChildTypeNotAllowed, "topic", "lang"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockParameterRepository)
+			mockTypeRepo := new(MockParameterTypeRepository)
+			tt.setupMock(mockRepo, mockTypeRepo)
+
+			result, err := usecase.NewParameterUsecase(mockRepo, mockTypeRepo, usecase.NewParameterLookup(mockRepo, nil, 0)).Update(ctx, validID.String(), tt.req, "test-auth-id")
+
+			assert.EqualError(t, err, tt.expectedError)
+			assert.Nil(t, result)
+			mockRepo.AssertExpectations(t)
+			mockTypeRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func writeParameterImportFile(t *testing.T, rows [][]string) string {
+	f := excelize.NewFile()
+	defer f.Close()
+	require.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]string{"Code", "Name", "Value", "Type", "Description", "Parent Code"}))
+	for i, row := range rows {
+		cell, _ := excelize.CoordinatesToCellName(1, i+2)
+		require.NoError(t, f.SetSheetRow("Sheet1", cell, &row))
+	}
+	path := filepath.Join(t.TempDir(), "parameters.xlsx")
+	require.NoError(t, f.SaveAs(path))
+	return path
+}
+
+func TestImportParameters(t *testing.T) {
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+	ctx := context.Background()
+	existingID := uuid.New()
+	topic := "topic"
+
+	path := writeParameterImportFile(t, [][]string{
+		{"TOPIC-NEWS", "News", "", "topic"},
+		{"TOPIC-LOCAL", "Local News", "", "topic", "", "TOPIC-NEWS"},
+		{"PRIO-1", "Priority One", "abc", "priority"},
+		{"PRIO-2", "Priority Two", "2", "priority", "", "PRIO-1"},
+		{"LANG-ID", "Bahasa", "", "unknown"},
+		{"TOPIC-NEWS", "Duplicated News", "", "topic"},
+		{"EXIST", "Existing Renamed", "", "topic", "", "MISSING"},
+	})
+	defer os.Remove(path)
+
+	mockRepo := new(MockParameterRepository)
+	mockTypeRepo := new(MockParameterTypeRepository)
+	mockRepo.On("GetByCodes", ctx, []string{"TOPIC-NEWS", "TOPIC-LOCAL", "PRIO-1", "PRIO-2", "LANG-ID", "EXIST", "MISSING"}).
+		Return([]models.Parameter{{ID: existingID, Code: "EXIST", Type: &topic}}, nil).Once()
+	mockTypeRepo.On("GetByCodes", ctx, []string{"topic", "priority", "unknown"}).Return([]models.ParameterType{
+		*stringParameterType("topic", "topic"),
+		{Code: "priority", ValueKind: constants.ParameterValueKindInt, AllowedParentTypes: utils.NullStringArray{Strings: []string{"priority"}, Valid: true}},
+	}, nil).Once()
+	for _, code := range []string{"TOPIC-NEWS", "TOPIC-LOCAL", "PRIO-1", "PRIO-2", "LANG-ID"} {
+		mockRepo.On("ExistsByCode", ctx, code, uuid.Nil).Return(false, nil).Once()
+	}
+	for _, name := range []string{"News", "Local News", "Priority One", "Priority Two", "Bahasa", "Duplicated News"} {
+		mockRepo.On("ExistsByName", ctx, name, uuid.Nil).Return(false, nil).Once()
+	}
+	mockRepo.On("ExistsByName", ctx, "Existing Renamed", existingID).Return(false, nil).Once()
+	mockRepo.On("GetLookupEntries", ctx).Return([]models.Parameter{{ID: existingID, Code: "EXIST", Type: &topic}}, nil).Once()
+	mockRepo.On("ImportParameters", ctx, []paramMod.ImportParameterParams{
+		{Code: "TOPIC-NEWS", Name: "News", Type: &topic},
+		{Code: "TOPIC-LOCAL", Name: "Local News", Type: &topic, ParentCode: "TOPIC-NEWS"},
+	}).Return(nil).Once()
+
+	res, err := usecase.NewParameterUsecase(mockRepo, mockTypeRepo, usecase.NewParameterLookup(mockRepo, nil, 0)).ImportFromExcel(ctx, path, "test-auth-id")
+	require.NoError(t, err)
+
+	assert.Equal(t, 7, res.TotalRows)
+	assert.Equal(t, 2, res.SuccessCount)
+	assert.Equal(t, 5, res.FailedCount)
+	assert.Equal(t, "success", res.Results[0].Status)
+	assert.Equal(t, "success", res.Results[1].Status)
+	assert.Equal(t, fmt.Sprintf(constants.ParameterValueInvalidInt, "priority"), res.Results[2].ErrorMessage)
+	assert.Equal(t, fmt.Sprintf(constants.ParameterImportParentRowFailed, 4), res.Results[3].ErrorMessage)
+	assert.Equal(t, fmt.Sprintf(constants.ParameterTypeNotRegistered, "unknown"), res.Results[4].ErrorMessage)
+	assert.Equal(t, fmt.Sprintf(constants.ParameterImportRowDuplicated, 2), res.Results[5].ErrorMessage)
+	assert.Equal(t, fmt.Sprintf(constants.ParameterImportParentNotFound, "MISSING"), res.Results[6].ErrorMessage)
+	mockRepo.AssertExpectations(t)
+	mockTypeRepo.AssertExpectations(t)
+}
@@ -0,0 +1,201 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/i18n"
+	"github.com/rendyfutsuy/base-go/models"
+	paramHttp "github.com/rendyfutsuy/base-go/modules/parameter/delivery/http"
+	"github.com/rendyfutsuy/base-go/modules/parameter/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lookupEntries() []models.Parameter {
+	topic := "topic"
+	setting := "setting"
+	values := map[string]string{
+		"MAX-UPLOAD":   "25",
+		"TAX-RATE":     "0.11",
+		"MAINTENANCE":  "true",
+		"LAUNCH-DATE":  "2026-01-31",
+		"HOMEPAGE":     `{"banner":true}`,
+		"BROKEN-INT":   "abc",
+		"EMPTY-STRING": "",
+	}
+	entries := []models.Parameter{
+		{ID: uuid.New(), Code: "TOPIC-LOCAL", Name: "Local News", Type: &topic, ParentCode: "TOPIC-NEWS"},
+		{ID: uuid.New(), Code: "TOPIC-NEWS", Name: "News", Type: &topic},
+		{ID: uuid.New(), Code: "TOPIC-SPORT", Name: "Sport", Type: &topic, ParentCode: "TOPIC-NEWS"},
+	}
+	for code, value := range values {
+		value := value
+		entries = append(entries, models.Parameter{ID: uuid.New(), Code: code, Name: code, Value: &value, Type: &setting})
+	}
+	return entries
+}
+
+func TestParameterLookupCache(t *testing.T) {
+	ctx := context.Background()
+	entries := lookupEntries()
+
+	mockRepo := new(MockParameterRepository)
+	mockRepo.On("GetLookupEntries", ctx).Return(entries, nil).Twice()
+	lookup := usecase.NewParameterLookup(mockRepo, nil, time.Minute)
+
+	// every read is served by a single load
+	p, err := lookup.GetByID(ctx, entries[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "TOPIC-NEWS", p.Code)
+
+	topics, err := lookup.ListByType(ctx, "topic")
+	require.NoError(t, err)
+	assert.Len(t, topics, 3)
+
+	children, err := lookup.ListChildren(ctx, "TOPIC-NEWS")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TOPIC-LOCAL", "TOPIC-SPORT"}, []string{children[0].Code, children[1].Code})
+
+	_, err = lookup.GetByCode(ctx, "UNKNOWN")
+	assert.EqualError(t, err, fmt.Sprintf(constants.ParameterLookupCodeNotFound, "UNKNOWN"))
+
+	maxUpload, err := lookup.GetInt(ctx, "MAX-UPLOAD")
+	require.NoError(t, err)
+	assert.Equal(t, int64(25), maxUpload)
+
+	taxRate, err := lookup.GetDecimal(ctx, "TAX-RATE")
+	require.NoError(t, err)
+	assert.Equal(t, 0.11, taxRate)
+
+	maintenance, err := lookup.GetBool(ctx, "MAINTENANCE")
+	require.NoError(t, err)
+	assert.True(t, maintenance)
+
+	launchDate, err := lookup.GetDate(ctx, "LAUNCH-DATE")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC), launchDate)
+
+	var homepage struct {
+		Banner bool `json:"banner"`
+	}
+	require.NoError(t, lookup.GetJSON(ctx, "HOMEPAGE", &homepage))
+	assert.True(t, homepage.Banner)
+
+	_, err = lookup.GetInt(ctx, "BROKEN-INT")
+	assert.EqualError(t, err, fmt.Sprintf(constants.ParameterLookupValueInvalid, "BROKEN-INT", "integer"))
+
+	empty, err := lookup.GetString(ctx, "EMPTY-STRING")
+	require.NoError(t, err)
+	assert.Equal(t, "", empty)
+	_, err = lookup.GetBool(ctx, "EMPTY-STRING")
+	assert.EqualError(t, err, fmt.Sprintf(constants.ParameterLookupValueEmpty, "EMPTY-STRING"))
+
+	// the next read after an invalidation loads again
+	lookup.Invalidate(ctx)
+	_, err = lookup.GetByCode(ctx, "TOPIC-NEWS")
+	require.NoError(t, err)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestParameterLookupByLocale(t *testing.T) {
+	ctx := context.Background()
+	enCtx := i18n.WithLocale(ctx, "en")
+	id := uuid.New()
+
+	mockRepo := new(MockParameterRepository)
+	mockRepo.On("GetLookupEntries", ctx).Return([]models.Parameter{{ID: id, Code: "TOPIC-NEWS", Name: "Berita"}}, nil).Once()
+	mockRepo.On("GetLookupEntries", enCtx).Return([]models.Parameter{{ID: id, Code: "TOPIC-NEWS", Name: "News"}}, nil).Once()
+	lookup := usecase.NewParameterLookup(mockRepo, nil, time.Minute)
+
+	// each locale keeps its own snapshot
+	for i := 0; i < 2; i++ {
+		p, err := lookup.GetByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "Berita", p.Name)
+
+		p, err = lookup.GetByID(enCtx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "News", p.Name)
+	}
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestParameterLookupInvalidatedOnDelete(t *testing.T) {
+	ctx := context.Background()
+	entries := lookupEntries()
+
+	mockRepo := new(MockParameterRepository)
+	mockRepo.On("GetLookupEntries", ctx).Return(entries, nil).Once()
+	mockRepo.On("Delete", ctx, entries[0].ID).Return(nil).Once()
+	mockRepo.On("GetLookupEntries", ctx).Return(entries[1:], nil).Once()
+
+	lookup := usecase.NewParameterLookup(mockRepo, nil, 0)
+	uc := usecase.NewParameterUsecase(mockRepo, newMockParameterTypeRepository(), lookup)
+
+	_, err := lookup.GetByCode(ctx, "TOPIC-LOCAL")
+	require.NoError(t, err)
+
+	require.NoError(t, uc.Delete(ctx, entries[0].ID.String(), "test-auth-id"))
+
+	_, err = lookup.GetByCode(ctx, "TOPIC-LOCAL")
+	assert.EqualError(t, err, fmt.Sprintf(constants.ParameterLookupCodeNotFound, "TOPIC-LOCAL"))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestParameterLookupHandler(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepo := new(MockParameterRepository)
+	mockRepo.On("GetLookupEntries", ctx).Return(lookupEntries(), nil).Once()
+	lookup := usecase.NewParameterLookup(mockRepo, nil, time.Minute)
+	h := &paramHttp.ParameterHandler{Usecase: usecase.NewParameterUsecase(mockRepo, newMockParameterTypeRepository(), lookup)}
+
+	e := echo.New()
+	e.GET("/v1/parameter/lookup", h.Lookup)
+
+	// children of a parent filtered by type
+	req := httptest.NewRequest(http.MethodGet, "/v1/parameter/lookup?type=topic&parent_code=TOPIC-NEWS", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"code":"TOPIC-LOCAL"`)
+	assert.Contains(t, rec.Body.String(), `"code":"TOPIC-SPORT"`)
+	assert.NotContains(t, rec.Body.String(), `"code":"TOPIC-NEWS"`)
+	etag := rec.Header().Get(constants.FieldETag)
+	assert.NotEmpty(t, etag)
+
+	// unchanged list
+	req = httptest.NewRequest(http.MethodGet, "/v1/parameter/lookup?type=topic&parent_code=TOPIC-NEWS", nil)
+	req.Header.Set(constants.FieldIfNoneMatch, etag)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+
+	// another list has another ETag
+	req = httptest.NewRequest(http.MethodGet, "/v1/parameter/lookup?type=topic", nil)
+	req.Header.Set(constants.FieldIfNoneMatch, etag)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotEqual(t, etag, rec.Header().Get(constants.FieldETag))
+
+	// a filter is required
+	req = httptest.NewRequest(http.MethodGet, "/v1/parameter/lookup", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), constants.ParameterLookupFilterRequired)
+
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,278 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/parameter/dto"
+	"github.com/rendyfutsuy/base-go/modules/parameter/usecase"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGetParameterTree(t *testing.T) {
+	ctx := context.Background()
+	topic := "topic"
+	newsID, localID, sportID, orphanID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	mockRepo := new(MockParameterRepository)
+	mockRepo.On("GetTree", ctx, "topic").Return([]models.Parameter{
+		{ID: newsID, Code: "TOPIC-NEWS", Name: "News", Type: &topic, SortOrder: 1},
+		{ID: sportID, Code: "TOPIC-SPORT", Name: "Sport", Type: &topic, ParentID: newsID, SortOrder: 1},
+		{ID: localID, Code: "TOPIC-LOCAL", Name: "Local", Type: &topic, ParentID: newsID, SortOrder: 2, Deletable: true},
+		// the parent has another type, so the parameter is a root of the tree
+		{ID: orphanID, Code: "TOPIC-OTHER", Name: "Other", Type: &topic, ParentID: uuid.New(), SortOrder: 2},
+	}, nil).Once()
+
+	uc := usecase.NewParameterUsecase(mockRepo, newMockParameterTypeRepository(), usecase.NewParameterLookup(mockRepo, nil, 0))
+	tree, err := uc.GetTree(ctx, dto.ReqParameterTree{Type: " topic "})
+	require.NoError(t, err)
+
+	require.Len(t, tree, 2)
+	assert.Equal(t, "TOPIC-NEWS", tree[0].Code)
+	assert.Equal(t, "TOPIC-OTHER", tree[1].Code)
+	assert.Empty(t, tree[1].Children)
+	require.Len(t, tree[0].Children, 2)
+	assert.Equal(t, "TOPIC-SPORT", tree[0].Children[0].Code)
+	assert.Equal(t, "TOPIC-LOCAL", tree[0].Children[1].Code)
+	assert.True(t, tree[0].Children[1].Deletable)
+	mockRepo.AssertExpectations(t)
+
+	_, err = uc.GetTree(ctx, dto.ReqParameterTree{})
+	assert.EqualError(t, err, constants.ParameterTreeTypeRequired)
+}
+
+func TestMoveParameter(t *testing.T) {
+	ctx := context.Background()
+	topic := "topic"
+	lang := "lang"
+	id := uuid.New()
+	parentID := uuid.New()
+	position := 2
+
+	tests := []struct {
+		name          string
+		req           *dto.ReqMoveParameter
+		setupMock     func(*MockParameterRepository, *MockParameterTypeRepository)
+		expectedError string
+	}{
+		{
+			name: "success - move under a parent at a position",
+			req:  &dto.ReqMoveParameter{ParentID: &parentID, Position: &position},
+			setupMock: func(mockRepo *MockParameterRepository, mockTypeRepo *MockParameterTypeRepository) {
+				mockRepo.On("GetByID", ctx, id).Return(&models.Parameter{ID: id, Code: "TOPIC-LOCAL", Type: &topic}, nil).Once()
+				mockRepo.On("GetByID", ctx, parentID).Return(&models.Parameter{ID: parentID, Code: "TOPIC-NEWS", Type: &topic}, nil).Once()
+				mockRepo.On("GetAncestorIDs", ctx, parentID).Return([]uuid.UUID{uuid.New()}, nil).Once()
+				mockTypeRepo.On("GetByCode", ctx, "topic").Return(stringParameterType("topic", "topic"), nil).Once()
+				mockRepo.On("Move", ctx, id, parentID, 2).Return(nil).Once()
+				mockRepo.On("GetByID", ctx, id).Return(&models.Parameter{ID: id, Code: "TOPIC-LOCAL", Type: &topic, ParentID: parentID, SortOrder: 2}, nil).Once()
+			},
+		},
+		{
+			name: "success - move to the roots, last",
+			req:  &dto.ReqMoveParameter{},
+			setupMock: func(mockRepo *MockParameterRepository, mockTypeRepo *MockParameterTypeRepository) {
+				mockRepo.On("GetByID", ctx, id).Return(&models.Parameter{ID: id, Code: "TOPIC-LOCAL", Type: &topic, ParentID: parentID}, nil).Twice()
+				mockTypeRepo.On("GetByCode", ctx, "topic").Return(stringParameterType("topic", "topic"), nil).Once()
+				mockRepo.On("Move", ctx, id, uuid.Nil, 0).Return(nil).Once()
+			},
+		},
+		{
+			name: "error - parent is the parameter",
+			req:  &dto.ReqMoveParameter{ParentID: &id},
+			setupMock: func(mockRepo *MockParameterRepository, mockTypeRepo *MockParameterTypeRepository) {
+				mockRepo.On("GetByID", ctx, id).Return(&models.Parameter{ID: id, Type: &topic}, nil).Once()
+			},
+			expectedError: constants.ParameterParentSelf,
+		},
+		{
+			name: "error - parent is a descendant",
+			req:  &dto.ReqMoveParameter{ParentID: &parentID},
+			setupMock: func(mockRepo *MockParameterRepository, mockTypeRepo *MockParameterTypeRepository) {
+				mockRepo.On("GetByID", ctx, id).Return(&models.Parameter{ID: id, Type: &topic}, nil).Once()
+				mockRepo.On("GetByID", ctx, parentID).Return(&models.Parameter{ID: parentID, Type: &topic}, nil).Once()
+				mockRepo.On("GetAncestorIDs", ctx, parentID).Return([]uuid.UUID{uuid.New(), id}, nil).Once()
+			},
+			expectedError: constants.ParameterParentCycle,
+		},
+		{
+			name: "error - parent type not allowed",
+			req:  &dto.ReqMoveParameter{ParentID: &parentID},
+			setupMock: func(mockRepo *MockParameterRepository, mockTypeRepo *MockParameterTypeRepository) {
+				mockRepo.On("GetByID", ctx, id).Return(&models.Parameter{ID: id, Type: &topic}, nil).Once()
+				mockRepo.On("GetByID", ctx, parentID).Return(&models.Parameter{ID: parentID, Type: &lang}, nil).Once()
+				mockRepo.On("GetAncestorIDs", ctx, parentID).Return([]uuid.UUID{}, nil).Once()
+				mockTypeRepo.On("GetByCode", ctx, "topic").Return(stringParameterType("topic", "topic"), nil).Once()
+			},
+			expectedError: "a parameter of type topic cannot have a parent of type lang",
+		},
+		{
+			name: "error - parameter not found",
+			req:  &dto.ReqMoveParameter{},
+			setupMock: func(mockRepo *MockParameterRepository, mockTypeRepo *MockParameterTypeRepository) {
+				mockRepo.On("GetByID", ctx, id).Return(&models.Parameter{}, nil).Once()
+			},
+			expectedError: "parameter with id " + id.String() + " not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockParameterRepository)
+			mockTypeRepo := new(MockParameterTypeRepository)
+			tt.setupMock(mockRepo, mockTypeRepo)
+
+			result, err := usecase.NewParameterUsecase(mockRepo, mockTypeRepo, usecase.NewParameterLookup(mockRepo, nil, 0)).Move(ctx, id.String(), tt.req, "test-auth-id")
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, id, result.ID)
+			}
+			mockRepo.AssertExpectations(t)
+			mockTypeRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateParameterParentCycle(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	childID := uuid.New()
+
+	mockRepo := new(MockParameterRepository)
+	mockRepo.On("ExistsByCode", ctx, "TOPIC-NEWS", id).Return(false, nil).Once()
+	mockRepo.On("ExistsByName", ctx, "News", id).Return(false, nil).Once()
+	mockRepo.On("GetByID", ctx, childID).Return(&models.Parameter{ID: childID}, nil).Once()
+	mockRepo.On("GetAncestorIDs", ctx, childID).Return([]uuid.UUID{id}, nil).Once()
+
+	req := &dto.ReqUpdateParameter{Code: "TOPIC-NEWS", Name: "News", ParentId: &childID}
+	result, err := usecase.NewParameterUsecase(mockRepo, newMockParameterTypeRepository(), usecase.NewParameterLookup(mockRepo, nil, 0)).Update(ctx, id.String(), req, "test-auth-id")
+
+	assert.EqualError(t, err, constants.ParameterParentCycle)
+	assert.Nil(t, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReorderParameters(t *testing.T) {
+	ctx := context.Background()
+	parentID := uuid.New()
+	firstID, secondID, thirdID := uuid.New(), uuid.New(), uuid.New()
+	siblings := []models.Parameter{{ID: firstID}, {ID: secondID}, {ID: thirdID}}
+	topic := "topic"
+
+	tests := []struct {
+		name          string
+		req           *dto.ReqReorderParameters
+		setupMock     func(*MockParameterRepository)
+		expectedError string
+	}{
+		{
+			name: "success - children of a parent",
+			req:  &dto.ReqReorderParameters{ParentID: &parentID, IDs: []uuid.UUID{thirdID, firstID, secondID}},
+			setupMock: func(mockRepo *MockParameterRepository) {
+				mockRepo.On("GetByID", ctx, parentID).Return(&models.Parameter{ID: parentID}, nil).Once()
+				mockRepo.On("GetSiblings", ctx, parentID, (*string)(nil)).Return(siblings, nil).Once()
+				mockRepo.On("Reorder", ctx, []uuid.UUID{thirdID, firstID, secondID}).Return(nil).Once()
+			},
+		},
+		{
+			name: "success - parameters of a type without parent",
+			req:  &dto.ReqReorderParameters{Type: &topic, IDs: []uuid.UUID{secondID, firstID, thirdID}},
+			setupMock: func(mockRepo *MockParameterRepository) {
+				mockRepo.On("GetSiblings", ctx, uuid.Nil, &topic).Return(siblings, nil).Once()
+				mockRepo.On("Reorder", ctx, []uuid.UUID{secondID, firstID, thirdID}).Return(nil).Once()
+			},
+		},
+		{
+			name: "error - sibling missing",
+			req:  &dto.ReqReorderParameters{ParentID: &parentID, IDs: []uuid.UUID{firstID, secondID}},
+			setupMock: func(mockRepo *MockParameterRepository) {
+				mockRepo.On("GetByID", ctx, parentID).Return(&models.Parameter{ID: parentID}, nil).Once()
+				mockRepo.On("GetSiblings", ctx, parentID, (*string)(nil)).Return(siblings, nil).Once()
+			},
+			expectedError: constants.ParameterReorderMismatch,
+		},
+		{
+			name: "error - sibling listed twice",
+			req:  &dto.ReqReorderParameters{ParentID: &parentID, IDs: []uuid.UUID{firstID, secondID, firstID}},
+			setupMock: func(mockRepo *MockParameterRepository) {
+				mockRepo.On("GetByID", ctx, parentID).Return(&models.Parameter{ID: parentID}, nil).Once()
+				mockRepo.On("GetSiblings", ctx, parentID, (*string)(nil)).Return(siblings, nil).Once()
+			},
+			expectedError: constants.ParameterReorderMismatch,
+		},
+		{
+			name: "error - parent not found",
+			req:  &dto.ReqReorderParameters{ParentID: &parentID, IDs: []uuid.UUID{firstID}},
+			setupMock: func(mockRepo *MockParameterRepository) {
+				mockRepo.On("GetByID", ctx, parentID).Return(&models.Parameter{}, nil).Once()
+			},
+			expectedError: constants.ParameterParentInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockParameterRepository)
+			tt.setupMock(mockRepo)
+
+			err := usecase.NewParameterUsecase(mockRepo, newMockParameterTypeRepository(), usecase.NewParameterLookup(mockRepo, nil, 0)).Reorder(ctx, tt.req, "test-auth-id")
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImportParametersParentCycle(t *testing.T) {
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+	ctx := context.Background()
+	newsID := uuid.New()
+	localID := uuid.New()
+
+	// TOPIC-LOCAL is a child of TOPIC-NEWS in the database, the file makes TOPIC-NEWS a child of TOPIC-LOCAL
+	path := writeParameterImportFile(t, [][]string{
+		{"TOPIC-NEWS", "News", "", "", "", "TOPIC-LOCAL"},
+		{"TOPIC-SPORT", "Sport", "", "", "", "TOPIC-NEWS"},
+	})
+	defer os.Remove(path)
+
+	mockRepo := new(MockParameterRepository)
+	mockRepo.On("GetByCodes", ctx, []string{"TOPIC-NEWS", "TOPIC-LOCAL", "TOPIC-SPORT"}).Return([]models.Parameter{
+		{ID: newsID, Code: "TOPIC-NEWS"},
+		{ID: localID, Code: "TOPIC-LOCAL", ParentID: newsID},
+	}, nil).Once()
+	mockRepo.On("ExistsByCode", ctx, "TOPIC-SPORT", uuid.Nil).Return(false, nil).Once()
+	mockRepo.On("ExistsByName", ctx, "News", newsID).Return(false, nil).Once()
+	mockRepo.On("ExistsByName", ctx, "Sport", uuid.Nil).Return(false, nil).Once()
+	mockRepo.On("GetLookupEntries", ctx).Return([]models.Parameter{
+		{ID: newsID, Code: "TOPIC-NEWS"},
+		{ID: localID, Code: "TOPIC-LOCAL", ParentID: newsID, ParentCode: "TOPIC-NEWS"},
+	}, nil).Once()
+
+	res, err := usecase.NewParameterUsecase(mockRepo, new(MockParameterTypeRepository), usecase.NewParameterLookup(mockRepo, nil, 0)).ImportFromExcel(ctx, path, "test-auth-id")
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, res.SuccessCount)
+	assert.Equal(t, constants.ParameterParentCycle, res.Results[0].ErrorMessage)
+	assert.Equal(t, fmt.Sprintf(constants.ParameterImportParentRowFailed, 2), res.Results[1].ErrorMessage)
+	mockRepo.AssertNotCalled(t, "ImportParameters", mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
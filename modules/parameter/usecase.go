@@ -16,4 +16,9 @@ type Usecase interface {
 	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqParameterIndexFilter) ([]models.Parameter, int, error)
 	GetAll(ctx context.Context, filter dto.ReqParameterIndexFilter) ([]models.Parameter, error)
 	Export(ctx context.Context, filter dto.ReqParameterIndexFilter) ([]byte, error)
+	ImportFromExcel(ctx context.Context, filePath string, authId string) (*dto.ResImportParameters, error)
+	Lookup(ctx context.Context, req dto.ReqParameterLookup) ([]dto.RespParameterLookup, string, error)
+	GetTree(ctx context.Context, req dto.ReqParameterTree) ([]dto.RespParameterTreeNode, error)
+	Move(ctx context.Context, id string, req *dto.ReqMoveParameter, authId string) (*models.Parameter, error)
+	Reorder(ctx context.Context, req *dto.ReqReorderParameters, authId string) error
 }
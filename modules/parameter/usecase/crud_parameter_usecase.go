@@ -5,24 +5,29 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/paramvalue"
 	"github.com/rendyfutsuy/base-go/helpers/request"
 	"github.com/rendyfutsuy/base-go/models"
 	mod "github.com/rendyfutsuy/base-go/modules/parameter"
 	"github.com/rendyfutsuy/base-go/modules/parameter/dto"
+	parameterTypeMod "github.com/rendyfutsuy/base-go/modules/parameter_type"
 	"github.com/rendyfutsuy/base-go/utils"
 	"github.com/xuri/excelize/v2"
 	"gorm.io/gorm"
 )
 
 type parameterUsecase struct {
-	repo mod.Repository
+	repo     mod.Repository
+	typeRepo parameterTypeMod.Repository
+	lookup   mod.Lookup
 }
 
-func NewParameterUsecase(repo mod.Repository) mod.Usecase {
-	return &parameterUsecase{repo: repo}
+func NewParameterUsecase(repo mod.Repository, typeRepo parameterTypeMod.Repository, lookup mod.Lookup) mod.Usecase {
+	return &parameterUsecase{repo: repo, typeRepo: typeRepo, lookup: lookup}
 }
 
 func (u *parameterUsecase) Create(ctx context.Context, reqBody *dto.ReqCreateParameter, userID string) (*models.Parameter, error) {
@@ -44,16 +49,34 @@ func (u *parameterUsecase) Create(ctx context.Context, reqBody *dto.ReqCreatePar
 		return nil, errors.New(constants.ParameterNameAlreadyExists)
 	}
 
-	res, err := u.repo.Create(ctx, reqBody.Code, reqBody.Name, reqBody.Value, reqBody.Type, reqBody.Desc)
+	// The value must match the registered type
+	parameterType, err := u.resolveType(ctx, reqBody.Type)
 	if err != nil {
 		return nil, err
 	}
+	value, err := normalizeValue(parameterType, reqBody.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	var parent *models.Parameter
 	if reqBody.ParentId != nil && *reqBody.ParentId != uuid.Nil {
-		parent, err := u.repo.GetByID(ctx, *reqBody.ParentId)
-		if err != nil || parent == nil {
-			return nil, errors.New("invalid parent parameter")
+		if parent, err = u.getParent(ctx, *reqBody.ParentId); err != nil {
+			return nil, err
 		}
-		if err := u.repo.SetParent(ctx, res.ID, *reqBody.ParentId); err != nil {
+	}
+	if err := checkParentType(parameterType, parent); err != nil {
+		return nil, err
+	}
+
+	res, err := u.repo.Create(ctx, reqBody.Code, reqBody.Name, value, typeCode(parameterType), reqBody.Desc)
+	if err != nil {
+		return nil, err
+	}
+	// drop the lookup cache once the parent is set as well
+	defer u.lookup.Invalidate(ctx)
+	if parent != nil {
+		if err := u.repo.SetParent(ctx, res.ID, parent.ID); err != nil {
 			return nil, err
 		}
 		// refresh result
@@ -86,32 +109,53 @@ func (u *parameterUsecase) Update(ctx context.Context, id string, reqBody *dto.R
 		return nil, errors.New(constants.ParameterNameAlreadyExists)
 	}
 
-	res, err := u.repo.Update(ctx, pid, reqBody.Code, reqBody.Name, reqBody.Value, reqBody.Type, reqBody.Desc)
+	// The value must match the registered type
+	parameterType, err := u.resolveType(ctx, reqBody.Type)
+	if err != nil {
+		return nil, err
+	}
+	value, err := normalizeValue(parameterType, reqBody.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	// The parent (the given one, or the current one when parent_id is omitted) and the children must accept the type
+	if reqBody.ParentId != nil && *reqBody.ParentId == pid {
+		return nil, errors.New(constants.ParameterParentSelf)
+	}
+	parent, err := u.effectiveParent(ctx, id, pid, parameterType, reqBody.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	if reqBody.ParentId != nil && parent != nil {
+		if err := u.checkParentCycle(ctx, pid, parent.ID); err != nil {
+			return nil, err
+		}
+	}
+	if err := checkParentType(parameterType, parent); err != nil {
+		return nil, err
+	}
+	if err := u.checkChildTypes(ctx, pid, parameterType); err != nil {
+		return nil, err
+	}
+
+	res, err := u.repo.Update(ctx, pid, reqBody.Code, reqBody.Name, value, typeCode(parameterType), reqBody.Desc)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fmt.Errorf(constants.ParameterNotFound, id)
 		}
 		return nil, err
 	}
+	defer u.lookup.Invalidate(ctx)
 	if reqBody.ParentId != nil {
-		if *reqBody.ParentId == pid {
-			return nil, errors.New("parent must not be the same as the parameter")
+		parentID := uuid.Nil
+		if parent != nil {
+			parentID = parent.ID
 		}
-		if *reqBody.ParentId != uuid.Nil {
-			parent, err := u.repo.GetByID(ctx, *reqBody.ParentId)
-			if err != nil || parent == nil {
-				return nil, errors.New("invalid parent parameter")
-			}
-			if err := u.repo.SetParent(ctx, pid, *reqBody.ParentId); err != nil {
-				return nil, err
-			}
-			res, _ = u.repo.GetByID(ctx, pid)
-		} else {
-			if err := u.repo.SetParent(ctx, pid, uuid.Nil); err != nil {
-				return nil, err
-			}
-			res, _ = u.repo.GetByID(ctx, pid)
+		if err := u.repo.SetParent(ctx, pid, parentID); err != nil {
+			return nil, err
 		}
+		res, _ = u.repo.GetByID(ctx, pid)
 	}
 	return res, nil
 }
@@ -121,7 +165,11 @@ func (u *parameterUsecase) Delete(ctx context.Context, id string, userID string)
 	if err != nil {
 		return err
 	}
-	return u.repo.Delete(ctx, pid)
+	if err := u.repo.Delete(ctx, pid); err != nil {
+		return err
+	}
+	u.lookup.Invalidate(ctx)
+	return nil
 }
 
 func (u *parameterUsecase) GetByID(ctx context.Context, id string) (*models.Parameter, error) {
@@ -153,20 +201,20 @@ func (u *parameterUsecase) Export(ctx context.Context, filter dto.ReqParameterIn
 	sheet := "Parameters"
 	f.SetSheetName("Sheet1", sheet)
 
-	// Header
-	f.SetCellValue(sheet, "A1", "Code")
-	f.SetCellValue(sheet, "B1", "Name")
-	f.SetCellValue(sheet, "C1", "Value")
-	f.SetCellValue(sheet, "D1", "Type")
-	f.SetCellValue(sheet, "E1", "Description")
+	// Header, the first six columns are the columns of the import
+	headers := []string{"Code", "Name", "Value", "Type", "Description", "Parent Code", "Value Kind"}
+	for i, header := range headers {
+		col, _ := excelize.ColumnNumberToName(i + 1)
+		f.SetCellValue(sheet, col+"1", header)
+	}
 
-	// Rows
+	// Rows, values are written as stored text so they import back unchanged
 	for i, p := range list {
 		row := i + 2
 		f.SetCellValue(sheet, "A"+strconv.Itoa(row), p.Code)
 		f.SetCellValue(sheet, "B"+strconv.Itoa(row), p.Name)
 		if p.Value != nil {
-			f.SetCellValue(sheet, "C"+strconv.Itoa(row), *p.Value)
+			f.SetCellStr(sheet, "C"+strconv.Itoa(row), *p.Value)
 		}
 		if p.Type != nil {
 			f.SetCellValue(sheet, "D"+strconv.Itoa(row), *p.Type)
@@ -174,6 +222,8 @@ func (u *parameterUsecase) Export(ctx context.Context, filter dto.ReqParameterIn
 		if p.Description != nil {
 			f.SetCellValue(sheet, "E"+strconv.Itoa(row), *p.Description)
 		}
+		f.SetCellValue(sheet, "F"+strconv.Itoa(row), p.ParentCode)
+		f.SetCellValue(sheet, "G"+strconv.Itoa(row), p.ValueKind)
 	}
 
 	// Write to buffer and return bytes
@@ -183,3 +233,136 @@ func (u *parameterUsecase) Export(ctx context.Context, filter dto.ReqParameterIn
 	}
 	return buf.Bytes(), nil
 }
+
+// resolveType returns the registered type of a parameter, nil when the parameter is untyped
+func (u *parameterUsecase) resolveType(ctx context.Context, typeVal *string) (*models.ParameterType, error) {
+	if typeVal == nil || strings.TrimSpace(*typeVal) == "" {
+		return nil, nil
+	}
+	code := strings.TrimSpace(*typeVal)
+	parameterType, err := u.typeRepo.GetByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.ParameterTypeNotRegistered, code)
+		}
+		return nil, err
+	}
+	return parameterType, nil
+}
+
+// getParent returns the parent parameter, ParameterParentInvalid when it does not exist
+func (u *parameterUsecase) getParent(ctx context.Context, parentID uuid.UUID) (*models.Parameter, error) {
+	parent, err := u.repo.GetByID(ctx, parentID)
+	if err != nil || parent == nil || parent.ID == uuid.Nil {
+		return nil, errors.New(constants.ParameterParentInvalid)
+	}
+	return parent, nil
+}
+
+// effectiveParent returns the parent the parameter will have after the update:
+// the given parent, or the current one when parent_id is omitted
+func (u *parameterUsecase) effectiveParent(ctx context.Context, id string, pid uuid.UUID, parameterType *models.ParameterType, parentID *uuid.UUID) (*models.Parameter, error) {
+	if parentID != nil {
+		if *parentID == uuid.Nil {
+			return nil, nil
+		}
+		return u.getParent(ctx, *parentID)
+	}
+
+	// The current parent only matters for a typed parameter
+	if parameterType == nil {
+		return nil, nil
+	}
+	current, err := u.repo.GetByID(ctx, pid)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.ParameterNotFound, id)
+		}
+		return nil, err
+	}
+	if current == nil || current.ParentID == uuid.Nil {
+		return nil, nil
+	}
+	parent, err := u.repo.GetByID(ctx, current.ParentID)
+	if err != nil || parent == nil || parent.ID == uuid.Nil {
+		// the parent was deleted, there is nothing to check
+		return nil, nil
+	}
+	return parent, nil
+}
+
+// checkChildTypes checks the typed children of a parameter still accept it as parent with its new type
+func (u *parameterUsecase) checkChildTypes(ctx context.Context, pid uuid.UUID, parameterType *models.ParameterType) error {
+	violation, err := u.childTypeViolation(ctx, pid, parameterType)
+	if err != nil {
+		return err
+	}
+	if violation != "" {
+		return errors.New(violation)
+	}
+	return nil
+}
+
+// childTypeViolation returns the message of the first child type not accepting the new type, empty when all accept it
+func (u *parameterUsecase) childTypeViolation(ctx context.Context, pid uuid.UUID, parameterType *models.ParameterType) (string, error) {
+	childTypes, err := u.repo.GetChildTypes(ctx, pid)
+	if err != nil {
+		return "", err
+	}
+	codes := make([]string, 0, len(childTypes))
+	for _, childType := range childTypes {
+		if childType != "" {
+			codes = append(codes, childType)
+		}
+	}
+	if len(codes) == 0 {
+		return "", nil
+	}
+
+	types, err := u.typeRepo.GetByCodes(ctx, codes)
+	if err != nil {
+		return "", err
+	}
+	newType := typeCode(parameterType)
+	for _, childType := range types {
+		if !paramvalue.ParentAllowed(childType, newType) {
+			return fmt.Sprintf(constants.ParameterChildTypeNotAllowed, childType.Code, typeName(newType)), nil
+		}
+	}
+	return "", nil
+}
+
+// normalizeValue returns the canonical value of a typed parameter, untyped values are free-form
+func normalizeValue(parameterType *models.ParameterType, value *string) (*string, error) {
+	if parameterType == nil {
+		return value, nil
+	}
+	return paramvalue.Normalize(*parameterType, value)
+}
+
+// checkParentType checks a typed parameter may have the parent
+func checkParentType(parameterType *models.ParameterType, parent *models.Parameter) error {
+	if parameterType == nil || parent == nil {
+		return nil
+	}
+	if !paramvalue.ParentAllowed(*parameterType, parent.Type) {
+		return fmt.Errorf(constants.ParameterParentTypeNotAllowed, parameterType.Code, typeName(parent.Type))
+	}
+	return nil
+}
+
+// typeCode returns the code of the type to store, nil for an untyped parameter
+func typeCode(parameterType *models.ParameterType) *string {
+	if parameterType == nil {
+		return nil
+	}
+	code := parameterType.Code
+	return &code
+}
+
+func typeName(parameterType *string) string {
+	if parameterType == nil || *parameterType == "" {
+		return "none"
+	}
+	return *parameterType
+}
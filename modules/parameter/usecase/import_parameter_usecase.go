@@ -0,0 +1,309 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/paramvalue"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/parameter"
+	"github.com/rendyfutsuy/base-go/modules/parameter/dto"
+)
+
+// parameterImportRow is a parsed row of a parameter import with its validation errors
+type parameterImportRow struct {
+	rowNum        int
+	params        mod.ImportParameterParams
+	parameterType *models.ParameterType
+	errors        []string
+}
+
+// ImportFromExcel creates or updates (by code) parameters from the rows of an Excel file with columns:
+// code, name, value, type, description, parent_code.
+// The parent may be a row of the same file or an existing parameter, a row fails when its parent row fails
+// or when its parent is one of its descendants.
+func (u *parameterUsecase) ImportFromExcel(ctx context.Context, filePath string, authId string) (*dto.ResImportParameters, error) {
+	rows, err := readParameterImportRows(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	importRows := make([]*parameterImportRow, 0, len(rows))
+	codes := make([]string, 0, len(rows)*2)
+	typeCodes := make([]string, 0)
+	for i, row := range rows {
+		if isBlankParameterImportRow(row) {
+			continue
+		}
+		importRow := &parameterImportRow{
+			rowNum: i + 2, // Excel row number, after the header
+			params: mod.ImportParameterParams{
+				Code:        parameterImportCell(row, 0),
+				Name:        parameterImportCell(row, 1),
+				Value:       optionalParameterImportCell(row, 2),
+				Type:        optionalParameterImportCell(row, 3),
+				Description: optionalParameterImportCell(row, 4),
+				ParentCode:  parameterImportCell(row, 5),
+			},
+		}
+		importRows = append(importRows, importRow)
+		codes = append(codes, importRow.params.Code)
+		if importRow.params.ParentCode != "" {
+			codes = append(codes, importRow.params.ParentCode)
+		}
+		if importRow.params.Type != nil {
+			typeCodes = append(typeCodes, *importRow.params.Type)
+		}
+	}
+
+	// Load the existing parameters and the types used by the file at once
+	existingList, err := u.repo.GetByCodes(ctx, uniqueParameterImportValues(codes))
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]models.Parameter, len(existingList))
+	for _, parameter := range existingList {
+		existing[parameter.Code] = parameter
+	}
+	types := make(map[string]models.ParameterType)
+	if len(typeCodes) > 0 {
+		typeList, err := u.typeRepo.GetByCodes(ctx, uniqueParameterImportValues(typeCodes))
+		if err != nil {
+			return nil, err
+		}
+		for _, parameterType := range typeList {
+			types[parameterType.Code] = parameterType
+		}
+	}
+
+	// Validate the fields of each row (akumulatif)
+	fileRows := make(map[string]*parameterImportRow)
+	seenNames := make(map[string]int)
+	for _, importRow := range importRows {
+		if err := u.validateParameterImportRow(ctx, importRow, existing, types, fileRows, seenNames); err != nil {
+			return nil, err
+		}
+	}
+
+	// Validate the parents, which may be rows of the file
+	for _, importRow := range importRows {
+		if err := u.validateParameterImportParent(ctx, importRow, existing, fileRows); err != nil {
+			return nil, err
+		}
+	}
+
+	// The parents must not form a cycle, through the rows of the file and the existing parameters
+	if err := u.validateParameterImportCycles(ctx, importRows, fileRows); err != nil {
+		return nil, err
+	}
+
+	// A row fails when its parent row failed, repeated until no row changes
+	failed := make(map[*parameterImportRow]bool, len(importRows))
+	for _, importRow := range importRows {
+		failed[importRow] = len(importRow.errors) > 0
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, importRow := range importRows {
+			if failed[importRow] || importRow.params.ParentCode == "" {
+				continue
+			}
+			parentRow, ok := fileRows[importRow.params.ParentCode]
+			if ok && failed[parentRow] {
+				importRow.errors = append(importRow.errors, fmt.Sprintf(constants.ParameterImportParentRowFailed, parentRow.rowNum))
+				failed[importRow] = true
+				changed = true
+			}
+		}
+	}
+
+	results := make([]dto.ResImportParameterExcel, 0, len(importRows))
+	validParams := make([]mod.ImportParameterParams, 0, len(importRows))
+	validResultIndices := make([]int, 0, len(importRows))
+	for _, importRow := range importRows {
+		result := dto.ResImportParameterExcel{Row: importRow.rowNum, Code: importRow.params.Code}
+		if len(importRow.errors) > 0 {
+			result.Status = "failed"
+			result.ErrorMessage = strings.Join(importRow.errors, "; ")
+			results = append(results, result)
+			continue
+		}
+
+		validParams = append(validParams, importRow.params)
+		validResultIndices = append(validResultIndices, len(results))
+
+		// Mark as success (will be validated after batch save)
+		result.Success = true
+		result.Status = "success"
+		results = append(results, result)
+	}
+
+	if len(validParams) > 0 {
+		if err := u.repo.ImportParameters(ctx, validParams); err != nil {
+			// If batch save fails, mark all pending rows as failed
+			for _, idx := range validResultIndices {
+				results[idx].Success = false
+				results[idx].Status = "failed"
+				results[idx].ErrorMessage = fmt.Sprintf("%s: %v", constants.ParameterImportBatchSaveFailed, err)
+			}
+		} else {
+			u.lookup.Invalidate(ctx)
+		}
+	}
+
+	return toResImportParameters(results), nil
+}
+
+// validateParameterImportRow checks the fields, the uniqueness and the typed value of a row
+func (u *parameterUsecase) validateParameterImportRow(ctx context.Context, importRow *parameterImportRow, existing map[string]models.Parameter, types map[string]models.ParameterType, fileRows map[string]*parameterImportRow, seenNames map[string]int) error {
+	params := &importRow.params
+
+	if params.Code == "" {
+		importRow.errors = append(importRow.errors, fmt.Sprintf(constants.ParameterImportFieldRequired, "code"))
+	} else if len(params.Code) > 255 {
+		importRow.errors = append(importRow.errors, fmt.Sprintf(constants.ParameterImportFieldTooLong, "code", 255))
+	} else if firstRow, ok := fileRows[params.Code]; ok {
+		importRow.errors = append(importRow.errors, fmt.Sprintf(constants.ParameterImportRowDuplicated, firstRow.rowNum))
+	} else {
+		fileRows[params.Code] = importRow
+		if _, ok := existing[params.Code]; !ok {
+			// the code may still be taken by a deleted parameter
+			exists, err := u.repo.ExistsByCode(ctx, params.Code, uuid.Nil)
+			if err != nil {
+				return err
+			}
+			if exists {
+				importRow.errors = append(importRow.errors, constants.ParameterCodeAlreadyExists)
+			}
+		}
+	}
+
+	if params.Name == "" {
+		importRow.errors = append(importRow.errors, fmt.Sprintf(constants.ParameterImportFieldRequired, "name"))
+	} else if len(params.Name) > 255 {
+		importRow.errors = append(importRow.errors, fmt.Sprintf(constants.ParameterImportFieldTooLong, "name", 255))
+	} else if firstRow, ok := seenNames[params.Name]; ok {
+		importRow.errors = append(importRow.errors, fmt.Sprintf(constants.ParameterImportRowDuplicated, firstRow))
+	} else {
+		seenNames[params.Name] = importRow.rowNum
+		excludeID := uuid.Nil
+		if current, ok := existing[params.Code]; ok {
+			excludeID = current.ID
+		}
+		exists, err := u.repo.ExistsByName(ctx, params.Name, excludeID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			importRow.errors = append(importRow.errors, constants.ParameterNameAlreadyExists)
+		}
+	}
+
+	if params.Type != nil {
+		if len(*params.Type) > 255 {
+			importRow.errors = append(importRow.errors, fmt.Sprintf(constants.ParameterImportFieldTooLong, "type", 255))
+		} else if parameterType, ok := types[*params.Type]; ok {
+			importRow.parameterType = &parameterType
+			value, err := normalizeValue(importRow.parameterType, params.Value)
+			if err != nil {
+				importRow.errors = append(importRow.errors, err.Error())
+			}
+			params.Value = value
+		} else {
+			importRow.errors = append(importRow.errors, fmt.Sprintf(constants.ParameterTypeNotRegistered, *params.Type))
+		}
+	}
+
+	if len(params.ParentCode) > 255 {
+		importRow.errors = append(importRow.errors, fmt.Sprintf(constants.ParameterImportFieldTooLong, "parent_code", 255))
+	} else if params.ParentCode != "" && params.ParentCode == params.Code {
+		importRow.errors = append(importRow.errors, constants.ParameterParentSelf)
+	}
+	return nil
+}
+
+// validateParameterImportParent checks the parent of a row exists and accepts its type,
+// and the children of an existing parameter accept its new type
+func (u *parameterUsecase) validateParameterImportParent(ctx context.Context, importRow *parameterImportRow, existing map[string]models.Parameter, fileRows map[string]*parameterImportRow) error {
+	params := importRow.params
+	if params.ParentCode != "" && params.ParentCode != params.Code && len(params.ParentCode) <= 255 {
+		var parentType *string
+		found := true
+		if parentRow, ok := fileRows[params.ParentCode]; ok {
+			parentType = parentRow.params.Type
+		} else if parent, ok := existing[params.ParentCode]; ok {
+			parentType = parent.Type
+		} else {
+			found = false
+			importRow.errors = append(importRow.errors, fmt.Sprintf(constants.ParameterImportParentNotFound, params.ParentCode))
+		}
+		if found && importRow.parameterType != nil && !paramvalue.ParentAllowed(*importRow.parameterType, parentType) {
+			importRow.errors = append(importRow.errors, fmt.Sprintf(constants.ParameterParentTypeNotAllowed, importRow.parameterType.Code, typeName(parentType)))
+		}
+	}
+
+	// The children of an existing parameter must accept its new type
+	current, ok := existing[params.Code]
+	if !ok || fileRows[params.Code] != importRow || typeName(current.Type) == typeName(params.Type) {
+		return nil
+	}
+	if params.Type != nil && importRow.parameterType == nil {
+		// the type is not registered, already reported
+		return nil
+	}
+	violation, err := u.childTypeViolation(ctx, current.ID, importRow.parameterType)
+	if err != nil {
+		return err
+	}
+	if violation != "" {
+		importRow.errors = append(importRow.errors, violation)
+	}
+	return nil
+}
+
+// validateParameterImportCycles reports the rows whose parent chain leads back to the row,
+// the parents of the rows of the file replace the parents of the existing parameters
+func (u *parameterUsecase) validateParameterImportCycles(ctx context.Context, importRows []*parameterImportRow, fileRows map[string]*parameterImportRow) error {
+	hasParent := false
+	for _, importRow := range importRows {
+		if importRow.params.ParentCode != "" {
+			hasParent = true
+			break
+		}
+	}
+	if !hasParent {
+		return nil
+	}
+
+	entries, err := u.repo.GetLookupEntries(ctx)
+	if err != nil {
+		return err
+	}
+	parentCodes := make(map[string]string, len(entries)+len(fileRows))
+	for _, entry := range entries {
+		parentCodes[entry.Code] = entry.ParentCode
+	}
+	for code, fileRow := range fileRows {
+		parentCodes[code] = fileRow.params.ParentCode
+	}
+
+	for _, importRow := range importRows {
+		params := importRow.params
+		if params.ParentCode == "" || params.ParentCode == params.Code || fileRows[params.Code] != importRow {
+			continue
+		}
+		// a chain longer than the number of parameters loops without reaching the row
+		code := params.ParentCode
+		for steps := 0; code != "" && steps <= len(parentCodes); steps++ {
+			if code == params.Code {
+				importRow.errors = append(importRow.errors, constants.ParameterParentCycle)
+				break
+			}
+			code = parentCodes[code]
+		}
+	}
+	return nil
+}
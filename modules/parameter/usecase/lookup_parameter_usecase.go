@@ -0,0 +1,321 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/i18n"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/parameter"
+	"github.com/rendyfutsuy/base-go/modules/parameter/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"go.uber.org/zap"
+)
+
+// parameterLookup keeps every parameter in memory, the table is small and read far more often than written.
+// A snapshot is kept per locale as the names are translated.
+// Changes made outside the parameter usecase (e.g. a restore from the recycle bin or a new translation) show after the TTL.
+type parameterLookup struct {
+	repo       mod.Repository
+	redis      *redis.Client
+	ttl        time.Duration
+	instanceID string // ignores the invalidation messages sent by this instance
+
+	mu         sync.RWMutex
+	snapshots  map[string]*lookupSnapshot // by locale
+	generation int                        // incremented on every invalidation, a load started before it is not kept
+	loadMu     sync.Mutex                 // a single load at a time
+}
+
+// lookupSnapshot is the cached content of the parameters table with its indexes
+type lookupSnapshot struct {
+	loadedAt time.Time
+	byID     map[uuid.UUID]models.Parameter
+	byCode   map[string]models.Parameter
+	byType   map[string][]models.Parameter
+	byParent map[string][]models.Parameter // by code of the parent
+}
+
+// NewParameterLookup returns the cached parameter lookup. Without Redis the cache is only
+// dropped on the instance making the change, the other instances reload it after the TTL.
+func NewParameterLookup(repo mod.Repository, redisClient *redis.Client, ttl time.Duration) mod.Lookup {
+	l := &parameterLookup{repo: repo, redis: redisClient, ttl: ttl, instanceID: uuid.NewString()}
+	if redisClient != nil {
+		go l.listen()
+	}
+	return l
+}
+
+// LookupCacheTTLFromConfig returns how long the lookup cache is kept without invalidation, 0 keeps it until invalidated
+func LookupCacheTTLFromConfig() time.Duration {
+	seconds := constants.ParameterLookupCacheTTLSecondsDefault
+	if utils.ConfigVars != nil && utils.ConfigVars.Exists("parameter.lookup_cache_ttl_seconds") {
+		seconds = utils.ConfigVars.Int("parameter.lookup_cache_ttl_seconds")
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func (l *parameterLookup) GetByID(ctx context.Context, id uuid.UUID) (*models.Parameter, error) {
+	snapshot, err := l.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := snapshot.byID[id]
+	if !ok {
+		return nil, fmt.Errorf(constants.ParameterNotFound, id)
+	}
+	return &p, nil
+}
+
+func (l *parameterLookup) GetByCode(ctx context.Context, code string) (*models.Parameter, error) {
+	snapshot, err := l.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := snapshot.byCode[code]
+	if !ok {
+		return nil, fmt.Errorf(constants.ParameterLookupCodeNotFound, code)
+	}
+	return &p, nil
+}
+
+func (l *parameterLookup) ListByType(ctx context.Context, typeCode string) ([]models.Parameter, error) {
+	snapshot, err := l.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return append([]models.Parameter{}, snapshot.byType[typeCode]...), nil
+}
+
+func (l *parameterLookup) ListChildren(ctx context.Context, parentCode string) ([]models.Parameter, error) {
+	snapshot, err := l.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return append([]models.Parameter{}, snapshot.byParent[parentCode]...), nil
+}
+
+// GetString returns the value of a parameter, empty when it has no value
+func (l *parameterLookup) GetString(ctx context.Context, code string) (string, error) {
+	p, err := l.GetByCode(ctx, code)
+	if err != nil {
+		return "", err
+	}
+	if p.Value == nil {
+		return "", nil
+	}
+	return *p.Value, nil
+}
+
+func (l *parameterLookup) GetInt(ctx context.Context, code string) (int64, error) {
+	value, err := l.requiredValue(ctx, code)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf(constants.ParameterLookupValueInvalid, code, "integer")
+	}
+	return n, nil
+}
+
+func (l *parameterLookup) GetDecimal(ctx context.Context, code string) (float64, error) {
+	value, err := l.requiredValue(ctx, code)
+	if err != nil {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf(constants.ParameterLookupValueInvalid, code, "decimal number")
+	}
+	return f, nil
+}
+
+func (l *parameterLookup) GetBool(ctx context.Context, code string) (bool, error) {
+	value, err := l.requiredValue(ctx, code)
+	if err != nil {
+		return false, err
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf(constants.ParameterLookupValueInvalid, code, "boolean")
+	}
+	return b, nil
+}
+
+func (l *parameterLookup) GetDate(ctx context.Context, code string) (time.Time, error) {
+	value, err := l.requiredValue(ctx, code)
+	if err != nil {
+		return time.Time{}, err
+	}
+	d, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(constants.ParameterLookupValueInvalid, code, "date")
+	}
+	return d, nil
+}
+
+func (l *parameterLookup) GetJSON(ctx context.Context, code string, dest interface{}) error {
+	value, err := l.requiredValue(ctx, code)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal([]byte(value), dest); err != nil {
+		return fmt.Errorf(constants.ParameterLookupValueInvalid, code, "JSON")
+	}
+	return nil
+}
+
+// Invalidate drops the cache and asks the other instances to drop theirs,
+// a failed publish is only logged as the other instances reload after the TTL
+func (l *parameterLookup) Invalidate(ctx context.Context) {
+	l.clear()
+	if l.redis == nil {
+		return
+	}
+	if err := l.redis.Publish(ctx, constants.ParameterLookupInvalidateChannel, l.instanceID).Err(); err != nil {
+		utils.Logger.Warn("Failed to publish parameter lookup invalidation", zap.Error(err))
+	}
+}
+
+func (l *parameterLookup) requiredValue(ctx context.Context, code string) (string, error) {
+	value, err := l.GetString(ctx, code)
+	if err != nil {
+		return "", err
+	}
+	if value == "" {
+		return "", fmt.Errorf(constants.ParameterLookupValueEmpty, code)
+	}
+	return value, nil
+}
+
+// get returns the cached snapshot of the locale of the request, loading it when missing or expired
+func (l *parameterLookup) get(ctx context.Context) (*lookupSnapshot, error) {
+	locale := i18n.LocaleFromContext(ctx)
+	if snapshot := l.current(locale); snapshot != nil {
+		return snapshot, nil
+	}
+
+	l.loadMu.Lock()
+	defer l.loadMu.Unlock()
+	if snapshot := l.current(locale); snapshot != nil {
+		return snapshot, nil
+	}
+
+	l.mu.RLock()
+	generation := l.generation
+	l.mu.RUnlock()
+
+	params, err := l.repo.GetLookupEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := newLookupSnapshot(params)
+
+	l.mu.Lock()
+	if l.generation == generation {
+		if l.snapshots == nil {
+			l.snapshots = map[string]*lookupSnapshot{}
+		}
+		l.snapshots[locale] = snapshot
+	}
+	l.mu.Unlock()
+	return snapshot, nil
+}
+
+// current returns the snapshot of the locale when it is still fresh
+func (l *parameterLookup) current(locale string) *lookupSnapshot {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	snapshot := l.snapshots[locale]
+	if snapshot == nil || (l.ttl > 0 && time.Since(snapshot.loadedAt) >= l.ttl) {
+		return nil
+	}
+	return snapshot
+}
+
+func (l *parameterLookup) clear() {
+	l.mu.Lock()
+	l.snapshots = nil
+	l.generation++
+	l.mu.Unlock()
+}
+
+// listen drops the cache when another instance changes a parameter
+func (l *parameterLookup) listen() {
+	pubsub := l.redis.Subscribe(context.Background(), constants.ParameterLookupInvalidateChannel)
+	defer pubsub.Close()
+	for msg := range pubsub.Channel() {
+		if msg.Payload != l.instanceID {
+			l.clear()
+		}
+	}
+}
+
+func newLookupSnapshot(params []models.Parameter) *lookupSnapshot {
+	snapshot := &lookupSnapshot{
+		loadedAt: time.Now(),
+		byID:     make(map[uuid.UUID]models.Parameter, len(params)),
+		byCode:   make(map[string]models.Parameter, len(params)),
+		byType:   make(map[string][]models.Parameter),
+		byParent: make(map[string][]models.Parameter),
+	}
+	for _, p := range params {
+		snapshot.byID[p.ID] = p
+		snapshot.byCode[p.Code] = p
+		if p.Type != nil {
+			snapshot.byType[*p.Type] = append(snapshot.byType[*p.Type], p)
+		}
+		if p.ParentCode != "" {
+			snapshot.byParent[p.ParentCode] = append(snapshot.byParent[p.ParentCode], p)
+		}
+	}
+	return snapshot
+}
+
+// Lookup returns the cached parameters of a type and/or children of a parent with the ETag of the result
+func (u *parameterUsecase) Lookup(ctx context.Context, req dto.ReqParameterLookup) ([]dto.RespParameterLookup, string, error) {
+	typeCode := strings.TrimSpace(req.Type)
+	parentCode := strings.TrimSpace(req.ParentCode)
+
+	var params []models.Parameter
+	var err error
+	switch {
+	case typeCode != "":
+		params, err = u.lookup.ListByType(ctx, typeCode)
+	case parentCode != "":
+		params, err = u.lookup.ListChildren(ctx, parentCode)
+	default:
+		return nil, "", errors.New(constants.ParameterLookupFilterRequired)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	res := make([]dto.RespParameterLookup, 0, len(params))
+	for _, p := range params {
+		if parentCode != "" && p.ParentCode != parentCode {
+			continue
+		}
+		res = append(res, dto.ToRespParameterLookup(p))
+	}
+
+	// the ETag is made from the locale and the content so every instance gives the same one
+	body, err := json.Marshal(res)
+	if err != nil {
+		return nil, "", err
+	}
+	sum := sha256.Sum256(append([]byte(i18n.LocaleFromContext(ctx)+"|"), body...))
+	return res, `"` + hex.EncodeToString(sum[:16]) + `"`, nil
+}
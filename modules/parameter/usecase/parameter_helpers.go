@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/modules/parameter/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/xuri/excelize/v2"
+)
+
+// readParameterImportRows returns the data rows of the first sheet, without the header row
+func readParameterImportRows(filePath string) ([][]string, error) {
+	f, err := excelize.OpenFile(filePath)
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, fmt.Errorf("%s: %v", constants.ParameterImportExcelOpenFailed, err)
+	}
+	defer f.Close()
+
+	rows, err := f.GetRows(f.GetSheetName(0))
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, fmt.Errorf("%s: %v", constants.ParameterImportExcelReadFailed, err)
+	}
+
+	if len(rows) < 2 {
+		return nil, errors.New(constants.ParameterImportExcelInsufficientRows)
+	}
+
+	return rows[1:], nil
+}
+
+func parameterImportCell(row []string, index int) string {
+	if index < len(row) {
+		return strings.TrimSpace(row[index])
+	}
+	return ""
+}
+
+// optionalParameterImportCell returns nil for an empty cell
+func optionalParameterImportCell(row []string, index int) *string {
+	value := parameterImportCell(row, index)
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func isBlankParameterImportRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// uniqueParameterImportValues removes the empty and duplicated values, keeping the order
+func uniqueParameterImportValues(values []string) []string {
+	res := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		res = append(res, value)
+	}
+	return res
+}
+
+func toResImportParameters(results []dto.ResImportParameterExcel) *dto.ResImportParameters {
+	successCount := 0
+	failedCount := 0
+	for _, result := range results {
+		if result.Status == "success" {
+			successCount++
+		} else {
+			failedCount++
+		}
+	}
+
+	return &dto.ResImportParameters{
+		TotalRows:    len(results),
+		SuccessCount: successCount,
+		FailedCount:  failedCount,
+		Results:      results,
+	}
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/parameter/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"gorm.io/gorm"
+)
+
+// GetTree returns the parameters of a type as a tree, in sibling order.
+// A parameter whose parent has another type is a root of the tree.
+func (u *parameterUsecase) GetTree(ctx context.Context, req dto.ReqParameterTree) ([]dto.RespParameterTreeNode, error) {
+	typeCode := strings.TrimSpace(req.Type)
+	if typeCode == "" {
+		return nil, errors.New(constants.ParameterTreeTypeRequired)
+	}
+	list, err := u.repo.GetTree(ctx, typeCode)
+	if err != nil {
+		return nil, err
+	}
+
+	inTree := make(map[uuid.UUID]bool, len(list))
+	for _, p := range list {
+		inTree[p.ID] = true
+	}
+	children := make(map[uuid.UUID][]models.Parameter)
+	roots := make([]models.Parameter, 0)
+	for _, p := range list {
+		if p.ParentID != uuid.Nil && inTree[p.ParentID] {
+			children[p.ParentID] = append(children[p.ParentID], p)
+			continue
+		}
+		roots = append(roots, p)
+	}
+
+	visited := make(map[uuid.UUID]bool, len(list))
+	return buildParameterTree(roots, children, visited), nil
+}
+
+// buildParameterTree nests the children under their parents, visited guards against cycles in stored data
+func buildParameterTree(nodes []models.Parameter, children map[uuid.UUID][]models.Parameter, visited map[uuid.UUID]bool) []dto.RespParameterTreeNode {
+	res := make([]dto.RespParameterTreeNode, 0, len(nodes))
+	for _, p := range nodes {
+		if visited[p.ID] {
+			continue
+		}
+		visited[p.ID] = true
+		node := dto.ToRespParameterTreeNode(p)
+		node.Children = buildParameterTree(children[p.ID], children, visited)
+		res = append(res, node)
+	}
+	return res
+}
+
+// Move links a parameter to another parent (or none) at a position among its new siblings.
+// The parent must exist, must not be the parameter or one of its descendants, and must be allowed by the type.
+func (u *parameterUsecase) Move(ctx context.Context, id string, reqBody *dto.ReqMoveParameter, userID string) (*models.Parameter, error) {
+	pid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	current, err := u.repo.GetByID(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil || current.ID == uuid.Nil {
+		return nil, fmt.Errorf(constants.ParameterNotFound, id)
+	}
+
+	var parent *models.Parameter
+	if reqBody.ParentID != nil && *reqBody.ParentID != uuid.Nil {
+		if *reqBody.ParentID == pid {
+			return nil, errors.New(constants.ParameterParentSelf)
+		}
+		if parent, err = u.getParent(ctx, *reqBody.ParentID); err != nil {
+			return nil, err
+		}
+		if err := u.checkParentCycle(ctx, pid, parent.ID); err != nil {
+			return nil, err
+		}
+	}
+	parameterType, err := u.resolveType(ctx, current.Type)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkParentType(parameterType, parent); err != nil {
+		return nil, err
+	}
+
+	parentID := uuid.Nil
+	if parent != nil {
+		parentID = parent.ID
+	}
+	position := 0
+	if reqBody.Position != nil {
+		position = *reqBody.Position
+	}
+	if err := u.repo.Move(ctx, pid, parentID, position); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.ParameterNotFound, id)
+		}
+		return nil, err
+	}
+	u.lookup.Invalidate(ctx)
+	return u.repo.GetByID(ctx, pid)
+}
+
+// Reorder sets the order of the children of a parent (or of the parameters of a type without parent),
+// ids must list every sibling exactly once
+func (u *parameterUsecase) Reorder(ctx context.Context, reqBody *dto.ReqReorderParameters, userID string) error {
+	parentID := uuid.Nil
+	if reqBody.ParentID != nil && *reqBody.ParentID != uuid.Nil {
+		parent, err := u.getParent(ctx, *reqBody.ParentID)
+		if err != nil {
+			return err
+		}
+		parentID = parent.ID
+	}
+	var typeVal *string
+	if reqBody.Type != nil && strings.TrimSpace(*reqBody.Type) != "" {
+		code := strings.TrimSpace(*reqBody.Type)
+		typeVal = &code
+	}
+
+	siblings, err := u.repo.GetSiblings(ctx, parentID, typeVal)
+	if err != nil {
+		return err
+	}
+	if len(siblings) != len(reqBody.IDs) {
+		return errors.New(constants.ParameterReorderMismatch)
+	}
+	pending := make(map[uuid.UUID]bool, len(siblings))
+	for _, sibling := range siblings {
+		pending[sibling.ID] = true
+	}
+	for _, siblingID := range reqBody.IDs {
+		if !pending[siblingID] {
+			return errors.New(constants.ParameterReorderMismatch)
+		}
+		delete(pending, siblingID)
+	}
+
+	if err := u.repo.Reorder(ctx, reqBody.IDs); err != nil {
+		return err
+	}
+	u.lookup.Invalidate(ctx)
+	return nil
+}
+
+// checkParentCycle checks the new parent is not a descendant of the parameter
+func (u *parameterUsecase) checkParentCycle(ctx context.Context, pid uuid.UUID, parentID uuid.UUID) error {
+	ancestorIDs, err := u.repo.GetAncestorIDs(ctx, parentID)
+	if err != nil {
+		return err
+	}
+	for _, ancestorID := range ancestorIDs {
+		if ancestorID == pid {
+			return errors.New(constants.ParameterParentCycle)
+		}
+	}
+	return nil
+}
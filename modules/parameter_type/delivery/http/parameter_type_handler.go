@@ -0,0 +1,200 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/middleware"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/parameter_type"
+	"github.com/rendyfutsuy/base-go/modules/parameter_type/dto"
+)
+
+type ResponseError struct {
+	Message string `json:"message"`
+}
+
+type Response struct {
+	Message string `json:"message"`
+}
+
+type ParameterTypeHandler struct {
+	Usecase              parameter_type.Usecase
+	validator            *validator.Validate
+	middlewareAuth       middleware.IMiddlewareAuth
+	middlewarePermission middleware.IMiddlewarePermission
+}
+
+func NewParameterTypeHandler(e *echo.Echo, uc parameter_type.Usecase, auth middleware.IMiddlewareAuth, middlewarePermission middleware.IMiddlewarePermission) {
+	h := &ParameterTypeHandler{Usecase: uc, validator: validator.New(), middlewareAuth: auth, middlewarePermission: middlewarePermission}
+
+	r := e.Group("/v1/parameter-type")
+	r.Use(h.middlewareAuth.AuthorizationCheck)
+
+	// Permissions, the types are managed with the parameters
+	// View:   parameter.view
+	// Create: parameter.create
+	// Update: parameter.update
+	// Delete: parameter.delete
+	permissionToView := []string{"parameter.view"}
+	permissionToCreate := []string{"parameter.create"}
+	permissionToUpdate := []string{"parameter.update"}
+	permissionToDelete := []string{"parameter.delete"}
+
+	// List every type
+	r.GET("", h.GetAll, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+
+	// Get by code (detail)
+	r.GET("/:code", h.GetByCode, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+
+	// Create
+	r.POST("", h.Create, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToCreate))
+
+	// Update
+	r.PUT("/:code", h.Update, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+
+	// Delete
+	r.DELETE("/:code", h.Delete, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToDelete))
+}
+
+// GetAll godoc
+// @Summary		Get parameter types
+// @Description	Retrieve every registered parameter type with its value kind (string, int, decimal, bool, date, enum, json), enum values, JSON Schema and allowed parent types
+// @Tags			Parameter Type
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Success		200	{object}	response.NonPaginationResponse{data=[]dto.RespParameterType}	"Successfully retrieved parameter types"
+// @Failure		400	{object}	response.NonPaginationResponse	"Bad request"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403	{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/parameter-type [get]
+func (h *ParameterTypeHandler) GetAll(c echo.Context) error {
+	res, err := h.Usecase.GetAll(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	respTypes := []dto.RespParameterType{}
+	for _, v := range res {
+		respTypes = append(respTypes, dto.ToRespParameterType(v))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(respTypes)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetByCode godoc
+// @Summary		Get parameter type by code
+// @Description	Retrieve a single parameter type by its code (the value of the type field of the parameters)
+// @Tags			Parameter Type
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			code	path		string	true	"Parameter type code"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespParameterType}	"Successfully retrieved parameter type"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - parameter type not found"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/parameter-type/{code} [get]
+func (h *ParameterTypeHandler) GetByCode(c echo.Context) error {
+	res, err := h.Usecase.GetByCode(c.Request().Context(), c.Param("code"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespParameterType(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Create godoc
+// @Summary		Create a parameter type
+// @Description	Register a parameter type. value_kind decides how the values of its parameters are parsed and stored: string, int, decimal, bool (true / false), date (YYYY-MM-DD), enum (one of enum_values) or json. json_schema is an optional JSON Schema the parsed value must match (type, enum, const, minimum, maximum, exclusiveMinimum, exclusiveMaximum, multipleOf, minLength, maxLength, pattern, format, properties, required, additionalProperties, minProperties, maxProperties, items, minItems, maxItems, uniqueItems). allowed_parent_types lists the types the parent of a parameter may have, the type itself included, empty when its parameters have no parent. Requires 'api.master-data.parameter.create' permission.
+// @Tags			Parameter Type
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	body		dto.ReqCreateParameterType	true	"Parameter type. Fields: code (required), name (required), value_kind (required), value_required, enum_values, json_schema, allowed_parent_types, description"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespParameterType}	"Successfully created parameter type"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, duplicate code, invalid enum values, schema or parent type"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/parameter-type [post]
+func (h *ParameterTypeHandler) Create(c echo.Context) error {
+	req := new(dto.ReqCreateParameterType)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	res, err := h.Usecase.Create(c.Request().Context(), req, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespParameterType(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Update godoc
+// @Summary		Update a parameter type
+// @Description	Update a parameter type with the same rules as creation. The code cannot be changed. Every parameter of the type must still match it (value and parent type), otherwise the update is rejected with the first parameter that does not. Requires 'api.master-data.parameter.update' permission.
+// @Tags			Parameter Type
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			code	path		string						true	"Parameter type code"
+// @Param			request	body		dto.ReqUpdateParameterType	true	"Parameter type. Fields: name (required), value_kind (required), value_required, enum_values, json_schema, allowed_parent_types, description"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespParameterType}	"Successfully updated parameter type"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, parameter type not found or a parameter no longer matches the type"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/parameter-type/{code} [put]
+func (h *ParameterTypeHandler) Update(c echo.Context) error {
+	req := new(dto.ReqUpdateParameterType)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	res, err := h.Usecase.Update(c.Request().Context(), c.Param("code"), req, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespParameterType(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Delete godoc
+// @Summary		Delete a parameter type
+// @Description	Delete a parameter type no parameter uses (soft-deleted parameters included). The type is removed from the allowed parent types of the other types. Requires 'api.master-data.parameter.delete' permission.
+// @Tags			Parameter Type
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			code	path		string	true	"Parameter type code"
+// @Success		200		{object}	response.NonPaginationResponse	"Successfully deleted parameter type"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - parameter type not found or in use"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/parameter-type/{code} [delete]
+func (h *ParameterTypeHandler) Delete(c echo.Context) error {
+	if err := h.Usecase.Delete(c.Request().Context(), c.Param("code"), authUserID(c)); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(Response{Message: constants.ParameterTypeDeleteSuccess})
+	return c.JSON(http.StatusOK, resp)
+}
+
+func authUserID(c echo.Context) string {
+	if userModel, ok := c.Get("user").(models.User); ok {
+		return userModel.ID.String()
+	}
+	return ""
+}
@@ -0,0 +1,73 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+)
+
+type ReqCreateParameterType struct {
+	Code               string          `json:"code" validate:"required,max=255"`
+	Name               string          `json:"name" validate:"required,max=255"`
+	ValueKind          string          `json:"value_kind" validate:"required,oneof=string int decimal bool date enum json"`
+	ValueRequired      bool            `json:"value_required"`
+	EnumValues         []string        `json:"enum_values,omitempty"`                      // required for value_kind enum
+	JSONSchema         json.RawMessage `json:"json_schema,omitempty" swaggertype:"object"` // optional JSON Schema the typed value must match
+	AllowedParentTypes []string        `json:"allowed_parent_types,omitempty"`             // empty when the parameters have no parent
+	Description        *string         `json:"description,omitempty"`
+}
+
+type ReqUpdateParameterType struct {
+	Name               string          `json:"name" validate:"required,max=255"`
+	ValueKind          string          `json:"value_kind" validate:"required,oneof=string int decimal bool date enum json"`
+	ValueRequired      bool            `json:"value_required"`
+	EnumValues         []string        `json:"enum_values,omitempty"`
+	JSONSchema         json.RawMessage `json:"json_schema,omitempty" swaggertype:"object"`
+	AllowedParentTypes []string        `json:"allowed_parent_types,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+}
+
+type RespParameterType struct {
+	Code               string          `json:"code"`
+	Name               string          `json:"name"`
+	ValueKind          string          `json:"value_kind"`
+	ValueRequired      bool            `json:"value_required"`
+	EnumValues         []string        `json:"enum_values"`
+	JSONSchema         json.RawMessage `json:"json_schema" swaggertype:"object"`
+	AllowedParentTypes []string        `json:"allowed_parent_types"`
+	Description        *string         `json:"description"`
+	CreatedAt          string          `json:"created_at"`
+	CreatedBy          string          `json:"created_by"`
+	UpdatedAt          string          `json:"updated_at"`
+	UpdatedBy          string          `json:"updated_by"`
+}
+
+func ToRespParameterType(m models.ParameterType) RespParameterType {
+	enumValues := []string{}
+	if m.EnumValues.Valid {
+		enumValues = append(enumValues, m.EnumValues.Strings...)
+	}
+	allowedParentTypes := []string{}
+	if m.AllowedParentTypes.Valid {
+		allowedParentTypes = append(allowedParentTypes, m.AllowedParentTypes.Strings...)
+	}
+	var schema json.RawMessage
+	if m.JSONSchema.Valid {
+		schema = m.JSONSchema.JsonRawMessage
+	}
+	return RespParameterType{
+		Code:               m.Code,
+		Name:               m.Name,
+		ValueKind:          m.ValueKind,
+		ValueRequired:      m.ValueRequired,
+		EnumValues:         enumValues,
+		JSONSchema:         schema,
+		AllowedParentTypes: allowedParentTypes,
+		Description:        m.Description,
+		CreatedAt:          m.CreatedAt.Format(constants.FormatDateTimeISO8601),
+		CreatedBy:          m.CreatedBy,
+		UpdatedAt:          m.UpdatedAt.Format(constants.FormatDateTimeISO8601),
+		UpdatedBy:          m.UpdatedBy,
+	}
+}
@@ -0,0 +1,27 @@
+package parameter_type
+
+import (
+	"context"
+
+	"github.com/rendyfutsuy/base-go/models"
+)
+
+// ParameterUsage is a parameter of a type with the type of its parent,
+// used to check the existing parameters when the type changes
+type ParameterUsage struct {
+	Code       string  `gorm:"column:code"`
+	Value      *string `gorm:"column:value"`
+	HasParent  bool    `gorm:"column:has_parent"`
+	ParentType *string `gorm:"column:parent_type"`
+}
+
+type Repository interface {
+	GetAll(ctx context.Context) ([]models.ParameterType, error)
+	GetByCode(ctx context.Context, code string) (*models.ParameterType, error)
+	GetByCodes(ctx context.Context, codes []string) ([]models.ParameterType, error)
+	Create(ctx context.Context, parameterType models.ParameterType) (*models.ParameterType, error)
+	Update(ctx context.Context, parameterType models.ParameterType) (*models.ParameterType, error)
+	Delete(ctx context.Context, code string) error
+	CountParameters(ctx context.Context, code string) (int64, error)
+	GetParameterUsages(ctx context.Context, code string) ([]ParameterUsage, error)
+}
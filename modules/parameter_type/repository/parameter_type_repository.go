@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/parameter_type"
+	"gorm.io/gorm"
+)
+
+type parameterTypeRepository struct {
+	DB *gorm.DB
+}
+
+func NewParameterTypeRepository(db *gorm.DB) *parameterTypeRepository {
+	return &parameterTypeRepository{
+		DB: db,
+	}
+}
+
+func (r *parameterTypeRepository) GetAll(ctx context.Context) ([]models.ParameterType, error) {
+	var types []models.ParameterType
+	if err := r.DB.WithContext(ctx).Order("code").Find(&types).Error; err != nil {
+		return nil, err
+	}
+	return types, nil
+}
+
+func (r *parameterTypeRepository) GetByCode(ctx context.Context, code string) (*models.ParameterType, error) {
+	parameterType := &models.ParameterType{}
+	if err := r.DB.WithContext(ctx).Where("code = ?", code).First(parameterType).Error; err != nil {
+		return nil, err
+	}
+	return parameterType, nil
+}
+
+func (r *parameterTypeRepository) GetByCodes(ctx context.Context, codes []string) ([]models.ParameterType, error) {
+	var types []models.ParameterType
+	if len(codes) == 0 {
+		return types, nil
+	}
+	if err := r.DB.WithContext(ctx).Where("code IN ?", codes).Find(&types).Error; err != nil {
+		return nil, err
+	}
+	return types, nil
+}
+
+func (r *parameterTypeRepository) Create(ctx context.Context, parameterType models.ParameterType) (*models.ParameterType, error) {
+	if err := r.DB.WithContext(ctx).Create(&parameterType).Error; err != nil {
+		return nil, err
+	}
+	return &parameterType, nil
+}
+
+func (r *parameterTypeRepository) Update(ctx context.Context, parameterType models.ParameterType) (*models.ParameterType, error) {
+	result := r.DB.WithContext(ctx).Model(&models.ParameterType{}).
+		Where("code = ?", parameterType.Code).
+		Updates(map[string]interface{}{
+			"name":                 parameterType.Name,
+			"value_kind":           parameterType.ValueKind,
+			"value_required":       parameterType.ValueRequired,
+			"enum_values":          parameterType.EnumValues,
+			"json_schema":          parameterType.JSONSchema,
+			"allowed_parent_types": parameterType.AllowedParentTypes,
+			"description":          parameterType.Description,
+			"updated_at":           parameterType.UpdatedAt,
+			"updated_by":           parameterType.UpdatedBy,
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return r.GetByCode(ctx, parameterType.Code)
+}
+
+// Delete removes the type and drops it from the allowed parent types of the other types
+func (r *parameterTypeRepository) Delete(ctx context.Context, code string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("code = ?", code).Delete(&models.ParameterType{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Exec(`UPDATE parameter_types SET allowed_parent_types = allowed_parent_types - ?::text
+			WHERE allowed_parent_types @> jsonb_build_array(?::text)`, code, code).Error
+	})
+}
+
+// CountParameters counts the parameters of a type, soft-deleted ones included as they can be restored
+func (r *parameterTypeRepository) CountParameters(ctx context.Context, code string) (int64, error) {
+	var count int64
+	if err := r.DB.WithContext(ctx).Unscoped().Model(&models.Parameter{}).Where("type = ?", code).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *parameterTypeRepository) GetParameterUsages(ctx context.Context, code string) ([]parameter_type.ParameterUsage, error) {
+	var usages []parameter_type.ParameterUsage
+	if err := r.DB.WithContext(ctx).
+		Table("parameters p").
+		Select("p.code, p.value, parent.id IS NOT NULL AS has_parent, parent.type AS parent_type").
+		Joins("LEFT JOIN parameters parent ON parent.id = p.parent_id AND parent.deleted_at IS NULL").
+		Where("p.type = ? AND p.deleted_at IS NULL", code).
+		Order("p.code").
+		Scan(&usages).Error; err != nil {
+		return nil, err
+	}
+	return usages, nil
+}
@@ -0,0 +1,355 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/parameter_type"
+	"github.com/rendyfutsuy/base-go/modules/parameter_type/dto"
+	"github.com/rendyfutsuy/base-go/modules/parameter_type/usecase"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// MockParameterTypeRepository is a mock implementation of parameter_type.Repository
+type MockParameterTypeRepository struct {
+	mock.Mock
+}
+
+func (m *MockParameterTypeRepository) GetAll(ctx context.Context) ([]models.ParameterType, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ParameterType), args.Error(1)
+}
+
+func (m *MockParameterTypeRepository) GetByCode(ctx context.Context, code string) (*models.ParameterType, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ParameterType), args.Error(1)
+}
+
+func (m *MockParameterTypeRepository) GetByCodes(ctx context.Context, codes []string) ([]models.ParameterType, error) {
+	args := m.Called(ctx, codes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ParameterType), args.Error(1)
+}
+
+func (m *MockParameterTypeRepository) Create(ctx context.Context, parameterType models.ParameterType) (*models.ParameterType, error) {
+	args := m.Called(ctx, parameterType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ParameterType), args.Error(1)
+}
+
+func (m *MockParameterTypeRepository) Update(ctx context.Context, parameterType models.ParameterType) (*models.ParameterType, error) {
+	args := m.Called(ctx, parameterType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ParameterType), args.Error(1)
+}
+
+func (m *MockParameterTypeRepository) Delete(ctx context.Context, code string) error {
+	args := m.Called(ctx, code)
+	return args.Error(0)
+}
+
+func (m *MockParameterTypeRepository) CountParameters(ctx context.Context, code string) (int64, error) {
+	args := m.Called(ctx, code)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockParameterTypeRepository) GetParameterUsages(ctx context.Context, code string) ([]mod.ParameterUsage, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]mod.ParameterUsage), args.Error(1)
+}
+
+func TestCreateParameterType(t *testing.T) {
+	ctx := context.Background()
+	var created models.ParameterType
+
+	tests := []struct {
+		name          string
+		req           *dto.ReqCreateParameterType
+		setupMock     func(*MockParameterTypeRepository)
+		expectedError string
+		check         func(*testing.T)
+	}{
+		{
+			name: "success create enum type with deduplicated parent types",
+			req: &dto.ReqCreateParameterType{
+				Code:               "size",
+				Name:               "Size",
+				ValueKind:          constants.ParameterValueKindEnum,
+				EnumValues:         []string{" S ", "M", "L"},
+				AllowedParentTypes: []string{"topic", "topic", "size"},
+			},
+			setupMock: func(m *MockParameterTypeRepository) {
+				m.On("GetByCode", ctx, "size").Return(nil, gorm.ErrRecordNotFound).Once()
+				m.On("GetByCodes", ctx, []string{"topic"}).Return([]models.ParameterType{{Code: "topic"}}, nil).Once()
+				m.On("Create", ctx, mock.MatchedBy(func(parameterType models.ParameterType) bool {
+					created = parameterType
+					return true
+				})).Return(&models.ParameterType{Code: "size"}, nil).Once()
+			},
+			check: func(t *testing.T) {
+				res := created
+				assert.Equal(t, []string{"S", "M", "L"}, res.EnumValues.Strings)
+				assert.Equal(t, []string{"topic", "size"}, res.AllowedParentTypes.Strings)
+				assert.Equal(t, "test-auth-id", res.CreatedBy)
+			},
+		},
+		{
+			name: "error when code already exists",
+			req:  &dto.ReqCreateParameterType{Code: "topic", Name: "Topic", ValueKind: constants.ParameterValueKindString},
+			setupMock: func(m *MockParameterTypeRepository) {
+				m.On("GetByCode", ctx, "topic").Return(&models.ParameterType{Code: "topic"}, nil).Once()
+			},
+			expectedError: fmt.Sprintf(constants.ParameterTypeCodeAlreadyExists, "topic"),
+		},
+		{
+			name: "error when enum type has no values",
+			req:  &dto.ReqCreateParameterType{Code: "size", Name: "Size", ValueKind: constants.ParameterValueKindEnum},
+			setupMock: func(m *MockParameterTypeRepository) {
+				m.On("GetByCode", ctx, "size").Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: constants.ParameterTypeEnumValuesRequired,
+		},
+		{
+			name: "error when enum values are given to another kind",
+			req:  &dto.ReqCreateParameterType{Code: "size", Name: "Size", ValueKind: constants.ParameterValueKindInt, EnumValues: []string{"1"}},
+			setupMock: func(m *MockParameterTypeRepository) {
+				m.On("GetByCode", ctx, "size").Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: constants.ParameterTypeEnumValuesNotAllowed,
+		},
+		{
+			name: "error when enum value is duplicated",
+			req:  &dto.ReqCreateParameterType{Code: "size", Name: "Size", ValueKind: constants.ParameterValueKindEnum, EnumValues: []string{"S", "s"}},
+			setupMock: func(m *MockParameterTypeRepository) {
+				m.On("GetByCode", ctx, "size").Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: fmt.Sprintf(constants.ParameterTypeEnumValueDuplicated, "s"),
+		},
+		{
+			name: "error when json schema uses an unsupported keyword",
+			req: &dto.ReqCreateParameterType{
+				Code:       "address",
+				Name:       "Address",
+				ValueKind:  constants.ParameterValueKindJSON,
+				JSONSchema: json.RawMessage(`{"type":"object","oneOf":[]}`),
+			},
+			setupMock: func(m *MockParameterTypeRepository) {
+				m.On("GetByCode", ctx, "address").Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: fmt.Sprintf(constants.ParameterTypeSchemaInvalid, fmt.Sprintf(constants.JSONSchemaKeywordNotSupported, "oneOf")),
+		},
+		{
+			name: "error when parent type is not registered",
+			req:  &dto.ReqCreateParameterType{Code: "city", Name: "City", ValueKind: constants.ParameterValueKindString, AllowedParentTypes: []string{"province"}},
+			setupMock: func(m *MockParameterTypeRepository) {
+				m.On("GetByCode", ctx, "city").Return(nil, gorm.ErrRecordNotFound).Once()
+				m.On("GetByCodes", ctx, []string{"province"}).Return([]models.ParameterType{}, nil).Once()
+			},
+			expectedError: fmt.Sprintf(constants.ParameterTypeParentTypeNotFound, "province"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockParameterTypeRepository)
+			tt.setupMock(mockRepo)
+
+			result, err := usecase.NewParameterTypeUsecase(mockRepo).Create(ctx, tt.req, "test-auth-id")
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+				if tt.check != nil {
+					tt.check(t)
+				}
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateParameterType(t *testing.T) {
+	ctx := context.Background()
+	topic := "topic"
+	lang := "lang"
+	value := "abc"
+	existing := &models.ParameterType{
+		Code:               "priority",
+		Name:               "Priority",
+		ValueKind:          constants.ParameterValueKindString,
+		AllowedParentTypes: utils.NullStringArray{Strings: []string{"topic"}, Valid: true},
+	}
+
+	tests := []struct {
+		name          string
+		code          string
+		req           *dto.ReqUpdateParameterType
+		setupMock     func(*MockParameterTypeRepository)
+		expectedError string
+	}{
+		{
+			name: "success update when every parameter still matches",
+			code: "priority",
+			req: &dto.ReqUpdateParameterType{
+				Name:               "Priority",
+				ValueKind:          constants.ParameterValueKindInt,
+				JSONSchema:         json.RawMessage(`{"minimum":1,"maximum":5}`),
+				AllowedParentTypes: []string{"topic"},
+			},
+			setupMock: func(m *MockParameterTypeRepository) {
+				three := "3"
+				m.On("GetByCode", ctx, "priority").Return(existing, nil).Once()
+				m.On("GetByCodes", ctx, []string{"topic"}).Return([]models.ParameterType{{Code: "topic"}}, nil).Once()
+				m.On("GetParameterUsages", ctx, "priority").Return([]mod.ParameterUsage{{Code: "P1", Value: &three, HasParent: true, ParentType: &topic}}, nil).Once()
+				m.On("Update", ctx, mock.AnythingOfType("models.ParameterType")).Return(&models.ParameterType{Code: "priority"}, nil).Once()
+			},
+		},
+		{
+			name: "error when a value no longer matches the value kind",
+			code: "priority",
+			req:  &dto.ReqUpdateParameterType{Name: "Priority", ValueKind: constants.ParameterValueKindInt, AllowedParentTypes: []string{"topic"}},
+			setupMock: func(m *MockParameterTypeRepository) {
+				m.On("GetByCode", ctx, "priority").Return(existing, nil).Once()
+				m.On("GetByCodes", ctx, []string{"topic"}).Return([]models.ParameterType{{Code: "topic"}}, nil).Once()
+				m.On("GetParameterUsages", ctx, "priority").Return([]mod.ParameterUsage{{Code: "P1", Value: &value}}, nil).Once()
+			},
+			expectedError: fmt.Sprintf(constants.ParameterTypeParameterInvalid, "P1", fmt.Sprintf(constants.ParameterValueInvalidInt, "priority")),
+		},
+		{
+			name: "error when a value no longer matches the schema",
+			code: "priority",
+			req: &dto.ReqUpdateParameterType{
+				Name:       "Priority",
+				ValueKind:  constants.ParameterValueKindString,
+				JSONSchema: json.RawMessage(`{"maxLength":2}`),
+			},
+			setupMock: func(m *MockParameterTypeRepository) {
+				m.On("GetByCode", ctx, "priority").Return(existing, nil).Once()
+				m.On("GetByCodes", ctx, []string{}).Return([]models.ParameterType{}, nil).Once()
+				m.On("GetParameterUsages", ctx, "priority").Return([]mod.ParameterUsage{{Code: "P1", Value: &value}}, nil).Once()
+			},
+			expectedError: fmt.Sprintf(constants.ParameterTypeParameterInvalid, "P1",
+				fmt.Sprintf(constants.ParameterValueSchemaFailed, "priority", fmt.Sprintf(constants.JSONSchemaMaxLength, "value", 2))),
+		},
+		{
+			name: "error when a parent type is no longer allowed",
+			code: "priority",
+			req:  &dto.ReqUpdateParameterType{Name: "Priority", ValueKind: constants.ParameterValueKindString},
+			setupMock: func(m *MockParameterTypeRepository) {
+				m.On("GetByCode", ctx, "priority").Return(existing, nil).Once()
+				m.On("GetByCodes", ctx, []string{}).Return([]models.ParameterType{}, nil).Once()
+				m.On("GetParameterUsages", ctx, "priority").Return([]mod.ParameterUsage{{Code: "P1", HasParent: true, ParentType: &lang}}, nil).Once()
+			},
+			expectedError: fmt.Sprintf(constants.ParameterTypeParameterInvalid, "P1",
+				fmt.Sprintf(constants.ParameterParentTypeNotAllowed, "priority", "lang")),
+		},
+		{
+			name: "error when type not found",
+			code: "unknown",
+			req:  &dto.ReqUpdateParameterType{Name: "Unknown", ValueKind: constants.ParameterValueKindString},
+			setupMock: func(m *MockParameterTypeRepository) {
+				m.On("GetByCode", ctx, "unknown").Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: fmt.Sprintf(constants.ParameterTypeNotFound, "unknown"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockParameterTypeRepository)
+			tt.setupMock(mockRepo)
+
+			result, err := usecase.NewParameterTypeUsecase(mockRepo).Update(ctx, tt.code, tt.req, "test-auth-id")
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteParameterType(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		setupMock     func(*MockParameterTypeRepository)
+		expectedError string
+	}{
+		{
+			name: "success delete unused type",
+			setupMock: func(m *MockParameterTypeRepository) {
+				m.On("GetByCode", ctx, "size").Return(&models.ParameterType{Code: "size"}, nil).Once()
+				m.On("CountParameters", ctx, "size").Return(int64(0), nil).Once()
+				m.On("Delete", ctx, "size").Return(nil).Once()
+			},
+		},
+		{
+			name: "error when type is used",
+			setupMock: func(m *MockParameterTypeRepository) {
+				m.On("GetByCode", ctx, "size").Return(&models.ParameterType{Code: "size"}, nil).Once()
+				m.On("CountParameters", ctx, "size").Return(int64(2), nil).Once()
+			},
+			expectedError: fmt.Sprintf(constants.ParameterTypeInUse, "size", 2),
+		},
+		{
+			name: "error when repository delete fails",
+			setupMock: func(m *MockParameterTypeRepository) {
+				m.On("GetByCode", ctx, "size").Return(&models.ParameterType{Code: "size"}, nil).Once()
+				m.On("CountParameters", ctx, "size").Return(int64(0), nil).Once()
+				m.On("Delete", ctx, "size").Return(errors.New("delete failed")).Once()
+			},
+			expectedError: "delete failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockParameterTypeRepository)
+			tt.setupMock(mockRepo)
+
+			err := usecase.NewParameterTypeUsecase(mockRepo).Delete(ctx, "size", "test-auth-id")
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
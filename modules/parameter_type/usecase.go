@@ -0,0 +1,16 @@
+package parameter_type
+
+import (
+	"context"
+
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/parameter_type/dto"
+)
+
+type Usecase interface {
+	GetAll(ctx context.Context) ([]models.ParameterType, error)
+	GetByCode(ctx context.Context, code string) (*models.ParameterType, error)
+	Create(ctx context.Context, req *dto.ReqCreateParameterType, authId string) (*models.ParameterType, error)
+	Update(ctx context.Context, code string, req *dto.ReqUpdateParameterType, authId string) (*models.ParameterType, error)
+	Delete(ctx context.Context, code string, authId string) error
+}
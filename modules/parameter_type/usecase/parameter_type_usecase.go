@@ -0,0 +1,258 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/paramvalue"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/parameter_type"
+	"github.com/rendyfutsuy/base-go/modules/parameter_type/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"gorm.io/gorm"
+)
+
+type parameterTypeUsecase struct {
+	repo mod.Repository
+}
+
+func NewParameterTypeUsecase(repo mod.Repository) mod.Usecase {
+	return &parameterTypeUsecase{repo: repo}
+}
+
+// typeDefinition is the part of a parameter type given by the create and update requests
+type typeDefinition struct {
+	Name               string
+	ValueKind          string
+	ValueRequired      bool
+	EnumValues         []string
+	JSONSchema         json.RawMessage
+	AllowedParentTypes []string
+	Description        *string
+}
+
+func (u *parameterTypeUsecase) GetAll(ctx context.Context) ([]models.ParameterType, error) {
+	return u.repo.GetAll(ctx)
+}
+
+func (u *parameterTypeUsecase) GetByCode(ctx context.Context, code string) (*models.ParameterType, error) {
+	res, err := u.repo.GetByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.ParameterTypeNotFound, code)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *parameterTypeUsecase) Create(ctx context.Context, req *dto.ReqCreateParameterType, authId string) (*models.ParameterType, error) {
+	code := strings.TrimSpace(req.Code)
+	_, err := u.repo.GetByCode(ctx, code)
+	if err == nil {
+		return nil, fmt.Errorf(constants.ParameterTypeCodeAlreadyExists, code)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	parameterType, err := u.buildParameterType(ctx, code, typeDefinition{
+		Name:               req.Name,
+		ValueKind:          req.ValueKind,
+		ValueRequired:      req.ValueRequired,
+		EnumValues:         req.EnumValues,
+		JSONSchema:         req.JSONSchema,
+		AllowedParentTypes: req.AllowedParentTypes,
+		Description:        req.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	parameterType.CreatedAt = now
+	parameterType.CreatedBy = authId
+	parameterType.UpdatedAt = now
+	parameterType.UpdatedBy = authId
+
+	return u.repo.Create(ctx, *parameterType)
+}
+
+// Update changes a type after checking that every parameter of the type still matches it,
+// the stored values are not rewritten
+func (u *parameterTypeUsecase) Update(ctx context.Context, code string, req *dto.ReqUpdateParameterType, authId string) (*models.ParameterType, error) {
+	existing, err := u.GetByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	parameterType, err := u.buildParameterType(ctx, existing.Code, typeDefinition{
+		Name:               req.Name,
+		ValueKind:          req.ValueKind,
+		ValueRequired:      req.ValueRequired,
+		EnumValues:         req.EnumValues,
+		JSONSchema:         req.JSONSchema,
+		AllowedParentTypes: req.AllowedParentTypes,
+		Description:        req.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usages, err := u.repo.GetParameterUsages(ctx, existing.Code)
+	if err != nil {
+		return nil, err
+	}
+	for _, usage := range usages {
+		if _, err := paramvalue.Normalize(*parameterType, usage.Value); err != nil {
+			return nil, fmt.Errorf(constants.ParameterTypeParameterInvalid, usage.Code, err)
+		}
+		if usage.HasParent && !paramvalue.ParentAllowed(*parameterType, usage.ParentType) {
+			return nil, fmt.Errorf(constants.ParameterTypeParameterInvalid, usage.Code,
+				fmt.Errorf(constants.ParameterParentTypeNotAllowed, existing.Code, typeName(usage.ParentType)))
+		}
+	}
+
+	parameterType.UpdatedAt = time.Now().UTC()
+	parameterType.UpdatedBy = authId
+	res, err := u.repo.Update(ctx, *parameterType)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.ParameterTypeNotFound, code)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// Delete removes a type no parameter uses
+func (u *parameterTypeUsecase) Delete(ctx context.Context, code string, authId string) error {
+	existing, err := u.GetByCode(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	count, err := u.repo.CountParameters(ctx, existing.Code)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf(constants.ParameterTypeInUse, existing.Code, count)
+	}
+
+	if err := u.repo.Delete(ctx, existing.Code); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf(constants.ParameterTypeNotFound, code)
+		}
+		return err
+	}
+	return nil
+}
+
+// buildParameterType checks a type definition: enum values only for enum types, a schema that compiles
+// and registered parent types (the type itself may be its own parent, e.g. topics under a topic)
+func (u *parameterTypeUsecase) buildParameterType(ctx context.Context, code string, def typeDefinition) (*models.ParameterType, error) {
+	if !paramvalue.ValidKind(def.ValueKind) {
+		return nil, errors.New(constants.ParameterTypeValueKindInvalid)
+	}
+
+	parameterType := &models.ParameterType{
+		Code:          code,
+		Name:          strings.TrimSpace(def.Name),
+		ValueKind:     def.ValueKind,
+		ValueRequired: def.ValueRequired,
+		Description:   def.Description,
+	}
+
+	enumValues, err := uniqueValues(def.EnumValues)
+	if err != nil {
+		return nil, err
+	}
+	if def.ValueKind == constants.ParameterValueKindEnum {
+		if len(enumValues) == 0 {
+			return nil, errors.New(constants.ParameterTypeEnumValuesRequired)
+		}
+		parameterType.EnumValues = utils.NullStringArray{Strings: enumValues, Valid: true}
+	} else if len(enumValues) > 0 {
+		return nil, errors.New(constants.ParameterTypeEnumValuesNotAllowed)
+	}
+
+	if len(def.JSONSchema) > 0 && string(def.JSONSchema) != "null" {
+		parameterType.JSONSchema = utils.NullJSONRawMessage{JsonRawMessage: def.JSONSchema, Valid: true}
+		if _, err := paramvalue.CompileSchema(*parameterType); err != nil {
+			return nil, fmt.Errorf(constants.ParameterTypeSchemaInvalid, err)
+		}
+	}
+
+	parentTypes, err := u.resolveParentTypes(ctx, code, def.AllowedParentTypes)
+	if err != nil {
+		return nil, err
+	}
+	parameterType.AllowedParentTypes = utils.NullStringArray{Strings: parentTypes, Valid: true}
+
+	return parameterType, nil
+}
+
+// resolveParentTypes removes duplicated parent types and checks that they are registered
+func (u *parameterTypeUsecase) resolveParentTypes(ctx context.Context, code string, parentTypes []string) ([]string, error) {
+	res := make([]string, 0, len(parentTypes))
+	lookup := make([]string, 0, len(parentTypes))
+	seen := make(map[string]bool, len(parentTypes))
+	for _, parentType := range parentTypes {
+		parentType = strings.TrimSpace(parentType)
+		if parentType == "" || seen[parentType] {
+			continue
+		}
+		seen[parentType] = true
+		res = append(res, parentType)
+		if parentType != code {
+			lookup = append(lookup, parentType)
+		}
+	}
+
+	registered, err := u.repo.GetByCodes(ctx, lookup)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]bool, len(registered))
+	for _, parameterType := range registered {
+		found[parameterType.Code] = true
+	}
+	for _, parentType := range lookup {
+		if !found[parentType] {
+			return nil, fmt.Errorf(constants.ParameterTypeParentTypeNotFound, parentType)
+		}
+	}
+	return res, nil
+}
+
+// uniqueValues trims the enum values and rejects duplicates
+func uniqueValues(values []string) ([]string, error) {
+	res := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		key := strings.ToLower(value)
+		if seen[key] {
+			return nil, fmt.Errorf(constants.ParameterTypeEnumValueDuplicated, value)
+		}
+		seen[key] = true
+		res = append(res, value)
+	}
+	return res, nil
+}
+
+// typeName returns the type for an error message, "none" for a parameter without type
+func typeName(parameterType *string) string {
+	if parameterType == nil || *parameterType == "" {
+		return "none"
+	}
+	return *parameterType
+}
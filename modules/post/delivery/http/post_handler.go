@@ -34,18 +34,45 @@ func NewPostHandler(e *echo.Echo, uc post.Usecase, mwP _reqContext.IMiddlewarePa
 	r := e.Group("/v1/post")
 	r.Use(h.middlewareAuth.AuthorizationCheck)
 
+	// Permissions
+	// Create:  post.create (authors, also lists their own posts)
+	// Update:  post.update (authors, also submits their drafts for review and browses, diffs and restores revisions)
+	// Delete:  post.delete
+	// Publish: post.publish (editors: list every post, reject, publish, schedule, unpublish and archive; update and restore any post in place)
 	permissionToCreate := []string{"post.create"}
 	permissionToUpdate := []string{"post.update"}
 	permissionToDelete := []string{"post.delete"}
+	permissionToPublish := []string{"post.publish"}
+
+	// Posts of the author in every status - must be before /:id to avoid route conflict
+	r.GET("/mine", h.GetMyIndex, middleware.RequireActivatedUser, h.mwPageRequest.PageRequestCtx, h.middlewarePermission.PermissionValidation(permissionToCreate))
+	r.GET("/mine/:id", h.GetMyByID, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToCreate))
+
+	// Every post in every status, for editors
+	r.GET("/manage", h.GetManageIndex, middleware.RequireActivatedUser, h.mwPageRequest.PageRequestCtx, h.middlewarePermission.PermissionValidation(permissionToPublish))
+	r.GET("/manage/:id", h.GetManageByID, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToPublish))
 
 	r.POST("", h.Create, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToCreate))
 	r.PUT("/:id", h.Update, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
 	r.DELETE("/:id", h.Delete, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToDelete))
+
+	// Publishing workflow: the author submits, the editor rejects, publishes (or schedules), unpublishes and archives
+	r.POST("/:id/submit", h.Submit, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.POST("/:id/reject", h.Reject, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToPublish))
+	r.POST("/:id/publish", h.Publish, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToPublish))
+	r.POST("/:id/unpublish", h.Unpublish, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToPublish))
+	r.POST("/:id/archive", h.Archive, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToPublish))
+
+	// Revisions - diff must be before /:revisionId to avoid route conflict
+	r.GET("/:id/revisions", h.GetRevisionIndex, middleware.RequireActivatedUser, h.mwPageRequest.PageRequestCtx, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.GET("/:id/revisions/diff", h.DiffRevisions, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.GET("/:id/revisions/:revisionId", h.GetRevisionByID, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.POST("/:id/revisions/:revisionId/restore", h.RestoreRevision, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
 }
 
 // Create Post
 // @Summary      Create post
-// @Description  Create a post with optional thumbnail upload
+// @Description  Create a post with optional thumbnail upload, the post starts as a draft
 // @Tags         Post
 // @Accept       json
 // @Accept       multipart/form-data
@@ -101,7 +128,7 @@ func (h *PostHandler) Create(c echo.Context) error {
 
 // Update Post
 // @Summary      Update post
-// @Description  Update a post with optional thumbnail upload
+// @Description  Update a post with optional thumbnail upload. Authors update their own posts only, a published or in review post goes back to draft; editors ('post.publish' permission) update any post.
 // @Tags         Post
 // @Accept       json
 // @Accept       multipart/form-data
@@ -147,7 +174,7 @@ func (h *PostHandler) Update(c echo.Context) error {
 			userID = u.ID.String()
 		}
 	}
-	res, err := h.Usecase.Update(ctx, id, req, userID, thumbnailData, thumbnailName)
+	res, err := h.Usecase.Update(ctx, id, req, userID, isEditor(c), thumbnailData, thumbnailName)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
 	}
@@ -158,7 +185,7 @@ func (h *PostHandler) Update(c echo.Context) error {
 
 // Delete Post
 // @Summary      Delete post
-// @Description  Delete a post by ID
+// @Description  Soft delete a post by ID, the post can be restored from the recycle bin
 // @Tags         Post
 // @Produce      json
 // @Security     BearerAuth
@@ -169,7 +196,14 @@ func (h *PostHandler) Update(c echo.Context) error {
 func (h *PostHandler) Delete(c echo.Context) error {
 	ctx := c.Request().Context()
 	id := c.Param("id")
-	if err := h.Usecase.Delete(ctx, id, ""); err != nil {
+
+	var userID string
+	if user := c.Get("user"); user != nil {
+		if u, ok := user.(models.User); ok {
+			userID = u.ID.String()
+		}
+	}
+	if err := h.Usecase.Delete(ctx, id, userID); err != nil {
 		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
 	}
 	resp := response.NonPaginationResponse{}
@@ -181,7 +215,7 @@ func (h *PostHandler) Delete(c echo.Context) error {
 
 // Get Posts
 // @Summary      Get paginated list of posts
-// @Description  Retrieve a paginated list of posts with optional filtering
+// @Description  Retrieve a paginated list of published posts with optional filtering
 // @Tags         Post
 // @Accept       json
 // @Produce      json
@@ -224,7 +258,7 @@ func (h *PostHandler) GetIndex(c echo.Context) error {
 
 // Get Post By ID
 // @Summary      Get post by ID
-// @Description  Retrieve post detail and its parameters
+// @Description  Retrieve the detail of a published post and its parameters
 // @Tags         Post
 // @Produce      json
 // @Param        id   path string true "Post ID"
@@ -0,0 +1,124 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/modules/post/dto"
+)
+
+// Get Post Revisions
+// @Summary      Get paginated list of post revisions
+// @Description  Retrieve the revisions of a post, newest first. A revision is recorded on every create, update and restore. Authors see the revisions of their own posts only, editors ('post.publish' permission) those of any post.
+// @Tags         Post
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id          path    string  true  "Post ID"
+// @Param        page        query   int     false "Page number"     default(1)
+// @Param        per_page    query   int     false "Items per page"  default(10)
+// @Param        sort_by     query   string  false "Sort by revision_number, action or created_at"
+// @Param        sort_order  query   string  false "Sort order (asc or desc)"
+// @Success      200         {object} response.PaginationResponse{data=[]dto.RespPostRevisionIndex}
+// @Failure      400         {object} response.NonPaginationResponse
+// @Router       /v1/post/{id}/revisions [get]
+func (h *PostHandler) GetRevisionIndex(c echo.Context) error {
+	pageRequest := c.Get("page_request").(*request.PageRequest)
+
+	res, total, err := h.Usecase.GetRevisionIndex(c.Request().Context(), c.Param("id"), *pageRequest, authUserID(c), isEditor(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	respRevisions := make([]dto.RespPostRevisionIndex, 0, len(res))
+	for _, v := range res {
+		respRevisions = append(respRevisions, dto.ToRespPostRevisionIndex(v))
+	}
+	respPag := response.PaginationResponse{}
+	respPag, err = respPag.SetResponse(respRevisions, total, pageRequest.PerPage, pageRequest.Page)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(http.StatusOK, respPag)
+}
+
+// Get Post Revision By ID
+// @Summary      Get post revision by ID
+// @Description  Retrieve the full snapshot of a revision: content, lang, topics and thumbnail, of an own post for authors
+// @Tags         Post
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id          path string true "Post ID"
+// @Param        revisionId  path string true "Revision ID"
+// @Success      200  {object} response.NonPaginationResponse{data=dto.RespPostRevision}
+// @Failure      400  {object} response.NonPaginationResponse
+// @Router       /v1/post/{id}/revisions/{revisionId} [get]
+func (h *PostHandler) GetRevisionByID(c echo.Context) error {
+	res, err := h.Usecase.GetRevisionByID(c.Request().Context(), c.Param("id"), c.Param("revisionId"), authUserID(c), isEditor(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespPostRevision(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Diff Post Revisions
+// @Summary      Diff two post revisions
+// @Description  List the fields whose value differs between the snapshots of two revisions of the post, of an own post for authors
+// @Tags         Post
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path   string true "Post ID"
+// @Param        from  query  string true "Revision ID to compare from"
+// @Param        to    query  string true "Revision ID to compare to"
+// @Success      200  {object} response.NonPaginationResponse{data=dto.RespPostRevisionDiff}
+// @Failure      400  {object} response.NonPaginationResponse
+// @Router       /v1/post/{id}/revisions/diff [get]
+func (h *PostHandler) DiffRevisions(c echo.Context) error {
+	req := new(dto.ReqPostRevisionDiff)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := h.Usecase.DiffRevisions(c.Request().Context(), c.Param("id"), *req, authUserID(c), isEditor(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Restore Post Revision
+// @Summary      Restore post revision
+// @Description  Roll the post back to the snapshot of a revision (content, lang, topics and thumbnail), recorded as a new revision. Authors restore their own posts only, a published or in review post goes back to draft; editors ('post.publish' permission) restore any post.
+// @Tags         Post
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id          path string true "Post ID"
+// @Param        revisionId  path string true "Revision ID"
+// @Success      200  {object} response.NonPaginationResponse{data=dto.RespPost}
+// @Failure      400  {object} response.NonPaginationResponse
+// @Router       /v1/post/{id}/revisions/{revisionId}/restore [post]
+func (h *PostHandler) RestoreRevision(c echo.Context) error {
+	ctx := c.Request().Context()
+	id := c.Param("id")
+	res, err := h.Usecase.RestoreRevision(ctx, id, c.Param("revisionId"), authUserID(c), isEditor(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	_, lang, topics, err := h.Usecase.GetParameterReferences(ctx, id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	out := dto.ToRespPost(*res)
+	out.Lang = lang
+	out.Topics = topics
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(out)
+	return c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,227 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/helpers/middleware"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/post/dto"
+)
+
+// Get My Posts
+// @Summary      Get paginated list of my posts
+// @Description  Retrieve the posts of the authenticated author in every status (draft, review, published, archived)
+// @Tags         Post
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page        query   int                     false "Page number"     default(1)
+// @Param        per_page    query   int                     false "Items per page"  default(10)
+// @Param        search      query   string                  false "Search query"
+// @Param        statuses    query   []string                false "Filter by statuses (array)"
+// @Param        filter      query   dto.ReqPostIndexFilter  false "Filter options"
+// @Success      200         {object} response.PaginationResponse{data=[]dto.RespPostIndex}
+// @Failure      400         {object} response.NonPaginationResponse
+// @Router       /v1/post/mine [get]
+func (h *PostHandler) GetMyIndex(c echo.Context) error {
+	return h.managedIndex(c, authUserID(c))
+}
+
+// Get My Post By ID
+// @Summary      Get my post by ID
+// @Description  Retrieve the detail of a post of the authenticated author in any status
+// @Tags         Post
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path string true "Post ID"
+// @Success      200  {object} response.NonPaginationResponse{data=dto.RespPost}
+// @Failure      400  {object} response.NonPaginationResponse
+// @Router       /v1/post/mine/{id} [get]
+func (h *PostHandler) GetMyByID(c echo.Context) error {
+	return h.managedDetail(c, authUserID(c))
+}
+
+// Get Managed Posts
+// @Summary      Get paginated list of every post
+// @Description  Retrieve the posts of every author in every status, e.g. statuses=review for the review queue. Requires 'post.publish' permission.
+// @Tags         Post
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page        query   int                     false "Page number"     default(1)
+// @Param        per_page    query   int                     false "Items per page"  default(10)
+// @Param        search      query   string                  false "Search query"
+// @Param        statuses    query   []string                false "Filter by statuses (array)"
+// @Param        filter      query   dto.ReqPostIndexFilter  false "Filter options"
+// @Success      200         {object} response.PaginationResponse{data=[]dto.RespPostIndex}
+// @Failure      400         {object} response.NonPaginationResponse
+// @Router       /v1/post/manage [get]
+func (h *PostHandler) GetManageIndex(c echo.Context) error {
+	return h.managedIndex(c, "")
+}
+
+// Get Managed Post By ID
+// @Summary      Get any post by ID
+// @Description  Retrieve the detail of a post in any status. Requires 'post.publish' permission.
+// @Tags         Post
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path string true "Post ID"
+// @Success      200  {object} response.NonPaginationResponse{data=dto.RespPost}
+// @Failure      400  {object} response.NonPaginationResponse
+// @Router       /v1/post/manage/{id} [get]
+func (h *PostHandler) GetManageByID(c echo.Context) error {
+	return h.managedDetail(c, "")
+}
+
+// Submit Post
+// @Summary      Submit post for review
+// @Description  Move a draft to review, only the author of the post can submit it
+// @Tags         Post
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path string true "Post ID"
+// @Success      200  {object} response.NonPaginationResponse{data=dto.RespPost}
+// @Failure      400  {object} response.NonPaginationResponse
+// @Router       /v1/post/{id}/submit [post]
+func (h *PostHandler) Submit(c echo.Context) error {
+	res, err := h.Usecase.Submit(c.Request().Context(), c.Param("id"), authUserID(c))
+	return h.transitionResponse(c, res, err)
+}
+
+// Reject Post
+// @Summary      Reject post
+// @Description  Send a post in review back to draft, cancelling its schedule. Requires 'post.publish' permission.
+// @Tags         Post
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path string true "Post ID"
+// @Success      200  {object} response.NonPaginationResponse{data=dto.RespPost}
+// @Failure      400  {object} response.NonPaginationResponse
+// @Router       /v1/post/{id}/reject [post]
+func (h *PostHandler) Reject(c echo.Context) error {
+	res, err := h.Usecase.Reject(c.Request().Context(), c.Param("id"), authUserID(c))
+	return h.transitionResponse(c, res, err)
+}
+
+// Publish Post
+// @Summary      Publish or schedule post
+// @Description  Publish a draft or a post in review now. With publish_at in the future the post stays in review and is published by the scheduler at that time. Requires 'post.publish' permission.
+// @Tags         Post
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path string              true  "Post ID"
+// @Param        request  body dto.ReqPublishPost  false "Publication time, now when empty"
+// @Success      200  {object} response.NonPaginationResponse{data=dto.RespPost}
+// @Failure      400  {object} response.NonPaginationResponse
+// @Router       /v1/post/{id}/publish [post]
+func (h *PostHandler) Publish(c echo.Context) error {
+	req := new(dto.ReqPublishPost)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	res, err := h.Usecase.Publish(c.Request().Context(), c.Param("id"), req, authUserID(c))
+	return h.transitionResponse(c, res, err)
+}
+
+// Unpublish Post
+// @Summary      Unpublish post
+// @Description  Take a published or archived post back to draft. Requires 'post.publish' permission.
+// @Tags         Post
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path string true "Post ID"
+// @Success      200  {object} response.NonPaginationResponse{data=dto.RespPost}
+// @Failure      400  {object} response.NonPaginationResponse
+// @Router       /v1/post/{id}/unpublish [post]
+func (h *PostHandler) Unpublish(c echo.Context) error {
+	res, err := h.Usecase.Unpublish(c.Request().Context(), c.Param("id"), authUserID(c))
+	return h.transitionResponse(c, res, err)
+}
+
+// Archive Post
+// @Summary      Archive post
+// @Description  Remove a post from the public index without deleting it, cancelling its schedule. Requires 'post.publish' permission.
+// @Tags         Post
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path string true "Post ID"
+// @Success      200  {object} response.NonPaginationResponse{data=dto.RespPost}
+// @Failure      400  {object} response.NonPaginationResponse
+// @Router       /v1/post/{id}/archive [post]
+func (h *PostHandler) Archive(c echo.Context) error {
+	res, err := h.Usecase.Archive(c.Request().Context(), c.Param("id"), authUserID(c))
+	return h.transitionResponse(c, res, err)
+}
+
+// managedIndex lists the posts in every status, of one author when authorID is set
+func (h *PostHandler) managedIndex(c echo.Context, authorID string) error {
+	ctx := c.Request().Context()
+	pageRequest := c.Get("page_request").(*request.PageRequest)
+
+	filter := new(dto.ReqPostIndexFilter)
+	if err := c.Bind(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, total, err := h.Usecase.GetManagedIndex(ctx, *pageRequest, *filter, authorID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	respPosts := make([]dto.RespPostIndex, 0, len(res))
+	for _, v := range res {
+		respPosts = append(respPosts, dto.ToRespPostIndex(v))
+	}
+	respPag := response.PaginationResponse{}
+	respPag, err = respPag.SetResponse(respPosts, total, pageRequest.PerPage, pageRequest.Page)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	return c.JSON(http.StatusOK, respPag)
+}
+
+// managedDetail returns a post in any status with its parameters, of one author when authorID is set
+func (h *PostHandler) managedDetail(c echo.Context, authorID string) error {
+	ctx := c.Request().Context()
+	id := c.Param("id")
+	res, err := h.Usecase.GetManagedByID(ctx, id, authorID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	_, lang, topics, err := h.Usecase.GetParameterReferences(ctx, id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	out := dto.ToRespPost(*res)
+	out.Lang = lang
+	out.Topics = topics
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(out)
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *PostHandler) transitionResponse(c echo.Context, res *models.Post, err error) error {
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespPost(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+func authUserID(c echo.Context) string {
+	if userModel, ok := c.Get("user").(models.User); ok {
+		return userModel.ID.String()
+	}
+	return ""
+}
+
+// isEditor reports whether the user may publish, editors change any post without sending it back to draft
+func isEditor(c echo.Context) bool {
+	return middleware.HasPermission(c, "post.publish")
+}
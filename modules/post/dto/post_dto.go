@@ -28,11 +28,14 @@ type ReqUpdatePost struct {
 }
 
 type RespPostIndex struct {
-	ID               uuid.UUID `json:"id"`
-	Title            string    `json:"title"`
-	ShortDescription string    `json:"short_description"`
-	ThumbnailURL     *string   `json:"thumbnail_url"`
-	CreatedAt        time.Time `json:"created_at"`
+	ID               uuid.UUID  `json:"id"`
+	Title            string     `json:"title"`
+	ShortDescription string     `json:"short_description"`
+	ThumbnailURL     *string    `json:"thumbnail_url"`
+	Status           string     `json:"status"`
+	PublishAt        *time.Time `json:"publish_at"`
+	PublishedAt      *time.Time `json:"published_at"`
+	CreatedAt        time.Time  `json:"created_at"`
 }
 
 type ToDBPost struct {
@@ -51,6 +54,9 @@ func ToRespPostIndex(m models.Post) RespPostIndex {
 		Title:            m.Title,
 		ShortDescription: m.ShortDescription,
 		ThumbnailURL:     m.ThumbnailURL,
+		Status:           m.Status,
+		PublishAt:        m.PublishAt,
+		PublishedAt:      m.PublishedAt,
 		CreatedAt:        m.CreatedAt,
 	}
 }
@@ -68,6 +74,10 @@ type RespPost struct {
 	Lang             *ReferenceObject  `json:"lang"`
 	Topics           []ReferenceObject `json:"topics"`
 	ThumbnailURL     *string           `json:"thumbnail_url"`
+	Status           string            `json:"status"`
+	PublishAt        *time.Time        `json:"publish_at"`
+	PublishedAt      *time.Time        `json:"published_at"`
+	CreatedBy        uuid.UUID         `json:"created_by"`
 	CreatedAt        time.Time         `json:"created_at"`
 	UpdatedAt        time.Time         `json:"updated_at"`
 }
@@ -84,6 +94,10 @@ func ToRespPost(m models.Post) RespPost {
 		Description:      m.Description,
 		ShortDescription: m.ShortDescription,
 		ThumbnailURL:     &presignedURL,
+		Status:           m.Status,
+		PublishAt:        m.PublishAt,
+		PublishedAt:      m.PublishedAt,
+		CreatedBy:        m.CreatedBy,
 		CreatedAt:        m.CreatedAt,
 		UpdatedAt:        m.UpdatedAt,
 	}
@@ -93,6 +107,32 @@ type ReqPostIndexFilter struct {
 	Search    string      `query:"search" json:"search"`
 	TopicIDs  []uuid.UUID `query:"topic_ids" json:"topic_ids"`
 	LangIDs   []uuid.UUID `query:"lang_ids" json:"lang_ids"`
+	Statuses  []string    `query:"statuses" json:"statuses"` // ignored by the public index, which lists published posts only
 	SortBy    string      `query:"sort_by" json:"sort_by"`
 	SortOrder string      `query:"sort_order" json:"sort_order"`
 }
+
+// PostVisibility restricts the posts listed: the public sees published posts only,
+// an author sees its own posts in every status and an editor sees every post
+type PostVisibility struct {
+	PublishedOnly bool
+	AuthorID      uuid.UUID // uuid.Nil for every author
+}
+
+// ReqPublishPost publishes a post now, or schedules it when publish_at is in the future
+type ReqPublishPost struct {
+	PublishAt *time.Time `json:"publish_at"`
+}
+
+// ToDBPostStatus is the workflow state written on a transition
+type ToDBPostStatus struct {
+	Status      string
+	PublishAt   *time.Time
+	PublishedAt *time.Time
+	PublishedBy *uuid.UUID
+}
+
+// RespPublishScheduledPosts is the result of a run of the publish scheduler
+type RespPublishScheduledPosts struct {
+	Published int64 `json:"published"`
+}
@@ -0,0 +1,89 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/models"
+	utilsServices "github.com/rendyfutsuy/base-go/utils/services"
+)
+
+// ToDBPostRevision describes who took a snapshot of the post and why
+type ToDBPostRevision struct {
+	Action       string
+	RestoredFrom *uuid.UUID
+	EditedBy     *uuid.UUID
+}
+
+// ReqPostRevisionDiff compares the snapshot of revision from with the snapshot of revision to
+type ReqPostRevisionDiff struct {
+	From string `query:"from" json:"from" validate:"required,uuid"`
+	To   string `query:"to" json:"to" validate:"required,uuid"`
+}
+
+type RespPostRevisionIndex struct {
+	ID             uuid.UUID  `json:"id"`
+	RevisionNumber int        `json:"revision_number"`
+	Action         string     `json:"action"`
+	RestoredFrom   *uuid.UUID `json:"restored_from"`
+	Title          string     `json:"title"`
+	EditedBy       *uuid.UUID `json:"edited_by"`
+	EditedByName   *string    `json:"edited_by_name"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+func ToRespPostRevisionIndex(m models.PostRevision) RespPostRevisionIndex {
+	return RespPostRevisionIndex{
+		ID:             m.ID,
+		RevisionNumber: m.RevisionNumber,
+		Action:         m.Action,
+		RestoredFrom:   m.RestoredFrom,
+		Title:          m.Title,
+		EditedBy:       m.EditedBy,
+		EditedByName:   m.EditedByName,
+		CreatedAt:      m.CreatedAt,
+	}
+}
+
+type RespPostRevision struct {
+	RespPostRevisionIndex
+	Description      string     `json:"description"`
+	ShortDescription string     `json:"short_description"`
+	LangID           *uuid.UUID `json:"lang_id"`
+	TopicIDs         []string   `json:"topic_ids"`
+	ThumbnailURL     *string    `json:"thumbnail_url"`
+}
+
+func ToRespPostRevision(m models.PostRevision) RespPostRevision {
+	var thumbnailURL *string
+	if m.ThumbnailURL != nil {
+		presignedURL, _ := utilsServices.GeneratePresignedURL(*m.ThumbnailURL)
+		thumbnailURL = &presignedURL
+	}
+	topicIDs := m.TopicIDs.Strings
+	if topicIDs == nil {
+		topicIDs = []string{}
+	}
+
+	return RespPostRevision{
+		RespPostRevisionIndex: ToRespPostRevisionIndex(m),
+		Description:           m.Description,
+		ShortDescription:      m.ShortDescription,
+		LangID:                m.LangID,
+		TopicIDs:              topicIDs,
+		ThumbnailURL:          thumbnailURL,
+	}
+}
+
+// RespPostRevisionChange is a field whose value differs between the two revisions
+type RespPostRevisionChange struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+type RespPostRevisionDiff struct {
+	From    RespPostRevisionIndex    `json:"from"`
+	To      RespPostRevisionIndex    `json:"to"`
+	Changes []RespPostRevisionChange `json:"changes"`
+}
@@ -2,6 +2,7 @@ package post
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rendyfutsuy/base-go/helpers/request"
@@ -12,8 +13,15 @@ import (
 type Repository interface {
 	Create(ctx context.Context, createdBy uuid.UUID, data dto.ToDBPost) (*models.Post, error)
 	Update(ctx context.Context, id uuid.UUID, data dto.ToDBPost) (*models.Post, error)
-	Delete(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID, deletedBy string) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Post, error)
-	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqPostIndexFilter) ([]models.Post, int, error)
-	GetAll(ctx context.Context, filter dto.ReqPostIndexFilter) ([]models.Post, error)
+	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqPostIndexFilter, visibility dto.PostVisibility) ([]models.Post, int, error)
+	GetAll(ctx context.Context, filter dto.ReqPostIndexFilter, visibility dto.PostVisibility) ([]models.Post, error)
+	Transition(ctx context.Context, id uuid.UUID, from []string, data dto.ToDBPostStatus) (*models.Post, error)
+	PublishScheduled(ctx context.Context, now time.Time) (int64, error)
+
+	// Revisions
+	CreateRevision(ctx context.Context, postID uuid.UUID, data dto.ToDBPostRevision) (*models.PostRevision, error)
+	GetRevisionByID(ctx context.Context, postID uuid.UUID, revisionID uuid.UUID) (*models.PostRevision, error)
+	GetRevisionIndex(ctx context.Context, postID uuid.UUID, req request.PageRequest) ([]models.PostRevision, int, error)
 }
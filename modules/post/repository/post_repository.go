@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -29,6 +30,7 @@ func (r *postRepository) Create(ctx context.Context, createdBy uuid.UUID, data d
 		Title:            data.Title,
 		Description:      data.Description,
 		ShortDescription: data.ShortDescription,
+		Status:           constants.PostStatusDraft,
 		CreatedAt:        now,
 		UpdatedAt:        now,
 	}
@@ -48,7 +50,7 @@ func (r *postRepository) Update(ctx context.Context, id uuid.UUID, data dto.ToDB
 
 	c := &models.Post{}
 	err := r.DB.WithContext(ctx).Model(&models.Post{}).
-		Where("id = ?", id).
+		Where("id = ? AND deleted_at IS NULL", id).
 		Updates(updates).
 		First(c).Error
 	if err != nil {
@@ -57,8 +59,14 @@ func (r *postRepository) Update(ctx context.Context, id uuid.UUID, data dto.ToDB
 	return c, nil
 }
 
-func (r *postRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.DB.WithContext(ctx).Where("id = ?", id).Delete(&models.Post{}).Error
+func (r *postRepository) Delete(ctx context.Context, id uuid.UUID, deletedBy string) error {
+	updates := map[string]interface{}{
+		"deleted_at": time.Now().UTC(),
+		"deleted_by": deletedBy,
+	}
+	return r.DB.WithContext(ctx).Model(&models.Post{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(updates).Error
 }
 
 func (r *postRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Post, error) {
@@ -72,17 +80,18 @@ func (r *postRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Pos
 				ORDER BY ftm.created_at DESC
 				LIMIT 1
 			) AS thumbnail_url,
+			c.status, c.publish_at, c.published_at, c.published_by,
 			c.created_at, c.updated_at`,
 			constants.ModuleTypePost, constants.FileTypeThumbnail,
 		).
-		Where("c.id = ?", id).
+		Where("c.id = ? AND c.deleted_at IS NULL", id).
 		First(c).Error; err != nil {
 		return nil, err
 	}
 	return c, nil
 }
 
-func (r *postRepository) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqPostIndexFilter) ([]models.Post, int, error) {
+func (r *postRepository) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqPostIndexFilter, visibility dto.PostVisibility) ([]models.Post, int, error) {
 	var posts []models.Post
 	query := r.DB.WithContext(ctx).
 		Table("posts c").
@@ -93,39 +102,17 @@ func (r *postRepository) GetIndex(ctx context.Context, req request.PageRequest,
 				ORDER BY ftm.created_at DESC
 				LIMIT 1
 			) AS thumbnail_url,
+			c.status, c.publish_at, c.published_at,
 			c.created_at`,
 			constants.ModuleTypePost, constants.FileTypeThumbnail,
 		).
-		Where("1=1")
+		Where("c.deleted_at IS NULL")
 
 	// Search support
 	query = request.ApplySearchConditionFromInterface(query, req.Search, csearch.NewPostSearchHelper())
 
-	// Filters by parameter relations
-	if len(filter.LangIDs) > 0 {
-		query = query.Where(`
-			EXISTS (
-				SELECT 1 FROM parameters_to_module ptm
-				JOIN parameters p ON p.id = ptm.parameter_id
-				WHERE ptm.module_type = ?
-				  AND ptm.module_id = c.id
-				  AND p.type = 'lang'
-				  AND p.id IN (?)
-			)
-		`, constants.ModuleTypePost, filter.LangIDs)
-	}
-	if len(filter.TopicIDs) > 0 {
-		query = query.Where(`
-			EXISTS (
-				SELECT 1 FROM parameters_to_module ptm
-				JOIN parameters p ON p.id = ptm.parameter_id
-				WHERE ptm.module_type = ?
-				  AND ptm.module_id = c.id
-				  AND p.type = 'topic'
-				  AND p.id IN (?)
-			)
-		`, constants.ModuleTypePost, filter.TopicIDs)
-	}
+	// Filters by status and parameter relations
+	query = applyPostFilters(query, filter, visibility)
 
 	// Pagination
 	total, err := request.ApplyPagination(query, req, request.PaginationConfig{
@@ -140,6 +127,12 @@ func (r *postRepository) GetIndex(ctx context.Context, req request.PageRequest,
 				return "c.title"
 			case "short_description":
 				return "c.short_description"
+			case "status":
+				return "c.status"
+			case "publish_at":
+				return "c.publish_at"
+			case "published_at":
+				return "c.published_at"
 			case "created_at":
 				return "c.created_at"
 			default:
@@ -154,7 +147,7 @@ func (r *postRepository) GetIndex(ctx context.Context, req request.PageRequest,
 	return posts, total, nil
 }
 
-func (r *postRepository) GetAll(ctx context.Context, filter dto.ReqPostIndexFilter) ([]models.Post, error) {
+func (r *postRepository) GetAll(ctx context.Context, filter dto.ReqPostIndexFilter, visibility dto.PostVisibility) ([]models.Post, error) {
 	var posts []models.Post
 	query := r.DB.WithContext(ctx).
 		Table("posts c").
@@ -165,15 +158,68 @@ func (r *postRepository) GetAll(ctx context.Context, filter dto.ReqPostIndexFilt
 				ORDER BY ftm.created_at DESC
 				LIMIT 1
 			) AS thumbnail_url,
+			c.status, c.publish_at, c.published_at,
 			c.created_at`,
 			constants.ModuleTypePost, constants.FileTypeThumbnail,
 		).
-		Where("1=1")
+		Where("c.deleted_at IS NULL")
 
 	// Search support
 	query = request.ApplySearchConditionFromInterface(query, filter.Search, csearch.NewPostSearchHelper())
 
 	// Filters (same as index)
+	query = applyPostFilters(query, filter, visibility)
+
+	if err := query.Order("c.created_at DESC").Find(&posts).Error; err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// Transition writes the workflow state of a post still in one of the from statuses,
+// PostStatusChanged when another request changed its status first
+func (r *postRepository) Transition(ctx context.Context, id uuid.UUID, from []string, data dto.ToDBPostStatus) (*models.Post, error) {
+	res := r.DB.WithContext(ctx).Model(&models.Post{}).
+		Where("id = ? AND status IN (?) AND deleted_at IS NULL", id, from).
+		Updates(map[string]interface{}{
+			"status":       data.Status,
+			"publish_at":   data.PublishAt,
+			"published_at": data.PublishedAt,
+			"published_by": data.PublishedBy,
+			"updated_at":   time.Now().UTC(),
+		})
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, errors.New(constants.PostStatusChanged)
+	}
+	return r.GetByID(ctx, id)
+}
+
+// PublishScheduled publishes the posts in review whose publish_at has passed
+func (r *postRepository) PublishScheduled(ctx context.Context, now time.Time) (int64, error) {
+	res := r.DB.WithContext(ctx).Model(&models.Post{}).
+		Where("status = ? AND publish_at IS NOT NULL AND publish_at <= ? AND deleted_at IS NULL", constants.PostStatusReview, now).
+		Updates(map[string]interface{}{
+			"status":       constants.PostStatusPublished,
+			"published_at": gorm.Expr("publish_at"),
+			"updated_at":   now,
+		})
+	return res.RowsAffected, res.Error
+}
+
+// applyPostFilters applies the visibility, the statuses and the parameter relations to the query
+func applyPostFilters(query *gorm.DB, filter dto.ReqPostIndexFilter, visibility dto.PostVisibility) *gorm.DB {
+	if visibility.PublishedOnly {
+		query = query.Where("c.status = ?", constants.PostStatusPublished)
+	} else if len(filter.Statuses) > 0 {
+		query = query.Where("c.status IN (?)", filter.Statuses)
+	}
+	if visibility.AuthorID != uuid.Nil {
+		query = query.Where("c.created_by = ?", visibility.AuthorID)
+	}
+
 	if len(filter.LangIDs) > 0 {
 		query = query.Where(`
 			EXISTS (
@@ -198,9 +244,5 @@ func (r *postRepository) GetAll(ctx context.Context, filter dto.ReqPostIndexFilt
 			)
 		`, constants.ModuleTypePost, filter.TopicIDs)
 	}
-
-	if err := query.Order("c.created_at DESC").Find(&posts).Error; err != nil {
-		return nil, err
-	}
-	return posts, nil
+	return query
 }
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/post/dto"
+	"gorm.io/gorm"
+)
+
+// postRevisionSnapshotSQL copies the current content, lang, sorted topics and thumbnail of a post
+// into its next revision
+const postRevisionSnapshotSQL = `
+INSERT INTO post_revisions (post_id, revision_number, action, restored_from, title, description, short_description,
+	lang_id, topic_ids, thumbnail_file_id, thumbnail_url, edited_by, created_at)
+SELECT p.id,
+	COALESCE((SELECT MAX(pr.revision_number) FROM post_revisions pr WHERE pr.post_id = p.id), 0) + 1,
+	@action, @restored_from, p.title, p.description, p.short_description,
+	(SELECT ptm.parameter_id FROM parameters_to_module ptm
+		JOIN parameters prm ON prm.id = ptm.parameter_id
+		WHERE ptm.module_type = @module_type AND ptm.module_id = p.id AND prm.type = 'lang'
+		ORDER BY ptm.created_at DESC
+		LIMIT 1),
+	(SELECT COALESCE(jsonb_agg(ptm.parameter_id::text ORDER BY ptm.parameter_id::text), '[]'::jsonb) FROM parameters_to_module ptm
+		JOIN parameters prm ON prm.id = ptm.parameter_id
+		WHERE ptm.module_type = @module_type AND ptm.module_id = p.id AND prm.type = 'topic'),
+	th.id, th.file_path, @edited_by, @created_at
+FROM posts p
+LEFT JOIN LATERAL (
+	SELECT f.id, f.file_path FROM files_to_module ftm
+		JOIN files f ON f.id = ftm.file_id AND f.deleted_at IS NULL
+		WHERE ftm.module_type = @module_type AND ftm.module_id = p.id AND ftm.type = @file_type
+		ORDER BY ftm.created_at DESC
+		LIMIT 1
+) th ON TRUE
+WHERE p.id = @post_id AND p.deleted_at IS NULL
+RETURNING *`
+
+// CreateRevision snapshots the current state of the post, gorm.ErrRecordNotFound when the post does not exist
+func (r *postRepository) CreateRevision(ctx context.Context, postID uuid.UUID, data dto.ToDBPostRevision) (*models.PostRevision, error) {
+	var revisions []models.PostRevision
+	err := r.DB.WithContext(ctx).Raw(postRevisionSnapshotSQL, map[string]interface{}{
+		"post_id":       postID,
+		"action":        data.Action,
+		"restored_from": data.RestoredFrom,
+		"edited_by":     data.EditedBy,
+		"created_at":    time.Now().UTC(),
+		"module_type":   constants.ModuleTypePost,
+		"file_type":     constants.FileTypeThumbnail,
+	}).Scan(&revisions).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &revisions[0], nil
+}
+
+// GetRevisionByID returns a revision of the post, gorm.ErrRecordNotFound when it belongs to another post
+func (r *postRepository) GetRevisionByID(ctx context.Context, postID uuid.UUID, revisionID uuid.UUID) (*models.PostRevision, error) {
+	rev := &models.PostRevision{}
+	if err := r.revisionQuery(ctx).
+		Where("pr.post_id = ? AND pr.id = ?", postID, revisionID).
+		First(rev).Error; err != nil {
+		return nil, err
+	}
+	return rev, nil
+}
+
+// GetRevisionIndex lists the revisions of the post, newest first
+func (r *postRepository) GetRevisionIndex(ctx context.Context, postID uuid.UUID, req request.PageRequest) ([]models.PostRevision, int, error) {
+	var revisions []models.PostRevision
+	query := r.revisionQuery(ctx).Where("pr.post_id = ?", postID)
+
+	total, err := request.ApplyPagination(query, req, request.PaginationConfig{
+		DefaultSortBy:    "pr.revision_number",
+		DefaultSortOrder: "DESC",
+		MaxPerPage:       100,
+		SortMapping: func(s string) string {
+			switch s {
+			case "revision_number":
+				return "pr.revision_number"
+			case "action":
+				return "pr.action"
+			case "created_at":
+				return "pr.created_at"
+			default:
+				return ""
+			}
+		},
+	}, &revisions)
+	if err != nil {
+		return nil, 0, err
+	}
+	return revisions, total, nil
+}
+
+func (r *postRepository) revisionQuery(ctx context.Context) *gorm.DB {
+	return r.DB.WithContext(ctx).
+		Table("post_revisions pr").
+		Select("pr.*, u.full_name AS edited_by_name").
+		Joins("LEFT JOIN users u ON u.id = pr.edited_by")
+}
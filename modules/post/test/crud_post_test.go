@@ -9,6 +9,7 @@ import (
 	"github.com/rendyfutsuy/base-go/helpers/request"
 	"github.com/rendyfutsuy/base-go/models"
 	fileDto "github.com/rendyfutsuy/base-go/modules/file/dto"
+	paramMod "github.com/rendyfutsuy/base-go/modules/parameter"
 	paramDto "github.com/rendyfutsuy/base-go/modules/parameter/dto"
 	postDto "github.com/rendyfutsuy/base-go/modules/post/dto"
 	postUsecase "github.com/rendyfutsuy/base-go/modules/post/usecase"
@@ -34,8 +35,8 @@ func (m *MockPostRepository) Update(ctx context.Context, id uuid.UUID, data post
 	}
 	return args.Get(0).(*models.Post), args.Error(1)
 }
-func (m *MockPostRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	args := m.Called(ctx, id)
+func (m *MockPostRepository) Delete(ctx context.Context, id uuid.UUID, deletedBy string) error {
+	args := m.Called(ctx, id, deletedBy)
 	return args.Error(0)
 }
 func (m *MockPostRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Post, error) {
@@ -45,20 +46,52 @@ func (m *MockPostRepository) GetByID(ctx context.Context, id uuid.UUID) (*models
 	}
 	return args.Get(0).(*models.Post), args.Error(1)
 }
-func (m *MockPostRepository) GetIndex(ctx context.Context, req request.PageRequest, filter postDto.ReqPostIndexFilter) ([]models.Post, int, error) {
-	args := m.Called(ctx, req, filter)
+func (m *MockPostRepository) GetIndex(ctx context.Context, req request.PageRequest, filter postDto.ReqPostIndexFilter, visibility postDto.PostVisibility) ([]models.Post, int, error) {
+	args := m.Called(ctx, req, filter, visibility)
 	if args.Get(0) == nil {
 		return nil, 0, args.Error(2)
 	}
 	return args.Get(0).([]models.Post), args.Int(1), args.Error(2)
 }
-func (m *MockPostRepository) GetAll(ctx context.Context, filter postDto.ReqPostIndexFilter) ([]models.Post, error) {
-	args := m.Called(ctx, filter)
+func (m *MockPostRepository) GetAll(ctx context.Context, filter postDto.ReqPostIndexFilter, visibility postDto.PostVisibility) ([]models.Post, error) {
+	args := m.Called(ctx, filter, visibility)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]models.Post), args.Error(1)
 }
+func (m *MockPostRepository) Transition(ctx context.Context, id uuid.UUID, from []string, data postDto.ToDBPostStatus) (*models.Post, error) {
+	args := m.Called(ctx, id, from, data)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Post), args.Error(1)
+}
+func (m *MockPostRepository) PublishScheduled(ctx context.Context, now time.Time) (int64, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+func (m *MockPostRepository) CreateRevision(ctx context.Context, postID uuid.UUID, data postDto.ToDBPostRevision) (*models.PostRevision, error) {
+	args := m.Called(ctx, postID, data)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PostRevision), args.Error(1)
+}
+func (m *MockPostRepository) GetRevisionByID(ctx context.Context, postID uuid.UUID, revisionID uuid.UUID) (*models.PostRevision, error) {
+	args := m.Called(ctx, postID, revisionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PostRevision), args.Error(1)
+}
+func (m *MockPostRepository) GetRevisionIndex(ctx context.Context, postID uuid.UUID, req request.PageRequest) ([]models.PostRevision, int, error) {
+	args := m.Called(ctx, postID, req)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]models.PostRevision), args.Int(1), args.Error(2)
+}
 
 type MockParameterRepository struct {
 	mock.Mock
@@ -110,6 +143,106 @@ func (m *MockParameterRepository) RemoveParametersFromModule(ctx context.Context
 	args := m.Called(ctx, moduleType, moduleID)
 	return args.Error(0)
 }
+func (m *MockParameterRepository) GetChildTypes(ctx context.Context, id uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+func (m *MockParameterRepository) GetByCodes(ctx context.Context, codes []string) ([]models.Parameter, error) {
+	args := m.Called(ctx, codes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Parameter), args.Error(1)
+}
+func (m *MockParameterRepository) ImportParameters(ctx context.Context, params []paramMod.ImportParameterParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+func (m *MockParameterRepository) GetLookupEntries(ctx context.Context) ([]models.Parameter, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Parameter), args.Error(1)
+}
+
+func (m *MockParameterRepository) GetTree(ctx context.Context, typeCode string) ([]models.Parameter, error) {
+	args := m.Called(ctx, typeCode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Parameter), args.Error(1)
+}
+
+func (m *MockParameterRepository) GetAncestorIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockParameterRepository) GetSiblings(ctx context.Context, parentID uuid.UUID, typeVal *string) ([]models.Parameter, error) {
+	args := m.Called(ctx, parentID, typeVal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Parameter), args.Error(1)
+}
+
+func (m *MockParameterRepository) Move(ctx context.Context, id uuid.UUID, parentID uuid.UUID, position int) error {
+	args := m.Called(ctx, id, parentID, position)
+	return args.Error(0)
+}
+
+func (m *MockParameterRepository) Reorder(ctx context.Context, ids []uuid.UUID) error {
+	args := m.Called(ctx, ids)
+	return args.Error(0)
+}
+
+// MockParameterLookup is a mock implementation of parameter.Lookup, only GetByID is used by posts
+type MockParameterLookup struct {
+	mock.Mock
+}
+
+func (m *MockParameterLookup) GetByID(ctx context.Context, id uuid.UUID) (*models.Parameter, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Parameter), args.Error(1)
+}
+func (m *MockParameterLookup) GetByCode(ctx context.Context, code string) (*models.Parameter, error) {
+	panic("not implemented")
+}
+func (m *MockParameterLookup) ListByType(ctx context.Context, typeCode string) ([]models.Parameter, error) {
+	panic("not implemented")
+}
+func (m *MockParameterLookup) ListChildren(ctx context.Context, parentCode string) ([]models.Parameter, error) {
+	panic("not implemented")
+}
+func (m *MockParameterLookup) GetString(ctx context.Context, code string) (string, error) {
+	panic("not implemented")
+}
+func (m *MockParameterLookup) GetInt(ctx context.Context, code string) (int64, error) {
+	panic("not implemented")
+}
+func (m *MockParameterLookup) GetDecimal(ctx context.Context, code string) (float64, error) {
+	panic("not implemented")
+}
+func (m *MockParameterLookup) GetBool(ctx context.Context, code string) (bool, error) {
+	panic("not implemented")
+}
+func (m *MockParameterLookup) GetDate(ctx context.Context, code string) (time.Time, error) {
+	panic("not implemented")
+}
+func (m *MockParameterLookup) GetJSON(ctx context.Context, code string, dest interface{}) error {
+	panic("not implemented")
+}
+func (m *MockParameterLookup) Invalidate(ctx context.Context) {}
 
 // Minimal mock for file usecase to satisfy constructor
 type MockFileUsecase struct{}
@@ -131,7 +264,8 @@ func TestPostUsecase_Create_TypeValidation(t *testing.T) {
 	ctx := context.Background()
 	mockPostRepo := new(MockPostRepository)
 	mockParamRepo := new(MockParameterRepository)
-	useCase := postUsecase.NewPostUsecase(mockPostRepo, mockParamRepo, new(MockFileUsecase))
+	mockParamLookup := new(MockParameterLookup)
+	useCase := postUsecase.NewPostUsecase(mockPostRepo, mockParamRepo, mockParamLookup, new(MockFileUsecase))
 
 	langID := uuid.New()
 	topicID := uuid.New()
@@ -145,22 +279,25 @@ func TestPostUsecase_Create_TypeValidation(t *testing.T) {
 		TopicIDs:         []uuid.UUID{topicID},
 	}
 	// return wrong type for lang to trigger validation error
-	mockParamRepo.On("GetByID", ctx, langID).Return(&models.Parameter{ID: langID, Type: ptrStr("wrong")}, nil).Once()
+	mockParamLookup.On("GetByID", ctx, langID).Return(&models.Parameter{ID: langID, Type: ptrStr("wrong")}, nil).Once()
 	_, err := useCase.Create(ctx, req, "", nil, "")
 	assert.Error(t, err)
 
 	// valid types then success
 	mockParamRepo.ExpectedCalls = nil
+	mockParamLookup.ExpectedCalls = nil
 	mockPostRepo.ExpectedCalls = nil
 
-	mockParamRepo.On("GetByID", ctx, langID).Return(&models.Parameter{ID: langID, Type: ptrStr("lang")}, nil).Once()
-	mockParamRepo.On("GetByID", ctx, topicID).Return(&models.Parameter{ID: topicID, Type: ptrStr("topic")}, nil).Once()
+	mockParamLookup.On("GetByID", ctx, langID).Return(&models.Parameter{ID: langID, Type: ptrStr("lang")}, nil).Once()
+	mockParamLookup.On("GetByID", ctx, topicID).Return(&models.Parameter{ID: topicID, Type: ptrStr("topic")}, nil).Once()
 
 	cID := uuid.New()
 	mockPostRepo.On("Create", ctx, uuid.Nil, mock.Anything).
 		Return(&models.Post{ID: cID, Title: "A", Description: "B", ShortDescription: "C", CreatedAt: time.Now(), UpdatedAt: time.Now()}, nil).Once()
 	mockParamRepo.On("AssignParametersToModule", ctx, "post", cID, []uuid.UUID{langID}).Return(nil).Once()
 	mockParamRepo.On("AssignParametersToModule", ctx, "post", cID, []uuid.UUID{topicID}).Return(nil).Once()
+	mockPostRepo.On("CreateRevision", ctx, cID, postDto.ToDBPostRevision{Action: "create"}).
+		Return(&models.PostRevision{ID: uuid.New(), PostID: cID, RevisionNumber: 1}, nil).Once()
 
 	res, err := useCase.Create(ctx, &postDto.ReqCreatePost{
 		Title:            "A",
@@ -173,6 +310,7 @@ func TestPostUsecase_Create_TypeValidation(t *testing.T) {
 	assert.NotNil(t, res)
 	mockPostRepo.AssertExpectations(t)
 	mockParamRepo.AssertExpectations(t)
+	mockParamLookup.AssertExpectations(t)
 }
 
 func ptrStr(s string) *string { return &s }
@@ -0,0 +1,152 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	postDto "github.com/rendyfutsuy/base-go/modules/post/dto"
+	postUsecase "github.com/rendyfutsuy/base-go/modules/post/usecase"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestPostUsecase_DiffRevisions(t *testing.T) {
+	ctx := context.Background()
+	mockPostRepo := new(MockPostRepository)
+	useCase := postUsecase.NewPostUsecase(mockPostRepo, new(MockParameterRepository), new(MockParameterLookup), new(MockFileUsecase))
+
+	p := &models.Post{ID: uuid.New(), Status: constants.PostStatusDraft}
+	langID := uuid.New()
+	topicA, topicB := uuid.NewString(), uuid.NewString()
+	from := &models.PostRevision{ID: uuid.New(), PostID: p.ID, RevisionNumber: 1, Title: "Old", Description: "Body", ShortDescription: "Short",
+		LangID: &langID, TopicIDs: utils.NullStringArray{Strings: []string{topicA, topicB}, Valid: true}, ThumbnailURL: ptrStr("posts/a.png")}
+	to := &models.PostRevision{ID: uuid.New(), PostID: p.ID, RevisionNumber: 2, Title: "New", Description: "Body", ShortDescription: "Short",
+		LangID: &langID, TopicIDs: utils.NullStringArray{Strings: []string{topicA}, Valid: true}}
+
+	mockPostRepo.On("GetByID", ctx, p.ID).Return(p, nil)
+	mockPostRepo.On("GetRevisionByID", ctx, p.ID, from.ID).Return(from, nil)
+	mockPostRepo.On("GetRevisionByID", ctx, p.ID, to.ID).Return(to, nil)
+
+	res, err := useCase.DiffRevisions(ctx, p.ID.String(), postDto.ReqPostRevisionDiff{From: from.ID.String(), To: to.ID.String()}, "", true)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, res.From.RevisionNumber)
+	assert.Equal(t, 2, res.To.RevisionNumber)
+	// sorted by field, unchanged fields are left out
+	assert.Equal(t, []postDto.RespPostRevisionChange{
+		{Field: "thumbnail_url", From: "posts/a.png", To: nil},
+		{Field: "title", From: "Old", To: "New"},
+		{Field: "topic_ids", From: []string{topicA, topicB}, To: []string{topicA}},
+	}, res.Changes)
+}
+
+func TestPostUsecase_GetRevisionByID_OtherPost(t *testing.T) {
+	ctx := context.Background()
+	mockPostRepo := new(MockPostRepository)
+	useCase := postUsecase.NewPostUsecase(mockPostRepo, new(MockParameterRepository), new(MockParameterLookup), new(MockFileUsecase))
+
+	p := &models.Post{ID: uuid.New(), Status: constants.PostStatusPublished}
+	revisionID := uuid.New()
+	mockPostRepo.On("GetByID", ctx, p.ID).Return(p, nil)
+	mockPostRepo.On("GetRevisionByID", ctx, p.ID, revisionID).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := useCase.GetRevisionByID(ctx, p.ID.String(), revisionID.String(), "", true)
+	assert.EqualError(t, err, "revision with id "+revisionID.String()+" not found")
+}
+
+func TestPostUsecase_RestoreRevision(t *testing.T) {
+	ctx := context.Background()
+	mockPostRepo := new(MockPostRepository)
+	mockParamRepo := new(MockParameterRepository)
+	mockParamLookup := new(MockParameterLookup)
+	useCase := postUsecase.NewPostUsecase(mockPostRepo, mockParamRepo, mockParamLookup, new(MockFileUsecase))
+
+	editorID := uuid.New()
+	langID, topicID := uuid.New(), uuid.New()
+	p := &models.Post{ID: uuid.New(), Title: "Current", Status: constants.PostStatusPublished}
+	rev := &models.PostRevision{ID: uuid.New(), PostID: p.ID, RevisionNumber: 1, Title: "Old", Description: "Old body", ShortDescription: "Old short",
+		LangID: &langID, TopicIDs: utils.NullStringArray{Strings: []string{topicID.String()}, Valid: true}}
+
+	mockPostRepo.On("GetByID", ctx, p.ID).Return(p, nil)
+	mockPostRepo.On("GetRevisionByID", ctx, p.ID, rev.ID).Return(rev, nil)
+	mockParamLookup.On("GetByID", ctx, langID).Return(&models.Parameter{ID: langID, Type: ptrStr("lang")}, nil).Once()
+	mockParamLookup.On("GetByID", ctx, topicID).Return(&models.Parameter{ID: topicID, Type: ptrStr("topic")}, nil).Once()
+	mockPostRepo.On("Update", ctx, p.ID, mock.MatchedBy(func(data postDto.ToDBPost) bool {
+		return data.Title == "Old" && data.Description == "Old body" && data.ShortDescription == "Old short" && data.RemoveThumbnail
+	})).Return(&models.Post{ID: p.ID, Title: "Old", Status: constants.PostStatusPublished}, nil).Once()
+	mockParamRepo.On("RemoveParametersFromModule", ctx, "post", p.ID).Return(nil).Once()
+	mockParamRepo.On("AssignParametersToModule", ctx, "post", p.ID, []uuid.UUID{langID}).Return(nil).Once()
+	mockParamRepo.On("AssignParametersToModule", ctx, "post", p.ID, []uuid.UUID{topicID}).Return(nil).Once()
+	mockPostRepo.On("CreateRevision", ctx, p.ID, postDto.ToDBPostRevision{Action: constants.PostRevisionActionRestore, RestoredFrom: &rev.ID, EditedBy: &editorID}).
+		Return(&models.PostRevision{ID: uuid.New(), PostID: p.ID, RevisionNumber: 3}, nil).Once()
+
+	res, err := useCase.RestoreRevision(ctx, p.ID.String(), rev.ID.String(), editorID.String(), true)
+	assert.NoError(t, err)
+	assert.Equal(t, "Old", res.Title)
+	mockPostRepo.AssertExpectations(t)
+	mockParamRepo.AssertExpectations(t)
+	mockParamLookup.AssertExpectations(t)
+}
+
+func TestPostUsecase_RestoreRevision_DeletedTopic(t *testing.T) {
+	ctx := context.Background()
+	mockPostRepo := new(MockPostRepository)
+	mockParamLookup := new(MockParameterLookup)
+	useCase := postUsecase.NewPostUsecase(mockPostRepo, new(MockParameterRepository), mockParamLookup, new(MockFileUsecase))
+
+	topicID := uuid.New()
+	p := &models.Post{ID: uuid.New(), Status: constants.PostStatusDraft}
+	rev := &models.PostRevision{ID: uuid.New(), PostID: p.ID, RevisionNumber: 1, TopicIDs: utils.NullStringArray{Strings: []string{topicID.String()}, Valid: true}}
+
+	mockPostRepo.On("GetByID", ctx, p.ID).Return(p, nil)
+	mockPostRepo.On("GetRevisionByID", ctx, p.ID, rev.ID).Return(rev, nil)
+	mockParamLookup.On("GetByID", ctx, topicID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+	_, err := useCase.RestoreRevision(ctx, p.ID.String(), rev.ID.String(), "", true)
+	assert.Error(t, err)
+	mockPostRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	mockPostRepo.AssertNotCalled(t, "CreateRevision", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPostUsecase_Revisions_AuthorRules(t *testing.T) {
+	ctx := context.Background()
+	mockPostRepo := new(MockPostRepository)
+	mockParamRepo := new(MockParameterRepository)
+	useCase := postUsecase.NewPostUsecase(mockPostRepo, mockParamRepo, new(MockParameterLookup), new(MockFileUsecase))
+
+	authorID := uuid.New()
+	p := &models.Post{ID: uuid.New(), CreatedBy: authorID, Title: "Current", Status: constants.PostStatusPublished}
+	rev := &models.PostRevision{ID: uuid.New(), PostID: p.ID, RevisionNumber: 1, Title: "Old"}
+	mockPostRepo.On("GetByID", ctx, p.ID).Return(p, nil)
+	mockPostRepo.On("GetRevisionByID", ctx, p.ID, rev.ID).Return(rev, nil)
+
+	// another author can neither browse nor restore the revisions
+	otherID := uuid.New().String()
+	_, _, err := useCase.GetRevisionIndex(ctx, p.ID.String(), request.PageRequest{Page: 1, PerPage: 10}, otherID, false)
+	assert.EqualError(t, err, constants.PostEditNotAuthor)
+	_, err = useCase.GetRevisionByID(ctx, p.ID.String(), rev.ID.String(), otherID, false)
+	assert.EqualError(t, err, constants.PostEditNotAuthor)
+	_, err = useCase.DiffRevisions(ctx, p.ID.String(), postDto.ReqPostRevisionDiff{From: rev.ID.String(), To: rev.ID.String()}, otherID, false)
+	assert.EqualError(t, err, constants.PostEditNotAuthor)
+	_, err = useCase.RestoreRevision(ctx, p.ID.String(), rev.ID.String(), otherID, false)
+	assert.EqualError(t, err, constants.PostEditNotAuthor)
+	mockPostRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+
+	// the author restores the published post through the workflow: back to draft first
+	mockPostRepo.On("Transition", ctx, p.ID, []string{constants.PostStatusPublished}, postDto.ToDBPostStatus{Status: constants.PostStatusDraft}).
+		Return(&models.Post{ID: p.ID, Status: constants.PostStatusDraft}, nil).Once()
+	mockPostRepo.On("Update", ctx, p.ID, mock.Anything).Return(&models.Post{ID: p.ID, Title: "Old", Status: constants.PostStatusDraft}, nil).Once()
+	mockParamRepo.On("RemoveParametersFromModule", ctx, "post", p.ID).Return(nil).Once()
+	mockPostRepo.On("CreateRevision", ctx, p.ID, mock.Anything).Return(&models.PostRevision{ID: uuid.New(), PostID: p.ID, RevisionNumber: 2}, nil).Once()
+
+	res, err := useCase.RestoreRevision(ctx, p.ID.String(), rev.ID.String(), authorID.String(), false)
+	assert.NoError(t, err)
+	assert.Equal(t, constants.PostStatusDraft, res.Status)
+	mockPostRepo.AssertExpectations(t)
+	mockParamRepo.AssertExpectations(t)
+}
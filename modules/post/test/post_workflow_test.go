@@ -0,0 +1,154 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	postDto "github.com/rendyfutsuy/base-go/modules/post/dto"
+	postUsecase "github.com/rendyfutsuy/base-go/modules/post/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPostUsecase_Submit_OnlyAuthor(t *testing.T) {
+	ctx := context.Background()
+	mockPostRepo := new(MockPostRepository)
+	useCase := postUsecase.NewPostUsecase(mockPostRepo, new(MockParameterRepository), new(MockParameterLookup), new(MockFileUsecase))
+
+	authorID := uuid.New()
+	draft := &models.Post{ID: uuid.New(), CreatedBy: authorID, Status: constants.PostStatusDraft}
+	mockPostRepo.On("GetByID", ctx, draft.ID).Return(draft, nil)
+
+	_, err := useCase.Submit(ctx, draft.ID.String(), uuid.New().String())
+	assert.EqualError(t, err, constants.PostSubmitNotAuthor)
+
+	mockPostRepo.On("Transition", ctx, draft.ID, []string{constants.PostStatusDraft}, postDto.ToDBPostStatus{Status: constants.PostStatusReview}).
+		Return(&models.Post{ID: draft.ID, CreatedBy: authorID, Status: constants.PostStatusReview}, nil).Once()
+	res, err := useCase.Submit(ctx, draft.ID.String(), authorID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, constants.PostStatusReview, res.Status)
+	mockPostRepo.AssertExpectations(t)
+}
+
+func TestPostUsecase_Publish_NowAndScheduled(t *testing.T) {
+	ctx := context.Background()
+	mockPostRepo := new(MockPostRepository)
+	useCase := postUsecase.NewPostUsecase(mockPostRepo, new(MockParameterRepository), new(MockParameterLookup), new(MockFileUsecase))
+
+	editorID := uuid.New()
+	inReview := &models.Post{ID: uuid.New(), CreatedBy: uuid.New(), Status: constants.PostStatusReview}
+	mockPostRepo.On("GetByID", ctx, inReview.ID).Return(inReview, nil)
+
+	// publish now
+	mockPostRepo.On("Transition", ctx, inReview.ID, []string{constants.PostStatusReview}, mock.MatchedBy(func(data postDto.ToDBPostStatus) bool {
+		return data.Status == constants.PostStatusPublished && data.PublishAt == nil && data.PublishedAt != nil &&
+			data.PublishedBy != nil && *data.PublishedBy == editorID
+	})).Return(&models.Post{ID: inReview.ID, Status: constants.PostStatusPublished}, nil).Once()
+	res, err := useCase.Publish(ctx, inReview.ID.String(), &postDto.ReqPublishPost{}, editorID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, constants.PostStatusPublished, res.Status)
+
+	// publish_at in the future keeps the post in review until the scheduler publishes it
+	publishAt := time.Now().Add(time.Hour)
+	mockPostRepo.On("Transition", ctx, inReview.ID, []string{constants.PostStatusReview}, mock.MatchedBy(func(data postDto.ToDBPostStatus) bool {
+		return data.Status == constants.PostStatusReview && data.PublishAt != nil && data.PublishAt.Equal(publishAt) && data.PublishedAt == nil
+	})).Return(&models.Post{ID: inReview.ID, Status: constants.PostStatusReview, PublishAt: &publishAt}, nil).Once()
+	res, err = useCase.Publish(ctx, inReview.ID.String(), &postDto.ReqPublishPost{PublishAt: &publishAt}, editorID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, constants.PostStatusReview, res.Status)
+	mockPostRepo.AssertExpectations(t)
+}
+
+func TestPostUsecase_Update_AuthorAndEditor(t *testing.T) {
+	ctx := context.Background()
+	mockPostRepo := new(MockPostRepository)
+	mockParamRepo := new(MockParameterRepository)
+	mockParamLookup := new(MockParameterLookup)
+	useCase := postUsecase.NewPostUsecase(mockPostRepo, mockParamRepo, mockParamLookup, new(MockFileUsecase))
+
+	authorID, editorID := uuid.New(), uuid.New()
+	langID := uuid.New()
+	published := &models.Post{ID: uuid.New(), CreatedBy: authorID, Status: constants.PostStatusPublished}
+	req := &postDto.ReqUpdatePost{Title: "New", Description: "Body", ShortDescription: "Short", LangID: langID}
+
+	mockPostRepo.On("GetByID", ctx, published.ID).Return(published, nil)
+	mockParamLookup.On("GetByID", ctx, langID).Return(&models.Parameter{ID: langID, Type: ptrStr("lang")}, nil)
+	mockParamRepo.On("RemoveParametersFromModule", ctx, "post", published.ID).Return(nil)
+	mockParamRepo.On("AssignParametersToModule", ctx, "post", published.ID, []uuid.UUID{langID}).Return(nil)
+	mockPostRepo.On("CreateRevision", ctx, published.ID, mock.Anything).Return(&models.PostRevision{ID: uuid.New(), PostID: published.ID}, nil)
+
+	// another author cannot change the post
+	_, err := useCase.Update(ctx, published.ID.String(), req, uuid.New().String(), false, nil, "")
+	assert.EqualError(t, err, constants.PostEditNotAuthor)
+	mockPostRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+
+	// an editor changes it in place
+	mockPostRepo.On("Update", ctx, published.ID, mock.Anything).Return(&models.Post{ID: published.ID, Title: "New", Status: constants.PostStatusPublished}, nil).Once()
+	res, err := useCase.Update(ctx, published.ID.String(), req, editorID.String(), true, nil, "")
+	assert.NoError(t, err)
+	assert.Equal(t, constants.PostStatusPublished, res.Status)
+	mockPostRepo.AssertNotCalled(t, "Transition", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// the author sends it back to draft before changing it
+	mockPostRepo.On("Transition", ctx, published.ID, []string{constants.PostStatusPublished}, postDto.ToDBPostStatus{Status: constants.PostStatusDraft}).
+		Return(&models.Post{ID: published.ID, Status: constants.PostStatusDraft}, nil).Once()
+	mockPostRepo.On("Update", ctx, published.ID, mock.Anything).Return(&models.Post{ID: published.ID, Title: "New", Status: constants.PostStatusDraft}, nil).Once()
+	res, err = useCase.Update(ctx, published.ID.String(), req, authorID.String(), false, nil, "")
+	assert.NoError(t, err)
+	assert.Equal(t, constants.PostStatusDraft, res.Status)
+	mockPostRepo.AssertExpectations(t)
+}
+
+func TestPostUsecase_Transition_NotAllowed(t *testing.T) {
+	ctx := context.Background()
+	mockPostRepo := new(MockPostRepository)
+	useCase := postUsecase.NewPostUsecase(mockPostRepo, new(MockParameterRepository), new(MockParameterLookup), new(MockFileUsecase))
+
+	published := &models.Post{ID: uuid.New(), CreatedBy: uuid.New(), Status: constants.PostStatusPublished}
+	mockPostRepo.On("GetByID", ctx, published.ID).Return(published, nil)
+
+	_, err := useCase.Submit(ctx, published.ID.String(), published.CreatedBy.String())
+	assert.EqualError(t, err, "cannot submit a post with status published")
+	_, err = useCase.Reject(ctx, published.ID.String(), "")
+	assert.EqualError(t, err, "cannot reject a post with status published")
+	mockPostRepo.AssertNotCalled(t, "Transition", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPostUsecase_GetByID_Visibility(t *testing.T) {
+	ctx := context.Background()
+	mockPostRepo := new(MockPostRepository)
+	useCase := postUsecase.NewPostUsecase(mockPostRepo, new(MockParameterRepository), new(MockParameterLookup), new(MockFileUsecase))
+
+	authorID := uuid.New()
+	draft := &models.Post{ID: uuid.New(), CreatedBy: authorID, Status: constants.PostStatusDraft}
+	mockPostRepo.On("GetByID", ctx, draft.ID).Return(draft, nil)
+
+	// drafts are hidden from the public detail
+	_, err := useCase.GetByID(ctx, draft.ID.String())
+	assert.EqualError(t, err, "post with id "+draft.ID.String()+" not found")
+
+	// but visible to their author and to editors
+	res, err := useCase.GetManagedByID(ctx, draft.ID.String(), authorID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, draft.ID, res.ID)
+	_, err = useCase.GetManagedByID(ctx, draft.ID.String(), uuid.New().String())
+	assert.Error(t, err)
+	_, err = useCase.GetManagedByID(ctx, draft.ID.String(), "")
+	assert.NoError(t, err)
+}
+
+func TestPostUsecase_PublishScheduled(t *testing.T) {
+	ctx := context.Background()
+	mockPostRepo := new(MockPostRepository)
+	useCase := postUsecase.NewPostUsecase(mockPostRepo, new(MockParameterRepository), new(MockParameterLookup), new(MockFileUsecase))
+
+	mockPostRepo.On("PublishScheduled", ctx, mock.AnythingOfType("time.Time")).Return(int64(3), nil).Once()
+	res, err := useCase.PublishScheduled(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), res.Published)
+	mockPostRepo.AssertExpectations(t)
+}
@@ -10,10 +10,26 @@ import (
 
 type Usecase interface {
 	Create(ctx context.Context, req *dto.ReqCreatePost, authId string, thumbnailData []byte, thumbnailName string) (*models.Post, error)
-	Update(ctx context.Context, id string, req *dto.ReqUpdatePost, authId string, thumbnailData []byte, thumbnailName string) (*models.Post, error)
+	Update(ctx context.Context, id string, req *dto.ReqUpdatePost, authId string, editor bool, thumbnailData []byte, thumbnailName string) (*models.Post, error)
 	Delete(ctx context.Context, id string, authId string) error
 	GetByID(ctx context.Context, id string) (*models.Post, error)
 	GetParameterReferences(ctx context.Context, id string) (*dto.ReferenceObject, *dto.ReferenceObject, []dto.ReferenceObject, error)
 	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqPostIndexFilter) ([]models.Post, int, error)
 	GetAll(ctx context.Context, filter dto.ReqPostIndexFilter) ([]models.Post, error)
+
+	// Publishing workflow
+	GetManagedIndex(ctx context.Context, req request.PageRequest, filter dto.ReqPostIndexFilter, authorID string) ([]models.Post, int, error)
+	GetManagedByID(ctx context.Context, id string, authorID string) (*models.Post, error)
+	Submit(ctx context.Context, id string, authId string) (*models.Post, error)
+	Reject(ctx context.Context, id string, authId string) (*models.Post, error)
+	Publish(ctx context.Context, id string, req *dto.ReqPublishPost, authId string) (*models.Post, error)
+	Unpublish(ctx context.Context, id string, authId string) (*models.Post, error)
+	Archive(ctx context.Context, id string, authId string) (*models.Post, error)
+	PublishScheduled(ctx context.Context) (*dto.RespPublishScheduledPosts, error)
+
+	// Revisions
+	GetRevisionIndex(ctx context.Context, id string, req request.PageRequest, authId string, editor bool) ([]models.PostRevision, int, error)
+	GetRevisionByID(ctx context.Context, id string, revisionID string, authId string, editor bool) (*models.PostRevision, error)
+	DiffRevisions(ctx context.Context, id string, req dto.ReqPostRevisionDiff, authId string, editor bool) (*dto.RespPostRevisionDiff, error)
+	RestoreRevision(ctx context.Context, id string, revisionID string, authId string, editor bool) (*models.Post, error)
 }
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/rendyfutsuy/base-go/constants"
@@ -17,13 +18,14 @@ import (
 )
 
 type postUsecase struct {
-	repo      post.Repository
-	paramRepo paramMod.Repository
-	fileUC    fileUsecase.Usecase
+	repo        post.Repository
+	paramRepo   paramMod.Repository
+	paramLookup paramMod.Lookup
+	fileUC      fileUsecase.Usecase
 }
 
-func NewPostUsecase(repo post.Repository, paramRepo paramMod.Repository, fileUC fileUsecase.Usecase) post.Usecase {
-	return &postUsecase{repo: repo, paramRepo: paramRepo, fileUC: fileUC}
+func NewPostUsecase(repo post.Repository, paramRepo paramMod.Repository, paramLookup paramMod.Lookup, fileUC fileUsecase.Usecase) post.Usecase {
+	return &postUsecase{repo: repo, paramRepo: paramRepo, paramLookup: paramLookup, fileUC: fileUC}
 }
 
 func (u *postUsecase) Create(ctx context.Context, req *dto.ReqCreatePost, authId string, thumbnailData []byte, thumbnailName string) (*models.Post, error) {
@@ -103,10 +105,22 @@ func (u *postUsecase) Create(ctx context.Context, req *dto.ReqCreatePost, authId
 			return nil, err
 		}
 	}
+
+	// First revision of the history
+	if err := u.recordRevision(ctx, c.ID, constants.PostRevisionActionCreate, nil, authId); err != nil {
+		return nil, err
+	}
 	return c, nil
 }
 
-func (u *postUsecase) Update(ctx context.Context, id string, req *dto.ReqUpdatePost, authId string, thumbnailData []byte, thumbnailName string) (*models.Post, error) {
+// Update changes the content of a post. Editors change any post, authors only their own:
+// a published or in review post of an author goes back to draft first.
+func (u *postUsecase) Update(ctx context.Context, id string, req *dto.ReqUpdatePost, authId string, editor bool, thumbnailData []byte, thumbnailName string) (*models.Post, error) {
+	current, err := u.getEditablePost(ctx, id, authId, editor)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate parameter types
 	if err := u.validateParameterType(ctx, req.LangID, "lang"); err != nil {
 		return nil, err
@@ -117,11 +131,6 @@ func (u *postUsecase) Update(ctx context.Context, id string, req *dto.ReqUpdateP
 		}
 	}
 
-	cid, err := utils.StringToUUID(id)
-	if err != nil {
-		return nil, err
-	}
-
 	// Upload thumbnail via file module first if provided
 	var uploadedURL *string
 	var uploadedFile *models.File
@@ -143,7 +152,13 @@ func (u *postUsecase) Update(ctx context.Context, id string, req *dto.ReqUpdateP
 		}
 	}
 
-	c, err := u.repo.Update(ctx, cid, dto.ToDBPost{
+	if !editor {
+		if err := u.redraft(ctx, current); err != nil {
+			return nil, err
+		}
+	}
+
+	c, err := u.repo.Update(ctx, current.ID, dto.ToDBPost{
 		Title:            req.Title,
 		Description:      req.Description,
 		ShortDescription: req.ShortDescription,
@@ -198,6 +213,10 @@ func (u *postUsecase) Update(ctx context.Context, id string, req *dto.ReqUpdateP
 			return nil, err
 		}
 	}
+
+	if err := u.recordRevision(ctx, c.ID, constants.PostRevisionActionUpdate, nil, authId); err != nil {
+		return nil, err
+	}
 	return c, nil
 }
 
@@ -206,15 +225,19 @@ func (u *postUsecase) Delete(ctx context.Context, id string, authId string) erro
 	if err != nil {
 		return err
 	}
-	return u.repo.Delete(ctx, cid)
+	return u.repo.Delete(ctx, cid, authId)
 }
 
+// GetByID returns a published post, the other statuses are only visible through GetManagedByID
 func (u *postUsecase) GetByID(ctx context.Context, id string) (*models.Post, error) {
-	cid, err := utils.StringToUUID(id)
+	c, err := u.getPost(ctx, id)
 	if err != nil {
 		return nil, err
 	}
-	return u.repo.GetByID(ctx, cid)
+	if c.Status != constants.PostStatusPublished {
+		return nil, fmt.Errorf(constants.PostNotFound, id)
+	}
+	return c, nil
 }
 
 func (u *postUsecase) GetParameterReferences(ctx context.Context, id string) (*dto.ReferenceObject, *dto.ReferenceObject, []dto.ReferenceObject, error) {
@@ -242,16 +265,19 @@ func (u *postUsecase) GetParameterReferences(ctx context.Context, id string) (*d
 	}
 	return level, lang, topics, nil
 }
+
+// GetIndex lists the published posts
 func (u *postUsecase) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqPostIndexFilter) ([]models.Post, int, error) {
-	return u.repo.GetIndex(ctx, req, filter)
+	return u.repo.GetIndex(ctx, req, filter, dto.PostVisibility{PublishedOnly: true})
 }
 
 func (u *postUsecase) GetAll(ctx context.Context, filter dto.ReqPostIndexFilter) ([]models.Post, error) {
-	return u.repo.GetAll(ctx, filter)
+	return u.repo.GetAll(ctx, filter, dto.PostVisibility{PublishedOnly: true})
 }
 
 func (u *postUsecase) validateParameterType(ctx context.Context, id uuid.UUID, expectedType string) error {
-	p, err := u.paramRepo.GetByID(ctx, id)
+	// read through the lookup cache, lang and topic are checked on every create and update
+	p, err := u.paramLookup.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	fileDto "github.com/rendyfutsuy/base-go/modules/file/dto"
+	"github.com/rendyfutsuy/base-go/modules/post/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"gorm.io/gorm"
+)
+
+// GetRevisionIndex lists the revisions of a post in any status, newest first.
+// The revisions follow the rules of Update: editors see those of any post, authors those of their own.
+func (u *postUsecase) GetRevisionIndex(ctx context.Context, id string, req request.PageRequest, authId string, editor bool) ([]models.PostRevision, int, error) {
+	c, err := u.getEditablePost(ctx, id, authId, editor)
+	if err != nil {
+		return nil, 0, err
+	}
+	return u.repo.GetRevisionIndex(ctx, c.ID, req)
+}
+
+// GetRevisionByID returns the full snapshot of a revision of the post
+func (u *postUsecase) GetRevisionByID(ctx context.Context, id string, revisionID string, authId string, editor bool) (*models.PostRevision, error) {
+	c, err := u.getEditablePost(ctx, id, authId, editor)
+	if err != nil {
+		return nil, err
+	}
+	return u.getRevision(ctx, c.ID, revisionID)
+}
+
+// DiffRevisions returns the fields whose value differs between two revisions of the post
+func (u *postUsecase) DiffRevisions(ctx context.Context, id string, req dto.ReqPostRevisionDiff, authId string, editor bool) (*dto.RespPostRevisionDiff, error) {
+	c, err := u.getEditablePost(ctx, id, authId, editor)
+	if err != nil {
+		return nil, err
+	}
+	from, err := u.getRevision(ctx, c.ID, req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := u.getRevision(ctx, c.ID, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	modified := make(map[string][2]interface{})
+	utils.MapChanges(revisionSnapshot(*from), revisionSnapshot(*to), "", modified)
+
+	changes := make([]dto.RespPostRevisionChange, 0, len(modified))
+	for field, values := range modified {
+		changes = append(changes, dto.RespPostRevisionChange{Field: field, From: values[0], To: values[1]})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+
+	return &dto.RespPostRevisionDiff{
+		From:    dto.ToRespPostRevisionIndex(*from),
+		To:      dto.ToRespPostRevisionIndex(*to),
+		Changes: changes,
+	}, nil
+}
+
+// RestoreRevision writes the snapshot of a revision back to the post: content, lang, topics and thumbnail.
+// The restore is recorded as a new revision, so it can be rolled back as well.
+// As for Update, a published or in review post of an author goes back to draft first.
+func (u *postUsecase) RestoreRevision(ctx context.Context, id string, revisionID string, authId string, editor bool) (*models.Post, error) {
+	c, err := u.getEditablePost(ctx, id, authId, editor)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := u.getRevision(ctx, c.ID, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The parameters may have been deleted or retyped since the snapshot
+	topicIDs := make([]uuid.UUID, 0, len(rev.TopicIDs.Strings))
+	for _, tid := range rev.TopicIDs.Strings {
+		topicID, err := utils.StringToUUID(tid)
+		if err != nil {
+			return nil, err
+		}
+		if err := u.validateParameterType(ctx, topicID, "topic"); err != nil {
+			return nil, err
+		}
+		topicIDs = append(topicIDs, topicID)
+	}
+	if rev.LangID != nil {
+		if err := u.validateParameterType(ctx, *rev.LangID, "lang"); err != nil {
+			return nil, err
+		}
+	}
+
+	if !editor {
+		if err := u.redraft(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+
+	restored, err := u.repo.Update(ctx, c.ID, dto.ToDBPost{
+		Title:            rev.Title,
+		Description:      rev.Description,
+		ShortDescription: rev.ShortDescription,
+		RemoveThumbnail:  rev.ThumbnailFileID == nil,
+		TopicIDs:         topicIDs,
+		ThumbnailURL:     rev.ThumbnailURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Re-assign the thumbnail file of the snapshot, the file is kept when a thumbnail is replaced
+	_ = u.fileUC.UnassignFiles(ctx, fileDto.UnassignFilesFromModule{
+		ModuleID:   c.ID,
+		ModuleType: constants.ModuleTypePost,
+	})
+	if rev.ThumbnailFileID != nil {
+		typ := constants.FileTypeThumbnail
+		_ = u.fileUC.AssignFiles(ctx, fileDto.AssignFilesToModule{
+			ModuleID:   c.ID,
+			ModuleType: constants.ModuleTypePost,
+			Items: []fileDto.AssignFileItem{
+				{FileID: *rev.ThumbnailFileID, Type: &typ},
+			},
+		})
+	}
+
+	if err := u.paramRepo.RemoveParametersFromModule(ctx, constants.ModuleTypePost, c.ID); err != nil {
+		return nil, err
+	}
+	if rev.LangID != nil {
+		if err := u.paramRepo.AssignParametersToModule(ctx, constants.ModuleTypePost, c.ID, []uuid.UUID{*rev.LangID}); err != nil {
+			return nil, err
+		}
+	}
+	if len(topicIDs) > 0 {
+		if err := u.paramRepo.AssignParametersToModule(ctx, constants.ModuleTypePost, c.ID, topicIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := u.recordRevision(ctx, c.ID, constants.PostRevisionActionRestore, &rev.ID, authId); err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
+
+// getEditablePost returns a post the user may change, see authorizeEdit
+func (u *postUsecase) getEditablePost(ctx context.Context, id string, authId string, editor bool) (*models.Post, error) {
+	c, err := u.getPost(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeEdit(c, authId, editor); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// recordRevision snapshots the post once all its relations are written
+func (u *postUsecase) recordRevision(ctx context.Context, postID uuid.UUID, action string, restoredFrom *uuid.UUID, authId string) error {
+	var editedBy *uuid.UUID
+	if editorID, err := utils.StringToUUID(authId); err == nil {
+		editedBy = &editorID
+	}
+	_, err := u.repo.CreateRevision(ctx, postID, dto.ToDBPostRevision{
+		Action:       action,
+		RestoredFrom: restoredFrom,
+		EditedBy:     editedBy,
+	})
+	return err
+}
+
+// getRevision returns a revision of the post, PostRevisionNotFound when it does not exist or belongs to another post
+func (u *postUsecase) getRevision(ctx context.Context, postID uuid.UUID, revisionID string) (*models.PostRevision, error) {
+	rid, err := utils.StringToUUID(revisionID)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := u.repo.GetRevisionByID(ctx, postID, rid)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.PostRevisionNotFound, revisionID)
+		}
+		return nil, err
+	}
+	return rev, nil
+}
+
+// revisionSnapshot is the comparable content of a revision, keyed by the field names of the post
+func revisionSnapshot(rev models.PostRevision) map[string]interface{} {
+	var langID, thumbnailURL interface{}
+	if rev.LangID != nil {
+		langID = rev.LangID.String()
+	}
+	if rev.ThumbnailURL != nil {
+		thumbnailURL = *rev.ThumbnailURL
+	}
+	// compared as a whole field: the ids are stored sorted, so a reordering is no change
+	topicIDs := rev.TopicIDs.Strings
+	if topicIDs == nil {
+		topicIDs = []string{}
+	}
+	return map[string]interface{}{
+		"title":             rev.Title,
+		"description":       rev.Description,
+		"short_description": rev.ShortDescription,
+		"lang_id":           langID,
+		"topic_ids":         topicIDs,
+		"thumbnail_url":     thumbnailURL,
+	}
+}
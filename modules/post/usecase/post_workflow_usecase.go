@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/post/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"gorm.io/gorm"
+)
+
+// PublishIntervalFromConfig returns how often the scheduled posts are published, 0 disables the scheduler
+func PublishIntervalFromConfig() time.Duration {
+	key := "post.publish_interval_minutes"
+	if utils.ConfigVars == nil || !utils.ConfigVars.Exists(key) {
+		return time.Duration(constants.PostPublishIntervalMinutesDefault) * time.Minute
+	}
+	return time.Duration(utils.ConfigVars.Int(key)) * time.Minute
+}
+
+// GetManagedIndex lists the posts in every status, of one author when authorID is set (the drafts of an author)
+// or of every author for editors
+func (u *postUsecase) GetManagedIndex(ctx context.Context, req request.PageRequest, filter dto.ReqPostIndexFilter, authorID string) ([]models.Post, int, error) {
+	for _, status := range filter.Statuses {
+		if !isPostStatus(status) {
+			return nil, 0, fmt.Errorf(constants.PostStatusInvalid, strings.Join(constants.PostStatuses, ", "))
+		}
+	}
+	visibility := dto.PostVisibility{}
+	if authorID != "" {
+		aid, err := utils.StringToUUID(authorID)
+		if err != nil {
+			return nil, 0, err
+		}
+		visibility.AuthorID = aid
+	}
+	return u.repo.GetIndex(ctx, req, filter, visibility)
+}
+
+// GetManagedByID returns a post in any status, when authorID is set only a post of that author
+func (u *postUsecase) GetManagedByID(ctx context.Context, id string, authorID string) (*models.Post, error) {
+	c, err := u.getPost(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if authorID != "" && c.CreatedBy.String() != authorID {
+		return nil, fmt.Errorf(constants.PostNotFound, id)
+	}
+	return c, nil
+}
+
+// Submit sends a draft of the author for review
+func (u *postUsecase) Submit(ctx context.Context, id string, authId string) (*models.Post, error) {
+	return u.transition(ctx, id, constants.PostActionSubmit, func(current *models.Post) (dto.ToDBPostStatus, error) {
+		if authId == "" || current.CreatedBy.String() != authId {
+			return dto.ToDBPostStatus{}, errors.New(constants.PostSubmitNotAuthor)
+		}
+		return keepPublication(current, constants.PostStatusReview), nil
+	})
+}
+
+// Reject sends a post in review back to draft, cancelling its schedule
+func (u *postUsecase) Reject(ctx context.Context, id string, authId string) (*models.Post, error) {
+	return u.transition(ctx, id, constants.PostActionReject, func(current *models.Post) (dto.ToDBPostStatus, error) {
+		return keepPublication(current, constants.PostStatusDraft), nil
+	})
+}
+
+// Publish publishes a draft or a post in review now, or schedules it when publish_at is in the future:
+// the post stays in review until the scheduler publishes it
+func (u *postUsecase) Publish(ctx context.Context, id string, req *dto.ReqPublishPost, authId string) (*models.Post, error) {
+	return u.transition(ctx, id, constants.PostActionPublish, func(current *models.Post) (dto.ToDBPostStatus, error) {
+		var publishedBy *uuid.UUID
+		if editorID, err := utils.StringToUUID(authId); err == nil {
+			publishedBy = &editorID
+		}
+		now := time.Now().UTC()
+		if req != nil && req.PublishAt != nil && req.PublishAt.After(now) {
+			publishAt := req.PublishAt.UTC()
+			return dto.ToDBPostStatus{
+				Status:      constants.PostStatusReview,
+				PublishAt:   &publishAt,
+				PublishedAt: current.PublishedAt,
+				PublishedBy: publishedBy,
+			}, nil
+		}
+		return dto.ToDBPostStatus{
+			Status:      constants.PostStatusPublished,
+			PublishedAt: &now,
+			PublishedBy: publishedBy,
+		}, nil
+	})
+}
+
+// Unpublish takes a published or archived post back to draft
+func (u *postUsecase) Unpublish(ctx context.Context, id string, authId string) (*models.Post, error) {
+	return u.transition(ctx, id, constants.PostActionUnpublish, func(current *models.Post) (dto.ToDBPostStatus, error) {
+		return keepPublication(current, constants.PostStatusDraft), nil
+	})
+}
+
+// Archive removes a post from the public index without deleting it, cancelling its schedule
+func (u *postUsecase) Archive(ctx context.Context, id string, authId string) (*models.Post, error) {
+	return u.transition(ctx, id, constants.PostActionArchive, func(current *models.Post) (dto.ToDBPostStatus, error) {
+		return keepPublication(current, constants.PostStatusArchived), nil
+	})
+}
+
+// PublishScheduled publishes the posts whose publish_at has passed, called by the background scheduler
+func (u *postUsecase) PublishScheduled(ctx context.Context) (*dto.RespPublishScheduledPosts, error) {
+	published, err := u.repo.PublishScheduled(ctx, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	return &dto.RespPublishScheduledPosts{Published: published}, nil
+}
+
+// transition checks the action is allowed from the current status of the post and writes the new state,
+// guarded against a concurrent transition
+func (u *postUsecase) transition(ctx context.Context, id string, action string, next func(current *models.Post) (dto.ToDBPostStatus, error)) (*models.Post, error) {
+	current, err := u.getPost(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	from := constants.PostTransitions[action]
+	if !containsPostStatus(from, current.Status) {
+		return nil, fmt.Errorf(constants.PostTransitionNotAllowed, action, current.Status)
+	}
+	data, err := next(current)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.Transition(ctx, current.ID, []string{current.Status}, data)
+}
+
+// authorizeEdit checks the user may change the post: editors change any post, authors only their own
+func authorizeEdit(current *models.Post, authId string, editor bool) error {
+	if editor {
+		return nil
+	}
+	if authId == "" || current.CreatedBy.String() != authId {
+		return errors.New(constants.PostEditNotAuthor)
+	}
+	return nil
+}
+
+// redraft takes a published or in review post back to draft before its author changes it, cancelling its schedule
+func (u *postUsecase) redraft(ctx context.Context, current *models.Post) error {
+	if !containsPostStatus(constants.PostRedraftStatuses, current.Status) {
+		return nil
+	}
+	_, err := u.repo.Transition(ctx, current.ID, []string{current.Status}, keepPublication(current, constants.PostStatusDraft))
+	return err
+}
+
+// getPost returns a post in any status, PostNotFound when it does not exist
+func (u *postUsecase) getPost(ctx context.Context, id string) (*models.Post, error) {
+	cid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	c, err := u.repo.GetByID(ctx, cid)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.PostNotFound, id)
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+// keepPublication moves a post to a status without schedule, keeping when and by whom it was last published
+func keepPublication(current *models.Post, status string) dto.ToDBPostStatus {
+	return dto.ToDBPostStatus{
+		Status:      status,
+		PublishedAt: current.PublishedAt,
+		PublishedBy: current.PublishedBy,
+	}
+}
+
+func isPostStatus(status string) bool {
+	return containsPostStatus(constants.PostStatuses, status)
+}
+
+func containsPostStatus(statuses []string, status string) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
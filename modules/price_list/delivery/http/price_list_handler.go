@@ -0,0 +1,341 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/middleware"
+	_reqContext "github.com/rendyfutsuy/base-go/helpers/middleware/request"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/price_list"
+	"github.com/rendyfutsuy/base-go/modules/price_list/dto"
+)
+
+type ResponseError struct {
+	Message string `json:"message"`
+}
+
+type Response struct {
+	Message string `json:"message"`
+}
+
+type PriceListHandler struct {
+	Usecase              price_list.Usecase
+	validator            *validator.Validate
+	mwPageRequest        _reqContext.IMiddlewarePageRequest
+	middlewareAuth       middleware.IMiddlewareAuth
+	middlewarePermission middleware.IMiddlewarePermission
+}
+
+func NewPriceListHandler(e *echo.Echo, uc price_list.Usecase, mwP _reqContext.IMiddlewarePageRequest, auth middleware.IMiddlewareAuth, middlewarePermission middleware.IMiddlewarePermission) {
+	h := &PriceListHandler{Usecase: uc, validator: validator.New(), mwPageRequest: mwP, middlewareAuth: auth, middlewarePermission: middlewarePermission}
+
+	r := e.Group("/v1/price-list")
+	r.Use(h.middlewareAuth.AuthorizationCheck)
+
+	// Permissions
+	// View:   price-list.view
+	// Create: price-list.create
+	// Update: price-list.update
+	// Delete: price-list.delete
+	// Export: price-list.export
+	permissionToView := []string{"price-list.view"}
+	permissionToCreate := []string{"price-list.create"}
+	permissionToUpdate := []string{"price-list.update"}
+	permissionToDelete := []string{"price-list.delete"}
+	permissionToExport := []string{"price-list.export"}
+
+	// Index with pagination + search
+	r.GET("", h.GetIndex, middleware.RequireActivatedUser, h.mwPageRequest.PageRequestCtx, h.middlewarePermission.PermissionValidation(permissionToView))
+
+	// Export (no pagination, same filters) - must be before /:id to avoid route conflict
+	r.GET("/export", h.Export, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToExport))
+
+	// Price resolution - must be before /:id to avoid route conflict
+	r.GET("/resolve", h.Resolve, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+
+	// Price import template - must be before /:id to avoid route conflict
+	r.GET("/prices/import/template", h.DownloadPriceImportTemplate, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+
+	// Get by ID (detail) - must be after /export to avoid route conflict
+	r.GET("/:id", h.GetByID, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+
+	// Create
+	r.POST("", h.Create, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToCreate))
+
+	// Update
+	r.PUT("/:id", h.Update, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+
+	// Delete
+	r.DELETE("/:id", h.Delete, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToDelete))
+
+	// Prices
+	r.GET("/:id/prices", h.GetPrices, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+	r.GET("/:id/prices/export", h.ExportPrices, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToExport))
+	r.POST("/:id/prices/import", h.ImportPrices, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.GET("/:id/prices/:priceId", h.GetPriceByID, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+	r.POST("/:id/prices", h.CreatePrice, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.PUT("/:id/prices/:priceId", h.UpdatePrice, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.DELETE("/:id/prices/:priceId", h.DeletePrice, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+}
+
+// Create godoc
+// @Summary		Create a new price list
+// @Description	Create a named price list. customer_category_ids assigns the list to customer categories (parameters of type customer_category), a list without category applies to every customer. When several lists have a price the list of the customer category wins, then the highest priority. is_active defaults to true. Requires 'api.master-data.price-list.create' permission.
+// @Tags			Price List
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	body		dto.ReqCreatePriceList	true	"Price list creation data. Fields: code (required), name (required), description, priority, customer_category_ids (array), is_active"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespPriceList}	"Successfully created price list with its customer categories"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, duplicate code or invalid customer category"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/price-list [post]
+func (h *PriceListHandler) Create(c echo.Context) error {
+	req := new(dto.ReqCreatePriceList)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	res, err := h.Usecase.Create(c.Request().Context(), req, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	return h.respondDetail(c, res.ID.String())
+}
+
+// Update godoc
+// @Summary		Update price list
+// @Description	Update an existing price list. The code cannot be changed. The customer categories are replaced by the given ones. is_active keeps its value when omitted. Requires 'api.master-data.price-list.update' permission.
+// @Tags			Price List
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string					true	"Price list UUID"
+// @Param			request	body	dto.ReqUpdatePriceList	true	"Updated price list data. Fields: name (required), description, priority, customer_category_ids (array), is_active"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespPriceList}	"Successfully updated price list with its customer categories"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error or invalid customer category"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Failure		404		{object}	response.NonPaginationResponse	"Price list not found"
+// @Router			/v1/price-list/{id} [put]
+func (h *PriceListHandler) Update(c echo.Context) error {
+	id := c.Param("id")
+	req := new(dto.ReqUpdatePriceList)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if _, err := h.Usecase.Update(c.Request().Context(), id, req, authUserID(c)); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	return h.respondDetail(c, id)
+}
+
+// Delete godoc
+// @Summary		Soft delete price list
+// @Description	Soft delete an existing price list by ID. The price list will be marked as deleted (deleted_at is set) and its prices are no longer used by the price resolution. Requires 'api.master-data.price-list.delete' permission.
+// @Tags			Price List
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string	true	"Price list UUID"
+// @Success		200		{object}	response.NonPaginationResponse	"Successfully soft deleted price list"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid UUID"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Failure		404		{object}	response.NonPaginationResponse	"Price list not found"
+// @Router			/v1/price-list/{id} [delete]
+func (h *PriceListHandler) Delete(c echo.Context) error {
+	if err := h.Usecase.Delete(c.Request().Context(), c.Param("id"), authUserID(c)); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(Response{Message: constants.PriceListDeleteSuccess})
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetIndex godoc
+// @Summary		Get list of price lists with pagination
+// @Description	Retrieve a paginated list of price lists with optional search and filters. Supports multiple filter values for each field. Only returns non-deleted price lists. Requires 'api.master-data.price-list.view' permission.
+// @Tags			Price List
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			page					query		int			false	"Page number (default: 1)"
+// @Param			per_page				query		int			false	"Items per page (default: 10)"
+// @Param			sort_by					query		string		false	"Sort column (allowed: id, code, name, priority, is_active, created_at, updated_at)"
+// @Param			sort_order				query		string		false	"Sort order: asc or desc (default: desc)"
+// @Param			search					query		string		false	"Search keyword (searches in code and name)"
+// @Param			codes					query		[]string	false	"Filter by codes (multiple values)"
+// @Param			names					query		[]string	false	"Filter by names (multiple values)"
+// @Param			customer_category_ids	query		[]string	false	"Filter by customer category IDs (multiple values)"
+// @Param			is_active				query		[]bool		false	"Filter by active flag (multiple values)"
+// @Success		200						{object}	response.PaginationResponse{data=[]dto.RespPriceListIndex}	"Successfully retrieved price lists"
+// @Failure		400						{object}	response.NonPaginationResponse	"Bad request - invalid query parameters"
+// @Failure		401						{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403						{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/price-list [get]
+func (h *PriceListHandler) GetIndex(c echo.Context) error {
+	pageRequest := c.Get("page_request").(*request.PageRequest)
+
+	// validate filter req.
+	// initialize filter
+	filter := new(dto.ReqPriceListIndexFilter)
+
+	// Bind form-data to the DTO
+	if err := c.Bind(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// Validate the request if necessary
+	if err := c.Validate(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, total, err := h.Usecase.GetIndex(c.Request().Context(), *pageRequest, *filter)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	respPriceList := []dto.RespPriceListIndex{}
+	for _, v := range res {
+		respPriceList = append(respPriceList, dto.ToRespPriceListIndex(v))
+	}
+
+	respPag := response.PaginationResponse{}
+	respPag, err = respPag.SetResponse(respPriceList, total, pageRequest.PerPage, pageRequest.Page)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, respPag)
+}
+
+// GetByID godoc
+// @Summary		Get price list by ID
+// @Description	Retrieve a single price list by its UUID with its customer categories. Only returns non-deleted price lists. Requires 'api.master-data.price-list.view' permission.
+// @Tags			Price List
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string	true	"Price list UUID"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespPriceList}	"Successfully retrieved price list"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid UUID"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Failure		404		{object}	response.NonPaginationResponse	"Price list not found"
+// @Router			/v1/price-list/{id} [get]
+func (h *PriceListHandler) GetByID(c echo.Context) error {
+	return h.respondDetail(c, c.Param("id"))
+}
+
+// Export godoc
+// @Summary		Export price lists to Excel
+// @Description	Export price lists to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination. Excel file includes: Code, Name, Description, Priority, Active, Customer Categories (codes), Prices (number of prices), Update Date. The prices of a list are exported with /v1/price-list/{id}/prices/export. Requires 'api.master-data.price-list.export' permission.
+// @Tags			Price List
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Param			search					query		string		false	"Search keyword (searches in code and name)"
+// @Param			codes					query		[]string	false	"Filter by codes (multiple values)"
+// @Param			names					query		[]string	false	"Filter by names (multiple values)"
+// @Param			customer_category_ids	query		[]string	false	"Filter by customer category IDs (multiple values)"
+// @Param			is_active				query		[]bool		false	"Filter by active flag (multiple values)"
+// @Success		200						{file}		binary	"Excel file (price_lists.xlsx) with price lists data"
+// @Failure		400						{object}	response.NonPaginationResponse	"Bad request - invalid query parameters"
+// @Failure		401						{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403						{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/price-list/export [get]
+func (h *PriceListHandler) Export(c echo.Context) error {
+	// validate filter req.
+	// initialize filter
+	filter := new(dto.ReqPriceListIndexFilter)
+
+	// Bind form-data to the DTO
+	if err := c.Bind(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// Validate the request if necessary
+	if err := c.Validate(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	excelBytes, err := h.Usecase.Export(c.Request().Context(), *filter)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, constants.ExcelContent)
+	c.Response().Header().Set(constants.FieldContentDisposition, constants.ExcelContentDisposition("price_lists.xlsx"))
+	return c.Blob(http.StatusOK, constants.ExcelContent, excelBytes)
+}
+
+// Resolve godoc
+// @Summary		Resolve the price of an item
+// @Description	Return the price of a quantity of an item for a customer category on a date. Only active price lists with a price of the item unit effective on the date and a quantity break not above qty are used. A list assigned to the customer category wins over a list for every customer, lists of other categories never apply. Then the highest priority, the highest quantity break and the latest effective_from win. total_price is unit_price times qty rounded to cents. Requires 'api.master-data.price-list.view' permission.
+// @Tags			Price List
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			item_id					query		string	true	"Item UUID"
+// @Param			qty						query		number	true	"Quantity, in the unit"
+// @Param			uom						query		string	false	"Unit of measure (default: base unit of the item)"
+// @Param			customer_category_id	query		string	false	"Customer category UUID, only lists for every customer are used when empty"
+// @Param			date					query		string	false	"Date as YYYY-MM-DD (default: today)"
+// @Success		200						{object}	response.NonPaginationResponse{data=dto.RespPriceResolve}	"Successfully resolved price"
+// @Failure		400						{object}	response.NonPaginationResponse	"Bad request - invalid query parameters, item or unit not found or no price found"
+// @Failure		401						{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403						{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/price-list/resolve [get]
+func (h *PriceListHandler) Resolve(c echo.Context) error {
+	req := new(dto.ReqPriceResolve)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := h.Usecase.Resolve(c.Request().Context(), *req)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// respondDetail loads the price list with its customer categories and writes the detail response
+func (h *PriceListHandler) respondDetail(c echo.Context, id string) error {
+	res, err := h.Usecase.GetByID(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	categories, err := h.Usecase.GetCustomerCategories(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespPriceList(*res, categories))
+	return c.JSON(http.StatusOK, resp)
+}
+
+func authUserID(c echo.Context) string {
+	if userModel, ok := c.Get("user").(models.User); ok {
+		return userModel.ID.String()
+	}
+	return ""
+}
@@ -0,0 +1,330 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/modules/price_list/dto"
+	"github.com/xuri/excelize/v2"
+)
+
+// GetPrices godoc
+// @Summary		Get price list prices
+// @Description	Retrieve the prices of a price list: item, unit, quantity break, price and effective period, ordered by item code, unit, quantity break and start date. Requires 'api.master-data.price-list.view' permission.
+// @Tags			Price List - Price
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		string	true	"Price list UUID"
+// @Success		200	{object}	response.NonPaginationResponse{data=[]dto.RespPriceListPrice}	"Successfully retrieved prices"
+// @Failure		400	{object}	response.NonPaginationResponse	"Bad request - invalid UUID or price list not found"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403	{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/price-list/{id}/prices [get]
+func (h *PriceListHandler) GetPrices(c echo.Context) error {
+	res, err := h.Usecase.GetPrices(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	respPrices := []dto.RespPriceListPrice{}
+	for _, v := range res {
+		respPrices = append(respPrices, dto.ToRespPriceListPrice(v))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(respPrices)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetPriceByID godoc
+// @Summary		Get a price of a price list
+// @Description	Retrieve a single price of a price list. Requires 'api.master-data.price-list.view' permission.
+// @Tags			Price List - Price
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string	true	"Price list UUID"
+// @Param			priceId	path		string	true	"Price UUID"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespPriceListPrice}	"Successfully retrieved price"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid UUID, price list or price not found"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/price-list/{id}/prices/{priceId} [get]
+func (h *PriceListHandler) GetPriceByID(c echo.Context) error {
+	res, err := h.Usecase.GetPriceByID(c.Request().Context(), c.Param("id"), c.Param("priceId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespPriceListPrice(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// CreatePrice godoc
+// @Summary		Add a price to a price list
+// @Description	Add the price of an item unit from a quantity break during a period. uom defaults to the base unit of the item and must be the base unit or one of the units of the item, min_qty defaults to 1. effective_to is the last day of the period, empty for an open ended price. The period must not overlap another price of the same item, unit and quantity break in the list. Requires 'api.master-data.price-list.update' permission.
+// @Tags			Price List - Price
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string						true	"Price list UUID"
+// @Param			request	body		dto.ReqCreatePriceListPrice	true	"Price data. Fields: item_id (required), uom, min_qty, price (required), effective_from (required, YYYY-MM-DD), effective_to (YYYY-MM-DD)"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespPriceListPrice}	"Successfully created price"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, item or unit not found, invalid or overlapping period"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/price-list/{id}/prices [post]
+func (h *PriceListHandler) CreatePrice(c echo.Context) error {
+	req := new(dto.ReqCreatePriceListPrice)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	res, err := h.Usecase.CreatePrice(c.Request().Context(), c.Param("id"), req, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespPriceListPrice(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// UpdatePrice godoc
+// @Summary		Update a price of a price list
+// @Description	Update a price of a price list with the same rules as creation, the period must not overlap another price of the same item, unit and quantity break. Requires 'api.master-data.price-list.update' permission.
+// @Tags			Price List - Price
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string						true	"Price list UUID"
+// @Param			priceId	path		string						true	"Price UUID"
+// @Param			request	body		dto.ReqUpdatePriceListPrice	true	"Price data. Fields: item_id (required), uom, min_qty, price (required), effective_from (required, YYYY-MM-DD), effective_to (YYYY-MM-DD)"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespPriceListPrice}	"Successfully updated price"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, price not found, item or unit not found, invalid or overlapping period"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/price-list/{id}/prices/{priceId} [put]
+func (h *PriceListHandler) UpdatePrice(c echo.Context) error {
+	req := new(dto.ReqUpdatePriceListPrice)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	res, err := h.Usecase.UpdatePrice(c.Request().Context(), c.Param("id"), c.Param("priceId"), req, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespPriceListPrice(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DeletePrice godoc
+// @Summary		Delete a price of a price list
+// @Description	Soft delete a price of a price list. Requires 'api.master-data.price-list.update' permission.
+// @Tags			Price List - Price
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string	true	"Price list UUID"
+// @Param			priceId	path		string	true	"Price UUID"
+// @Success		200		{object}	response.NonPaginationResponse	"Successfully deleted price"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid UUID or price not found"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/price-list/{id}/prices/{priceId} [delete]
+func (h *PriceListHandler) DeletePrice(c echo.Context) error {
+	if err := h.Usecase.DeletePrice(c.Request().Context(), c.Param("id"), c.Param("priceId"), authUserID(c)); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(Response{Message: constants.PriceListPriceDeleteSuccess})
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ExportPrices godoc
+// @Summary		Export price list prices to Excel
+// @Description	Export the prices of a price list to Excel file (.xlsx). Excel file includes: Item Code, UoM, Min Qty, Price, Effective From, Effective To, Item Name, Update Date. The first six columns follow the import template so the file can be imported back. Requires 'api.master-data.price-list.export' permission.
+// @Tags			Price List - Price
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Param			id	path		string	true	"Price list UUID"
+// @Success		200	{file}		binary	"Excel file (price_list_prices.xlsx)"
+// @Failure		400	{object}	response.NonPaginationResponse	"Bad request - invalid UUID or price list not found"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403	{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/price-list/{id}/prices/export [get]
+func (h *PriceListHandler) ExportPrices(c echo.Context) error {
+	excelBytes, err := h.Usecase.ExportPrices(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, constants.ExcelContent)
+	c.Response().Header().Set(constants.FieldContentDisposition, constants.ExcelContentDisposition("price_list_prices.xlsx"))
+	return c.Blob(http.StatusOK, constants.ExcelContent, excelBytes)
+}
+
+// ImportPrices godoc
+// @Summary		Import price list prices from Excel file
+// @Description	Upsert the prices of a price list from an Excel file (.xlsx or .xls) with columns: item_code, uom (default base unit), min_qty (default 1), price, effective_from, effective_to (empty or "-" for open ended). Dates are written as YYYY-MM-DD. A row with the item, unit, quantity break and effective_from of an existing price updates it, periods must not overlap. Valid rows are imported even when other rows fail, failed rows are returned with HTTP 400. Requires 'api.master-data.price-list.update' permission.
+// @Tags			Price List - Price
+// @Accept			multipart/form-data
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string	true	"Price list UUID"
+// @Param			file	formData	file	true	"Excel file (.xlsx or .xls) with columns: item_code, uom, min_qty, price, effective_from, effective_to"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.ResImportPriceListPrices}	"Successfully imported all rows"
+// @Failure		400		{object}	response.NonPaginationResponse{data=dto.ResImportPriceListPrices}	"Bad request - one or more rows failed validation. Response contains details for each row including row number, item code, status, and error message"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500		{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/price-list/{id}/prices/import [post]
+func (h *PriceListHandler) ImportPrices(c echo.Context) error {
+	tempFilePath, status, err := savePriceListImportFile(c, "import_price_list_prices")
+	if err != nil {
+		return c.JSON(status, response.SetErrorResponse(status, err.Error()))
+	}
+	defer os.Remove(tempFilePath)
+
+	res, err := h.Usecase.ImportPricesFromExcel(c.Request().Context(), c.Param("id"), tempFilePath, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+
+	if res.FailedCount > 0 {
+		resp.Message = constants.PriceListImportFailedPartial
+		if res.SuccessCount == 0 {
+			resp.Message = constants.PriceListImportFailed
+		}
+		resp.Status = http.StatusBadRequest
+		return c.JSON(http.StatusBadRequest, resp)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DownloadPriceImportTemplate godoc
+// @Summary		Download price list price import Excel template
+// @Description	Download Excel template file for importing the prices of a price list. Template contains columns: item_code, uom, min_qty, price, effective_from, effective_to with example data.
+// @Tags			Price List - Price
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Success		200	{file}		file	"Excel template file"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500	{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/price-list/prices/import/template [get]
+func (h *PriceListHandler) DownloadPriceImportTemplate(c echo.Context) error {
+	// base unit price, a quantity break from 10 pcs, then a box price for the first half of the year
+	examples := [][]string{
+		{"SKU-0001", "pcs", "1", "1500", "2025-01-01", ""},
+		{"SKU-0001", "pcs", "10", "1400", "2025-01-01", ""},
+		{"SKU-0001", "box", "1", "16000", "2025-01-01", "2025-06-30"},
+	}
+	headers := []string{"Item Code", "UoM", "Min Qty", "Price", "Effective From", "Effective To"}
+	widths := []float64{15, 12, 12, 15, 18, 18}
+
+	f := excelize.NewFile()
+	defer f.Close()
+
+	sheetName := "Import Prices"
+	f.SetSheetName("Sheet1", sheetName)
+
+	for i, header := range headers {
+		col, _ := excelize.ColumnNumberToName(i + 1)
+		f.SetCellValue(sheetName, col+"1", header)
+		f.SetColWidth(sheetName, col, col, widths[i])
+	}
+
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{
+			Bold: true,
+			Size: 12,
+		},
+		Fill: excelize.Fill{
+			Type:    "pattern",
+			Color:   []string{"#E8E8E8"},
+			Pattern: 1,
+		},
+		Alignment: &excelize.Alignment{
+			Horizontal: "center",
+			Vertical:   "center",
+		},
+	})
+	if err == nil {
+		lastCol, _ := excelize.ColumnNumberToName(len(headers))
+		f.SetCellStyle(sheetName, "A1", lastCol+"1", headerStyle)
+	}
+
+	// codes and dates are text so Excel keeps them as written
+	for i, example := range examples {
+		for j, value := range example {
+			cell, _ := excelize.CoordinatesToCellName(j+1, i+2)
+			f.SetCellStr(sheetName, cell, value)
+		}
+	}
+
+	c.Response().Header().Set(constants.FieldContentType, constants.ExcelContent)
+	c.Response().Header().Set(constants.FieldContentDisposition, constants.ExcelContentDisposition("price_list_price_import_template.xlsx"))
+
+	if err := f.Write(c.Response().Writer); err != nil {
+		return c.JSON(http.StatusInternalServerError, response.SetErrorResponse(http.StatusInternalServerError, fmt.Sprintf("%s: %v", constants.PriceListImportTemplateCreateFailed, err)))
+	}
+
+	return nil
+}
+
+// savePriceListImportFile copies the uploaded "file" field to a temporary file keeping its extension,
+// the caller removes the file. The returned status is the one to answer with on error.
+func savePriceListImportFile(c echo.Context, prefix string) (string, int, error) {
+	// Get uploaded file
+	file, err := c.FormFile("file")
+	if err != nil {
+		return "", http.StatusBadRequest, errors.New(constants.PriceListImportFileNotFound)
+	}
+
+	// Validate file extension
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+	if ext != ".xlsx" && ext != ".xls" {
+		return "", http.StatusBadRequest, errors.New(constants.PriceListImportInvalidFileFormat)
+	}
+
+	// Open uploaded file
+	src, err := file.Open()
+	if err != nil {
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.PriceListImportFileOpenFailed, err)
+	}
+	defer src.Close()
+
+	tempFilePath := filepath.Join(os.TempDir(), fmt.Sprintf("%s_%d%s", prefix, time.Now().UnixNano(), ext))
+	dst, err := os.Create(tempFilePath)
+	if err != nil {
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.PriceListImportFileOpenFailed, err)
+	}
+	defer dst.Close()
+
+	if _, err = io.Copy(dst, src); err != nil {
+		os.Remove(tempFilePath)
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.PriceListImportFileOpenFailed, err)
+	}
+
+	return tempFilePath, http.StatusOK, nil
+}
@@ -0,0 +1,112 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/models"
+)
+
+type ReqCreatePriceList struct {
+	Code                string      `json:"code" validate:"required,max=255"`
+	Name                string      `json:"name" validate:"required,max=255"`
+	Description         *string     `json:"description"`
+	Priority            int         `json:"priority" validate:"gte=0"`
+	CustomerCategoryIDs []uuid.UUID `json:"customer_category_ids" validate:"omitempty,dive,required"` // empty for a list that applies to every customer
+	IsActive            *bool       `json:"is_active"`
+}
+
+type ReqUpdatePriceList struct {
+	Name                string      `json:"name" validate:"required,max=255"`
+	Description         *string     `json:"description"`
+	Priority            int         `json:"priority" validate:"gte=0"`
+	CustomerCategoryIDs []uuid.UUID `json:"customer_category_ids" validate:"omitempty,dive,required"`
+	IsActive            *bool       `json:"is_active"`
+}
+
+// RespPriceListCategory is a customer category the price list applies to
+type RespPriceListCategory struct {
+	ID   uuid.UUID `json:"id"`
+	Code string    `json:"code"`
+	Name string    `json:"name"`
+}
+
+type RespPriceList struct {
+	ID                 uuid.UUID               `json:"id"`
+	Code               string                  `json:"code"`
+	Name               string                  `json:"name"`
+	Description        *string                 `json:"description"`
+	Priority           int                     `json:"priority"`
+	IsActive           bool                    `json:"is_active"`
+	CustomerCategories []RespPriceListCategory `json:"customer_categories"`
+	CreatedAt          string                  `json:"created_at"`
+	CreatedBy          string                  `json:"created_by"`
+	UpdatedAt          string                  `json:"updated_at"`
+	UpdatedBy          string                  `json:"updated_by"`
+}
+
+func ToRespPriceList(m models.PriceList, categories []models.Parameter) RespPriceList {
+	respCategories := []RespPriceListCategory{}
+	for _, category := range categories {
+		respCategories = append(respCategories, RespPriceListCategory{ID: category.ID, Code: category.Code, Name: category.Name})
+	}
+
+	return RespPriceList{
+		ID:                 m.ID,
+		Code:               m.Code,
+		Name:               m.Name,
+		Description:        m.Description,
+		Priority:           m.Priority,
+		IsActive:           m.IsActive,
+		CustomerCategories: respCategories,
+		CreatedAt:          m.CreatedAt.Format("2006-01-02 15:04:05"),
+		CreatedBy:          m.CreatedBy,
+		UpdatedAt:          m.UpdatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedBy:          m.UpdatedBy,
+	}
+}
+
+type RespPriceListIndex struct {
+	ID        uuid.UUID `json:"id"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	Priority  int       `json:"priority"`
+	IsActive  bool      `json:"is_active"`
+	CreatedAt string    `json:"created_at"`
+	UpdatedAt string    `json:"updated_at"`
+}
+
+func ToRespPriceListIndex(m models.PriceList) RespPriceListIndex {
+	return RespPriceListIndex{
+		ID:        m.ID,
+		Code:      m.Code,
+		Name:      m.Name,
+		Priority:  m.Priority,
+		IsActive:  m.IsActive,
+		CreatedAt: m.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt: m.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// ReqPriceListIndexFilter for filtering price list index and export
+type ReqPriceListIndexFilter struct {
+	Search              string   `query:"search" json:"search"` // Search keyword for filtering by code and name
+	Codes               []string `query:"codes" json:"codes"`
+	Names               []string `query:"names" json:"names"`
+	CustomerCategoryIDs []string `query:"customer_category_ids" json:"customer_category_ids" validate:"omitempty,dive,uuid"`
+	IsActive            []bool   `query:"is_active" json:"is_active"`
+	SortBy              string   `query:"sort_by" json:"sort_by"`
+	SortOrder           string   `query:"sort_order" json:"sort_order"`
+}
+
+// PriceListExport represents price list data for export with the codes of its customer categories
+type PriceListExport struct {
+	Code               string
+	Name               string
+	Description        *string
+	Priority           int
+	IsActive           bool
+	CustomerCategories []string
+	PriceCount         int
+	UpdatedAt          time.Time
+}
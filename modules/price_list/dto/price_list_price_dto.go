@@ -0,0 +1,123 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/models"
+)
+
+// ReqCreatePriceListPrice creates the price of an item unit from a minimum quantity during a period
+type ReqCreatePriceListPrice struct {
+	ItemID        uuid.UUID `json:"item_id" validate:"required"`
+	Uom           string    `json:"uom" validate:"omitempty,max=20"`   // defaults to the base unit of the item
+	MinQty        *float64  `json:"min_qty" validate:"omitempty,gt=0"` // defaults to 1
+	Price         float64   `json:"price" validate:"gte=0"`            // price of one unit
+	EffectiveFrom string    `json:"effective_from" validate:"required,datetime=2006-01-02"`
+	EffectiveTo   *string   `json:"effective_to" validate:"omitempty,datetime=2006-01-02"` // open ended when empty
+}
+
+type ReqUpdatePriceListPrice struct {
+	ItemID        uuid.UUID `json:"item_id" validate:"required"`
+	Uom           string    `json:"uom" validate:"omitempty,max=20"`
+	MinQty        *float64  `json:"min_qty" validate:"omitempty,gt=0"`
+	Price         float64   `json:"price" validate:"gte=0"`
+	EffectiveFrom string    `json:"effective_from" validate:"required,datetime=2006-01-02"`
+	EffectiveTo   *string   `json:"effective_to" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type RespPriceListPrice struct {
+	ID            uuid.UUID `json:"id"`
+	PriceListID   uuid.UUID `json:"price_list_id"`
+	ItemID        uuid.UUID `json:"item_id"`
+	ItemCode      string    `json:"item_code"`
+	ItemName      string    `json:"item_name"`
+	Uom           string    `json:"uom"`
+	MinQty        float64   `json:"min_qty"`
+	Price         float64   `json:"price"`
+	EffectiveFrom string    `json:"effective_from"`
+	EffectiveTo   *string   `json:"effective_to"`
+	CreatedAt     string    `json:"created_at"`
+	UpdatedAt     string    `json:"updated_at"`
+}
+
+func ToRespPriceListPrice(m models.PriceListItem) RespPriceListPrice {
+	var effectiveTo *string
+	if m.EffectiveTo != nil {
+		value := m.EffectiveTo.Format("2006-01-02")
+		effectiveTo = &value
+	}
+
+	return RespPriceListPrice{
+		ID:            m.ID,
+		PriceListID:   m.PriceListID,
+		ItemID:        m.ItemID,
+		ItemCode:      m.ItemCode,
+		ItemName:      m.ItemName,
+		Uom:           m.Uom,
+		MinQty:        m.MinQty,
+		Price:         m.Price,
+		EffectiveFrom: m.EffectiveFrom.Format("2006-01-02"),
+		EffectiveTo:   effectiveTo,
+		CreatedAt:     m.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:     m.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// PriceListItemUnits is an item with the units it can be priced in, the base unit first
+type PriceListItemUnits struct {
+	ItemID   uuid.UUID
+	ItemCode string
+	ItemName string
+	Uoms     []string
+}
+
+// ReqPriceResolve asks the price of a quantity of an item for a customer category on a date
+type ReqPriceResolve struct {
+	ItemID             string  `query:"item_id" validate:"required,uuid"`
+	Qty                float64 `query:"qty" validate:"required,gt=0"`
+	Uom                string  `query:"uom" validate:"omitempty,max=20"`                // defaults to the base unit of the item
+	CustomerCategoryID string  `query:"customer_category_id" validate:"omitempty,uuid"` // only general price lists are used when empty
+	Date               string  `query:"date" validate:"omitempty,datetime=2006-01-02"`  // defaults to today
+}
+
+// PriceResolveCandidate is the scan target of the price resolution query
+type PriceResolveCandidate struct {
+	PriceListItemID uuid.UUID  `gorm:"column:price_list_item_id"`
+	PriceListID     uuid.UUID  `gorm:"column:price_list_id"`
+	PriceListCode   string     `gorm:"column:price_list_code"`
+	PriceListName   string     `gorm:"column:price_list_name"`
+	Priority        int        `gorm:"column:priority"`
+	CategoryMatched bool       `gorm:"column:category_matched"`
+	Uom             string     `gorm:"column:uom"`
+	MinQty          float64    `gorm:"column:min_qty"`
+	Price           string     `gorm:"column:price"` // NUMERIC as text, read exactly
+	EffectiveFrom   time.Time  `gorm:"column:effective_from"`
+	EffectiveTo     *time.Time `gorm:"column:effective_to"`
+}
+
+// RespPriceListReference is the price list a resolved price comes from
+type RespPriceListReference struct {
+	ID       uuid.UUID `json:"id"`
+	Code     string    `json:"code"`
+	Name     string    `json:"name"`
+	Priority int       `json:"priority"`
+}
+
+type RespPriceResolve struct {
+	ItemID             uuid.UUID              `json:"item_id"`
+	ItemCode           string                 `json:"item_code"`
+	ItemName           string                 `json:"item_name"`
+	Uom                string                 `json:"uom"`
+	Qty                float64                `json:"qty"`
+	Date               string                 `json:"date"`
+	CustomerCategoryID *uuid.UUID             `json:"customer_category_id"`
+	PriceList          RespPriceListReference `json:"price_list"`
+	PriceListItemID    uuid.UUID              `json:"price_list_item_id"`
+	CategoryPrice      bool                   `json:"category_price"` // false when the price comes from a list for every customer
+	MinQty             float64                `json:"min_qty"`
+	EffectiveFrom      string                 `json:"effective_from"`
+	EffectiveTo        *string                `json:"effective_to"`
+	UnitPrice          float64                `json:"unit_price"`
+	TotalPrice         float64                `json:"total_price"`
+}
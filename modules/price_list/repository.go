@@ -0,0 +1,97 @@
+package price_list
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/price_list/dto"
+)
+
+// CreatePriceListParams contains parameters for creating a price list
+type CreatePriceListParams struct {
+	Code        string
+	Name        string
+	Description *string
+	Priority    int
+	IsActive    bool
+	CreatedBy   string
+}
+
+// UpdatePriceListParams contains parameters for updating a price list
+type UpdatePriceListParams struct {
+	Name        string
+	Description *string
+	Priority    int
+	IsActive    bool
+	UpdatedBy   string
+}
+
+// CreatePriceListPriceParams contains parameters for creating a price, the Excel import upserts with it too
+type CreatePriceListPriceParams struct {
+	PriceListID   uuid.UUID
+	ItemID        uuid.UUID
+	Uom           string
+	MinQty        float64
+	Price         float64
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+	CreatedBy     string
+}
+
+// UpdatePriceListPriceParams contains parameters for updating a price
+type UpdatePriceListPriceParams struct {
+	ItemID        uuid.UUID
+	Uom           string
+	MinQty        float64
+	Price         float64
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+	UpdatedBy     string
+}
+
+// PriceOverlapParams describes the period of a price, it overlaps another price of the same item, unit and quantity break
+type PriceOverlapParams struct {
+	PriceListID   uuid.UUID
+	ItemID        uuid.UUID
+	Uom           string
+	MinQty        float64
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+	ExcludeID     uuid.UUID
+}
+
+// PriceResolveParams contains the parameters of a price resolution
+type PriceResolveParams struct {
+	ItemID             uuid.UUID
+	Uom                string
+	Qty                float64
+	CustomerCategoryID *uuid.UUID
+	Date               time.Time
+}
+
+type Repository interface {
+	Create(ctx context.Context, params CreatePriceListParams) (*models.PriceList, error)
+	Update(ctx context.Context, id uuid.UUID, params UpdatePriceListParams) (*models.PriceList, error)
+	Delete(ctx context.Context, id uuid.UUID, deletedBy string) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.PriceList, error)
+	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqPriceListIndexFilter) ([]models.PriceList, int, error)
+	GetAllForExport(ctx context.Context, filter dto.ReqPriceListIndexFilter) ([]dto.PriceListExport, error)
+	ExistsByCode(ctx context.Context, code string) (bool, error)
+
+	// Prices
+	CreatePrice(ctx context.Context, params CreatePriceListPriceParams) (*models.PriceListItem, error)
+	UpdatePrice(ctx context.Context, priceListID uuid.UUID, priceID uuid.UUID, params UpdatePriceListPriceParams) (*models.PriceListItem, error)
+	UpsertPrices(ctx context.Context, params []CreatePriceListPriceParams) error
+	DeletePrice(ctx context.Context, priceListID uuid.UUID, priceID uuid.UUID, deletedBy string) error
+	GetPriceByID(ctx context.Context, priceListID uuid.UUID, priceID uuid.UUID) (*models.PriceListItem, error)
+	GetPricesByPriceListID(ctx context.Context, priceListID uuid.UUID) ([]models.PriceListItem, error)
+	ExistsOverlappingPrice(ctx context.Context, params PriceOverlapParams) (bool, error)
+	GetItemUnits(ctx context.Context, itemID uuid.UUID) (*dto.PriceListItemUnits, error)
+	GetItemUnitsByCodes(ctx context.Context, itemCodes []string) ([]dto.PriceListItemUnits, error)
+
+	// Resolution
+	ResolvePrice(ctx context.Context, params PriceResolveParams) (*dto.PriceResolveCandidate, error)
+}
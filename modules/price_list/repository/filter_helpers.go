@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/modules/price_list/dto"
+	"gorm.io/gorm"
+)
+
+// applyPriceListFilters applies all filters from ReqPriceListIndexFilter to the query
+func applyPriceListFilters(query *gorm.DB, filter dto.ReqPriceListIndexFilter) *gorm.DB {
+	if len(filter.Codes) > 0 {
+		query = query.Where("pl.code IN (?)", filter.Codes)
+	}
+	if len(filter.Names) > 0 {
+		query = query.Where("pl.name IN (?)", filter.Names)
+	}
+	if len(filter.CustomerCategoryIDs) > 0 {
+		query = query.Where("EXISTS (SELECT 1 FROM parameters_to_module ptm WHERE ptm.module_type = ? AND ptm.module_id = pl.id AND ptm.parameter_id IN (?))", constants.ModuleTypePriceList, filter.CustomerCategoryIDs)
+	}
+	if len(filter.IsActive) > 0 {
+		query = query.Where("pl.is_active IN (?)", filter.IsActive)
+	}
+	return query
+}
+
+// ApplyFilters applies filters to the query
+// Implements NeedFilterPredefine interface
+func (r *priceListRepository) ApplyFilters(query *gorm.DB, filter interface{}) *gorm.DB {
+	priceListFilter, ok := filter.(dto.ReqPriceListIndexFilter)
+	if !ok {
+		return query
+	}
+
+	return applyPriceListFilters(query, priceListFilter)
+}
+
+// Compile-time check to ensure priceListRepository implements NeedFilterPredefine interface
+var _ request.NeedFilterPredefine = (*priceListRepository)(nil)
@@ -0,0 +1,280 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/price_list"
+	"github.com/rendyfutsuy/base-go/modules/price_list/dto"
+	"gorm.io/gorm"
+)
+
+// priceQuery joins the item of every active price
+func (r *priceListRepository) priceQuery(ctx context.Context) *gorm.DB {
+	return r.DB.WithContext(ctx).Table("price_list_items pli").
+		Select("pli.*, it.item_code as item_code, it.name as item_name").
+		Joins("LEFT JOIN items it ON it.id = pli.item_id").
+		Where("pli.deleted_at IS NULL")
+}
+
+func (r *priceListRepository) CreatePrice(ctx context.Context, params price_list.CreatePriceListPriceParams) (*models.PriceListItem, error) {
+	now := time.Now().UTC()
+	price := &models.PriceListItem{
+		PriceListID:   params.PriceListID,
+		ItemID:        params.ItemID,
+		Uom:           params.Uom,
+		MinQty:        params.MinQty,
+		Price:         params.Price,
+		EffectiveFrom: params.EffectiveFrom,
+		EffectiveTo:   params.EffectiveTo,
+		CreatedAt:     now,
+		CreatedBy:     params.CreatedBy,
+		UpdatedAt:     now,
+		UpdatedBy:     params.CreatedBy,
+	}
+	if err := r.DB.WithContext(ctx).Create(price).Error; err != nil {
+		return nil, err
+	}
+	return r.GetPriceByID(ctx, params.PriceListID, price.ID)
+}
+
+func (r *priceListRepository) UpdatePrice(ctx context.Context, priceListID uuid.UUID, priceID uuid.UUID, params price_list.UpdatePriceListPriceParams) (*models.PriceListItem, error) {
+	res := r.DB.WithContext(ctx).Model(&models.PriceListItem{}).
+		Where("id = ? AND price_list_id = ? AND deleted_at IS NULL", priceID, priceListID).
+		Updates(map[string]interface{}{
+			"item_id":        params.ItemID,
+			"uom":            params.Uom,
+			"min_qty":        params.MinQty,
+			"price":          params.Price,
+			"effective_from": params.EffectiveFrom,
+			"effective_to":   params.EffectiveTo,
+			"updated_at":     time.Now().UTC(),
+			"updated_by":     params.UpdatedBy,
+		})
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return r.GetPriceByID(ctx, priceListID, priceID)
+}
+
+// UpsertPrices updates the price of every existing item, unit, quantity break and start date and creates the missing ones in a single transaction
+func (r *priceListRepository) UpsertPrices(ctx context.Context, params []price_list.CreatePriceListPriceParams) error {
+	if len(params) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, p := range params {
+			res := tx.Model(&models.PriceListItem{}).
+				Where("price_list_id = ? AND item_id = ? AND uom = ? AND min_qty = ? AND effective_from = ? AND deleted_at IS NULL", p.PriceListID, p.ItemID, p.Uom, p.MinQty, p.EffectiveFrom.Format("2006-01-02")).
+				Updates(map[string]interface{}{
+					"price":        p.Price,
+					"effective_to": p.EffectiveTo,
+					"updated_at":   now,
+					"updated_by":   p.CreatedBy,
+				})
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected > 0 {
+				continue
+			}
+
+			if err := tx.Create(&models.PriceListItem{
+				PriceListID:   p.PriceListID,
+				ItemID:        p.ItemID,
+				Uom:           p.Uom,
+				MinQty:        p.MinQty,
+				Price:         p.Price,
+				EffectiveFrom: p.EffectiveFrom,
+				EffectiveTo:   p.EffectiveTo,
+				CreatedAt:     now,
+				CreatedBy:     p.CreatedBy,
+				UpdatedAt:     now,
+				UpdatedBy:     p.CreatedBy,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *priceListRepository) DeletePrice(ctx context.Context, priceListID uuid.UUID, priceID uuid.UUID, deletedBy string) error {
+	res := r.DB.WithContext(ctx).Model(&models.PriceListItem{}).
+		Where("id = ? AND price_list_id = ? AND deleted_at IS NULL", priceID, priceListID).
+		Updates(map[string]interface{}{
+			"deleted_at": time.Now().UTC(),
+			"deleted_by": deletedBy,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *priceListRepository) GetPriceByID(ctx context.Context, priceListID uuid.UUID, priceID uuid.UUID) (*models.PriceListItem, error) {
+	price := &models.PriceListItem{}
+	err := r.priceQuery(ctx).
+		Where("pli.id = ? AND pli.price_list_id = ?", priceID, priceListID).
+		Scan(price).Error
+	if err != nil {
+		return nil, err
+	}
+	// Scan() doesn't return error for record not found, so check if ID is nil
+	if price.ID == uuid.Nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return price, nil
+}
+
+func (r *priceListRepository) GetPricesByPriceListID(ctx context.Context, priceListID uuid.UUID) ([]models.PriceListItem, error) {
+	var prices []models.PriceListItem
+	err := r.priceQuery(ctx).
+		Where("pli.price_list_id = ?", priceListID).
+		Order("it.item_code ASC, pli.uom ASC, pli.min_qty ASC, pli.effective_from ASC").
+		Scan(&prices).Error
+	return prices, err
+}
+
+// ExistsOverlappingPrice reports whether another price of the item, unit and quantity break applies on a day of the period
+func (r *priceListRepository) ExistsOverlappingPrice(ctx context.Context, params price_list.PriceOverlapParams) (bool, error) {
+	var count int64
+	q := r.DB.WithContext(ctx).Model(&models.PriceListItem{}).
+		Where("price_list_id = ? AND item_id = ? AND LOWER(uom) = LOWER(?) AND min_qty = ? AND deleted_at IS NULL", params.PriceListID, params.ItemID, params.Uom, params.MinQty).
+		Where("(effective_to IS NULL OR effective_to >= ?)", params.EffectiveFrom.Format("2006-01-02"))
+	if params.EffectiveTo != nil {
+		q = q.Where("effective_from <= ?", params.EffectiveTo.Format("2006-01-02"))
+	}
+	if params.ExcludeID != uuid.Nil {
+		q = q.Where("id <> ?", params.ExcludeID)
+	}
+	if err := q.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// itemUnitsRow is the scan target of an item with one of its units
+type itemUnitsRow struct {
+	ItemID   uuid.UUID `gorm:"column:item_id"`
+	ItemCode string    `gorm:"column:item_code"`
+	ItemName string    `gorm:"column:item_name"`
+	BaseUom  string    `gorm:"column:base_uom"`
+	Uom      *string   `gorm:"column:uom"`
+}
+
+// getItemUnits loads active items with their units, the base unit first
+func (r *priceListRepository) getItemUnits(ctx context.Context, where string, args ...interface{}) ([]dto.PriceListItemUnits, error) {
+	var rows []itemUnitsRow
+	err := r.DB.WithContext(ctx).Table("items it").
+		Select("it.id as item_id, it.item_code, it.name as item_name, it.base_uom, iu.uom").
+		Joins("LEFT JOIN item_units iu ON iu.item_id = it.id").
+		Where("it.deleted_at IS NULL").
+		Where(where, args...).
+		Order("it.item_code ASC, iu.conversion ASC, iu.uom ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.PriceListItemUnits, 0)
+	indexes := make(map[uuid.UUID]int)
+	for _, row := range rows {
+		idx, ok := indexes[row.ItemID]
+		if !ok {
+			idx = len(items)
+			indexes[row.ItemID] = idx
+			items = append(items, dto.PriceListItemUnits{
+				ItemID:   row.ItemID,
+				ItemCode: row.ItemCode,
+				ItemName: row.ItemName,
+				Uoms:     []string{row.BaseUom},
+			})
+		}
+		if row.Uom != nil && !strings.EqualFold(*row.Uom, row.BaseUom) {
+			items[idx].Uoms = append(items[idx].Uoms, *row.Uom)
+		}
+	}
+	return items, nil
+}
+
+func (r *priceListRepository) GetItemUnits(ctx context.Context, itemID uuid.UUID) (*dto.PriceListItemUnits, error) {
+	items, err := r.getItemUnits(ctx, "it.id = ?", itemID)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &items[0], nil
+}
+
+func (r *priceListRepository) GetItemUnitsByCodes(ctx context.Context, itemCodes []string) ([]dto.PriceListItemUnits, error) {
+	if len(itemCodes) == 0 {
+		return []dto.PriceListItemUnits{}, nil
+	}
+	return r.getItemUnits(ctx, "it.item_code IN (?)", itemCodes)
+}
+
+// ResolvePrice returns the price that applies to the quantity of an item unit on a date.
+// Only active price lists are used. A list assigned to the customer category wins over a list for every customer,
+// then the highest priority, the highest quantity break reached and the latest start date win.
+// Lists assigned to other categories never apply. It returns nil when no price applies.
+func (r *priceListRepository) ResolvePrice(ctx context.Context, params price_list.PriceResolveParams) (*dto.PriceResolveCandidate, error) {
+	date := params.Date.Format("2006-01-02")
+
+	categoryMatched := "FALSE"
+	categoryArgs := []interface{}{}
+	if params.CustomerCategoryID != nil {
+		categoryMatched = "EXISTS (SELECT 1 FROM parameters_to_module ptm WHERE ptm.module_type = ? AND ptm.module_id = pl.id AND ptm.parameter_id = ?)"
+		categoryArgs = append(categoryArgs, constants.ModuleTypePriceList, *params.CustomerCategoryID)
+	}
+
+	query := r.DB.WithContext(ctx).Table("price_list_items pli").
+		Select(`
+			pli.id as price_list_item_id,
+			pl.id as price_list_id,
+			pl.code as price_list_code,
+			pl.name as price_list_name,
+			pl.priority,
+			`+categoryMatched+` as category_matched,
+			pli.uom,
+			pli.min_qty,
+			pli.price::text as price,
+			pli.effective_from,
+			pli.effective_to`, categoryArgs...).
+		Joins("JOIN price_lists pl ON pl.id = pli.price_list_id AND pl.deleted_at IS NULL AND pl.is_active = TRUE").
+		Where("pli.deleted_at IS NULL AND pli.item_id = ? AND LOWER(pli.uom) = LOWER(?) AND pli.min_qty <= ?", params.ItemID, params.Uom, params.Qty).
+		Where("pli.effective_from <= ? AND (pli.effective_to IS NULL OR pli.effective_to >= ?)", date, date)
+
+	generalList := "NOT EXISTS (SELECT 1 FROM parameters_to_module ptm WHERE ptm.module_type = ? AND ptm.module_id = pl.id)"
+	if params.CustomerCategoryID != nil {
+		query = query.Where("("+generalList+" OR "+categoryMatched+")", append([]interface{}{constants.ModuleTypePriceList}, categoryArgs...)...)
+	} else {
+		query = query.Where(generalList, constants.ModuleTypePriceList)
+	}
+
+	var candidates []dto.PriceResolveCandidate
+	if err := query.
+		Order("category_matched DESC, pl.priority DESC, pli.min_qty DESC, pli.effective_from DESC").
+		Limit(1).
+		Scan(&candidates).Error; err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	return &candidates[0], nil
+}
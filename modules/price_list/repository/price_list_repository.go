@@ -0,0 +1,238 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/price_list"
+	"github.com/rendyfutsuy/base-go/modules/price_list/dto"
+	rsearchpricelist "github.com/rendyfutsuy/base-go/modules/price_list/repository/searches"
+	"gorm.io/gorm"
+)
+
+type priceListRepository struct {
+	DB *gorm.DB
+}
+
+func NewPriceListRepository(db *gorm.DB) *priceListRepository {
+	return &priceListRepository{
+		DB: db,
+	}
+}
+
+func (r *priceListRepository) Create(ctx context.Context, params price_list.CreatePriceListParams) (*models.PriceList, error) {
+	now := time.Now().UTC()
+	pl := &models.PriceList{
+		Code:        params.Code,
+		Name:        params.Name,
+		Description: params.Description,
+		Priority:    params.Priority,
+		IsActive:    params.IsActive,
+		CreatedAt:   now,
+		CreatedBy:   params.CreatedBy,
+		UpdatedAt:   now,
+		UpdatedBy:   params.CreatedBy,
+	}
+	if err := r.DB.WithContext(ctx).Create(pl).Error; err != nil {
+		return nil, err
+	}
+	if pl.ID == uuid.Nil {
+		return nil, errors.New(constants.PriceListCreateFailedIDNotSet)
+	}
+	return pl, nil
+}
+
+func (r *priceListRepository) Update(ctx context.Context, id uuid.UUID, params price_list.UpdatePriceListParams) (*models.PriceList, error) {
+	updates := map[string]interface{}{
+		"name":        params.Name,
+		"description": params.Description,
+		"priority":    params.Priority,
+		"is_active":   params.IsActive,
+		"updated_at":  time.Now().UTC(),
+		"updated_by":  params.UpdatedBy,
+	}
+
+	pl := &models.PriceList{}
+	err := r.DB.WithContext(ctx).Model(&models.PriceList{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(updates).
+		Take(pl).Error
+	if err != nil {
+		return nil, err
+	}
+	return pl, nil
+}
+
+func (r *priceListRepository) Delete(ctx context.Context, id uuid.UUID, deletedBy string) error {
+	updates := map[string]interface{}{
+		"deleted_at": time.Now().UTC(),
+		"deleted_by": deletedBy,
+	}
+	return r.DB.WithContext(ctx).Model(&models.PriceList{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(updates).Error
+}
+
+func (r *priceListRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.PriceList, error) {
+	pl := &models.PriceList{}
+	err := r.DB.WithContext(ctx).Table("price_lists pl").
+		Select("pl.*").
+		Where("pl.id = ? AND pl.deleted_at IS NULL", id).
+		Scan(pl).Error
+	if err != nil {
+		return nil, err
+	}
+	// Scan() doesn't return error for record not found, so check if ID is nil
+	if pl.ID == uuid.Nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return pl, nil
+}
+
+func (r *priceListRepository) ExistsByCode(ctx context.Context, code string) (bool, error) {
+	var count int64
+	// codes are unique across soft-deleted price lists too
+	if err := r.DB.WithContext(ctx).Unscoped().Model(&models.PriceList{}).
+		Where("code = ?", code).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *priceListRepository) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqPriceListIndexFilter) ([]models.PriceList, int, error) {
+	var priceLists []models.PriceList
+	query := r.DB.WithContext(ctx).Table("price_lists pl").
+		Select(`
+			pl.id,
+			pl.code,
+			pl.name,
+			pl.priority,
+			pl.is_active,
+			pl.created_at,
+			pl.updated_at`).
+		Where("pl.deleted_at IS NULL")
+
+	// Apply search from PageRequest
+	query = request.ApplySearchConditionFromInterface(query, req.Search, rsearchpricelist.NewPriceListSearchHelper())
+
+	// Apply filters with multiple values support
+	query = r.ApplyFilters(query, filter)
+
+	// Pagination
+	total, err := request.ApplyPagination(query, req, request.PaginationConfig{
+		DefaultSortBy:      "pl.created_at",
+		DefaultSortOrder:   "DESC",
+		MaxPerPage:         100,
+		SortMapping:        mapPriceListIndexSortColumn,
+		NaturalSortColumns: []string{"pl.name", "pl.code"}, // Enable natural sorting for name and code
+	}, &priceLists)
+	if err != nil {
+		return nil, 0, err
+	}
+	return priceLists, total, nil
+}
+
+func (r *priceListRepository) GetAllForExport(ctx context.Context, filter dto.ReqPriceListIndexFilter) ([]dto.PriceListExport, error) {
+	// First, get all price lists
+	var priceListsBase []models.PriceList
+	query := r.DB.WithContext(ctx).Table("price_lists pl").
+		Select(`
+			pl.id,
+			pl.code,
+			pl.name,
+			pl.description,
+			pl.priority,
+			pl.is_active,
+			pl.updated_at`).
+		Where("pl.deleted_at IS NULL")
+
+	// Apply search from filter
+	query = request.ApplySearchConditionFromInterface(query, filter.Search, rsearchpricelist.NewPriceListSearchHelper())
+
+	// Apply filters with multiple values support
+	query = r.ApplyFilters(query, filter)
+
+	// Determine sorting with natural sorting support
+	sortExpression := request.BuildSortExpressionForExport(
+		filter.SortBy,
+		filter.SortOrder,
+		"pl.created_at",
+		"DESC",
+		mapPriceListIndexSortColumn,
+		[]string{"pl.name", "pl.code"},
+	)
+
+	// Order results
+	if err := query.Order(sortExpression).Find(&priceListsBase).Error; err != nil {
+		return nil, err
+	}
+
+	priceListIDs := make([]uuid.UUID, len(priceListsBase))
+	for i, pl := range priceListsBase {
+		priceListIDs[i] = pl.ID
+	}
+
+	// Fetch the customer categories and the number of prices of every price list
+	type categoryRow struct {
+		ModuleID uuid.UUID `gorm:"column:module_id"`
+		Code     string    `gorm:"column:code"`
+	}
+	type priceCountRow struct {
+		PriceListID uuid.UUID `gorm:"column:price_list_id"`
+		Total       int       `gorm:"column:total"`
+	}
+	var categories []categoryRow
+	var priceCounts []priceCountRow
+	if len(priceListIDs) > 0 {
+		if err := r.DB.WithContext(ctx).Table("parameters_to_module ptm").
+			Select("ptm.module_id, p.code").
+			Joins("JOIN parameters p ON p.id = ptm.parameter_id AND p.deleted_at IS NULL").
+			Where("ptm.module_type = ? AND ptm.module_id IN (?)", constants.ModuleTypePriceList, priceListIDs).
+			Order("p.code ASC").
+			Scan(&categories).Error; err != nil {
+			return nil, err
+		}
+		if err := r.DB.WithContext(ctx).Model(&models.PriceListItem{}).
+			Select("price_list_id, COUNT(*) as total").
+			Where("price_list_id IN (?) AND deleted_at IS NULL", priceListIDs).
+			Group("price_list_id").
+			Scan(&priceCounts).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	categoriesMap := make(map[uuid.UUID][]string)
+	for _, category := range categories {
+		categoriesMap[category.ModuleID] = append(categoriesMap[category.ModuleID], category.Code)
+	}
+	priceCountsMap := make(map[uuid.UUID]int)
+	for _, priceCount := range priceCounts {
+		priceCountsMap[priceCount.PriceListID] = priceCount.Total
+	}
+
+	// Map to PriceListExport
+	priceLists := make([]dto.PriceListExport, len(priceListsBase))
+	for i, pl := range priceListsBase {
+		priceLists[i] = dto.PriceListExport{
+			Code:               pl.Code,
+			Name:               pl.Name,
+			Description:        pl.Description,
+			Priority:           pl.Priority,
+			IsActive:           pl.IsActive,
+			CustomerCategories: categoriesMap[pl.ID],
+			PriceCount:         priceCountsMap[pl.ID],
+			UpdatedAt:          pl.UpdatedAt,
+		}
+	}
+
+	return priceLists, nil
+}
+
+// Implement price_list.Repository interface
+var _ price_list.Repository = (*priceListRepository)(nil)
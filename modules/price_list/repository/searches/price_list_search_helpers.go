@@ -0,0 +1,23 @@
+package searches
+
+import "github.com/rendyfutsuy/base-go/helpers/request"
+
+// initialize, value for search and map the function & variable need for it
+type PriceListSearchHelper struct{ request.SearchPredefineBase }
+
+func (PriceListSearchHelper) GetSearchColumns() []string {
+	return []string{
+		"pl.code",
+		"pl.name",
+	}
+}
+
+func (PriceListSearchHelper) GetSearchExistsSubqueries() []string {
+	return []string{}
+}
+
+var _ request.NeedSearchPredefine = PriceListSearchHelper{}
+
+func NewPriceListSearchHelper() PriceListSearchHelper {
+	return PriceListSearchHelper{SearchPredefineBase: request.SearchPredefineBase{Threshold: nil}}
+}
@@ -0,0 +1,32 @@
+package repository
+
+import "strings"
+
+func normalizePriceListSortKey(sortBy string) string {
+	sortBy = strings.TrimSpace(sortBy)
+	if sortBy == "" {
+		return ""
+	}
+	sortBy = strings.ReplaceAll(sortBy, "-", "_")
+	sortBy = strings.ReplaceAll(sortBy, " ", "_")
+	return strings.ToLower(sortBy)
+}
+
+func mapPriceListIndexSortColumn(sortBy string) string {
+	normalized := normalizePriceListSortKey(sortBy)
+	if normalized == "" {
+		return ""
+	}
+
+	mapping := map[string]string{
+		"id":         "pl.id",
+		"code":       "pl.code",
+		"name":       "pl.name",
+		"priority":   "pl.priority",
+		"is_active":  "pl.is_active",
+		"created_at": "pl.created_at",
+		"updated_at": "pl.updated_at",
+	}
+
+	return mapping[normalized]
+}
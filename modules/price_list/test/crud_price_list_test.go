@@ -0,0 +1,730 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	paramMod "github.com/rendyfutsuy/base-go/modules/parameter"
+	paramDto "github.com/rendyfutsuy/base-go/modules/parameter/dto"
+	priceListMod "github.com/rendyfutsuy/base-go/modules/price_list"
+	priceListDto "github.com/rendyfutsuy/base-go/modules/price_list/dto"
+	"github.com/rendyfutsuy/base-go/modules/price_list/usecase"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// MockPriceListRepository is a mock implementation of price_list.Repository
+type MockPriceListRepository struct {
+	mock.Mock
+}
+
+func (m *MockPriceListRepository) Create(ctx context.Context, params priceListMod.CreatePriceListParams) (*models.PriceList, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PriceList), args.Error(1)
+}
+
+func (m *MockPriceListRepository) Update(ctx context.Context, id uuid.UUID, params priceListMod.UpdatePriceListParams) (*models.PriceList, error) {
+	args := m.Called(ctx, id, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PriceList), args.Error(1)
+}
+
+func (m *MockPriceListRepository) Delete(ctx context.Context, id uuid.UUID, deletedBy string) error {
+	args := m.Called(ctx, id, deletedBy)
+	return args.Error(0)
+}
+
+func (m *MockPriceListRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.PriceList, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PriceList), args.Error(1)
+}
+
+func (m *MockPriceListRepository) GetIndex(ctx context.Context, req request.PageRequest, filter priceListDto.ReqPriceListIndexFilter) ([]models.PriceList, int, error) {
+	args := m.Called(ctx, req, filter)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]models.PriceList), args.Int(1), args.Error(2)
+}
+
+func (m *MockPriceListRepository) GetAllForExport(ctx context.Context, filter priceListDto.ReqPriceListIndexFilter) ([]priceListDto.PriceListExport, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]priceListDto.PriceListExport), args.Error(1)
+}
+
+func (m *MockPriceListRepository) ExistsByCode(ctx context.Context, code string) (bool, error) {
+	args := m.Called(ctx, code)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockPriceListRepository) CreatePrice(ctx context.Context, params priceListMod.CreatePriceListPriceParams) (*models.PriceListItem, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PriceListItem), args.Error(1)
+}
+
+func (m *MockPriceListRepository) UpdatePrice(ctx context.Context, priceListID uuid.UUID, priceID uuid.UUID, params priceListMod.UpdatePriceListPriceParams) (*models.PriceListItem, error) {
+	args := m.Called(ctx, priceListID, priceID, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PriceListItem), args.Error(1)
+}
+
+func (m *MockPriceListRepository) UpsertPrices(ctx context.Context, params []priceListMod.CreatePriceListPriceParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+
+func (m *MockPriceListRepository) DeletePrice(ctx context.Context, priceListID uuid.UUID, priceID uuid.UUID, deletedBy string) error {
+	args := m.Called(ctx, priceListID, priceID, deletedBy)
+	return args.Error(0)
+}
+
+func (m *MockPriceListRepository) GetPriceByID(ctx context.Context, priceListID uuid.UUID, priceID uuid.UUID) (*models.PriceListItem, error) {
+	args := m.Called(ctx, priceListID, priceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PriceListItem), args.Error(1)
+}
+
+func (m *MockPriceListRepository) GetPricesByPriceListID(ctx context.Context, priceListID uuid.UUID) ([]models.PriceListItem, error) {
+	args := m.Called(ctx, priceListID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.PriceListItem), args.Error(1)
+}
+
+func (m *MockPriceListRepository) ExistsOverlappingPrice(ctx context.Context, params priceListMod.PriceOverlapParams) (bool, error) {
+	args := m.Called(ctx, params)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockPriceListRepository) GetItemUnits(ctx context.Context, itemID uuid.UUID) (*priceListDto.PriceListItemUnits, error) {
+	args := m.Called(ctx, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*priceListDto.PriceListItemUnits), args.Error(1)
+}
+
+func (m *MockPriceListRepository) GetItemUnitsByCodes(ctx context.Context, itemCodes []string) ([]priceListDto.PriceListItemUnits, error) {
+	args := m.Called(ctx, itemCodes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]priceListDto.PriceListItemUnits), args.Error(1)
+}
+
+func (m *MockPriceListRepository) ResolvePrice(ctx context.Context, params priceListMod.PriceResolveParams) (*priceListDto.PriceResolveCandidate, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*priceListDto.PriceResolveCandidate), args.Error(1)
+}
+
+// MockParameterRepository implements the lookup and pivot methods used for customer categories
+type MockParameterRepository struct {
+	mock.Mock
+}
+
+func (m *MockParameterRepository) Create(ctx context.Context, code, name string, value, typeVal, desc *string) (*models.Parameter, error) {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) Update(ctx context.Context, id uuid.UUID, code, name string, value, typeVal, desc *string) (*models.Parameter, error) {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) SetParent(ctx context.Context, id uuid.UUID, parentID uuid.UUID) error {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Parameter, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Parameter), args.Error(1)
+}
+func (m *MockParameterRepository) GetIndex(ctx context.Context, req request.PageRequest, filter paramDto.ReqParameterIndexFilter) ([]models.Parameter, int, error) {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) GetAll(ctx context.Context, filter paramDto.ReqParameterIndexFilter) ([]models.Parameter, error) {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) ExistsByCode(ctx context.Context, code string, excludeID uuid.UUID) (bool, error) {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) ExistsByName(ctx context.Context, name string, excludeID uuid.UUID) (bool, error) {
+	panic("not implemented")
+}
+func (m *MockParameterRepository) AssignParametersToModule(ctx context.Context, moduleType string, moduleID uuid.UUID, parameterIDs []uuid.UUID) error {
+	args := m.Called(ctx, moduleType, moduleID, parameterIDs)
+	return args.Error(0)
+}
+func (m *MockParameterRepository) GetByModule(ctx context.Context, moduleType string, moduleID uuid.UUID) ([]models.Parameter, error) {
+	args := m.Called(ctx, moduleType, moduleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Parameter), args.Error(1)
+}
+func (m *MockParameterRepository) RemoveParametersFromModule(ctx context.Context, moduleType string, moduleID uuid.UUID) error {
+	args := m.Called(ctx, moduleType, moduleID)
+	return args.Error(0)
+}
+func (m *MockParameterRepository) GetChildTypes(ctx context.Context, id uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+func (m *MockParameterRepository) GetByCodes(ctx context.Context, codes []string) ([]models.Parameter, error) {
+	args := m.Called(ctx, codes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Parameter), args.Error(1)
+}
+func (m *MockParameterRepository) ImportParameters(ctx context.Context, params []paramMod.ImportParameterParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+func (m *MockParameterRepository) GetLookupEntries(ctx context.Context) ([]models.Parameter, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Parameter), args.Error(1)
+}
+
+func (m *MockParameterRepository) GetTree(ctx context.Context, typeCode string) ([]models.Parameter, error) {
+	args := m.Called(ctx, typeCode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Parameter), args.Error(1)
+}
+
+func (m *MockParameterRepository) GetAncestorIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockParameterRepository) GetSiblings(ctx context.Context, parentID uuid.UUID, typeVal *string) ([]models.Parameter, error) {
+	args := m.Called(ctx, parentID, typeVal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Parameter), args.Error(1)
+}
+
+func (m *MockParameterRepository) Move(ctx context.Context, id uuid.UUID, parentID uuid.UUID, position int) error {
+	args := m.Called(ctx, id, parentID, position)
+	return args.Error(0)
+}
+
+func (m *MockParameterRepository) Reorder(ctx context.Context, ids []uuid.UUID) error {
+	args := m.Called(ctx, ids)
+	return args.Error(0)
+}
+
+func date(value string) time.Time {
+	d, _ := time.Parse("2006-01-02", value)
+	return d
+}
+
+func TestCreatePriceList(t *testing.T) {
+	ctx := context.Background()
+	categoryID := uuid.New()
+	categoryType := constants.CustomerCategoryParameterType
+	otherType := "topic"
+
+	tests := []struct {
+		name          string
+		req           *priceListDto.ReqCreatePriceList
+		setupMock     func(*MockPriceListRepository, *MockParameterRepository)
+		expectedError error
+	}{
+		{
+			name: "success create price list assigned to a customer category",
+			req: &priceListDto.ReqCreatePriceList{
+				Code:                " PL-GROSIR ",
+				Name:                "Harga Grosir",
+				Priority:            10,
+				CustomerCategoryIDs: []uuid.UUID{categoryID, categoryID},
+			},
+			setupMock: func(m *MockPriceListRepository, p *MockParameterRepository) {
+				m.On("ExistsByCode", mock.Anything, "PL-GROSIR").Return(false, nil).Once()
+				p.On("GetByID", mock.Anything, categoryID).Return(&models.Parameter{ID: categoryID, Type: &categoryType}, nil).Once()
+				m.On("Create", mock.Anything, priceListMod.CreatePriceListParams{
+					Code:      "PL-GROSIR",
+					Name:      "Harga Grosir",
+					Priority:  10,
+					IsActive:  true,
+					CreatedBy: "test-auth-id",
+				}).Return(&models.PriceList{ID: uuid.MustParse("0190a3f1-0000-7000-8000-000000000001"), Code: "PL-GROSIR"}, nil).Once()
+				p.On("AssignParametersToModule", mock.Anything, constants.ModuleTypePriceList, uuid.MustParse("0190a3f1-0000-7000-8000-000000000001"), []uuid.UUID{categoryID}).Return(nil).Once()
+			},
+		},
+		{
+			name: "error when code already exists",
+			req:  &priceListDto.ReqCreatePriceList{Code: "PL-GROSIR", Name: "Harga Grosir"},
+			setupMock: func(m *MockPriceListRepository, p *MockParameterRepository) {
+				m.On("ExistsByCode", mock.Anything, "PL-GROSIR").Return(true, nil).Once()
+			},
+			expectedError: fmt.Errorf(constants.PriceListCodeAlreadyExists, "PL-GROSIR"),
+		},
+		{
+			name: "error when category is not a customer category",
+			req:  &priceListDto.ReqCreatePriceList{Code: "PL-GROSIR", Name: "Harga Grosir", CustomerCategoryIDs: []uuid.UUID{categoryID}},
+			setupMock: func(m *MockPriceListRepository, p *MockParameterRepository) {
+				m.On("ExistsByCode", mock.Anything, "PL-GROSIR").Return(false, nil).Once()
+				p.On("GetByID", mock.Anything, categoryID).Return(&models.Parameter{ID: categoryID, Type: &otherType}, nil).Once()
+			},
+			expectedError: fmt.Errorf(constants.PriceListCategoryInvalid, categoryID),
+		},
+		{
+			name: "error when category does not exist",
+			req:  &priceListDto.ReqCreatePriceList{Code: "PL-GROSIR", Name: "Harga Grosir", CustomerCategoryIDs: []uuid.UUID{categoryID}},
+			setupMock: func(m *MockPriceListRepository, p *MockParameterRepository) {
+				m.On("ExistsByCode", mock.Anything, "PL-GROSIR").Return(false, nil).Once()
+				p.On("GetByID", mock.Anything, categoryID).Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: fmt.Errorf(constants.PriceListCategoryInvalid, categoryID),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockPriceListRepository)
+			mockParamRepo := new(MockParameterRepository)
+			tt.setupMock(mockRepo, mockParamRepo)
+			uc := usecase.NewPriceListUsecase(mockRepo, mockParamRepo)
+
+			result, err := uc.Create(ctx, tt.req, "test-auth-id")
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "PL-GROSIR", result.Code)
+			}
+			mockRepo.AssertExpectations(t)
+			mockParamRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdatePriceList(t *testing.T) {
+	ctx := context.Background()
+	priceListID := uuid.New()
+	categoryID := uuid.New()
+	categoryType := constants.CustomerCategoryParameterType
+
+	t.Run("success replaces the customer categories and keeps is_active", func(t *testing.T) {
+		mockRepo := new(MockPriceListRepository)
+		mockParamRepo := new(MockParameterRepository)
+		mockRepo.On("GetByID", mock.Anything, priceListID).Return(&models.PriceList{ID: priceListID, IsActive: false}, nil).Once()
+		mockParamRepo.On("GetByID", mock.Anything, categoryID).Return(&models.Parameter{ID: categoryID, Type: &categoryType}, nil).Once()
+		mockRepo.On("Update", mock.Anything, priceListID, priceListMod.UpdatePriceListParams{
+			Name:      "Harga Grosir",
+			Priority:  5,
+			IsActive:  false,
+			UpdatedBy: "test-auth-id",
+		}).Return(&models.PriceList{ID: priceListID}, nil).Once()
+		mockParamRepo.On("RemoveParametersFromModule", mock.Anything, constants.ModuleTypePriceList, priceListID).Return(nil).Once()
+		mockParamRepo.On("AssignParametersToModule", mock.Anything, constants.ModuleTypePriceList, priceListID, []uuid.UUID{categoryID}).Return(nil).Once()
+
+		_, err := usecase.NewPriceListUsecase(mockRepo, mockParamRepo).Update(ctx, priceListID.String(), &priceListDto.ReqUpdatePriceList{
+			Name:                "Harga Grosir",
+			Priority:            5,
+			CustomerCategoryIDs: []uuid.UUID{categoryID},
+		}, "test-auth-id")
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockParamRepo.AssertExpectations(t)
+	})
+
+	t.Run("error when price list not found", func(t *testing.T) {
+		mockRepo := new(MockPriceListRepository)
+		mockRepo.On("GetByID", mock.Anything, priceListID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		_, err := usecase.NewPriceListUsecase(mockRepo, new(MockParameterRepository)).Update(ctx, priceListID.String(), &priceListDto.ReqUpdatePriceList{Name: "Harga Grosir"}, "test-auth-id")
+		assert.EqualError(t, err, fmt.Sprintf(constants.PriceListNotFound, priceListID.String()))
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestCreatePriceListPrice(t *testing.T) {
+	ctx := context.Background()
+	priceListID := uuid.New()
+	itemID := uuid.New()
+	item := &priceListDto.PriceListItemUnits{ItemID: itemID, ItemCode: "SKU-0001", ItemName: "Sampul Plastik A4", Uoms: []string{"pcs", "box"}}
+	minQty := 10.0
+	effectiveTo := "2025-06-30"
+	before := "2024-12-31"
+	to := date("2025-06-30")
+
+	tests := []struct {
+		name          string
+		req           *priceListDto.ReqCreatePriceListPrice
+		setupMock     func(*MockPriceListRepository)
+		expectedError error
+	}{
+		{
+			name: "success create price in the base unit from quantity 1",
+			req:  &priceListDto.ReqCreatePriceListPrice{ItemID: itemID, Price: 1500, EffectiveFrom: "2025-01-01"},
+			setupMock: func(m *MockPriceListRepository) {
+				m.On("GetItemUnits", mock.Anything, itemID).Return(item, nil).Once()
+				m.On("ExistsOverlappingPrice", mock.Anything, priceListMod.PriceOverlapParams{
+					PriceListID:   priceListID,
+					ItemID:        itemID,
+					Uom:           "pcs",
+					MinQty:        1,
+					EffectiveFrom: date("2025-01-01"),
+				}).Return(false, nil).Once()
+				m.On("CreatePrice", mock.Anything, priceListMod.CreatePriceListPriceParams{
+					PriceListID:   priceListID,
+					ItemID:        itemID,
+					Uom:           "pcs",
+					MinQty:        1,
+					Price:         1500,
+					EffectiveFrom: date("2025-01-01"),
+					CreatedBy:     "test-auth-id",
+				}).Return(&models.PriceListItem{ID: uuid.New(), Price: 1500}, nil).Once()
+			},
+		},
+		{
+			name: "success create quantity break of a unit written in another case",
+			req:  &priceListDto.ReqCreatePriceListPrice{ItemID: itemID, Uom: "BOX", MinQty: &minQty, Price: 1400, EffectiveFrom: "2025-01-01", EffectiveTo: &effectiveTo},
+			setupMock: func(m *MockPriceListRepository) {
+				m.On("GetItemUnits", mock.Anything, itemID).Return(item, nil).Once()
+				m.On("ExistsOverlappingPrice", mock.Anything, priceListMod.PriceOverlapParams{
+					PriceListID:   priceListID,
+					ItemID:        itemID,
+					Uom:           "box",
+					MinQty:        10,
+					EffectiveFrom: date("2025-01-01"),
+					EffectiveTo:   &to,
+				}).Return(false, nil).Once()
+				m.On("CreatePrice", mock.Anything, priceListMod.CreatePriceListPriceParams{
+					PriceListID:   priceListID,
+					ItemID:        itemID,
+					Uom:           "box",
+					MinQty:        10,
+					Price:         1400,
+					EffectiveFrom: date("2025-01-01"),
+					EffectiveTo:   &to,
+					CreatedBy:     "test-auth-id",
+				}).Return(&models.PriceListItem{ID: uuid.New(), Price: 1400}, nil).Once()
+			},
+		},
+		{
+			name: "error when item does not exist",
+			req:  &priceListDto.ReqCreatePriceListPrice{ItemID: itemID, Price: 1500, EffectiveFrom: "2025-01-01"},
+			setupMock: func(m *MockPriceListRepository) {
+				m.On("GetItemUnits", mock.Anything, itemID).Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: errors.New(constants.PriceListItemNotFound),
+		},
+		{
+			name: "error when unit is not a unit of the item",
+			req:  &priceListDto.ReqCreatePriceListPrice{ItemID: itemID, Uom: "carton", Price: 1500, EffectiveFrom: "2025-01-01"},
+			setupMock: func(m *MockPriceListRepository) {
+				m.On("GetItemUnits", mock.Anything, itemID).Return(item, nil).Once()
+			},
+			expectedError: fmt.Errorf(constants.PriceListUomInvalid, "carton"),
+		},
+		{
+			name: "error when effective_to is before effective_from",
+			req:  &priceListDto.ReqCreatePriceListPrice{ItemID: itemID, Price: 1500, EffectiveFrom: "2025-01-01", EffectiveTo: &before},
+			setupMock: func(m *MockPriceListRepository) {
+				m.On("GetItemUnits", mock.Anything, itemID).Return(item, nil).Once()
+			},
+			expectedError: errors.New(constants.PriceListPeriodInvalid),
+		},
+		{
+			name: "error when the period overlaps another price",
+			req:  &priceListDto.ReqCreatePriceListPrice{ItemID: itemID, MinQty: &minQty, Price: 1500, EffectiveFrom: "2025-01-01"},
+			setupMock: func(m *MockPriceListRepository) {
+				m.On("GetItemUnits", mock.Anything, itemID).Return(item, nil).Once()
+				m.On("ExistsOverlappingPrice", mock.Anything, mock.Anything).Return(true, nil).Once()
+			},
+			expectedError: fmt.Errorf(constants.PriceListPeriodOverlap, "pcs", "10"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockPriceListRepository)
+			mockRepo.On("GetByID", mock.Anything, priceListID).Return(&models.PriceList{ID: priceListID}, nil).Once()
+			tt.setupMock(mockRepo)
+			uc := usecase.NewPriceListUsecase(mockRepo, new(MockParameterRepository))
+
+			result, err := uc.CreatePrice(ctx, priceListID.String(), tt.req, "test-auth-id")
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.req.Price, result.Price)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeletePriceListPrice(t *testing.T) {
+	ctx := context.Background()
+	priceListID := uuid.New()
+	priceID := uuid.New()
+
+	mockRepo := new(MockPriceListRepository)
+	mockRepo.On("GetByID", mock.Anything, priceListID).Return(&models.PriceList{ID: priceListID}, nil).Once()
+	mockRepo.On("DeletePrice", mock.Anything, priceListID, priceID, "test-auth-id").Return(gorm.ErrRecordNotFound).Once()
+
+	err := usecase.NewPriceListUsecase(mockRepo, new(MockParameterRepository)).DeletePrice(ctx, priceListID.String(), priceID.String(), "test-auth-id")
+	assert.EqualError(t, err, fmt.Sprintf(constants.PriceListPriceNotFound, priceID.String()))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestResolvePrice(t *testing.T) {
+	ctx := context.Background()
+	itemID := uuid.New()
+	categoryID := uuid.New()
+	priceListID := uuid.New()
+	priceListItemID := uuid.New()
+	categoryType := constants.CustomerCategoryParameterType
+	item := &priceListDto.PriceListItemUnits{ItemID: itemID, ItemCode: "SKU-0001", ItemName: "Sampul Plastik A4", Uoms: []string{"pcs", "box"}}
+
+	t.Run("success resolves the category price and rounds the total", func(t *testing.T) {
+		mockRepo := new(MockPriceListRepository)
+		mockParamRepo := new(MockParameterRepository)
+		mockRepo.On("GetItemUnits", mock.Anything, itemID).Return(item, nil).Once()
+		mockParamRepo.On("GetByID", mock.Anything, categoryID).Return(&models.Parameter{ID: categoryID, Type: &categoryType}, nil).Once()
+		mockRepo.On("ResolvePrice", mock.Anything, priceListMod.PriceResolveParams{
+			ItemID:             itemID,
+			Uom:                "box",
+			Qty:                3,
+			CustomerCategoryID: &categoryID,
+			Date:               date("2025-03-15"),
+		}).Return(&priceListDto.PriceResolveCandidate{
+			PriceListItemID: priceListItemID,
+			PriceListID:     priceListID,
+			PriceListCode:   "PL-GROSIR",
+			PriceListName:   "Harga Grosir",
+			Priority:        10,
+			CategoryMatched: true,
+			Uom:             "box",
+			MinQty:          2,
+			Price:           "16000.335",
+			EffectiveFrom:   date("2025-01-01"),
+		}, nil).Once()
+
+		res, err := usecase.NewPriceListUsecase(mockRepo, mockParamRepo).Resolve(ctx, priceListDto.ReqPriceResolve{
+			ItemID:             itemID.String(),
+			Qty:                3,
+			Uom:                "Box",
+			CustomerCategoryID: categoryID.String(),
+			Date:               "2025-03-15",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "box", res.Uom)
+		assert.Equal(t, "2025-03-15", res.Date)
+		assert.Equal(t, "PL-GROSIR", res.PriceList.Code)
+		assert.Equal(t, priceListItemID, res.PriceListItemID)
+		assert.True(t, res.CategoryPrice)
+		assert.Equal(t, 2.0, res.MinQty)
+		assert.Equal(t, "2025-01-01", res.EffectiveFrom)
+		assert.Nil(t, res.EffectiveTo)
+		assert.Equal(t, 16000.335, res.UnitPrice)
+		assert.Equal(t, 48001.01, res.TotalPrice)
+		mockRepo.AssertExpectations(t)
+		mockParamRepo.AssertExpectations(t)
+	})
+
+	t.Run("total is computed on exact decimals and rounded half up once", func(t *testing.T) {
+		tests := []struct {
+			price    string
+			qty      float64
+			expected float64
+		}{
+			{"0.10", 1.45, 0.15},
+			{"0.05", 0.7, 0.04},
+			{"0.12", 1.375, 0.17},
+			{"1500.00", 3, 4500},
+		}
+
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%s x %v", tt.price, tt.qty), func(t *testing.T) {
+				mockRepo := new(MockPriceListRepository)
+				mockRepo.On("GetItemUnits", mock.Anything, itemID).Return(item, nil).Once()
+				mockRepo.On("ResolvePrice", mock.Anything, mock.Anything).
+					Return(&priceListDto.PriceResolveCandidate{Price: tt.price, EffectiveFrom: date("2025-01-01")}, nil).Once()
+
+				res, err := usecase.NewPriceListUsecase(mockRepo, new(MockParameterRepository)).Resolve(ctx, priceListDto.ReqPriceResolve{ItemID: itemID.String(), Qty: tt.qty})
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, res.TotalPrice)
+			})
+		}
+	})
+
+	t.Run("uses the base unit and today by default", func(t *testing.T) {
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+		mockRepo := new(MockPriceListRepository)
+		mockRepo.On("GetItemUnits", mock.Anything, itemID).Return(item, nil).Once()
+		mockRepo.On("ResolvePrice", mock.Anything, priceListMod.PriceResolveParams{
+			ItemID: itemID,
+			Uom:    "pcs",
+			Qty:    1,
+			Date:   today,
+		}).Return(nil, nil).Once()
+
+		res, err := usecase.NewPriceListUsecase(mockRepo, new(MockParameterRepository)).Resolve(ctx, priceListDto.ReqPriceResolve{ItemID: itemID.String(), Qty: 1})
+		assert.EqualError(t, err, constants.PriceListNoPriceFound)
+		assert.Nil(t, res)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error when unit is not a unit of the item", func(t *testing.T) {
+		mockRepo := new(MockPriceListRepository)
+		mockRepo.On("GetItemUnits", mock.Anything, itemID).Return(item, nil).Once()
+
+		_, err := usecase.NewPriceListUsecase(mockRepo, new(MockParameterRepository)).Resolve(ctx, priceListDto.ReqPriceResolve{ItemID: itemID.String(), Qty: 1, Uom: "carton"})
+		assert.EqualError(t, err, fmt.Sprintf(constants.PriceListUomInvalid, "carton"))
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestExportPriceListPrices(t *testing.T) {
+	ctx := context.Background()
+	priceListID := uuid.New()
+	to := date("2025-06-30")
+
+	mockRepo := new(MockPriceListRepository)
+	mockRepo.On("GetByID", mock.Anything, priceListID).Return(&models.PriceList{ID: priceListID}, nil).Once()
+	mockRepo.On("GetPricesByPriceListID", mock.Anything, priceListID).Return([]models.PriceListItem{
+		{ItemCode: "010101010001", ItemName: "Sampul Plastik A4", Uom: "pcs", MinQty: 1, Price: 1500, EffectiveFrom: date("2025-01-01"), UpdatedAt: time.Now()},
+		{ItemCode: "010101010001", ItemName: "Sampul Plastik A4", Uom: "box", MinQty: 10, Price: 16000, EffectiveFrom: date("2025-01-01"), EffectiveTo: &to, UpdatedAt: time.Now()},
+	}, nil).Once()
+
+	result, err := usecase.NewPriceListUsecase(mockRepo, new(MockParameterRepository)).ExportPrices(ctx, priceListID.String())
+	require.NoError(t, err)
+
+	f, err := excelize.OpenReader(bytes.NewReader(result))
+	require.NoError(t, err)
+	rows, err := f.GetRows("Prices")
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, "Item Code", rows[0][0])
+	assert.Equal(t, []string{"010101010001", "pcs", "1", "1500", "2025-01-01", "-", "Sampul Plastik A4"}, rows[1][:7])
+	assert.Equal(t, []string{"010101010001", "box", "10", "16000", "2025-01-01", "2025-06-30", "Sampul Plastik A4"}, rows[2][:7])
+	mockRepo.AssertExpectations(t)
+}
+
+// writePriceImportFile writes an import file with a header row and the given rows
+func writePriceImportFile(t *testing.T, rows [][]string) string {
+	f := excelize.NewFile()
+	defer f.Close()
+	require.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]string{"Item Code", "UoM", "Min Qty", "Price", "Effective From", "Effective To"}))
+	for i, row := range rows {
+		cell, _ := excelize.CoordinatesToCellName(1, i+2)
+		require.NoError(t, f.SetSheetRow("Sheet1", cell, &row))
+	}
+	path := filepath.Join(t.TempDir(), "prices.xlsx")
+	require.NoError(t, f.SaveAs(path))
+	return path
+}
+
+func TestImportPriceListPrices(t *testing.T) {
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+	ctx := context.Background()
+	priceListID := uuid.New()
+	itemID := uuid.New()
+	to := date("2025-06-30")
+
+	path := writePriceImportFile(t, [][]string{
+		{"SKU-0001", "", "", "1500", "2025-01-01", ""},
+		{"SKU-0001", "PCS", "1", "1450", "2025-01-01", "-"},
+		{"SKU-0001", "box", "1", "16000", "2025-01-01", "2025-06-30"},
+		{"SKU-0001", "box", "1", "15500", "2025-06-01", ""},
+		{"SKU-0001", "pcs", "10", "1400", "2025-01-01", ""},
+		{"SKU-9999", "carton", "0", "abc", "31/13/2025", ""},
+	})
+	defer os.Remove(path)
+
+	mockRepo := new(MockPriceListRepository)
+	mockRepo.On("GetByID", mock.Anything, priceListID).Return(&models.PriceList{ID: priceListID}, nil).Once()
+	mockRepo.On("GetItemUnitsByCodes", mock.Anything, []string{"SKU-0001", "SKU-0001", "SKU-0001", "SKU-0001", "SKU-0001", "SKU-9999"}).Return([]priceListDto.PriceListItemUnits{
+		{ItemID: itemID, ItemCode: "SKU-0001", ItemName: "Sampul Plastik A4", Uoms: []string{"pcs", "box"}},
+	}, nil).Once()
+	// the stored base unit price starting on 2025-01-01 is updated by the first row, the stored quantity break overlaps the fifth row
+	mockRepo.On("GetPricesByPriceListID", mock.Anything, priceListID).Return([]models.PriceListItem{
+		{ItemID: itemID, Uom: "pcs", MinQty: 1, Price: 1300, EffectiveFrom: date("2025-01-01")},
+		{ItemID: itemID, Uom: "pcs", MinQty: 10, Price: 1200, EffectiveFrom: date("2024-01-01")},
+	}, nil).Once()
+	mockRepo.On("UpsertPrices", mock.Anything, []priceListMod.CreatePriceListPriceParams{
+		{PriceListID: priceListID, ItemID: itemID, Uom: "pcs", MinQty: 1, Price: 1500, EffectiveFrom: date("2025-01-01"), CreatedBy: "test-auth-id"},
+		{PriceListID: priceListID, ItemID: itemID, Uom: "box", MinQty: 1, Price: 16000, EffectiveFrom: date("2025-01-01"), EffectiveTo: &to, CreatedBy: "test-auth-id"},
+	}).Return(nil).Once()
+
+	res, err := usecase.NewPriceListUsecase(mockRepo, new(MockParameterRepository)).ImportPricesFromExcel(ctx, priceListID.String(), path, "test-auth-id")
+	require.NoError(t, err)
+
+	assert.Equal(t, 6, res.TotalRows)
+	assert.Equal(t, 2, res.SuccessCount)
+	assert.Equal(t, 4, res.FailedCount)
+	assert.Equal(t, "success", res.Results[0].Status)
+	assert.Equal(t, fmt.Sprintf(constants.PriceListImportRowDuplicated, 2), res.Results[1].ErrorMessage)
+	assert.Equal(t, "success", res.Results[2].Status)
+	assert.Equal(t, fmt.Sprintf(constants.PriceListImportRowOverlap, 4), res.Results[3].ErrorMessage)
+	assert.Equal(t, fmt.Sprintf(constants.PriceListPeriodOverlap, "pcs", "10"), res.Results[4].ErrorMessage)
+	assert.Contains(t, res.Results[5].ErrorMessage, fmt.Sprintf(constants.PriceListImportItemNotFound, "SKU-9999"))
+	assert.Contains(t, res.Results[5].ErrorMessage, constants.PriceListImportMinQtyInvalid)
+	assert.Contains(t, res.Results[5].ErrorMessage, constants.PriceListImportPriceInvalid)
+	assert.Contains(t, res.Results[5].ErrorMessage, fmt.Sprintf(constants.PriceListImportDateInvalid, "effective_from"))
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,431 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/middleware"
+	reqMw "github.com/rendyfutsuy/base-go/helpers/middleware/request"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	priceListMod "github.com/rendyfutsuy/base-go/modules/price_list"
+	priceListHttp "github.com/rendyfutsuy/base-go/modules/price_list/delivery/http"
+	"github.com/rendyfutsuy/base-go/modules/price_list/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPriceListUsecase struct {
+	mock.Mock
+}
+
+func (m *mockPriceListUsecase) Create(ctx context.Context, req *dto.ReqCreatePriceList, authId string) (*models.PriceList, error) {
+	args := m.Called(ctx, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PriceList), args.Error(1)
+}
+
+func (m *mockPriceListUsecase) Update(ctx context.Context, id string, req *dto.ReqUpdatePriceList, authId string) (*models.PriceList, error) {
+	args := m.Called(ctx, id, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PriceList), args.Error(1)
+}
+
+func (m *mockPriceListUsecase) Delete(ctx context.Context, id string, authId string) error {
+	args := m.Called(ctx, id, authId)
+	return args.Error(0)
+}
+
+func (m *mockPriceListUsecase) GetByID(ctx context.Context, id string) (*models.PriceList, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PriceList), args.Error(1)
+}
+
+func (m *mockPriceListUsecase) GetCustomerCategories(ctx context.Context, id string) ([]models.Parameter, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Parameter), args.Error(1)
+}
+
+func (m *mockPriceListUsecase) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqPriceListIndexFilter) ([]models.PriceList, int, error) {
+	args := m.Called(ctx, req, filter)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]models.PriceList), args.Int(1), args.Error(2)
+}
+
+func (m *mockPriceListUsecase) Export(ctx context.Context, filter dto.ReqPriceListIndexFilter) ([]byte, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *mockPriceListUsecase) CreatePrice(ctx context.Context, priceListID string, req *dto.ReqCreatePriceListPrice, authId string) (*models.PriceListItem, error) {
+	args := m.Called(ctx, priceListID, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PriceListItem), args.Error(1)
+}
+
+func (m *mockPriceListUsecase) UpdatePrice(ctx context.Context, priceListID string, priceID string, req *dto.ReqUpdatePriceListPrice, authId string) (*models.PriceListItem, error) {
+	args := m.Called(ctx, priceListID, priceID, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PriceListItem), args.Error(1)
+}
+
+func (m *mockPriceListUsecase) DeletePrice(ctx context.Context, priceListID string, priceID string, authId string) error {
+	args := m.Called(ctx, priceListID, priceID, authId)
+	return args.Error(0)
+}
+
+func (m *mockPriceListUsecase) GetPriceByID(ctx context.Context, priceListID string, priceID string) (*models.PriceListItem, error) {
+	args := m.Called(ctx, priceListID, priceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PriceListItem), args.Error(1)
+}
+
+func (m *mockPriceListUsecase) GetPrices(ctx context.Context, priceListID string) ([]models.PriceListItem, error) {
+	args := m.Called(ctx, priceListID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.PriceListItem), args.Error(1)
+}
+
+func (m *mockPriceListUsecase) ExportPrices(ctx context.Context, priceListID string) ([]byte, error) {
+	args := m.Called(ctx, priceListID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *mockPriceListUsecase) ImportPricesFromExcel(ctx context.Context, priceListID string, filePath string, authId string) (*dto.ResImportPriceListPrices, error) {
+	args := m.Called(ctx, priceListID, filePath, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ResImportPriceListPrices), args.Error(1)
+}
+
+func (m *mockPriceListUsecase) Resolve(ctx context.Context, req dto.ReqPriceResolve) (*dto.RespPriceResolve, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.RespPriceResolve), args.Error(1)
+}
+
+type mockMiddlewareAuth struct {
+	mock.Mock
+}
+
+func (m *mockMiddlewareAuth) AuthorizationCheck(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return next(c)
+	}
+}
+
+type mockMiddlewarePermission struct {
+	mock.Mock
+}
+
+func (m *mockMiddlewarePermission) PermissionValidation(args []string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			return next(c)
+		}
+	}
+}
+
+type mockMiddlewarePageRequest struct {
+	mock.Mock
+}
+
+func (m *mockMiddlewarePageRequest) PageRequestCtx(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		pageReq := &request.PageRequest{
+			Page:      1,
+			PerPage:   10,
+			SortBy:    "id",
+			SortOrder: "desc",
+		}
+		c.Set("page_request", pageReq)
+		return next(c)
+	}
+}
+
+func (m *mockMiddlewarePageRequest) PageRequestCtxWithoutLimitation(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return next(c)
+	}
+}
+
+type customValidator struct {
+	validator *validator.Validate
+}
+
+func (cv *customValidator) Validate(i interface{}) error {
+	return utils.ValidateRequest(i, cv.validator)
+}
+
+func newEcho() *echo.Echo {
+	e := echo.New()
+	v := validator.New()
+	utils.RegisterCustomValidator(v)
+	e.Validator = &customValidator{validator: v}
+	return e
+}
+
+func newPriceListHandler(mockUC priceListMod.Usecase, mockAuthMw middleware.IMiddlewareAuth, mockPermMw middleware.IMiddlewarePermission, mockPageReqMw reqMw.IMiddlewarePageRequest) *priceListHttp.PriceListHandler {
+	handler := &priceListHttp.PriceListHandler{
+		Usecase: mockUC,
+	}
+	val := reflect.ValueOf(handler).Elem()
+
+	authField := val.FieldByName("middlewareAuth")
+	if authField.IsValid() && authField.CanSet() {
+		authField.Set(reflect.ValueOf(mockAuthMw))
+	}
+
+	permField := val.FieldByName("middlewarePermission")
+	if permField.IsValid() && permField.CanSet() {
+		permField.Set(reflect.ValueOf(mockPermMw))
+	}
+
+	pageReqField := val.FieldByName("mwPageRequest")
+	if pageReqField.IsValid() && pageReqField.CanSet() {
+		pageReqField.Set(reflect.ValueOf(mockPageReqMw))
+	}
+
+	return handler
+}
+
+func TestPriceListHandler_CreateSuccess(t *testing.T) {
+	e := newEcho()
+	categoryID := uuid.New()
+	reqBody := `{"code":"PL-GROSIR","name":"Harga Grosir","priority":10,"customer_category_ids":["` + categoryID.String() + `"]}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/price-list", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", models.User{ID: uuid.New()})
+
+	mockUC := new(mockPriceListUsecase)
+	handler := newPriceListHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	created := &models.PriceList{ID: uuid.New(), Code: "PL-GROSIR", Name: "Harga Grosir", Priority: 10, IsActive: true, CreatedAt: time.Now(), UpdatedAt: time.Now()}
+	mockUC.On("Create", mock.Anything, mock.AnythingOfType("*dto.ReqCreatePriceList"), mock.AnythingOfType("string")).Return(created, nil).Once()
+	mockUC.On("GetByID", mock.Anything, created.ID.String()).Return(created, nil).Once()
+	mockUC.On("GetCustomerCategories", mock.Anything, created.ID.String()).Return([]models.Parameter{{ID: categoryID, Code: "GROSIR", Name: "Grosir"}}, nil).Once()
+
+	err := handler.Create(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Data dto.RespPriceList `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "PL-GROSIR", resp.Data.Code)
+	require.Len(t, resp.Data.CustomerCategories, 1)
+	assert.Equal(t, categoryID, resp.Data.CustomerCategories[0].ID)
+	mockUC.AssertExpectations(t)
+}
+
+func TestPriceListHandler_CreateValidationError(t *testing.T) {
+	e := newEcho()
+	reqBody := `{"name":"Harga Grosir","priority":-1}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/price-list", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockPriceListUsecase)
+	handler := newPriceListHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	err := handler.Create(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestPriceListHandler_GetIndexSuccess(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodGet, "/v1/price-list?page=1&per_page=10&is_active=true", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("page_request", &request.PageRequest{Page: 1, PerPage: 10})
+
+	mockUC := new(mockPriceListUsecase)
+	handler := newPriceListHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	priceLists := []models.PriceList{
+		{ID: uuid.New(), Code: "PL-GROSIR", Name: "Harga Grosir", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+	}
+	mockUC.On("GetIndex", mock.Anything, mock.AnythingOfType("request.PageRequest"), mock.MatchedBy(func(filter dto.ReqPriceListIndexFilter) bool {
+		return len(filter.IsActive) == 1 && filter.IsActive[0]
+	})).Return(priceLists, 1, nil).Once()
+
+	err := handler.GetIndex(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestPriceListHandler_CreatePriceOverlap(t *testing.T) {
+	e := newEcho()
+	priceListID := uuid.New().String()
+	reqBody := `{"item_id":"` + uuid.New().String() + `","min_qty":10,"price":1400,"effective_from":"2025-01-01"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/price-list/"+priceListID+"/prices", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(priceListID)
+
+	mockUC := new(mockPriceListUsecase)
+	handler := newPriceListHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	message := "Another price of the item for unit pcs and minimum quantity 10 overlaps this period"
+	mockUC.On("CreatePrice", mock.Anything, priceListID, mock.AnythingOfType("*dto.ReqCreatePriceListPrice"), mock.AnythingOfType("string")).
+		Return(nil, errors.New(message)).Once()
+
+	err := handler.CreatePrice(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), message)
+	mockUC.AssertExpectations(t)
+}
+
+func TestPriceListHandler_CreatePriceInvalidDate(t *testing.T) {
+	e := newEcho()
+	priceListID := uuid.New().String()
+	reqBody := `{"item_id":"` + uuid.New().String() + `","price":1400,"effective_from":"01/01/2025"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/price-list/"+priceListID+"/prices", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(priceListID)
+
+	mockUC := new(mockPriceListUsecase)
+	handler := newPriceListHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	err := handler.CreatePrice(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestPriceListHandler_ResolveSuccess(t *testing.T) {
+	e := newEcho()
+	itemID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodGet, "/v1/price-list/resolve?item_id="+itemID+"&qty=3&uom=box&date=2025-03-15", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockPriceListUsecase)
+	handler := newPriceListHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	mockUC.On("Resolve", mock.Anything, dto.ReqPriceResolve{ItemID: itemID, Qty: 3, Uom: "box", Date: "2025-03-15"}).
+		Return(&dto.RespPriceResolve{Uom: "box", Qty: 3, UnitPrice: 16000, TotalPrice: 48000}, nil).Once()
+
+	err := handler.Resolve(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Data dto.RespPriceResolve `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, 48000.0, resp.Data.TotalPrice)
+	mockUC.AssertExpectations(t)
+}
+
+func TestPriceListHandler_ResolveValidationError(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodGet, "/v1/price-list/resolve?item_id=not-a-uuid&qty=0", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockPriceListUsecase)
+	handler := newPriceListHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	err := handler.Resolve(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestPriceListHandler_ExportPricesSuccess(t *testing.T) {
+	e := newEcho()
+	priceListID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodGet, "/v1/price-list/"+priceListID+"/prices/export", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(priceListID)
+
+	mockUC := new(mockPriceListUsecase)
+	handler := newPriceListHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	mockUC.On("ExportPrices", mock.Anything, priceListID).Return([]byte("excel"), nil).Once()
+
+	err := handler.ExportPrices(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, constants.ExcelContent, rec.Header().Get(echo.HeaderContentType))
+	mockUC.AssertExpectations(t)
+}
+
+func TestPriceListHandler_DeletePriceSuccess(t *testing.T) {
+	e := newEcho()
+	priceListID := uuid.New().String()
+	priceID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodDelete, "/v1/price-list/"+priceListID+"/prices/"+priceID, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id", "priceId")
+	c.SetParamValues(priceListID, priceID)
+
+	mockUC := new(mockPriceListUsecase)
+	handler := newPriceListHandler(mockUC, new(mockMiddlewareAuth), new(mockMiddlewarePermission), new(mockMiddlewarePageRequest))
+
+	mockUC.On("DeletePrice", mock.Anything, priceListID, priceID, mock.AnythingOfType("string")).Return(nil).Once()
+
+	err := handler.DeletePrice(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
@@ -0,0 +1,31 @@
+package price_list
+
+import (
+	"context"
+
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/price_list/dto"
+)
+
+type Usecase interface {
+	Create(ctx context.Context, req *dto.ReqCreatePriceList, authId string) (*models.PriceList, error)
+	Update(ctx context.Context, id string, req *dto.ReqUpdatePriceList, authId string) (*models.PriceList, error)
+	Delete(ctx context.Context, id string, authId string) error
+	GetByID(ctx context.Context, id string) (*models.PriceList, error)
+	GetCustomerCategories(ctx context.Context, id string) ([]models.Parameter, error)
+	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqPriceListIndexFilter) ([]models.PriceList, int, error)
+	Export(ctx context.Context, filter dto.ReqPriceListIndexFilter) ([]byte, error)
+
+	// Prices
+	CreatePrice(ctx context.Context, priceListID string, req *dto.ReqCreatePriceListPrice, authId string) (*models.PriceListItem, error)
+	UpdatePrice(ctx context.Context, priceListID string, priceID string, req *dto.ReqUpdatePriceListPrice, authId string) (*models.PriceListItem, error)
+	DeletePrice(ctx context.Context, priceListID string, priceID string, authId string) error
+	GetPriceByID(ctx context.Context, priceListID string, priceID string) (*models.PriceListItem, error)
+	GetPrices(ctx context.Context, priceListID string) ([]models.PriceListItem, error)
+	ExportPrices(ctx context.Context, priceListID string) ([]byte, error)
+	ImportPricesFromExcel(ctx context.Context, priceListID string, filePath string, authId string) (*dto.ResImportPriceListPrices, error)
+
+	// Resolution
+	Resolve(ctx context.Context, req dto.ReqPriceResolve) (*dto.RespPriceResolve, error)
+}
@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	paramMod "github.com/rendyfutsuy/base-go/modules/parameter"
+	mod "github.com/rendyfutsuy/base-go/modules/price_list"
+	"github.com/rendyfutsuy/base-go/modules/price_list/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"gorm.io/gorm"
+)
+
+type priceListUsecase struct {
+	repo      mod.Repository
+	paramRepo paramMod.Repository
+}
+
+func NewPriceListUsecase(repo mod.Repository, paramRepo paramMod.Repository) mod.Usecase {
+	return &priceListUsecase{repo: repo, paramRepo: paramRepo}
+}
+
+func (u *priceListUsecase) Create(ctx context.Context, reqBody *dto.ReqCreatePriceList, authId string) (*models.PriceList, error) {
+	code := strings.TrimSpace(reqBody.Code)
+	exists, err := u.repo.ExistsByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, fmt.Errorf(constants.PriceListCodeAlreadyExists, code)
+	}
+
+	categoryIDs, err := u.validateCustomerCategories(ctx, reqBody.CustomerCategoryIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	isActive := true
+	if reqBody.IsActive != nil {
+		isActive = *reqBody.IsActive
+	}
+
+	pl, err := u.repo.Create(ctx, mod.CreatePriceListParams{
+		Code:        code,
+		Name:        reqBody.Name,
+		Description: reqBody.Description,
+		Priority:    reqBody.Priority,
+		IsActive:    isActive,
+		CreatedBy:   authId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.paramRepo.AssignParametersToModule(ctx, constants.ModuleTypePriceList, pl.ID, categoryIDs); err != nil {
+		return nil, err
+	}
+	return pl, nil
+}
+
+func (u *priceListUsecase) Update(ctx context.Context, id string, reqBody *dto.ReqUpdatePriceList, authId string) (*models.PriceList, error) {
+	pid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := u.repo.GetByID(ctx, pid)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.PriceListNotFound, id)
+		}
+		return nil, err
+	}
+
+	categoryIDs, err := u.validateCustomerCategories(ctx, reqBody.CustomerCategoryIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	isActive := current.IsActive
+	if reqBody.IsActive != nil {
+		isActive = *reqBody.IsActive
+	}
+
+	pl, err := u.repo.Update(ctx, pid, mod.UpdatePriceListParams{
+		Name:        reqBody.Name,
+		Description: reqBody.Description,
+		Priority:    reqBody.Priority,
+		IsActive:    isActive,
+		UpdatedBy:   authId,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.PriceListNotFound, id)
+		}
+		return nil, err
+	}
+
+	// Replace the customer categories
+	if err := u.paramRepo.RemoveParametersFromModule(ctx, constants.ModuleTypePriceList, pid); err != nil {
+		return nil, err
+	}
+	if err := u.paramRepo.AssignParametersToModule(ctx, constants.ModuleTypePriceList, pid, categoryIDs); err != nil {
+		return nil, err
+	}
+	return pl, nil
+}
+
+func (u *priceListUsecase) Delete(ctx context.Context, id string, authId string) error {
+	pid, err := utils.StringToUUID(id)
+	if err != nil {
+		return err
+	}
+	return u.repo.Delete(ctx, pid, authId)
+}
+
+func (u *priceListUsecase) GetByID(ctx context.Context, id string) (*models.PriceList, error) {
+	pid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	res, err := u.repo.GetByID(ctx, pid)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.PriceListNotFound, id)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *priceListUsecase) GetCustomerCategories(ctx context.Context, id string) ([]models.Parameter, error) {
+	pid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.paramRepo.GetByModule(ctx, constants.ModuleTypePriceList, pid)
+}
+
+func (u *priceListUsecase) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqPriceListIndexFilter) ([]models.PriceList, int, error) {
+	return u.repo.GetIndex(ctx, req, filter)
+}
+
+func (u *priceListUsecase) Export(ctx context.Context, filter dto.ReqPriceListIndexFilter) ([]byte, error) {
+	list, err := u.repo.GetAllForExport(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := []string{"Code", "Name", "Description", "Priority", "Active", "Customer Categories", "Prices", "Update Date"}
+	rows := make([][]interface{}, 0, len(list))
+	for _, pl := range list {
+		description := "-"
+		if pl.Description != nil && *pl.Description != "" {
+			description = *pl.Description
+		}
+		categories := "-"
+		if len(pl.CustomerCategories) > 0 {
+			categories = strings.Join(pl.CustomerCategories, "; ")
+		}
+		rows = append(rows, []interface{}{
+			pl.Code,
+			pl.Name,
+			description,
+			pl.Priority,
+			formatPriceListIsActive(pl.IsActive),
+			categories,
+			pl.PriceCount,
+			pl.UpdatedAt.Local().Format("2006/01/02"),
+		})
+	}
+
+	return writePriceListSheet("Price Lists", headers, rows)
+}
@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	mod "github.com/rendyfutsuy/base-go/modules/price_list"
+	"github.com/rendyfutsuy/base-go/modules/price_list/dto"
+)
+
+// importedPricePeriod is the period of a price seen in the file or already stored, keyed by item, unit and quantity break
+type importedPricePeriod struct {
+	Row           int // 0 for a stored price
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+}
+
+// ImportPricesFromExcel upserts the prices of a price list from the rows of an Excel file with columns:
+// item_code, uom (optional, base unit), min_qty (optional, 1), price, effective_from, effective_to (optional).
+// A row with the item, unit, quantity break and effective_from of a stored price updates it.
+func (u *priceListUsecase) ImportPricesFromExcel(ctx context.Context, priceListID string, filePath string, authId string) (*dto.ResImportPriceListPrices, error) {
+	pid, err := u.resolvePriceListID(ctx, priceListID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := readPriceListImportRows(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Phase 1: load every item of the file and the stored prices in batch
+	codes := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if code := priceListImportCell(row, 0); code != "" {
+			codes = append(codes, code)
+		}
+	}
+	items, err := u.repo.GetItemUnitsByCodes(ctx, codes)
+	if err != nil {
+		return nil, err
+	}
+	itemsByCode := make(map[string]dto.PriceListItemUnits, len(items))
+	for _, item := range items {
+		itemsByCode[item.ItemCode] = item
+	}
+
+	stored, err := u.repo.GetPricesByPriceListID(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	periods := make(map[string][]importedPricePeriod)
+	for _, price := range stored {
+		key := priceImportKey(price.ItemID, price.Uom, price.MinQty)
+		periods[key] = append(periods[key], importedPricePeriod{EffectiveFrom: price.EffectiveFrom, EffectiveTo: price.EffectiveTo})
+	}
+
+	// Phase 2: validate every row
+	results := make([]dto.ResImportPriceListPriceExcel, 0, len(rows))
+	validParams := make([]mod.CreatePriceListPriceParams, 0, len(rows))
+	validResultIndices := make([]int, 0, len(rows))
+	seen := make(map[string]int)
+
+	for i, row := range rows {
+		if isBlankPriceListImportRow(row) {
+			continue
+		}
+		rowNum := i + 2 // Excel row number, after the header
+
+		code := priceListImportCell(row, 0)
+		result := dto.ResImportPriceListPriceExcel{Row: rowNum, ItemCode: code}
+
+		// Collect all validation errors (akumulatif)
+		var allErrors []string
+
+		var item *dto.PriceListItemUnits
+		if code == "" {
+			allErrors = append(allErrors, constants.PriceListImportItemCodeRequired)
+		} else if found, ok := itemsByCode[code]; ok {
+			item = &found
+		} else {
+			allErrors = append(allErrors, fmt.Sprintf(constants.PriceListImportItemNotFound, code))
+		}
+
+		uom := priceListImportCell(row, 1)
+		if len(uom) > 20 {
+			allErrors = append(allErrors, constants.PriceListImportUomTooLong)
+		} else if item != nil {
+			matched, err := matchPriceListUom(*item, uom)
+			if err != nil {
+				allErrors = append(allErrors, err.Error())
+			}
+			uom = matched
+		}
+
+		minQty := 1.0
+		if value := priceListImportCell(row, 2); value != "" {
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil || number <= 0 {
+				allErrors = append(allErrors, constants.PriceListImportMinQtyInvalid)
+			}
+			minQty = number
+		}
+
+		price, err := strconv.ParseFloat(priceListImportCell(row, 3), 64)
+		if err != nil || price < 0 {
+			allErrors = append(allErrors, constants.PriceListImportPriceInvalid)
+		}
+
+		var effectiveFrom *time.Time
+		if value := priceListImportCell(row, 4); value == "" {
+			allErrors = append(allErrors, constants.PriceListImportDateRequired)
+		} else if effectiveFrom, err = parsePriceListImportDate(value, "effective_from"); err != nil {
+			allErrors = append(allErrors, err.Error())
+		}
+
+		var effectiveTo *time.Time
+		if value := priceListImportCell(row, 5); value != "" && value != "-" {
+			if effectiveTo, err = parsePriceListImportDate(value, "effective_to"); err != nil {
+				allErrors = append(allErrors, err.Error())
+			} else if effectiveFrom != nil && effectiveTo.Before(*effectiveFrom) {
+				allErrors = append(allErrors, constants.PriceListPeriodInvalid)
+			}
+		}
+
+		if len(allErrors) == 0 {
+			key := priceImportKey(item.ItemID, uom, minQty)
+			rowKey := key + "|" + effectiveFrom.Format("2006-01-02")
+			if firstRow, ok := seen[rowKey]; ok {
+				allErrors = append(allErrors, fmt.Sprintf(constants.PriceListImportRowDuplicated, firstRow))
+			} else {
+				for _, period := range periods[key] {
+					// a stored price with the same start date is updated by the row
+					if period.Row == 0 && period.EffectiveFrom.Equal(*effectiveFrom) {
+						continue
+					}
+					if !pricePeriodsOverlap(period.EffectiveFrom, period.EffectiveTo, *effectiveFrom, effectiveTo) {
+						continue
+					}
+					if period.Row > 0 {
+						allErrors = append(allErrors, fmt.Sprintf(constants.PriceListImportRowOverlap, period.Row))
+					} else {
+						allErrors = append(allErrors, fmt.Sprintf(constants.PriceListPeriodOverlap, uom, formatPriceListQty(minQty)))
+					}
+					break
+				}
+				seen[rowKey] = rowNum
+			}
+			if len(allErrors) == 0 {
+				periods[key] = append(periods[key], importedPricePeriod{Row: rowNum, EffectiveFrom: *effectiveFrom, EffectiveTo: effectiveTo})
+			}
+		}
+
+		if len(allErrors) > 0 {
+			result.Status = "failed"
+			result.ErrorMessage = strings.Join(allErrors, "; ")
+			results = append(results, result)
+			continue
+		}
+
+		validParams = append(validParams, mod.CreatePriceListPriceParams{
+			PriceListID:   pid,
+			ItemID:        item.ItemID,
+			Uom:           uom,
+			MinQty:        minQty,
+			Price:         price,
+			EffectiveFrom: *effectiveFrom,
+			EffectiveTo:   effectiveTo,
+			CreatedBy:     authId,
+		})
+		validResultIndices = append(validResultIndices, len(results))
+
+		// Mark as success (will be validated after batch upsert)
+		result.Success = true
+		result.Status = "success"
+		results = append(results, result)
+	}
+
+	// Phase 3: save the valid rows in one transaction
+	if len(validParams) > 0 {
+		if err := u.repo.UpsertPrices(ctx, validParams); err != nil {
+			// If batch upsert fails, mark all pending rows as failed
+			for _, idx := range validResultIndices {
+				results[idx].Success = false
+				results[idx].Status = "failed"
+				results[idx].ErrorMessage = fmt.Sprintf("%s: %v", constants.PriceListImportBatchSaveFailed, err)
+			}
+		}
+	}
+
+	return toResImportPriceListPrices(results), nil
+}
+
+// priceImportKey identifies the prices of an item unit and quantity break, their periods must not overlap
+func priceImportKey(itemID uuid.UUID, uom string, minQty float64) string {
+	return itemID.String() + "|" + strings.ToLower(uom) + "|" + formatPriceListQty(minQty)
+}
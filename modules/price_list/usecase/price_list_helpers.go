@@ -0,0 +1,266 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/modules/price_list/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/xuri/excelize/v2"
+	"gorm.io/gorm"
+)
+
+// resolvePriceListID parses the price list id and makes sure the price list exists
+func (u *priceListUsecase) resolvePriceListID(ctx context.Context, id string) (uuid.UUID, error) {
+	pid, err := utils.StringToUUID(id)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if _, err := u.repo.GetByID(ctx, pid); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, fmt.Errorf(constants.PriceListNotFound, id)
+		}
+		return uuid.Nil, err
+	}
+	return pid, nil
+}
+
+// validateCustomerCategories makes sure every id is a customer_category parameter and drops the duplicated ones
+func (u *priceListUsecase) validateCustomerCategories(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	categoryIDs := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		p, err := u.paramRepo.GetByID(ctx, id)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		if p == nil || p.Type == nil || *p.Type != constants.CustomerCategoryParameterType {
+			return nil, fmt.Errorf(constants.PriceListCategoryInvalid, id)
+		}
+		categoryIDs = append(categoryIDs, id)
+	}
+	return categoryIDs, nil
+}
+
+// matchPriceListUom returns the unit of the item matching uom written in any case, the base unit when uom is empty
+func matchPriceListUom(item dto.PriceListItemUnits, uom string) (string, error) {
+	uom = strings.TrimSpace(uom)
+	if uom == "" {
+		return item.Uoms[0], nil
+	}
+	for _, itemUom := range item.Uoms {
+		if strings.EqualFold(itemUom, uom) {
+			return itemUom, nil
+		}
+	}
+	return "", fmt.Errorf(constants.PriceListUomInvalid, uom)
+}
+
+// parsePriceListDate parses a YYYY-MM-DD date
+func parsePriceListDate(value string) (time.Time, error) {
+	return time.Parse("2006-01-02", strings.TrimSpace(value))
+}
+
+// pricePeriodsOverlap reports whether two periods share a day, an empty end is open ended
+func pricePeriodsOverlap(fromA time.Time, toA *time.Time, fromB time.Time, toB *time.Time) bool {
+	if toA != nil && toA.Before(fromB) {
+		return false
+	}
+	if toB != nil && toB.Before(fromA) {
+		return false
+	}
+	return true
+}
+
+// formatPriceListQty writes a quantity without trailing zeros
+func formatPriceListQty(qty float64) string {
+	return strconv.FormatFloat(qty, 'f', -1, 64)
+}
+
+// parsePriceDecimal reads a decimal exactly, e.g. the text of a NUMERIC column
+func parsePriceDecimal(value string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok {
+		return nil, fmt.Errorf("invalid decimal %q", value)
+	}
+	return r, nil
+}
+
+// priceDecimalFloat converts an exact decimal back to the float64 of the response
+func priceDecimalFloat(r *big.Rat) float64 {
+	f, _ := r.Float64()
+	return f
+}
+
+// roundPrice rounds an amount half up to cents (the scale of price), amounts are never negative
+func roundPrice(amount *big.Rat) *big.Rat {
+	cents := new(big.Rat).Mul(amount, big.NewRat(100, 1))
+
+	// (2 * num + den) / (2 * den) is cents + 1/2 truncated
+	twiceDen := new(big.Int).Mul(cents.Denom(), big.NewInt(2))
+	rounded := new(big.Int).Mul(cents.Num(), big.NewInt(2))
+	rounded.Add(rounded, cents.Denom())
+	rounded.Quo(rounded, twiceDen)
+
+	return new(big.Rat).SetFrac(rounded, big.NewInt(100))
+}
+
+func formatPriceListDate(date *time.Time) string {
+	if date == nil {
+		return "-"
+	}
+	return date.Format("2006-01-02")
+}
+
+func formatPriceListIsActive(isActive bool) string {
+	if isActive {
+		return "yes"
+	}
+	return "no"
+}
+
+// readPriceListImportRows returns the data rows of the first sheet, without the header row
+func readPriceListImportRows(filePath string) ([][]string, error) {
+	f, err := excelize.OpenFile(filePath)
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, fmt.Errorf("%s: %v", constants.PriceListImportExcelOpenFailed, err)
+	}
+	defer f.Close()
+
+	rows, err := f.GetRows(f.GetSheetName(0))
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, fmt.Errorf("%s: %v", constants.PriceListImportExcelReadFailed, err)
+	}
+
+	if len(rows) < 2 {
+		return nil, errors.New(constants.PriceListImportExcelInsufficientRows)
+	}
+
+	return rows[1:], nil
+}
+
+// priceListImportCell returns the trimmed cell of a row, empty when the row is shorter
+func priceListImportCell(row []string, index int) string {
+	if index < len(row) {
+		return strings.TrimSpace(row[index])
+	}
+	return ""
+}
+
+// isBlankPriceListImportRow reports whether every cell of the row is empty
+func isBlankPriceListImportRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// parsePriceListImportDate parses a date cell, written as YYYY-MM-DD like the export or in a format Excel displays dates in
+func parsePriceListImportDate(value string, field string) (*time.Time, error) {
+	if date, err := parsePriceListDate(value); err == nil {
+		return &date, nil
+	}
+	date, err := utils.UniversalTimeParser(value)
+	if err != nil {
+		return nil, fmt.Errorf(constants.PriceListImportDateInvalid, field)
+	}
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	return &day, nil
+}
+
+// toResImportPriceListPrices counts the row results of a price import
+func toResImportPriceListPrices(results []dto.ResImportPriceListPriceExcel) *dto.ResImportPriceListPrices {
+	successCount := 0
+	failedCount := 0
+	for _, result := range results {
+		if result.Status == "success" {
+			successCount++
+		} else {
+			failedCount++
+		}
+	}
+
+	return &dto.ResImportPriceListPrices{
+		TotalRows:    len(results),
+		SuccessCount: successCount,
+		FailedCount:  failedCount,
+		Results:      results,
+	}
+}
+
+// writePriceListSheet builds a single sheet workbook with a bold header row and bordered cells
+func writePriceListSheet(sheet string, headers []string, rows [][]interface{}) ([]byte, error) {
+	f := excelize.NewFile()
+	defer f.Close()
+	f.SetSheetName("Sheet1", sheet)
+
+	for i, header := range headers {
+		cell, _ := excelize.CoordinatesToCellName(i+1, 1)
+		f.SetCellValue(sheet, cell, header)
+	}
+	for i, row := range rows {
+		for j, value := range row {
+			cell, _ := excelize.CoordinatesToCellName(j+1, i+2)
+			// codes and dates are text so they are read back as written
+			if text, ok := value.(string); ok {
+				f.SetCellStr(sheet, cell, text)
+				continue
+			}
+			f.SetCellValue(sheet, cell, value)
+		}
+	}
+
+	// Define border configuration
+	borderDefinition := []excelize.Border{
+		{Type: "left", Color: "000000", Style: 1},
+		{Type: "top", Color: "000000", Style: 1},
+		{Type: "bottom", Color: "000000", Style: 1},
+		{Type: "right", Color: "000000", Style: 1},
+	}
+
+	borderStyle, err := f.NewStyle(&excelize.Style{
+		Border: borderDefinition,
+	})
+	if err != nil {
+		return nil, err
+	}
+	endCell, _ := excelize.CoordinatesToCellName(len(headers), len(rows)+1)
+	if err := f.SetCellStyle(sheet, "A1", endCell, borderStyle); err != nil {
+		return nil, err
+	}
+
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font:   &excelize.Font{Bold: true},
+		Border: borderDefinition,
+	})
+	if err != nil {
+		return nil, err
+	}
+	headerEndCell, _ := excelize.CoordinatesToCellName(len(headers), 1)
+	if err := f.SetCellStyle(sheet, "A1", headerEndCell, headerStyle); err != nil {
+		return nil, err
+	}
+
+	// Write to buffer and return bytes
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
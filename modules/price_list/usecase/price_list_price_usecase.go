@@ -0,0 +1,303 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/price_list"
+	"github.com/rendyfutsuy/base-go/modules/price_list/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"gorm.io/gorm"
+)
+
+// priceInput is a price request once its item, unit, quantity break and period are checked
+type priceInput struct {
+	ItemID        uuid.UUID
+	Uom           string
+	MinQty        float64
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+}
+
+// validatePrice checks the item and its unit, the period and that no other price of the item, unit and quantity break overlaps it
+func (u *priceListUsecase) validatePrice(ctx context.Context, priceListID uuid.UUID, itemID uuid.UUID, uom string, minQty *float64, effectiveFrom string, effectiveTo *string, excludeID uuid.UUID) (*priceInput, error) {
+	item, err := u.repo.GetItemUnits(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New(constants.PriceListItemNotFound)
+		}
+		return nil, err
+	}
+
+	input := &priceInput{ItemID: itemID, MinQty: 1}
+	if input.Uom, err = matchPriceListUom(*item, uom); err != nil {
+		return nil, err
+	}
+	if minQty != nil {
+		input.MinQty = *minQty
+	}
+
+	if input.EffectiveFrom, err = parsePriceListDate(effectiveFrom); err != nil {
+		return nil, err
+	}
+	if effectiveTo != nil && *effectiveTo != "" {
+		to, err := parsePriceListDate(*effectiveTo)
+		if err != nil {
+			return nil, err
+		}
+		if to.Before(input.EffectiveFrom) {
+			return nil, errors.New(constants.PriceListPeriodInvalid)
+		}
+		input.EffectiveTo = &to
+	}
+
+	overlap, err := u.repo.ExistsOverlappingPrice(ctx, mod.PriceOverlapParams{
+		PriceListID:   priceListID,
+		ItemID:        itemID,
+		Uom:           input.Uom,
+		MinQty:        input.MinQty,
+		EffectiveFrom: input.EffectiveFrom,
+		EffectiveTo:   input.EffectiveTo,
+		ExcludeID:     excludeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if overlap {
+		return nil, fmt.Errorf(constants.PriceListPeriodOverlap, input.Uom, formatPriceListQty(input.MinQty))
+	}
+
+	return input, nil
+}
+
+func (u *priceListUsecase) CreatePrice(ctx context.Context, priceListID string, reqBody *dto.ReqCreatePriceListPrice, authId string) (*models.PriceListItem, error) {
+	pid, err := u.resolvePriceListID(ctx, priceListID)
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := u.validatePrice(ctx, pid, reqBody.ItemID, reqBody.Uom, reqBody.MinQty, reqBody.EffectiveFrom, reqBody.EffectiveTo, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.repo.CreatePrice(ctx, mod.CreatePriceListPriceParams{
+		PriceListID:   pid,
+		ItemID:        input.ItemID,
+		Uom:           input.Uom,
+		MinQty:        input.MinQty,
+		Price:         reqBody.Price,
+		EffectiveFrom: input.EffectiveFrom,
+		EffectiveTo:   input.EffectiveTo,
+		CreatedBy:     authId,
+	})
+}
+
+func (u *priceListUsecase) UpdatePrice(ctx context.Context, priceListID string, priceID string, reqBody *dto.ReqUpdatePriceListPrice, authId string) (*models.PriceListItem, error) {
+	pid, err := u.resolvePriceListID(ctx, priceListID)
+	if err != nil {
+		return nil, err
+	}
+	rid, err := utils.StringToUUID(priceID)
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := u.validatePrice(ctx, pid, reqBody.ItemID, reqBody.Uom, reqBody.MinQty, reqBody.EffectiveFrom, reqBody.EffectiveTo, rid)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.repo.UpdatePrice(ctx, pid, rid, mod.UpdatePriceListPriceParams{
+		ItemID:        input.ItemID,
+		Uom:           input.Uom,
+		MinQty:        input.MinQty,
+		Price:         reqBody.Price,
+		EffectiveFrom: input.EffectiveFrom,
+		EffectiveTo:   input.EffectiveTo,
+		UpdatedBy:     authId,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.PriceListPriceNotFound, priceID)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *priceListUsecase) DeletePrice(ctx context.Context, priceListID string, priceID string, authId string) error {
+	pid, err := u.resolvePriceListID(ctx, priceListID)
+	if err != nil {
+		return err
+	}
+	rid, err := utils.StringToUUID(priceID)
+	if err != nil {
+		return err
+	}
+	if err := u.repo.DeletePrice(ctx, pid, rid, authId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf(constants.PriceListPriceNotFound, priceID)
+		}
+		return err
+	}
+	return nil
+}
+
+func (u *priceListUsecase) GetPriceByID(ctx context.Context, priceListID string, priceID string) (*models.PriceListItem, error) {
+	pid, err := u.resolvePriceListID(ctx, priceListID)
+	if err != nil {
+		return nil, err
+	}
+	rid, err := utils.StringToUUID(priceID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := u.repo.GetPriceByID(ctx, pid, rid)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.PriceListPriceNotFound, priceID)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *priceListUsecase) GetPrices(ctx context.Context, priceListID string) ([]models.PriceListItem, error) {
+	pid, err := u.resolvePriceListID(ctx, priceListID)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.GetPricesByPriceListID(ctx, pid)
+}
+
+// priceExportHeaders starts with the import columns so an export can be imported again
+var priceExportHeaders = []string{"Item Code", "UoM", "Min Qty", "Price", "Effective From", "Effective To", "Item Name", "Update Date"}
+
+func (u *priceListUsecase) ExportPrices(ctx context.Context, priceListID string) ([]byte, error) {
+	pid, err := u.resolvePriceListID(ctx, priceListID)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := u.repo.GetPricesByPriceListID(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([][]interface{}, 0, len(prices))
+	for _, price := range prices {
+		rows = append(rows, []interface{}{
+			price.ItemCode,
+			price.Uom,
+			price.MinQty,
+			price.Price,
+			price.EffectiveFrom.Format("2006-01-02"),
+			formatPriceListDate(price.EffectiveTo),
+			price.ItemName,
+			price.UpdatedAt.Local().Format("2006/01/02"),
+		})
+	}
+
+	return writePriceListSheet("Prices", priceExportHeaders, rows)
+}
+
+// Resolve returns the unit and total price of a quantity of an item for a customer category on a date
+func (u *priceListUsecase) Resolve(ctx context.Context, req dto.ReqPriceResolve) (*dto.RespPriceResolve, error) {
+	itemID, err := utils.StringToUUID(req.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := u.repo.GetItemUnits(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New(constants.PriceListItemNotFound)
+		}
+		return nil, err
+	}
+	uom, err := matchPriceListUom(*item, req.Uom)
+	if err != nil {
+		return nil, err
+	}
+
+	var categoryID *uuid.UUID
+	if req.CustomerCategoryID != "" {
+		cid, err := utils.StringToUUID(req.CustomerCategoryID)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := u.validateCustomerCategories(ctx, []uuid.UUID{cid}); err != nil {
+			return nil, err
+		}
+		categoryID = &cid
+	}
+
+	// today by default
+	now := time.Now()
+	date := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if req.Date != "" {
+		if date, err = parsePriceListDate(req.Date); err != nil {
+			return nil, err
+		}
+	}
+
+	candidate, err := u.repo.ResolvePrice(ctx, mod.PriceResolveParams{
+		ItemID:             itemID,
+		Uom:                uom,
+		Qty:                req.Qty,
+		CustomerCategoryID: categoryID,
+		Date:               date,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if candidate == nil {
+		return nil, errors.New(constants.PriceListNoPriceFound)
+	}
+
+	// the total is computed on exact decimals and rounded to cents once
+	price, err := parsePriceDecimal(candidate.Price)
+	if err != nil {
+		return nil, err
+	}
+	qty, err := parsePriceDecimal(formatPriceListQty(req.Qty))
+	if err != nil {
+		return nil, err
+	}
+	total := roundPrice(new(big.Rat).Mul(price, qty))
+
+	var effectiveTo *string
+	if candidate.EffectiveTo != nil {
+		value := candidate.EffectiveTo.Format("2006-01-02")
+		effectiveTo = &value
+	}
+
+	return &dto.RespPriceResolve{
+		ItemID:             item.ItemID,
+		ItemCode:           item.ItemCode,
+		ItemName:           item.ItemName,
+		Uom:                uom,
+		Qty:                req.Qty,
+		Date:               date.Format("2006-01-02"),
+		CustomerCategoryID: categoryID,
+		PriceList: dto.RespPriceListReference{
+			ID:       candidate.PriceListID,
+			Code:     candidate.PriceListCode,
+			Name:     candidate.PriceListName,
+			Priority: candidate.Priority,
+		},
+		PriceListItemID: candidate.PriceListItemID,
+		CategoryPrice:   candidate.CategoryMatched,
+		MinQty:          candidate.MinQty,
+		EffectiveFrom:   candidate.EffectiveFrom.Format("2006-01-02"),
+		EffectiveTo:     effectiveTo,
+		UnitPrice:       priceDecimalFloat(price),
+		TotalPrice:      priceDecimalFloat(total),
+	}, nil
+}
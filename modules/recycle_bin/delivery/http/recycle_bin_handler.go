@@ -0,0 +1,153 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/middleware"
+	_reqContext "github.com/rendyfutsuy/base-go/helpers/middleware/request"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/modules/recycle_bin"
+	"github.com/rendyfutsuy/base-go/modules/recycle_bin/dto"
+)
+
+type ResponseError struct {
+	Message string `json:"message"`
+}
+
+type Response struct {
+	Message string `json:"message"`
+}
+
+type RecycleBinHandler struct {
+	Usecase              recycle_bin.Usecase
+	mwPageRequest        _reqContext.IMiddlewarePageRequest
+	middlewareAuth       middleware.IMiddlewareAuth
+	middlewarePermission middleware.IMiddlewarePermission
+}
+
+func NewRecycleBinHandler(e *echo.Echo, uc recycle_bin.Usecase, mwP _reqContext.IMiddlewarePageRequest, auth middleware.IMiddlewareAuth, middlewarePermission middleware.IMiddlewarePermission) {
+	h := &RecycleBinHandler{Usecase: uc, mwPageRequest: mwP, middlewareAuth: auth, middlewarePermission: middlewarePermission}
+
+	r := e.Group("/v1/recycle-bin")
+	r.Use(h.middlewareAuth.AuthorizationCheck)
+
+	// Every resource is guarded by the delete permission of its own module,
+	// e.g. /v1/recycle-bin/sub-groups requires sub-group.delete
+	for _, resource := range recycle_bin.Resources {
+		permission := h.middlewarePermission.PermissionValidation([]string{resource.Permission})
+
+		r.GET("/"+resource.Key, h.GetDeletedIndex(resource), middleware.RequireActivatedUser, h.mwPageRequest.PageRequestCtx, permission)
+		r.PATCH("/"+resource.Key+"/:id/restore", h.Restore(resource), middleware.RequireActivatedUser, permission)
+		r.DELETE("/"+resource.Key+"/:id", h.HardDelete(resource), middleware.RequireActivatedUser, permission)
+	}
+}
+
+// GetDeletedIndex godoc
+// @Summary		Get list of deleted records with pagination
+// @Description	Retrieve a paginated list of soft deleted records of a resource, including who deleted them and when they will be purged
+// @Tags			Recycle Bin
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			resource	path		string	true	"Resource (users, roles, groups, sub-groups, types, backings, items, price-lists, expeditions, suppliers, customers, parameters, provinces, cities, districts, subdistricts, posts)"
+// @Param			page		query		int		false	"Page number"
+// @Param			per_page	query		int		false	"Items per page"
+// @Param			search		query		string	false	"Search by name or code"
+// @Param			sort_by		query		string	false	"Sort by (name, code, deleted_at)"
+// @Param			sort_order	query		string	false	"Sort order (asc, desc)"
+// @Success		200			{object}	response.PaginationResponse{data=[]dto.RespTrashItem}	"Successfully retrieved deleted records"
+// @Failure		400			{object}	response.NonPaginationResponse	"Bad request"
+// @Failure		401			{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/recycle-bin/{resource} [get]
+func (h *RecycleBinHandler) GetDeletedIndex(resource dto.TrashResource) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		// initialize context from echo
+		ctx := c.Request().Context()
+
+		pageRequest := c.Get("page_request").(*request.PageRequest)
+
+		res, total, err := h.Usecase.GetDeletedIndex(ctx, resource.Key, *pageRequest)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+		}
+
+		respPag := response.PaginationResponse{}
+		respPag, err = respPag.SetResponse(res, total, pageRequest.PerPage, pageRequest.Page)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+		}
+
+		return c.JSON(http.StatusOK, respPag)
+	}
+}
+
+// Restore godoc
+// @Summary		Restore a deleted record
+// @Description	Restore a soft deleted record. Refused when an active record already uses the same unique values or when the record it belongs to is still deleted
+// @Tags			Recycle Bin
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			resource	path		string	true	"Resource"
+// @Param			id			path		string	true	"Record UUID"
+// @Success		200			{object}	response.NonPaginationResponse	"Successfully restored record"
+// @Failure		400			{object}	response.NonPaginationResponse	"Bad request - invalid UUID, conflict or parent deleted"
+// @Failure		401			{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/recycle-bin/{resource}/{id}/restore [patch]
+func (h *RecycleBinHandler) Restore(resource dto.TrashResource) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		// initialize context from echo
+		ctx := c.Request().Context()
+
+		id := c.Param("id")
+		if err := uuid.Validate(id); err != nil {
+			return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, constants.ErrorUUIDNotRecognized))
+		}
+
+		if err := h.Usecase.Restore(ctx, resource.Key, id); err != nil {
+			return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+		}
+
+		resp := response.NonPaginationResponse{}
+		resp, _ = resp.SetResponse(Response{Message: fmt.Sprintf(constants.RecycleBinRestoreSuccess, resource.Label)})
+		return c.JSON(http.StatusOK, resp)
+	}
+}
+
+// HardDelete godoc
+// @Summary		Permanently delete a deleted record
+// @Description	Permanently delete a soft deleted record together with its dependent rows. Refused while other data still references it
+// @Tags			Recycle Bin
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			resource	path		string	true	"Resource"
+// @Param			id			path		string	true	"Record UUID"
+// @Success		200			{object}	response.NonPaginationResponse	"Successfully deleted record permanently"
+// @Failure		400			{object}	response.NonPaginationResponse	"Bad request - invalid UUID or still referenced"
+// @Failure		401			{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/recycle-bin/{resource}/{id} [delete]
+func (h *RecycleBinHandler) HardDelete(resource dto.TrashResource) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		// initialize context from echo
+		ctx := c.Request().Context()
+
+		id := c.Param("id")
+		if err := uuid.Validate(id); err != nil {
+			return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, constants.ErrorUUIDNotRecognized))
+		}
+
+		if err := h.Usecase.HardDelete(ctx, resource.Key, id); err != nil {
+			return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+		}
+
+		resp := response.NonPaginationResponse{}
+		resp, _ = resp.SetResponse(Response{Message: fmt.Sprintf(constants.RecycleBinHardDeleteSuccess, resource.Label)})
+		return c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,101 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrashResource describes a soft deletable table exposed through the recycle bin
+type TrashResource struct {
+	// Key is the resource segment used in the url, e.g. "sub-groups"
+	Key string
+	// Label is the singular human readable name, e.g. "sub-group"
+	Label string
+	// Table is the database table holding the records
+	Table string
+	// Permission required to list, restore and permanently delete the records
+	Permission string
+	// CodeColumn is optional, NameColumn is required. Both are shown in the listing.
+	CodeColumn string
+	NameColumn string
+	// HasDeletedBy tells whether the table stores who deleted the record
+	HasDeletedBy bool
+	// UniqueKeys must not collide with an active record on restore
+	UniqueKeys []TrashUniqueKey
+	// Parents must be active on restore
+	Parents []TrashParent
+	// Dependents are removed before the record is permanently deleted
+	Dependents []TrashDependent
+	// KeepForever excludes the records from the automatic purge, they can only be deleted by hand
+	KeepForever bool
+	// Scope is an optional condition on the table alias "t" hiding records from the recycle bin
+	Scope string
+}
+
+type TrashUniqueKey struct {
+	Columns []string
+	Label   string
+}
+
+type TrashParent struct {
+	Column string
+	Table  string
+	Label  string
+}
+
+type TrashDependent struct {
+	Table  string
+	Column string
+	// ModuleType filters polymorphic pivot tables (module_type / module_id)
+	ModuleType string
+	// EntityType filters polymorphic tables keyed by entity_type / entity_id, e.g. translations
+	EntityType string
+}
+
+// TrashItem is the scan target of a deleted record
+type TrashItem struct {
+	ID            uuid.UUID `gorm:"column:id"`
+	Code          *string   `gorm:"column:code"`
+	Name          string    `gorm:"column:name"`
+	DeletedAt     time.Time `gorm:"column:deleted_at"`
+	DeletedBy     *string   `gorm:"column:deleted_by"`
+	DeletedByName *string   `gorm:"column:deleted_by_name"`
+}
+
+type RespTrashItem struct {
+	Resource      string     `json:"resource"`
+	ID            uuid.UUID  `json:"id"`
+	Code          *string    `json:"code"`
+	Name          string     `json:"name"`
+	DeletedAt     time.Time  `json:"deleted_at"`
+	DeletedBy     *string    `json:"deleted_by"`
+	DeletedByName *string    `json:"deleted_by_name"`
+	PurgeAt       *time.Time `json:"purge_at"`
+}
+
+type RespRecycleBinPurge struct {
+	Purged    int            `json:"purged"`
+	Skipped   int            `json:"skipped"`
+	Resources map[string]int `json:"resources"`
+}
+
+// ToRespTrashItem maps a deleted record, purgeAt is computed from the retention days (0 = kept forever)
+func ToRespTrashItem(resource string, item TrashItem, retentionDays int) RespTrashItem {
+	resp := RespTrashItem{
+		Resource:      resource,
+		ID:            item.ID,
+		Code:          item.Code,
+		Name:          item.Name,
+		DeletedAt:     item.DeletedAt,
+		DeletedBy:     item.DeletedBy,
+		DeletedByName: item.DeletedByName,
+	}
+
+	if retentionDays > 0 {
+		purgeAt := item.DeletedAt.AddDate(0, 0, retentionDays)
+		resp.PurgeAt = &purgeAt
+	}
+
+	return resp
+}
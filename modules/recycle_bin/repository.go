@@ -0,0 +1,20 @@
+package recycle_bin
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/modules/recycle_bin/dto"
+)
+
+type Repository interface {
+	GetDeletedIndex(ctx context.Context, resource dto.TrashResource, req request.PageRequest) ([]dto.TrashItem, int, error)
+	GetDeletedByID(ctx context.Context, resource dto.TrashResource, id uuid.UUID) (*dto.TrashItem, error)
+	ExistsActiveDuplicate(ctx context.Context, resource dto.TrashResource, key dto.TrashUniqueKey, id uuid.UUID) (bool, error)
+	IsParentActive(ctx context.Context, resource dto.TrashResource, parent dto.TrashParent, id uuid.UUID) (bool, error)
+	Restore(ctx context.Context, resource dto.TrashResource, id uuid.UUID) error
+	HardDelete(ctx context.Context, resource dto.TrashResource, id uuid.UUID) error
+	GetExpiredIDs(ctx context.Context, resource dto.TrashResource, before time.Time) ([]uuid.UUID, error)
+}
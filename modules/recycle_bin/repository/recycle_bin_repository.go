@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/modules/recycle_bin/dto"
+	"gorm.io/gorm"
+)
+
+// table and column names used below come from the recycle bin resource registry, never from the request
+
+type recycleBinRepository struct {
+	DB *gorm.DB
+}
+
+func NewRecycleBinRepository(db *gorm.DB) *recycleBinRepository {
+	return &recycleBinRepository{
+		DB: db,
+	}
+}
+
+func (r *recycleBinRepository) deletedQuery(ctx context.Context, resource dto.TrashResource) *gorm.DB {
+	code := "NULL"
+	if resource.CodeColumn != "" {
+		code = "t." + resource.CodeColumn + "::text"
+	}
+
+	deletedBy := "NULL"
+	if resource.HasDeletedBy {
+		deletedBy = "t.deleted_by::text"
+	}
+
+	query := r.DB.WithContext(ctx).
+		Table(resource.Table + " t").
+		Select(fmt.Sprintf(`t.id, %s AS code, t.%s AS name, t.deleted_at, %s AS deleted_by, deleter.full_name AS deleted_by_name`,
+			code, resource.NameColumn, deletedBy)).
+		Joins(fmt.Sprintf("LEFT JOIN users deleter ON deleter.id::text = %s", deletedBy)).
+		Where("t.deleted_at IS NOT NULL")
+
+	if resource.Scope != "" {
+		query = query.Where(resource.Scope)
+	}
+
+	return query
+}
+
+// GetDeletedIndex retrieves the soft deleted records of a resource, most recently deleted first.
+func (r *recycleBinRepository) GetDeletedIndex(ctx context.Context, resource dto.TrashResource, req request.PageRequest) ([]dto.TrashItem, int, error) {
+	items := []dto.TrashItem{}
+	query := r.deletedQuery(ctx, resource)
+
+	if search := strings.TrimSpace(req.Search); search != "" {
+		like := "%" + search + "%"
+		if resource.CodeColumn != "" {
+			query = query.Where(fmt.Sprintf("(t.%s ILIKE ? OR t.%s::text ILIKE ?)", resource.NameColumn, resource.CodeColumn), like, like)
+		} else {
+			query = query.Where(fmt.Sprintf("t.%s ILIKE ?", resource.NameColumn), like)
+		}
+	}
+
+	total, err := request.ApplyPagination(query, req, request.PaginationConfig{
+		DefaultSortBy:    "t.deleted_at",
+		DefaultSortOrder: "DESC",
+		MaxPerPage:       100,
+		SortMapping:      mapTrashSortColumn,
+	}, &items)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return items, total, nil
+}
+
+// GetDeletedByID retrieves a single soft deleted record of a resource.
+func (r *recycleBinRepository) GetDeletedByID(ctx context.Context, resource dto.TrashResource, id uuid.UUID) (*dto.TrashItem, error) {
+	items := []dto.TrashItem{}
+	err := r.deletedQuery(ctx, resource).
+		Where("t.id = ?", id).
+		Limit(1).
+		Scan(&items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return nil, fmt.Errorf(constants.RecycleBinItemNotFound, resource.Label, id)
+	}
+
+	return &items[0], nil
+}
+
+// ExistsActiveDuplicate checks whether an active record shares the unique key values of the deleted record.
+// Like the unique indexes, NULL values never collide.
+func (r *recycleBinRepository) ExistsActiveDuplicate(ctx context.Context, resource dto.TrashResource, key dto.TrashUniqueKey, id uuid.UUID) (bool, error) {
+	conditions := make([]string, 0, len(key.Columns))
+	for _, column := range key.Columns {
+		conditions = append(conditions, fmt.Sprintf("active.%s = deleted.%s", column, column))
+	}
+
+	var count int64
+	err := r.DB.WithContext(ctx).
+		Table(resource.Table+" active").
+		Joins(fmt.Sprintf("JOIN %s deleted ON %s", resource.Table, strings.Join(conditions, " AND "))).
+		Where("deleted.id = ? AND active.id <> deleted.id AND active.deleted_at IS NULL", id).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// IsParentActive checks that the record the deleted record belongs to is not deleted, a record without parent is always fine.
+func (r *recycleBinRepository) IsParentActive(ctx context.Context, resource dto.TrashResource, parent dto.TrashParent, id uuid.UUID) (bool, error) {
+	var count int64
+	err := r.DB.WithContext(ctx).
+		Table(resource.Table+" t").
+		Joins(fmt.Sprintf("LEFT JOIN %s parent ON parent.id = t.%s", parent.Table, parent.Column)).
+		Where("t.id = ?", id).
+		Where(fmt.Sprintf("(t.%s IS NULL OR parent.deleted_at IS NULL)", parent.Column)).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Restore clears the soft delete marks of a record.
+func (r *recycleBinRepository) Restore(ctx context.Context, resource dto.TrashResource, id uuid.UUID) error {
+	updates := map[string]interface{}{
+		"deleted_at": nil,
+		"updated_at": time.Now().UTC(),
+	}
+	if resource.HasDeletedBy {
+		updates["deleted_by"] = nil
+	}
+
+	result := r.DB.WithContext(ctx).
+		Table(resource.Table).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf(constants.RecycleBinItemNotFound, resource.Label, id)
+	}
+
+	return nil
+}
+
+// HardDelete permanently removes a soft deleted record together with its dependent rows.
+func (r *recycleBinRepository) HardDelete(ctx context.Context, resource dto.TrashResource, id uuid.UUID) error {
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, dependent := range resource.Dependents {
+			query := tx.Table(dependent.Table).Where(dependent.Column+" = ?", id)
+			if dependent.ModuleType != "" {
+				query = query.Where("module_type = ?", dependent.ModuleType)
+			}
+			if dependent.EntityType != "" {
+				query = query.Where("entity_type = ?", dependent.EntityType)
+			}
+			if err := query.Delete(nil).Error; err != nil {
+				return err
+			}
+		}
+
+		result := tx.Table(resource.Table).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Delete(nil)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return fmt.Errorf(constants.RecycleBinItemNotFound, resource.Label, id)
+		}
+
+		return nil
+	})
+
+	if err != nil && isForeignKeyViolation(err) {
+		return errors.New(constants.RecycleBinStillReferenced)
+	}
+
+	return err
+}
+
+// GetExpiredIDs retrieves the ids of records deleted at or before the given time.
+func (r *recycleBinRepository) GetExpiredIDs(ctx context.Context, resource dto.TrashResource, before time.Time) ([]uuid.UUID, error) {
+	ids := []uuid.UUID{}
+	err := r.DB.WithContext(ctx).
+		Table(resource.Table).
+		Where("deleted_at IS NOT NULL AND deleted_at <= ?", before).
+		Order("deleted_at ASC").
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func mapTrashSortColumn(sortBy string) string {
+	mapping := map[string]string{
+		"name":       "name",
+		"code":       "code",
+		"deleted_at": "t.deleted_at",
+	}
+
+	return mapping[strings.ToLower(strings.TrimSpace(sortBy))]
+}
+
+// isForeignKeyViolation detects foreign_key_violation (23503) from both lib/pq and pgx errors
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code == "23503"
+	}
+
+	return strings.Contains(err.Error(), "SQLSTATE 23503")
+}
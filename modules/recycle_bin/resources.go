@@ -0,0 +1,334 @@
+package recycle_bin
+
+import (
+	"fmt"
+
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/modules/recycle_bin/dto"
+)
+
+// Resources lists every module exposed through the recycle bin.
+// Children come before their parents so the retention purge removes them first.
+var Resources = []dto.TrashResource{
+	{
+		Key:          constants.RecycleBinResourcePosts,
+		Label:        "post",
+		Table:        "posts",
+		Permission:   "post.delete",
+		NameColumn:   "title",
+		HasDeletedBy: true,
+		Parents: []dto.TrashParent{
+			{Column: "created_by", Table: "users", Label: "author"},
+		},
+		Dependents: []dto.TrashDependent{
+			{Table: "files_to_module", Column: "module_id", ModuleType: constants.ModuleTypePost},
+			{Table: "parameters_to_module", Column: "module_id", ModuleType: constants.ModuleTypePost},
+			// restored_from only points to revisions of the same post, all removed by this one statement
+			{Table: "post_revisions", Column: "post_id"},
+		},
+	},
+	{
+		Key:          constants.RecycleBinResourceItems,
+		Label:        "item",
+		Table:        "items",
+		Permission:   "item.delete",
+		CodeColumn:   "item_code",
+		NameColumn:   "name",
+		HasDeletedBy: true,
+		UniqueKeys: []dto.TrashUniqueKey{
+			{Columns: []string{"item_code"}, Label: "code"},
+			{Columns: []string{"backing_id", "name"}, Label: "name in backing"},
+		},
+		Parents: []dto.TrashParent{
+			{Column: "backing_id", Table: "backings", Label: "backing"},
+		},
+		Dependents: []dto.TrashDependent{
+			{Table: "item_units", Column: "item_id"},
+			{Table: "item_barcodes", Column: "item_id"},
+			{Table: "price_list_items", Column: "item_id"},
+			{Table: "files_to_module", Column: "module_id", ModuleType: constants.ModuleTypeItem},
+		},
+	},
+	{
+		Key:          constants.RecycleBinResourcePriceLists,
+		Label:        "price list",
+		Table:        "price_lists",
+		Permission:   "price-list.delete",
+		CodeColumn:   "code",
+		NameColumn:   "name",
+		HasDeletedBy: true,
+		UniqueKeys: []dto.TrashUniqueKey{
+			{Columns: []string{"code"}, Label: "code"},
+		},
+		Dependents: []dto.TrashDependent{
+			{Table: "price_list_items", Column: "price_list_id"},
+			{Table: "parameters_to_module", Column: "module_id", ModuleType: constants.ModuleTypePriceList},
+		},
+	},
+	{
+		Key:          constants.RecycleBinResourceBackings,
+		Label:        "backing",
+		Table:        "backings",
+		Permission:   "backing.delete",
+		CodeColumn:   "backing_code",
+		NameColumn:   "name",
+		HasDeletedBy: true,
+		UniqueKeys: []dto.TrashUniqueKey{
+			{Columns: []string{"backing_code"}, Label: "code"},
+			{Columns: []string{"type_id", "name"}, Label: "name in type"},
+		},
+		Parents: []dto.TrashParent{
+			{Column: "type_id", Table: "types", Label: "type"},
+		},
+	},
+	{
+		Key:          constants.RecycleBinResourceTypes,
+		Label:        "type",
+		Table:        "types",
+		Permission:   "type.delete",
+		CodeColumn:   "type_code",
+		NameColumn:   "name",
+		HasDeletedBy: true,
+		UniqueKeys: []dto.TrashUniqueKey{
+			{Columns: []string{"type_code"}, Label: "code"},
+			{Columns: []string{"subgroup_id", "name"}, Label: "name in sub-group"},
+		},
+		Parents: []dto.TrashParent{
+			{Column: "subgroup_id", Table: "sub_groups", Label: "sub-group"},
+		},
+		Dependents: []dto.TrashDependent{
+			{Table: "translations", Column: "entity_id", EntityType: constants.TranslationEntityType},
+		},
+	},
+	{
+		Key:          constants.RecycleBinResourceSubGroups,
+		Label:        "sub-group",
+		Table:        "sub_groups",
+		Permission:   "sub-group.delete",
+		CodeColumn:   "subgroup_code",
+		NameColumn:   "name",
+		HasDeletedBy: true,
+		UniqueKeys: []dto.TrashUniqueKey{
+			{Columns: []string{"subgroup_code"}, Label: "code"},
+			{Columns: []string{"groups_id", "name"}, Label: "name in group"},
+		},
+		Parents: []dto.TrashParent{
+			{Column: "groups_id", Table: "groups", Label: "group"},
+		},
+		Dependents: []dto.TrashDependent{
+			{Table: "translations", Column: "entity_id", EntityType: constants.TranslationEntitySubGroup},
+		},
+	},
+	{
+		Key:          constants.RecycleBinResourceGroups,
+		Label:        "group",
+		Table:        "groups",
+		Permission:   "group.delete",
+		CodeColumn:   "group_code",
+		NameColumn:   "name",
+		HasDeletedBy: true,
+		UniqueKeys: []dto.TrashUniqueKey{
+			{Columns: []string{"group_code"}, Label: "code"},
+			{Columns: []string{"name"}, Label: "name"},
+		},
+		Dependents: []dto.TrashDependent{
+			{Table: "translations", Column: "entity_id", EntityType: constants.TranslationEntityGroup},
+		},
+	},
+	{
+		Key:        constants.RecycleBinResourceSubdistricts,
+		Label:      "subdistrict",
+		Table:      "subdistricts",
+		Permission: "province.delete",
+		CodeColumn: "code",
+		NameColumn: "name",
+		UniqueKeys: []dto.TrashUniqueKey{
+			{Columns: []string{"code"}, Label: "code"},
+			{Columns: []string{"district_id", "name"}, Label: "name in district"},
+		},
+		Parents: []dto.TrashParent{
+			{Column: "district_id", Table: "districts", Label: "district"},
+		},
+		Dependents: []dto.TrashDependent{
+			{Table: "translations", Column: "entity_id", EntityType: constants.TranslationEntitySubdistrict},
+		},
+	},
+	{
+		Key:        constants.RecycleBinResourceDistricts,
+		Label:      "district",
+		Table:      "districts",
+		Permission: "province.delete",
+		CodeColumn: "code",
+		NameColumn: "name",
+		UniqueKeys: []dto.TrashUniqueKey{
+			{Columns: []string{"code"}, Label: "code"},
+			{Columns: []string{"city_id", "name"}, Label: "name in city"},
+		},
+		Parents: []dto.TrashParent{
+			{Column: "city_id", Table: "cities", Label: "city"},
+		},
+		Dependents: []dto.TrashDependent{
+			{Table: "translations", Column: "entity_id", EntityType: constants.TranslationEntityDistrict},
+		},
+	},
+	{
+		Key:        constants.RecycleBinResourceCities,
+		Label:      "city",
+		Table:      "cities",
+		Permission: "province.delete",
+		CodeColumn: "code",
+		NameColumn: "name",
+		UniqueKeys: []dto.TrashUniqueKey{
+			{Columns: []string{"code"}, Label: "code"},
+			{Columns: []string{"province_id", "name"}, Label: "name in province"},
+		},
+		Parents: []dto.TrashParent{
+			{Column: "province_id", Table: "provinces", Label: "province"},
+		},
+		Dependents: []dto.TrashDependent{
+			{Table: "translations", Column: "entity_id", EntityType: constants.TranslationEntityCity},
+		},
+	},
+	{
+		Key:        constants.RecycleBinResourceProvinces,
+		Label:      "province",
+		Table:      "provinces",
+		Permission: "province.delete",
+		CodeColumn: "code",
+		NameColumn: "name",
+		UniqueKeys: []dto.TrashUniqueKey{
+			{Columns: []string{"code"}, Label: "code"},
+			{Columns: []string{"name"}, Label: "name"},
+		},
+		Dependents: []dto.TrashDependent{
+			{Table: "translations", Column: "entity_id", EntityType: constants.TranslationEntityProvince},
+		},
+	},
+	{
+		Key:          constants.RecycleBinResourceCustomers,
+		Label:        "customer",
+		Table:        "customers",
+		Permission:   "customer.delete",
+		CodeColumn:   "customer_code",
+		NameColumn:   "customer_name",
+		HasDeletedBy: true,
+		UniqueKeys: []dto.TrashUniqueKey{
+			{Columns: []string{"customer_code"}, Label: "code"},
+			{Columns: []string{"customer_name"}, Label: "name"},
+		},
+		Parents: []dto.TrashParent{
+			{Column: "expedition_send_id", Table: "expeditions", Label: "expedition"},
+			{Column: "customer_category_id", Table: "parameters", Label: "customer category"},
+		},
+		Dependents: []dto.TrashDependent{
+			{Table: "customer_addresses", Column: "customer_id"},
+			{Table: "customer_contacts", Column: "customer_id"},
+		},
+	},
+	{
+		Key:          constants.RecycleBinResourceSuppliers,
+		Label:        "supplier",
+		Table:        "suppliers",
+		Permission:   "supplier.delete",
+		CodeColumn:   "supplier_code",
+		NameColumn:   "supplier_name",
+		HasDeletedBy: true,
+		UniqueKeys: []dto.TrashUniqueKey{
+			{Columns: []string{"supplier_code"}, Label: "code"},
+			{Columns: []string{"supplier_name"}, Label: "name"},
+		},
+		Parents: []dto.TrashParent{
+			{Column: "expedition_arrives_id", Table: "expeditions", Label: "expedition"},
+		},
+		Dependents: []dto.TrashDependent{
+			{Table: "supplier_contacts", Column: "supplier_id"},
+		},
+	},
+	{
+		Key:          constants.RecycleBinResourceExpeditions,
+		Label:        "expedition",
+		Table:        "expeditions",
+		Permission:   "expedition.delete",
+		CodeColumn:   "expedition_code",
+		NameColumn:   "expedition_name",
+		HasDeletedBy: true,
+		UniqueKeys: []dto.TrashUniqueKey{
+			{Columns: []string{"expedition_code"}, Label: "code"},
+			{Columns: []string{"expedition_name"}, Label: "name"},
+		},
+		Dependents: []dto.TrashDependent{
+			{Table: "expedition_contacts", Column: "expedition_id"},
+			{Table: "expedition_coverages", Column: "expedition_id"},
+			{Table: "expedition_tariffs", Column: "expedition_id"},
+		},
+	},
+	{
+		Key:        constants.RecycleBinResourceParameters,
+		Label:      "parameter",
+		Table:      "parameters",
+		Permission: "parameter.delete",
+		CodeColumn: "code",
+		NameColumn: "name",
+		UniqueKeys: []dto.TrashUniqueKey{
+			{Columns: []string{"code"}, Label: "code"},
+			{Columns: []string{"name"}, Label: "name"},
+		},
+		Parents: []dto.TrashParent{
+			{Column: "parent_id", Table: "parameters", Label: "parent parameter"},
+		},
+		Dependents: []dto.TrashDependent{
+			{Table: "translations", Column: "entity_id", EntityType: constants.TranslationEntityParameter},
+		},
+	},
+	{
+		Key:        constants.RecycleBinResourceUsers,
+		Label:      "user",
+		Table:      "users",
+		Permission: "user.delete",
+		CodeColumn: "username",
+		NameColumn: "full_name",
+		UniqueKeys: []dto.TrashUniqueKey{
+			{Columns: []string{"username"}, Label: "username"},
+			{Columns: []string{"email"}, Label: "email"},
+			{Columns: []string{"nik"}, Label: "nik"},
+		},
+		Parents: []dto.TrashParent{
+			{Column: "role_id", Table: "roles", Label: "role"},
+		},
+		Dependents: []dto.TrashDependent{
+			{Table: "jwt_tokens", Column: "user_id"},
+			{Table: "reset_password_tokens", Column: "user_id"},
+			{Table: "password_histories", Column: "user_id"},
+			{Table: "otps", Column: "user_id"},
+		},
+		// users stay referenced by created_by / updated_by, so they are never purged automatically
+		KeepForever: true,
+		// erased users are anonymized for good and can neither be restored nor deleted
+		Scope: fmt.Sprintf(
+			"NOT EXISTS (SELECT 1 FROM user_data_requests udr WHERE udr.user_id = t.id AND udr.request_type = '%s' AND udr.status <> '%s')",
+			constants.UserDataRequestTypeErasure, constants.UserDataRequestStatusFailed,
+		),
+	},
+	{
+		Key:          constants.RecycleBinResourceRoles,
+		Label:        "role",
+		Table:        "roles",
+		Permission:   "role.delete",
+		NameColumn:   "name",
+		HasDeletedBy: true,
+		UniqueKeys: []dto.TrashUniqueKey{
+			{Columns: []string{"name"}, Label: "name"},
+		},
+	},
+}
+
+// GetResource finds a recycle bin resource by its url key
+func GetResource(key string) (dto.TrashResource, error) {
+	for _, resource := range Resources {
+		if resource.Key == key {
+			return resource, nil
+		}
+	}
+
+	return dto.TrashResource{}, fmt.Errorf(constants.RecycleBinResourceNotFound, key)
+}
@@ -0,0 +1,111 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/modules/recycle_bin"
+	"github.com/rendyfutsuy/base-go/modules/recycle_bin/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var deleteTablePattern = regexp.MustCompile(`^DELETE FROM "?(\w+)"?`)
+
+// recordingConnPool records the statements instead of running them. A DELETE fails with a foreign key
+// violation while a table referencing it (references: table -> referencing tables) was not deleted from first.
+type recordingConnPool struct {
+	references map[string][]string
+	statements []string
+	deleted    map[string]bool
+}
+
+type recordingResult struct{}
+
+func (recordingResult) LastInsertId() (int64, error) { return 0, nil }
+func (recordingResult) RowsAffected() (int64, error) { return 1, nil }
+
+func (p *recordingConnPool) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	p.statements = append(p.statements, query)
+	match := deleteTablePattern.FindStringSubmatch(query)
+	if match == nil {
+		return recordingResult{}, nil
+	}
+	for _, referencing := range p.references[match[1]] {
+		if !p.deleted[referencing] {
+			return nil, fmt.Errorf("ERROR: update or delete on table %q violates foreign key constraint on table %q (SQLSTATE 23503)", match[1], referencing)
+		}
+	}
+	if p.deleted == nil {
+		p.deleted = map[string]bool{}
+	}
+	p.deleted[match[1]] = true
+	return recordingResult{}, nil
+}
+
+func (p *recordingConnPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (p *recordingConnPool) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (p *recordingConnPool) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return nil
+}
+
+func (p *recordingConnPool) BeginTx(ctx context.Context, opts *sql.TxOptions) (gorm.ConnPool, error) {
+	return &recordingTx{p}, nil
+}
+
+// recordingTx is the transaction of a recordingConnPool, its statements are recorded by the pool
+type recordingTx struct {
+	*recordingConnPool
+}
+
+func (*recordingTx) Commit() error   { return nil }
+func (*recordingTx) Rollback() error { return nil }
+
+func newRecordingDB(t *testing.T, pool *recordingConnPool) *gorm.DB {
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: pool}), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	return db
+}
+
+func TestRepositoryHardDelete_PostWithRevisions(t *testing.T) {
+	pool := &recordingConnPool{references: map[string][]string{"posts": {"post_revisions"}}}
+	repo := repository.NewRecycleBinRepository(newRecordingDB(t, pool))
+	resource, err := recycle_bin.GetResource(constants.RecycleBinResourcePosts)
+	require.NoError(t, err)
+
+	err = repo.HardDelete(context.Background(), resource, uuid.New())
+
+	assert.NoError(t, err)
+	require.NotEmpty(t, pool.statements)
+	assert.Regexp(t, `^DELETE FROM "posts"`, pool.statements[len(pool.statements)-1])
+}
+
+func TestRepositoryHardDelete_TranslatedGroup(t *testing.T) {
+	pool := &recordingConnPool{}
+	repo := repository.NewRecycleBinRepository(newRecordingDB(t, pool))
+	resource, err := recycle_bin.GetResource(constants.RecycleBinResourceGroups)
+	require.NoError(t, err)
+
+	err = repo.HardDelete(context.Background(), resource, uuid.New())
+
+	assert.NoError(t, err)
+	require.Len(t, pool.statements, 2)
+	// the translations of the group only, translations are keyed by entity_type / entity_id
+	assert.Regexp(t, `^DELETE FROM "translations" WHERE entity_id = \$1 AND entity_type = \$2`, pool.statements[0])
+	assert.Regexp(t, `^DELETE FROM "groups"`, pool.statements[1])
+}
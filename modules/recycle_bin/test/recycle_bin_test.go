@@ -0,0 +1,281 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/modules/recycle_bin"
+	recycleBinDto "github.com/rendyfutsuy/base-go/modules/recycle_bin/dto"
+	"github.com/rendyfutsuy/base-go/modules/recycle_bin/usecase"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockRecycleBinRepository is a mock implementation of recycle_bin.Repository
+type MockRecycleBinRepository struct {
+	mock.Mock
+}
+
+func (m *MockRecycleBinRepository) GetDeletedIndex(ctx context.Context, resource recycleBinDto.TrashResource, req request.PageRequest) ([]recycleBinDto.TrashItem, int, error) {
+	args := m.Called(ctx, resource.Key, req)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]recycleBinDto.TrashItem), args.Int(1), args.Error(2)
+}
+
+func (m *MockRecycleBinRepository) GetDeletedByID(ctx context.Context, resource recycleBinDto.TrashResource, id uuid.UUID) (*recycleBinDto.TrashItem, error) {
+	args := m.Called(ctx, resource.Key, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*recycleBinDto.TrashItem), args.Error(1)
+}
+
+func (m *MockRecycleBinRepository) ExistsActiveDuplicate(ctx context.Context, resource recycleBinDto.TrashResource, key recycleBinDto.TrashUniqueKey, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, resource.Key, key.Label, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRecycleBinRepository) IsParentActive(ctx context.Context, resource recycleBinDto.TrashResource, parent recycleBinDto.TrashParent, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, resource.Key, parent.Label, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRecycleBinRepository) Restore(ctx context.Context, resource recycleBinDto.TrashResource, id uuid.UUID) error {
+	args := m.Called(ctx, resource.Key, id)
+	return args.Error(0)
+}
+
+func (m *MockRecycleBinRepository) HardDelete(ctx context.Context, resource recycleBinDto.TrashResource, id uuid.UUID) error {
+	args := m.Called(ctx, resource.Key, id)
+	return args.Error(0)
+}
+
+func (m *MockRecycleBinRepository) GetExpiredIDs(ctx context.Context, resource recycleBinDto.TrashResource, before time.Time) ([]uuid.UUID, error) {
+	args := m.Called(ctx, resource.Key, before)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func setupTestLogger() {
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+}
+
+func TestGetResource(t *testing.T) {
+	resource, err := recycle_bin.GetResource(constants.RecycleBinResourceSubGroups)
+	assert.NoError(t, err)
+	assert.Equal(t, "sub_groups", resource.Table)
+	assert.Equal(t, "sub-group.delete", resource.Permission)
+
+	_, err = recycle_bin.GetResource("unknown")
+	assert.EqualError(t, err, fmt.Sprintf(constants.RecycleBinResourceNotFound, "unknown"))
+}
+
+func TestGetDeletedIndex(t *testing.T) {
+	mockRepo := new(MockRecycleBinRepository)
+	uc := usecase.NewRecycleBinUsecase(mockRepo, 30)
+	ctx := context.Background()
+
+	deletedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	item := recycleBinDto.TrashItem{ID: uuid.New(), Name: "Group A", DeletedAt: deletedAt}
+	req := request.PageRequest{Page: 1, PerPage: 10}
+
+	mockRepo.On("GetDeletedIndex", ctx, constants.RecycleBinResourceGroups, req).Return([]recycleBinDto.TrashItem{item}, 1, nil).Once()
+
+	res, total, err := uc.GetDeletedIndex(ctx, constants.RecycleBinResourceGroups, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, constants.RecycleBinResourceGroups, res[0].Resource)
+	assert.Equal(t, deletedAt.AddDate(0, 0, 30), *res[0].PurgeAt)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetDeletedIndex_KeptForever(t *testing.T) {
+	mockRepo := new(MockRecycleBinRepository)
+	uc := usecase.NewRecycleBinUsecase(mockRepo, 30)
+	ctx := context.Background()
+
+	item := recycleBinDto.TrashItem{ID: uuid.New(), Name: "John Doe", DeletedAt: time.Now().UTC()}
+	req := request.PageRequest{Page: 1, PerPage: 10}
+
+	mockRepo.On("GetDeletedIndex", ctx, constants.RecycleBinResourceUsers, req).Return([]recycleBinDto.TrashItem{item}, 1, nil).Once()
+
+	res, _, err := uc.GetDeletedIndex(ctx, constants.RecycleBinResourceUsers, req)
+
+	assert.NoError(t, err)
+	assert.Nil(t, res[0].PurgeAt)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRestore(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	resource := constants.RecycleBinResourceSubGroups
+
+	t.Run("Positive case - restored", func(t *testing.T) {
+		mockRepo := new(MockRecycleBinRepository)
+		uc := usecase.NewRecycleBinUsecase(mockRepo, 30)
+
+		mockRepo.On("GetDeletedByID", ctx, resource, id).Return(&recycleBinDto.TrashItem{ID: id}, nil).Once()
+		mockRepo.On("ExistsActiveDuplicate", ctx, resource, mock.Anything, id).Return(false, nil).Twice()
+		mockRepo.On("IsParentActive", ctx, resource, "group", id).Return(true, nil).Once()
+		mockRepo.On("Restore", ctx, resource, id).Return(nil).Once()
+
+		err := uc.Restore(ctx, resource, id.String())
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Negative case - active duplicate", func(t *testing.T) {
+		mockRepo := new(MockRecycleBinRepository)
+		uc := usecase.NewRecycleBinUsecase(mockRepo, 30)
+
+		mockRepo.On("GetDeletedByID", ctx, resource, id).Return(&recycleBinDto.TrashItem{ID: id}, nil).Once()
+		mockRepo.On("ExistsActiveDuplicate", ctx, resource, "code", id).Return(true, nil).Once()
+
+		err := uc.Restore(ctx, resource, id.String())
+
+		assert.EqualError(t, err, fmt.Sprintf(constants.RecycleBinRestoreConflict, "code"))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Negative case - parent deleted", func(t *testing.T) {
+		mockRepo := new(MockRecycleBinRepository)
+		uc := usecase.NewRecycleBinUsecase(mockRepo, 30)
+
+		mockRepo.On("GetDeletedByID", ctx, resource, id).Return(&recycleBinDto.TrashItem{ID: id}, nil).Once()
+		mockRepo.On("ExistsActiveDuplicate", ctx, resource, mock.Anything, id).Return(false, nil).Twice()
+		mockRepo.On("IsParentActive", ctx, resource, "group", id).Return(false, nil).Once()
+
+		err := uc.Restore(ctx, resource, id.String())
+
+		assert.EqualError(t, err, fmt.Sprintf(constants.RecycleBinParentDeleted, "group"))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Negative case - not in recycle bin", func(t *testing.T) {
+		mockRepo := new(MockRecycleBinRepository)
+		uc := usecase.NewRecycleBinUsecase(mockRepo, 30)
+
+		mockRepo.On("GetDeletedByID", ctx, resource, id).Return(nil, fmt.Errorf(constants.RecycleBinItemNotFound, "sub-group", id)).Once()
+
+		err := uc.Restore(ctx, resource, id.String())
+
+		assert.Error(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestHardDelete(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("Positive case - deleted", func(t *testing.T) {
+		mockRepo := new(MockRecycleBinRepository)
+		uc := usecase.NewRecycleBinUsecase(mockRepo, 30)
+
+		mockRepo.On("GetDeletedByID", ctx, constants.RecycleBinResourceGroups, id).Return(&recycleBinDto.TrashItem{ID: id}, nil).Once()
+		mockRepo.On("HardDelete", ctx, constants.RecycleBinResourceGroups, id).Return(nil).Once()
+
+		err := uc.HardDelete(ctx, constants.RecycleBinResourceGroups, id.String())
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Negative case - erased user is not in recycle bin", func(t *testing.T) {
+		mockRepo := new(MockRecycleBinRepository)
+		uc := usecase.NewRecycleBinUsecase(mockRepo, 30)
+
+		mockRepo.On("GetDeletedByID", ctx, constants.RecycleBinResourceUsers, id).Return(nil, fmt.Errorf(constants.RecycleBinItemNotFound, "user", id)).Once()
+
+		err := uc.HardDelete(ctx, constants.RecycleBinResourceUsers, id.String())
+
+		assert.EqualError(t, err, fmt.Sprintf(constants.RecycleBinItemNotFound, "user", id))
+		mockRepo.AssertNotCalled(t, "HardDelete", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUsersResource(t *testing.T) {
+	resource, err := recycle_bin.GetResource(constants.RecycleBinResourceUsers)
+
+	assert.NoError(t, err)
+	assert.True(t, resource.KeepForever)
+	assert.Contains(t, resource.Scope, "user_data_requests")
+}
+
+func TestRegionResources(t *testing.T) {
+	keys := []string{
+		constants.RecycleBinResourceProvinces,
+		constants.RecycleBinResourceCities,
+		constants.RecycleBinResourceDistricts,
+		constants.RecycleBinResourceSubdistricts,
+	}
+
+	for _, key := range keys {
+		t.Run(key, func(t *testing.T) {
+			resource, err := recycle_bin.GetResource(key)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "code", resource.CodeColumn)
+			assert.Contains(t, resource.UniqueKeys, recycleBinDto.TrashUniqueKey{Columns: []string{"code"}, Label: "code"})
+		})
+	}
+}
+
+func TestPurgeExpired(t *testing.T) {
+	setupTestLogger()
+	ctx := context.Background()
+
+	t.Run("Positive case - referenced records are skipped", func(t *testing.T) {
+		mockRepo := new(MockRecycleBinRepository)
+		uc := usecase.NewRecycleBinUsecase(mockRepo, 30)
+
+		postID := uuid.New()
+		groupID := uuid.New()
+		referencedGroupID := uuid.New()
+
+		mockRepo.On("GetExpiredIDs", ctx, constants.RecycleBinResourcePosts, mock.AnythingOfType("time.Time")).Return([]uuid.UUID{postID}, nil).Once()
+		mockRepo.On("GetExpiredIDs", ctx, constants.RecycleBinResourceGroups, mock.AnythingOfType("time.Time")).Return([]uuid.UUID{groupID, referencedGroupID}, nil).Once()
+		mockRepo.On("GetExpiredIDs", ctx, mock.Anything, mock.AnythingOfType("time.Time")).Return([]uuid.UUID{}, nil)
+		mockRepo.On("HardDelete", ctx, constants.RecycleBinResourcePosts, postID).Return(nil).Once()
+		mockRepo.On("HardDelete", ctx, constants.RecycleBinResourceGroups, groupID).Return(nil).Once()
+		mockRepo.On("HardDelete", ctx, constants.RecycleBinResourceGroups, referencedGroupID).Return(errors.New(constants.RecycleBinStillReferenced)).Once()
+
+		res, err := uc.PurgeExpired(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, res.Purged)
+		assert.Equal(t, 1, res.Skipped)
+		assert.Equal(t, map[string]int{constants.RecycleBinResourcePosts: 1, constants.RecycleBinResourceGroups: 1}, res.Resources)
+		mockRepo.AssertNotCalled(t, "GetExpiredIDs", ctx, constants.RecycleBinResourceUsers, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Positive case - retention disabled", func(t *testing.T) {
+		mockRepo := new(MockRecycleBinRepository)
+		uc := usecase.NewRecycleBinUsecase(mockRepo, 0)
+
+		res, err := uc.PurgeExpired(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, res.Purged)
+		mockRepo.AssertExpectations(t)
+	})
+}
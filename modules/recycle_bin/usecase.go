@@ -0,0 +1,15 @@
+package recycle_bin
+
+import (
+	"context"
+
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/modules/recycle_bin/dto"
+)
+
+type Usecase interface {
+	GetDeletedIndex(ctx context.Context, resource string, req request.PageRequest) ([]dto.RespTrashItem, int, error)
+	Restore(ctx context.Context, resource string, id string) error
+	HardDelete(ctx context.Context, resource string, id string) error
+	PurgeExpired(ctx context.Context) (*dto.RespRecycleBinPurge, error)
+}
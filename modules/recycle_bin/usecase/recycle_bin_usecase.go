@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	mod "github.com/rendyfutsuy/base-go/modules/recycle_bin"
+	"github.com/rendyfutsuy/base-go/modules/recycle_bin/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"go.uber.org/zap"
+)
+
+type recycleBinUsecase struct {
+	repo          mod.Repository
+	retentionDays int
+}
+
+func NewRecycleBinUsecase(repo mod.Repository, retentionDays int) mod.Usecase {
+	return &recycleBinUsecase{repo: repo, retentionDays: retentionDays}
+}
+
+// RetentionDaysFromConfig returns how long deleted records are kept, 0 keeps them forever
+func RetentionDaysFromConfig() int {
+	return configIntOrDefault("recycle_bin.retention_days", constants.RecycleBinRetentionDaysDefault)
+}
+
+// PurgeIntervalFromConfig returns how often expired records are purged, 0 disables the scheduler
+func PurgeIntervalFromConfig() time.Duration {
+	return time.Duration(configIntOrDefault("recycle_bin.purge_interval_minutes", constants.RecycleBinPurgeIntervalMinutesDefault)) * time.Minute
+}
+
+func configIntOrDefault(key string, def int) int {
+	if utils.ConfigVars == nil || !utils.ConfigVars.Exists(key) {
+		return def
+	}
+	return utils.ConfigVars.Int(key)
+}
+
+func (u *recycleBinUsecase) GetDeletedIndex(ctx context.Context, resourceKey string, req request.PageRequest) ([]dto.RespTrashItem, int, error) {
+	resource, err := mod.GetResource(resourceKey)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	items, total, err := u.repo.GetDeletedIndex(ctx, resource, req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res := make([]dto.RespTrashItem, 0, len(items))
+	retentionDays := u.retentionDays
+	if resource.KeepForever {
+		retentionDays = 0
+	}
+
+	for _, item := range items {
+		res = append(res, dto.ToRespTrashItem(resource.Key, item, retentionDays))
+	}
+
+	return res, total, nil
+}
+
+func (u *recycleBinUsecase) Restore(ctx context.Context, resourceKey string, id string) error {
+	resource, err := mod.GetResource(resourceKey)
+	if err != nil {
+		return err
+	}
+
+	uId, err := utils.StringToUUID(id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := u.repo.GetDeletedByID(ctx, resource, uId); err != nil {
+		return err
+	}
+
+	// a restored record must not collide with what was created in the meantime
+	for _, key := range resource.UniqueKeys {
+		exists, err := u.repo.ExistsActiveDuplicate(ctx, resource, key, uId)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf(constants.RecycleBinRestoreConflict, key.Label)
+		}
+	}
+
+	for _, parent := range resource.Parents {
+		active, err := u.repo.IsParentActive(ctx, resource, parent, uId)
+		if err != nil {
+			return err
+		}
+		if !active {
+			return fmt.Errorf(constants.RecycleBinParentDeleted, parent.Label)
+		}
+	}
+
+	return u.repo.Restore(ctx, resource, uId)
+}
+
+func (u *recycleBinUsecase) HardDelete(ctx context.Context, resourceKey string, id string) error {
+	resource, err := mod.GetResource(resourceKey)
+	if err != nil {
+		return err
+	}
+
+	uId, err := utils.StringToUUID(id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := u.repo.GetDeletedByID(ctx, resource, uId); err != nil {
+		return err
+	}
+
+	return u.repo.HardDelete(ctx, resource, uId)
+}
+
+// PurgeExpired permanently deletes records that stayed in the recycle bin longer than the retention period.
+// Records that are still referenced are skipped and retried on the next run, resources kept forever are left alone.
+func (u *recycleBinUsecase) PurgeExpired(ctx context.Context) (*dto.RespRecycleBinPurge, error) {
+	res := &dto.RespRecycleBinPurge{Resources: map[string]int{}}
+	if u.retentionDays <= 0 {
+		return res, nil
+	}
+
+	before := time.Now().UTC().AddDate(0, 0, -u.retentionDays)
+	var errs []error
+
+	for _, resource := range mod.Resources {
+		if resource.KeepForever {
+			continue
+		}
+
+		ids, err := u.repo.GetExpiredIDs(ctx, resource, before)
+		if err != nil {
+			utils.Logger.Error("failed to get expired records", zap.String("resource", resource.Key), zap.Error(err))
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, id := range ids {
+			if err := u.repo.HardDelete(ctx, resource, id); err != nil {
+				utils.Logger.Warn("skipped purging deleted record", zap.String("resource", resource.Key), zap.String("id", id.String()), zap.Error(err))
+				res.Skipped++
+				continue
+			}
+			res.Purged++
+			res.Resources[resource.Key]++
+		}
+	}
+
+	return res, errors.Join(errs...)
+}
@@ -0,0 +1,194 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/regency/dto"
+)
+
+// SaveRegencyBoundary godoc
+// @Summary		Upload the boundary of a region
+// @Description	Create or replace the GeoJSON boundary of a province, city, district or subdistrict. The body is a Polygon / MultiPolygon geometry, a Feature holding one, or a FeatureCollection whose polygons are merged. Positions are [longitude, latitude]
+// @Tags			Regency - Geo
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			level	path		string					true	"province, city, district or subdistrict"
+// @Param			id		path		string					true	"Region UUID"
+// @Param			request	body		dto.ReqRegencyBoundary	true	"GeoJSON boundary"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.GeoJSONFeature}	"Successfully saved boundary"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid GeoJSON or unknown region"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/regency/boundaries/{level}/{id} [put]
+func (h *RegencyHandler) SaveRegencyBoundary(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("id")
+	if err := uuid.Validate(id); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, constants.ErrorUUIDNotRecognized))
+	}
+
+	req := new(dto.ReqRegencyBoundary)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// get user from context
+	user := c.Get("user")
+	userID := ""
+	if userModel, ok := user.(models.User); ok {
+		userID = userModel.ID.String()
+	}
+
+	res, err := h.Usecase.SaveRegencyBoundary(ctx, c.Param("level"), id, req, userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	resp.Message = constants.RegencyBoundarySaveSuccess
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DeleteRegencyBoundary godoc
+// @Summary		Delete the boundary of a region
+// @Description	Remove the stored GeoJSON boundary of a region, the region itself is kept
+// @Tags			Regency - Geo
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			level	path		string	true	"province, city, district or subdistrict"
+// @Param			id		path		string	true	"Region UUID"
+// @Success		200		{object}	response.NonPaginationResponse	"Successfully deleted boundary"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - boundary not found"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/regency/boundaries/{level}/{id} [delete]
+func (h *RegencyHandler) DeleteRegencyBoundary(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("id")
+	if err := uuid.Validate(id); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, constants.ErrorUUIDNotRecognized))
+	}
+
+	if err := h.Usecase.DeleteRegencyBoundary(ctx, c.Param("level"), id); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(Response{Message: constants.RegencyBoundaryDeleteSuccess})
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetRegencyBoundary godoc
+// @Summary		Get the boundary of a region
+// @Description	Retrieve the boundary of a region as a GeoJSON Feature (MultiPolygon) with the region id, level, code, name and path as properties
+// @Tags			Regency - Geo
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			level	path		string	true	"province, city, district or subdistrict"
+// @Param			id		path		string	true	"Region UUID"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.GeoJSONFeature}	"Successfully retrieved boundary"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - boundary not found"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/regency/boundaries/{level}/{id} [get]
+func (h *RegencyHandler) GetRegencyBoundary(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("id")
+	if err := uuid.Validate(id); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, constants.ErrorUUIDNotRecognized))
+	}
+
+	res, err := h.Usecase.GetRegencyBoundary(ctx, c.Param("level"), id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetRegencyBoundaries godoc
+// @Summary		Get the boundaries of a level
+// @Description	Retrieve the boundaries of every region of a level as a GeoJSON FeatureCollection, optionally only the children of a region
+// @Tags			Regency - Geo
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			level		query		string	true	"province, city, district or subdistrict"
+// @Param			parent_id	query		string	false	"Only the children of this region"
+// @Success		200			{object}	response.NonPaginationResponse{data=dto.GeoJSONFeatureCollection}	"Successfully retrieved boundaries"
+// @Failure		400			{object}	response.NonPaginationResponse	"Bad request - validation error"
+// @Failure		401			{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/regency/boundaries [get]
+func (h *RegencyHandler) GetRegencyBoundaries(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	req := new(dto.ReqRegencyBoundaryIndex)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := h.Usecase.GetRegencyBoundaries(ctx, *req)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// LocateRegency godoc
+// @Summary		Resolve a coordinate to its region
+// @Description	Find the deepest region whose boundary contains the coordinate and return it with its chain up to the province. When only the district boundary matches, the subdistrict is picked by the nearest centroid inside that district
+// @Tags			Regency - Geo
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			latitude	query		number	true	"Latitude (-90 to 90)"
+// @Param			longitude	query		number	true	"Longitude (-180 to 180)"
+// @Success		200			{object}	response.NonPaginationResponse{data=dto.RespRegencyLocate}	"Successfully resolved region"
+// @Failure		400			{object}	response.NonPaginationResponse	"Bad request - invalid coordinate or outside every boundary"
+// @Failure		401			{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/regency/locate [get]
+func (h *RegencyHandler) LocateRegency(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	req := new(dto.ReqRegencyLocate)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := h.Usecase.LocateRegency(ctx, *req)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
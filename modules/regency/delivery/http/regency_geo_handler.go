@@ -0,0 +1,147 @@
+package http
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/modules/regency/dto"
+)
+
+// GetNearestRegency godoc
+// @Summary		Find the nearest region to a coordinate
+// @Description	Return the subdistricts (or districts) whose centroid is the nearest to the coordinate, nearest first, with their full hierarchy and distance in meters
+// @Tags			Regency - Geo
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			latitude	query		number	true	"Latitude (-90 to 90)"
+// @Param			longitude	query		number	true	"Longitude (-180 to 180)"
+// @Param			level		query		string	false	"district or subdistrict (default subdistrict)"
+// @Param			limit		query		int		false	"Number of regions returned (default 1, max 20)"
+// @Param			radius_km	query		int		false	"Search radius in km (default 25, max 200)"
+// @Success		200			{object}	response.NonPaginationResponse{data=[]dto.RespRegencyGeo}	"Successfully retrieved nearest regions"
+// @Failure		400			{object}	response.NonPaginationResponse	"Bad request - invalid coordinate or nothing within the radius"
+// @Failure		401			{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/regency/nearest [get]
+func (h *RegencyHandler) GetNearestRegency(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	req := new(dto.ReqRegencyNearest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := h.Usecase.GetNearestRegency(ctx, *req)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetRegencyByPostalCode godoc
+// @Summary		Get regions by postal code
+// @Description	Return every subdistrict served by the postal code with its full hierarchy (district, city, province)
+// @Tags			Regency - Geo
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			postal_code	path		string	true	"5 digit postal code"
+// @Success		200			{object}	response.NonPaginationResponse{data=[]dto.RespRegencyGeo}	"Successfully retrieved regions"
+// @Failure		400			{object}	response.NonPaginationResponse	"Bad request - invalid or unknown postal code"
+// @Failure		401			{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/regency/postal-code/{postal_code} [get]
+func (h *RegencyHandler) GetRegencyByPostalCode(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	res, err := h.Usecase.GetRegencyByPostalCode(ctx, c.Param("postal_code"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ImportRegencyGeo godoc
+// @Summary		Import postal codes and coordinates
+// @Description	Bulk update postal codes and centroids from a file (.csv, .xlsx or .xls) with columns: code, postal_codes, latitude, longitude. Several postal codes of a subdistrict are separated by comma, semicolon or space and replace the stored ones. Regions are matched by official code (district or subdistrict), empty cells are left untouched. Nothing is written when a row is invalid or when dry_run is true.
+// @Tags			Regency - Geo
+// @Accept			multipart/form-data
+// @Produce		json
+// @Security		BearerAuth
+// @Param			file	formData	file	true	"File (.csv, .xlsx or .xls) with columns: code, postal_codes, latitude, longitude"
+// @Param			dry_run	formData	bool	false	"Only validate the file"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespRegencyGeoImport}	"Successfully imported postal codes and coordinates"
+// @Failure		400		{object}	response.NonPaginationResponse{data=dto.RespRegencyGeoImport}	"Bad request - one or more rows are invalid, the report contains the row errors"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500		{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/regency/geo/import [post]
+func (h *RegencyHandler) ImportRegencyGeo(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	req := new(dto.ReqRegencyImport)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	tempFilePath, status, err := saveRegencyImportFile(c, "import_regency_geo")
+	if err != nil {
+		return c.JSON(status, response.SetErrorResponse(status, err.Error()))
+	}
+	defer os.Remove(tempFilePath)
+
+	res, err := h.Usecase.ImportRegencyGeo(ctx, tempFilePath, req.DryRun)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+
+	// invalid rows: nothing was imported, return the row errors
+	if len(res.Errors) > 0 {
+		resp.Message = constants.RegencyImportInvalidRows
+		resp.Status = http.StatusBadRequest
+		return c.JSON(http.StatusBadRequest, resp)
+	}
+
+	resp.Message = constants.RegencyGeoImportSuccess
+	if res.DryRun {
+		resp.Message = constants.RegencyGeoImportDryRunSuccess
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DownloadRegencyGeoImportTemplate godoc
+// @Summary		Download postal code and coordinates import template
+// @Description	Download Excel template file for importing postal codes and coordinates. Template contains columns: code, postal_codes, latitude, longitude.
+// @Tags			Regency - Geo
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Success		200	{file}		file	"Excel template file"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500	{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/regency/geo/import/template [get]
+func (h *RegencyHandler) DownloadRegencyGeoImportTemplate(c echo.Context) error {
+	// a district carries coordinates only, a subdistrict carries postal codes and coordinates
+	examples := [][]string{
+		{"32.73.01", "", "-6.8815", "107.5837"},
+		{"32.73.01.1001", "40151, 40152", "-6.8748", "107.5790"},
+	}
+
+	return writeRegencyTemplate(c, "Import Postal Codes", []string{"Code", "Postal Codes", "Latitude", "Longitude"}, []float64{20, 15, 15, 15}, examples, "regency_geo_import_template.xlsx")
+}
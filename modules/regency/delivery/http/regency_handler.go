@@ -89,6 +89,34 @@ func NewRegencyHandler(e *echo.Echo, uc regency.Usecase, mwP _reqContext.IMiddle
 	subdistrictGroup.POST("", h.CreateSubdistrict, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToCreate))
 	subdistrictGroup.PUT("/:id", h.UpdateSubdistrict, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
 	subdistrictGroup.DELETE("/:id", h.DeleteSubdistrict, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToDelete))
+
+	// Tree & cross level search routes
+	regencyGroup := e.Group("/v1/regency")
+	regencyGroup.Use(h.middlewareAuth.AuthorizationCheck)
+
+	regencyGroup.GET("/tree", h.GetRegencyTree, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+	regencyGroup.GET("/tree/children", h.GetRegencyTreeChildren, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+	regencyGroup.GET("/search", h.SearchRegency, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+	regencyGroup.GET("/code/:code", h.GetRegencyByCode, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+
+	// Official dataset import
+	// Import: province.import
+	permissionToImport := []string{"province.import"}
+	regencyGroup.POST("/import", h.ImportRegencies, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToImport))
+	regencyGroup.GET("/import/template", h.DownloadRegencyImportTemplate, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToImport))
+
+	// Postal code & coordinates
+	regencyGroup.GET("/nearest", h.GetNearestRegency, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+	regencyGroup.GET("/postal-code/:postal_code", h.GetRegencyByPostalCode, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+	regencyGroup.POST("/geo/import", h.ImportRegencyGeo, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToImport))
+	regencyGroup.GET("/geo/import/template", h.DownloadRegencyGeoImportTemplate, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToImport))
+
+	// Boundaries
+	regencyGroup.GET("/boundaries", h.GetRegencyBoundaries, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+	regencyGroup.GET("/boundaries/:level/:id", h.GetRegencyBoundary, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+	regencyGroup.PUT("/boundaries/:level/:id", h.SaveRegencyBoundary, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	regencyGroup.DELETE("/boundaries/:level/:id", h.DeleteRegencyBoundary, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	regencyGroup.GET("/locate", h.LocateRegency, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
 }
 
 // Province Handlers
@@ -162,7 +190,7 @@ func (h *RegencyHandler) UpdateProvince(c echo.Context) error {
 
 // DeleteProvince godoc
 // @Summary		Delete province
-// @Description	Delete an existing province by ID
+// @Description	Delete an existing province by ID. A province still used by active supplier or customer addresses cannot be deleted
 // @Tags			Regency - Province
 // @Accept			json
 // @Produce		json
@@ -360,7 +388,7 @@ func (h *RegencyHandler) UpdateCity(c echo.Context) error {
 
 // DeleteCity godoc
 // @Summary		Delete city
-// @Description	Delete an existing city by ID
+// @Description	Delete an existing city by ID. A city still used by active supplier or customer addresses cannot be deleted
 // @Tags			Regency - City
 // @Accept			json
 // @Produce		json
@@ -590,7 +618,7 @@ func (h *RegencyHandler) UpdateDistrict(c echo.Context) error {
 
 // DeleteDistrict godoc
 // @Summary		Delete district
-// @Description	Delete an existing district by ID
+// @Description	Delete an existing district by ID. A district still used by active supplier or customer addresses cannot be deleted
 // @Tags			Regency - District
 // @Accept			json
 // @Produce		json
@@ -788,7 +816,7 @@ func (h *RegencyHandler) UpdateSubdistrict(c echo.Context) error {
 
 // DeleteSubdistrict godoc
 // @Summary		Delete subdistrict
-// @Description	Delete an existing subdistrict by ID
+// @Description	Delete an existing subdistrict by ID. A subdistrict still used by active supplier or customer addresses cannot be deleted
 // @Tags			Regency - Subdistrict
 // @Accept			json
 // @Produce		json
@@ -914,3 +942,116 @@ func (h *RegencyHandler) ExportSubdistrict(c echo.Context) error {
 	c.Response().Header().Set(constants.FieldContentDisposition, constants.ExcelContentDisposition("subdistricts.xlsx"))
 	return c.Blob(http.StatusOK, constants.ExcelContent, excelBytes)
 }
+
+// Tree & Search Handlers
+
+// GetRegencyTree godoc
+// @Summary		Get the full regency tree
+// @Description	Retrieve every province with its nested cities, districts and subdistricts. Responds 304 when If-None-Match matches the current ETag
+// @Tags			Regency - Tree
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			If-None-Match	header		string	false	"ETag of the tree already held by the client"
+// @Success		200				{object}	response.NonPaginationResponse{data=[]dto.RespRegencyTreeNode}	"Successfully retrieved regency tree"
+// @Success		304				"Tree not modified"
+// @Failure		400				{object}	response.NonPaginationResponse	"Bad request"
+// @Failure		401				{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/regency/tree [get]
+func (h *RegencyHandler) GetRegencyTree(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	// answer conditional requests without building the tree
+	if ifNoneMatch := c.Request().Header.Get(constants.FieldIfNoneMatch); ifNoneMatch != "" {
+		etag, err := h.Usecase.GetRegencyTreeETag(ctx)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+		}
+		if ifNoneMatch == etag {
+			c.Response().Header().Set(constants.FieldETag, etag)
+			return c.NoContent(http.StatusNotModified)
+		}
+	}
+
+	res, etag, err := h.Usecase.GetRegencyTree(ctx)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	c.Response().Header().Set(constants.FieldETag, etag)
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetRegencyTreeChildren godoc
+// @Summary		Get one level of the regency tree
+// @Description	Retrieve the nodes of a level, filtered by their parent. Provinces have no parent, every other level requires parent_id
+// @Tags			Regency - Tree
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			level		query		string	true	"Level of the nodes (province, city, district, subdistrict)"
+// @Param			parent_id	query		string	false	"Parent UUID, required unless level is province"
+// @Success		200			{object}	response.NonPaginationResponse{data=[]dto.RespRegencyTreeNode}	"Successfully retrieved regency nodes"
+// @Failure		400			{object}	response.NonPaginationResponse	"Bad request - validation error"
+// @Failure		401			{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/regency/tree/children [get]
+func (h *RegencyHandler) GetRegencyTreeChildren(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	req := new(dto.ReqRegencyTreeChildren)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := h.Usecase.GetRegencyTreeChildren(ctx, *req)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// SearchRegency godoc
+// @Summary		Search across all regency levels
+// @Description	Search provinces, cities, districts and subdistricts at once. Every match comes with its full ancestor path
+// @Tags			Regency - Tree
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			search	query		string	true	"Search keyword (min 2 characters)"
+// @Param			level	query		string	false	"Restrict to a level (province, city, district, subdistrict)"
+// @Param			limit	query		int		false	"Maximum number of matches (default 20, max 100)"
+// @Success		200		{object}	response.NonPaginationResponse{data=[]dto.RespRegencySearchResult}	"Successfully searched regencies"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/regency/search [get]
+func (h *RegencyHandler) SearchRegency(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	req := new(dto.ReqRegencySearch)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := h.Usecase.SearchRegency(ctx, *req)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
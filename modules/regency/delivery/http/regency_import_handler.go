@@ -0,0 +1,206 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/modules/regency/dto"
+	"github.com/xuri/excelize/v2"
+)
+
+// GetRegencyByCode godoc
+// @Summary		Get region by official code
+// @Description	Retrieve a province, city, district or subdistrict by its official Kemendagri / BPS code (e.g. 32.73.01.1001). The level is derived from the code
+// @Tags			Regency - Tree
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			code	path		string	true	"Official region code"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespRegencySearchResult}	"Successfully retrieved region"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid code"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		404		{object}	response.NonPaginationResponse	"Region not found"
+// @Router			/v1/regency/code/{code} [get]
+func (h *RegencyHandler) GetRegencyByCode(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	res, err := h.Usecase.GetRegencyByCode(ctx, c.Param("code"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ImportRegencies godoc
+// @Summary		Import official regency dataset
+// @Description	Upsert the whole province > city > district > subdistrict hierarchy from an official dataset (.csv, .xlsx or .xls) with columns: code, name. Regions are matched by code, regions without code are linked by name, coded regions missing from the dataset are removed. The report lists added, renamed, linked and removed regions. Nothing is written when a row is invalid or when dry_run is true.
+// @Tags			Regency - Tree
+// @Accept			multipart/form-data
+// @Produce		json
+// @Security		BearerAuth
+// @Param			file	formData	file	true	"Dataset file (.csv, .xlsx or .xls) with columns: code, name"
+// @Param			dry_run	formData	bool	false	"Only report the changes"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespRegencyImport}	"Successfully imported dataset"
+// @Failure		400		{object}	response.NonPaginationResponse{data=dto.RespRegencyImport}	"Bad request - one or more rows are invalid, the report contains the row errors"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500		{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/regency/import [post]
+func (h *RegencyHandler) ImportRegencies(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	req := new(dto.ReqRegencyImport)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	tempFilePath, status, err := saveRegencyImportFile(c, "import_regencies")
+	if err != nil {
+		return c.JSON(status, response.SetErrorResponse(status, err.Error()))
+	}
+	defer os.Remove(tempFilePath)
+
+	res, err := h.Usecase.ImportRegencies(ctx, tempFilePath, req.DryRun)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+
+	// invalid rows: nothing was imported, return the row errors
+	if len(res.Errors) > 0 {
+		resp.Message = constants.RegencyImportInvalidRows
+		resp.Status = http.StatusBadRequest
+		return c.JSON(http.StatusBadRequest, resp)
+	}
+
+	resp.Message = constants.RegencyImportSuccess
+	if res.DryRun {
+		resp.Message = constants.RegencyImportDryRunSuccess
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// saveRegencyImportFile copies the uploaded "file" field to a temporary file keeping its extension,
+// the caller removes the file. The returned status is the one to answer with on error.
+func saveRegencyImportFile(c echo.Context, prefix string) (string, int, error) {
+	// Get uploaded file
+	file, err := c.FormFile("file")
+	if err != nil {
+		return "", http.StatusBadRequest, errors.New(constants.RegencyImportFileNotFound)
+	}
+
+	// Validate file extension
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+	if ext != ".csv" && ext != ".xlsx" && ext != ".xls" {
+		return "", http.StatusBadRequest, errors.New(constants.RegencyImportInvalidFileFormat)
+	}
+
+	// Open uploaded file
+	src, err := file.Open()
+	if err != nil {
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.RegencyImportFileOpenFailed, err)
+	}
+	defer src.Close()
+
+	tempFilePath := filepath.Join(os.TempDir(), fmt.Sprintf("%s_%d%s", prefix, time.Now().UnixNano(), ext))
+	dst, err := os.Create(tempFilePath)
+	if err != nil {
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.RegencyImportFileOpenFailed, err)
+	}
+	defer dst.Close()
+
+	if _, err = io.Copy(dst, src); err != nil {
+		os.Remove(tempFilePath)
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.RegencyImportFileOpenFailed, err)
+	}
+
+	return tempFilePath, http.StatusOK, nil
+}
+
+// DownloadRegencyImportTemplate godoc
+// @Summary		Download regency import Excel template
+// @Description	Download Excel template file for importing the official regency dataset. Template contains columns: code, name with one example row per level.
+// @Tags			Regency - Tree
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Success		200	{file}		file	"Excel template file"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500	{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/regency/import/template [get]
+func (h *RegencyHandler) DownloadRegencyImportTemplate(c echo.Context) error {
+	// one example per level, codes are text so leading zeros are kept
+	examples := [][]string{
+		{"32", "JAWA BARAT"},
+		{"32.73", "KOTA BANDUNG"},
+		{"32.73.01", "SUKASARI"},
+		{"32.73.01.1001", "SARIJADI"},
+	}
+
+	return writeRegencyTemplate(c, "Import Regencies", []string{"Code", "Name"}, []float64{20, 40}, examples, "regency_import_template.xlsx")
+}
+
+// writeRegencyTemplate streams a single sheet template with a styled header row and text example rows
+func writeRegencyTemplate(c echo.Context, sheetName string, headers []string, widths []float64, examples [][]string, fileName string) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	f.SetSheetName("Sheet1", sheetName)
+
+	for i, header := range headers {
+		col, _ := excelize.ColumnNumberToName(i + 1)
+		f.SetCellValue(sheetName, col+"1", header)
+		f.SetColWidth(sheetName, col, col, widths[i])
+	}
+
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{
+			Bold: true,
+			Size: 12,
+		},
+		Fill: excelize.Fill{
+			Type:    "pattern",
+			Color:   []string{"#E8E8E8"},
+			Pattern: 1,
+		},
+		Alignment: &excelize.Alignment{
+			Horizontal: "center",
+			Vertical:   "center",
+		},
+	})
+	if err == nil {
+		lastCol, _ := excelize.ColumnNumberToName(len(headers))
+		f.SetCellStyle(sheetName, "A1", lastCol+"1", headerStyle)
+	}
+
+	for i, example := range examples {
+		for j, value := range example {
+			cell, _ := excelize.CoordinatesToCellName(j+1, i+2)
+			f.SetCellStr(sheetName, cell, value)
+		}
+	}
+
+	c.Response().Header().Set(constants.FieldContentType, constants.ExcelContent)
+	c.Response().Header().Set(constants.FieldContentDisposition, constants.ExcelContentDisposition(fileName))
+
+	if err := f.Write(c.Response().Writer); err != nil {
+		return c.JSON(http.StatusInternalServerError, response.SetErrorResponse(http.StatusInternalServerError, fmt.Sprintf("%s: %v", constants.RegencyImportTemplateFailed, err)))
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+)
+
+// ReqRegencyBoundary is an uploaded GeoJSON document: a Polygon / MultiPolygon geometry,
+// a Feature holding one, or a FeatureCollection whose polygons are merged
+type ReqRegencyBoundary struct {
+	Type        string               `json:"type" validate:"required"`
+	Coordinates json.RawMessage      `json:"coordinates,omitempty" swaggertype:"array,number"`
+	Geometry    *ReqRegencyBoundary  `json:"geometry,omitempty"`
+	Features    []ReqRegencyBoundary `json:"features,omitempty"`
+}
+
+type ReqRegencyBoundaryIndex struct {
+	Level    string `query:"level" json:"level" validate:"required"`               // province, city, district or subdistrict
+	ParentID string `query:"parent_id" json:"parent_id" validate:"omitempty,uuid"` // Only the children of this region
+}
+
+type ReqRegencyLocate struct {
+	Latitude  *float64 `query:"latitude" json:"latitude" validate:"required,gte=-90,lte=90"`
+	Longitude *float64 `query:"longitude" json:"longitude" validate:"required,gte=-180,lte=180"`
+}
+
+// RegencyBoundaryRow is a stored boundary with the region it belongs to and its ancestors
+type RegencyBoundaryRow struct {
+	RegencySearchRow
+	Geometry  string    `gorm:"column:geometry"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+type GeoJSONFeature struct {
+	Type       string                 `json:"type"`
+	ID         uuid.UUID              `json:"id"`
+	Properties map[string]interface{} `json:"properties"`
+	Geometry   json.RawMessage        `json:"geometry" swaggertype:"object"`
+}
+
+type GeoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []GeoJSONFeature `json:"features"`
+}
+
+func ToGeoJSONFeature(m RegencyBoundaryRow) GeoJSONFeature {
+	region := ToRespRegencySearchResult(m.RegencySearchRow)
+	return GeoJSONFeature{
+		Type: "Feature",
+		ID:   m.ID,
+		Properties: map[string]interface{}{
+			"id":         m.ID,
+			"level":      m.Level,
+			"code":       m.Code,
+			"name":       m.Name,
+			"path":       region.Path,
+			"updated_at": m.UpdatedAt.Format(constants.FormatDateTimeISO8601),
+		},
+		Geometry: json.RawMessage(m.Geometry),
+	}
+}
+
+func ToGeoJSONFeatureCollection(rows []RegencyBoundaryRow) GeoJSONFeatureCollection {
+	features := make([]GeoJSONFeature, 0, len(rows))
+	for _, row := range rows {
+		features = append(features, ToGeoJSONFeature(row))
+	}
+	return GeoJSONFeatureCollection{Type: "FeatureCollection", Features: features}
+}
+
+type RespRegencyLocate struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// Region is the deepest region resolved, with its ancestors up to the province
+	Region RespRegencySearchResult `json:"region"`
+	// BoundaryLevel is the level of the boundary that contains the coordinate
+	BoundaryLevel string `json:"boundary_level"`
+	// MatchedBy is boundary, or nearest_centroid when the subdistrict was picked by centroid inside the matched district
+	MatchedBy string `json:"matched_by"`
+}
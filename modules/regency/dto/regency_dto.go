@@ -8,16 +8,19 @@ import (
 
 // Province DTOs
 type ReqCreateProvince struct {
-	Name string `form:"name" json:"name" validate:"required,max=100"`
+	Name string  `form:"name" json:"name" validate:"required,max=100"`
+	Code *string `form:"code" json:"code" validate:"omitempty,max=20"` // Official Kemendagri / BPS region code
 }
 
 type ReqUpdateProvince struct {
-	Name string `form:"name" json:"name" validate:"required,max=100"`
+	Name string  `form:"name" json:"name" validate:"required,max=100"`
+	Code *string `form:"code" json:"code" validate:"omitempty,max=20"` // Official Kemendagri / BPS region code
 }
 
 type RespProvince struct {
 	ID        uuid.UUID `json:"id"`
 	Name      string    `json:"name"`
+	Code      *string   `json:"code"`
 	CreatedAt string    `json:"created_at"`
 	UpdatedAt string    `json:"updated_at"`
 }
@@ -26,6 +29,7 @@ func ToRespProvince(m models.Province) RespProvince {
 	return RespProvince{
 		ID:        m.ID,
 		Name:      m.Name,
+		Code:      m.Code,
 		CreatedAt: m.CreatedAt.Format(constants.FormatDateTimeISO8601),
 		UpdatedAt: m.UpdatedAt.Format(constants.FormatDateTimeISO8601),
 	}
@@ -34,6 +38,7 @@ func ToRespProvince(m models.Province) RespProvince {
 type RespProvinceIndex struct {
 	ID        uuid.UUID `json:"id"`
 	Name      string    `json:"name"`
+	Code      *string   `json:"code"`
 	CreatedAt string    `json:"created_at"`
 	UpdatedAt string    `json:"updated_at"`
 }
@@ -42,6 +47,7 @@ func ToRespProvinceIndex(m models.Province) RespProvinceIndex {
 	return RespProvinceIndex{
 		ID:        m.ID,
 		Name:      m.Name,
+		Code:      m.Code,
 		CreatedAt: m.CreatedAt.Format(constants.FormatDateTimeISO8601),
 		UpdatedAt: m.UpdatedAt.Format(constants.FormatDateTimeISO8601),
 	}
@@ -55,12 +61,14 @@ type ReqProvinceIndexFilter struct {
 type ReqCreateCity struct {
 	ProvinceID uuid.UUID `form:"province_id" json:"province_id" validate:"required"`
 	Name       string    `form:"name" json:"name" validate:"required,max=255"`
+	Code       *string   `form:"code" json:"code" validate:"omitempty,max=20"` // Official Kemendagri / BPS region code
 	AreaCode   *string   `form:"area_code" json:"area_code" validate:"omitempty,max=50"`
 }
 
 type ReqUpdateCity struct {
 	ProvinceID uuid.UUID `form:"province_id" json:"province_id" validate:"required"`
 	Name       string    `form:"name" json:"name" validate:"required,max=255"`
+	Code       *string   `form:"code" json:"code" validate:"omitempty,max=20"` // Official Kemendagri / BPS region code
 	AreaCode   *string   `form:"area_code" json:"area_code" validate:"omitempty,max=50"`
 }
 
@@ -69,6 +77,7 @@ type RespCity struct {
 	ProvinceID uuid.UUID     `json:"province_id"`
 	Province   *RespProvince `json:"province,omitempty"`
 	Name       string        `json:"name"`
+	Code       *string       `json:"code"`
 	AreaCode   *string       `json:"area_code"`
 	CreatedAt  string        `json:"created_at"`
 	UpdatedAt  string        `json:"updated_at"`
@@ -79,6 +88,7 @@ func ToRespCity(m models.City) RespCity {
 		ID:         m.ID,
 		ProvinceID: m.ProvinceID,
 		Name:       m.Name,
+		Code:       m.Code,
 		AreaCode:   m.AreaCode,
 		CreatedAt:  m.CreatedAt.Format(constants.FormatDateTimeISO8601),
 		UpdatedAt:  m.UpdatedAt.Format(constants.FormatDateTimeISO8601),
@@ -94,6 +104,7 @@ type RespCityIndex struct {
 	ID         uuid.UUID `json:"id"`
 	ProvinceID uuid.UUID `json:"province_id"`
 	Name       string    `json:"name"`
+	Code       *string   `json:"code"`
 	AreaCode   *string   `json:"area_code"`
 	CreatedAt  string    `json:"created_at"`
 	UpdatedAt  string    `json:"updated_at"`
@@ -104,6 +115,7 @@ func ToRespCityIndex(m models.City) RespCityIndex {
 		ID:         m.ID,
 		ProvinceID: m.ProvinceID,
 		Name:       m.Name,
+		Code:       m.Code,
 		AreaCode:   m.AreaCode,
 		CreatedAt:  m.CreatedAt.Format(constants.FormatDateTimeISO8601),
 		UpdatedAt:  m.UpdatedAt.Format(constants.FormatDateTimeISO8601),
@@ -122,13 +134,19 @@ type RespCityAreaCode struct {
 
 // District DTOs
 type ReqCreateDistrict struct {
-	CityID uuid.UUID `form:"city_id" json:"city_id" validate:"required"`
-	Name   string    `form:"name" json:"name" validate:"required,max=255"`
+	CityID    uuid.UUID `form:"city_id" json:"city_id" validate:"required"`
+	Name      string    `form:"name" json:"name" validate:"required,max=255"`
+	Code      *string   `form:"code" json:"code" validate:"omitempty,max=20"`                     // Official Kemendagri / BPS region code
+	Latitude  *float64  `form:"latitude" json:"latitude" validate:"omitempty,gte=-90,lte=90"`     // Centroid latitude, sent together with longitude
+	Longitude *float64  `form:"longitude" json:"longitude" validate:"omitempty,gte=-180,lte=180"` // Centroid longitude, sent together with latitude
 }
 
 type ReqUpdateDistrict struct {
-	CityID uuid.UUID `form:"city_id" json:"city_id" validate:"required"`
-	Name   string    `form:"name" json:"name" validate:"required,max=255"`
+	CityID    uuid.UUID `form:"city_id" json:"city_id" validate:"required"`
+	Name      string    `form:"name" json:"name" validate:"required,max=255"`
+	Code      *string   `form:"code" json:"code" validate:"omitempty,max=20"`                     // Official Kemendagri / BPS region code
+	Latitude  *float64  `form:"latitude" json:"latitude" validate:"omitempty,gte=-90,lte=90"`     // Centroid latitude, sent together with longitude
+	Longitude *float64  `form:"longitude" json:"longitude" validate:"omitempty,gte=-180,lte=180"` // Centroid longitude, sent together with latitude
 }
 
 type RespDistrict struct {
@@ -136,6 +154,9 @@ type RespDistrict struct {
 	CityID    uuid.UUID `json:"city_id"`
 	City      *RespCity `json:"city,omitempty"`
 	Name      string    `json:"name"`
+	Code      *string   `json:"code"`
+	Latitude  *float64  `json:"latitude"`
+	Longitude *float64  `json:"longitude"`
 	CreatedAt string    `json:"created_at"`
 	UpdatedAt string    `json:"updated_at"`
 }
@@ -145,6 +166,9 @@ func ToRespDistrict(m models.District) RespDistrict {
 		ID:        m.ID,
 		CityID:    m.CityID,
 		Name:      m.Name,
+		Code:      m.Code,
+		Latitude:  m.Latitude,
+		Longitude: m.Longitude,
 		CreatedAt: m.CreatedAt.Format(constants.FormatDateTimeISO8601),
 		UpdatedAt: m.UpdatedAt.Format(constants.FormatDateTimeISO8601),
 	}
@@ -159,6 +183,9 @@ type RespDistrictIndex struct {
 	ID        uuid.UUID `json:"id"`
 	CityID    uuid.UUID `json:"city_id"`
 	Name      string    `json:"name"`
+	Code      *string   `json:"code"`
+	Latitude  *float64  `json:"latitude"`
+	Longitude *float64  `json:"longitude"`
 	CreatedAt string    `json:"created_at"`
 	UpdatedAt string    `json:"updated_at"`
 }
@@ -168,6 +195,9 @@ func ToRespDistrictIndex(m models.District) RespDistrictIndex {
 		ID:        m.ID,
 		CityID:    m.CityID,
 		Name:      m.Name,
+		Code:      m.Code,
+		Latitude:  m.Latitude,
+		Longitude: m.Longitude,
 		CreatedAt: m.CreatedAt.Format(constants.FormatDateTimeISO8601),
 		UpdatedAt: m.UpdatedAt.Format(constants.FormatDateTimeISO8601),
 	}
@@ -181,31 +211,47 @@ type ReqDistrictIndexFilter struct {
 
 // Subdistrict DTOs
 type ReqCreateSubdistrict struct {
-	DistrictID uuid.UUID `form:"district_id" json:"district_id" validate:"required"`
-	Name       string    `form:"name" json:"name" validate:"required,max=255"`
+	DistrictID  uuid.UUID `form:"district_id" json:"district_id" validate:"required"`
+	Name        string    `form:"name" json:"name" validate:"required,max=255"`
+	Code        *string   `form:"code" json:"code" validate:"omitempty,max=20"`                      // Official Kemendagri / BPS region code
+	PostalCodes []string  `form:"postal_codes" json:"postal_codes" validate:"omitempty,dive,max=10"` // 5 digit postal codes, an empty list clears them
+	Latitude    *float64  `form:"latitude" json:"latitude" validate:"omitempty,gte=-90,lte=90"`      // Centroid latitude, sent together with longitude
+	Longitude   *float64  `form:"longitude" json:"longitude" validate:"omitempty,gte=-180,lte=180"`  // Centroid longitude, sent together with latitude
 }
 
 type ReqUpdateSubdistrict struct {
-	DistrictID uuid.UUID `form:"district_id" json:"district_id" validate:"required"`
-	Name       string    `form:"name" json:"name" validate:"required,max=255"`
+	DistrictID  uuid.UUID `form:"district_id" json:"district_id" validate:"required"`
+	Name        string    `form:"name" json:"name" validate:"required,max=255"`
+	Code        *string   `form:"code" json:"code" validate:"omitempty,max=20"`                      // Official Kemendagri / BPS region code
+	PostalCodes []string  `form:"postal_codes" json:"postal_codes" validate:"omitempty,dive,max=10"` // 5 digit postal codes, an empty list clears them
+	Latitude    *float64  `form:"latitude" json:"latitude" validate:"omitempty,gte=-90,lte=90"`      // Centroid latitude, sent together with longitude
+	Longitude   *float64  `form:"longitude" json:"longitude" validate:"omitempty,gte=-180,lte=180"`  // Centroid longitude, sent together with latitude
 }
 
 type RespSubdistrict struct {
-	ID         uuid.UUID     `json:"id"`
-	DistrictID uuid.UUID     `json:"district_id"`
-	District   *RespDistrict `json:"district,omitempty"`
-	Name       string        `json:"name"`
-	CreatedAt  string        `json:"created_at"`
-	UpdatedAt  string        `json:"updated_at"`
+	ID          uuid.UUID     `json:"id"`
+	DistrictID  uuid.UUID     `json:"district_id"`
+	District    *RespDistrict `json:"district,omitempty"`
+	Name        string        `json:"name"`
+	Code        *string       `json:"code"`
+	PostalCodes []string      `json:"postal_codes"`
+	Latitude    *float64      `json:"latitude"`
+	Longitude   *float64      `json:"longitude"`
+	CreatedAt   string        `json:"created_at"`
+	UpdatedAt   string        `json:"updated_at"`
 }
 
 func ToRespSubdistrict(m models.Subdistrict) RespSubdistrict {
 	resp := RespSubdistrict{
-		ID:         m.ID,
-		DistrictID: m.DistrictID,
-		Name:       m.Name,
-		CreatedAt:  m.CreatedAt.Format(constants.FormatDateTimeISO8601),
-		UpdatedAt:  m.UpdatedAt.Format(constants.FormatDateTimeISO8601),
+		ID:          m.ID,
+		DistrictID:  m.DistrictID,
+		Name:        m.Name,
+		Code:        m.Code,
+		PostalCodes: regencyPostalCodes(m.PostalCodes),
+		Latitude:    m.Latitude,
+		Longitude:   m.Longitude,
+		CreatedAt:   m.CreatedAt.Format(constants.FormatDateTimeISO8601),
+		UpdatedAt:   m.UpdatedAt.Format(constants.FormatDateTimeISO8601),
 	}
 	if m.District.ID != uuid.Nil {
 		district := ToRespDistrict(m.District)
@@ -215,20 +261,28 @@ func ToRespSubdistrict(m models.Subdistrict) RespSubdistrict {
 }
 
 type RespSubdistrictIndex struct {
-	ID         uuid.UUID `json:"id"`
-	DistrictID uuid.UUID `json:"district_id"`
-	Name       string    `json:"name"`
-	CreatedAt  string    `json:"created_at"`
-	UpdatedAt  string    `json:"updated_at"`
+	ID          uuid.UUID `json:"id"`
+	DistrictID  uuid.UUID `json:"district_id"`
+	Name        string    `json:"name"`
+	Code        *string   `json:"code"`
+	PostalCodes []string  `json:"postal_codes"`
+	Latitude    *float64  `json:"latitude"`
+	Longitude   *float64  `json:"longitude"`
+	CreatedAt   string    `json:"created_at"`
+	UpdatedAt   string    `json:"updated_at"`
 }
 
 func ToRespSubdistrictIndex(m models.Subdistrict) RespSubdistrictIndex {
 	return RespSubdistrictIndex{
-		ID:         m.ID,
-		DistrictID: m.DistrictID,
-		Name:       m.Name,
-		CreatedAt:  m.CreatedAt.Format(constants.FormatDateTimeISO8601),
-		UpdatedAt:  m.UpdatedAt.Format(constants.FormatDateTimeISO8601),
+		ID:          m.ID,
+		DistrictID:  m.DistrictID,
+		Name:        m.Name,
+		Code:        m.Code,
+		PostalCodes: regencyPostalCodes(m.PostalCodes),
+		Latitude:    m.Latitude,
+		Longitude:   m.Longitude,
+		CreatedAt:   m.CreatedAt.Format(constants.FormatDateTimeISO8601),
+		UpdatedAt:   m.UpdatedAt.Format(constants.FormatDateTimeISO8601),
 	}
 }
 
@@ -0,0 +1,76 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/utils"
+)
+
+// RegencyGeo holds the postal codes and centroid to store on a region, nil fields are left untouched
+// and an empty (non nil) list of postal codes clears them
+type RegencyGeo struct {
+	PostalCodes []string
+	Latitude    *float64
+	Longitude   *float64
+}
+
+// IsEmpty reports whether nothing has to be written
+func (g RegencyGeo) IsEmpty() bool {
+	return g.PostalCodes == nil && g.Latitude == nil && g.Longitude == nil
+}
+
+type ReqRegencyNearest struct {
+	Latitude  *float64 `query:"latitude" json:"latitude" validate:"required,gte=-90,lte=90"`
+	Longitude *float64 `query:"longitude" json:"longitude" validate:"required,gte=-180,lte=180"`
+	Level     string   `query:"level" json:"level"`         // district or subdistrict (default)
+	Limit     int      `query:"limit" json:"limit"`         // Number of regions returned, nearest first (default 1, max 20)
+	RadiusKm  int      `query:"radius_km" json:"radius_km"` // Search radius in km (default 25, max 200)
+}
+
+// RegencyGeoRow is a district or subdistrict with its ancestors and geo attributes
+type RegencyGeoRow struct {
+	RegencySearchRow
+	PostalCodes utils.NullStringArray `gorm:"column:postal_codes"`
+	Latitude    *float64              `gorm:"column:latitude"`
+	Longitude   *float64              `gorm:"column:longitude"`
+}
+
+type RespRegencyGeo struct {
+	RespRegencySearchResult
+	// PostalCodes is null on districts
+	PostalCodes []string `json:"postal_codes"`
+	Latitude    *float64 `json:"latitude"`
+	Longitude   *float64 `json:"longitude"`
+	// DistanceMeters is only set on the nearest-region lookup
+	DistanceMeters *float64 `json:"distance_meters,omitempty"`
+}
+
+func ToRespRegencyGeo(m RegencyGeoRow) RespRegencyGeo {
+	return RespRegencyGeo{
+		RespRegencySearchResult: ToRespRegencySearchResult(m.RegencySearchRow),
+		PostalCodes:             m.PostalCodes.Strings,
+		Latitude:                m.Latitude,
+		Longitude:               m.Longitude,
+	}
+}
+
+// regencyPostalCodes returns the postal codes of a subdistrict, an empty list when it has none
+func regencyPostalCodes(codes utils.NullStringArray) []string {
+	if codes.Strings == nil {
+		return []string{}
+	}
+	return codes.Strings
+}
+
+// RegencyGeoUpdate is a single row of a postal code / coordinates import
+type RegencyGeoUpdate struct {
+	ID    uuid.UUID
+	Level string
+	Geo   RegencyGeo
+}
+
+type RespRegencyGeoImport struct {
+	DryRun    bool                        `json:"dry_run"`
+	TotalRows int                         `json:"total_rows"`
+	Updated   int                         `json:"updated"`
+	Errors    []RespRegencyImportRowError `json:"errors"`
+}
@@ -0,0 +1,79 @@
+package dto
+
+import "github.com/google/uuid"
+
+type ReqRegencyImport struct {
+	DryRun bool `form:"dry_run" json:"dry_run"` // Only report the changes, nothing is written
+}
+
+// RegencyImportRow is a parsed row of an official dataset
+type RegencyImportRow struct {
+	Row   int
+	Code  string
+	Name  string
+	Level string
+}
+
+// RegencyCodeRecord is an active region of any level, used to reconcile a dataset with the database
+type RegencyCodeRecord struct {
+	ID       uuid.UUID  `gorm:"column:id"`
+	Level    string     `gorm:"column:level"`
+	Code     *string    `gorm:"column:code"`
+	Name     string     `gorm:"column:name"`
+	ParentID *uuid.UUID `gorm:"column:parent_id"`
+}
+
+// RegencyImportChange is a single write of an import, applied in order
+type RegencyImportChange struct {
+	Action  string
+	Level   string
+	Code    string
+	Name    string
+	OldName string
+	// ID of the existing region (renamed, linked, removed)
+	ID uuid.UUID
+	// ParentID is set when the parent already exists, otherwise the parent is added
+	// by the same import and resolved from ParentCode
+	ParentID   *uuid.UUID
+	ParentCode string
+}
+
+type RespRegencyImportChange struct {
+	Action  string  `json:"action"`
+	Level   string  `json:"level"`
+	Code    string  `json:"code"`
+	Name    string  `json:"name"`
+	OldName *string `json:"old_name,omitempty"`
+}
+
+func ToRespRegencyImportChange(m RegencyImportChange) RespRegencyImportChange {
+	resp := RespRegencyImportChange{
+		Action: m.Action,
+		Level:  m.Level,
+		Code:   m.Code,
+		Name:   m.Name,
+	}
+	if m.OldName != "" {
+		oldName := m.OldName
+		resp.OldName = &oldName
+	}
+	return resp
+}
+
+type RespRegencyImportRowError struct {
+	Row          int    `json:"row"`
+	Code         string `json:"code"`
+	ErrorMessage string `json:"error_message"`
+}
+
+type RespRegencyImport struct {
+	DryRun    bool                        `json:"dry_run"`
+	TotalRows int                         `json:"total_rows"`
+	Added     int                         `json:"added"`
+	Renamed   int                         `json:"renamed"`
+	Linked    int                         `json:"linked"`
+	Removed   int                         `json:"removed"`
+	Unchanged int                         `json:"unchanged"`
+	Changes   []RespRegencyImportChange   `json:"changes"`
+	Errors    []RespRegencyImportRowError `json:"errors"`
+}
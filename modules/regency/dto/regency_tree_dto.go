@@ -0,0 +1,102 @@
+package dto
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+)
+
+// Regency tree DTOs
+type ReqRegencyTreeChildren struct {
+	Level    string `query:"level" json:"level" validate:"required,oneof=province city district subdistrict"`
+	ParentID string `query:"parent_id" json:"parent_id" validate:"omitempty,uuid"`
+}
+
+type ReqRegencySearch struct {
+	Search string `query:"search" json:"search" validate:"required,min=2"`
+	Level  string `query:"level" json:"level" validate:"omitempty,oneof=province city district subdistrict"`
+	Limit  int    `query:"limit" json:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+// RegencyNode is a single province / city / district / subdistrict row of the tree
+type RegencyNode struct {
+	ID          uuid.UUID  `gorm:"column:id"`
+	ParentID    *uuid.UUID `gorm:"column:parent_id"`
+	Name        string     `gorm:"column:name"`
+	HasChildren bool       `gorm:"column:has_children"`
+}
+
+type RespRegencyTreeNode struct {
+	ID          uuid.UUID             `json:"id"`
+	Name        string                `json:"name"`
+	Level       string                `json:"level"`
+	HasChildren bool                  `json:"has_children"`
+	Children    []RespRegencyTreeNode `json:"children,omitempty"`
+}
+
+func ToRespRegencyTreeNode(level string, m RegencyNode) RespRegencyTreeNode {
+	return RespRegencyTreeNode{
+		ID:          m.ID,
+		Name:        m.Name,
+		Level:       level,
+		HasChildren: m.HasChildren,
+	}
+}
+
+// RegencySearchRow is a search match of any level along with its ancestors
+type RegencySearchRow struct {
+	ID           uuid.UUID  `gorm:"column:id"`
+	Code         *string    `gorm:"column:code"`
+	Name         string     `gorm:"column:name"`
+	Level        string     `gorm:"column:level"`
+	ProvinceID   *uuid.UUID `gorm:"column:province_id"`
+	ProvinceName *string    `gorm:"column:province_name"`
+	CityID       *uuid.UUID `gorm:"column:city_id"`
+	CityName     *string    `gorm:"column:city_name"`
+	DistrictID   *uuid.UUID `gorm:"column:district_id"`
+	DistrictName *string    `gorm:"column:district_name"`
+}
+
+type RespRegencyAncestor struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Level string    `json:"level"`
+}
+
+type RespRegencySearchResult struct {
+	ID    uuid.UUID `json:"id"`
+	Code  *string   `json:"code"`
+	Name  string    `json:"name"`
+	Level string    `json:"level"`
+	// Path is the full name from the match up to its province, e.g. "Kec. X, Kota Y, Prov. Z"
+	Path string `json:"path"`
+	// Ancestors are ordered from the province down to the direct parent
+	Ancestors []RespRegencyAncestor `json:"ancestors"`
+}
+
+func ToRespRegencySearchResult(m RegencySearchRow) RespRegencySearchResult {
+	ancestors := []RespRegencyAncestor{}
+	appendAncestor := func(id *uuid.UUID, name *string, level string) {
+		if id != nil && name != nil {
+			ancestors = append(ancestors, RespRegencyAncestor{ID: *id, Name: *name, Level: level})
+		}
+	}
+	appendAncestor(m.ProvinceID, m.ProvinceName, constants.RegencyLevelProvince)
+	appendAncestor(m.CityID, m.CityName, constants.RegencyLevelCity)
+	appendAncestor(m.DistrictID, m.DistrictName, constants.RegencyLevelDistrict)
+
+	names := []string{m.Name}
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		names = append(names, ancestors[i].Name)
+	}
+
+	return RespRegencySearchResult{
+		ID:        m.ID,
+		Code:      m.Code,
+		Name:      m.Name,
+		Level:     m.Level,
+		Path:      strings.Join(names, constants.RegencyPathSeparator),
+		Ancestors: ancestors,
+	}
+}
@@ -47,4 +47,34 @@ type Repository interface {
 	GetSubdistrictIndex(ctx context.Context, req request.PageRequest, filter dto.ReqSubdistrictIndexFilter) ([]models.Subdistrict, int, error)
 	GetAllSubdistrict(ctx context.Context, filter dto.ReqSubdistrictIndexFilter) ([]models.Subdistrict, error)
 	ExistsSubdistrictByName(ctx context.Context, districtID uuid.UUID, name string, excludeID uuid.UUID) (bool, error)
+
+	// Tree & search methods
+	GetRegencyNodes(ctx context.Context, level string, parentID *uuid.UUID) ([]dto.RegencyNode, error)
+	GetRegencyTreeVersion(ctx context.Context) (string, error)
+	SearchRegency(ctx context.Context, search string, level string, limit int) ([]dto.RegencySearchRow, error)
+
+	// Official code & import methods
+	ExistsRegencyByCode(ctx context.Context, level string, code string, excludeID uuid.UUID) (bool, error)
+	SetRegencyCode(ctx context.Context, level string, id uuid.UUID, code *string) error
+	GetRegencyByCode(ctx context.Context, level string, code string) (*dto.RegencySearchRow, error)
+	GetRegencyCodeRecords(ctx context.Context) ([]dto.RegencyCodeRecord, error)
+	ApplyRegencyImport(ctx context.Context, changes []dto.RegencyImportChange) error
+
+	// Usage methods
+	ExistsRegencyInUse(ctx context.Context, level string, id uuid.UUID) (bool, error)
+
+	// Postal code & coordinates methods
+	SetRegencyGeo(ctx context.Context, level string, id uuid.UUID, geo dto.RegencyGeo) error
+	GetRegencyGeoInBox(ctx context.Context, level string, minLat, maxLat, minLong, maxLong float64) ([]dto.RegencyGeoRow, error)
+	GetRegencyByPostalCode(ctx context.Context, postalCode string) ([]dto.RegencyGeoRow, error)
+	ApplyRegencyGeoImport(ctx context.Context, updates []dto.RegencyGeoUpdate) error
+
+	// Boundary methods
+	ExistsRegencyByID(ctx context.Context, level string, id uuid.UUID) (bool, error)
+	GetRegencyByID(ctx context.Context, level string, id uuid.UUID) (*dto.RegencySearchRow, error)
+	UpsertRegencyBoundary(ctx context.Context, boundary *models.RegencyBoundary) error
+	DeleteRegencyBoundary(ctx context.Context, level string, regionID uuid.UUID) error
+	GetRegencyBoundary(ctx context.Context, level string, regionID uuid.UUID) (*dto.RegencyBoundaryRow, error)
+	GetRegencyBoundaries(ctx context.Context, level string, parentID *uuid.UUID) ([]dto.RegencyBoundaryRow, error)
+	GetRegencyBoundariesAt(ctx context.Context, level string, latitude, longitude float64) ([]dto.RegencyBoundaryRow, error)
 }
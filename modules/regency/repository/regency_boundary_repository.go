@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/regency/dto"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// column of the flattened relation (r) holding the parent of each level
+var regencyUnionParentColumns = map[string]string{
+	constants.RegencyLevelCity:        "r.province_id",
+	constants.RegencyLevelDistrict:    "r.city_id",
+	constants.RegencyLevelSubdistrict: "r.district_id",
+}
+
+// regencyBoundaryQuery joins the boundaries of a level with their active region and its ancestors
+func (r *regencyRepository) regencyBoundaryQuery(ctx context.Context, level string) *gorm.DB {
+	return r.DB.WithContext(ctx).
+		Table(regencySearchUnion(ctx)).
+		Joins("JOIN regency_boundaries b ON b.region_id = r.id AND b.level = r.level").
+		Select("r.id, r.code, r.name, r.level, r.province_id, r.province_name, r.city_id, r.city_name, r.district_id, r.district_name, b.geometry, b.updated_at").
+		Where("r.level = ?", level)
+}
+
+// ExistsRegencyByID checks whether an active region of the level exists
+func (r *regencyRepository) ExistsRegencyByID(ctx context.Context, level string, id uuid.UUID) (bool, error) {
+	levelTable, err := regencyTable(level)
+	if err != nil {
+		return false, err
+	}
+
+	var count int64
+	if err := r.DB.WithContext(ctx).Table(levelTable.Table).Where("id = ? AND deleted_at IS NULL", id).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetRegencyByID retrieves an active region of the level along with its ancestors
+func (r *regencyRepository) GetRegencyByID(ctx context.Context, level string, id uuid.UUID) (*dto.RegencySearchRow, error) {
+	rows := []dto.RegencySearchRow{}
+	err := r.DB.WithContext(ctx).
+		Table(regencySearchUnion(ctx)).
+		Select("r.id, r.code, r.name, r.level, r.province_id, r.province_name, r.city_id, r.city_name, r.district_id, r.district_name").
+		Where("r.level = ? AND r.id = ?", level, id).
+		Limit(1).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf(constants.RegencyRegionNotFound, level, id)
+	}
+
+	return &rows[0], nil
+}
+
+// UpsertRegencyBoundary creates the boundary of a region or replaces the existing one
+func (r *regencyRepository) UpsertRegencyBoundary(ctx context.Context, boundary *models.RegencyBoundary) error {
+	return r.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "level"}, {Name: "region_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"geometry", "min_latitude", "max_latitude", "min_longitude", "max_longitude", "updated_by", "updated_at"}),
+	}).Create(boundary).Error
+}
+
+func (r *regencyRepository) DeleteRegencyBoundary(ctx context.Context, level string, regionID uuid.UUID) error {
+	res := r.DB.WithContext(ctx).Where("level = ? AND region_id = ?", level, regionID).Delete(&models.RegencyBoundary{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *regencyRepository) GetRegencyBoundary(ctx context.Context, level string, regionID uuid.UUID) (*dto.RegencyBoundaryRow, error) {
+	rows := []dto.RegencyBoundaryRow{}
+	if err := r.regencyBoundaryQuery(ctx, level).Where("r.id = ?", regionID).Limit(1).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &rows[0], nil
+}
+
+// GetRegencyBoundaries retrieves the boundaries of a level, optionally only the children of parentID
+func (r *regencyRepository) GetRegencyBoundaries(ctx context.Context, level string, parentID *uuid.UUID) ([]dto.RegencyBoundaryRow, error) {
+	query := r.regencyBoundaryQuery(ctx, level)
+	if parentID != nil {
+		parentColumn, ok := regencyUnionParentColumns[level]
+		if !ok {
+			return nil, errors.New(constants.RegencyTreeParentNotNeeded)
+		}
+		query = query.Where(parentColumn+" = ?", *parentID)
+	}
+
+	rows := []dto.RegencyBoundaryRow{}
+	if err := query.Order("r.name ASC").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// GetRegencyBoundariesAt retrieves the boundaries of a level whose bounding box contains the coordinate
+func (r *regencyRepository) GetRegencyBoundariesAt(ctx context.Context, level string, latitude, longitude float64) ([]dto.RegencyBoundaryRow, error) {
+	rows := []dto.RegencyBoundaryRow{}
+	err := r.regencyBoundaryQuery(ctx, level).
+		Where("? BETWEEN b.min_latitude AND b.max_latitude", latitude).
+		Where("? BETWEEN b.min_longitude AND b.max_longitude", longitude).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
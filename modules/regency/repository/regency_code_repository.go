@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/regency/dto"
+	"gorm.io/gorm"
+)
+
+func regencyTable(level string) (regencyLevelTable, error) {
+	levelTable, ok := regencyLevelTables[level]
+	if !ok {
+		return regencyLevelTable{}, fmt.Errorf("unknown regency level %s", level)
+	}
+	return levelTable, nil
+}
+
+// ExistsRegencyByCode checks whether an active region of the level already uses the code
+func (r *regencyRepository) ExistsRegencyByCode(ctx context.Context, level string, code string, excludeID uuid.UUID) (bool, error) {
+	levelTable, err := regencyTable(level)
+	if err != nil {
+		return false, err
+	}
+
+	var count int64
+	q := r.DB.WithContext(ctx).Table(levelTable.Table).Where("code = ? AND deleted_at IS NULL", code)
+	if excludeID != uuid.Nil {
+		q = q.Where("id <> ?", excludeID)
+	}
+	if err := q.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// SetRegencyCode sets (or clears when nil) the official code of a region
+func (r *regencyRepository) SetRegencyCode(ctx context.Context, level string, id uuid.UUID, code *string) error {
+	levelTable, err := regencyTable(level)
+	if err != nil {
+		return err
+	}
+
+	return r.DB.WithContext(ctx).
+		Table(levelTable.Table).
+		Where("id = ? AND deleted_at IS NULL", id).
+		UpdateColumn("code", code).Error
+}
+
+// GetRegencyByCode retrieves an active region of the level by its official code, along with its ancestors
+func (r *regencyRepository) GetRegencyByCode(ctx context.Context, level string, code string) (*dto.RegencySearchRow, error) {
+	rows := []dto.RegencySearchRow{}
+	err := r.DB.WithContext(ctx).
+		Table(regencySearchUnion(ctx)).
+		Select("r.id, r.code, r.name, r.level, r.province_id, r.province_name, r.city_id, r.city_name, r.district_id, r.district_name").
+		Where("r.level = ? AND r.code = ?", level, code).
+		Limit(1).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf(constants.RegencyCodeNotFound, code)
+	}
+
+	return &rows[0], nil
+}
+
+// GetRegencyCodeRecords retrieves every active region of every level
+func (r *regencyRepository) GetRegencyCodeRecords(ctx context.Context) ([]dto.RegencyCodeRecord, error) {
+	records := []dto.RegencyCodeRecord{}
+	err := r.DB.WithContext(ctx).Raw(`
+		SELECT id, 'province' AS level, code, name, NULL::uuid AS parent_id FROM provinces WHERE deleted_at IS NULL
+		UNION ALL
+		SELECT id, 'city', code, name, province_id FROM cities WHERE deleted_at IS NULL
+		UNION ALL
+		SELECT id, 'district', code, name, city_id FROM districts WHERE deleted_at IS NULL
+		UNION ALL
+		SELECT id, 'subdistrict', code, name, district_id FROM subdistricts WHERE deleted_at IS NULL
+	`).Scan(&records).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// ApplyRegencyImport writes the changes of an import in a single transaction, in the given order
+func (r *regencyRepository) ApplyRegencyImport(ctx context.Context, changes []dto.RegencyImportChange) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+		added := map[string]uuid.UUID{}
+
+		for _, change := range changes {
+			levelTable, err := regencyTable(change.Level)
+			if err != nil {
+				return err
+			}
+
+			switch change.Action {
+			case constants.RegencyImportActionAdded:
+				parentID := uuid.Nil
+				if change.ParentID != nil {
+					parentID = *change.ParentID
+				} else if change.ParentCode != "" {
+					parentID = added[change.ParentCode]
+				}
+
+				id, err := createRegencyWithCode(tx, change.Level, parentID, change.Code, change.Name, now)
+				if err != nil {
+					return err
+				}
+				added[change.Code] = id
+			case constants.RegencyImportActionRenamed:
+				err = tx.Table(levelTable.Table).Where("id = ?", change.ID).
+					Updates(map[string]interface{}{"name": change.Name, "updated_at": now}).Error
+			case constants.RegencyImportActionLinked:
+				err = tx.Table(levelTable.Table).Where("id = ?", change.ID).
+					Updates(map[string]interface{}{"code": change.Code, "updated_at": now}).Error
+			case constants.RegencyImportActionRemoved:
+				err = tx.Table(levelTable.Table).Where("id = ? AND deleted_at IS NULL", change.ID).
+					Update("deleted_at", now).Error
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func createRegencyWithCode(tx *gorm.DB, level string, parentID uuid.UUID, code string, name string, now time.Time) (uuid.UUID, error) {
+	switch level {
+	case constants.RegencyLevelProvince:
+		m := &models.Province{Code: &code, Name: name, CreatedAt: now, UpdatedAt: now}
+		err := tx.Create(m).Error
+		return m.ID, err
+	case constants.RegencyLevelCity:
+		m := &models.City{ProvinceID: parentID, Code: &code, Name: name, CreatedAt: now, UpdatedAt: now}
+		err := tx.Create(m).Error
+		return m.ID, err
+	case constants.RegencyLevelDistrict:
+		m := &models.District{CityID: parentID, Code: &code, Name: name, CreatedAt: now, UpdatedAt: now}
+		err := tx.Create(m).Error
+		return m.ID, err
+	default:
+		m := &models.Subdistrict{DistrictID: parentID, Code: &code, Name: name, CreatedAt: now, UpdatedAt: now}
+		err := tx.Create(m).Error
+		return m.ID, err
+	}
+}
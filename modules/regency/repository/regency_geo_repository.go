@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/modules/regency/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"gorm.io/gorm"
+)
+
+const regencyGeoSelect = "r.id, r.code, r.name, r.level, r.province_id, r.province_name, r.city_id, r.city_name, r.district_id, r.district_name, %s AS postal_codes, g.latitude, g.longitude"
+
+// regencyGeoQuery joins the flattened regions of a level (district or subdistrict) with their geo columns
+func (r *regencyRepository) regencyGeoQuery(ctx context.Context, level string) (*gorm.DB, error) {
+	if level != constants.RegencyLevelDistrict && level != constants.RegencyLevelSubdistrict {
+		return nil, fmt.Errorf(constants.RegencyNearestLevelInvalid)
+	}
+
+	// postal codes are only stored on subdistricts
+	postalCodes := "NULL::jsonb"
+	if level == constants.RegencyLevelSubdistrict {
+		postalCodes = "g.postal_codes"
+	}
+
+	levelTable, err := regencyTable(level)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.DB.WithContext(ctx).
+		Table(regencySearchUnion(ctx)).
+		Joins(fmt.Sprintf("JOIN %s g ON g.id = r.id", levelTable.Table)).
+		Select(fmt.Sprintf(regencyGeoSelect, postalCodes)).
+		Where("r.level = ?", level), nil
+}
+
+// SetRegencyGeo writes the postal codes and centroid of a district or subdistrict, nil fields are left untouched
+// and an empty list of postal codes clears them
+func (r *regencyRepository) SetRegencyGeo(ctx context.Context, level string, id uuid.UUID, geo dto.RegencyGeo) error {
+	return r.setRegencyGeo(r.DB.WithContext(ctx), level, id, geo)
+}
+
+func (r *regencyRepository) setRegencyGeo(db *gorm.DB, level string, id uuid.UUID, geo dto.RegencyGeo) error {
+	levelTable, err := regencyTable(level)
+	if err != nil {
+		return err
+	}
+
+	updates := map[string]interface{}{
+		"updated_at": time.Now().UTC(),
+	}
+	if geo.PostalCodes != nil {
+		updates["postal_codes"] = utils.NullStringArray{Strings: geo.PostalCodes, Valid: true}
+	}
+	if geo.Latitude != nil && geo.Longitude != nil {
+		updates["latitude"] = *geo.Latitude
+		updates["longitude"] = *geo.Longitude
+	}
+
+	return db.Table(levelTable.Table).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(updates).Error
+}
+
+// GetRegencyGeoInBox retrieves the districts or subdistricts whose centroid lies in the bounding box
+func (r *regencyRepository) GetRegencyGeoInBox(ctx context.Context, level string, minLat, maxLat, minLong, maxLong float64) ([]dto.RegencyGeoRow, error) {
+	query, err := r.regencyGeoQuery(ctx, level)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []dto.RegencyGeoRow{}
+	err = query.
+		Where("g.latitude BETWEEN ? AND ?", minLat, maxLat).
+		Where("g.longitude BETWEEN ? AND ?", minLong, maxLong).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// GetRegencyByPostalCode retrieves every subdistrict having the postal code among its postal codes, along with its ancestors
+func (r *regencyRepository) GetRegencyByPostalCode(ctx context.Context, postalCode string) ([]dto.RegencyGeoRow, error) {
+	query, err := r.regencyGeoQuery(ctx, constants.RegencyLevelSubdistrict)
+	if err != nil {
+		return nil, err
+	}
+
+	contains, err := json.Marshal([]string{postalCode})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []dto.RegencyGeoRow{}
+	if err := query.Where("g.postal_codes @> ?::jsonb", string(contains)).Order("r.name ASC").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// ApplyRegencyGeoImport writes the postal codes and centroids of an import in a single transaction
+func (r *regencyRepository) ApplyRegencyGeoImport(ctx context.Context, updates []dto.RegencyGeoUpdate) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, update := range updates {
+			if err := r.setRegencyGeo(tx, update.Level, update.ID, update.Geo); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/i18n"
+	"gorm.io/gorm"
+)
+
+// regencyColumns are the columns of each regency table read into its model, besides the name
+var regencyColumns = map[string]string{
+	constants.TranslationEntityProvince:    "id, code, created_at, updated_at, deleted_at",
+	constants.TranslationEntityCity:        "id, province_id, code, area_code, created_at, updated_at, deleted_at",
+	constants.TranslationEntityDistrict:    "id, city_id, code, latitude, longitude, created_at, updated_at, deleted_at",
+	constants.TranslationEntitySubdistrict: "id, district_id, code, postal_codes, latitude, longitude, created_at, updated_at, deleted_at",
+}
+
+// selectLocalized selects the columns of a regency table with the name in the locale of the request,
+// usable as scope and as preload condition
+func selectLocalized(ctx context.Context, entityType, table string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Select(regencyColumns[entityType] + ", " + i18n.NameColumn(ctx, entityType, table+".id", table+".name") + " AS name")
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/i18n"
 	"github.com/rendyfutsuy/base-go/helpers/request"
 	"github.com/rendyfutsuy/base-go/models"
 	"github.com/rendyfutsuy/base-go/modules/regency"
@@ -66,7 +67,7 @@ func (r *regencyRepository) DeleteProvince(ctx context.Context, id uuid.UUID) er
 
 func (r *regencyRepository) GetProvinceByID(ctx context.Context, id uuid.UUID) (*models.Province, error) {
 	p := &models.Province{}
-	if err := r.DB.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(p).Error; err != nil {
+	if err := r.DB.WithContext(ctx).Scopes(selectLocalized(ctx, constants.TranslationEntityProvince, "provinces")).Where("id = ? AND deleted_at IS NULL", id).First(p).Error; err != nil {
 		return nil, err
 	}
 	return p, nil
@@ -86,7 +87,7 @@ func (r *regencyRepository) ExistsProvinceByName(ctx context.Context, name strin
 
 func (r *regencyRepository) GetProvinceIndex(ctx context.Context, req request.PageRequest, filter dto.ReqProvinceIndexFilter) ([]models.Province, int, error) {
 	var provinces []models.Province
-	query := r.DB.WithContext(ctx).Table("provinces p").Select("p.id, p.name, p.created_at, p.updated_at").
+	query := r.DB.WithContext(ctx).Table("provinces p").Select("p.id, p.code, " + i18n.NameColumn(ctx, constants.TranslationEntityProvince, "p.id", "p.name") + " AS name, p.created_at, p.updated_at").
 		Where("p.deleted_at IS NULL")
 
 	searchQuery := req.Search
@@ -95,7 +96,7 @@ func (r *regencyRepository) GetProvinceIndex(ctx context.Context, req request.Pa
 	total, err := request.ApplyPagination(query, req, request.PaginationConfig{
 		DefaultSortBy:      "p.created_at",
 		DefaultSortOrder:   "DESC",
-		AllowedColumns:     []string{"id", "name", "created_at", "updated_at"},
+		AllowedColumns:     []string{"id", "code", "name", "created_at", "updated_at"},
 		ColumnPrefix:       "p.",
 		MaxPerPage:         100,
 		NaturalSortColumns: []string{"p.name"}, // Enable natural sorting for p.name
@@ -108,7 +109,7 @@ func (r *regencyRepository) GetProvinceIndex(ctx context.Context, req request.Pa
 
 func (r *regencyRepository) GetAllProvince(ctx context.Context, filter dto.ReqProvinceIndexFilter) ([]models.Province, error) {
 	var provinces []models.Province
-	query := r.DB.WithContext(ctx).Table("provinces p").Select("p.id, p.name, p.created_at, p.updated_at").
+	query := r.DB.WithContext(ctx).Table("provinces p").Select("p.id, p.code, p.name, p.created_at, p.updated_at").
 		Where("p.deleted_at IS NULL")
 
 	query = request.ApplySearchConditionFromInterface(query, filter.Search, searches.NewProvinceSearchHelper())
@@ -167,7 +168,10 @@ func (r *regencyRepository) DeleteCity(ctx context.Context, id uuid.UUID) error
 
 func (r *regencyRepository) GetCityByID(ctx context.Context, id uuid.UUID) (*models.City, error) {
 	c := &models.City{}
-	if err := r.DB.WithContext(ctx).Preload("Province").Where("id = ? AND deleted_at IS NULL", id).First(c).Error; err != nil {
+	if err := r.DB.WithContext(ctx).
+		Scopes(selectLocalized(ctx, constants.TranslationEntityCity, "cities")).
+		Preload("Province", selectLocalized(ctx, constants.TranslationEntityProvince, "provinces")).
+		Where("id = ? AND deleted_at IS NULL", id).First(c).Error; err != nil {
 		return nil, err
 	}
 	return c, nil
@@ -187,7 +191,7 @@ func (r *regencyRepository) ExistsCityByName(ctx context.Context, provinceID uui
 
 func (r *regencyRepository) GetCityIndex(ctx context.Context, req request.PageRequest, filter dto.ReqCityIndexFilter) ([]models.City, int, error) {
 	var cities []models.City
-	query := r.DB.WithContext(ctx).Table("cities c").Select("c.id, c.province_id, c.name, c.area_code, c.created_at, c.updated_at").
+	query := r.DB.WithContext(ctx).Table("cities c").Select("c.id, c.province_id, c.code, " + i18n.NameColumn(ctx, constants.TranslationEntityCity, "c.id", "c.name") + " AS name, c.area_code, c.created_at, c.updated_at").
 		Where("c.deleted_at IS NULL")
 
 	searchQuery := req.Search
@@ -199,7 +203,7 @@ func (r *regencyRepository) GetCityIndex(ctx context.Context, req request.PageRe
 	total, err := request.ApplyPagination(query, req, request.PaginationConfig{
 		DefaultSortBy:      "c.created_at",
 		DefaultSortOrder:   "DESC",
-		AllowedColumns:     []string{"id", "province_id", "name", "area_code", "created_at", "updated_at"},
+		AllowedColumns:     []string{"id", "province_id", "code", "name", "area_code", "created_at", "updated_at"},
 		ColumnPrefix:       "c.",
 		MaxPerPage:         100,
 		NaturalSortColumns: []string{"c.name"}, // Enable natural sorting for c.name
@@ -212,7 +216,7 @@ func (r *regencyRepository) GetCityIndex(ctx context.Context, req request.PageRe
 
 func (r *regencyRepository) GetAllCity(ctx context.Context, filter dto.ReqCityIndexFilter) ([]models.City, error) {
 	var cities []models.City
-	query := r.DB.WithContext(ctx).Table("cities c").Select("c.id, c.province_id, c.name, c.area_code, c.created_at, c.updated_at").
+	query := r.DB.WithContext(ctx).Table("cities c").Select("c.id, c.province_id, c.code, c.name, c.area_code, c.created_at, c.updated_at").
 		Where("c.deleted_at IS NULL")
 
 	query = request.ApplySearchConditionFromInterface(query, filter.Search, searches.NewCitySearchHelper())
@@ -290,7 +294,11 @@ func (r *regencyRepository) DeleteDistrict(ctx context.Context, id uuid.UUID) er
 
 func (r *regencyRepository) GetDistrictByID(ctx context.Context, id uuid.UUID) (*models.District, error) {
 	d := &models.District{}
-	if err := r.DB.WithContext(ctx).Preload("City").Preload("City.Province").Where("id = ? AND deleted_at IS NULL", id).First(d).Error; err != nil {
+	if err := r.DB.WithContext(ctx).
+		Scopes(selectLocalized(ctx, constants.TranslationEntityDistrict, "districts")).
+		Preload("City", selectLocalized(ctx, constants.TranslationEntityCity, "cities")).
+		Preload("City.Province", selectLocalized(ctx, constants.TranslationEntityProvince, "provinces")).
+		Where("id = ? AND deleted_at IS NULL", id).First(d).Error; err != nil {
 		return nil, err
 	}
 	return d, nil
@@ -310,7 +318,7 @@ func (r *regencyRepository) ExistsDistrictByName(ctx context.Context, cityID uui
 
 func (r *regencyRepository) GetDistrictIndex(ctx context.Context, req request.PageRequest, filter dto.ReqDistrictIndexFilter) ([]models.District, int, error) {
 	var districts []models.District
-	query := r.DB.WithContext(ctx).Table("districts d").Select("d.id, d.city_id, d.name, d.created_at, d.updated_at").
+	query := r.DB.WithContext(ctx).Table("districts d").Select("d.id, d.city_id, d.code, " + i18n.NameColumn(ctx, constants.TranslationEntityDistrict, "d.id", "d.name") + " AS name, d.latitude, d.longitude, d.created_at, d.updated_at").
 		Where("d.deleted_at IS NULL")
 
 	searchQuery := req.Search
@@ -322,7 +330,7 @@ func (r *regencyRepository) GetDistrictIndex(ctx context.Context, req request.Pa
 	total, err := request.ApplyPagination(query, req, request.PaginationConfig{
 		DefaultSortBy:      "d.created_at",
 		DefaultSortOrder:   "DESC",
-		AllowedColumns:     []string{"id", "city_id", "name", "created_at", "updated_at"},
+		AllowedColumns:     []string{"id", "city_id", "code", "name", "created_at", "updated_at"},
 		ColumnPrefix:       "d.",
 		MaxPerPage:         100,
 		NaturalSortColumns: []string{"d.name"}, // Enable natural sorting for d.name
@@ -335,7 +343,7 @@ func (r *regencyRepository) GetDistrictIndex(ctx context.Context, req request.Pa
 
 func (r *regencyRepository) GetAllDistrict(ctx context.Context, filter dto.ReqDistrictIndexFilter) ([]models.District, error) {
 	var districts []models.District
-	query := r.DB.WithContext(ctx).Table("districts d").Select("d.id, d.city_id, d.name, d.created_at, d.updated_at").
+	query := r.DB.WithContext(ctx).Table("districts d").Select("d.id, d.city_id, d.code, d.name, d.latitude, d.longitude, d.created_at, d.updated_at").
 		Where("d.deleted_at IS NULL")
 
 	query = request.ApplySearchConditionFromInterface(query, filter.Search, searches.NewDistrictSearchHelper())
@@ -393,7 +401,12 @@ func (r *regencyRepository) DeleteSubdistrict(ctx context.Context, id uuid.UUID)
 
 func (r *regencyRepository) GetSubdistrictByID(ctx context.Context, id uuid.UUID) (*models.Subdistrict, error) {
 	s := &models.Subdistrict{}
-	if err := r.DB.WithContext(ctx).Preload("District").Preload("District.City").Preload("District.City.Province").Where("id = ? AND deleted_at IS NULL", id).First(s).Error; err != nil {
+	if err := r.DB.WithContext(ctx).
+		Scopes(selectLocalized(ctx, constants.TranslationEntitySubdistrict, "subdistricts")).
+		Preload("District", selectLocalized(ctx, constants.TranslationEntityDistrict, "districts")).
+		Preload("District.City", selectLocalized(ctx, constants.TranslationEntityCity, "cities")).
+		Preload("District.City.Province", selectLocalized(ctx, constants.TranslationEntityProvince, "provinces")).
+		Where("id = ? AND deleted_at IS NULL", id).First(s).Error; err != nil {
 		return nil, err
 	}
 	return s, nil
@@ -413,7 +426,7 @@ func (r *regencyRepository) ExistsSubdistrictByName(ctx context.Context, distric
 
 func (r *regencyRepository) GetSubdistrictIndex(ctx context.Context, req request.PageRequest, filter dto.ReqSubdistrictIndexFilter) ([]models.Subdistrict, int, error) {
 	var subdistricts []models.Subdistrict
-	query := r.DB.WithContext(ctx).Table("subdistricts s").Select("s.id, s.district_id, s.name, s.created_at, s.updated_at").
+	query := r.DB.WithContext(ctx).Table("subdistricts s").Select("s.id, s.district_id, s.code, " + i18n.NameColumn(ctx, constants.TranslationEntitySubdistrict, "s.id", "s.name") + " AS name, s.postal_codes, s.latitude, s.longitude, s.created_at, s.updated_at").
 		Where("s.deleted_at IS NULL")
 
 	searchQuery := req.Search
@@ -425,7 +438,7 @@ func (r *regencyRepository) GetSubdistrictIndex(ctx context.Context, req request
 	total, err := request.ApplyPagination(query, req, request.PaginationConfig{
 		DefaultSortBy:      "s.created_at",
 		DefaultSortOrder:   "DESC",
-		AllowedColumns:     []string{"id", "district_id", "name", "created_at", "updated_at"},
+		AllowedColumns:     []string{"id", "district_id", "code", "name", "created_at", "updated_at"},
 		ColumnPrefix:       "s.",
 		MaxPerPage:         100,
 		NaturalSortColumns: []string{"s.name"}, // Enable natural sorting for s.name
@@ -438,7 +451,7 @@ func (r *regencyRepository) GetSubdistrictIndex(ctx context.Context, req request
 
 func (r *regencyRepository) GetAllSubdistrict(ctx context.Context, filter dto.ReqSubdistrictIndexFilter) ([]models.Subdistrict, error) {
 	var subdistricts []models.Subdistrict
-	query := r.DB.WithContext(ctx).Table("subdistricts s").Select("s.id, s.district_id, s.name, s.created_at, s.updated_at").
+	query := r.DB.WithContext(ctx).Table("subdistricts s").Select("s.id, s.district_id, s.code, s.name, s.postal_codes, s.latitude, s.longitude, s.created_at, s.updated_at").
 		Where("s.deleted_at IS NULL")
 
 	query = request.ApplySearchConditionFromInterface(query, filter.Search, searches.NewSubdistrictSearchHelper())
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/i18n"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/modules/regency/dto"
+	"github.com/rendyfutsuy/base-go/modules/regency/repository/searches"
+)
+
+// regencyLevelTable maps a level of the hierarchy to its table and to the table of its children
+type regencyLevelTable struct {
+	Table        string
+	EntityType   string // translation entity of the names
+	ParentColumn string
+	ChildTable   string
+	ChildColumn  string
+}
+
+var regencyLevelTables = map[string]regencyLevelTable{
+	constants.RegencyLevelProvince:    {Table: "provinces", EntityType: constants.TranslationEntityProvince, ChildTable: "cities", ChildColumn: "province_id"},
+	constants.RegencyLevelCity:        {Table: "cities", EntityType: constants.TranslationEntityCity, ParentColumn: "province_id", ChildTable: "districts", ChildColumn: "city_id"},
+	constants.RegencyLevelDistrict:    {Table: "districts", EntityType: constants.TranslationEntityDistrict, ParentColumn: "city_id", ChildTable: "subdistricts", ChildColumn: "district_id"},
+	constants.RegencyLevelSubdistrict: {Table: "subdistricts", EntityType: constants.TranslationEntitySubdistrict, ParentColumn: "district_id"},
+}
+
+// GetRegencyNodes retrieves the active nodes of a level ordered by name, in the locale of the request.
+// When parentID is nil every node of the level is returned (used to build the full tree).
+func (r *regencyRepository) GetRegencyNodes(ctx context.Context, level string, parentID *uuid.UUID) ([]dto.RegencyNode, error) {
+	levelTable, ok := regencyLevelTables[level]
+	if !ok {
+		return nil, fmt.Errorf("unknown regency level %s", level)
+	}
+
+	parent := "NULL::uuid"
+	if levelTable.ParentColumn != "" {
+		parent = "n." + levelTable.ParentColumn
+	}
+
+	hasChildren := "FALSE"
+	if levelTable.ChildTable != "" {
+		hasChildren = fmt.Sprintf("EXISTS (SELECT 1 FROM %s child WHERE child.%s = n.id AND child.deleted_at IS NULL)", levelTable.ChildTable, levelTable.ChildColumn)
+	}
+
+	query := r.DB.WithContext(ctx).
+		Table(levelTable.Table + " n").
+		Select(fmt.Sprintf("n.id, %s AS parent_id, %s AS name, %s AS has_children",
+			parent, i18n.NameColumn(ctx, levelTable.EntityType, "n.id", "n.name"), hasChildren)).
+		Where("n.deleted_at IS NULL")
+
+	if parentID != nil && levelTable.ParentColumn != "" {
+		query = query.Where("n."+levelTable.ParentColumn+" = ?", *parentID)
+	}
+
+	nodes := []dto.RegencyNode{}
+	if err := query.Order("name ASC").Scan(&nodes).Error; err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+// GetRegencyTreeVersion returns a fingerprint of the four regency tables and of their name translations in the
+// locale of the request, it changes whenever a row is created, updated, deleted or restored, or a name is translated.
+func (r *regencyRepository) GetRegencyTreeVersion(ctx context.Context) (string, error) {
+	var version string
+	err := r.DB.WithContext(ctx).Raw(`
+		SELECT CONCAT_WS('|',
+			(SELECT COUNT(*) || ':' || COALESCE(MAX(GREATEST(updated_at, deleted_at))::text, '') FROM provinces),
+			(SELECT COUNT(*) || ':' || COALESCE(MAX(GREATEST(updated_at, deleted_at))::text, '') FROM cities),
+			(SELECT COUNT(*) || ':' || COALESCE(MAX(GREATEST(updated_at, deleted_at))::text, '') FROM districts),
+			(SELECT COUNT(*) || ':' || COALESCE(MAX(GREATEST(updated_at, deleted_at))::text, '') FROM subdistricts),
+			(SELECT COUNT(*) || ':' || COALESCE(MAX(updated_at)::text, '') FROM translations
+				WHERE entity_type IN (?) AND field = ? AND locale = ?)
+		)`,
+		[]string{constants.TranslationEntityProvince, constants.TranslationEntityCity, constants.TranslationEntityDistrict, constants.TranslationEntitySubdistrict},
+		constants.TranslationFieldName, i18n.LocaleFromContext(ctx),
+	).Scan(&version).Error
+	if err != nil {
+		return "", err
+	}
+
+	return version, nil
+}
+
+// regencySearchUnionTemplate flattens all levels into one relation (r) carrying the ancestors of each row,
+// the {province}, {city}, {district} and {subdistrict} placeholders are replaced by the localized names
+const regencySearchUnionTemplate = `(
+	SELECT p.id, p.code, {province} AS name, 'province' AS level,
+		NULL::uuid AS province_id, NULL::varchar AS province_name,
+		NULL::uuid AS city_id, NULL::varchar AS city_name,
+		NULL::uuid AS district_id, NULL::varchar AS district_name
+	FROM provinces p
+	WHERE p.deleted_at IS NULL
+	UNION ALL
+	SELECT c.id, c.code, {city}, 'city',
+		p.id, {province},
+		NULL::uuid, NULL::varchar,
+		NULL::uuid, NULL::varchar
+	FROM cities c
+	JOIN provinces p ON p.id = c.province_id AND p.deleted_at IS NULL
+	WHERE c.deleted_at IS NULL
+	UNION ALL
+	SELECT d.id, d.code, {district}, 'district',
+		p.id, {province},
+		c.id, {city},
+		NULL::uuid, NULL::varchar
+	FROM districts d
+	JOIN cities c ON c.id = d.city_id AND c.deleted_at IS NULL
+	JOIN provinces p ON p.id = c.province_id AND p.deleted_at IS NULL
+	WHERE d.deleted_at IS NULL
+	UNION ALL
+	SELECT s.id, s.code, {subdistrict}, 'subdistrict',
+		p.id, {province},
+		c.id, {city},
+		d.id, {district}
+	FROM subdistricts s
+	JOIN districts d ON d.id = s.district_id AND d.deleted_at IS NULL
+	JOIN cities c ON c.id = d.city_id AND c.deleted_at IS NULL
+	JOIN provinces p ON p.id = c.province_id AND p.deleted_at IS NULL
+	WHERE s.deleted_at IS NULL
+) r`
+
+// regencySearchUnion returns the flattened relation with the names in the locale of the request
+func regencySearchUnion(ctx context.Context) string {
+	name := func(entityType, alias string) string {
+		return "(" + i18n.NameColumn(ctx, entityType, alias+".id", alias+".name") + ")::varchar"
+	}
+
+	return strings.NewReplacer(
+		"{province}", name(constants.TranslationEntityProvince, "p"),
+		"{city}", name(constants.TranslationEntityCity, "c"),
+		"{district}", name(constants.TranslationEntityDistrict, "d"),
+		"{subdistrict}", name(constants.TranslationEntitySubdistrict, "s"),
+	).Replace(regencySearchUnionTemplate)
+}
+
+// SearchRegency searches every level at once with the trigram search builder, on the names in the locale of the request.
+// Rows are ordered by trigram similarity to the search (highest first), ties are ordered by name.
+func (r *regencyRepository) SearchRegency(ctx context.Context, search string, level string, limit int) ([]dto.RegencySearchRow, error) {
+	query := r.DB.WithContext(ctx).
+		Table(regencySearchUnion(ctx)).
+		Select("r.id, r.code, r.name, r.level, r.province_id, r.province_name, r.city_id, r.city_name, r.district_id, r.district_name")
+
+	if level != "" {
+		query = query.Where("r.level = ?", level)
+	}
+
+	// the search builder adds the similarity ordering, the name only breaks ties
+	query = request.ApplySearchConditionFromInterface(query, search, searches.NewRegencySearchHelper())
+
+	rows := []dto.RegencySearchRow{}
+	if err := query.Order("r.name ASC").Limit(limit).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
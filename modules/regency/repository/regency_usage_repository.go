@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+)
+
+// ExistsRegencyInUse checks whether an active supplier, customer address or expedition coverage / tariff still references the region.
+// Addresses always store the whole hierarchy, so a region is in use as soon as its own column is referenced.
+func (r *regencyRepository) ExistsRegencyInUse(ctx context.Context, level string, id uuid.UUID) (bool, error) {
+	if _, err := regencyTable(level); err != nil {
+		return false, err
+	}
+	column := level + "_id"
+
+	query := fmt.Sprintf(`
+		SELECT EXISTS (
+			SELECT 1 FROM suppliers s
+			WHERE s.%[1]s = @id AND s.deleted_at IS NULL
+		) OR EXISTS (
+			SELECT 1 FROM customer_addresses ca
+			JOIN customers cu ON cu.id = ca.customer_id AND cu.deleted_at IS NULL
+			WHERE ca.%[1]s = @id AND ca.deleted_at IS NULL
+		)`, column)
+
+	// Expedition services only reference cities and districts
+	switch level {
+	case constants.RegencyLevelCity:
+		query += `
+		OR EXISTS (
+			SELECT 1 FROM expedition_coverages ec
+			JOIN expeditions e ON e.id = ec.expedition_id AND e.deleted_at IS NULL
+			WHERE ec.city_id = @id AND ec.deleted_at IS NULL
+		) OR EXISTS (
+			SELECT 1 FROM expedition_tariffs et
+			JOIN expeditions e ON e.id = et.expedition_id AND e.deleted_at IS NULL
+			WHERE (et.origin_city_id = @id OR et.destination_city_id = @id) AND et.deleted_at IS NULL
+		)`
+	case constants.RegencyLevelDistrict:
+		query += `
+		OR EXISTS (
+			SELECT 1 FROM expedition_coverages ec
+			JOIN expeditions e ON e.id = ec.expedition_id AND e.deleted_at IS NULL
+			WHERE ec.district_id = @id AND ec.deleted_at IS NULL
+		)`
+	}
+
+	var exists bool
+	err := r.DB.WithContext(ctx).Raw(query, sql.Named("id", id)).Scan(&exists).Error
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
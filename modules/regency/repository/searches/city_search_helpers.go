@@ -7,7 +7,7 @@ import "github.com/rendyfutsuy/base-go/helpers/request"
 type CitySearchHelper struct{ request.SearchPredefineBase }
 
 func (CitySearchHelper) GetSearchColumns() []string {
-	return []string{"c.name", "c.area_code"}
+	return []string{"c.name", "c.area_code", "c.code"}
 }
 func (CitySearchHelper) GetSearchExistsSubqueries() []string {
 	return []string{}
@@ -11,7 +11,7 @@ import "github.com/rendyfutsuy/base-go/helpers/request"
 type DistrictSearchHelper struct{ request.SearchPredefineBase }
 
 func (DistrictSearchHelper) GetSearchColumns() []string {
-	return []string{"d.name"}
+	return []string{"d.name", "d.code"}
 }
 func (DistrictSearchHelper) GetSearchExistsSubqueries() []string {
 	return []string{}
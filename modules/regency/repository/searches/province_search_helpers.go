@@ -12,7 +12,7 @@ import "github.com/rendyfutsuy/base-go/helpers/request"
 type ProvinceSearchHelper struct{ request.SearchPredefineBase }
 
 func (ProvinceSearchHelper) GetSearchColumns() []string {
-	return []string{"p.name"}
+	return []string{"p.name", "p.code"}
 }
 func (ProvinceSearchHelper) GetSearchExistsSubqueries() []string {
 	return []string{}
@@ -0,0 +1,23 @@
+package searches
+
+import "github.com/rendyfutsuy/base-go/helpers/request"
+
+// initialize, value for search and map the function & variable need for it
+// implement search across all regency levels (province, city, district, subdistrict) -- BEGIN
+type RegencySearchHelper struct{ request.SearchPredefineBase }
+
+func (RegencySearchHelper) GetSearchColumns() []string {
+	return []string{"r.name", "r.code"}
+}
+func (RegencySearchHelper) GetSearchExistsSubqueries() []string {
+	return []string{}
+}
+
+var _ request.NeedSearchPredefine = RegencySearchHelper{}
+
+func NewRegencySearchHelper() RegencySearchHelper {
+	t := 0.40
+	return RegencySearchHelper{SearchPredefineBase: request.SearchPredefineBase{Threshold: &t}}
+}
+
+// implement search across all regency levels -- END
@@ -7,7 +7,7 @@ import "github.com/rendyfutsuy/base-go/helpers/request"
 type SubdistrictSearchHelper struct{ request.SearchPredefineBase }
 
 func (SubdistrictSearchHelper) GetSearchColumns() []string {
-	return []string{"s.name"}
+	return []string{"s.name", "s.code"}
 }
 func (SubdistrictSearchHelper) GetSearchExistsSubqueries() []string {
 	return []string{}
@@ -3,6 +3,7 @@ package test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
 	"github.com/rendyfutsuy/base-go/helpers/request"
 	"github.com/rendyfutsuy/base-go/models"
 	regencyDto "github.com/rendyfutsuy/base-go/modules/regency/dto"
@@ -236,6 +238,137 @@ func (m *MockRegencyRepository) ExistsSubdistrictByName(ctx context.Context, dis
 	return args.Bool(0), args.Error(1)
 }
 
+// Tree & search methods
+func (m *MockRegencyRepository) GetRegencyNodes(ctx context.Context, level string, parentID *uuid.UUID) ([]regencyDto.RegencyNode, error) {
+	args := m.Called(ctx, level, parentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]regencyDto.RegencyNode), args.Error(1)
+}
+
+func (m *MockRegencyRepository) GetRegencyTreeVersion(ctx context.Context) (string, error) {
+	args := m.Called(ctx)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockRegencyRepository) SearchRegency(ctx context.Context, search string, level string, limit int) ([]regencyDto.RegencySearchRow, error) {
+	args := m.Called(ctx, search, level, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]regencyDto.RegencySearchRow), args.Error(1)
+}
+
+func (m *MockRegencyRepository) ExistsRegencyByCode(ctx context.Context, level string, code string, excludeID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, level, code, excludeID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRegencyRepository) SetRegencyCode(ctx context.Context, level string, id uuid.UUID, code *string) error {
+	args := m.Called(ctx, level, id, code)
+	return args.Error(0)
+}
+
+func (m *MockRegencyRepository) GetRegencyByCode(ctx context.Context, level string, code string) (*regencyDto.RegencySearchRow, error) {
+	args := m.Called(ctx, level, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*regencyDto.RegencySearchRow), args.Error(1)
+}
+
+func (m *MockRegencyRepository) GetRegencyCodeRecords(ctx context.Context) ([]regencyDto.RegencyCodeRecord, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]regencyDto.RegencyCodeRecord), args.Error(1)
+}
+
+func (m *MockRegencyRepository) ApplyRegencyImport(ctx context.Context, changes []regencyDto.RegencyImportChange) error {
+	args := m.Called(ctx, changes)
+	return args.Error(0)
+}
+
+func (m *MockRegencyRepository) ExistsRegencyInUse(ctx context.Context, level string, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, level, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRegencyRepository) SetRegencyGeo(ctx context.Context, level string, id uuid.UUID, geo regencyDto.RegencyGeo) error {
+	args := m.Called(ctx, level, id, geo)
+	return args.Error(0)
+}
+
+func (m *MockRegencyRepository) GetRegencyGeoInBox(ctx context.Context, level string, minLat, maxLat, minLong, maxLong float64) ([]regencyDto.RegencyGeoRow, error) {
+	args := m.Called(ctx, level, minLat, maxLat, minLong, maxLong)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]regencyDto.RegencyGeoRow), args.Error(1)
+}
+
+func (m *MockRegencyRepository) GetRegencyByPostalCode(ctx context.Context, postalCode string) ([]regencyDto.RegencyGeoRow, error) {
+	args := m.Called(ctx, postalCode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]regencyDto.RegencyGeoRow), args.Error(1)
+}
+
+func (m *MockRegencyRepository) ApplyRegencyGeoImport(ctx context.Context, updates []regencyDto.RegencyGeoUpdate) error {
+	args := m.Called(ctx, updates)
+	return args.Error(0)
+}
+
+func (m *MockRegencyRepository) ExistsRegencyByID(ctx context.Context, level string, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, level, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRegencyRepository) GetRegencyByID(ctx context.Context, level string, id uuid.UUID) (*regencyDto.RegencySearchRow, error) {
+	args := m.Called(ctx, level, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*regencyDto.RegencySearchRow), args.Error(1)
+}
+
+func (m *MockRegencyRepository) UpsertRegencyBoundary(ctx context.Context, boundary *models.RegencyBoundary) error {
+	args := m.Called(ctx, boundary)
+	return args.Error(0)
+}
+
+func (m *MockRegencyRepository) DeleteRegencyBoundary(ctx context.Context, level string, regionID uuid.UUID) error {
+	args := m.Called(ctx, level, regionID)
+	return args.Error(0)
+}
+
+func (m *MockRegencyRepository) GetRegencyBoundary(ctx context.Context, level string, regionID uuid.UUID) (*regencyDto.RegencyBoundaryRow, error) {
+	args := m.Called(ctx, level, regionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*regencyDto.RegencyBoundaryRow), args.Error(1)
+}
+
+func (m *MockRegencyRepository) GetRegencyBoundaries(ctx context.Context, level string, parentID *uuid.UUID) ([]regencyDto.RegencyBoundaryRow, error) {
+	args := m.Called(ctx, level, parentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]regencyDto.RegencyBoundaryRow), args.Error(1)
+}
+
+func (m *MockRegencyRepository) GetRegencyBoundariesAt(ctx context.Context, level string, latitude, longitude float64) ([]regencyDto.RegencyBoundaryRow, error) {
+	args := m.Called(ctx, level, latitude, longitude)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]regencyDto.RegencyBoundaryRow), args.Error(1)
+}
+
 // Province Tests
 func TestCreateProvince(t *testing.T) {
 	e := echo.New()
@@ -414,10 +547,20 @@ func TestDeleteProvince(t *testing.T) {
 			id:     validID.String(),
 			authId: "test-auth-id",
 			setupMock: func(m *MockRegencyRepository) {
+				m.On("ExistsRegencyInUse", ctx, constants.RegencyLevelProvince, validID).Return(false, nil).Once()
 				m.On("DeleteProvince", ctx, validID).Return(nil).Once()
 			},
 			expectedError: nil,
 		},
+		{
+			name:   "error when province is still used by addresses",
+			id:     validID.String(),
+			authId: "test-auth-id",
+			setupMock: func(m *MockRegencyRepository) {
+				m.On("ExistsRegencyInUse", ctx, constants.RegencyLevelProvince, validID).Return(true, nil).Once()
+			},
+			expectedError: fmt.Errorf(constants.RegencyStillUsed, constants.RegencyLevelProvince),
+		},
 		{
 			name:   "error when invalid UUID",
 			id:     "invalid-uuid",
@@ -878,10 +1021,20 @@ func TestDeleteCity(t *testing.T) {
 			id:     validID.String(),
 			authId: "test-auth-id",
 			setupMock: func(m *MockRegencyRepository) {
+				m.On("ExistsRegencyInUse", ctx, constants.RegencyLevelCity, validID).Return(false, nil).Once()
 				m.On("DeleteCity", ctx, validID).Return(nil).Once()
 			},
 			expectedError: nil,
 		},
+		{
+			name:   "error when city is still used by addresses",
+			id:     validID.String(),
+			authId: "test-auth-id",
+			setupMock: func(m *MockRegencyRepository) {
+				m.On("ExistsRegencyInUse", ctx, constants.RegencyLevelCity, validID).Return(true, nil).Once()
+			},
+			expectedError: fmt.Errorf(constants.RegencyStillUsed, constants.RegencyLevelCity),
+		},
 	}
 
 	for _, tt := range tests {
@@ -1291,10 +1444,20 @@ func TestDeleteDistrict(t *testing.T) {
 			id:     validID.String(),
 			authId: "test-auth-id",
 			setupMock: func(m *MockRegencyRepository) {
+				m.On("ExistsRegencyInUse", ctx, constants.RegencyLevelDistrict, validID).Return(false, nil).Once()
 				m.On("DeleteDistrict", ctx, validID).Return(nil).Once()
 			},
 			expectedError: nil,
 		},
+		{
+			name:   "error when district is still used by addresses",
+			id:     validID.String(),
+			authId: "test-auth-id",
+			setupMock: func(m *MockRegencyRepository) {
+				m.On("ExistsRegencyInUse", ctx, constants.RegencyLevelDistrict, validID).Return(true, nil).Once()
+			},
+			expectedError: fmt.Errorf(constants.RegencyStillUsed, constants.RegencyLevelDistrict),
+		},
 	}
 
 	for _, tt := range tests {
@@ -1704,10 +1867,20 @@ func TestDeleteSubdistrict(t *testing.T) {
 			id:     validID.String(),
 			authId: "test-auth-id",
 			setupMock: func(m *MockRegencyRepository) {
+				m.On("ExistsRegencyInUse", ctx, constants.RegencyLevelSubdistrict, validID).Return(false, nil).Once()
 				m.On("DeleteSubdistrict", ctx, validID).Return(nil).Once()
 			},
 			expectedError: nil,
 		},
+		{
+			name:   "error when subdistrict is still used by addresses",
+			id:     validID.String(),
+			authId: "test-auth-id",
+			setupMock: func(m *MockRegencyRepository) {
+				m.On("ExistsRegencyInUse", ctx, constants.RegencyLevelSubdistrict, validID).Return(true, nil).Once()
+			},
+			expectedError: fmt.Errorf(constants.RegencyStillUsed, constants.RegencyLevelSubdistrict),
+		},
 	}
 
 	for _, tt := range tests {
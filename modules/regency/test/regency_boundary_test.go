@@ -0,0 +1,200 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	regencyDto "github.com/rendyfutsuy/base-go/modules/regency/dto"
+	"github.com/rendyfutsuy/base-go/modules/regency/usecase"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// square around Bandung with a small hole in its middle
+const squareWithHole = `{"type":"Polygon","coordinates":[
+	[[107.5,-7.0],[107.7,-7.0],[107.7,-6.8],[107.5,-6.8],[107.5,-7.0]],
+	[[107.59,-6.91],[107.61,-6.91],[107.61,-6.89],[107.59,-6.89],[107.59,-6.91]]
+]}`
+
+const storedSquare = `{"type":"MultiPolygon","coordinates":[[[[107.5,-7],[107.7,-7],[107.7,-6.8],[107.5,-6.8],[107.5,-7]]]]}`
+
+func boundaryRequest(t *testing.T, raw string) *regencyDto.ReqRegencyBoundary {
+	t.Helper()
+	req := &regencyDto.ReqRegencyBoundary{}
+	assert.NoError(t, json.Unmarshal([]byte(raw), req))
+	return req
+}
+
+func TestSaveRegencyBoundary(t *testing.T) {
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+	ctx := context.Background()
+	cityID := uuid.New()
+
+	t.Run("normalizes a feature collection to a multi polygon with its bounding box", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		req := boundaryRequest(t, `{"type":"FeatureCollection","features":[
+			{"type":"Feature","properties":{},"geometry":`+squareWithHole+`},
+			{"type":"Feature","properties":{},"geometry":{"type":"Polygon","coordinates":[[[108.0,-7.2],[108.1,-7.2,12],[108.1,-7.1],[108.0,-7.2]]]}}
+		]}`)
+
+		mockRepo.On("ExistsRegencyByID", ctx, constants.RegencyLevelCity, cityID).Return(true, nil).Once()
+		mockRepo.On("UpsertRegencyBoundary", ctx, mock.MatchedBy(func(b *models.RegencyBoundary) bool {
+			geometry := map[string]interface{}{}
+			_ = json.Unmarshal([]byte(b.Geometry), &geometry)
+			return b.Level == constants.RegencyLevelCity && b.RegionID == cityID && b.CreatedBy == "user-1" &&
+				geometry["type"] == "MultiPolygon" && len(geometry["coordinates"].([]interface{})) == 2 &&
+				b.MinLatitude == -7.2 && b.MaxLatitude == -6.8 && b.MinLongitude == 107.5 && b.MaxLongitude == 108.1
+		})).Return(nil).Once()
+		mockRepo.On("GetRegencyBoundary", ctx, constants.RegencyLevelCity, cityID).Return(&regencyDto.RegencyBoundaryRow{
+			RegencySearchRow: regencyDto.RegencySearchRow{ID: cityID, Name: "KOTA BANDUNG", Level: constants.RegencyLevelCity},
+			Geometry:         storedSquare,
+		}, nil).Once()
+
+		res, err := uc.SaveRegencyBoundary(ctx, constants.RegencyLevelCity, cityID.String(), req, "user-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Feature", res.Type)
+		assert.Equal(t, "KOTA BANDUNG", res.Properties["name"])
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid geometries", func(t *testing.T) {
+		cases := map[string]string{
+			constants.RegencyBoundaryRingNotClosed: `{"type":"Polygon","coordinates":[[[107.5,-7.0],[107.7,-7.0],[107.7,-6.8],[107.5,-6.8]]]}`,
+			constants.RegencyBoundaryRingTooShort:  `{"type":"Polygon","coordinates":[[[107.5,-7.0],[107.7,-7.0],[107.5,-7.0]]]}`,
+			constants.RegencyBoundaryEmpty:         `{"type":"FeatureCollection","features":[]}`,
+		}
+		for message, raw := range cases {
+			mockRepo := new(MockRegencyRepository)
+			uc := usecase.NewRegencyUsecase(mockRepo)
+			mockRepo.On("ExistsRegencyByID", ctx, constants.RegencyLevelCity, cityID).Return(true, nil).Once()
+
+			_, err := uc.SaveRegencyBoundary(ctx, constants.RegencyLevelCity, cityID.String(), boundaryRequest(t, raw), "")
+
+			assert.EqualError(t, err, message)
+			mockRepo.AssertNotCalled(t, "UpsertRegencyBoundary", mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("rejects an unknown level", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		_, err := uc.SaveRegencyBoundary(ctx, "village", cityID.String(), boundaryRequest(t, squareWithHole), "")
+
+		assert.EqualError(t, err, constants.RegencyLevelInvalid)
+	})
+}
+
+func TestLocateRegency(t *testing.T) {
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+	ctx := context.Background()
+
+	provinceID := uuid.New()
+	cityID := uuid.New()
+	districtID := uuid.New()
+	subdistrictID := uuid.New()
+	otherSubdistrictID := uuid.New()
+
+	districtRow := regencyDto.RegencyBoundaryRow{
+		RegencySearchRow: regencyDto.RegencySearchRow{
+			ID: districtID, Name: "SUKASARI", Level: constants.RegencyLevelDistrict,
+			ProvinceID: &provinceID, ProvinceName: strPtr("JAWA BARAT"), CityID: &cityID, CityName: strPtr("KOTA BANDUNG"),
+		},
+		Geometry: storedSquare,
+	}
+
+	t.Run("picks the nearest subdistrict centroid inside the matched district", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		latitude, longitude := -6.85, 107.55
+		near := geoRow(subdistrictID, "SARIJADI", -6.86, 107.56)
+		near.DistrictID = &districtID
+		// nearer, but in another district
+		other := geoRow(otherSubdistrictID, "LAIN", -6.85, 107.55)
+		otherDistrictID := uuid.New()
+		other.DistrictID = &otherDistrictID
+
+		mockRepo.On("GetRegencyBoundariesAt", ctx, constants.RegencyLevelSubdistrict, latitude, longitude).Return([]regencyDto.RegencyBoundaryRow{}, nil).Once()
+		mockRepo.On("GetRegencyBoundariesAt", ctx, constants.RegencyLevelDistrict, latitude, longitude).Return([]regencyDto.RegencyBoundaryRow{districtRow}, nil).Once()
+		mockRepo.On("GetRegencyGeoInBox", ctx, constants.RegencyLevelSubdistrict, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]regencyDto.RegencyGeoRow{other, near}, nil).Once()
+
+		res, err := uc.LocateRegency(ctx, regencyDto.ReqRegencyLocate{Latitude: &latitude, Longitude: &longitude})
+
+		assert.NoError(t, err)
+		assert.Equal(t, subdistrictID, res.Region.ID)
+		assert.Equal(t, constants.RegencyLevelDistrict, res.BoundaryLevel)
+		assert.Equal(t, constants.RegencyLocateMatchedNearestCenter, res.MatchedBy)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("falls back to a higher level when the point is outside the polygon", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		// inside the bounding box of the city row but outside the district square
+		latitude, longitude := -6.75, 107.55
+		cityRow := regencyDto.RegencyBoundaryRow{
+			RegencySearchRow: regencyDto.RegencySearchRow{ID: cityID, Name: "KOTA BANDUNG", Level: constants.RegencyLevelCity, ProvinceID: &provinceID, ProvinceName: strPtr("JAWA BARAT")},
+			Geometry:         `{"type":"MultiPolygon","coordinates":[[[[107.4,-7.1],[107.8,-7.1],[107.8,-6.7],[107.4,-6.7],[107.4,-7.1]]]]}`,
+		}
+
+		mockRepo.On("GetRegencyBoundariesAt", ctx, constants.RegencyLevelSubdistrict, latitude, longitude).Return([]regencyDto.RegencyBoundaryRow{}, nil).Once()
+		mockRepo.On("GetRegencyBoundariesAt", ctx, constants.RegencyLevelDistrict, latitude, longitude).Return([]regencyDto.RegencyBoundaryRow{districtRow}, nil).Once()
+		mockRepo.On("GetRegencyBoundariesAt", ctx, constants.RegencyLevelCity, latitude, longitude).Return([]regencyDto.RegencyBoundaryRow{cityRow}, nil).Once()
+
+		res, err := uc.LocateRegency(ctx, regencyDto.ReqRegencyLocate{Latitude: &latitude, Longitude: &longitude})
+
+		assert.NoError(t, err)
+		assert.Equal(t, cityID, res.Region.ID)
+		assert.Equal(t, "KOTA BANDUNG, JAWA BARAT", res.Region.Path)
+		assert.Equal(t, constants.RegencyLocateMatchedBoundary, res.MatchedBy)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("a point inside a hole is outside the polygon", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		latitude, longitude := -6.9, 107.6
+		holed := districtRow
+		holed.Geometry = `{"type":"MultiPolygon","coordinates":[[
+			[[107.5,-7.0],[107.7,-7.0],[107.7,-6.8],[107.5,-6.8],[107.5,-7.0]],
+			[[107.59,-6.91],[107.61,-6.91],[107.61,-6.89],[107.59,-6.89],[107.59,-6.91]]
+		]]}`
+
+		mockRepo.On("GetRegencyBoundariesAt", ctx, constants.RegencyLevelDistrict, latitude, longitude).Return([]regencyDto.RegencyBoundaryRow{holed}, nil).Once()
+		mockRepo.On("GetRegencyBoundariesAt", ctx, mock.Anything, latitude, longitude).Return([]regencyDto.RegencyBoundaryRow{}, nil).Times(3)
+
+		_, err := uc.LocateRegency(ctx, regencyDto.ReqRegencyLocate{Latitude: &latitude, Longitude: &longitude})
+
+		assert.EqualError(t, err, constants.RegencyLocateNotFound)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("outside every boundary", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		latitude, longitude := 0.0, 0.0
+		mockRepo.On("GetRegencyBoundariesAt", ctx, mock.Anything, latitude, longitude).Return([]regencyDto.RegencyBoundaryRow{}, nil).Times(4)
+
+		_, err := uc.LocateRegency(ctx, regencyDto.ReqRegencyLocate{Latitude: &latitude, Longitude: &longitude})
+
+		assert.EqualError(t, err, constants.RegencyLocateNotFound)
+		mockRepo.AssertExpectations(t)
+	})
+}
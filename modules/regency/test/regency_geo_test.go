@@ -0,0 +1,211 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	regencyDto "github.com/rendyfutsuy/base-go/modules/regency/dto"
+	"github.com/rendyfutsuy/base-go/modules/regency/usecase"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
+func geoRow(id uuid.UUID, name string, latitude, longitude float64) regencyDto.RegencyGeoRow {
+	return regencyDto.RegencyGeoRow{
+		RegencySearchRow: regencyDto.RegencySearchRow{ID: id, Name: name, Level: constants.RegencyLevelSubdistrict},
+		Latitude:         floatPtr(latitude),
+		Longitude:        floatPtr(longitude),
+	}
+}
+
+func TestGetNearestRegency(t *testing.T) {
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+	ctx := context.Background()
+
+	// Bandung city hall
+	latitude, longitude := -6.9110, 107.6097
+	nearID := uuid.New()
+	farID := uuid.New()
+	outsideID := uuid.New()
+
+	t.Run("ranks candidates by distance and drops the box corners", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		mockRepo.On("GetRegencyGeoInBox", ctx, constants.RegencyLevelSubdistrict, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]regencyDto.RegencyGeoRow{
+			geoRow(farID, "SARIJADI", -6.8748, 107.5790),
+			geoRow(outsideID, "CORNER", -6.9110+0.2, 107.6097+0.2),
+			geoRow(nearID, "BABAKAN CIAMIS", -6.9090, 107.6100),
+		}, nil).Once()
+
+		res, err := uc.GetNearestRegency(ctx, regencyDto.ReqRegencyNearest{Latitude: &latitude, Longitude: &longitude, Limit: 5})
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+		assert.Equal(t, nearID, res[0].ID)
+		assert.Equal(t, farID, res[1].ID)
+		assert.Less(t, *res[0].DistanceMeters, *res[1].DistanceMeters)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("nothing within the radius", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		mockRepo.On("GetRegencyGeoInBox", ctx, constants.RegencyLevelDistrict, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]regencyDto.RegencyGeoRow{}, nil).Once()
+
+		res, err := uc.GetNearestRegency(ctx, regencyDto.ReqRegencyNearest{Latitude: &latitude, Longitude: &longitude, Level: constants.RegencyLevelDistrict})
+
+		assert.Nil(t, res)
+		assert.EqualError(t, err, fmt.Sprintf(constants.RegencyNearestNotFound, constants.RegencyLevelDistrict, constants.RegencyNearestRadiusDefault))
+	})
+
+	t.Run("unsupported level", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		_, err := uc.GetNearestRegency(ctx, regencyDto.ReqRegencyNearest{Latitude: &latitude, Longitude: &longitude, Level: constants.RegencyLevelCity})
+
+		assert.EqualError(t, err, constants.RegencyNearestLevelInvalid)
+		mockRepo.AssertNotCalled(t, "GetRegencyGeoInBox")
+	})
+}
+
+func TestGetRegencyByPostalCode(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRegencyRepository)
+	uc := usecase.NewRegencyUsecase(mockRepo)
+
+	subdistrictID := uuid.New()
+	mockRepo.On("GetRegencyByPostalCode", ctx, "40151").Return([]regencyDto.RegencyGeoRow{
+		geoRow(subdistrictID, "SARIJADI", -6.8748, 107.5790),
+	}, nil).Once()
+	mockRepo.On("GetRegencyByPostalCode", ctx, "99999").Return([]regencyDto.RegencyGeoRow{}, nil).Once()
+
+	res, err := uc.GetRegencyByPostalCode(ctx, "40151")
+	assert.NoError(t, err)
+	assert.Equal(t, subdistrictID, res[0].ID)
+
+	_, err = uc.GetRegencyByPostalCode(ctx, "99999")
+	assert.EqualError(t, err, fmt.Sprintf(constants.RegencyPostalCodeNotFound, "99999"))
+
+	_, err = uc.GetRegencyByPostalCode(ctx, "4015")
+	assert.EqualError(t, err, fmt.Sprintf(constants.RegencyPostalCodeInvalid, "4015"))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestImportRegencyGeo(t *testing.T) {
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+	ctx := context.Background()
+
+	districtID := uuid.New()
+	subdistrictID := uuid.New()
+	records := []regencyDto.RegencyCodeRecord{
+		{ID: districtID, Level: constants.RegencyLevelDistrict, Code: strPtr("32.73.01"), Name: "SUKASARI"},
+		{ID: subdistrictID, Level: constants.RegencyLevelSubdistrict, Code: strPtr("32.73.01.1001"), Name: "SARIJADI", ParentID: &districtID},
+	}
+
+	t.Run("updates postal codes and coordinates", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		mockRepo.On("GetRegencyCodeRecords", ctx).Return(records, nil).Once()
+		mockRepo.On("ApplyRegencyGeoImport", ctx, []regencyDto.RegencyGeoUpdate{
+			{ID: districtID, Level: constants.RegencyLevelDistrict, Geo: regencyDto.RegencyGeo{Latitude: floatPtr(-6.8815), Longitude: floatPtr(107.5837)}},
+			{ID: subdistrictID, Level: constants.RegencyLevelSubdistrict, Geo: regencyDto.RegencyGeo{PostalCodes: []string{"40151", "40152"}}},
+		}).Return(nil).Once()
+
+		dataset := "code,postal_codes,latitude,longitude\n" +
+			"32.73.01,,-6.8815,107.5837\n" +
+			"32.73.01.1001,40152;40151 40152,,\n"
+		res, err := uc.ImportRegencyGeo(ctx, writeRegencyDataset(t, dataset), false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, res.TotalRows)
+		assert.Equal(t, 2, res.Updated)
+		assert.Empty(t, res.Errors)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid rows are reported and nothing is imported", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		mockRepo.On("GetRegencyCodeRecords", ctx).Return(records, nil).Once()
+
+		dataset := "code,postal_codes,latitude,longitude\n" +
+			"32.73,40151,,\n" +
+			"32.73.01,40151,,\n" +
+			"32.73.01.1001,40151,-6.87,\n" +
+			"32.73.01.1002,40152,,\n" +
+			"32.73.01.1001,,,\n" +
+			"32.73.01.1003,40153;4015,,\n"
+		res, err := uc.ImportRegencyGeo(ctx, writeRegencyDataset(t, dataset), false)
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Errors, 6)
+		assert.Equal(t, fmt.Sprintf(constants.RegencyGeoImportRowLevelInvalid, "32.73"), res.Errors[0].ErrorMessage)
+		assert.Equal(t, constants.RegencyGeoImportRowPostalCodeLevel, res.Errors[1].ErrorMessage)
+		assert.Equal(t, constants.RegencyGeoImportRowCoordinateInvalid, res.Errors[2].ErrorMessage)
+		assert.Equal(t, fmt.Sprintf(constants.RegencyGeoImportRowCodeNotFound, "32.73.01.1002"), res.Errors[3].ErrorMessage)
+		assert.Equal(t, fmt.Sprintf(constants.RegencyImportRowCodeDuplicated, "32.73.01.1001", 4), res.Errors[4].ErrorMessage)
+		assert.Equal(t, fmt.Sprintf(constants.RegencyPostalCodeInvalid, "4015"), res.Errors[5].ErrorMessage)
+		mockRepo.AssertNotCalled(t, "ApplyRegencyGeoImport", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCreateSubdistrictWithGeo(t *testing.T) {
+	ctx := context.Background()
+	districtID := uuid.New()
+	subdistrictID := uuid.New()
+
+	t.Run("stores postal code and coordinates", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		geo := regencyDto.RegencyGeo{PostalCodes: []string{"40151", "40152"}, Latitude: floatPtr(-6.8748), Longitude: floatPtr(107.5790)}
+		mockRepo.On("GetDistrictByID", ctx, districtID).Return(&models.District{ID: districtID}, nil).Once()
+		mockRepo.On("ExistsSubdistrictByName", ctx, districtID, "Sarijadi", uuid.Nil).Return(false, nil).Once()
+		mockRepo.On("CreateSubdistrict", ctx, districtID, "Sarijadi").Return(&models.Subdistrict{ID: subdistrictID, DistrictID: districtID, Name: "Sarijadi"}, nil).Once()
+		mockRepo.On("SetRegencyGeo", ctx, constants.RegencyLevelSubdistrict, subdistrictID, geo).Return(nil).Once()
+
+		res, err := uc.CreateSubdistrict(ctx, &regencyDto.ReqCreateSubdistrict{
+			DistrictID: districtID, Name: "Sarijadi", PostalCodes: []string{"40152", " 40151", "40152"}, Latitude: floatPtr(-6.8748), Longitude: floatPtr(107.5790),
+		}, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"40151", "40152"}, res.PostalCodes.Strings)
+		assert.Equal(t, -6.8748, *res.Latitude)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects a latitude without longitude", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		mockRepo.On("GetDistrictByID", ctx, districtID).Return(&models.District{ID: districtID}, nil).Once()
+		mockRepo.On("ExistsSubdistrictByName", ctx, districtID, "Sarijadi", uuid.Nil).Return(false, nil).Once()
+
+		res, err := uc.CreateSubdistrict(ctx, &regencyDto.ReqCreateSubdistrict{
+			DistrictID: districtID, Name: "Sarijadi", Latitude: floatPtr(-6.8748),
+		}, "")
+
+		assert.Nil(t, res)
+		assert.EqualError(t, err, constants.RegencyCoordinatePairRequired)
+		mockRepo.AssertNotCalled(t, "CreateSubdistrict", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,252 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	regencyDto "github.com/rendyfutsuy/base-go/modules/regency/dto"
+	"github.com/rendyfutsuy/base-go/modules/regency/usecase"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func writeRegencyDataset(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "regencies.csv")
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestImportRegencies(t *testing.T) {
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+	ctx := context.Background()
+
+	provinceID := uuid.New()
+	cityID := uuid.New()
+	districtID := uuid.New()
+	obsoleteID := uuid.New()
+
+	records := []regencyDto.RegencyCodeRecord{
+		{ID: provinceID, Level: constants.RegencyLevelProvince, Code: strPtr("32"), Name: "JAWA BARAT"},
+		{ID: cityID, Level: constants.RegencyLevelCity, Code: strPtr("32.73"), Name: "KOTA BDG", ParentID: &provinceID},
+		{ID: districtID, Level: constants.RegencyLevelDistrict, Name: "SUKASARI", ParentID: &cityID},
+		{ID: obsoleteID, Level: constants.RegencyLevelCity, Code: strPtr("32.99"), Name: "KOTA LAMA", ParentID: &provinceID},
+	}
+	dataset := "code,name\n" +
+		"32.73.01.1001,SARIJADI\n" +
+		"32,JAWA BARAT\n" +
+		"32.73,KOTA BANDUNG\n" +
+		"32.73.01,SUKASARI\n"
+
+	t.Run("applies added, renamed, linked and removed regions", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		mockRepo.On("GetRegencyCodeRecords", ctx).Return(records, nil).Once()
+		mockRepo.On("ExistsRegencyInUse", ctx, constants.RegencyLevelCity, obsoleteID).Return(false, nil).Once()
+		mockRepo.On("ApplyRegencyImport", ctx, mock.MatchedBy(func(changes []regencyDto.RegencyImportChange) bool {
+			return len(changes) == 4 &&
+				changes[0].Action == constants.RegencyImportActionRenamed && changes[0].ID == cityID &&
+				changes[1].Action == constants.RegencyImportActionLinked && changes[1].ID == districtID &&
+				changes[2].Action == constants.RegencyImportActionAdded && changes[2].ParentID != nil && *changes[2].ParentID == districtID &&
+				changes[3].Action == constants.RegencyImportActionRemoved && changes[3].ID == obsoleteID
+		})).Return(nil).Once()
+
+		res, err := uc.ImportRegencies(ctx, writeRegencyDataset(t, dataset), false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, res.TotalRows)
+		assert.Equal(t, 1, res.Added)
+		assert.Equal(t, 1, res.Renamed)
+		assert.Equal(t, 1, res.Linked)
+		assert.Equal(t, 1, res.Removed)
+		assert.Equal(t, 1, res.Unchanged)
+		assert.Empty(t, res.Errors)
+		assert.Equal(t, "KOTA BDG", *res.Changes[0].OldName)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("dry run only reports the changes", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		mockRepo.On("GetRegencyCodeRecords", ctx).Return(records, nil).Once()
+		mockRepo.On("ExistsRegencyInUse", ctx, constants.RegencyLevelCity, obsoleteID).Return(false, nil).Once()
+
+		res, err := uc.ImportRegencies(ctx, writeRegencyDataset(t, dataset), true)
+
+		assert.NoError(t, err)
+		assert.True(t, res.DryRun)
+		assert.Len(t, res.Changes, 4)
+		mockRepo.AssertNotCalled(t, "ApplyRegencyImport", mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("removed regions still in use are reported and nothing is imported", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		mockRepo.On("GetRegencyCodeRecords", ctx).Return(records, nil).Once()
+		mockRepo.On("ExistsRegencyInUse", ctx, constants.RegencyLevelCity, obsoleteID).Return(true, nil).Once()
+
+		res, err := uc.ImportRegencies(ctx, writeRegencyDataset(t, dataset), false)
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Errors, 1)
+		assert.Equal(t, "32.99", res.Errors[0].Code)
+		assert.Equal(t, fmt.Sprintf(constants.RegencyStillUsed, constants.RegencyLevelCity), res.Errors[0].ErrorMessage)
+		mockRepo.AssertNotCalled(t, "ApplyRegencyImport", mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("regions without code under a removed region are removed with it", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		oldDistrictID := uuid.New()
+		oldSubdistrictID := uuid.New()
+		withChildren := append([]regencyDto.RegencyCodeRecord{}, records...)
+		withChildren = append(withChildren,
+			regencyDto.RegencyCodeRecord{ID: oldDistrictID, Level: constants.RegencyLevelDistrict, Name: "LAMA", ParentID: &obsoleteID},
+			regencyDto.RegencyCodeRecord{ID: oldSubdistrictID, Level: constants.RegencyLevelSubdistrict, Name: "LAMA WETAN", ParentID: &oldDistrictID},
+		)
+
+		mockRepo.On("GetRegencyCodeRecords", ctx).Return(withChildren, nil).Once()
+		mockRepo.On("ExistsRegencyInUse", ctx, constants.RegencyLevelSubdistrict, oldSubdistrictID).Return(true, nil).Once()
+		mockRepo.On("ExistsRegencyInUse", ctx, constants.RegencyLevelDistrict, oldDistrictID).Return(false, nil).Once()
+		mockRepo.On("ExistsRegencyInUse", ctx, constants.RegencyLevelCity, obsoleteID).Return(false, nil).Once()
+
+		res, err := uc.ImportRegencies(ctx, writeRegencyDataset(t, dataset), false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, res.Removed)
+		// children first
+		removed := []string{}
+		for _, change := range res.Changes {
+			if change.Action == constants.RegencyImportActionRemoved {
+				removed = append(removed, change.Name)
+			}
+		}
+		assert.Equal(t, []string{"LAMA WETAN", "LAMA", "KOTA LAMA"}, removed)
+		assert.Len(t, res.Errors, 1)
+		assert.Equal(t, fmt.Sprintf(constants.RegencyStillUsed, constants.RegencyLevelSubdistrict+" LAMA WETAN"), res.Errors[0].ErrorMessage)
+		mockRepo.AssertNotCalled(t, "ApplyRegencyImport", mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid rows are reported and nothing is imported", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		invalid := "code,name\n" +
+			"32,JAWA BARAT\n" +
+			"32.7,KOTA BANDUNG\n" +
+			"32,JABAR\n" +
+			"33.01,KAB. CILACAP\n" +
+			"32.73,\n"
+
+		res, err := uc.ImportRegencies(ctx, writeRegencyDataset(t, invalid), false)
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Errors, 4)
+		assert.Equal(t, 3, res.Errors[0].Row)
+		assert.Equal(t, fmt.Sprintf(constants.RegencyImportRowCodeInvalid, "32.7"), res.Errors[0].ErrorMessage)
+		assert.Equal(t, fmt.Sprintf(constants.RegencyImportRowCodeDuplicated, "32", 2), res.Errors[1].ErrorMessage)
+		assert.Equal(t, constants.RegencyImportRowNameRequired, res.Errors[2].ErrorMessage)
+		assert.Equal(t, fmt.Sprintf(constants.RegencyImportRowParentMissing, "33"), res.Errors[3].ErrorMessage)
+		mockRepo.AssertNotCalled(t, "GetRegencyCodeRecords", mock.Anything)
+		mockRepo.AssertNotCalled(t, "ApplyRegencyImport", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unsupported file format", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		res, err := uc.ImportRegencies(ctx, filepath.Join(t.TempDir(), "regencies.txt"), false)
+
+		assert.Nil(t, res)
+		assert.EqualError(t, err, constants.RegencyImportInvalidFileFormat)
+	})
+}
+
+func TestCreateProvinceWithCode(t *testing.T) {
+	ctx := context.Background()
+	provinceID := uuid.New()
+
+	t.Run("stores a valid code", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		mockRepo.On("ExistsProvinceByName", ctx, "Jawa Barat", uuid.Nil).Return(false, nil).Once()
+		mockRepo.On("ExistsRegencyByCode", ctx, constants.RegencyLevelProvince, "32", uuid.Nil).Return(false, nil).Once()
+		mockRepo.On("CreateProvince", ctx, "Jawa Barat").Return(&models.Province{ID: provinceID, Name: "Jawa Barat"}, nil).Once()
+		mockRepo.On("SetRegencyCode", ctx, constants.RegencyLevelProvince, provinceID, strPtr("32")).Return(nil).Once()
+
+		res, err := uc.CreateProvince(ctx, &regencyDto.ReqCreateProvince{Name: "Jawa Barat", Code: strPtr(" 32 ")}, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "32", *res.Code)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects a code of another level", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		mockRepo.On("ExistsProvinceByName", ctx, "Jawa Barat", uuid.Nil).Return(false, nil).Once()
+
+		res, err := uc.CreateProvince(ctx, &regencyDto.ReqCreateProvince{Name: "Jawa Barat", Code: strPtr("32.73")}, "")
+
+		assert.Nil(t, res)
+		assert.EqualError(t, err, fmt.Sprintf(constants.RegencyCodeInvalidFormat, "32.73", constants.RegencyLevelProvince))
+		mockRepo.AssertNotCalled(t, "CreateProvince", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects a code already in use", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		mockRepo.On("ExistsProvinceByName", ctx, "Jawa Barat", uuid.Nil).Return(false, nil).Once()
+		mockRepo.On("ExistsRegencyByCode", ctx, constants.RegencyLevelProvince, "32", uuid.Nil).Return(true, nil).Once()
+
+		res, err := uc.CreateProvince(ctx, &regencyDto.ReqCreateProvince{Name: "Jawa Barat", Code: strPtr("32")}, "")
+
+		assert.Nil(t, res)
+		assert.EqualError(t, err, fmt.Sprintf(constants.RegencyCodeAlreadyExists, constants.RegencyLevelProvince, "32"))
+	})
+}
+
+func TestGetRegencyByCode(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRegencyRepository)
+	uc := usecase.NewRegencyUsecase(mockRepo)
+
+	subdistrictID := uuid.New()
+	mockRepo.On("GetRegencyByCode", ctx, constants.RegencyLevelSubdistrict, "32.73.01.1001").Return(&regencyDto.RegencySearchRow{
+		ID: subdistrictID, Level: constants.RegencyLevelSubdistrict, Name: "SARIJADI", Code: strPtr("32.73.01.1001"),
+	}, nil).Once()
+
+	res, err := uc.GetRegencyByCode(ctx, "32.73.01.1001")
+
+	assert.NoError(t, err)
+	assert.Equal(t, subdistrictID, res.ID)
+	assert.Equal(t, constants.RegencyLevelSubdistrict, res.Level)
+
+	_, err = uc.GetRegencyByCode(ctx, "32-73")
+	assert.EqualError(t, err, fmt.Sprintf(constants.RegencyImportRowCodeInvalid, "32-73"))
+	mockRepo.AssertExpectations(t)
+}
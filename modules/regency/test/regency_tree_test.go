@@ -0,0 +1,158 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/i18n"
+	regencyDto "github.com/rendyfutsuy/base-go/modules/regency/dto"
+	"github.com/rendyfutsuy/base-go/modules/regency/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRegencyTree(t *testing.T) {
+	mockRepo := new(MockRegencyRepository)
+	uc := usecase.NewRegencyUsecase(mockRepo)
+	ctx := context.Background()
+
+	provinceID := uuid.New()
+	cityID := uuid.New()
+	districtID := uuid.New()
+	subdistrictID := uuid.New()
+	emptyCityID := uuid.New()
+
+	mockRepo.On("GetRegencyTreeVersion", ctx).Return("v1", nil).Twice()
+	mockRepo.On("GetRegencyNodes", ctx, constants.RegencyLevelSubdistrict, (*uuid.UUID)(nil)).Return([]regencyDto.RegencyNode{
+		{ID: subdistrictID, ParentID: &districtID, Name: "Menteng"},
+	}, nil).Once()
+	mockRepo.On("GetRegencyNodes", ctx, constants.RegencyLevelDistrict, (*uuid.UUID)(nil)).Return([]regencyDto.RegencyNode{
+		{ID: districtID, ParentID: &cityID, Name: "Menteng", HasChildren: true},
+	}, nil).Once()
+	mockRepo.On("GetRegencyNodes", ctx, constants.RegencyLevelCity, (*uuid.UUID)(nil)).Return([]regencyDto.RegencyNode{
+		{ID: cityID, ParentID: &provinceID, Name: "Jakarta Pusat", HasChildren: true},
+		{ID: emptyCityID, ParentID: &provinceID, Name: "Kepulauan Seribu"},
+	}, nil).Once()
+	mockRepo.On("GetRegencyNodes", ctx, constants.RegencyLevelProvince, (*uuid.UUID)(nil)).Return([]regencyDto.RegencyNode{
+		{ID: provinceID, Name: "DKI Jakarta", HasChildren: true},
+	}, nil).Once()
+
+	tree, etag, err := uc.GetRegencyTree(ctx)
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, etag)
+	assert.Len(t, tree, 1)
+	assert.Len(t, tree[0].Children, 2)
+	assert.Equal(t, constants.RegencyLevelCity, tree[0].Children[0].Level)
+	assert.Equal(t, subdistrictID, tree[0].Children[0].Children[0].Children[0].ID)
+	assert.False(t, tree[0].Children[1].HasChildren)
+
+	// unchanged version is served from the cache without reloading the levels
+	cached, cachedETag, err := uc.GetRegencyTree(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, etag, cachedETag)
+	assert.Equal(t, tree, cached)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetRegencyTree_ByLocale(t *testing.T) {
+	mockRepo := new(MockRegencyRepository)
+	uc := usecase.NewRegencyUsecase(mockRepo)
+	ctx := context.Background()
+	ctxEN := i18n.WithLocale(ctx, constants.TranslationLocaleEnglish)
+
+	provinceID := uuid.New()
+	for _, c := range []struct {
+		ctx  context.Context
+		name string
+	}{{ctx, "Jawa Barat"}, {ctxEN, "West Java"}} {
+		mockRepo.On("GetRegencyTreeVersion", c.ctx).Return("v1", nil).Twice()
+		mockRepo.On("GetRegencyNodes", c.ctx, constants.RegencyLevelProvince, (*uuid.UUID)(nil)).Return([]regencyDto.RegencyNode{
+			{ID: provinceID, Name: c.name},
+		}, nil).Once()
+		for _, level := range []string{constants.RegencyLevelCity, constants.RegencyLevelDistrict, constants.RegencyLevelSubdistrict} {
+			mockRepo.On("GetRegencyNodes", c.ctx, level, (*uuid.UUID)(nil)).Return([]regencyDto.RegencyNode{}, nil).Once()
+		}
+	}
+
+	tree, etag, err := uc.GetRegencyTree(ctx)
+	assert.NoError(t, err)
+	treeEN, etagEN, err := uc.GetRegencyTree(ctxEN)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Jawa Barat", tree[0].Name)
+	assert.Equal(t, "West Java", treeEN[0].Name)
+	assert.NotEqual(t, etag, etagEN)
+
+	// each locale is served from its own cache entry
+	cached, _, err := uc.GetRegencyTree(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "Jawa Barat", cached[0].Name)
+	cachedEN, _, err := uc.GetRegencyTree(ctxEN)
+	assert.NoError(t, err)
+	assert.Equal(t, "West Java", cachedEN[0].Name)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetRegencyTreeChildren(t *testing.T) {
+	ctx := context.Background()
+	parentID := uuid.New()
+
+	t.Run("Positive case - children of a city", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		mockRepo.On("GetRegencyNodes", ctx, constants.RegencyLevelDistrict, &parentID).Return([]regencyDto.RegencyNode{
+			{ID: uuid.New(), ParentID: &parentID, Name: "Gambir", HasChildren: true},
+		}, nil).Once()
+
+		res, err := uc.GetRegencyTreeChildren(ctx, regencyDto.ReqRegencyTreeChildren{Level: constants.RegencyLevelDistrict, ParentID: parentID.String()})
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.True(t, res[0].HasChildren)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Negative case - parent required", func(t *testing.T) {
+		mockRepo := new(MockRegencyRepository)
+		uc := usecase.NewRegencyUsecase(mockRepo)
+
+		res, err := uc.GetRegencyTreeChildren(ctx, regencyDto.ReqRegencyTreeChildren{Level: constants.RegencyLevelCity})
+
+		assert.EqualError(t, err, fmt.Sprintf(constants.RegencyTreeParentRequired, constants.RegencyLevelCity))
+		assert.Nil(t, res)
+	})
+}
+
+func TestSearchRegency(t *testing.T) {
+	mockRepo := new(MockRegencyRepository)
+	uc := usecase.NewRegencyUsecase(mockRepo)
+	ctx := context.Background()
+
+	provinceID, cityID, districtID := uuid.New(), uuid.New(), uuid.New()
+	provinceName, cityName, districtName := "Prov. Z", "Kota Y", "Kec. X"
+
+	mockRepo.On("SearchRegency", ctx, "menteng", "", constants.RegencySearchLimitDefault).Return([]regencyDto.RegencySearchRow{
+		{
+			ID: uuid.New(), Name: "Kel. W", Level: constants.RegencyLevelSubdistrict,
+			ProvinceID: &provinceID, ProvinceName: &provinceName,
+			CityID: &cityID, CityName: &cityName,
+			DistrictID: &districtID, DistrictName: &districtName,
+		},
+		{ID: provinceID, Name: provinceName, Level: constants.RegencyLevelProvince},
+	}, nil).Once()
+
+	res, err := uc.SearchRegency(ctx, regencyDto.ReqRegencySearch{Search: "menteng"})
+
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, "Kel. W, Kec. X, Kota Y, Prov. Z", res[0].Path)
+	assert.Equal(t, constants.RegencyLevelProvince, res[0].Ancestors[0].Level)
+	assert.Len(t, res[1].Ancestors, 0)
+	assert.Equal(t, provinceName, res[1].Path)
+	mockRepo.AssertExpectations(t)
+}
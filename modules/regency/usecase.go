@@ -45,4 +45,26 @@ type Usecase interface {
 	GetSubdistrictIndex(ctx context.Context, req request.PageRequest, filter dto.ReqSubdistrictIndexFilter) ([]models.Subdistrict, int, error)
 	GetAllSubdistrict(ctx context.Context, filter dto.ReqSubdistrictIndexFilter) ([]models.Subdistrict, error)
 	ExportSubdistrict(ctx context.Context, filter dto.ReqSubdistrictIndexFilter) ([]byte, error)
+
+	// Tree & search
+	GetRegencyTree(ctx context.Context) ([]dto.RespRegencyTreeNode, string, error)
+	GetRegencyTreeETag(ctx context.Context) (string, error)
+	GetRegencyTreeChildren(ctx context.Context, req dto.ReqRegencyTreeChildren) ([]dto.RespRegencyTreeNode, error)
+	SearchRegency(ctx context.Context, req dto.ReqRegencySearch) ([]dto.RespRegencySearchResult, error)
+
+	// Official code & import
+	GetRegencyByCode(ctx context.Context, code string) (*dto.RespRegencySearchResult, error)
+	ImportRegencies(ctx context.Context, filePath string, dryRun bool) (*dto.RespRegencyImport, error)
+
+	// Postal code & coordinates
+	GetNearestRegency(ctx context.Context, req dto.ReqRegencyNearest) ([]dto.RespRegencyGeo, error)
+	GetRegencyByPostalCode(ctx context.Context, postalCode string) ([]dto.RespRegencyGeo, error)
+	ImportRegencyGeo(ctx context.Context, filePath string, dryRun bool) (*dto.RespRegencyGeoImport, error)
+
+	// Boundaries
+	SaveRegencyBoundary(ctx context.Context, level string, id string, req *dto.ReqRegencyBoundary, userID string) (*dto.GeoJSONFeature, error)
+	DeleteRegencyBoundary(ctx context.Context, level string, id string) error
+	GetRegencyBoundary(ctx context.Context, level string, id string) (*dto.GeoJSONFeature, error)
+	GetRegencyBoundaries(ctx context.Context, req dto.ReqRegencyBoundaryIndex) (*dto.GeoJSONFeatureCollection, error)
+	LocateRegency(ctx context.Context, req dto.ReqRegencyLocate) (*dto.RespRegencyLocate, error)
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"sync"
 
 	"github.com/google/uuid"
 	"github.com/rendyfutsuy/base-go/constants"
@@ -19,12 +20,33 @@ import (
 
 type regencyUsecase struct {
 	repo mod.Repository
+
+	// full tree cache by locale, valid as long as the tree version does not change
+	treeMu    sync.RWMutex
+	treeCache map[string]regencyTreeCache
+}
+
+type regencyTreeCache struct {
+	etag string
+	tree []dto.RespRegencyTreeNode
 }
 
 func NewRegencyUsecase(repo mod.Repository) mod.Usecase {
 	return &regencyUsecase{repo: repo}
 }
 
+// ensureRegencyNotInUse rejects removing a region that is still referenced by an active supplier or customer address
+func (u *regencyUsecase) ensureRegencyNotInUse(ctx context.Context, level string, id uuid.UUID) error {
+	inUse, err := u.repo.ExistsRegencyInUse(ctx, level, id)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return fmt.Errorf(constants.RegencyStillUsed, level)
+	}
+	return nil
+}
+
 // Province Usecase
 func (u *regencyUsecase) CreateProvince(ctx context.Context, reqBody *dto.ReqCreateProvince, userID string) (*models.Province, error) {
 	exists, err := u.repo.ExistsProvinceByName(ctx, reqBody.Name, uuid.Nil)
@@ -35,7 +57,22 @@ func (u *regencyUsecase) CreateProvince(ctx context.Context, reqBody *dto.ReqCre
 		return nil, errors.New(constants.ProvinceNameAlreadyExists)
 	}
 
-	return u.repo.CreateProvince(ctx, reqBody.Name)
+	code, setCode, err := u.prepareRegencyCode(ctx, constants.RegencyLevelProvince, reqBody.Code, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.repo.CreateProvince(ctx, reqBody.Name)
+	if err != nil {
+		return nil, err
+	}
+	if setCode {
+		if err := u.repo.SetRegencyCode(ctx, constants.RegencyLevelProvince, res.ID, code); err != nil {
+			return nil, err
+		}
+		res.Code = code
+	}
+	return res, nil
 }
 
 func (u *regencyUsecase) UpdateProvince(ctx context.Context, id string, reqBody *dto.ReqUpdateProvince, userID string) (*models.Province, error) {
@@ -52,6 +89,11 @@ func (u *regencyUsecase) UpdateProvince(ctx context.Context, id string, reqBody
 		return nil, errors.New(constants.ProvinceNameAlreadyExists)
 	}
 
+	code, setCode, err := u.prepareRegencyCode(ctx, constants.RegencyLevelProvince, reqBody.Code, pid)
+	if err != nil {
+		return nil, err
+	}
+
 	res, err := u.repo.UpdateProvince(ctx, pid, reqBody.Name)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -59,6 +101,12 @@ func (u *regencyUsecase) UpdateProvince(ctx context.Context, id string, reqBody
 		}
 		return nil, err
 	}
+	if setCode {
+		if err := u.repo.SetRegencyCode(ctx, constants.RegencyLevelProvince, res.ID, code); err != nil {
+			return nil, err
+		}
+		res.Code = code
+	}
 	return res, nil
 }
 
@@ -67,6 +115,9 @@ func (u *regencyUsecase) DeleteProvince(ctx context.Context, id string, userID s
 	if err != nil {
 		return err
 	}
+	if err := u.ensureRegencyNotInUse(ctx, constants.RegencyLevelProvince, pid); err != nil {
+		return err
+	}
 	return u.repo.DeleteProvince(ctx, pid)
 }
 
@@ -137,7 +188,22 @@ func (u *regencyUsecase) CreateCity(ctx context.Context, reqBody *dto.ReqCreateC
 		return nil, errors.New(constants.CityNameAlreadyExists)
 	}
 
-	return u.repo.CreateCity(ctx, reqBody.ProvinceID, reqBody.Name, reqBody.AreaCode)
+	code, setCode, err := u.prepareRegencyCode(ctx, constants.RegencyLevelCity, reqBody.Code, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.repo.CreateCity(ctx, reqBody.ProvinceID, reqBody.Name, reqBody.AreaCode)
+	if err != nil {
+		return nil, err
+	}
+	if setCode {
+		if err := u.repo.SetRegencyCode(ctx, constants.RegencyLevelCity, res.ID, code); err != nil {
+			return nil, err
+		}
+		res.Code = code
+	}
+	return res, nil
 }
 
 func (u *regencyUsecase) UpdateCity(ctx context.Context, id string, reqBody *dto.ReqUpdateCity, userID string) (*models.City, error) {
@@ -169,6 +235,11 @@ func (u *regencyUsecase) UpdateCity(ctx context.Context, id string, reqBody *dto
 		return nil, errors.New(constants.CityNameAlreadyExists)
 	}
 
+	code, setCode, err := u.prepareRegencyCode(ctx, constants.RegencyLevelCity, reqBody.Code, cid)
+	if err != nil {
+		return nil, err
+	}
+
 	res, err := u.repo.UpdateCity(ctx, cid, reqBody.ProvinceID, reqBody.Name, reqBody.AreaCode)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -176,6 +247,12 @@ func (u *regencyUsecase) UpdateCity(ctx context.Context, id string, reqBody *dto
 		}
 		return nil, err
 	}
+	if setCode {
+		if err := u.repo.SetRegencyCode(ctx, constants.RegencyLevelCity, res.ID, code); err != nil {
+			return nil, err
+		}
+		res.Code = code
+	}
 	return res, nil
 }
 
@@ -184,6 +261,9 @@ func (u *regencyUsecase) DeleteCity(ctx context.Context, id string, userID strin
 	if err != nil {
 		return err
 	}
+	if err := u.ensureRegencyNotInUse(ctx, constants.RegencyLevelCity, cid); err != nil {
+		return err
+	}
 	return u.repo.DeleteCity(ctx, cid)
 }
 
@@ -266,7 +346,35 @@ func (u *regencyUsecase) CreateDistrict(ctx context.Context, reqBody *dto.ReqCre
 		return nil, errors.New(constants.DistrictNameAlreadyExists)
 	}
 
-	return u.repo.CreateDistrict(ctx, reqBody.CityID, reqBody.Name)
+	code, setCode, err := u.prepareRegencyCode(ctx, constants.RegencyLevelDistrict, reqBody.Code, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+
+	geo, err := prepareRegencyGeo(nil, reqBody.Latitude, reqBody.Longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.repo.CreateDistrict(ctx, reqBody.CityID, reqBody.Name)
+	if err != nil {
+		return nil, err
+	}
+	if setCode {
+		if err := u.repo.SetRegencyCode(ctx, constants.RegencyLevelDistrict, res.ID, code); err != nil {
+			return nil, err
+		}
+		res.Code = code
+	}
+	if !geo.IsEmpty() {
+		if err := u.repo.SetRegencyGeo(ctx, constants.RegencyLevelDistrict, res.ID, geo); err != nil {
+			return nil, err
+		}
+		if geo.Latitude != nil {
+			res.Latitude, res.Longitude = geo.Latitude, geo.Longitude
+		}
+	}
+	return res, nil
 }
 
 func (u *regencyUsecase) UpdateDistrict(ctx context.Context, id string, reqBody *dto.ReqUpdateDistrict, userID string) (*models.District, error) {
@@ -298,6 +406,16 @@ func (u *regencyUsecase) UpdateDistrict(ctx context.Context, id string, reqBody
 		return nil, errors.New(constants.DistrictNameAlreadyExists)
 	}
 
+	code, setCode, err := u.prepareRegencyCode(ctx, constants.RegencyLevelDistrict, reqBody.Code, did)
+	if err != nil {
+		return nil, err
+	}
+
+	geo, err := prepareRegencyGeo(nil, reqBody.Latitude, reqBody.Longitude)
+	if err != nil {
+		return nil, err
+	}
+
 	res, err := u.repo.UpdateDistrict(ctx, did, reqBody.CityID, reqBody.Name)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -305,6 +423,20 @@ func (u *regencyUsecase) UpdateDistrict(ctx context.Context, id string, reqBody
 		}
 		return nil, err
 	}
+	if setCode {
+		if err := u.repo.SetRegencyCode(ctx, constants.RegencyLevelDistrict, res.ID, code); err != nil {
+			return nil, err
+		}
+		res.Code = code
+	}
+	if !geo.IsEmpty() {
+		if err := u.repo.SetRegencyGeo(ctx, constants.RegencyLevelDistrict, res.ID, geo); err != nil {
+			return nil, err
+		}
+		if geo.Latitude != nil {
+			res.Latitude, res.Longitude = geo.Latitude, geo.Longitude
+		}
+	}
 	return res, nil
 }
 
@@ -313,6 +445,9 @@ func (u *regencyUsecase) DeleteDistrict(ctx context.Context, id string, userID s
 	if err != nil {
 		return err
 	}
+	if err := u.ensureRegencyNotInUse(ctx, constants.RegencyLevelDistrict, did); err != nil {
+		return err
+	}
 	return u.repo.DeleteDistrict(ctx, did)
 }
 
@@ -385,7 +520,38 @@ func (u *regencyUsecase) CreateSubdistrict(ctx context.Context, reqBody *dto.Req
 		return nil, errors.New(constants.SubdistrictNameAlreadyExists)
 	}
 
-	return u.repo.CreateSubdistrict(ctx, reqBody.DistrictID, reqBody.Name)
+	code, setCode, err := u.prepareRegencyCode(ctx, constants.RegencyLevelSubdistrict, reqBody.Code, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+
+	geo, err := prepareRegencyGeo(reqBody.PostalCodes, reqBody.Latitude, reqBody.Longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.repo.CreateSubdistrict(ctx, reqBody.DistrictID, reqBody.Name)
+	if err != nil {
+		return nil, err
+	}
+	if setCode {
+		if err := u.repo.SetRegencyCode(ctx, constants.RegencyLevelSubdistrict, res.ID, code); err != nil {
+			return nil, err
+		}
+		res.Code = code
+	}
+	if !geo.IsEmpty() {
+		if err := u.repo.SetRegencyGeo(ctx, constants.RegencyLevelSubdistrict, res.ID, geo); err != nil {
+			return nil, err
+		}
+		if geo.PostalCodes != nil {
+			res.PostalCodes = utils.NullStringArray{Strings: geo.PostalCodes, Valid: true}
+		}
+		if geo.Latitude != nil {
+			res.Latitude, res.Longitude = geo.Latitude, geo.Longitude
+		}
+	}
+	return res, nil
 }
 
 func (u *regencyUsecase) UpdateSubdistrict(ctx context.Context, id string, reqBody *dto.ReqUpdateSubdistrict, userID string) (*models.Subdistrict, error) {
@@ -417,6 +583,16 @@ func (u *regencyUsecase) UpdateSubdistrict(ctx context.Context, id string, reqBo
 		return nil, errors.New(constants.SubdistrictNameAlreadyExists)
 	}
 
+	code, setCode, err := u.prepareRegencyCode(ctx, constants.RegencyLevelSubdistrict, reqBody.Code, sid)
+	if err != nil {
+		return nil, err
+	}
+
+	geo, err := prepareRegencyGeo(reqBody.PostalCodes, reqBody.Latitude, reqBody.Longitude)
+	if err != nil {
+		return nil, err
+	}
+
 	res, err := u.repo.UpdateSubdistrict(ctx, sid, reqBody.DistrictID, reqBody.Name)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -424,6 +600,23 @@ func (u *regencyUsecase) UpdateSubdistrict(ctx context.Context, id string, reqBo
 		}
 		return nil, err
 	}
+	if setCode {
+		if err := u.repo.SetRegencyCode(ctx, constants.RegencyLevelSubdistrict, res.ID, code); err != nil {
+			return nil, err
+		}
+		res.Code = code
+	}
+	if !geo.IsEmpty() {
+		if err := u.repo.SetRegencyGeo(ctx, constants.RegencyLevelSubdistrict, res.ID, geo); err != nil {
+			return nil, err
+		}
+		if geo.PostalCodes != nil {
+			res.PostalCodes = utils.NullStringArray{Strings: geo.PostalCodes, Valid: true}
+		}
+		if geo.Latitude != nil {
+			res.Latitude, res.Longitude = geo.Latitude, geo.Longitude
+		}
+	}
 	return res, nil
 }
 
@@ -432,6 +625,9 @@ func (u *regencyUsecase) DeleteSubdistrict(ctx context.Context, id string, userI
 	if err != nil {
 		return err
 	}
+	if err := u.ensureRegencyNotInUse(ctx, constants.RegencyLevelSubdistrict, sid); err != nil {
+		return err
+	}
 	return u.repo.DeleteSubdistrict(ctx, sid)
 }
 
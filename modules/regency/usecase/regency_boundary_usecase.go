@@ -0,0 +1,358 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/regency/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"gorm.io/gorm"
+)
+
+// regencyPolygon is a list of rings of [longitude, latitude] positions, the first ring is the outer one and the others are holes
+type regencyPolygon [][][2]float64
+
+type regencyMultiPolygon []regencyPolygon
+
+// regencyStoredGeometry is the normalized GeoJSON geometry saved on regency_boundaries
+type regencyStoredGeometry struct {
+	Type        string              `json:"type"`
+	Coordinates regencyMultiPolygon `json:"coordinates"`
+}
+
+// parseRegencyBoundary normalizes an uploaded GeoJSON document to a MultiPolygon
+func parseRegencyBoundary(req *dto.ReqRegencyBoundary) (regencyMultiPolygon, error) {
+	switch req.Type {
+	case "Polygon":
+		var coordinates [][][]float64
+		if err := json.Unmarshal(req.Coordinates, &coordinates); err != nil {
+			return nil, fmt.Errorf(constants.RegencyBoundaryInvalidGeometry, err.Error())
+		}
+		polygon, err := toRegencyPolygon(coordinates)
+		if err != nil {
+			return nil, err
+		}
+		return regencyMultiPolygon{polygon}, nil
+	case "MultiPolygon":
+		var coordinates [][][][]float64
+		if err := json.Unmarshal(req.Coordinates, &coordinates); err != nil {
+			return nil, fmt.Errorf(constants.RegencyBoundaryInvalidGeometry, err.Error())
+		}
+		multiPolygon := regencyMultiPolygon{}
+		for _, polygonCoordinates := range coordinates {
+			polygon, err := toRegencyPolygon(polygonCoordinates)
+			if err != nil {
+				return nil, err
+			}
+			multiPolygon = append(multiPolygon, polygon)
+		}
+		return multiPolygon, nil
+	case "Feature":
+		if req.Geometry == nil {
+			return regencyMultiPolygon{}, nil
+		}
+		return parseRegencyBoundary(req.Geometry)
+	case "FeatureCollection":
+		multiPolygon := regencyMultiPolygon{}
+		for i := range req.Features {
+			polygons, err := parseRegencyBoundary(&req.Features[i])
+			if err != nil {
+				return nil, err
+			}
+			multiPolygon = append(multiPolygon, polygons...)
+		}
+		return multiPolygon, nil
+	default:
+		return nil, fmt.Errorf(constants.RegencyBoundaryInvalidType, req.Type)
+	}
+}
+
+func toRegencyPolygon(coordinates [][][]float64) (regencyPolygon, error) {
+	polygon := regencyPolygon{}
+	for _, ringCoordinates := range coordinates {
+		if len(ringCoordinates) < 4 {
+			return nil, errors.New(constants.RegencyBoundaryRingTooShort)
+		}
+
+		ring := make([][2]float64, 0, len(ringCoordinates))
+		for _, position := range ringCoordinates {
+			// altitude, when present, is dropped
+			if len(position) < 2 || !validRegencyCoordinate(position[1], position[0]) {
+				return nil, fmt.Errorf(constants.RegencyBoundaryPositionInvalid, position)
+			}
+			ring = append(ring, [2]float64{position[0], position[1]})
+		}
+		if ring[0] != ring[len(ring)-1] {
+			return nil, errors.New(constants.RegencyBoundaryRingNotClosed)
+		}
+
+		polygon = append(polygon, ring)
+	}
+	if len(polygon) == 0 {
+		return nil, errors.New(constants.RegencyBoundaryEmpty)
+	}
+	return polygon, nil
+}
+
+// bounds returns the bounding box as min latitude, max latitude, min longitude, max longitude
+func (m regencyMultiPolygon) bounds() (float64, float64, float64, float64) {
+	minLat, maxLat := math.Inf(1), math.Inf(-1)
+	minLong, maxLong := math.Inf(1), math.Inf(-1)
+	for _, polygon := range m {
+		// the outer ring encloses the holes
+		for _, position := range polygon[0] {
+			minLong, maxLong = math.Min(minLong, position[0]), math.Max(maxLong, position[0])
+			minLat, maxLat = math.Min(minLat, position[1]), math.Max(maxLat, position[1])
+		}
+	}
+	return minLat, maxLat, minLong, maxLong
+}
+
+// contains reports whether the point lies inside one of the polygons and outside of its holes
+func (m regencyMultiPolygon) contains(latitude, longitude float64) bool {
+	for _, polygon := range m {
+		if !regencyRingContains(polygon[0], latitude, longitude) {
+			continue
+		}
+		inHole := false
+		for _, hole := range polygon[1:] {
+			if regencyRingContains(hole, latitude, longitude) {
+				inHole = true
+				break
+			}
+		}
+		if !inHole {
+			return true
+		}
+	}
+	return false
+}
+
+// regencyRingContains is the even-odd ray casting test
+func regencyRingContains(ring [][2]float64, latitude, longitude float64) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		xi, yi := ring[i][0], ring[i][1]
+		xj, yj := ring[j][0], ring[j][1]
+		if (yi > latitude) != (yj > latitude) && longitude < (xj-xi)*(latitude-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func validateRegencyLevel(level string) error {
+	if !utils.InArray(level, regencyLevels) {
+		return errors.New(constants.RegencyLevelInvalid)
+	}
+	return nil
+}
+
+func (u *regencyUsecase) SaveRegencyBoundary(ctx context.Context, level string, id string, req *dto.ReqRegencyBoundary, userID string) (*dto.GeoJSONFeature, error) {
+	if err := validateRegencyLevel(level); err != nil {
+		return nil, err
+	}
+	regionID, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := u.repo.ExistsRegencyByID(ctx, level, regionID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf(constants.RegencyRegionNotFound, level, id)
+	}
+
+	multiPolygon, err := parseRegencyBoundary(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(multiPolygon) == 0 {
+		return nil, errors.New(constants.RegencyBoundaryEmpty)
+	}
+
+	geometry, err := json.Marshal(regencyStoredGeometry{Type: "MultiPolygon", Coordinates: multiPolygon})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	minLat, maxLat, minLong, maxLong := multiPolygon.bounds()
+	boundary := &models.RegencyBoundary{
+		Level:        level,
+		RegionID:     regionID,
+		Geometry:     string(geometry),
+		MinLatitude:  minLat,
+		MaxLatitude:  maxLat,
+		MinLongitude: minLong,
+		MaxLongitude: maxLong,
+		CreatedBy:    userID,
+		UpdatedBy:    userID,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := u.repo.UpsertRegencyBoundary(ctx, boundary); err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, err
+	}
+
+	return u.GetRegencyBoundary(ctx, level, id)
+}
+
+func (u *regencyUsecase) DeleteRegencyBoundary(ctx context.Context, level string, id string) error {
+	if err := validateRegencyLevel(level); err != nil {
+		return err
+	}
+	regionID, err := utils.StringToUUID(id)
+	if err != nil {
+		return err
+	}
+
+	if err := u.repo.DeleteRegencyBoundary(ctx, level, regionID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf(constants.RegencyBoundaryNotFound, level, id)
+		}
+		return err
+	}
+	return nil
+}
+
+func (u *regencyUsecase) GetRegencyBoundary(ctx context.Context, level string, id string) (*dto.GeoJSONFeature, error) {
+	if err := validateRegencyLevel(level); err != nil {
+		return nil, err
+	}
+	regionID, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := u.repo.GetRegencyBoundary(ctx, level, regionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.RegencyBoundaryNotFound, level, id)
+		}
+		return nil, err
+	}
+
+	feature := dto.ToGeoJSONFeature(*row)
+	return &feature, nil
+}
+
+func (u *regencyUsecase) GetRegencyBoundaries(ctx context.Context, req dto.ReqRegencyBoundaryIndex) (*dto.GeoJSONFeatureCollection, error) {
+	if err := validateRegencyLevel(req.Level); err != nil {
+		return nil, err
+	}
+
+	var parentID *uuid.UUID
+	if req.ParentID != "" {
+		if req.Level == constants.RegencyLevelProvince {
+			return nil, errors.New(constants.RegencyTreeParentNotNeeded)
+		}
+		id, err := utils.StringToUUID(req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		parentID = &id
+	}
+
+	rows, err := u.repo.GetRegencyBoundaries(ctx, req.Level, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	collection := dto.ToGeoJSONFeatureCollection(rows)
+	return &collection, nil
+}
+
+// LocateRegency resolves a coordinate to the deepest region whose boundary contains it.
+// When the deepest boundary is a district, the subdistrict is picked by the nearest centroid inside that district.
+func (u *regencyUsecase) LocateRegency(ctx context.Context, req dto.ReqRegencyLocate) (*dto.RespRegencyLocate, error) {
+	if req.Latitude == nil || req.Longitude == nil {
+		return nil, errors.New(constants.RegencyCoordinatePairRequired)
+	}
+	latitude, longitude := *req.Latitude, *req.Longitude
+	if !validRegencyCoordinate(latitude, longitude) {
+		return nil, errors.New(constants.RegencyCoordinateInvalid)
+	}
+
+	// deepest level first
+	for i := len(regencyLevels) - 1; i >= 0; i-- {
+		level := regencyLevels[i]
+
+		rows, err := u.repo.GetRegencyBoundariesAt(ctx, level, latitude, longitude)
+		if err != nil {
+			utils.Logger.Error(err.Error())
+			return nil, err
+		}
+
+		for _, row := range rows {
+			geometry := regencyStoredGeometry{}
+			if err := json.Unmarshal([]byte(row.Geometry), &geometry); err != nil {
+				utils.Logger.Error(fmt.Sprintf("invalid stored boundary of %s %s: %v", level, row.ID, err))
+				continue
+			}
+			if !geometry.Coordinates.contains(latitude, longitude) {
+				continue
+			}
+
+			res := &dto.RespRegencyLocate{
+				Latitude:      latitude,
+				Longitude:     longitude,
+				Region:        dto.ToRespRegencySearchResult(row.RegencySearchRow),
+				BoundaryLevel: level,
+				MatchedBy:     constants.RegencyLocateMatchedBoundary,
+			}
+
+			if level == constants.RegencyLevelDistrict {
+				subdistrict, err := u.nearestSubdistrictInDistrict(ctx, row.ID, latitude, longitude)
+				if err != nil {
+					return nil, err
+				}
+				if subdistrict != nil {
+					res.Region = *subdistrict
+					res.MatchedBy = constants.RegencyLocateMatchedNearestCenter
+				}
+			}
+
+			return res, nil
+		}
+	}
+
+	return nil, errors.New(constants.RegencyLocateNotFound)
+}
+
+// nearestSubdistrictInDistrict returns the subdistrict of the district whose centroid is the nearest, nil when none has coordinates
+func (u *regencyUsecase) nearestSubdistrictInDistrict(ctx context.Context, districtID uuid.UUID, latitude, longitude float64) (*dto.RespRegencySearchResult, error) {
+	minLat, maxLat, minLong, maxLong := regencyBoundingBox(latitude, longitude, constants.RegencyNearestRadiusDefault)
+	rows, err := u.repo.GetRegencyGeoInBox(ctx, constants.RegencyLevelSubdistrict, minLat, maxLat, minLong, maxLong)
+	if err != nil {
+		return nil, err
+	}
+
+	var nearest *dto.RegencyGeoRow
+	nearestDistance := math.Inf(1)
+	for i, row := range rows {
+		if row.DistrictID == nil || *row.DistrictID != districtID || row.Latitude == nil || row.Longitude == nil {
+			continue
+		}
+		if distance := utils.Haversine(latitude, longitude, *row.Latitude, *row.Longitude); distance < nearestDistance {
+			nearest, nearestDistance = &rows[i], distance
+		}
+	}
+
+	if nearest == nil {
+		return nil, nil
+	}
+
+	res := dto.ToRespRegencySearchResult(nearest.RegencySearchRow)
+	return &res, nil
+}
@@ -0,0 +1,303 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/modules/regency/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+)
+
+var regencyPostalCodePattern = regexp.MustCompile(`^\d{5}$`)
+
+// km covered by one degree of latitude, used to build the bounding box of the nearest lookup
+const regencyKmPerDegree = 111.32
+
+// regencyBoundingBox returns the box around the coordinate covering the radius,
+// as min latitude, max latitude, min longitude, max longitude
+func regencyBoundingBox(latitude, longitude float64, radiusKm int) (float64, float64, float64, float64) {
+	deltaLat := float64(radiusKm) / regencyKmPerDegree
+	deltaLong := 180.0
+	if cos := math.Cos(latitude * math.Pi / 180); cos > 0.01 {
+		deltaLong = math.Min(180, deltaLat/cos)
+	}
+	return latitude - deltaLat, latitude + deltaLat, longitude - deltaLong, longitude + deltaLong
+}
+
+func validRegencyCoordinate(latitude, longitude float64) bool {
+	return latitude >= -90 && latitude <= 90 && longitude >= -180 && longitude <= 180
+}
+
+// normalizeRegencyPostalCodes trims, validates, de-duplicates and sorts postal codes,
+// the result is never nil so that an empty list clears the stored codes
+func normalizeRegencyPostalCodes(postalCodes []string) ([]string, error) {
+	seen := map[string]bool{}
+	res := []string{}
+	for _, postalCode := range postalCodes {
+		postalCode = strings.TrimSpace(postalCode)
+		if postalCode == "" || seen[postalCode] {
+			continue
+		}
+		if !regencyPostalCodePattern.MatchString(postalCode) {
+			return nil, fmt.Errorf(constants.RegencyPostalCodeInvalid, postalCode)
+		}
+		seen[postalCode] = true
+		res = append(res, postalCode)
+	}
+	sort.Strings(res)
+
+	return res, nil
+}
+
+// splitRegencyPostalCodes splits an import cell holding several postal codes, e.g. "40151, 40152" or "40151;40152"
+func splitRegencyPostalCodes(cell string) []string {
+	return strings.FieldsFunc(cell, func(r rune) bool {
+		return r == ',' || r == ';' || r == ' '
+	})
+}
+
+// prepareRegencyGeo validates the postal codes and centroid sent on create / update.
+// nil leaves a field untouched, an empty list of postal codes clears them.
+func prepareRegencyGeo(postalCodes []string, latitude, longitude *float64) (dto.RegencyGeo, error) {
+	geo := dto.RegencyGeo{}
+
+	if postalCodes != nil {
+		normalized, err := normalizeRegencyPostalCodes(postalCodes)
+		if err != nil {
+			return geo, err
+		}
+		geo.PostalCodes = normalized
+	}
+
+	if (latitude == nil) != (longitude == nil) {
+		return geo, fmt.Errorf(constants.RegencyCoordinatePairRequired)
+	}
+	if latitude != nil {
+		if !validRegencyCoordinate(*latitude, *longitude) {
+			return geo, fmt.Errorf(constants.RegencyCoordinateInvalid)
+		}
+		geo.Latitude = latitude
+		geo.Longitude = longitude
+	}
+
+	return geo, nil
+}
+
+// GetNearestRegency returns the districts or subdistricts whose centroid is the nearest to the coordinate.
+// Candidates are narrowed with a bounding box of the radius, then ranked with the haversine distance.
+func (u *regencyUsecase) GetNearestRegency(ctx context.Context, req dto.ReqRegencyNearest) ([]dto.RespRegencyGeo, error) {
+	if req.Latitude == nil || req.Longitude == nil {
+		return nil, fmt.Errorf(constants.RegencyCoordinatePairRequired)
+	}
+	latitude, longitude := *req.Latitude, *req.Longitude
+	if !validRegencyCoordinate(latitude, longitude) {
+		return nil, fmt.Errorf(constants.RegencyCoordinateInvalid)
+	}
+
+	level := req.Level
+	if level == "" {
+		level = constants.RegencyLevelSubdistrict
+	}
+	if level != constants.RegencyLevelDistrict && level != constants.RegencyLevelSubdistrict {
+		return nil, fmt.Errorf(constants.RegencyNearestLevelInvalid)
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = constants.RegencyNearestLimitDefault
+	}
+	if limit > constants.RegencyNearestLimitMax {
+		limit = constants.RegencyNearestLimitMax
+	}
+
+	radiusKm := req.RadiusKm
+	if radiusKm <= 0 {
+		radiusKm = constants.RegencyNearestRadiusDefault
+	}
+	if radiusKm > constants.RegencyNearestRadiusMax {
+		radiusKm = constants.RegencyNearestRadiusMax
+	}
+
+	minLat, maxLat, minLong, maxLong := regencyBoundingBox(latitude, longitude, radiusKm)
+	rows, err := u.repo.GetRegencyGeoInBox(ctx, level, minLat, maxLat, minLong, maxLong)
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, err
+	}
+
+	maxDistance := float64(radiusKm) * 1000
+	results := make([]dto.RespRegencyGeo, 0, len(rows))
+	for _, row := range rows {
+		if row.Latitude == nil || row.Longitude == nil {
+			continue
+		}
+		distance := utils.Haversine(latitude, longitude, *row.Latitude, *row.Longitude)
+		// the corners of the box are further than the radius
+		if distance > maxDistance {
+			continue
+		}
+		result := dto.ToRespRegencyGeo(row)
+		result.DistanceMeters = &distance
+		results = append(results, result)
+	}
+
+	if len(results) == 0 {
+		return nil, fmt.Errorf(constants.RegencyNearestNotFound, level, radiusKm)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return *results[i].DistanceMeters < *results[j].DistanceMeters
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results, nil
+}
+
+// GetRegencyByPostalCode returns every subdistrict served by the postal code with its full hierarchy
+func (u *regencyUsecase) GetRegencyByPostalCode(ctx context.Context, postalCode string) ([]dto.RespRegencyGeo, error) {
+	postalCode = strings.TrimSpace(postalCode)
+	if !regencyPostalCodePattern.MatchString(postalCode) {
+		return nil, fmt.Errorf(constants.RegencyPostalCodeInvalid, postalCode)
+	}
+
+	rows, err := u.repo.GetRegencyByPostalCode(ctx, postalCode)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf(constants.RegencyPostalCodeNotFound, postalCode)
+	}
+
+	results := make([]dto.RespRegencyGeo, 0, len(rows))
+	for _, row := range rows {
+		results = append(results, dto.ToRespRegencyGeo(row))
+	}
+
+	return results, nil
+}
+
+// ImportRegencyGeo bulk updates postal codes and centroids from a file with columns: code, postal_codes, latitude, longitude.
+// A subdistrict may list several postal codes in one cell separated by comma, semicolon or space, they replace the stored ones.
+// Regions are matched by their official code, empty cells are left untouched. Nothing is written when a row is invalid or on dry run.
+func (u *regencyUsecase) ImportRegencyGeo(ctx context.Context, filePath string, dryRun bool) (*dto.RespRegencyGeoImport, error) {
+	records, err := readRegencySheet(filePath)
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, err
+	}
+
+	regions, err := u.repo.GetRegencyCodeRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byCode := map[string]dto.RegencyCodeRecord{}
+	for _, region := range regions {
+		if region.Code != nil && *region.Code != "" {
+			byCode[region.Level+"|"+*region.Code] = region
+		}
+	}
+
+	res := &dto.RespRegencyGeoImport{
+		DryRun: dryRun,
+		Errors: []dto.RespRegencyImportRowError{},
+	}
+	addError := func(row int, code string, message string) {
+		res.Errors = append(res.Errors, dto.RespRegencyImportRowError{Row: row, Code: code, ErrorMessage: message})
+	}
+
+	seen := map[string]int{}
+	updates := []dto.RegencyGeoUpdate{}
+	for i, record := range records {
+		row := i + 2
+		code := regencySheetCell(record, 0)
+		postalCode := regencySheetCell(record, 1)
+		latitude := regencySheetCell(record, 2)
+		longitude := regencySheetCell(record, 3)
+
+		// skip blank lines
+		if code == "" && postalCode == "" && latitude == "" && longitude == "" {
+			continue
+		}
+		res.TotalRows++
+
+		if code == "" {
+			addError(row, code, constants.RegencyImportRowCodeRequired)
+			continue
+		}
+		level, ok := regencyLevelFromCode(code)
+		if !ok {
+			addError(row, code, fmt.Sprintf(constants.RegencyImportRowCodeInvalid, code))
+			continue
+		}
+		if level != constants.RegencyLevelDistrict && level != constants.RegencyLevelSubdistrict {
+			addError(row, code, fmt.Sprintf(constants.RegencyGeoImportRowLevelInvalid, code))
+			continue
+		}
+		if firstRow, ok := seen[code]; ok {
+			addError(row, code, fmt.Sprintf(constants.RegencyImportRowCodeDuplicated, code, firstRow))
+			continue
+		}
+		seen[code] = row
+
+		geo := dto.RegencyGeo{}
+		if postalCode != "" {
+			if level != constants.RegencyLevelSubdistrict {
+				addError(row, code, constants.RegencyGeoImportRowPostalCodeLevel)
+				continue
+			}
+			postalCodes, err := normalizeRegencyPostalCodes(splitRegencyPostalCodes(postalCode))
+			if err != nil {
+				addError(row, code, err.Error())
+				continue
+			}
+			geo.PostalCodes = postalCodes
+		}
+
+		if latitude != "" || longitude != "" {
+			lat, latErr := strconv.ParseFloat(latitude, 64)
+			long, longErr := strconv.ParseFloat(longitude, 64)
+			if latErr != nil || longErr != nil || !validRegencyCoordinate(lat, long) {
+				addError(row, code, constants.RegencyGeoImportRowCoordinateInvalid)
+				continue
+			}
+			geo.Latitude = &lat
+			geo.Longitude = &long
+		}
+
+		if geo.IsEmpty() {
+			addError(row, code, constants.RegencyGeoImportRowEmpty)
+			continue
+		}
+
+		region, ok := byCode[level+"|"+code]
+		if !ok {
+			addError(row, code, fmt.Sprintf(constants.RegencyGeoImportRowCodeNotFound, code))
+			continue
+		}
+
+		updates = append(updates, dto.RegencyGeoUpdate{ID: region.ID, Level: level, Geo: geo})
+	}
+
+	if len(res.Errors) > 0 {
+		return res, nil
+	}
+
+	res.Updated = len(updates)
+	if dryRun || len(updates) == 0 {
+		return res, nil
+	}
+
+	if err := u.repo.ApplyRegencyGeoImport(ctx, updates); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
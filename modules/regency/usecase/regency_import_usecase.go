@@ -0,0 +1,407 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/modules/regency/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/xuri/excelize/v2"
+)
+
+// official Kemendagri / BPS code format of each level, e.g. 32.73.01.1001
+var regencyCodePatterns = map[string]*regexp.Regexp{
+	constants.RegencyLevelProvince:    regexp.MustCompile(`^\d{2}$`),
+	constants.RegencyLevelCity:        regexp.MustCompile(`^\d{2}\.\d{2}$`),
+	constants.RegencyLevelDistrict:    regexp.MustCompile(`^\d{2}\.\d{2}\.\d{2}$`),
+	constants.RegencyLevelSubdistrict: regexp.MustCompile(`^\d{2}\.\d{2}\.\d{2}\.\d{4}$`),
+}
+
+// regencyLevelFromCode derives the level from the number of segments of a code
+func regencyLevelFromCode(code string) (string, bool) {
+	segments := strings.Count(code, ".") + 1
+	if segments < 1 || segments > len(regencyLevels) {
+		return "", false
+	}
+
+	level := regencyLevels[segments-1]
+	if !regencyCodePatterns[level].MatchString(code) {
+		return "", false
+	}
+	return level, true
+}
+
+func regencyParentCode(code string) string {
+	if i := strings.LastIndex(code, "."); i >= 0 {
+		return code[:i]
+	}
+	return ""
+}
+
+// prepareRegencyCode validates the code sent on create / update.
+// nil leaves the code untouched, an empty string clears it.
+func (u *regencyUsecase) prepareRegencyCode(ctx context.Context, level string, code *string, excludeID uuid.UUID) (*string, bool, error) {
+	if code == nil {
+		return nil, false, nil
+	}
+
+	trimmed := strings.TrimSpace(*code)
+	if trimmed == "" {
+		return nil, true, nil
+	}
+
+	if !regencyCodePatterns[level].MatchString(trimmed) {
+		return nil, false, fmt.Errorf(constants.RegencyCodeInvalidFormat, trimmed, level)
+	}
+
+	exists, err := u.repo.ExistsRegencyByCode(ctx, level, trimmed, excludeID)
+	if err != nil {
+		return nil, false, err
+	}
+	if exists {
+		return nil, false, fmt.Errorf(constants.RegencyCodeAlreadyExists, level, trimmed)
+	}
+
+	return &trimmed, true, nil
+}
+
+func (u *regencyUsecase) GetRegencyByCode(ctx context.Context, code string) (*dto.RespRegencySearchResult, error) {
+	code = strings.TrimSpace(code)
+	level, ok := regencyLevelFromCode(code)
+	if !ok {
+		return nil, fmt.Errorf(constants.RegencyImportRowCodeInvalid, code)
+	}
+
+	row, err := u.repo.GetRegencyByCode(ctx, level, code)
+	if err != nil {
+		return nil, err
+	}
+
+	res := dto.ToRespRegencySearchResult(*row)
+	return &res, nil
+}
+
+// ImportRegencies upserts the whole hierarchy from an official dataset (columns: code, name).
+// Regions are matched by code, regions without code are linked by name under the same parent,
+// coded regions missing from the dataset are removed together with the regions without code under them,
+// unless still used by an address.
+// Nothing is written when a row is invalid, a removed region is in use or on dry run.
+func (u *regencyUsecase) ImportRegencies(ctx context.Context, filePath string, dryRun bool) (*dto.RespRegencyImport, error) {
+	rows, err := readRegencyDataset(filePath)
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, err
+	}
+
+	res := &dto.RespRegencyImport{
+		DryRun:    dryRun,
+		TotalRows: len(rows),
+		Changes:   []dto.RespRegencyImportChange{},
+		Errors:    []dto.RespRegencyImportRowError{},
+	}
+
+	entries := validateRegencyDataset(rows, res)
+	if len(res.Errors) > 0 {
+		return res, nil
+	}
+
+	records, err := u.repo.GetRegencyCodeRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := planRegencyImport(entries, records, res)
+	for _, change := range changes {
+		res.Changes = append(res.Changes, dto.ToRespRegencyImportChange(change))
+	}
+
+	// regions still used by supplier or customer addresses cannot be removed
+	for _, change := range changes {
+		if change.Action != constants.RegencyImportActionRemoved {
+			continue
+		}
+		inUse, err := u.repo.ExistsRegencyInUse(ctx, change.Level, change.ID)
+		if err != nil {
+			return nil, err
+		}
+		if inUse {
+			// a region without code is named instead
+			label := change.Level
+			if change.Code == "" {
+				label += " " + change.Name
+			}
+			res.Errors = append(res.Errors, dto.RespRegencyImportRowError{
+				Code:         change.Code,
+				ErrorMessage: fmt.Sprintf(constants.RegencyStillUsed, label),
+			})
+		}
+	}
+	if len(res.Errors) > 0 {
+		return res, nil
+	}
+
+	if dryRun || len(changes) == 0 {
+		return res, nil
+	}
+
+	if err := u.repo.ApplyRegencyImport(ctx, changes); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// readRegencySheet reads the rows of a .csv, .xlsx or .xls file, the first row is the header and is skipped
+func readRegencySheet(filePath string) ([][]string, error) {
+	var records [][]string
+
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".csv":
+		file, err := os.Open(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", constants.RegencyImportFileOpenFailed, err)
+		}
+		defer file.Close()
+
+		reader := csv.NewReader(file)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+		for {
+			record, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", constants.RegencyImportFileReadFailed, err)
+			}
+			records = append(records, record)
+		}
+	case ".xlsx", ".xls":
+		f, err := excelize.OpenFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", constants.RegencyImportFileOpenFailed, err)
+		}
+		defer f.Close()
+
+		records, err = f.GetRows(f.GetSheetName(0))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", constants.RegencyImportFileReadFailed, err)
+		}
+	default:
+		return nil, fmt.Errorf(constants.RegencyImportInvalidFileFormat)
+	}
+
+	if len(records) < 2 {
+		return nil, fmt.Errorf(constants.RegencyImportInsufficientRows)
+	}
+
+	return records[1:], nil
+}
+
+// regencySheetCell returns the trimmed cell of a row, empty when the row is shorter
+func regencySheetCell(record []string, index int) string {
+	if index < len(record) {
+		return strings.TrimSpace(record[index])
+	}
+	return ""
+}
+
+// readRegencyDataset reads the code and name columns of a .csv, .xlsx or .xls file
+func readRegencyDataset(filePath string) ([]dto.RegencyImportRow, error) {
+	records, err := readRegencySheet(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]dto.RegencyImportRow, 0, len(records))
+	for i, record := range records {
+		row := dto.RegencyImportRow{
+			Row:  i + 2,
+			Code: regencySheetCell(record, 0),
+			Name: regencySheetCell(record, 1),
+		}
+		// skip blank lines
+		if row.Code == "" && row.Name == "" {
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// validateRegencyDataset checks every row and returns the valid entries sorted from provinces down
+func validateRegencyDataset(rows []dto.RegencyImportRow, res *dto.RespRegencyImport) []dto.RegencyImportRow {
+	addError := func(row dto.RegencyImportRow, message string) {
+		res.Errors = append(res.Errors, dto.RespRegencyImportRowError{Row: row.Row, Code: row.Code, ErrorMessage: message})
+	}
+
+	seen := map[string]int{}
+	entries := make([]dto.RegencyImportRow, 0, len(rows))
+	for _, row := range rows {
+		if row.Code == "" {
+			addError(row, constants.RegencyImportRowCodeRequired)
+			continue
+		}
+		if row.Name == "" {
+			addError(row, constants.RegencyImportRowNameRequired)
+			continue
+		}
+
+		level, ok := regencyLevelFromCode(row.Code)
+		if !ok {
+			addError(row, fmt.Sprintf(constants.RegencyImportRowCodeInvalid, row.Code))
+			continue
+		}
+		if firstRow, ok := seen[row.Code]; ok {
+			addError(row, fmt.Sprintf(constants.RegencyImportRowCodeDuplicated, row.Code, firstRow))
+			continue
+		}
+		seen[row.Code] = row.Row
+
+		row.Level = level
+		entries = append(entries, row)
+	}
+
+	// the dataset is the entire hierarchy, every parent must be part of it
+	for _, entry := range entries {
+		if parentCode := regencyParentCode(entry.Code); parentCode != "" {
+			if _, ok := seen[parentCode]; !ok {
+				addError(entry, fmt.Sprintf(constants.RegencyImportRowParentMissing, parentCode))
+			}
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Code < entries[j].Code
+	})
+
+	return entries
+}
+
+// planRegencyImport compares the dataset with the active regions and returns the changes to apply:
+// added / renamed / linked from provinces down, then removed from subdistricts up.
+// Regions without code under a removed region are removed before it, they would stay hidden under a deleted parent.
+func planRegencyImport(entries []dto.RegencyImportRow, records []dto.RegencyCodeRecord, res *dto.RespRegencyImport) []dto.RegencyImportChange {
+	byCode := map[string]dto.RegencyCodeRecord{}
+	uncoded := map[string]dto.RegencyCodeRecord{}
+	uncodedKey := func(level string, parentID *uuid.UUID, name string) string {
+		parent := ""
+		if parentID != nil {
+			parent = parentID.String()
+		}
+		return level + "|" + parent + "|" + strings.ToLower(name)
+	}
+
+	for _, record := range records {
+		if record.Code != nil && *record.Code != "" {
+			byCode[*record.Code] = record
+			continue
+		}
+		uncoded[uncodedKey(record.Level, record.ParentID, record.Name)] = record
+	}
+
+	changes := []dto.RegencyImportChange{}
+	codeToID := map[string]uuid.UUID{}
+	inDataset := map[string]bool{}
+	linked := map[uuid.UUID]bool{}
+
+	// entries are sorted by code, so a parent is always planned before its children
+	for _, entry := range entries {
+		inDataset[entry.Code] = true
+
+		if record, ok := byCode[entry.Code]; ok {
+			codeToID[entry.Code] = record.ID
+			if record.Name != entry.Name {
+				changes = append(changes, dto.RegencyImportChange{
+					Action: constants.RegencyImportActionRenamed, Level: entry.Level, Code: entry.Code,
+					Name: entry.Name, OldName: record.Name, ID: record.ID,
+				})
+				res.Renamed++
+				continue
+			}
+			res.Unchanged++
+			continue
+		}
+
+		parentCode := regencyParentCode(entry.Code)
+		var parentID *uuid.UUID
+		if id, ok := codeToID[parentCode]; ok {
+			parentID = &id
+		}
+
+		// an existing region without code under the same (existing) parent is adopted
+		if parentCode == "" || parentID != nil {
+			key := uncodedKey(entry.Level, parentID, entry.Name)
+			if record, ok := uncoded[key]; ok {
+				delete(uncoded, key)
+				linked[record.ID] = true
+				codeToID[entry.Code] = record.ID
+				changes = append(changes, dto.RegencyImportChange{
+					Action: constants.RegencyImportActionLinked, Level: entry.Level, Code: entry.Code,
+					Name: entry.Name, ID: record.ID,
+				})
+				res.Linked++
+				continue
+			}
+		}
+
+		changes = append(changes, dto.RegencyImportChange{
+			Action: constants.RegencyImportActionAdded, Level: entry.Level, Code: entry.Code,
+			Name: entry.Name, ParentID: parentID, ParentCode: parentCode,
+		})
+		res.Added++
+	}
+
+	removed := []dto.RegencyCodeRecord{}
+	for code, record := range byCode {
+		if !inDataset[code] {
+			removed = append(removed, record)
+		}
+	}
+	// children first
+	sort.Slice(removed, func(i, j int) bool {
+		return *removed[i].Code > *removed[j].Code
+	})
+
+	uncodedChildren := map[uuid.UUID][]dto.RegencyCodeRecord{}
+	for _, record := range records {
+		if record.ParentID != nil && (record.Code == nil || *record.Code == "") && !linked[record.ID] {
+			uncodedChildren[*record.ParentID] = append(uncodedChildren[*record.ParentID], record)
+		}
+	}
+	var removeUncodedChildren func(parentID uuid.UUID)
+	removeUncodedChildren = func(parentID uuid.UUID) {
+		children := uncodedChildren[parentID]
+		sort.Slice(children, func(i, j int) bool {
+			return children[i].Name < children[j].Name
+		})
+		for _, child := range children {
+			removeUncodedChildren(child.ID)
+			changes = append(changes, dto.RegencyImportChange{
+				Action: constants.RegencyImportActionRemoved, Level: child.Level, Name: child.Name, ID: child.ID,
+			})
+			res.Removed++
+		}
+	}
+
+	for _, record := range removed {
+		removeUncodedChildren(record.ID)
+		changes = append(changes, dto.RegencyImportChange{
+			Action: constants.RegencyImportActionRemoved, Level: record.Level, Code: *record.Code,
+			Name: record.Name, ID: record.ID,
+		})
+		res.Removed++
+	}
+
+	return changes
+}
@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/i18n"
+	"github.com/rendyfutsuy/base-go/modules/regency/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+)
+
+// regencyLevels lists the hierarchy from the root down
+var regencyLevels = []string{
+	constants.RegencyLevelProvince,
+	constants.RegencyLevelCity,
+	constants.RegencyLevelDistrict,
+	constants.RegencyLevelSubdistrict,
+}
+
+// GetRegencyTreeETag returns the ETag of the full tree in the locale of the request without building it
+func (u *regencyUsecase) GetRegencyTreeETag(ctx context.Context) (string, error) {
+	version, err := u.repo.GetRegencyTreeVersion(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(i18n.LocaleFromContext(ctx) + "|" + version))
+	return `"` + hex.EncodeToString(sum[:16]) + `"`, nil
+}
+
+// GetRegencyTree returns the full province > city > district > subdistrict tree in the locale of the request and its ETag.
+// The tree of a locale is rebuilt only when its ETag changed since the last call.
+func (u *regencyUsecase) GetRegencyTree(ctx context.Context) ([]dto.RespRegencyTreeNode, string, error) {
+	etag, err := u.GetRegencyTreeETag(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	locale := i18n.LocaleFromContext(ctx)
+
+	u.treeMu.RLock()
+	if cached, ok := u.treeCache[locale]; ok && cached.etag == etag {
+		u.treeMu.RUnlock()
+		return cached.tree, etag, nil
+	}
+	u.treeMu.RUnlock()
+
+	// load every level, then attach children from the bottom up
+	children := map[uuid.UUID][]dto.RespRegencyTreeNode{}
+	var tree []dto.RespRegencyTreeNode
+	for i := len(regencyLevels) - 1; i >= 0; i-- {
+		level := regencyLevels[i]
+		nodes, err := u.repo.GetRegencyNodes(ctx, level, nil)
+		if err != nil {
+			return nil, "", err
+		}
+
+		levelChildren := map[uuid.UUID][]dto.RespRegencyTreeNode{}
+		for _, node := range nodes {
+			resp := dto.ToRespRegencyTreeNode(level, node)
+			resp.Children = children[node.ID]
+			resp.HasChildren = len(resp.Children) > 0
+
+			if node.ParentID == nil {
+				tree = append(tree, resp)
+				continue
+			}
+			levelChildren[*node.ParentID] = append(levelChildren[*node.ParentID], resp)
+		}
+		children = levelChildren
+	}
+
+	if tree == nil {
+		tree = []dto.RespRegencyTreeNode{}
+	}
+
+	u.treeMu.Lock()
+	if u.treeCache == nil {
+		u.treeCache = map[string]regencyTreeCache{}
+	}
+	u.treeCache[locale] = regencyTreeCache{etag: etag, tree: tree}
+	u.treeMu.Unlock()
+
+	return tree, etag, nil
+}
+
+// GetRegencyTreeChildren returns one level of the tree, used by pickers that expand nodes lazily
+func (u *regencyUsecase) GetRegencyTreeChildren(ctx context.Context, req dto.ReqRegencyTreeChildren) ([]dto.RespRegencyTreeNode, error) {
+	var parentID *uuid.UUID
+	if req.Level == constants.RegencyLevelProvince {
+		if req.ParentID != "" {
+			return nil, errors.New(constants.RegencyTreeParentNotNeeded)
+		}
+	} else {
+		if req.ParentID == "" {
+			return nil, fmt.Errorf(constants.RegencyTreeParentRequired, req.Level)
+		}
+		id, err := utils.StringToUUID(req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		parentID = &id
+	}
+
+	nodes, err := u.repo.GetRegencyNodes(ctx, req.Level, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]dto.RespRegencyTreeNode, 0, len(nodes))
+	for _, node := range nodes {
+		res = append(res, dto.ToRespRegencyTreeNode(req.Level, node))
+	}
+
+	return res, nil
+}
+
+// SearchRegency searches every level at once and returns each match with its ancestor path
+func (u *regencyUsecase) SearchRegency(ctx context.Context, req dto.ReqRegencySearch) ([]dto.RespRegencySearchResult, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = constants.RegencySearchLimitDefault
+	}
+	if limit > constants.RegencySearchLimitMax {
+		limit = constants.RegencySearchLimitMax
+	}
+
+	rows, err := u.repo.SearchRegency(ctx, req.Search, req.Level, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]dto.RespRegencySearchResult, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, dto.ToRespRegencySearchResult(row))
+	}
+
+	return res, nil
+}
@@ -66,6 +66,10 @@ func NewSubGroupHandler(e *echo.Echo, uc sub_group.Usecase, mwP _reqContext.IMid
 
 	// Delete
 	r.DELETE("/:id", h.Delete, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToDelete))
+
+	// Move to another group / merge into another sub-group
+	r.POST("/:id/move", h.Move, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.POST("/:id/merge", h.Merge, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
 }
 
 // Create godoc
@@ -0,0 +1,100 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/sub-group/dto"
+)
+
+// Move godoc
+// @Summary		Move sub-group to another group
+// @Description	Re-parent a sub-group and its types to another group in one transaction. Name must be unique within the target group. When regenerate_codes is true the sub-group, its types and their backings get new codes. The operation is recorded in the taxonomy audit log.
+// @Tags			Sub Golongan
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string					true	"Sub-group UUID"
+// @Param			request	body	dto.ReqMoveSubGroup		true	"Target group. Fields: groups_id (required, UUID), regenerate_codes (optional)"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespSubGroup}	"Successfully moved sub-group"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, same group or duplicate name in target group"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/sub-group/{id}/move [post]
+func (h *SubGroupHandler) Move(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("id")
+	req := new(dto.ReqMoveSubGroup)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// Get user ID from context
+	user := c.Get("user")
+	userID := ""
+	if user != nil {
+		if userModel, ok := user.(models.User); ok {
+			userID = userModel.ID.String()
+		}
+	}
+
+	res, err := h.Usecase.Move(ctx, id, req, userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespSubGroup(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Merge godoc
+// @Summary		Merge sub-group into another sub-group
+// @Description	Move all active types of a duplicate sub-group into the target sub-group and soft delete the duplicate, in one transaction. Fails when the target already has types with the same names. When regenerate_codes is true the moved types and their backings get new codes. The operation is recorded in the taxonomy audit log.
+// @Tags			Sub Golongan
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string					true	"Duplicate (source) sub-group UUID"
+// @Param			request	body	dto.ReqMergeSubGroup	true	"Target sub-group. Fields: target_id (required, UUID), regenerate_codes (optional)"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespSubGroup}	"Successfully merged, returns the target sub-group"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, merge into itself or conflicting type names"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/sub-group/{id}/merge [post]
+func (h *SubGroupHandler) Merge(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("id")
+	req := new(dto.ReqMergeSubGroup)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// Get user ID from context
+	user := c.Get("user")
+	userID := ""
+	if user != nil {
+		if userModel, ok := user.(models.User); ok {
+			userID = userModel.ID.String()
+		}
+	}
+
+	res, err := h.Usecase.Merge(ctx, id, req, userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespSubGroup(*res))
+	return c.JSON(http.StatusOK, resp)
+}
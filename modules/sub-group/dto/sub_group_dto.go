@@ -17,6 +17,18 @@ type ReqUpdateSubGroup struct {
 	Name    string    `form:"name" json:"name" validate:"required,max=255"`
 }
 
+// ReqMoveSubGroup moves a sub-group with its types to another group
+type ReqMoveSubGroup struct {
+	GroupID         uuid.UUID `form:"groups_id" json:"groups_id" validate:"required"`
+	RegenerateCodes bool      `form:"regenerate_codes" json:"regenerate_codes"` // new codes for the sub-group, its types and their backings
+}
+
+// ReqMergeSubGroup merges a duplicate sub-group into the target, the source is soft-deleted
+type ReqMergeSubGroup struct {
+	TargetID        uuid.UUID `form:"target_id" json:"target_id" validate:"required"`
+	RegenerateCodes bool      `form:"regenerate_codes" json:"regenerate_codes"` // new codes for the moved types and their backings
+}
+
 type RespSubGroup struct {
 	ID           uuid.UUID `json:"id"`
 	GroupID      uuid.UUID `json:"groups_id"`
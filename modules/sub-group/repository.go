@@ -18,4 +18,21 @@ type Repository interface {
 	GetAll(ctx context.Context, filter dto.ReqSubGroupIndexFilter) ([]models.SubGroup, error)
 	ExistsByName(ctx context.Context, goodsGroupID uuid.UUID, name string, excludeID uuid.UUID) (bool, error)
 	ExistsInTypes(ctx context.Context, subGroupID uuid.UUID) (bool, error)
+	GetConflictingTypeNames(ctx context.Context, sourceID uuid.UUID, targetID uuid.UUID) ([]string, error)
+	Move(ctx context.Context, id uuid.UUID, params MoveSubGroupParams) error
+	Merge(ctx context.Context, sourceID uuid.UUID, params MergeSubGroupParams) error
+}
+
+// MoveSubGroupParams re-parents a sub-group (and its types) to another group
+type MoveSubGroupParams struct {
+	GroupID         uuid.UUID
+	RegenerateCodes bool // new codes for the sub-group, its types and their backings
+	MovedBy         string
+}
+
+// MergeSubGroupParams moves the types of a sub-group into TargetID and soft-deletes the source
+type MergeSubGroupParams struct {
+	TargetID        uuid.UUID
+	RegenerateCodes bool // new codes for the moved types and their backings
+	MergedBy        string
 }
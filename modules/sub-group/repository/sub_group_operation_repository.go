@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/codegen"
+	"github.com/rendyfutsuy/base-go/models"
+	subgroup "github.com/rendyfutsuy/base-go/modules/sub-group"
+	"gorm.io/gorm"
+)
+
+// GetConflictingTypeNames returns the names of the active types of sourceID already used in targetID.
+// Soft-deleted types of the target count too, type_in_subgroup is not a partial index.
+func (r *subGroupRepository) GetConflictingTypeNames(ctx context.Context, sourceID uuid.UUID, targetID uuid.UUID) ([]string, error) {
+	var names []string
+	err := r.DB.WithContext(ctx).Table("types t").
+		Where("t.subgroup_id = ? AND t.deleted_at IS NULL", sourceID).
+		Where("EXISTS (SELECT 1 FROM types tt WHERE tt.subgroup_id = ? AND tt.name = t.name)", targetID).
+		Order("t.name").
+		Pluck("t.name", &names).Error
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+func (r *subGroupRepository) Move(ctx context.Context, id uuid.UUID, params subgroup.MoveSubGroupParams) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		current := &models.SubGroup{}
+		if err := tx.Select("id", "groups_id", "subgroup_code").Where("id = ? AND deleted_at IS NULL", id).First(current).Error; err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		updates := map[string]interface{}{
+			"groups_id":  params.GroupID,
+			"updated_at": now,
+			"updated_by": params.MovedBy,
+		}
+		if err := tx.Model(&models.SubGroup{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+			return err
+		}
+		if params.RegenerateCodes {
+			if err := codegen.Recode(tx, constants.CodeEntitySubGroup, []uuid.UUID{id}, params.MovedBy, now); err != nil {
+				return err
+			}
+		}
+
+		typeIDs, err := activeTypeIDs(tx, id)
+		if err != nil {
+			return err
+		}
+		if params.RegenerateCodes {
+			if err := regenerateTypeCodes(tx, typeIDs, params.MovedBy, now); err != nil {
+				return err
+			}
+		}
+
+		var newCode string
+		if err := tx.Model(&models.SubGroup{}).Where("id = ?", id).Pluck("subgroup_code", &newCode).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&models.TaxonomyOperation{
+			Resource:         constants.CatalogTreeLevelSubGroup,
+			Operation:        constants.TaxonomyOperationMove,
+			SourceID:         id,
+			TargetID:         params.GroupID,
+			PreviousParentID: &current.GroupID,
+			PreviousCode:     &current.SubgroupCode,
+			NewCode:          &newCode,
+			RegenerateCodes:  params.RegenerateCodes,
+			AffectedChildren: len(typeIDs),
+			CreatedBy:        params.MovedBy,
+			CreatedAt:        now,
+		}).Error
+	})
+}
+
+func (r *subGroupRepository) Merge(ctx context.Context, sourceID uuid.UUID, params subgroup.MergeSubGroupParams) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		source := &models.SubGroup{}
+		if err := tx.Select("id", "groups_id", "subgroup_code").Where("id = ? AND deleted_at IS NULL", sourceID).First(source).Error; err != nil {
+			return err
+		}
+
+		typeIDs, err := activeTypeIDs(tx, sourceID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		if len(typeIDs) > 0 {
+			err := tx.Model(&models.Type{}).Where("id IN (?)", typeIDs).Updates(map[string]interface{}{
+				"subgroup_id": params.TargetID,
+				"updated_at":  now,
+				"updated_by":  params.MergedBy,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+		if params.RegenerateCodes {
+			if err := regenerateTypeCodes(tx, typeIDs, params.MergedBy, now); err != nil {
+				return err
+			}
+		}
+
+		err = tx.Model(&models.SubGroup{}).Where("id = ?", sourceID).Updates(map[string]interface{}{
+			"deleted_at": now,
+			"deleted_by": params.MergedBy,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&models.TaxonomyOperation{
+			Resource:         constants.CatalogTreeLevelSubGroup,
+			Operation:        constants.TaxonomyOperationMerge,
+			SourceID:         sourceID,
+			TargetID:         params.TargetID,
+			PreviousParentID: &source.GroupID,
+			PreviousCode:     &source.SubgroupCode,
+			RegenerateCodes:  params.RegenerateCodes,
+			AffectedChildren: len(typeIDs),
+			CreatedBy:        params.MergedBy,
+			CreatedAt:        now,
+		}).Error
+	})
+}
+
+// activeTypeIDs returns the ids of the active types of a sub-group
+func activeTypeIDs(tx *gorm.DB, subGroupID uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := tx.Model(&models.Type{}).Where("subgroup_id = ? AND deleted_at IS NULL", subGroupID).Order("created_at").Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// regenerateTypeCodes gives the types and their active backings new codes from the type / backing code policies
+func regenerateTypeCodes(tx *gorm.DB, typeIDs []uuid.UUID, updatedBy string, now time.Time) error {
+	if len(typeIDs) == 0 {
+		return nil
+	}
+	if err := codegen.Recode(tx, constants.CodeEntityType, typeIDs, updatedBy, now); err != nil {
+		return err
+	}
+
+	var backingIDs []uuid.UUID
+	if err := tx.Model(&models.Backing{}).Where("type_id IN (?) AND deleted_at IS NULL", typeIDs).Order("created_at").Pluck("id", &backingIDs).Error; err != nil {
+		return err
+	}
+	return codegen.Recode(tx, constants.CodeEntityBacking, backingIDs, updatedBy, now)
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/codegen"
+	"github.com/rendyfutsuy/base-go/helpers/i18n"
 	"github.com/rendyfutsuy/base-go/helpers/request"
 	"github.com/rendyfutsuy/base-go/models"
 	"github.com/rendyfutsuy/base-go/modules/sub-group/dto"
@@ -34,19 +36,23 @@ func (r *subGroupRepository) Create(ctx context.Context, goodsGroupID uuid.UUID,
 		UpdatedAt: now,
 		UpdatedBy: createdBy,
 	}
-	// Omit subgroup_code to let database trigger generate it with formatted 2-digit code
-	// Trigger will automatically generate code like "01", "02", etc. based on sequence
-	if err := r.DB.WithContext(ctx).Omit("subgroup_code").Create(sg).Error; err != nil {
+	// subgroup_code is allocated from the sub-group code policy in the same transaction
+	// (the database trigger only fills it when left empty)
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		code, err := codegen.Allocate(tx, constants.CodeEntitySubGroup, &goodsGroupID)
+		if err != nil {
+			return err
+		}
+		sg.SubgroupCode = code
+		return tx.Create(sg).Error
+	})
+	if err != nil {
 		return nil, err
 	}
 	// if sg not update, return error
 	if sg.ID == uuid.Nil {
 		return nil, errors.New(constants.SubGroupCreateFailedIDNotSet)
 	}
-	// Reload to get the generated subgroup_code from database (generated by trigger)
-	if err := r.DB.WithContext(ctx).Select("id", "groups_id", "subgroup_code", "name", "created_at", "created_by", "updated_at", "updated_by").Where("id = ?", sg.ID).First(sg).Error; err != nil {
-		return nil, err
-	}
 	return sg, nil
 }
 
@@ -85,12 +91,12 @@ func (r *subGroupRepository) GetByID(ctx context.Context, id uuid.UUID) (*models
 			sg.id,
 			sg.groups_id,
 			sg.subgroup_code,
-			sg.name,
+			`+i18n.NameColumn(ctx, constants.TranslationEntitySubGroup, "sg.id", "sg.name")+` AS name,
 			sg.created_at,
 			sg.created_by,
 			sg.updated_at,
 			sg.updated_by,
-			gg.name as groups_name,
+			`+i18n.NameColumn(ctx, constants.TranslationEntityGroup, "gg.id", "gg.name")+` AS groups_name,
 			NOT EXISTS (
 				SELECT 1 FROM types t
 				WHERE t.subgroup_id = sg.id AND t.deleted_at IS NULL
@@ -141,10 +147,10 @@ func (r *subGroupRepository) GetIndex(ctx context.Context, req request.PageReque
 			sg.id,
 			sg.groups_id,
 			sg.subgroup_code,
-			sg.name,
+			` + i18n.NameColumn(ctx, constants.TranslationEntitySubGroup, "sg.id", "sg.name") + ` AS name,
 			sg.created_at,
 			sg.updated_at,
-			gg.name as groups_name,
+			` + i18n.NameColumn(ctx, constants.TranslationEntityGroup, "gg.id", "gg.name") + ` AS groups_name,
 			NOT EXISTS (
 				SELECT 1 FROM types t
 				WHERE t.subgroup_id = sg.id AND t.deleted_at IS NULL
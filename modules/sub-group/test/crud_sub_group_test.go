@@ -3,6 +3,7 @@ package test
 import (
 	"context"
 	"errors"
+	subgroup "github.com/rendyfutsuy/base-go/modules/sub-group"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -14,6 +15,7 @@ import (
 	"github.com/rendyfutsuy/base-go/constants"
 	"github.com/rendyfutsuy/base-go/helpers/request"
 	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/group"
 	groupDto "github.com/rendyfutsuy/base-go/modules/group/dto"
 	subGroupDto "github.com/rendyfutsuy/base-go/modules/sub-group/dto"
 	"github.com/rendyfutsuy/base-go/modules/sub-group/usecase"
@@ -141,6 +143,32 @@ func (m *MockGroupRepository) ExistsInSubGroups(ctx context.Context, groupID uui
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockGroupRepository) GetCatalogTree(ctx context.Context, filter groupDto.ReqCatalogTreeFilter) ([]group.CatalogTreeRow, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]group.CatalogTreeRow), args.Error(1)
+}
+
+func (m *MockSubGroupRepository) GetConflictingTypeNames(ctx context.Context, sourceID uuid.UUID, targetID uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, sourceID, targetID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockSubGroupRepository) Move(ctx context.Context, id uuid.UUID, params subgroup.MoveSubGroupParams) error {
+	args := m.Called(ctx, id, params)
+	return args.Error(0)
+}
+
+func (m *MockSubGroupRepository) Merge(ctx context.Context, sourceID uuid.UUID, params subgroup.MergeSubGroupParams) error {
+	args := m.Called(ctx, sourceID, params)
+	return args.Error(0)
+}
+
 func TestCreateSubGroup(t *testing.T) {
 	e := echo.New()
 	ctx := context.Background()
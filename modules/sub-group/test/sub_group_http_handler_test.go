@@ -3,6 +3,7 @@ package test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -85,6 +86,22 @@ func (m *mockSubGroupUsecase) ExistsInTypes(ctx context.Context, subGroupID stri
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *mockSubGroupUsecase) Move(ctx context.Context, id string, req *dto.ReqMoveSubGroup, authId string) (*models.SubGroup, error) {
+	args := m.Called(ctx, id, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.SubGroup), args.Error(1)
+}
+
+func (m *mockSubGroupUsecase) Merge(ctx context.Context, id string, req *dto.ReqMergeSubGroup, authId string) (*models.SubGroup, error) {
+	args := m.Called(ctx, id, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.SubGroup), args.Error(1)
+}
+
 func newSubGroupEcho() *echo.Echo {
 	e := echo.New()
 	v := validator.New()
@@ -266,3 +283,29 @@ func TestSubGroupHandler_ExportValidateError(t *testing.T) {
 	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
 	assert.NotEmpty(t, resp.Message)
 }
+
+func TestSubGroupHandler_MergeConflict(t *testing.T) {
+	e := newSubGroupEcho()
+	subGroupID := uuid.New().String()
+	targetID := uuid.New()
+	body := fmt.Sprintf(`{"target_id":"%s"}`, targetID)
+	req := httptest.NewRequest(http.MethodPost, "/v1/sub-group/"+subGroupID+"/merge", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(subGroupID)
+
+	mockUC := new(mockSubGroupUsecase)
+	handler := &subgroupHttp.SubGroupHandler{Usecase: mockUC}
+
+	conflict := fmt.Sprintf(constants.SubGroupMergeNameConflict, "POLOS")
+	mockUC.On("Merge", mock.Anything, subGroupID, &dto.ReqMergeSubGroup{TargetID: targetID}, "").
+		Return(nil, errors.New(conflict)).Once()
+
+	err := handler.Merge(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), conflict)
+	mockUC.AssertExpectations(t)
+}
@@ -0,0 +1,159 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	subgroup "github.com/rendyfutsuy/base-go/modules/sub-group"
+	subGroupDto "github.com/rendyfutsuy/base-go/modules/sub-group/dto"
+	"github.com/rendyfutsuy/base-go/modules/sub-group/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestMoveSubGroup(t *testing.T) {
+	ctx := context.Background()
+	subGroupID := uuid.New()
+	fromGroupID := uuid.New()
+	toGroupID := uuid.New()
+	current := &models.SubGroup{ID: subGroupID, GroupID: fromGroupID, SubgroupCode: "01", Name: "KATUN"}
+
+	tests := []struct {
+		name          string
+		req           *subGroupDto.ReqMoveSubGroup
+		setupMock     func(*MockSubGroupRepository, *MockGroupRepository)
+		expectedError string
+	}{
+		{
+			name: "success move with regenerated codes",
+			req:  &subGroupDto.ReqMoveSubGroup{GroupID: toGroupID, RegenerateCodes: true},
+			setupMock: func(m *MockSubGroupRepository, mg *MockGroupRepository) {
+				m.On("GetByID", ctx, subGroupID).Return(current, nil).Once()
+				mg.On("GetByID", ctx, toGroupID).Return(&models.Group{ID: toGroupID}, nil).Once()
+				m.On("ExistsByName", ctx, toGroupID, "KATUN", subGroupID).Return(false, nil).Once()
+				m.On("Move", ctx, subGroupID, subgroup.MoveSubGroupParams{GroupID: toGroupID, RegenerateCodes: true, MovedBy: "test-auth-id"}).Return(nil).Once()
+				m.On("GetByID", ctx, subGroupID).Return(&models.SubGroup{ID: subGroupID, GroupID: toGroupID, SubgroupCode: "07", Name: "KATUN"}, nil).Once()
+			},
+		},
+		{
+			name: "error when already in target group",
+			req:  &subGroupDto.ReqMoveSubGroup{GroupID: fromGroupID},
+			setupMock: func(m *MockSubGroupRepository, mg *MockGroupRepository) {
+				m.On("GetByID", ctx, subGroupID).Return(current, nil).Once()
+			},
+			expectedError: constants.SubGroupMoveSameGroup,
+		},
+		{
+			name: "error when target group not found",
+			req:  &subGroupDto.ReqMoveSubGroup{GroupID: toGroupID},
+			setupMock: func(m *MockSubGroupRepository, mg *MockGroupRepository) {
+				m.On("GetByID", ctx, subGroupID).Return(current, nil).Once()
+				mg.On("GetByID", ctx, toGroupID).Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: constants.SubGroupGroupNotFound,
+		},
+		{
+			name: "error when name already used in target group",
+			req:  &subGroupDto.ReqMoveSubGroup{GroupID: toGroupID},
+			setupMock: func(m *MockSubGroupRepository, mg *MockGroupRepository) {
+				m.On("GetByID", ctx, subGroupID).Return(current, nil).Once()
+				mg.On("GetByID", ctx, toGroupID).Return(&models.Group{ID: toGroupID}, nil).Once()
+				m.On("ExistsByName", ctx, toGroupID, "KATUN", subGroupID).Return(true, nil).Once()
+			},
+			expectedError: constants.SubGroupNameAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockSubGroupRepository)
+			mockGroupRepo := new(MockGroupRepository)
+			tt.setupMock(mockRepo, mockGroupRepo)
+
+			result, err := usecase.NewSubGroupUsecase(mockRepo, mockGroupRepo).Move(ctx, subGroupID.String(), tt.req, "test-auth-id")
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, toGroupID, result.GroupID)
+				assert.Equal(t, "07", result.SubgroupCode)
+			}
+			mockRepo.AssertExpectations(t)
+			mockGroupRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMergeSubGroup(t *testing.T) {
+	ctx := context.Background()
+	sourceID := uuid.New()
+	targetID := uuid.New()
+
+	tests := []struct {
+		name          string
+		targetID      uuid.UUID
+		setupMock     func(*MockSubGroupRepository)
+		expectedError string
+	}{
+		{
+			name:     "success merge",
+			targetID: targetID,
+			setupMock: func(m *MockSubGroupRepository) {
+				m.On("GetByID", ctx, sourceID).Return(&models.SubGroup{ID: sourceID}, nil).Once()
+				m.On("GetByID", ctx, targetID).Return(&models.SubGroup{ID: targetID}, nil).Once()
+				m.On("GetConflictingTypeNames", ctx, sourceID, targetID).Return([]string{}, nil).Once()
+				m.On("Merge", ctx, sourceID, subgroup.MergeSubGroupParams{TargetID: targetID, MergedBy: "test-auth-id"}).Return(nil).Once()
+				m.On("GetByID", ctx, targetID).Return(&models.SubGroup{ID: targetID, Name: "KATUN"}, nil).Once()
+			},
+		},
+		{
+			name:          "error when merged into itself",
+			targetID:      sourceID,
+			setupMock:     func(m *MockSubGroupRepository) {},
+			expectedError: constants.SubGroupMergeIntoItself,
+		},
+		{
+			name:     "error when target not found",
+			targetID: targetID,
+			setupMock: func(m *MockSubGroupRepository) {
+				m.On("GetByID", ctx, sourceID).Return(&models.SubGroup{ID: sourceID}, nil).Once()
+				m.On("GetByID", ctx, targetID).Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: fmt.Sprintf(constants.SubGroupNotFound, targetID),
+		},
+		{
+			name:     "error when type names conflict",
+			targetID: targetID,
+			setupMock: func(m *MockSubGroupRepository) {
+				m.On("GetByID", ctx, sourceID).Return(&models.SubGroup{ID: sourceID}, nil).Once()
+				m.On("GetByID", ctx, targetID).Return(&models.SubGroup{ID: targetID}, nil).Once()
+				m.On("GetConflictingTypeNames", ctx, sourceID, targetID).Return([]string{"MOTIF", "POLOS"}, nil).Once()
+			},
+			expectedError: fmt.Sprintf(constants.SubGroupMergeNameConflict, "MOTIF, POLOS"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockSubGroupRepository)
+			tt.setupMock(mockRepo)
+
+			req := &subGroupDto.ReqMergeSubGroup{TargetID: tt.targetID}
+			result, err := usecase.NewSubGroupUsecase(mockRepo, new(MockGroupRepository)).Merge(ctx, sourceID.String(), req, "test-auth-id")
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, targetID, result.ID)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
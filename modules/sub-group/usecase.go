@@ -17,4 +17,6 @@ type Usecase interface {
 	GetAll(ctx context.Context, filter dto.ReqSubGroupIndexFilter) ([]models.SubGroup, error)
 	Export(ctx context.Context, filter dto.ReqSubGroupIndexFilter) ([]byte, error)
 	ExistsInTypes(ctx context.Context, subGroupID string) (bool, error)
+	Move(ctx context.Context, id string, req *dto.ReqMoveSubGroup, authId string) (*models.SubGroup, error)
+	Merge(ctx context.Context, id string, req *dto.ReqMergeSubGroup, authId string) (*models.SubGroup, error)
 }
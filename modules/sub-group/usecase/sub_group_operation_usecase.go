@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/sub-group"
+	"github.com/rendyfutsuy/base-go/modules/sub-group/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"gorm.io/gorm"
+)
+
+// Move re-parents a sub-group to another group, its types follow it
+func (u *subGroupUsecase) Move(ctx context.Context, id string, reqBody *dto.ReqMoveSubGroup, userID string) (*models.SubGroup, error) {
+	sgid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := u.repo.GetByID(ctx, sgid)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.SubGroupNotFound, id)
+		}
+		return nil, err
+	}
+	if current.GroupID == reqBody.GroupID {
+		return nil, errors.New(constants.SubGroupMoveSameGroup)
+	}
+
+	if _, err := u.groupRepo.GetByID(ctx, reqBody.GroupID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New(constants.SubGroupGroupNotFound)
+		}
+		return nil, err
+	}
+
+	exists, err := u.repo.ExistsByName(ctx, reqBody.GroupID, current.Name, sgid)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New(constants.SubGroupNameAlreadyExists)
+	}
+
+	if err := u.repo.Move(ctx, sgid, mod.MoveSubGroupParams{
+		GroupID:         reqBody.GroupID,
+		RegenerateCodes: reqBody.RegenerateCodes,
+		MovedBy:         userID,
+	}); err != nil {
+		return nil, err
+	}
+	return u.repo.GetByID(ctx, sgid)
+}
+
+// Merge moves the types of a duplicate sub-group into the target and soft-deletes the duplicate
+func (u *subGroupUsecase) Merge(ctx context.Context, id string, reqBody *dto.ReqMergeSubGroup, userID string) (*models.SubGroup, error) {
+	sgid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	if sgid == reqBody.TargetID {
+		return nil, errors.New(constants.SubGroupMergeIntoItself)
+	}
+
+	for _, checkID := range []uuid.UUID{sgid, reqBody.TargetID} {
+		if _, err := u.repo.GetByID(ctx, checkID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, fmt.Errorf(constants.SubGroupNotFound, checkID)
+			}
+			return nil, err
+		}
+	}
+
+	conflicts, err := u.repo.GetConflictingTypeNames(ctx, sgid, reqBody.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if len(conflicts) > 0 {
+		return nil, fmt.Errorf(constants.SubGroupMergeNameConflict, strings.Join(conflicts, ", "))
+	}
+
+	if err := u.repo.Merge(ctx, sgid, mod.MergeSubGroupParams{
+		TargetID:        reqBody.TargetID,
+		RegenerateCodes: reqBody.RegenerateCodes,
+		MergedBy:        userID,
+	}); err != nil {
+		return nil, err
+	}
+	return u.repo.GetByID(ctx, reqBody.TargetID)
+}
@@ -0,0 +1,360 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/middleware"
+	_reqContext "github.com/rendyfutsuy/base-go/helpers/middleware/request"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/supplier"
+	"github.com/rendyfutsuy/base-go/modules/supplier/dto"
+)
+
+type ResponseError struct {
+	Message string `json:"message"`
+}
+
+type Response struct {
+	Message string `json:"message"`
+}
+
+type SupplierHandler struct {
+	Usecase              supplier.Usecase
+	validator            *validator.Validate
+	mwPageRequest        _reqContext.IMiddlewarePageRequest
+	middlewareAuth       middleware.IMiddlewareAuth
+	middlewarePermission middleware.IMiddlewarePermission
+}
+
+func NewSupplierHandler(e *echo.Echo, uc supplier.Usecase, mwP _reqContext.IMiddlewarePageRequest, auth middleware.IMiddlewareAuth, middlewarePermission middleware.IMiddlewarePermission) {
+	h := &SupplierHandler{Usecase: uc, validator: validator.New(), mwPageRequest: mwP, middlewareAuth: auth, middlewarePermission: middlewarePermission}
+
+	r := e.Group("/v1/supplier")
+	r.Use(h.middlewareAuth.AuthorizationCheck)
+
+	// Permissions
+	// View:   supplier.view
+	// Create: supplier.create
+	// Update: supplier.update
+	// Delete: supplier.delete
+	// Export: supplier.export
+	permissionToView := []string{"supplier.view"}
+	permissionToCreate := []string{"supplier.create"}
+	permissionToUpdate := []string{"supplier.update"}
+	permissionToDelete := []string{"supplier.delete"}
+	permissionToExport := []string{"supplier.export"}
+
+	// Index with pagination + search
+	r.GET("", h.GetIndex, middleware.RequireActivatedUser, h.mwPageRequest.PageRequestCtx, h.middlewarePermission.PermissionValidation(permissionToView))
+
+	// Export (no pagination, same filters) - must be before /:id to avoid route conflict
+	r.GET("/export", h.Export, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToExport))
+
+	// Get by ID (detail) - must be after /export to avoid route conflict
+	r.GET("/:id", h.GetByID, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+
+	// Create
+	r.POST("", h.Create, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToCreate))
+
+	// Update
+	r.PUT("/:id", h.Update, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+
+	// Delete
+	r.DELETE("/:id", h.Delete, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToDelete))
+}
+
+// Create godoc
+// @Summary		Create a new supplier
+// @Description	Create a new supplier with provided information. Accepts JSON body with telp_numbers (array of objects where area_code optional and phone_number required, contoh: [{&quot;area_code&quot;:&quot;022&quot;,&quot;phone_number&quot;:&quot;1112223355&quot;}, {&quot;area_code&quot;:&quot;022&quot;,&quot;phone_number&quot;:&quot;1112223366&quot;}]) and phone_numbers arrays. First index of telp_numbers array will automatically become primary telp, and first index of phone_numbers array will automatically become primary hp. Address regions must follow the province > city > district > subdistrict hierarchy and expedition_arrives_id is the default expedition for goods from this supplier. Supplier code is automatically generated by the system. Requires 'api.master-data.supplier.create' permission.
+// @Tags			Supplier
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	body		dto.ReqCreateSupplier	true	"Supplier creation data. Fields: supplier_name (required), address (max 255 chars), province_id, city_id, district_id, subdistrict_id, expedition_arrives_id (optional), telp_numbers (array, first index becomes primary telp), phone_numbers (array, first index becomes primary hp), notes (optional)"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespSupplier}	"Successfully created supplier with full details including contacts"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, duplicate supplier name or invalid address region/expedition"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/supplier [post]
+func (h *SupplierHandler) Create(c echo.Context) error {
+	req := new(dto.ReqCreateSupplier)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := bindSupplierTelpNumbersFromForm(c, &req.TelpNumbers); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	user := c.Get("user")
+	userID := ""
+	if user != nil {
+		if userModel, ok := user.(models.User); ok {
+			userID = userModel.ID.String()
+		}
+	}
+	res, err := h.Usecase.Create(c.Request().Context(), req, userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	// Get contacts for this supplier
+	contacts, err := h.Usecase.GetContactsBySupplierID(c.Request().Context(), res.ID.String())
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespSupplier(*res, contacts))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Update godoc
+// @Summary		Update supplier
+// @Description	Update an existing supplier's information. Accepts JSON body with telp_numbers (array of objects where area_code optional and phone_number required, contoh: [{&quot;area_code&quot;:&quot;022&quot;,&quot;phone_number&quot;:&quot;1112223355&quot;}, {&quot;area_code&quot;:&quot;022&quot;,&quot;phone_number&quot;:&quot;1112223366&quot;}]) and phone_numbers arrays. First index of telp_numbers array will automatically become primary telp, and first index of phone_numbers array will automatically become primary hp. Address regions must follow the province > city > district > subdistrict hierarchy. Existing contacts will be hard deleted before new ones are created. The response includes full supplier details with all contacts. Requires 'api.master-data.supplier.update' permission.
+// @Tags			Supplier
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string					true	"Supplier UUID"
+// @Param			request	body	dto.ReqUpdateSupplier	true	"Updated supplier data. Fields: supplier_name (required), address (max 255 chars), province_id, city_id, district_id, subdistrict_id, expedition_arrives_id (optional), telp_numbers (array, first index becomes primary telp), phone_numbers (array, first index becomes primary hp), notes (optional)"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespSupplier}	"Successfully updated supplier with full details including contacts"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, duplicate supplier name or invalid address region/expedition"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Failure		404		{object}	response.NonPaginationResponse	"Supplier not found"
+// @Router			/v1/supplier/{id} [put]
+func (h *SupplierHandler) Update(c echo.Context) error {
+	id := c.Param("id")
+	req := new(dto.ReqUpdateSupplier)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := bindSupplierTelpNumbersFromForm(c, &req.TelpNumbers); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	user := c.Get("user")
+	userID := ""
+	if user != nil {
+		if userModel, ok := user.(models.User); ok {
+			userID = userModel.ID.String()
+		}
+	}
+	_, err := h.Usecase.Update(c.Request().Context(), id, req, userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	// Get supplier detail for response
+	res, err := h.Usecase.GetByID(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	// Get contacts for this supplier
+	contacts, err := h.Usecase.GetContactsBySupplierID(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespSupplier(*res, contacts))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Delete godoc
+// @Summary		Soft delete supplier
+// @Description	Soft delete an existing supplier by ID. The supplier will be marked as deleted (deleted_at is set) but remains in the database. Requires 'api.master-data.supplier.delete' permission.
+// @Tags			Supplier
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string	true	"Supplier UUID"
+// @Success		200		{object}	response.NonPaginationResponse	"Successfully soft deleted supplier"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid UUID"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Failure		404		{object}	response.NonPaginationResponse	"Supplier not found"
+// @Router			/v1/supplier/{id} [delete]
+func (h *SupplierHandler) Delete(c echo.Context) error {
+	id := c.Param("id")
+	user := c.Get("user")
+	userID := ""
+	if user != nil {
+		if userModel, ok := user.(models.User); ok {
+			userID = userModel.ID.String()
+		}
+	}
+	if err := h.Usecase.Delete(c.Request().Context(), id, userID); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(Response{Message: constants.SupplierDeleteSuccess})
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetIndex godoc
+// @Summary		Get list of suppliers with pagination
+// @Description	Retrieve a paginated list of suppliers with optional search and filters. Supports multiple filter values for each field. Only returns non-deleted suppliers. Requires 'api.master-data.supplier.view' permission.
+// @Tags			Supplier
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			page			query		int							false	"Page number (default: 1)"
+// @Param			per_page		query		int							false	"Items per page (default: 10)"
+// @Param			sort_by			query		string						false	"Sort column (allowed: id, supplier_code, supplier_name, address, city_name, expedition_arrives_name, phone_number, telp_number, created_at, updated_at)"
+// @Param			sort_order		query		string						false	"Sort order: asc or desc (default: desc)"
+// @Param			search			query		string						false	"Search keyword (searches in supplier_name, supplier_code, address, city name, expedition name and phone numbers from contacts)"
+// @Param			supplier_codes	query		[]string					false	"Filter by supplier codes (multiple values)"
+// @Param			supplier_names	query		[]string					false	"Filter by supplier names (multiple values)"
+// @Param			province_ids	query		[]string					false	"Filter by province IDs (multiple values)"
+// @Param			city_ids		query		[]string					false	"Filter by city IDs (multiple values)"
+// @Param			expedition_arrives_ids	query		[]string			false	"Filter by default expedition IDs (multiple values)"
+// @Param			addresses		query		[]string					false	"Filter by addresses (multiple values)"
+// @Param			telp_numbers	query		[]string					false	"Filter by telp numbers (multiple values)"
+// @Param			phone_numbers	query		[]string					false	"Filter by phone numbers (multiple values)"
+// @Success		200				{object}	response.PaginationResponse{data=[]dto.RespSupplierIndex}	"Successfully retrieved suppliers"
+// @Failure		400				{object}	response.NonPaginationResponse	"Bad request - invalid query parameters"
+// @Failure		401				{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403				{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/supplier [get]
+func (h *SupplierHandler) GetIndex(c echo.Context) error {
+	pageRequest := c.Get("page_request").(*request.PageRequest)
+
+	// validate filter req.
+	// initialize filter
+	filter := new(dto.ReqSupplierIndexFilter)
+
+	// Bind form-data to the DTO
+	if err := c.Bind(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// Validate the request if necessary
+	if err := c.Validate(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, total, err := h.Usecase.GetIndex(c.Request().Context(), *pageRequest, *filter)
+
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	respSupplier := []dto.RespSupplierIndex{}
+
+	for _, v := range res {
+		respSupplier = append(respSupplier, dto.ToRespSupplierIndex(v))
+	}
+
+	respPag := response.PaginationResponse{}
+	respPag, err = respPag.SetResponse(respSupplier, total, pageRequest.PerPage, pageRequest.Page)
+
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, respPag)
+}
+
+// GetByID godoc
+// @Summary		Get supplier by ID
+// @Description	Retrieve a single supplier by its UUID. The response includes full supplier details with all contacts (telp_numbers and phone_numbers arrays). Only returns non-deleted suppliers. Requires 'api.master-data.supplier.view' permission.
+// @Tags			Supplier
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string	true	"Supplier UUID"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespSupplier}	"Successfully retrieved supplier with full details including contacts"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid UUID"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Failure		404		{object}	response.NonPaginationResponse	"Supplier not found"
+// @Router			/v1/supplier/{id} [get]
+func (h *SupplierHandler) GetByID(c echo.Context) error {
+	id := c.Param("id")
+	res, err := h.Usecase.GetByID(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	// Get contacts for this supplier
+	contacts, err := h.Usecase.GetContactsBySupplierID(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespSupplier(*res, contacts))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Export godoc
+// @Summary		Export suppliers to Excel
+// @Description	Export suppliers to Excel file (.xlsx) with optional search and filter. Same search and filter logic as index but without pagination. Supports multiple filter values for each field. Only exports non-deleted suppliers. Excel file includes: Kode Supplier, Nama Supplier, Alamat, Provinsi, Kota, Kecamatan, Kelurahan, Ekspedisi, No HP, No Telp, Update Date. Requires 'api.master-data.supplier.export' permission.
+// @Tags			Supplier
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Param			search			query		string						false	"Search keyword (searches in supplier_name, supplier_code, address, city name, expedition name and phone numbers from contacts)"
+// @Param			supplier_codes	query		[]string					false	"Filter by supplier codes (multiple values)"
+// @Param			supplier_names	query		[]string					false	"Filter by supplier names (multiple values)"
+// @Param			province_ids	query		[]string					false	"Filter by province IDs (multiple values)"
+// @Param			city_ids		query		[]string					false	"Filter by city IDs (multiple values)"
+// @Param			expedition_arrives_ids	query		[]string			false	"Filter by default expedition IDs (multiple values)"
+// @Param			addresses		query		[]string					false	"Filter by addresses (multiple values)"
+// @Param			telp_numbers	query		[]string					false	"Filter by telp numbers (multiple values)"
+// @Param			phone_numbers	query		[]string					false	"Filter by phone numbers (multiple values)"
+// @Success		200				{file}		binary	"Excel file (suppliers.xlsx) with suppliers data"
+// @Failure		400				{object}	response.NonPaginationResponse	"Bad request - invalid query parameters"
+// @Failure		401				{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403				{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/supplier/export [get]
+func (h *SupplierHandler) Export(c echo.Context) error {
+	// validate filter req.
+	// initialize filter
+	filter := new(dto.ReqSupplierIndexFilter)
+
+	// Bind form-data to the DTO
+	if err := c.Bind(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// Validate the request if necessary
+	if err := c.Validate(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	excelBytes, err := h.Usecase.Export(c.Request().Context(), *filter)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, constants.ExcelContent)
+	c.Response().Header().Set(constants.FieldContentDisposition, constants.ExcelContentDisposition("suppliers.xlsx"))
+	return c.Blob(http.StatusOK, constants.ExcelContent, excelBytes)
+}
+
+func bindSupplierTelpNumbersFromForm(c echo.Context, telpNumbers *[]dto.TelpNumberItem) error {
+	raw := strings.TrimSpace(c.FormValue("telp_numbers"))
+	if raw == "" {
+		return nil
+	}
+
+	var parsed []dto.TelpNumberItem
+	if err := json.Unmarshal([]byte(raw), &parsed); err != nil {
+		return fmt.Errorf("invalid telp_numbers format: %w", err)
+	}
+
+	*telpNumbers = parsed
+	return nil
+}
@@ -0,0 +1,186 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+)
+
+// TelpNumberItem represents telp number with area code
+type TelpNumberItem struct {
+	AreaCode    *string `json:"area_code" form:"area_code"`
+	PhoneNumber string  `json:"phone_number" form:"phone_number" validate:"required"`
+}
+
+// SupplierRegency holds the regency hierarchy of a supplier address
+type SupplierRegency struct {
+	ProvinceID    *uuid.UUID
+	CityID        *uuid.UUID
+	DistrictID    *uuid.UUID
+	SubdistrictID *uuid.UUID
+}
+
+type ReqCreateSupplier struct {
+	SupplierName        string           `form:"supplier_name" json:"supplier_name" validate:"required,max=255"`
+	Address             string           `form:"address" json:"address" validate:"max=255"`
+	ProvinceID          *uuid.UUID       `form:"province_id" json:"province_id"`
+	CityID              *uuid.UUID       `form:"city_id" json:"city_id"`
+	DistrictID          *uuid.UUID       `form:"district_id" json:"district_id"`
+	SubdistrictID       *uuid.UUID       `form:"subdistrict_id" json:"subdistrict_id"`
+	ExpeditionArrivesID *uuid.UUID       `form:"expedition_arrives_id" json:"expedition_arrives_id"`
+	TelpNumbers         []TelpNumberItem `form:"-" json:"telp_numbers" validate:"omitempty"`
+	PhoneNumbers        []string         `form:"phone_numbers" json:"phone_numbers" validate:"omitempty"`
+	Notes               *string          `form:"notes" json:"notes,omitempty"`
+}
+
+func (r ReqCreateSupplier) Regency() SupplierRegency {
+	return SupplierRegency{ProvinceID: r.ProvinceID, CityID: r.CityID, DistrictID: r.DistrictID, SubdistrictID: r.SubdistrictID}
+}
+
+type ReqUpdateSupplier struct {
+	SupplierName        string           `form:"supplier_name" json:"supplier_name" validate:"required,max=255"`
+	Address             string           `form:"address" json:"address" validate:"max=255"`
+	ProvinceID          *uuid.UUID       `form:"province_id" json:"province_id"`
+	CityID              *uuid.UUID       `form:"city_id" json:"city_id"`
+	DistrictID          *uuid.UUID       `form:"district_id" json:"district_id"`
+	SubdistrictID       *uuid.UUID       `form:"subdistrict_id" json:"subdistrict_id"`
+	ExpeditionArrivesID *uuid.UUID       `form:"expedition_arrives_id" json:"expedition_arrives_id"`
+	TelpNumbers         []TelpNumberItem `form:"-" json:"telp_numbers"`
+	PhoneNumbers        []string         `form:"phone_numbers" json:"phone_numbers"`
+	Notes               *string          `form:"notes" json:"notes,omitempty"`
+}
+
+func (r ReqUpdateSupplier) Regency() SupplierRegency {
+	return SupplierRegency{ProvinceID: r.ProvinceID, CityID: r.CityID, DistrictID: r.DistrictID, SubdistrictID: r.SubdistrictID}
+}
+
+// RespSupplierRegion represents a region of the supplier address
+type RespSupplierRegion struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+func toRespSupplierRegion(id *uuid.UUID, name *string) *RespSupplierRegion {
+	if id == nil {
+		return nil
+	}
+	region := &RespSupplierRegion{ID: *id}
+	if name != nil {
+		region.Name = *name
+	}
+	return region
+}
+
+type RespSupplier struct {
+	ID                uuid.UUID           `json:"id"`
+	SupplierCode      string              `json:"supplier_code"`
+	SupplierName      string              `json:"supplier_name"`
+	Address           string              `json:"address"`
+	Province          *RespSupplierRegion `json:"province"`
+	City              *RespSupplierRegion `json:"city"`
+	District          *RespSupplierRegion `json:"district"`
+	Subdistrict       *RespSupplierRegion `json:"subdistrict"`
+	ExpeditionArrives *RespSupplierRegion `json:"expedition_arrives"`
+	TelpNumbers       []TelpNumberItem    `json:"telp_numbers"`
+	PhoneNumbers      []string            `json:"phone_numbers"`
+	Notes             *string             `json:"notes,omitempty"`
+	CreatedAt         string              `json:"created_at"`
+	CreatedBy         string              `json:"created_by"`
+	UpdatedAt         string              `json:"updated_at"`
+	UpdatedBy         string              `json:"updated_by"`
+}
+
+func ToRespSupplier(m models.Supplier, contacts []models.SupplierContact) RespSupplier {
+	// Map contacts to response
+	telpNumbers := []TelpNumberItem{}
+	phoneNumbers := []string{}
+	for _, contact := range contacts {
+		if contact.PhoneType == constants.SupplierContactTypeTelp {
+			telpNumbers = append(telpNumbers, TelpNumberItem{
+				AreaCode:    contact.AreaCode,
+				PhoneNumber: contact.PhoneNumber,
+			})
+		} else {
+			phoneNumbers = append(phoneNumbers, contact.PhoneNumber)
+		}
+	}
+
+	return RespSupplier{
+		ID:                m.ID,
+		SupplierCode:      m.SupplierCode,
+		SupplierName:      m.SupplierName,
+		Address:           m.Address,
+		Province:          toRespSupplierRegion(m.ProvinceID, m.ProvinceName),
+		City:              toRespSupplierRegion(m.CityID, m.CityName),
+		District:          toRespSupplierRegion(m.DistrictID, m.DistrictName),
+		Subdistrict:       toRespSupplierRegion(m.SubdistrictID, m.SubdistrictName),
+		ExpeditionArrives: toRespSupplierRegion(m.ExpeditionArrivesID, m.ExpeditionArrivesName),
+		TelpNumbers:       telpNumbers,
+		PhoneNumbers:      phoneNumbers,
+		Notes:             m.Notes,
+		CreatedAt:         m.CreatedAt.Format("2006-01-02 15:04:05"),
+		CreatedBy:         m.CreatedBy,
+		UpdatedAt:         m.UpdatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedBy:         m.UpdatedBy,
+	}
+}
+
+type RespSupplierIndex struct {
+	ID                    uuid.UUID `json:"id"`
+	SupplierCode          string    `json:"supplier_code"`
+	SupplierName          string    `json:"supplier_name"`
+	Address               string    `json:"address"`
+	CityName              *string   `json:"city_name"`
+	ExpeditionArrivesName *string   `json:"expedition_arrives_name"`
+	PhoneNumber           *string   `json:"phone_number"`
+	TelpNumber            *string   `json:"telp_number"`
+	CreatedAt             string    `json:"created_at"`
+	UpdatedAt             string    `json:"updated_at"`
+}
+
+func ToRespSupplierIndex(m models.Supplier) RespSupplierIndex {
+	return RespSupplierIndex{
+		ID:                    m.ID,
+		SupplierCode:          m.SupplierCode,
+		SupplierName:          m.SupplierName,
+		Address:               m.Address,
+		CityName:              m.CityName,
+		ExpeditionArrivesName: m.ExpeditionArrivesName,
+		PhoneNumber:           m.PrimaryPhoneNumber,
+		TelpNumber:            m.PrimaryTelpNumber,
+		CreatedAt:             m.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:             m.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// SupplierExport represents supplier data for export with all phone numbers
+type SupplierExport struct {
+	SupplierCode          string
+	SupplierName          string
+	Address               string
+	ProvinceName          *string
+	CityName              *string
+	DistrictName          *string
+	SubdistrictName       *string
+	ExpeditionArrivesName *string
+	PhoneNumbers          []string // All HP phone numbers
+	TelpNumbers           []string // All Telp phone numbers
+	UpdatedAt             time.Time
+}
+
+// ReqSupplierIndexFilter for filtering supplier index and export
+type ReqSupplierIndexFilter struct {
+	Search               string   `query:"search" json:"search"` // Search keyword for filtering by supplier_name, supplier_code and address
+	SupplierCodes        []string `query:"supplier_codes" json:"supplier_codes"`
+	SupplierNames        []string `query:"supplier_names" json:"supplier_names"`
+	Addresses            []string `query:"addresses" json:"addresses"`
+	ProvinceIDs          []string `query:"province_ids" json:"province_ids" validate:"omitempty,dive,uuid"`
+	CityIDs              []string `query:"city_ids" json:"city_ids" validate:"omitempty,dive,uuid"`
+	ExpeditionArrivesIDs []string `query:"expedition_arrives_ids" json:"expedition_arrives_ids" validate:"omitempty,dive,uuid"`
+	TelpNumbers          []string `query:"telp_numbers" json:"telp_numbers"`
+	PhoneNumbers         []string `query:"phone_numbers" json:"phone_numbers"`
+	SortBy               string   `query:"sort_by" json:"sort_by"`
+	SortOrder            string   `query:"sort_order" json:"sort_order"`
+}
@@ -0,0 +1,48 @@
+package supplier
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/supplier/dto"
+)
+
+// CreateSupplierParams contains parameters for creating a supplier
+type CreateSupplierParams struct {
+	SupplierName        string
+	Address             string
+	Regency             dto.SupplierRegency
+	ExpeditionArrivesID *uuid.UUID
+	TelpNumbers         []dto.TelpNumberItem
+	PhoneNumbers        []string
+	Notes               *string
+	CreatedBy           string
+}
+
+// UpdateSupplierParams contains parameters for updating a supplier
+type UpdateSupplierParams struct {
+	SupplierName        string
+	Address             string
+	Regency             dto.SupplierRegency
+	ExpeditionArrivesID *uuid.UUID
+	TelpNumbers         []dto.TelpNumberItem
+	PhoneNumbers        []string
+	Notes               *string
+	UpdatedBy           string
+}
+
+type Repository interface {
+	Create(ctx context.Context, params CreateSupplierParams) (*models.Supplier, error)
+	Update(ctx context.Context, id uuid.UUID, params UpdateSupplierParams) (*models.Supplier, error)
+	Delete(ctx context.Context, id uuid.UUID, deletedBy string) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Supplier, error)
+	GetContactsBySupplierID(ctx context.Context, supplierID uuid.UUID) ([]models.SupplierContact, error)
+	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqSupplierIndexFilter) ([]models.Supplier, int, error)
+	GetAll(ctx context.Context, filter dto.ReqSupplierIndexFilter) ([]models.Supplier, error)
+	GetAllForExport(ctx context.Context, filter dto.ReqSupplierIndexFilter) ([]dto.SupplierExport, error)
+	ExistsBySupplierName(ctx context.Context, supplierName string, excludeID uuid.UUID) (bool, error)
+	ExistsExpeditionByID(ctx context.Context, expeditionID uuid.UUID) (bool, error)
+	ExistsRegencyHierarchy(ctx context.Context, regency dto.SupplierRegency) (bool, error)
+}
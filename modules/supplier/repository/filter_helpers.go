@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/modules/supplier/dto"
+	"gorm.io/gorm"
+)
+
+// applySupplierFilters applies all filters from ReqSupplierIndexFilter to the query
+func applySupplierFilters(query *gorm.DB, filter dto.ReqSupplierIndexFilter) *gorm.DB {
+	if len(filter.SupplierCodes) > 0 {
+		query = query.Where("s.supplier_code IN (?)", filter.SupplierCodes)
+	}
+	if len(filter.SupplierNames) > 0 {
+		query = query.Where("s.supplier_name IN (?)", filter.SupplierNames)
+	}
+	if len(filter.Addresses) > 0 {
+		query = query.Where("s.address IN (?)", filter.Addresses)
+	}
+	if len(filter.ProvinceIDs) > 0 {
+		query = query.Where("s.province_id IN (?)", filter.ProvinceIDs)
+	}
+	if len(filter.CityIDs) > 0 {
+		query = query.Where("s.city_id IN (?)", filter.CityIDs)
+	}
+	if len(filter.ExpeditionArrivesIDs) > 0 {
+		query = query.Where("s.expedition_arrives_id IN (?)", filter.ExpeditionArrivesIDs)
+	}
+	if len(filter.TelpNumbers) > 0 {
+		query = query.Where("EXISTS (SELECT 1 FROM supplier_contacts sc WHERE sc.supplier_id = s.id AND sc.deleted_at IS NULL AND sc.phone_type = 'telp' AND sc.phone_number IN (?))", filter.TelpNumbers)
+	}
+	if len(filter.PhoneNumbers) > 0 {
+		query = query.Where("EXISTS (SELECT 1 FROM supplier_contacts sc WHERE sc.supplier_id = s.id AND sc.deleted_at IS NULL AND sc.phone_type = 'hp' AND sc.phone_number IN (?))", filter.PhoneNumbers)
+	}
+	return query
+}
+
+// ApplyFilters applies filters to the query
+// Implements NeedFilterPredefine interface
+func (r *supplierRepository) ApplyFilters(query *gorm.DB, filter interface{}) *gorm.DB {
+	supplierFilter, ok := filter.(dto.ReqSupplierIndexFilter)
+	if !ok {
+		return query
+	}
+
+	return applySupplierFilters(query, supplierFilter)
+}
+
+// Compile-time check to ensure supplierRepository implements NeedFilterPredefine interface
+var _ request.NeedFilterPredefine = (*supplierRepository)(nil)
@@ -0,0 +1,28 @@
+package searches
+
+import "github.com/rendyfutsuy/base-go/helpers/request"
+
+// initialize, value for search and map the function & variable need for it
+type SupplierSearchHelper struct{ request.SearchPredefineBase }
+
+func (SupplierSearchHelper) GetSearchColumns() []string {
+	return []string{
+		"s.supplier_code",
+		"s.supplier_name",
+		"s.address",
+	}
+}
+
+func (SupplierSearchHelper) GetSearchExistsSubqueries() []string {
+	return []string{
+		"EXISTS (SELECT 1 FROM supplier_contacts sc WHERE sc.supplier_id = s.id AND sc.deleted_at IS NULL AND sc.phone_number ILIKE ?)",
+		"EXISTS (SELECT 1 FROM cities ct WHERE ct.id = s.city_id AND ct.deleted_at IS NULL AND REPLACE(ct.name, ' ', '') ILIKE ?)",
+		"EXISTS (SELECT 1 FROM expeditions ex WHERE ex.id = s.expedition_arrives_id AND ex.deleted_at IS NULL AND REPLACE(ex.expedition_name, ' ', '') ILIKE ?)",
+	}
+}
+
+var _ request.NeedSearchPredefine = SupplierSearchHelper{}
+
+func NewSupplierSearchHelper() SupplierSearchHelper {
+	return SupplierSearchHelper{SearchPredefineBase: request.SearchPredefineBase{Threshold: nil}}
+}
@@ -0,0 +1,36 @@
+package repository
+
+import "strings"
+
+func normalizeSupplierSortKey(sortBy string) string {
+	sortBy = strings.TrimSpace(sortBy)
+	if sortBy == "" {
+		return ""
+	}
+	sortBy = strings.ReplaceAll(sortBy, "-", "_")
+	sortBy = strings.ReplaceAll(sortBy, " ", "_")
+	return strings.ToLower(sortBy)
+}
+
+func mapSupplierIndexSortColumn(sortBy string) string {
+	normalized := normalizeSupplierSortKey(sortBy)
+	if normalized == "" {
+		return ""
+	}
+
+	mapping := map[string]string{
+		"id":                      "s.id",
+		"supplier_id":             "s.id",
+		"supplier_code":           "s.supplier_code",
+		"supplier_name":           "s.supplier_name",
+		"address":                 "s.address",
+		"city_name":               "c.name",
+		"expedition_arrives_name": "e.expedition_name",
+		"phone_number":            "primary_phone_number",
+		"telp_number":             "primary_telp_number",
+		"created_at":              "s.created_at",
+		"updated_at":              "s.updated_at",
+	}
+
+	return mapping[normalized]
+}
@@ -0,0 +1,508 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/codegen"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/supplier"
+	"github.com/rendyfutsuy/base-go/modules/supplier/dto"
+	rsearchsupplier "github.com/rendyfutsuy/base-go/modules/supplier/repository/searches"
+	"gorm.io/gorm"
+)
+
+// supplierPrimaryContactColumns selects the primary telp (with area code) and primary hp of a supplier
+const supplierPrimaryContactColumns = `
+	(SELECT CASE
+		WHEN sc_telp.area_code IS NULL OR sc_telp.area_code = '' THEN sc_telp.phone_number
+		ELSE sc_telp.area_code || '-' || sc_telp.phone_number
+	END
+	 FROM supplier_contacts sc_telp
+	 WHERE sc_telp.supplier_id = s.id AND sc_telp.phone_type = 'telp' AND sc_telp.is_primary = true AND sc_telp.deleted_at IS NULL
+	 LIMIT 1) as primary_telp_number,
+	(SELECT sc_hp.phone_number FROM supplier_contacts sc_hp WHERE sc_hp.supplier_id = s.id AND sc_hp.phone_type = 'hp' AND sc_hp.is_primary = true AND sc_hp.deleted_at IS NULL LIMIT 1) as primary_phone_number`
+
+type supplierRepository struct {
+	DB *gorm.DB
+}
+
+func NewSupplierRepository(db *gorm.DB) *supplierRepository {
+	return &supplierRepository{
+		DB: db,
+	}
+}
+
+// supplierQuery joins the address regions and the default expedition of a supplier
+func (r *supplierRepository) supplierQuery(ctx context.Context) *gorm.DB {
+	return r.DB.WithContext(ctx).Table("suppliers s").
+		Joins("LEFT JOIN provinces p ON p.id = s.province_id").
+		Joins("LEFT JOIN cities c ON c.id = s.city_id").
+		Joins("LEFT JOIN districts d ON d.id = s.district_id").
+		Joins("LEFT JOIN subdistricts sd ON sd.id = s.subdistrict_id").
+		Joins("LEFT JOIN expeditions e ON e.id = s.expedition_arrives_id AND e.deleted_at IS NULL").
+		Where("s.deleted_at IS NULL")
+}
+
+// buildSupplierContacts maps telp and hp numbers to contacts, the first index of each type becomes primary
+func buildSupplierContacts(supplierID uuid.UUID, telpNumbers []dto.TelpNumberItem, phoneNumbers []string, actor string, now time.Time) []models.SupplierContact {
+	contacts := make([]models.SupplierContact, 0)
+
+	for i, telp := range telpNumbers {
+		if telp.PhoneNumber != "" {
+			contacts = append(contacts, models.SupplierContact{
+				SupplierID:  supplierID,
+				PhoneType:   constants.SupplierContactTypeTelp,
+				PhoneNumber: telp.PhoneNumber,
+				AreaCode:    telp.AreaCode,
+				IsPrimary:   i == 0, // First telp is always primary
+				CreatedAt:   now,
+				CreatedBy:   actor,
+				UpdatedAt:   now,
+				UpdatedBy:   actor,
+			})
+		}
+	}
+
+	for i, phoneNumber := range phoneNumbers {
+		if phoneNumber != "" {
+			contacts = append(contacts, models.SupplierContact{
+				SupplierID:  supplierID,
+				PhoneType:   constants.SupplierContactTypePhone,
+				PhoneNumber: phoneNumber,
+				IsPrimary:   i == 0, // First hp is always primary
+				CreatedAt:   now,
+				CreatedBy:   actor,
+				UpdatedAt:   now,
+				UpdatedBy:   actor,
+			})
+		}
+	}
+
+	return contacts
+}
+
+func (r *supplierRepository) Create(ctx context.Context, params supplier.CreateSupplierParams) (*models.Supplier, error) {
+	now := time.Now().UTC()
+	sup := &models.Supplier{
+		SupplierName:        params.SupplierName,
+		Address:             params.Address,
+		ProvinceID:          params.Regency.ProvinceID,
+		CityID:              params.Regency.CityID,
+		DistrictID:          params.Regency.DistrictID,
+		SubdistrictID:       params.Regency.SubdistrictID,
+		ExpeditionArrivesID: params.ExpeditionArrivesID,
+		Notes:               params.Notes,
+		CreatedAt:           now,
+		CreatedBy:           params.CreatedBy,
+		UpdatedAt:           now,
+		UpdatedBy:           params.CreatedBy,
+	}
+
+	// Start transaction
+	tx := r.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// supplier_code is allocated from the supplier code policy in the same transaction
+	code, err := codegen.Allocate(tx, constants.CodeEntitySupplier, nil)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	sup.SupplierCode = code
+	if err := tx.Create(sup).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if sup.ID == uuid.Nil {
+		tx.Rollback()
+		return nil, errors.New(constants.SupplierCreateFailedIDNotSet)
+	}
+
+	contacts := buildSupplierContacts(sup.ID, params.TelpNumbers, params.PhoneNumbers, params.CreatedBy, now)
+	if len(contacts) > 0 {
+		if err := tx.Create(&contacts).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	// Commit transaction
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return sup, nil
+}
+
+func (r *supplierRepository) Update(ctx context.Context, id uuid.UUID, params supplier.UpdateSupplierParams) (*models.Supplier, error) {
+	updates := map[string]interface{}{
+		"supplier_name":         params.SupplierName,
+		"address":               params.Address,
+		"province_id":           params.Regency.ProvinceID,
+		"city_id":               params.Regency.CityID,
+		"district_id":           params.Regency.DistrictID,
+		"subdistrict_id":        params.Regency.SubdistrictID,
+		"expedition_arrives_id": params.ExpeditionArrivesID,
+		"updated_at":            time.Now().UTC(),
+		"updated_by":            params.UpdatedBy,
+	}
+	if params.Notes != nil {
+		updates["notes"] = *params.Notes
+	} else {
+		updates["notes"] = nil
+	}
+
+	// Start transaction
+	tx := r.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	sup := &models.Supplier{}
+	err := tx.Model(&models.Supplier{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(updates).
+		Take(sup).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// Update contacts: Always hard delete existing contacts before creating new ones
+	if err := tx.Unscoped().Where("supplier_id = ?", id).
+		Delete(&models.SupplierContact{}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	contacts := buildSupplierContacts(sup.ID, params.TelpNumbers, params.PhoneNumbers, params.UpdatedBy, time.Now().UTC())
+	if len(contacts) > 0 {
+		if err := tx.Create(&contacts).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	// Commit transaction
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return sup, nil
+}
+
+func (r *supplierRepository) Delete(ctx context.Context, id uuid.UUID, deletedBy string) error {
+	updates := map[string]interface{}{
+		"deleted_at": time.Now().UTC(),
+		"deleted_by": deletedBy,
+	}
+	return r.DB.WithContext(ctx).Model(&models.Supplier{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(updates).Error
+}
+
+func (r *supplierRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Supplier, error) {
+	sup := &models.Supplier{}
+	err := r.supplierQuery(ctx).
+		Select(`
+			s.*,
+			p.name as province_name,
+			c.name as city_name,
+			d.name as district_name,
+			sd.name as subdistrict_name,
+			e.expedition_name as expedition_arrives_name
+		`).
+		Where("s.id = ?", id).
+		Scan(sup).Error
+	if err != nil {
+		return nil, err
+	}
+	// Scan() doesn't return error for record not found, so check if ID is nil
+	if sup.ID == uuid.Nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return sup, nil
+}
+
+func (r *supplierRepository) ExistsBySupplierName(ctx context.Context, supplierName string, excludeID uuid.UUID) (bool, error) {
+	var count int64
+	q := r.DB.WithContext(ctx).Unscoped().Model(&models.Supplier{}).Where("supplier_name = ?", supplierName)
+	if excludeID != uuid.Nil {
+		q = q.Where("id <> ?", excludeID)
+	}
+	if err := q.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *supplierRepository) ExistsExpeditionByID(ctx context.Context, expeditionID uuid.UUID) (bool, error) {
+	var count int64
+	if err := r.DB.WithContext(ctx).Model(&models.Expedition{}).
+		Where("id = ? AND deleted_at IS NULL", expeditionID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// ExistsRegencyHierarchy checks that the deepest given region exists and belongs to the given parent regions
+func (r *supplierRepository) ExistsRegencyHierarchy(ctx context.Context, regency dto.SupplierRegency) (bool, error) {
+	q := r.DB.WithContext(ctx)
+	switch {
+	case regency.SubdistrictID != nil:
+		q = q.Table("subdistricts sd").
+			Joins("JOIN districts d ON d.id = sd.district_id AND d.deleted_at IS NULL").
+			Joins("JOIN cities c ON c.id = d.city_id AND c.deleted_at IS NULL").
+			Where("sd.id = ? AND sd.deleted_at IS NULL", *regency.SubdistrictID)
+	case regency.DistrictID != nil:
+		q = q.Table("districts d").
+			Joins("JOIN cities c ON c.id = d.city_id AND c.deleted_at IS NULL").
+			Where("d.id = ? AND d.deleted_at IS NULL", *regency.DistrictID)
+	case regency.CityID != nil:
+		q = q.Table("cities c").
+			Where("c.id = ? AND c.deleted_at IS NULL", *regency.CityID)
+	case regency.ProvinceID != nil:
+		q = q.Table("provinces p").
+			Where("p.id = ? AND p.deleted_at IS NULL", *regency.ProvinceID)
+	default:
+		return true, nil
+	}
+
+	if regency.SubdistrictID != nil && regency.DistrictID != nil {
+		q = q.Where("d.id = ?", *regency.DistrictID)
+	}
+	if (regency.SubdistrictID != nil || regency.DistrictID != nil) && regency.CityID != nil {
+		q = q.Where("c.id = ?", *regency.CityID)
+	}
+	if (regency.SubdistrictID != nil || regency.DistrictID != nil || regency.CityID != nil) && regency.ProvinceID != nil {
+		q = q.Where("c.province_id = ?", *regency.ProvinceID)
+	}
+
+	var count int64
+	if err := q.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *supplierRepository) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqSupplierIndexFilter) ([]models.Supplier, int, error) {
+	var suppliers []models.Supplier
+	query := r.supplierQuery(ctx).
+		Select(`
+			s.id,
+			s.supplier_code,
+			s.supplier_name,
+			s.address,
+			s.city_id,
+			s.expedition_arrives_id,
+			s.created_at,
+			s.updated_at,
+			c.name as city_name,
+			e.expedition_name as expedition_arrives_name,` + supplierPrimaryContactColumns)
+
+	// Apply search from PageRequest
+	query = request.ApplySearchConditionFromInterface(query, req.Search, rsearchsupplier.NewSupplierSearchHelper())
+
+	// Apply filters with multiple values support
+	query = r.ApplyFilters(query, filter)
+
+	// Pagination
+	total, err := request.ApplyPagination(query, req, request.PaginationConfig{
+		DefaultSortBy:      "s.created_at",
+		DefaultSortOrder:   "DESC",
+		MaxPerPage:         100,
+		SortMapping:        mapSupplierIndexSortColumn,
+		NaturalSortColumns: []string{"s.supplier_name", "s.address"}, // Enable natural sorting for supplier_name and address
+	}, &suppliers)
+	if err != nil {
+		return nil, 0, err
+	}
+	return suppliers, total, nil
+}
+
+func (r *supplierRepository) GetAll(ctx context.Context, filter dto.ReqSupplierIndexFilter) ([]models.Supplier, error) {
+	var suppliers []models.Supplier
+	query := r.supplierQuery(ctx).
+		Select(`
+			s.id,
+			s.supplier_code,
+			s.supplier_name,
+			s.address,
+			s.city_id,
+			s.expedition_arrives_id,
+			s.notes,
+			s.created_at,
+			s.updated_at,
+			c.name as city_name,
+			e.expedition_name as expedition_arrives_name,` + supplierPrimaryContactColumns)
+
+	// Apply search from filter
+	query = request.ApplySearchConditionFromInterface(query, filter.Search, rsearchsupplier.NewSupplierSearchHelper())
+
+	// Apply filters with multiple values support
+	query = r.ApplyFilters(query, filter)
+
+	// Determine sorting with natural sorting support
+	sortExpression := request.BuildSortExpressionForExport(
+		filter.SortBy,
+		filter.SortOrder,
+		"s.created_at",
+		"DESC",
+		mapSupplierIndexSortColumn,
+		[]string{"s.supplier_name", "s.address"}, // Enable natural sorting for supplier_name and address
+	)
+
+	// Order results
+	if err := query.Order(sortExpression).Find(&suppliers).Error; err != nil {
+		return nil, err
+	}
+	return suppliers, nil
+}
+
+func (r *supplierRepository) GetAllForExport(ctx context.Context, filter dto.ReqSupplierIndexFilter) ([]dto.SupplierExport, error) {
+	// First, get all suppliers
+	type SupplierBase struct {
+		ID                    uuid.UUID
+		SupplierCode          string
+		SupplierName          string
+		Address               string
+		ProvinceName          *string
+		CityName              *string
+		DistrictName          *string
+		SubdistrictName       *string
+		ExpeditionArrivesName *string
+		UpdatedAt             time.Time
+	}
+
+	var suppliersBase []SupplierBase
+	query := r.supplierQuery(ctx).
+		Select(`
+			s.id,
+			s.supplier_code,
+			s.supplier_name,
+			s.address,
+			p.name as province_name,
+			c.name as city_name,
+			d.name as district_name,
+			sd.name as subdistrict_name,
+			e.expedition_name as expedition_arrives_name,
+			s.updated_at
+		`)
+
+	// Apply search from filter
+	query = request.ApplySearchConditionFromInterface(query, filter.Search, rsearchsupplier.NewSupplierSearchHelper())
+
+	// Apply filters with multiple values support
+	query = r.ApplyFilters(query, filter)
+
+	// Determine sorting with natural sorting support
+	sortExpression := request.BuildSortExpressionForExport(
+		filter.SortBy,
+		filter.SortOrder,
+		"s.created_at",
+		"DESC",
+		mapSupplierIndexSortColumn,
+		[]string{"s.supplier_name", "s.address"},
+	)
+
+	// Order results
+	if err := query.Order(sortExpression).Find(&suppliersBase).Error; err != nil {
+		return nil, err
+	}
+
+	// Get supplier IDs
+	supplierIDs := make([]uuid.UUID, len(suppliersBase))
+	for i, sup := range suppliersBase {
+		supplierIDs[i] = sup.ID
+	}
+
+	// Fetch all HP and Telp phone numbers for all suppliers
+	var contacts []struct {
+		SupplierID  uuid.UUID
+		PhoneType   string
+		PhoneNumber string
+		AreaCode    *string
+	}
+	if len(supplierIDs) > 0 {
+		if err := r.DB.WithContext(ctx).Table("supplier_contacts").
+			Select("supplier_id, phone_type, phone_number, area_code").
+			Where("supplier_id IN (?) AND deleted_at IS NULL", supplierIDs).
+			Order("is_primary DESC, created_at ASC").
+			Find(&contacts).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	// Group phone numbers by supplier_id and phone_type
+	phoneNumbersMap := make(map[uuid.UUID][]string)
+	telpNumbersMap := make(map[uuid.UUID][]string)
+	for _, contact := range contacts {
+		formatted := formatContactNumber(contact.AreaCode, contact.PhoneNumber)
+		switch contact.PhoneType {
+		case constants.SupplierContactTypePhone:
+			phoneNumbersMap[contact.SupplierID] = append(phoneNumbersMap[contact.SupplierID], formatted)
+		case constants.SupplierContactTypeTelp:
+			telpNumbersMap[contact.SupplierID] = append(telpNumbersMap[contact.SupplierID], formatted)
+		}
+	}
+
+	// Map to SupplierExport
+	suppliers := make([]dto.SupplierExport, len(suppliersBase))
+	for i, sup := range suppliersBase {
+		suppliers[i] = dto.SupplierExport{
+			SupplierCode:          sup.SupplierCode,
+			SupplierName:          sup.SupplierName,
+			Address:               sup.Address,
+			ProvinceName:          sup.ProvinceName,
+			CityName:              sup.CityName,
+			DistrictName:          sup.DistrictName,
+			SubdistrictName:       sup.SubdistrictName,
+			ExpeditionArrivesName: sup.ExpeditionArrivesName,
+			PhoneNumbers:          phoneNumbersMap[sup.ID],
+			TelpNumbers:           telpNumbersMap[sup.ID],
+			UpdatedAt:             sup.UpdatedAt,
+		}
+	}
+
+	return suppliers, nil
+}
+
+func (r *supplierRepository) GetContactsBySupplierID(ctx context.Context, supplierID uuid.UUID) ([]models.SupplierContact, error) {
+	var contacts []models.SupplierContact
+	err := r.DB.WithContext(ctx).
+		Where("supplier_id = ? AND deleted_at IS NULL", supplierID).
+		Order("is_primary DESC, created_at ASC").
+		Find(&contacts).Error
+	return contacts, err
+}
+
+// Implement supplier.Repository interface
+var _ supplier.Repository = (*supplierRepository)(nil)
+
+func formatContactNumber(areaCode *string, phoneNumber string) string {
+	if phoneNumber == "" {
+		return ""
+	}
+	if areaCode == nil {
+		return phoneNumber
+	}
+	ac := strings.TrimSpace(*areaCode)
+	if ac == "" {
+		return phoneNumber
+	}
+	return ac + "-" + strings.TrimSpace(phoneNumber)
+}
@@ -0,0 +1,369 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	supplierMod "github.com/rendyfutsuy/base-go/modules/supplier"
+	supplierDto "github.com/rendyfutsuy/base-go/modules/supplier/dto"
+	"github.com/rendyfutsuy/base-go/modules/supplier/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+	"gorm.io/gorm"
+)
+
+// MockSupplierRepository is a mock implementation of supplier.Repository
+type MockSupplierRepository struct {
+	mock.Mock
+}
+
+func (m *MockSupplierRepository) Create(ctx context.Context, params supplierMod.CreateSupplierParams) (*models.Supplier, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Supplier), args.Error(1)
+}
+
+func (m *MockSupplierRepository) Update(ctx context.Context, id uuid.UUID, params supplierMod.UpdateSupplierParams) (*models.Supplier, error) {
+	args := m.Called(ctx, id, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Supplier), args.Error(1)
+}
+
+func (m *MockSupplierRepository) Delete(ctx context.Context, id uuid.UUID, deletedBy string) error {
+	args := m.Called(ctx, id, deletedBy)
+	return args.Error(0)
+}
+
+func (m *MockSupplierRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Supplier, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Supplier), args.Error(1)
+}
+
+func (m *MockSupplierRepository) GetContactsBySupplierID(ctx context.Context, supplierID uuid.UUID) ([]models.SupplierContact, error) {
+	args := m.Called(ctx, supplierID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.SupplierContact), args.Error(1)
+}
+
+func (m *MockSupplierRepository) GetIndex(ctx context.Context, req request.PageRequest, filter supplierDto.ReqSupplierIndexFilter) ([]models.Supplier, int, error) {
+	args := m.Called(ctx, req, filter)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]models.Supplier), args.Int(1), args.Error(2)
+}
+
+func (m *MockSupplierRepository) GetAll(ctx context.Context, filter supplierDto.ReqSupplierIndexFilter) ([]models.Supplier, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Supplier), args.Error(1)
+}
+
+func (m *MockSupplierRepository) GetAllForExport(ctx context.Context, filter supplierDto.ReqSupplierIndexFilter) ([]supplierDto.SupplierExport, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]supplierDto.SupplierExport), args.Error(1)
+}
+
+func (m *MockSupplierRepository) ExistsBySupplierName(ctx context.Context, supplierName string, excludeID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, supplierName, excludeID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockSupplierRepository) ExistsExpeditionByID(ctx context.Context, expeditionID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, expeditionID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockSupplierRepository) ExistsRegencyHierarchy(ctx context.Context, regency supplierDto.SupplierRegency) (bool, error) {
+	args := m.Called(ctx, regency)
+	return args.Bool(0), args.Error(1)
+}
+
+func TestCreateSupplier(t *testing.T) {
+	ctx := context.Background()
+	notes := "Test notes"
+	provinceID := uuid.New()
+	cityID := uuid.New()
+	districtID := uuid.New()
+	expeditionID := uuid.New()
+
+	tests := []struct {
+		name          string
+		req           *supplierDto.ReqCreateSupplier
+		setupMock     func(*MockSupplierRepository)
+		expectedError error
+	}{
+		{
+			name: "success create supplier with address and expedition",
+			req: &supplierDto.ReqCreateSupplier{
+				SupplierName:        "PT Sumber Makmur",
+				Address:             "Jl. Ahmad Yani No. 123",
+				ProvinceID:          &provinceID,
+				CityID:              &cityID,
+				DistrictID:          &districtID,
+				ExpeditionArrivesID: &expeditionID,
+				TelpNumbers:         []supplierDto.TelpNumberItem{{PhoneNumber: "1234567"}},
+				PhoneNumbers:        []string{"081234567890"},
+				Notes:               &notes,
+			},
+			setupMock: func(m *MockSupplierRepository) {
+				regency := supplierDto.SupplierRegency{ProvinceID: &provinceID, CityID: &cityID, DistrictID: &districtID}
+				m.On("ExistsBySupplierName", mock.Anything, "PT Sumber Makmur", uuid.Nil).Return(false, nil).Once()
+				m.On("ExistsRegencyHierarchy", mock.Anything, regency).Return(true, nil).Once()
+				m.On("ExistsExpeditionByID", mock.Anything, expeditionID).Return(true, nil).Once()
+				m.On("Create", mock.Anything, supplierMod.CreateSupplierParams{
+					SupplierName:        "PT Sumber Makmur",
+					Address:             "Jl. Ahmad Yani No. 123",
+					Regency:             regency,
+					ExpeditionArrivesID: &expeditionID,
+					TelpNumbers:         []supplierDto.TelpNumberItem{{PhoneNumber: "1234567"}},
+					PhoneNumbers:        []string{"081234567890"},
+					Notes:               &notes,
+					CreatedBy:           "test-auth-id",
+				}).Return(&models.Supplier{ID: uuid.New(), SupplierCode: "01", SupplierName: "PT Sumber Makmur"}, nil).Once()
+			},
+		},
+		{
+			name: "error when supplier name already exists",
+			req:  &supplierDto.ReqCreateSupplier{SupplierName: "PT Sumber Makmur"},
+			setupMock: func(m *MockSupplierRepository) {
+				m.On("ExistsBySupplierName", mock.Anything, "PT Sumber Makmur", uuid.Nil).Return(true, nil).Once()
+			},
+			expectedError: errors.New(constants.SupplierNameAlreadyExists),
+		},
+		{
+			name: "error when region is set without its parent",
+			req:  &supplierDto.ReqCreateSupplier{SupplierName: "PT Sumber Makmur", DistrictID: &districtID},
+			setupMock: func(m *MockSupplierRepository) {
+				m.On("ExistsBySupplierName", mock.Anything, "PT Sumber Makmur", uuid.Nil).Return(false, nil).Once()
+			},
+			expectedError: errors.New(constants.SupplierAddressRegencyIncomplete),
+		},
+		{
+			name: "error when region does not belong to its parent",
+			req:  &supplierDto.ReqCreateSupplier{SupplierName: "PT Sumber Makmur", ProvinceID: &provinceID, CityID: &cityID},
+			setupMock: func(m *MockSupplierRepository) {
+				m.On("ExistsBySupplierName", mock.Anything, "PT Sumber Makmur", uuid.Nil).Return(false, nil).Once()
+				m.On("ExistsRegencyHierarchy", mock.Anything, supplierDto.SupplierRegency{ProvinceID: &provinceID, CityID: &cityID}).Return(false, nil).Once()
+			},
+			expectedError: errors.New(constants.SupplierAddressRegencyNotFound),
+		},
+		{
+			name: "error when expedition does not exist",
+			req:  &supplierDto.ReqCreateSupplier{SupplierName: "PT Sumber Makmur", ExpeditionArrivesID: &expeditionID},
+			setupMock: func(m *MockSupplierRepository) {
+				m.On("ExistsBySupplierName", mock.Anything, "PT Sumber Makmur", uuid.Nil).Return(false, nil).Once()
+				m.On("ExistsRegencyHierarchy", mock.Anything, supplierDto.SupplierRegency{}).Return(true, nil).Once()
+				m.On("ExistsExpeditionByID", mock.Anything, expeditionID).Return(false, nil).Once()
+			},
+			expectedError: errors.New(constants.SupplierExpeditionNotFound),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockSupplierRepository)
+			tt.setupMock(mockRepo)
+			uc := usecase.NewSupplierUsecase(mockRepo)
+
+			result, err := uc.Create(ctx, tt.req, "test-auth-id")
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "01", result.SupplierCode)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateSupplier(t *testing.T) {
+	ctx := context.Background()
+	supplierID := uuid.New()
+
+	tests := []struct {
+		name          string
+		id            string
+		req           *supplierDto.ReqUpdateSupplier
+		setupMock     func(*MockSupplierRepository)
+		expectedError error
+	}{
+		{
+			name: "success update supplier",
+			id:   supplierID.String(),
+			req:  &supplierDto.ReqUpdateSupplier{SupplierName: "PT Sumber Rejeki", PhoneNumbers: []string{"081234567890"}},
+			setupMock: func(m *MockSupplierRepository) {
+				m.On("ExistsBySupplierName", mock.Anything, "PT Sumber Rejeki", supplierID).Return(false, nil).Once()
+				m.On("ExistsRegencyHierarchy", mock.Anything, supplierDto.SupplierRegency{}).Return(true, nil).Once()
+				m.On("Update", mock.Anything, supplierID, supplierMod.UpdateSupplierParams{
+					SupplierName: "PT Sumber Rejeki",
+					PhoneNumbers: []string{"081234567890"},
+					UpdatedBy:    "test-auth-id",
+				}).Return(&models.Supplier{ID: supplierID, SupplierName: "PT Sumber Rejeki"}, nil).Once()
+			},
+		},
+		{
+			name: "error when supplier not found",
+			id:   supplierID.String(),
+			req:  &supplierDto.ReqUpdateSupplier{SupplierName: "PT Sumber Rejeki"},
+			setupMock: func(m *MockSupplierRepository) {
+				m.On("ExistsBySupplierName", mock.Anything, "PT Sumber Rejeki", supplierID).Return(false, nil).Once()
+				m.On("ExistsRegencyHierarchy", mock.Anything, supplierDto.SupplierRegency{}).Return(true, nil).Once()
+				m.On("Update", mock.Anything, supplierID, mock.Anything).Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: fmt.Errorf(constants.SupplierNotFound, supplierID.String()),
+		},
+		{
+			name:          "error when id is not a uuid",
+			id:            "invalid-uuid",
+			req:           &supplierDto.ReqUpdateSupplier{SupplierName: "PT Sumber Rejeki"},
+			setupMock:     func(m *MockSupplierRepository) {},
+			expectedError: errors.New("requested param is string"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockSupplierRepository)
+			tt.setupMock(mockRepo)
+			uc := usecase.NewSupplierUsecase(mockRepo)
+
+			result, err := uc.Update(ctx, tt.id, tt.req, "test-auth-id")
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "PT Sumber Rejeki", result.SupplierName)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteSupplier(t *testing.T) {
+	ctx := context.Background()
+	supplierID := uuid.New()
+
+	mockRepo := new(MockSupplierRepository)
+	mockRepo.On("Delete", mock.Anything, supplierID, "test-auth-id").Return(nil).Once()
+	uc := usecase.NewSupplierUsecase(mockRepo)
+
+	assert.NoError(t, uc.Delete(ctx, supplierID.String(), "test-auth-id"))
+	assert.Error(t, uc.Delete(ctx, "invalid-uuid", "test-auth-id"))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetSupplierByID(t *testing.T) {
+	ctx := context.Background()
+	supplierID := uuid.New()
+	cityName := "Bandung"
+
+	mockRepo := new(MockSupplierRepository)
+	mockRepo.On("GetByID", mock.Anything, supplierID).Return(&models.Supplier{ID: supplierID, SupplierCode: "01", CityName: &cityName}, nil).Once()
+	uc := usecase.NewSupplierUsecase(mockRepo)
+
+	result, err := uc.GetByID(ctx, supplierID.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, "01", result.SupplierCode)
+	assert.Equal(t, &cityName, result.CityName)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestToRespSupplier(t *testing.T) {
+	provinceID := uuid.New()
+	provinceName := "Jawa Barat"
+	areaCode := "022"
+	supplier := models.Supplier{ID: uuid.New(), SupplierCode: "01", ProvinceID: &provinceID, ProvinceName: &provinceName}
+	contacts := []models.SupplierContact{
+		{PhoneType: constants.SupplierContactTypeTelp, PhoneNumber: "1234567", AreaCode: &areaCode, IsPrimary: true},
+		{PhoneType: constants.SupplierContactTypePhone, PhoneNumber: "081234567890", IsPrimary: true},
+	}
+
+	resp := supplierDto.ToRespSupplier(supplier, contacts)
+
+	require.NotNil(t, resp.Province)
+	assert.Equal(t, provinceID, resp.Province.ID)
+	assert.Equal(t, provinceName, resp.Province.Name)
+	assert.Nil(t, resp.City)
+	assert.Nil(t, resp.ExpeditionArrives)
+	assert.Equal(t, []supplierDto.TelpNumberItem{{AreaCode: &areaCode, PhoneNumber: "1234567"}}, resp.TelpNumbers)
+	assert.Equal(t, []string{"081234567890"}, resp.PhoneNumbers)
+}
+
+func TestExportSupplier(t *testing.T) {
+	ctx := context.Background()
+	cityName := "Bandung"
+	expeditionName := "JNE"
+
+	t.Run("success export suppliers", func(t *testing.T) {
+		mockRepo := new(MockSupplierRepository)
+		mockRepo.On("GetAllForExport", ctx, supplierDto.ReqSupplierIndexFilter{}).Return([]supplierDto.SupplierExport{
+			{
+				SupplierCode:          "01",
+				SupplierName:          "PT Sumber Makmur",
+				Address:               "Jl. Ahmad Yani No. 123",
+				CityName:              &cityName,
+				ExpeditionArrivesName: &expeditionName,
+				PhoneNumbers:          []string{"081234567890", "081234567891"},
+				TelpNumbers:           []string{"022-1234567"},
+				UpdatedAt:             time.Now(),
+			},
+		}, nil).Once()
+		uc := usecase.NewSupplierUsecase(mockRepo)
+
+		result, err := uc.Export(ctx, supplierDto.ReqSupplierIndexFilter{})
+		require.NoError(t, err)
+
+		f, err := excelize.OpenReader(bytes.NewReader(result))
+		require.NoError(t, err)
+		rows, err := f.GetRows("Suppliers")
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		assert.Equal(t, []string{"Kode Supplier", "Nama Supplier", "Alamat Supplier", "Provinsi", "Kota", "Kecamatan", "Kelurahan", "Ekspedisi", "No HP", "", "No Telp", "Update Date"}, rows[0])
+		assert.Equal(t, []string{"01", "PT Sumber Makmur", "Jl. Ahmad Yani No. 123", "-", "Bandung", "-", "-", "JNE", "081234567890", "081234567891", "022-1234567"}, rows[1][:11])
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error when repository fails", func(t *testing.T) {
+		mockRepo := new(MockSupplierRepository)
+		mockRepo.On("GetAllForExport", ctx, supplierDto.ReqSupplierIndexFilter{}).Return(nil, errors.New("database error")).Once()
+		uc := usecase.NewSupplierUsecase(mockRepo)
+
+		result, err := uc.Export(ctx, supplierDto.ReqSupplierIndexFilter{})
+
+		assert.EqualError(t, err, "database error")
+		assert.Nil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,542 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/middleware"
+	reqMw "github.com/rendyfutsuy/base-go/helpers/middleware/request"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	supplierMod "github.com/rendyfutsuy/base-go/modules/supplier"
+	supplierHttp "github.com/rendyfutsuy/base-go/modules/supplier/delivery/http"
+	"github.com/rendyfutsuy/base-go/modules/supplier/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSupplierUsecase struct {
+	mock.Mock
+}
+
+func (m *mockSupplierUsecase) Create(ctx context.Context, req *dto.ReqCreateSupplier, authId string) (*models.Supplier, error) {
+	args := m.Called(ctx, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Supplier), args.Error(1)
+}
+
+func (m *mockSupplierUsecase) Update(ctx context.Context, id string, req *dto.ReqUpdateSupplier, authId string) (*models.Supplier, error) {
+	args := m.Called(ctx, id, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Supplier), args.Error(1)
+}
+
+func (m *mockSupplierUsecase) Delete(ctx context.Context, id string, authId string) error {
+	args := m.Called(ctx, id, authId)
+	return args.Error(0)
+}
+
+func (m *mockSupplierUsecase) GetByID(ctx context.Context, id string) (*models.Supplier, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Supplier), args.Error(1)
+}
+
+func (m *mockSupplierUsecase) GetContactsBySupplierID(ctx context.Context, id string) ([]models.SupplierContact, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.SupplierContact), args.Error(1)
+}
+
+func (m *mockSupplierUsecase) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqSupplierIndexFilter) ([]models.Supplier, int, error) {
+	args := m.Called(ctx, req, filter)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]models.Supplier), args.Int(1), args.Error(2)
+}
+
+func (m *mockSupplierUsecase) GetAll(ctx context.Context, filter dto.ReqSupplierIndexFilter) ([]models.Supplier, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Supplier), args.Error(1)
+}
+
+func (m *mockSupplierUsecase) Export(ctx context.Context, filter dto.ReqSupplierIndexFilter) ([]byte, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+type mockMiddlewareAuth struct {
+	mock.Mock
+}
+
+func (m *mockMiddlewareAuth) AuthorizationCheck(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return next(c)
+	}
+}
+
+type mockMiddlewarePermission struct {
+	mock.Mock
+}
+
+func (m *mockMiddlewarePermission) PermissionValidation(args []string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			return next(c)
+		}
+	}
+}
+
+type mockMiddlewarePageRequest struct {
+	mock.Mock
+}
+
+func (m *mockMiddlewarePageRequest) PageRequestCtx(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		pageReq := &request.PageRequest{
+			Page:      1,
+			PerPage:   10,
+			SortBy:    "id",
+			SortOrder: "desc",
+		}
+		c.Set("page_request", pageReq)
+		return next(c)
+	}
+}
+
+func (m *mockMiddlewarePageRequest) PageRequestCtxWithoutLimitation(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return next(c)
+	}
+}
+
+type customValidator struct {
+	validator *validator.Validate
+}
+
+func (cv *customValidator) Validate(i interface{}) error {
+	return utils.ValidateRequest(i, cv.validator)
+}
+
+func newEcho() *echo.Echo {
+	e := echo.New()
+	v := validator.New()
+	utils.RegisterCustomValidator(v)
+	e.Validator = &customValidator{validator: v}
+	return e
+}
+
+func setHandlerValidator(handler *supplierHttp.SupplierHandler, v *validator.Validate) {
+	val := reflect.ValueOf(handler).Elem()
+	field := val.FieldByName("validator")
+	if field.IsValid() && field.CanSet() {
+		field.Set(reflect.ValueOf(v))
+	}
+}
+
+func newSupplierHandler(mockUC supplierMod.Usecase, mockAuthMw middleware.IMiddlewareAuth, mockPermMw middleware.IMiddlewarePermission, mockPageReqMw reqMw.IMiddlewarePageRequest) *supplierHttp.SupplierHandler {
+	handler := &supplierHttp.SupplierHandler{
+		Usecase: mockUC,
+	}
+	val := reflect.ValueOf(handler).Elem()
+
+	authField := val.FieldByName("middlewareAuth")
+	if authField.IsValid() && authField.CanSet() {
+		authField.Set(reflect.ValueOf(mockAuthMw))
+	}
+
+	permField := val.FieldByName("middlewarePermission")
+	if permField.IsValid() && permField.CanSet() {
+		permField.Set(reflect.ValueOf(mockPermMw))
+	}
+
+	pageReqField := val.FieldByName("mwPageRequest")
+	if pageReqField.IsValid() && pageReqField.CanSet() {
+		pageReqField.Set(reflect.ValueOf(mockPageReqMw))
+	}
+
+	setHandlerValidator(handler, validator.New())
+	return handler
+}
+
+func TestSupplierHandler_CreateSuccess(t *testing.T) {
+	e := newEcho()
+	reqBody := `{"supplier_name":"TEST SUPPLIER","address":"TEST ADDRESS"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/supplier", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", models.User{ID: uuid.New()})
+
+	mockUC := new(mockSupplierUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newSupplierHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	supplierID := uuid.New()
+	createdSup := &models.Supplier{
+		ID:           supplierID,
+		SupplierCode: "SUP001",
+		SupplierName: "TEST SUPPLIER",
+		Address:      "TEST ADDRESS",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	mockUC.On("Create", mock.Anything, mock.AnythingOfType("*dto.ReqCreateSupplier"), mock.AnythingOfType("string")).
+		Return(createdSup, nil).Once()
+	mockUC.On("GetContactsBySupplierID", mock.Anything, supplierID.String()).
+		Return([]models.SupplierContact{}, nil).Once()
+
+	err := handler.Create(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Status  int `json:"status"`
+		Message string
+		Data    dto.RespSupplier `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "TEST SUPPLIER", resp.Data.SupplierName)
+	mockUC.AssertExpectations(t)
+}
+
+func TestSupplierHandler_CreateValidationError(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodPost, "/v1/supplier", strings.NewReader(`{"supplier_name":""}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", models.User{ID: uuid.New()})
+
+	mockUC := new(mockSupplierUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newSupplierHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	err := handler.Create(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestSupplierHandler_CreateUsecaseError(t *testing.T) {
+	e := newEcho()
+	reqBody := `{"supplier_name":"TEST SUPPLIER","address":"TEST ADDRESS"}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/supplier", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user", models.User{ID: uuid.New()})
+
+	mockUC := new(mockSupplierUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newSupplierHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	mockUC.On("Create", mock.Anything, mock.AnythingOfType("*dto.ReqCreateSupplier"), mock.AnythingOfType("string")).
+		Return(nil, errors.New("usecase error")).Once()
+
+	err := handler.Create(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestSupplierHandler_UpdateSuccess(t *testing.T) {
+	e := newEcho()
+	supplierID := uuid.New().String()
+	reqBody := `{"supplier_name":"UPDATED SUPPLIER","address":"UPDATED ADDRESS"}`
+	req := httptest.NewRequest(http.MethodPut, "/v1/supplier/"+supplierID, strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(supplierID)
+
+	mockUC := new(mockSupplierUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newSupplierHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	updatedSup := &models.Supplier{
+		ID:           uuid.MustParse(supplierID),
+		SupplierCode: "SUP001",
+		SupplierName: "UPDATED SUPPLIER",
+		Address:      "UPDATED ADDRESS",
+		UpdatedAt:    time.Now(),
+	}
+
+	mockUC.On("Update", mock.Anything, supplierID, mock.AnythingOfType("*dto.ReqUpdateSupplier"), mock.AnythingOfType("string")).
+		Return(updatedSup, nil).Once()
+	mockUC.On("GetByID", mock.Anything, supplierID).
+		Return(updatedSup, nil).Once()
+	mockUC.On("GetContactsBySupplierID", mock.Anything, supplierID).
+		Return([]models.SupplierContact{}, nil).Once()
+
+	err := handler.Update(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestSupplierHandler_UpdateValidationError(t *testing.T) {
+	e := newEcho()
+	supplierID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodPut, "/v1/supplier/"+supplierID, strings.NewReader(`{"supplier_name":""}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(supplierID)
+
+	mockUC := new(mockSupplierUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newSupplierHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	err := handler.Update(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestSupplierHandler_DeleteSuccess(t *testing.T) {
+	e := newEcho()
+	supplierID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodDelete, "/v1/supplier/"+supplierID, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(supplierID)
+
+	mockUC := new(mockSupplierUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newSupplierHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	mockUC.On("Delete", mock.Anything, supplierID, mock.AnythingOfType("string")).
+		Return(nil).Once()
+
+	err := handler.Delete(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestSupplierHandler_DeleteUsecaseError(t *testing.T) {
+	e := newEcho()
+	supplierID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodDelete, "/v1/supplier/"+supplierID, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(supplierID)
+
+	mockUC := new(mockSupplierUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newSupplierHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	mockUC.On("Delete", mock.Anything, supplierID, mock.AnythingOfType("string")).
+		Return(errors.New("not found")).Once()
+
+	err := handler.Delete(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestSupplierHandler_GetIndexSuccess(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodGet, "/v1/supplier?page=1&per_page=10", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockSupplierUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newSupplierHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	mockPageReqMw.PageRequestCtx(func(c echo.Context) error {
+		c.Set("page_request", &request.PageRequest{Page: 1, PerPage: 10})
+		return nil
+	})(c)
+
+	suppliers := []models.Supplier{
+		{
+			ID:           uuid.New(),
+			SupplierCode: "SUP001",
+			SupplierName: "TEST SUPPLIER",
+			Address:      "TEST ADDRESS",
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+
+	mockUC.On("GetIndex", mock.Anything, mock.AnythingOfType("request.PageRequest"), mock.AnythingOfType("dto.ReqSupplierIndexFilter")).
+		Return(suppliers, 1, nil).Once()
+
+	err := handler.GetIndex(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestSupplierHandler_GetByIDSuccess(t *testing.T) {
+	e := newEcho()
+	supplierID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodGet, "/v1/supplier/"+supplierID, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(supplierID)
+
+	mockUC := new(mockSupplierUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newSupplierHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	supplier := &models.Supplier{
+		ID:           uuid.MustParse(supplierID),
+		SupplierCode: "SUP001",
+		SupplierName: "TEST SUPPLIER",
+		Address:      "TEST ADDRESS",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	mockUC.On("GetByID", mock.Anything, supplierID).
+		Return(supplier, nil).Once()
+	mockUC.On("GetContactsBySupplierID", mock.Anything, supplierID).
+		Return([]models.SupplierContact{}, nil).Once()
+
+	err := handler.GetByID(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestSupplierHandler_GetByIDUsecaseError(t *testing.T) {
+	e := newEcho()
+	supplierID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodGet, "/v1/supplier/"+supplierID, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(supplierID)
+
+	mockUC := new(mockSupplierUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newSupplierHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	mockUC.On("GetByID", mock.Anything, supplierID).
+		Return(nil, errors.New("not found")).Once()
+
+	err := handler.GetByID(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestSupplierHandler_ExportSuccess(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodGet, "/v1/supplier/export", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockSupplierUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newSupplierHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	excelBytes := []byte("PK\x03\x04") // Excel file signature
+
+	mockUC.On("Export", mock.Anything, mock.AnythingOfType("dto.ReqSupplierIndexFilter")).
+		Return(excelBytes, nil).Once()
+
+	err := handler.Export(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, constants.ExcelContent, rec.Header().Get(echo.HeaderContentType))
+	mockUC.AssertExpectations(t)
+}
+
+func TestSupplierHandler_ExportUsecaseError(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodGet, "/v1/supplier/export", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockUC := new(mockSupplierUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newSupplierHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	mockUC.On("Export", mock.Anything, mock.AnythingOfType("dto.ReqSupplierIndexFilter")).
+		Return(nil, errors.New("export error")).Once()
+
+	err := handler.Export(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestSupplierHandler_GetIndexInvalidFilter(t *testing.T) {
+	e := newEcho()
+	req := httptest.NewRequest(http.MethodGet, "/v1/supplier?city_ids=not-a-uuid", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("page_request", &request.PageRequest{Page: 1, PerPage: 10})
+
+	mockUC := new(mockSupplierUsecase)
+	mockAuthMw := new(mockMiddlewareAuth)
+	mockPermMw := new(mockMiddlewarePermission)
+	mockPageReqMw := new(mockMiddlewarePageRequest)
+	handler := newSupplierHandler(mockUC, mockAuthMw, mockPermMw, mockPageReqMw)
+
+	err := handler.GetIndex(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertNotCalled(t, "GetIndex", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,20 @@
+package supplier
+
+import (
+	"context"
+
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/supplier/dto"
+)
+
+type Usecase interface {
+	Create(ctx context.Context, req *dto.ReqCreateSupplier, authId string) (*models.Supplier, error)
+	Update(ctx context.Context, id string, req *dto.ReqUpdateSupplier, authId string) (*models.Supplier, error)
+	Delete(ctx context.Context, id string, authId string) error
+	GetByID(ctx context.Context, id string) (*models.Supplier, error)
+	GetContactsBySupplierID(ctx context.Context, id string) ([]models.SupplierContact, error)
+	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqSupplierIndexFilter) ([]models.Supplier, int, error)
+	GetAll(ctx context.Context, filter dto.ReqSupplierIndexFilter) ([]models.Supplier, error)
+	Export(ctx context.Context, filter dto.ReqSupplierIndexFilter) ([]byte, error)
+}
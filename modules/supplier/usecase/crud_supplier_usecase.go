@@ -0,0 +1,304 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/supplier"
+	"github.com/rendyfutsuy/base-go/modules/supplier/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/xuri/excelize/v2"
+	"gorm.io/gorm"
+)
+
+type supplierUsecase struct {
+	repo mod.Repository
+}
+
+func NewSupplierUsecase(repo mod.Repository) mod.Usecase {
+	return &supplierUsecase{repo: repo}
+}
+
+// validateReferences makes sure the address regions form a valid hierarchy and the default expedition exists
+func (u *supplierUsecase) validateReferences(ctx context.Context, regency dto.SupplierRegency, expeditionArrivesID *uuid.UUID) error {
+	// A region can only be set together with all of its parent regions
+	if (regency.SubdistrictID != nil && regency.DistrictID == nil) ||
+		(regency.DistrictID != nil && regency.CityID == nil) ||
+		(regency.CityID != nil && regency.ProvinceID == nil) {
+		return errors.New(constants.SupplierAddressRegencyIncomplete)
+	}
+
+	exists, err := u.repo.ExistsRegencyHierarchy(ctx, regency)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.New(constants.SupplierAddressRegencyNotFound)
+	}
+
+	if expeditionArrivesID != nil {
+		exists, err := u.repo.ExistsExpeditionByID(ctx, *expeditionArrivesID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.New(constants.SupplierExpeditionNotFound)
+		}
+	}
+
+	return nil
+}
+
+func (u *supplierUsecase) Create(ctx context.Context, reqBody *dto.ReqCreateSupplier, authId string) (*models.Supplier, error) {
+	// Check if supplier name already exists
+	exists, err := u.repo.ExistsBySupplierName(ctx, reqBody.SupplierName, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New(constants.SupplierNameAlreadyExists)
+	}
+
+	if err := u.validateReferences(ctx, reqBody.Regency(), reqBody.ExpeditionArrivesID); err != nil {
+		return nil, err
+	}
+
+	return u.repo.Create(ctx, mod.CreateSupplierParams{
+		SupplierName:        reqBody.SupplierName,
+		Address:             reqBody.Address,
+		Regency:             reqBody.Regency(),
+		ExpeditionArrivesID: reqBody.ExpeditionArrivesID,
+		TelpNumbers:         reqBody.TelpNumbers,
+		PhoneNumbers:        reqBody.PhoneNumbers,
+		Notes:               reqBody.Notes,
+		CreatedBy:           authId,
+	})
+}
+
+func (u *supplierUsecase) Update(ctx context.Context, id string, reqBody *dto.ReqUpdateSupplier, authId string) (*models.Supplier, error) {
+	sid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if supplier name already exists (excluding current id)
+	exists, err := u.repo.ExistsBySupplierName(ctx, reqBody.SupplierName, sid)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New(constants.SupplierNameAlreadyExists)
+	}
+
+	if err := u.validateReferences(ctx, reqBody.Regency(), reqBody.ExpeditionArrivesID); err != nil {
+		return nil, err
+	}
+
+	res, err := u.repo.Update(ctx, sid, mod.UpdateSupplierParams{
+		SupplierName:        reqBody.SupplierName,
+		Address:             reqBody.Address,
+		Regency:             reqBody.Regency(),
+		ExpeditionArrivesID: reqBody.ExpeditionArrivesID,
+		TelpNumbers:         reqBody.TelpNumbers,
+		PhoneNumbers:        reqBody.PhoneNumbers,
+		Notes:               reqBody.Notes,
+		UpdatedBy:           authId,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.SupplierNotFound, id)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *supplierUsecase) Delete(ctx context.Context, id string, authId string) error {
+	sid, err := utils.StringToUUID(id)
+	if err != nil {
+		return err
+	}
+	return u.repo.Delete(ctx, sid, authId)
+}
+
+func (u *supplierUsecase) GetByID(ctx context.Context, id string) (*models.Supplier, error) {
+	sid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.GetByID(ctx, sid)
+}
+
+func (u *supplierUsecase) GetContactsBySupplierID(ctx context.Context, id string) ([]models.SupplierContact, error) {
+	sid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.GetContactsBySupplierID(ctx, sid)
+}
+
+func (u *supplierUsecase) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqSupplierIndexFilter) ([]models.Supplier, int, error) {
+	return u.repo.GetIndex(ctx, req, filter)
+}
+
+func (u *supplierUsecase) GetAll(ctx context.Context, filter dto.ReqSupplierIndexFilter) ([]models.Supplier, error) {
+	return u.repo.GetAll(ctx, filter)
+}
+
+func (u *supplierUsecase) Export(ctx context.Context, filter dto.ReqSupplierIndexFilter) ([]byte, error) {
+	// Use GetAllForExport for export with all phone numbers
+	list, err := u.repo.GetAllForExport(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find maximum number of phone numbers and telp numbers to determine header width
+	maxPhoneNumbers := 1
+	maxTelpNumbers := 1
+	for _, supplier := range list {
+		if len(supplier.PhoneNumbers) > maxPhoneNumbers {
+			maxPhoneNumbers = len(supplier.PhoneNumbers)
+		}
+		if len(supplier.TelpNumbers) > maxTelpNumbers {
+			maxTelpNumbers = len(supplier.TelpNumbers)
+		}
+	}
+
+	// Create Excel file
+	f := excelize.NewFile()
+	sheet := "Suppliers"
+	f.SetSheetName("Sheet1", sheet)
+
+	colToLetter := func(col int) string {
+		name, _ := excelize.ColumnNumberToName(col + 1)
+		return name
+	}
+
+	// Header columns: fixed columns, No HP (merged), No Telp (merged), Update Date
+	fixedHeaders := []string{"Kode Supplier", "Nama Supplier", "Alamat Supplier", "Provinsi", "Kota", "Kecamatan", "Kelurahan", "Ekspedisi"}
+	for i, header := range fixedHeaders {
+		f.SetCellValue(sheet, colToLetter(i)+"1", header)
+	}
+
+	noHPStartCell := colToLetter(len(fixedHeaders)) + "1"
+	noHPEndCell := colToLetter(len(fixedHeaders)+maxPhoneNumbers-1) + "1"
+	f.SetCellValue(sheet, noHPStartCell, "No HP")
+	if maxPhoneNumbers > 1 {
+		if err := f.MergeCell(sheet, noHPStartCell, noHPEndCell); err != nil {
+			return nil, err
+		}
+	}
+
+	noTelpStartCell := colToLetter(len(fixedHeaders)+maxPhoneNumbers) + "1"
+	noTelpEndCell := colToLetter(len(fixedHeaders)+maxPhoneNumbers+maxTelpNumbers-1) + "1"
+	f.SetCellValue(sheet, noTelpStartCell, "No Telp")
+	if maxTelpNumbers > 1 {
+		if err := f.MergeCell(sheet, noTelpStartCell, noTelpEndCell); err != nil {
+			return nil, err
+		}
+	}
+
+	totalCols := len(fixedHeaders) + maxPhoneNumbers + maxTelpNumbers + 1
+	f.SetCellValue(sheet, colToLetter(totalCols-1)+"1", "Update Date")
+
+	// Rows
+	for i, supplier := range list {
+		row := i + 2
+		col := 0
+
+		setCell := func(value interface{}) {
+			f.SetCellValue(sheet, colToLetter(col)+strconv.Itoa(row), value)
+			col++
+		}
+		setOptional := func(value *string) {
+			if value == nil || *value == "" {
+				setCell("-")
+				return
+			}
+			setCell(*value)
+		}
+		setNumbers := func(numbers []string, width int) {
+			for j := 0; j < width; j++ {
+				if j < len(numbers) {
+					setCell(numbers[j])
+				} else {
+					setCell("-")
+				}
+			}
+		}
+
+		setCell(supplier.SupplierCode)
+		setCell(supplier.SupplierName)
+		setCell(supplier.Address)
+		setOptional(supplier.ProvinceName)
+		setOptional(supplier.CityName)
+		setOptional(supplier.DistrictName)
+		setOptional(supplier.SubdistrictName)
+		setOptional(supplier.ExpeditionArrivesName)
+		setNumbers(supplier.PhoneNumbers, maxPhoneNumbers)
+		setNumbers(supplier.TelpNumbers, maxTelpNumbers)
+		setCell(supplier.UpdatedAt.Local().Format("2006/01/02"))
+	}
+
+	// Define border configuration
+	borderDefinition := []excelize.Border{
+		{Type: "left", Color: "000000", Style: 1},
+		{Type: "top", Color: "000000", Style: 1},
+		{Type: "bottom", Color: "000000", Style: 1},
+		{Type: "right", Color: "000000", Style: 1},
+	}
+
+	borderStyle, err := f.NewStyle(&excelize.Style{
+		Border: borderDefinition,
+	})
+	if err != nil {
+		return nil, err
+	}
+	endCell := colToLetter(totalCols-1) + strconv.Itoa(len(list)+1)
+	if err := f.SetCellStyle(sheet, "A1", endCell, borderStyle); err != nil {
+		return nil, err
+	}
+
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font:   &excelize.Font{Bold: true},
+		Border: borderDefinition,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := f.SetCellStyle(sheet, "A1", colToLetter(totalCols-1)+"1", headerStyle); err != nil {
+		return nil, err
+	}
+
+	// Center the merged "No HP" and "No Telp" headers
+	mergedHeaderStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{Bold: true},
+		Alignment: &excelize.Alignment{
+			Horizontal: "center",
+			Vertical:   "center",
+		},
+		Border: borderDefinition,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := f.SetCellStyle(sheet, noHPStartCell, noHPEndCell, mergedHeaderStyle); err != nil {
+		return nil, err
+	}
+	if err := f.SetCellStyle(sheet, noTelpStartCell, noTelpEndCell, mergedHeaderStyle); err != nil {
+		return nil, err
+	}
+
+	// Write to buffer and return bytes
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
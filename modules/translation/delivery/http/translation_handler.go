@@ -0,0 +1,295 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/middleware"
+	_reqContext "github.com/rendyfutsuy/base-go/helpers/middleware/request"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/translation"
+	"github.com/rendyfutsuy/base-go/modules/translation/dto"
+)
+
+type Response struct {
+	Message string `json:"message"`
+}
+
+type TranslationHandler struct {
+	Usecase              translation.Usecase
+	validator            *validator.Validate
+	mwPageRequest        _reqContext.IMiddlewarePageRequest
+	middlewareAuth       middleware.IMiddlewareAuth
+	middlewarePermission middleware.IMiddlewarePermission
+}
+
+func NewTranslationHandler(e *echo.Echo, uc translation.Usecase, mwP _reqContext.IMiddlewarePageRequest, auth middleware.IMiddlewareAuth, middlewarePermission middleware.IMiddlewarePermission) {
+	h := &TranslationHandler{Usecase: uc, validator: validator.New(), mwPageRequest: mwP, middlewareAuth: auth, middlewarePermission: middlewarePermission}
+
+	r := e.Group("/v1/translation")
+	r.Use(h.middlewareAuth.AuthorizationCheck)
+
+	// Permissions
+	// View:   translation.view
+	// Create: translation.create
+	// Update: translation.update
+	// Delete: translation.delete
+	// Export: translation.export
+	permissionToView := []string{"translation.view"}
+	permissionToCreate := []string{"translation.create"}
+	permissionToUpdate := []string{"translation.update"}
+	permissionToDelete := []string{"translation.delete"}
+	permissionToExport := []string{"translation.export"}
+
+	// Index with pagination + search
+	r.GET("", h.GetIndex, middleware.RequireActivatedUser, h.mwPageRequest.PageRequestCtx, h.middlewarePermission.PermissionValidation(permissionToView))
+
+	// Export (no pagination, same filters) - must be before /:id to avoid route conflict
+	r.GET("/export", h.Export, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToExport))
+
+	// Import from Excel - template must be before /:id to avoid route conflict
+	r.GET("/import/template", h.DownloadImportTemplate, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToCreate))
+	r.POST("/import", h.ImportTranslations, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToCreate))
+
+	// Get by ID (detail) - must be after /export to avoid route conflict
+	r.GET("/:id", h.GetByID, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToView))
+
+	// Create
+	r.POST("", h.Create, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToCreate))
+
+	// Update
+	r.PUT("/:id", h.Update, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+
+	// Delete
+	r.DELETE("/:id", h.Delete, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToDelete))
+}
+
+// Create godoc
+// @Summary		Create a new translation
+// @Description	Create the translation of a field of a master data entity in a locale. The entity type must be one of parameter, group, sub_group, type, province, city, district, subdistrict, the field must be name and the locale must be a supported non-base locale (en). Index and detail responses of the entity show the translation when requested with a matching Accept-Language header.
+// @Tags			Translation
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	body		dto.ReqCreateTranslation	true	"Translation creation data"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespTranslation}	"Successfully created translation"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/translation [post]
+func (h *TranslationHandler) Create(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	req := new(dto.ReqCreateTranslation)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	res, err := h.Usecase.Create(ctx, req, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespTranslation(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Update godoc
+// @Summary		Update translation
+// @Description	Update the value of an existing translation. The entity, field and locale of a translation cannot be changed.
+// @Tags			Translation
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string						true	"Translation UUID"
+// @Param			request	body	dto.ReqUpdateTranslation	true	"Updated translation value"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespTranslation}	"Successfully updated translation"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		404		{object}	response.NonPaginationResponse	"Translation not found"
+// @Router			/v1/translation/{id} [put]
+func (h *TranslationHandler) Update(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("id")
+	req := new(dto.ReqUpdateTranslation)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	res, err := h.Usecase.Update(ctx, id, req, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespTranslation(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Delete godoc
+// @Summary		Delete translation
+// @Description	Delete an existing translation by ID, the entity falls back to its base value in that locale
+// @Tags			Translation
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string	true	"Translation UUID"
+// @Success		200		{object}	response.NonPaginationResponse	"Successfully deleted translation"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid UUID"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		404		{object}	response.NonPaginationResponse	"Translation not found"
+// @Router			/v1/translation/{id} [delete]
+func (h *TranslationHandler) Delete(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("id")
+	if err := h.Usecase.Delete(ctx, id, authUserID(c)); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(Response{Message: "Successfully delete Translation"})
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetIndex godoc
+// @Summary		Get list of translations with pagination
+// @Description	Retrieve a paginated list of translations with the code and base value of the translated entity and optional filters
+// @Tags			Translation
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			page			query		int			false	"Page number"
+// @Param			per_page		query		int			false	"Items per page"
+// @Param			search			query		string		false	"Search keyword on the value, the base value and the entity code"
+// @Param			entity_types	query		[]string	false	"Filter by entity types (array)"
+// @Param			entity_ids		query		[]string	false	"Filter by entity IDs (array)"
+// @Param			fields			query		[]string	false	"Filter by fields (array)"
+// @Param			locales			query		[]string	false	"Filter by locales (array)"
+// @Success		200				{object}	response.PaginationResponse{data=[]dto.RespTranslation}	"Successfully retrieved translations"
+// @Failure		400				{object}	response.NonPaginationResponse	"Bad request"
+// @Failure		401				{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/translation [get]
+func (h *TranslationHandler) GetIndex(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	pageRequest := c.Get("page_request").(*request.PageRequest)
+
+	// validate filter req.
+	// initialize filter
+	filter := new(dto.ReqTranslationIndexFilter)
+
+	// Bind form-data to the DTO
+	if err := c.Bind(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// Validate the request if necessary
+	if err := c.Validate(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, total, err := h.Usecase.GetIndex(ctx, *pageRequest, *filter)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	respTranslation := []dto.RespTranslation{}
+	for _, v := range res {
+		respTranslation = append(respTranslation, dto.ToRespTranslation(v))
+	}
+
+	respPag := response.PaginationResponse{}
+	respPag, err = respPag.SetResponse(respTranslation, total, pageRequest.PerPage, pageRequest.Page)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	return c.JSON(http.StatusOK, respPag)
+}
+
+// GetByID godoc
+// @Summary		Get translation by ID
+// @Description	Retrieve a single translation by its ID
+// @Tags			Translation
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string	true	"Translation UUID"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespTranslation}	"Successfully retrieved translation"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid UUID"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		404		{object}	response.NonPaginationResponse	"Translation not found"
+// @Router			/v1/translation/{id} [get]
+func (h *TranslationHandler) GetByID(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("id")
+	res, err := h.Usecase.GetByID(ctx, id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespTranslation(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Export godoc
+// @Summary		Export translations to Excel
+// @Description	Export translations to Excel file (.xlsx) with optional search and filter. The file can be edited and imported back, the Base Value column is informative only.
+// @Tags			Translation
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Param			search			query		string		false	"Search keyword"
+// @Param			entity_types	query		[]string	false	"Filter by entity types (array)"
+// @Param			locales			query		[]string	false	"Filter by locales (array)"
+// @Success		200				{file}		binary	"Excel file with translations data"
+// @Failure		400				{object}	response.NonPaginationResponse	"Bad request"
+// @Failure		401				{object}	response.NonPaginationResponse	"Unauthorized"
+// @Router			/v1/translation/export [get]
+func (h *TranslationHandler) Export(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	// validate filter req.
+	// initialize filter
+	filter := new(dto.ReqTranslationIndexFilter)
+
+	// Bind form-data to the DTO
+	if err := c.Bind(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// Validate the request if necessary
+	if err := c.Validate(filter); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	excelBytes, err := h.Usecase.Export(ctx, *filter)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, constants.ExcelContent)
+	c.Response().Header().Set(constants.FieldContentDisposition, constants.ExcelContentDisposition("translations.xlsx"))
+	return c.Blob(http.StatusOK, constants.ExcelContent, excelBytes)
+}
+
+func authUserID(c echo.Context) string {
+	if userModel, ok := c.Get("user").(models.User); ok {
+		return userModel.ID.String()
+	}
+	return ""
+}
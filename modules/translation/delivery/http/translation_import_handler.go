@@ -0,0 +1,164 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/xuri/excelize/v2"
+)
+
+// ImportTranslations godoc
+// @Summary		Import translations from Excel file
+// @Description	Create or update (by entity, field and locale) translations from an Excel file (.xlsx or .xls) with columns: entity_type, entity_id, entity_code, field, locale, value. The entity is found by entity_id when given, otherwise by entity_code. A file exported from /v1/translation/export can be imported as is. Valid rows are imported even when other rows fail, failed rows are returned with HTTP 400. Requires 'translation.create' permission.
+// @Tags			Translation
+// @Accept			multipart/form-data
+// @Produce		json
+// @Security		BearerAuth
+// @Param			file	formData	file	true	"Excel file (.xlsx or .xls) with columns: entity_type, entity_id, entity_code, field, locale, value"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.ResImportTranslations}	"Successfully imported all translations"
+// @Failure		400		{object}	response.NonPaginationResponse{data=dto.ResImportTranslations}	"Bad request - one or more rows failed validation. Response contains details for each row including row number, entity type, entity code, status, and error message"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500		{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/translation/import [post]
+func (h *TranslationHandler) ImportTranslations(c echo.Context) error {
+	tempFilePath, status, err := saveTranslationImportFile(c, "import_translations")
+	if err != nil {
+		return c.JSON(status, response.SetErrorResponse(status, err.Error()))
+	}
+	defer os.Remove(tempFilePath)
+
+	res, err := h.Usecase.ImportFromExcel(c.Request().Context(), tempFilePath, authUserID(c))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+
+	if res.FailedCount > 0 {
+		resp.Message = constants.TranslationImportFailedPartial
+		if res.SuccessCount == 0 {
+			resp.Message = constants.TranslationImportFailed
+		}
+		resp.Status = http.StatusBadRequest
+		return c.JSON(http.StatusBadRequest, resp)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DownloadImportTemplate godoc
+// @Summary		Download translation import Excel template
+// @Description	Download Excel template file for importing translations. Template contains columns: entity_type, entity_id, entity_code, field, locale, value with example data.
+// @Tags			Translation
+// @Accept			json
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security		BearerAuth
+// @Success		200	{file}		file	"Excel template file"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		500	{object}	response.NonPaginationResponse	"Internal server error"
+// @Router			/v1/translation/import/template [get]
+func (h *TranslationHandler) DownloadImportTemplate(c echo.Context) error {
+	// an entity found by its code, entity_id may be given instead
+	examples := [][]string{
+		{"group", "", "GRP-001", "name", "en", "Electronics"},
+		{"province", "", "31", "name", "en", "Jakarta Special Capital Region"},
+	}
+	headers := []string{"Entity Type", "Entity ID", "Entity Code", "Field", "Locale", "Value"}
+	widths := []float64{20, 40, 20, 15, 10, 40}
+
+	f := excelize.NewFile()
+	defer f.Close()
+
+	sheetName := "Import Translations"
+	f.SetSheetName("Sheet1", sheetName)
+
+	for i, header := range headers {
+		col, _ := excelize.ColumnNumberToName(i + 1)
+		f.SetCellValue(sheetName, col+"1", header)
+		f.SetColWidth(sheetName, col, col, widths[i])
+	}
+
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{
+			Bold: true,
+			Size: 12,
+		},
+		Fill: excelize.Fill{
+			Type:    "pattern",
+			Color:   []string{"#E8E8E8"},
+			Pattern: 1,
+		},
+		Alignment: &excelize.Alignment{
+			Horizontal: "center",
+			Vertical:   "center",
+		},
+	})
+	if err == nil {
+		lastCol, _ := excelize.ColumnNumberToName(len(headers))
+		f.SetCellStyle(sheetName, "A1", lastCol+"1", headerStyle)
+	}
+
+	// codes are text so they are imported as written
+	for i, example := range examples {
+		for j, value := range example {
+			cell, _ := excelize.CoordinatesToCellName(j+1, i+2)
+			f.SetCellStr(sheetName, cell, value)
+		}
+	}
+
+	c.Response().Header().Set(constants.FieldContentType, constants.ExcelContent)
+	c.Response().Header().Set(constants.FieldContentDisposition, constants.ExcelContentDisposition("translation_import_template.xlsx"))
+
+	if err := f.Write(c.Response().Writer); err != nil {
+		return c.JSON(http.StatusInternalServerError, response.SetErrorResponse(http.StatusInternalServerError, fmt.Sprintf("%s: %v", constants.TranslationImportTemplateCreateFailed, err)))
+	}
+
+	return nil
+}
+
+// saveTranslationImportFile copies the uploaded "file" field to a temporary file keeping its extension,
+// the caller removes the file. The returned status is the one to answer with on error.
+func saveTranslationImportFile(c echo.Context, prefix string) (string, int, error) {
+	// Get uploaded file
+	file, err := c.FormFile("file")
+	if err != nil {
+		return "", http.StatusBadRequest, errors.New(constants.TranslationImportFileNotFound)
+	}
+
+	// Validate file extension
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+	if ext != ".xlsx" && ext != ".xls" {
+		return "", http.StatusBadRequest, errors.New(constants.TranslationImportInvalidFileFormat)
+	}
+
+	// Open uploaded file
+	src, err := file.Open()
+	if err != nil {
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.TranslationImportFileOpenFailed, err)
+	}
+	defer src.Close()
+
+	tempFilePath := filepath.Join(os.TempDir(), fmt.Sprintf("%s_%d%s", prefix, time.Now().UnixNano(), ext))
+	dst, err := os.Create(tempFilePath)
+	if err != nil {
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.TranslationImportFileOpenFailed, err)
+	}
+	defer dst.Close()
+
+	if _, err = io.Copy(dst, src); err != nil {
+		os.Remove(tempFilePath)
+		return "", http.StatusInternalServerError, fmt.Errorf("%s: %v", constants.TranslationImportFileOpenFailed, err)
+	}
+
+	return tempFilePath, http.StatusOK, nil
+}
@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+)
+
+type ReqCreateTranslation struct {
+	EntityType string    `json:"entity_type" validate:"required,max=50"`
+	EntityID   uuid.UUID `json:"entity_id" validate:"required"`
+	Field      string    `json:"field" validate:"required,max=50"`
+	Locale     string    `json:"locale" validate:"required,max=10"`
+	Value      string    `json:"value" validate:"required"`
+}
+
+// ReqUpdateTranslation changes the value only, the entity, field and locale identify the translation
+type ReqUpdateTranslation struct {
+	Value string `json:"value" validate:"required"`
+}
+
+type RespTranslation struct {
+	ID         uuid.UUID `json:"id"`
+	EntityType string    `json:"entity_type"`
+	EntityID   uuid.UUID `json:"entity_id"`
+	EntityCode string    `json:"entity_code"`
+	Field      string    `json:"field"`
+	Locale     string    `json:"locale"`
+	Value      string    `json:"value"`
+	BaseValue  string    `json:"base_value"`
+	CreatedAt  string    `json:"created_at"`
+	UpdatedAt  string    `json:"updated_at"`
+}
+
+func ToRespTranslation(m models.Translation) RespTranslation {
+	return RespTranslation{
+		ID:         m.ID,
+		EntityType: m.EntityType,
+		EntityID:   m.EntityID,
+		EntityCode: m.EntityCode,
+		Field:      m.Field,
+		Locale:     m.Locale,
+		Value:      m.Value,
+		BaseValue:  m.BaseValue,
+		CreatedAt:  m.CreatedAt.Format(constants.FormatDateTimeISO8601),
+		UpdatedAt:  m.UpdatedAt.Format(constants.FormatDateTimeISO8601),
+	}
+}
+
+// ReqTranslationIndexFilter filters the translations, every filter accepts multiple values
+type ReqTranslationIndexFilter struct {
+	Search      string      `query:"search"` // Search keyword on the value, the base value and the entity code
+	EntityTypes []string    `query:"entity_types"`
+	EntityIDs   []uuid.UUID `query:"entity_ids"`
+	Fields      []string    `query:"fields"`
+	Locales     []string    `query:"locales"`
+	SortBy      string      `query:"sort_by"`
+	SortOrder   string      `query:"sort_order"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+type ResImportTranslationExcel struct {
+	Row          int    `json:"row"`                     // Excel row number
+	EntityType   string `json:"entity_type"`             // Entity type of the row
+	EntityCode   string `json:"entity_code"`             // Entity ID or code of the row
+	Status       string `json:"status"`                  // "success" or "failed"
+	ErrorMessage string `json:"error_message,omitempty"` // Errors of a failed row
+	Success      bool   `json:"-"`                       // Internal field, not shown in the response
+}
+
+type ResImportTranslations struct {
+	TotalRows    int                         `json:"total_rows"`
+	SuccessCount int                         `json:"success_count"`
+	FailedCount  int                         `json:"failed_count"`
+	Results      []ResImportTranslationExcel `json:"results"`
+}
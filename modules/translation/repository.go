@@ -0,0 +1,29 @@
+package translation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/translation/dto"
+)
+
+// EntityRef is a translatable entity found by ID or code
+type EntityRef struct {
+	ID   uuid.UUID `gorm:"column:id"`
+	Code string    `gorm:"column:code"`
+}
+
+type Repository interface {
+	Create(ctx context.Context, translation models.Translation) (*models.Translation, error)
+	Update(ctx context.Context, id uuid.UUID, value string, updatedBy string) (*models.Translation, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Translation, error)
+	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqTranslationIndexFilter) ([]models.Translation, int, error)
+	GetAll(ctx context.Context, filter dto.ReqTranslationIndexFilter) ([]models.Translation, error)
+	ExistsByKey(ctx context.Context, entityType string, entityID uuid.UUID, field, locale string, excludeID uuid.UUID) (bool, error)
+	EntityExists(ctx context.Context, entityType string, entityID uuid.UUID) (bool, error)
+	FindEntities(ctx context.Context, entityType string, ids []uuid.UUID, codes []string) ([]EntityRef, error)
+	Upsert(ctx context.Context, translations []models.Translation) error
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/modules/translation/dto"
+	"gorm.io/gorm"
+)
+
+// applyTranslationFilters applies all filters from ReqTranslationIndexFilter to the query
+func applyTranslationFilters(query *gorm.DB, filter dto.ReqTranslationIndexFilter) *gorm.DB {
+	// Apply filters with multiple values support
+	if len(filter.EntityTypes) > 0 {
+		query = query.Where("tr.entity_type IN (?)", filter.EntityTypes)
+	}
+	if len(filter.EntityIDs) > 0 {
+		query = query.Where("tr.entity_id IN (?)", filter.EntityIDs)
+	}
+	if len(filter.Fields) > 0 {
+		query = query.Where("tr.field IN (?)", filter.Fields)
+	}
+	if len(filter.Locales) > 0 {
+		query = query.Where("tr.locale IN (?)", filter.Locales)
+	}
+	return query
+}
+
+// ApplyFilters applies filters to the query
+// Implements NeedFilterPredefine interface
+func (r *translationRepository) ApplyFilters(query *gorm.DB, filter interface{}) *gorm.DB {
+	translationFilter, ok := filter.(dto.ReqTranslationIndexFilter)
+	if !ok {
+		return query
+	}
+	return applyTranslationFilters(query, translationFilter)
+}
+
+// Compile-time check to ensure translationRepository implements NeedFilterPredefine interface
+var _ request.NeedFilterPredefine = (*translationRepository)(nil)
@@ -0,0 +1,20 @@
+package searches
+
+import "github.com/rendyfutsuy/base-go/helpers/request"
+
+// initialize, value for search and map the function & variable need for it
+type TranslationSearchHelper struct{ request.SearchPredefineBase }
+
+func (TranslationSearchHelper) GetSearchColumns() []string {
+	return []string{"tr.value", "e.name", "e.code"}
+}
+func (TranslationSearchHelper) GetSearchExistsSubqueries() []string {
+	return []string{}
+}
+
+var _ request.NeedSearchPredefine = TranslationSearchHelper{}
+
+func NewTranslationSearchHelper() TranslationSearchHelper {
+	t := 0.75
+	return TranslationSearchHelper{SearchPredefineBase: request.SearchPredefineBase{Threshold: &t}}
+}
@@ -0,0 +1,35 @@
+package repository
+
+import "strings"
+
+func normalizeTranslationSortKey(sortBy string) string {
+	sortBy = strings.TrimSpace(sortBy)
+	if sortBy == "" {
+		return ""
+	}
+	sortBy = strings.ReplaceAll(sortBy, "-", "_")
+	sortBy = strings.ReplaceAll(sortBy, " ", "_")
+	return strings.ToLower(sortBy)
+}
+
+func mapTranslationIndexSortColumn(sortBy string) string {
+	normalized := normalizeTranslationSortKey(sortBy)
+	if normalized == "" {
+		return ""
+	}
+
+	mapping := map[string]string{
+		"id":          "tr.id",
+		"entity_type": "tr.entity_type",
+		"entity_id":   "tr.entity_id",
+		"entity_code": "e.code",
+		"field":       "tr.field",
+		"locale":      "tr.locale",
+		"value":       "tr.value",
+		"base_value":  "e.name",
+		"created_at":  "tr.created_at",
+		"updated_at":  "tr.updated_at",
+	}
+
+	return mapping[normalized]
+}
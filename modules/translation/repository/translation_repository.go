@@ -0,0 +1,241 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/translation"
+	"github.com/rendyfutsuy/base-go/modules/translation/dto"
+	rsearchtranslation "github.com/rendyfutsuy/base-go/modules/translation/repository/searches"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// translatableEntity is the table of a translatable entity and its code column
+type translatableEntity struct {
+	table      string
+	codeColumn string
+}
+
+// translatableEntities maps translations.entity_type to the entity tables
+var translatableEntities = map[string]translatableEntity{
+	constants.TranslationEntityParameter:   {table: "parameters", codeColumn: "code"},
+	constants.TranslationEntityGroup:       {table: "groups", codeColumn: "group_code"},
+	constants.TranslationEntitySubGroup:    {table: "sub_groups", codeColumn: "subgroup_code"},
+	constants.TranslationEntityType:        {table: "types", codeColumn: "type_code"},
+	constants.TranslationEntityProvince:    {table: "provinces", codeColumn: "code"},
+	constants.TranslationEntityCity:        {table: "cities", codeColumn: "code"},
+	constants.TranslationEntityDistrict:    {table: "districts", codeColumn: "code"},
+	constants.TranslationEntitySubdistrict: {table: "subdistricts", codeColumn: "code"},
+}
+
+// translationEntitiesJoin joins the code and the base name of the translated entity as e.code and e.name
+var translationEntitiesJoin = func() string {
+	parts := make([]string, 0, len(constants.TranslationEntityTypes))
+	for _, entityType := range constants.TranslationEntityTypes {
+		entity := translatableEntities[entityType]
+		parts = append(parts, fmt.Sprintf("SELECT '%s' AS entity_type, id, %s AS code, name FROM %s WHERE deleted_at IS NULL",
+			entityType, entity.codeColumn, entity.table))
+	}
+	return "LEFT JOIN (" + strings.Join(parts, " UNION ALL ") + ") e ON e.entity_type = tr.entity_type AND e.id = tr.entity_id"
+}()
+
+const translationSelect = `tr.id, tr.entity_type, tr.entity_id, tr.field, tr.locale, tr.value,
+	tr.created_at, tr.created_by, tr.updated_at, tr.updated_by,
+	COALESCE(e.code, '') AS entity_code, COALESCE(e.name, '') AS base_value`
+
+type translationRepository struct {
+	DB *gorm.DB
+}
+
+func NewTranslationRepository(db *gorm.DB) *translationRepository {
+	return &translationRepository{
+		DB: db,
+	}
+}
+
+func (r *translationRepository) Create(ctx context.Context, translation models.Translation) (*models.Translation, error) {
+	now := time.Now().UTC()
+	translation.CreatedAt = now
+	translation.UpdatedAt = now
+	if err := r.DB.WithContext(ctx).Create(&translation).Error; err != nil {
+		return nil, err
+	}
+	if translation.ID == uuid.Nil {
+		return nil, errors.New("failed to create translation: ID not set")
+	}
+	return r.GetByID(ctx, translation.ID)
+}
+
+func (r *translationRepository) Update(ctx context.Context, id uuid.UUID, value string, updatedBy string) (*models.Translation, error) {
+	res := r.DB.WithContext(ctx).Model(&models.Translation{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"value":      value,
+			"updated_at": time.Now().UTC(),
+			"updated_by": updatedBy,
+		})
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return r.GetByID(ctx, id)
+}
+
+// Delete removes a translation, the localized responses fall back to the base value
+func (r *translationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	res := r.DB.WithContext(ctx).Where("id = ?", id).Delete(&models.Translation{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *translationRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Translation, error) {
+	translation := &models.Translation{}
+	if err := r.DB.WithContext(ctx).
+		Table("translations tr").
+		Select(translationSelect).
+		Joins(translationEntitiesJoin).
+		Where("tr.id = ?", id).
+		First(translation).Error; err != nil {
+		return nil, err
+	}
+	return translation, nil
+}
+
+func (r *translationRepository) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqTranslationIndexFilter) ([]models.Translation, int, error) {
+	var translations []models.Translation
+	query := r.DB.WithContext(ctx).
+		Table("translations tr").
+		Select(translationSelect).
+		Joins(translationEntitiesJoin)
+
+	// Apply search from PageRequest
+	query = request.ApplySearchConditionFromInterface(query, req.Search, rsearchtranslation.NewTranslationSearchHelper())
+
+	// Apply filters with multiple values support
+	query = r.ApplyFilters(query, filter)
+
+	// Pagination
+	total, err := request.ApplyPagination(query, req, request.PaginationConfig{
+		DefaultSortBy:    "tr.created_at",
+		DefaultSortOrder: "DESC",
+		MaxPerPage:       100,
+		SortMapping:      mapTranslationIndexSortColumn,
+	}, &translations)
+	if err != nil {
+		return nil, 0, err
+	}
+	return translations, total, nil
+}
+
+func (r *translationRepository) GetAll(ctx context.Context, filter dto.ReqTranslationIndexFilter) ([]models.Translation, error) {
+	var translations []models.Translation
+	query := r.DB.WithContext(ctx).
+		Table("translations tr").
+		Select(translationSelect).
+		Joins(translationEntitiesJoin)
+
+	// Apply search from filter
+	query = request.ApplySearchConditionFromInterface(query, filter.Search, rsearchtranslation.NewTranslationSearchHelper())
+
+	// Apply filters with multiple values support
+	query = r.ApplyFilters(query, filter)
+
+	// Determine sorting, by default grouped per entity
+	sortBy := "tr.entity_type ASC, e.code ASC, tr.field ASC, tr.locale"
+	if mapped := mapTranslationIndexSortColumn(filter.SortBy); mapped != "" {
+		sortBy = mapped
+	}
+	sortOrder := request.ValidateAndSanitizeSortOrder(filter.SortOrder)
+	if sortOrder == "" {
+		sortOrder = "ASC"
+	}
+
+	if err := query.Order(sortBy + " " + sortOrder).Find(&translations).Error; err != nil {
+		return nil, err
+	}
+	return translations, nil
+}
+
+func (r *translationRepository) ExistsByKey(ctx context.Context, entityType string, entityID uuid.UUID, field, locale string, excludeID uuid.UUID) (bool, error) {
+	var count int64
+	query := r.DB.WithContext(ctx).Model(&models.Translation{}).
+		Where("entity_type = ? AND entity_id = ? AND field = ? AND locale = ?", entityType, entityID, field, locale)
+	if excludeID != uuid.Nil {
+		query = query.Where("id <> ?", excludeID)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *translationRepository) EntityExists(ctx context.Context, entityType string, entityID uuid.UUID) (bool, error) {
+	entity, ok := translatableEntities[entityType]
+	if !ok {
+		return false, nil
+	}
+	var count int64
+	if err := r.DB.WithContext(ctx).
+		Table(entity.table).
+		Where("id = ? AND deleted_at IS NULL", entityID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// FindEntities returns the entities of a type matching one of the IDs or codes
+func (r *translationRepository) FindEntities(ctx context.Context, entityType string, ids []uuid.UUID, codes []string) ([]mod.EntityRef, error) {
+	entity, ok := translatableEntities[entityType]
+	if !ok || (len(ids) == 0 && len(codes) == 0) {
+		return []mod.EntityRef{}, nil
+	}
+	var refs []mod.EntityRef
+	query := r.DB.WithContext(ctx).
+		Table(entity.table).
+		Select(fmt.Sprintf("id, COALESCE(%s, '') AS code", entity.codeColumn)).
+		Where("deleted_at IS NULL")
+	switch {
+	case len(ids) > 0 && len(codes) > 0:
+		query = query.Where(fmt.Sprintf("id IN (?) OR %s IN (?)", entity.codeColumn), ids, codes)
+	case len(ids) > 0:
+		query = query.Where("id IN (?)", ids)
+	default:
+		query = query.Where(fmt.Sprintf("%s IN (?)", entity.codeColumn), codes)
+	}
+	if err := query.Find(&refs).Error; err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// Upsert creates the translations, or updates the value of the existing ones with the same entity, field and locale
+func (r *translationRepository) Upsert(ctx context.Context, translations []models.Translation) error {
+	if len(translations) == 0 {
+		return nil
+	}
+	now := time.Now().UTC()
+	for i := range translations {
+		translations[i].CreatedAt = now
+		translations[i].UpdatedAt = now
+	}
+	return r.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "entity_type"}, {Name: "entity_id"}, {Name: "field"}, {Name: "locale"}},
+		DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at", "updated_by"}),
+	}).Create(&translations).Error
+}
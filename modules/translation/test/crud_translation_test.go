@@ -0,0 +1,337 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/translation"
+	"github.com/rendyfutsuy/base-go/modules/translation/dto"
+	"github.com/rendyfutsuy/base-go/modules/translation/usecase"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// MockTranslationRepository is a mock implementation of translation.Repository
+type MockTranslationRepository struct {
+	mock.Mock
+}
+
+func (m *MockTranslationRepository) Create(ctx context.Context, translation models.Translation) (*models.Translation, error) {
+	args := m.Called(ctx, translation)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Translation), args.Error(1)
+}
+
+func (m *MockTranslationRepository) Update(ctx context.Context, id uuid.UUID, value string, updatedBy string) (*models.Translation, error) {
+	args := m.Called(ctx, id, value, updatedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Translation), args.Error(1)
+}
+
+func (m *MockTranslationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockTranslationRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Translation, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Translation), args.Error(1)
+}
+
+func (m *MockTranslationRepository) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqTranslationIndexFilter) ([]models.Translation, int, error) {
+	args := m.Called(ctx, req, filter)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]models.Translation), args.Int(1), args.Error(2)
+}
+
+func (m *MockTranslationRepository) GetAll(ctx context.Context, filter dto.ReqTranslationIndexFilter) ([]models.Translation, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Translation), args.Error(1)
+}
+
+func (m *MockTranslationRepository) ExistsByKey(ctx context.Context, entityType string, entityID uuid.UUID, field, locale string, excludeID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, entityType, entityID, field, locale, excludeID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTranslationRepository) EntityExists(ctx context.Context, entityType string, entityID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, entityType, entityID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTranslationRepository) FindEntities(ctx context.Context, entityType string, ids []uuid.UUID, codes []string) ([]mod.EntityRef, error) {
+	args := m.Called(ctx, entityType, ids, codes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]mod.EntityRef), args.Error(1)
+}
+
+func (m *MockTranslationRepository) Upsert(ctx context.Context, translations []models.Translation) error {
+	args := m.Called(ctx, translations)
+	return args.Error(0)
+}
+
+func TestCreateTranslation(t *testing.T) {
+	ctx := context.Background()
+	entityID := uuid.New()
+	locales := strings.Join(constants.TranslationLocales, ", ")
+
+	tests := []struct {
+		name          string
+		req           *dto.ReqCreateTranslation
+		setupMock     func(*MockTranslationRepository)
+		expectedError string
+	}{
+		{
+			name: "success create with trimmed value and lowercased locale",
+			req:  &dto.ReqCreateTranslation{EntityType: "group", EntityID: entityID, Field: "name", Locale: "EN", Value: " Electronics "},
+			setupMock: func(m *MockTranslationRepository) {
+				m.On("EntityExists", ctx, "group", entityID).Return(true, nil).Once()
+				m.On("ExistsByKey", ctx, "group", entityID, "name", "en", uuid.Nil).Return(false, nil).Once()
+				m.On("Create", ctx, models.Translation{
+					EntityType: "group",
+					EntityID:   entityID,
+					Field:      "name",
+					Locale:     "en",
+					Value:      "Electronics",
+					CreatedBy:  "test-auth-id",
+					UpdatedBy:  "test-auth-id",
+				}).Return(&models.Translation{ID: uuid.New(), EntityType: "group", EntityID: entityID, Value: "Electronics"}, nil).Once()
+			},
+		},
+		{
+			name:          "error when entity type is not translatable",
+			req:           &dto.ReqCreateTranslation{EntityType: "customer", EntityID: entityID, Field: "name", Locale: "en", Value: "x"},
+			setupMock:     func(m *MockTranslationRepository) {},
+			expectedError: fmt.Sprintf(constants.TranslationEntityTypeInvalid, strings.Join(constants.TranslationEntityTypes, ", ")),
+		},
+		{
+			name:          "error when field is not translatable",
+			req:           &dto.ReqCreateTranslation{EntityType: "group", EntityID: entityID, Field: "group_code", Locale: "en", Value: "x"},
+			setupMock:     func(m *MockTranslationRepository) {},
+			expectedError: fmt.Sprintf(constants.TranslationFieldInvalid, strings.Join(constants.TranslationFields, ", ")),
+		},
+		{
+			name:          "error when locale is the base locale",
+			req:           &dto.ReqCreateTranslation{EntityType: "group", EntityID: entityID, Field: "name", Locale: "id", Value: "x"},
+			setupMock:     func(m *MockTranslationRepository) {},
+			expectedError: fmt.Sprintf(constants.TranslationLocaleInvalid, locales),
+		},
+		{
+			name: "error when entity does not exist",
+			req:  &dto.ReqCreateTranslation{EntityType: "province", EntityID: entityID, Field: "name", Locale: "en", Value: "x"},
+			setupMock: func(m *MockTranslationRepository) {
+				m.On("EntityExists", ctx, "province", entityID).Return(false, nil).Once()
+			},
+			expectedError: fmt.Sprintf(constants.TranslationEntityNotFound, "province", entityID),
+		},
+		{
+			name: "error when translation already exists",
+			req:  &dto.ReqCreateTranslation{EntityType: "parameter", EntityID: entityID, Field: "name", Locale: "en", Value: "x"},
+			setupMock: func(m *MockTranslationRepository) {
+				m.On("EntityExists", ctx, "parameter", entityID).Return(true, nil).Once()
+				m.On("ExistsByKey", ctx, "parameter", entityID, "name", "en", uuid.Nil).Return(true, nil).Once()
+			},
+			expectedError: constants.TranslationAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTranslationRepository)
+			tt.setupMock(mockRepo)
+
+			result, err := usecase.NewTranslationUsecase(mockRepo).Create(ctx, tt.req, "test-auth-id")
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateTranslation(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	tests := []struct {
+		name          string
+		id            string
+		setupMock     func(*MockTranslationRepository)
+		expectedError string
+	}{
+		{
+			name: "success update value",
+			id:   id.String(),
+			setupMock: func(m *MockTranslationRepository) {
+				m.On("Update", ctx, id, "Electronics", "test-auth-id").Return(&models.Translation{ID: id, Value: "Electronics"}, nil).Once()
+			},
+		},
+		{
+			name: "error when translation not found",
+			id:   id.String(),
+			setupMock: func(m *MockTranslationRepository) {
+				m.On("Update", ctx, id, "Electronics", "test-auth-id").Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: fmt.Sprintf(constants.TranslationNotFound, id.String()),
+		},
+		{
+			name:          "error when id is invalid",
+			id:            "invalid-uuid",
+			setupMock:     func(m *MockTranslationRepository) {},
+			expectedError: "requested param is string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTranslationRepository)
+			tt.setupMock(mockRepo)
+
+			result, err := usecase.NewTranslationUsecase(mockRepo).Update(ctx, tt.id, &dto.ReqUpdateTranslation{Value: " Electronics "}, "test-auth-id")
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "Electronics", result.Value)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteTranslation(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	mockRepo := new(MockTranslationRepository)
+	mockRepo.On("Delete", ctx, id).Return(gorm.ErrRecordNotFound).Once()
+
+	err := usecase.NewTranslationUsecase(mockRepo).Delete(ctx, id.String(), "test-auth-id")
+	assert.EqualError(t, err, fmt.Sprintf(constants.TranslationNotFound, id.String()))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestExportTranslation(t *testing.T) {
+	ctx := context.Background()
+	entityID := uuid.New()
+	filter := dto.ReqTranslationIndexFilter{Locales: []string{"en"}}
+
+	mockRepo := new(MockTranslationRepository)
+	mockRepo.On("GetAll", ctx, filter).Return([]models.Translation{
+		{EntityType: "group", EntityID: entityID, EntityCode: "GRP-001", Field: "name", Locale: "en", Value: "Electronics", BaseValue: "Elektronik"},
+	}, nil).Once()
+
+	excelBytes, err := usecase.NewTranslationUsecase(mockRepo).Export(ctx, filter)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "translations.xlsx")
+	require.NoError(t, os.WriteFile(path, excelBytes, 0o644))
+	f, err := excelize.OpenFile(path)
+	require.NoError(t, err)
+	defer f.Close()
+	rows, err := f.GetRows("Translations")
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, []string{"group", entityID.String(), "GRP-001", "name", "en", "Electronics", "Elektronik"}, rows[1])
+	mockRepo.AssertExpectations(t)
+}
+
+func writeTranslationImportFile(t *testing.T, rows [][]string) string {
+	t.Helper()
+	f := excelize.NewFile()
+	defer f.Close()
+	require.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]string{"Entity Type", "Entity ID", "Entity Code", "Field", "Locale", "Value"}))
+	for i, row := range rows {
+		cell, _ := excelize.CoordinatesToCellName(1, i+2)
+		require.NoError(t, f.SetSheetRow("Sheet1", cell, &row))
+	}
+	path := filepath.Join(t.TempDir(), "translations.xlsx")
+	require.NoError(t, f.SaveAs(path))
+	return path
+}
+
+func TestImportTranslations(t *testing.T) {
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+	ctx := context.Background()
+	groupID := uuid.New()
+	provinceID := uuid.New()
+	missingID := uuid.New()
+
+	path := writeTranslationImportFile(t, [][]string{
+		{"group", "", "GRP-001", "name", "en", "Electronics"},
+		{"province", provinceID.String(), "", "name", "EN", "Jakarta"},
+		{"group", groupID.String(), "", "name", "en", "Electronic Goods"},
+		{"group", "", "GRP-404", "name", "en", "Unknown"},
+		{"province", missingID.String(), "", "name", "en", "Missing"},
+		{"customer", "", "C-1", "name", "en", "Customer"},
+		{"group", "", "GRP-001", "name", "id", ""},
+		{"group", "not-a-uuid", "", "name", "en", "Invalid"},
+	})
+	defer os.Remove(path)
+
+	mockRepo := new(MockTranslationRepository)
+	mockRepo.On("FindEntities", ctx, "group", []uuid.UUID{groupID}, []string{"GRP-001", "GRP-404"}).
+		Return([]mod.EntityRef{{ID: groupID, Code: "GRP-001"}}, nil).Once()
+	mockRepo.On("FindEntities", ctx, "province", []uuid.UUID{provinceID, missingID}, []string(nil)).
+		Return([]mod.EntityRef{{ID: provinceID, Code: "31"}}, nil).Once()
+	mockRepo.On("Upsert", ctx, []models.Translation{
+		{EntityType: "group", EntityID: groupID, Field: "name", Locale: "en", Value: "Electronics", CreatedBy: "test-auth-id", UpdatedBy: "test-auth-id"},
+		{EntityType: "province", EntityID: provinceID, Field: "name", Locale: "en", Value: "Jakarta", CreatedBy: "test-auth-id", UpdatedBy: "test-auth-id"},
+	}).Return(nil).Once()
+
+	res, err := usecase.NewTranslationUsecase(mockRepo).ImportFromExcel(ctx, path, "test-auth-id")
+	require.NoError(t, err)
+
+	assert.Equal(t, 8, res.TotalRows)
+	assert.Equal(t, 2, res.SuccessCount)
+	assert.Equal(t, 6, res.FailedCount)
+	assert.Equal(t, "success", res.Results[0].Status)
+	assert.Equal(t, "success", res.Results[1].Status)
+	assert.Equal(t, fmt.Sprintf(constants.TranslationImportRowDuplicated, 2), res.Results[2].ErrorMessage)
+	assert.Equal(t, fmt.Sprintf(constants.TranslationEntityCodeMissing, "group", "GRP-404"), res.Results[3].ErrorMessage)
+	assert.Equal(t, fmt.Sprintf(constants.TranslationEntityNotFound, "province", missingID), res.Results[4].ErrorMessage)
+	assert.Equal(t, fmt.Sprintf(constants.TranslationEntityTypeInvalid, strings.Join(constants.TranslationEntityTypes, ", ")), res.Results[5].ErrorMessage)
+	assert.Equal(t, fmt.Sprintf(constants.TranslationImportFieldRequired, "value")+"; "+
+		fmt.Sprintf(constants.TranslationLocaleInvalid, strings.Join(constants.TranslationLocales, ", ")), res.Results[6].ErrorMessage)
+	assert.Equal(t, constants.TranslationImportEntityIDInvalid, res.Results[7].ErrorMessage)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,19 @@
+package translation
+
+import (
+	"context"
+
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/translation/dto"
+)
+
+type Usecase interface {
+	Create(ctx context.Context, req *dto.ReqCreateTranslation, authId string) (*models.Translation, error)
+	Update(ctx context.Context, id string, req *dto.ReqUpdateTranslation, authId string) (*models.Translation, error)
+	Delete(ctx context.Context, id string, authId string) error
+	GetByID(ctx context.Context, id string) (*models.Translation, error)
+	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqTranslationIndexFilter) ([]models.Translation, int, error)
+	Export(ctx context.Context, filter dto.ReqTranslationIndexFilter) ([]byte, error)
+	ImportFromExcel(ctx context.Context, filePath string, authId string) (*dto.ResImportTranslations, error)
+}
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/request"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/translation"
+	"github.com/rendyfutsuy/base-go/modules/translation/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/xuri/excelize/v2"
+	"gorm.io/gorm"
+)
+
+type translationUsecase struct {
+	repo mod.Repository
+}
+
+func NewTranslationUsecase(repo mod.Repository) mod.Usecase {
+	return &translationUsecase{repo: repo}
+}
+
+func (u *translationUsecase) Create(ctx context.Context, reqBody *dto.ReqCreateTranslation, userID string) (*models.Translation, error) {
+	entityType := strings.TrimSpace(reqBody.EntityType)
+	field := strings.TrimSpace(reqBody.Field)
+	locale := strings.ToLower(strings.TrimSpace(reqBody.Locale))
+	if err := validateTranslationKey(entityType, field, locale); err != nil {
+		return nil, err
+	}
+
+	// The translated entity must exist
+	exists, err := u.repo.EntityExists(ctx, entityType, reqBody.EntityID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf(constants.TranslationEntityNotFound, entityType, reqBody.EntityID)
+	}
+
+	// One translation per entity, field and locale
+	exists, err = u.repo.ExistsByKey(ctx, entityType, reqBody.EntityID, field, locale, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New(constants.TranslationAlreadyExists)
+	}
+
+	return u.repo.Create(ctx, models.Translation{
+		EntityType: entityType,
+		EntityID:   reqBody.EntityID,
+		Field:      field,
+		Locale:     locale,
+		Value:      strings.TrimSpace(reqBody.Value),
+		CreatedBy:  userID,
+		UpdatedBy:  userID,
+	})
+}
+
+func (u *translationUsecase) Update(ctx context.Context, id string, reqBody *dto.ReqUpdateTranslation, userID string) (*models.Translation, error) {
+	tid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	res, err := u.repo.Update(ctx, tid, strings.TrimSpace(reqBody.Value), userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.TranslationNotFound, id)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *translationUsecase) Delete(ctx context.Context, id string, userID string) error {
+	tid, err := utils.StringToUUID(id)
+	if err != nil {
+		return err
+	}
+	if err := u.repo.Delete(ctx, tid); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf(constants.TranslationNotFound, id)
+		}
+		return err
+	}
+	return nil
+}
+
+func (u *translationUsecase) GetByID(ctx context.Context, id string) (*models.Translation, error) {
+	tid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	res, err := u.repo.GetByID(ctx, tid)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.TranslationNotFound, id)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *translationUsecase) GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqTranslationIndexFilter) ([]models.Translation, int, error) {
+	// Search is already set in req.Search from PageRequest middleware
+	return u.repo.GetIndex(ctx, req, filter)
+}
+
+func (u *translationUsecase) Export(ctx context.Context, filter dto.ReqTranslationIndexFilter) ([]byte, error) {
+	// Use GetAll for export without pagination
+	list, err := u.repo.GetAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create Excel file
+	f := excelize.NewFile()
+	sheet := "Translations"
+	f.SetSheetName("Sheet1", sheet)
+
+	// Header, the first six columns are the columns of the import
+	headers := []string{"Entity Type", "Entity ID", "Entity Code", "Field", "Locale", "Value", "Base Value"}
+	for i, header := range headers {
+		col, _ := excelize.ColumnNumberToName(i + 1)
+		f.SetCellValue(sheet, col+"1", header)
+	}
+
+	// Rows, written as text so they import back unchanged
+	for i, t := range list {
+		row := strconv.Itoa(i + 2)
+		f.SetCellStr(sheet, "A"+row, t.EntityType)
+		f.SetCellStr(sheet, "B"+row, t.EntityID.String())
+		f.SetCellStr(sheet, "C"+row, t.EntityCode)
+		f.SetCellStr(sheet, "D"+row, t.Field)
+		f.SetCellStr(sheet, "E"+row, t.Locale)
+		f.SetCellStr(sheet, "F"+row, t.Value)
+		f.SetCellStr(sheet, "G"+row, t.BaseValue)
+	}
+
+	// Write to buffer and return bytes
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// validateTranslationKey checks the entity type, the field and the locale are translatable
+func validateTranslationKey(entityType, field, locale string) error {
+	if !containsTranslationValue(constants.TranslationEntityTypes, entityType) {
+		return fmt.Errorf(constants.TranslationEntityTypeInvalid, strings.Join(constants.TranslationEntityTypes, ", "))
+	}
+	if !containsTranslationValue(constants.TranslationFields, field) {
+		return fmt.Errorf(constants.TranslationFieldInvalid, strings.Join(constants.TranslationFields, ", "))
+	}
+	if !containsTranslationValue(constants.TranslationLocales, locale) {
+		return fmt.Errorf(constants.TranslationLocaleInvalid, strings.Join(constants.TranslationLocales, ", "))
+	}
+	return nil
+}
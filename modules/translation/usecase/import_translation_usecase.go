@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/translation"
+	"github.com/rendyfutsuy/base-go/modules/translation/dto"
+)
+
+// translationImportRow is a parsed row of a translation import with its validation errors
+type translationImportRow struct {
+	rowNum     int
+	entityType string
+	entityID   uuid.UUID
+	entityCode string
+	field      string
+	locale     string
+	value      string
+	errors     []string
+}
+
+// translationEntityIndex finds the entities of one type by ID or code
+type translationEntityIndex struct {
+	byID   map[uuid.UUID]mod.EntityRef
+	byCode map[string]mod.EntityRef
+}
+
+// ImportFromExcel creates or updates (by entity, field and locale) translations from the rows of an Excel file
+// with columns: entity_type, entity_id, entity_code, field, locale, value.
+// The entity is found by entity_id when given, otherwise by entity_code.
+func (u *translationUsecase) ImportFromExcel(ctx context.Context, filePath string, authId string) (*dto.ResImportTranslations, error) {
+	rows, err := readTranslationImportRows(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	importRows := make([]*translationImportRow, 0, len(rows))
+	for i, row := range rows {
+		if isBlankTranslationImportRow(row) {
+			continue
+		}
+		importRows = append(importRows, parseTranslationImportRow(i+2, row)) // Excel row number, after the header
+	}
+
+	// Load the entities used by the file at once per type
+	entities, err := u.loadTranslationImportEntities(ctx, importRows)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve the entity of each row and report the rows translating the same field twice
+	seenKeys := make(map[string]int)
+	for _, importRow := range importRows {
+		if len(importRow.errors) > 0 {
+			continue
+		}
+		index := entities[importRow.entityType]
+		if importRow.entityID != uuid.Nil {
+			if _, ok := index.byID[importRow.entityID]; !ok {
+				importRow.errors = append(importRow.errors, fmt.Sprintf(constants.TranslationEntityNotFound, importRow.entityType, importRow.entityID))
+				continue
+			}
+		} else if ref, ok := index.byCode[importRow.entityCode]; ok {
+			importRow.entityID = ref.ID
+		} else {
+			importRow.errors = append(importRow.errors, fmt.Sprintf(constants.TranslationEntityCodeMissing, importRow.entityType, importRow.entityCode))
+			continue
+		}
+
+		key := strings.Join([]string{importRow.entityType, importRow.entityID.String(), importRow.field, importRow.locale}, "|")
+		if firstRow, ok := seenKeys[key]; ok {
+			importRow.errors = append(importRow.errors, fmt.Sprintf(constants.TranslationImportRowDuplicated, firstRow))
+			continue
+		}
+		seenKeys[key] = importRow.rowNum
+	}
+
+	results := make([]dto.ResImportTranslationExcel, 0, len(importRows))
+	validTranslations := make([]models.Translation, 0, len(importRows))
+	validResultIndices := make([]int, 0, len(importRows))
+	for _, importRow := range importRows {
+		result := dto.ResImportTranslationExcel{Row: importRow.rowNum, EntityType: importRow.entityType, EntityCode: importRow.entityCode}
+		if result.EntityCode == "" && importRow.entityID != uuid.Nil {
+			result.EntityCode = importRow.entityID.String()
+		}
+		if len(importRow.errors) > 0 {
+			result.Status = "failed"
+			result.ErrorMessage = strings.Join(importRow.errors, "; ")
+			results = append(results, result)
+			continue
+		}
+
+		validTranslations = append(validTranslations, models.Translation{
+			EntityType: importRow.entityType,
+			EntityID:   importRow.entityID,
+			Field:      importRow.field,
+			Locale:     importRow.locale,
+			Value:      importRow.value,
+			CreatedBy:  authId,
+			UpdatedBy:  authId,
+		})
+		validResultIndices = append(validResultIndices, len(results))
+
+		// Mark as success (will be validated after batch save)
+		result.Success = true
+		result.Status = "success"
+		results = append(results, result)
+	}
+
+	if len(validTranslations) > 0 {
+		if err := u.repo.Upsert(ctx, validTranslations); err != nil {
+			// If batch save fails, mark all pending rows as failed
+			for _, idx := range validResultIndices {
+				results[idx].Success = false
+				results[idx].Status = "failed"
+				results[idx].ErrorMessage = fmt.Sprintf("%s: %v", constants.TranslationImportBatchSaveFailed, err)
+			}
+		}
+	}
+
+	return toResImportTranslations(results), nil
+}
+
+// parseTranslationImportRow reads the cells of a row and checks the required fields and the translatable values
+func parseTranslationImportRow(rowNum int, row []string) *translationImportRow {
+	importRow := &translationImportRow{
+		rowNum:     rowNum,
+		entityType: translationImportCell(row, 0),
+		entityCode: translationImportCell(row, 2),
+		field:      translationImportCell(row, 3),
+		locale:     strings.ToLower(translationImportCell(row, 4)),
+		value:      translationImportCell(row, 5),
+	}
+
+	required := []struct{ name, value string }{
+		{"entity_type", importRow.entityType},
+		{"field", importRow.field},
+		{"locale", importRow.locale},
+		{"value", importRow.value},
+	}
+	for _, cell := range required {
+		if cell.value == "" {
+			importRow.errors = append(importRow.errors, fmt.Sprintf(constants.TranslationImportFieldRequired, cell.name))
+		}
+	}
+
+	if importRow.entityType != "" && !containsTranslationValue(constants.TranslationEntityTypes, importRow.entityType) {
+		importRow.errors = append(importRow.errors, fmt.Sprintf(constants.TranslationEntityTypeInvalid, strings.Join(constants.TranslationEntityTypes, ", ")))
+	}
+	if importRow.field != "" && !containsTranslationValue(constants.TranslationFields, importRow.field) {
+		importRow.errors = append(importRow.errors, fmt.Sprintf(constants.TranslationFieldInvalid, strings.Join(constants.TranslationFields, ", ")))
+	}
+	if importRow.locale != "" && !containsTranslationValue(constants.TranslationLocales, importRow.locale) {
+		importRow.errors = append(importRow.errors, fmt.Sprintf(constants.TranslationLocaleInvalid, strings.Join(constants.TranslationLocales, ", ")))
+	}
+
+	if rawID := translationImportCell(row, 1); rawID != "" {
+		entityID, err := uuid.Parse(rawID)
+		if err != nil {
+			importRow.errors = append(importRow.errors, constants.TranslationImportEntityIDInvalid)
+		} else {
+			importRow.entityID = entityID
+		}
+	} else if importRow.entityCode == "" {
+		importRow.errors = append(importRow.errors, constants.TranslationImportEntityRequired)
+	}
+	return importRow
+}
+
+// loadTranslationImportEntities finds the entities referenced by the valid rows, per entity type
+func (u *translationUsecase) loadTranslationImportEntities(ctx context.Context, importRows []*translationImportRow) (map[string]translationEntityIndex, error) {
+	ids := make(map[string][]uuid.UUID)
+	codes := make(map[string][]string)
+	for _, importRow := range importRows {
+		if len(importRow.errors) > 0 {
+			continue
+		}
+		if importRow.entityID != uuid.Nil {
+			ids[importRow.entityType] = append(ids[importRow.entityType], importRow.entityID)
+		} else {
+			codes[importRow.entityType] = append(codes[importRow.entityType], importRow.entityCode)
+		}
+	}
+
+	entities := make(map[string]translationEntityIndex)
+	for _, entityType := range constants.TranslationEntityTypes {
+		if len(ids[entityType]) == 0 && len(codes[entityType]) == 0 {
+			continue
+		}
+		refs, err := u.repo.FindEntities(ctx, entityType, ids[entityType], codes[entityType])
+		if err != nil {
+			return nil, err
+		}
+		index := translationEntityIndex{
+			byID:   make(map[uuid.UUID]mod.EntityRef, len(refs)),
+			byCode: make(map[string]mod.EntityRef, len(refs)),
+		}
+		for _, ref := range refs {
+			index.byID[ref.ID] = ref
+			if _, ok := index.byCode[ref.Code]; !ok && ref.Code != "" {
+				index.byCode[ref.Code] = ref
+			}
+		}
+		entities[entityType] = index
+	}
+	return entities, nil
+}
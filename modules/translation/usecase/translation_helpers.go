@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/modules/translation/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/xuri/excelize/v2"
+)
+
+func containsTranslationValue(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// readTranslationImportRows returns the data rows of the first sheet, without the header row
+func readTranslationImportRows(filePath string) ([][]string, error) {
+	f, err := excelize.OpenFile(filePath)
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, fmt.Errorf("%s: %v", constants.TranslationImportExcelOpenFailed, err)
+	}
+	defer f.Close()
+
+	rows, err := f.GetRows(f.GetSheetName(0))
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, fmt.Errorf("%s: %v", constants.TranslationImportExcelReadFailed, err)
+	}
+
+	if len(rows) < 2 {
+		return nil, errors.New(constants.TranslationImportExcelInsufficientRows)
+	}
+
+	return rows[1:], nil
+}
+
+func translationImportCell(row []string, index int) string {
+	if index < len(row) {
+		return strings.TrimSpace(row[index])
+	}
+	return ""
+}
+
+func isBlankTranslationImportRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func toResImportTranslations(results []dto.ResImportTranslationExcel) *dto.ResImportTranslations {
+	successCount := 0
+	failedCount := 0
+	for _, result := range results {
+		if result.Status == "success" {
+			successCount++
+		} else {
+			failedCount++
+		}
+	}
+
+	return &dto.ResImportTranslations{
+		TotalRows:    len(results),
+		SuccessCount: successCount,
+		FailedCount:  failedCount,
+		Results:      results,
+	}
+}
@@ -66,6 +66,10 @@ func NewTypeHandler(e *echo.Echo, uc type_module.Usecase, mwP _reqContext.IMiddl
 
 	// Delete
 	r.DELETE("/:id", h.Delete, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToDelete))
+
+	// Move to another sub-group / merge into another type
+	r.POST("/:id/move", h.Move, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.POST("/:id/merge", h.Merge, middleware.RequireActivatedUser, h.middlewarePermission.PermissionValidation(permissionToUpdate))
 }
 
 // Create godoc
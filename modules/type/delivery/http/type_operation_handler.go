@@ -0,0 +1,100 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/type/dto"
+)
+
+// Move godoc
+// @Summary		Move type to another sub-group
+// @Description	Re-parent a type and its backings to another sub-group in one transaction. Name must be unique within the target sub-group. When regenerate_codes is true the type and its backings get new codes. The operation is recorded in the taxonomy audit log.
+// @Tags			Jenis
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string					true	"Type UUID"
+// @Param			request	body	dto.ReqMoveType		true	"Target sub-group. Fields: subgroup_id (required, UUID), regenerate_codes (optional)"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespType}	"Successfully moved type"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, same sub-group or duplicate name in target sub-group"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/type/{id}/move [post]
+func (h *TypeHandler) Move(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("id")
+	req := new(dto.ReqMoveType)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// Get user ID from context
+	user := c.Get("user")
+	userID := ""
+	if user != nil {
+		if userModel, ok := user.(models.User); ok {
+			userID = userModel.ID.String()
+		}
+	}
+
+	res, err := h.Usecase.Move(ctx, id, req, userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespType(*res))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Merge godoc
+// @Summary		Merge type into another type
+// @Description	Move all active backings of a duplicate type into the target type and soft delete the duplicate, in one transaction. Fails when the target already has backings with the same names. When regenerate_codes is true the moved backings get new codes. The operation is recorded in the taxonomy audit log.
+// @Tags			Jenis
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string					true	"Duplicate (source) type UUID"
+// @Param			request	body	dto.ReqMergeType	true	"Target type. Fields: target_id (required, UUID), regenerate_codes (optional)"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespType}	"Successfully merged, returns the target type"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error, merge into itself or conflicting backing names"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/type/{id}/merge [post]
+func (h *TypeHandler) Merge(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("id")
+	req := new(dto.ReqMergeType)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// Get user ID from context
+	user := c.Get("user")
+	userID := ""
+	if user != nil {
+		if userModel, ok := user.(models.User); ok {
+			userID = userModel.ID.String()
+		}
+	}
+
+	res, err := h.Usecase.Merge(ctx, id, req, userID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(dto.ToRespType(*res))
+	return c.JSON(http.StatusOK, resp)
+}
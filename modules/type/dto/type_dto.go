@@ -17,6 +17,18 @@ type ReqUpdateType struct {
 	Name       string    `form:"name" json:"name" validate:"required,max=255"`
 }
 
+// ReqMoveType moves a type with its backings to another sub-group
+type ReqMoveType struct {
+	SubgroupID      uuid.UUID `form:"subgroup_id" json:"subgroup_id" validate:"required"`
+	RegenerateCodes bool      `form:"regenerate_codes" json:"regenerate_codes"` // new codes for the type and its backings
+}
+
+// ReqMergeType merges a duplicate type into the target, the source is soft-deleted
+type ReqMergeType struct {
+	TargetID        uuid.UUID `form:"target_id" json:"target_id" validate:"required"`
+	RegenerateCodes bool      `form:"regenerate_codes" json:"regenerate_codes"` // new codes for the moved backings
+}
+
 type RespType struct {
 	ID           uuid.UUID `json:"id"`
 	SubgroupID   uuid.UUID `json:"subgroup_id"`
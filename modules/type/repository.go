@@ -18,4 +18,21 @@ type Repository interface {
 	GetAll(ctx context.Context, filter dto.ReqTypeIndexFilter) ([]models.Type, error)
 	ExistsByNameInSubgroup(ctx context.Context, subgroupID uuid.UUID, name string, excludeID uuid.UUID) (bool, error)
 	ExistsInBackings(ctx context.Context, typeID uuid.UUID) (bool, error)
+	GetConflictingBackingNames(ctx context.Context, sourceID uuid.UUID, targetID uuid.UUID) ([]string, error)
+	Move(ctx context.Context, id uuid.UUID, params MoveTypeParams) error
+	Merge(ctx context.Context, sourceID uuid.UUID, params MergeTypeParams) error
+}
+
+// MoveTypeParams re-parents a type (and its backings) to another sub-group
+type MoveTypeParams struct {
+	SubgroupID      uuid.UUID
+	RegenerateCodes bool // new codes for the type and its backings
+	MovedBy         string
+}
+
+// MergeTypeParams moves the backings of a type into TargetID and soft-deletes the source
+type MergeTypeParams struct {
+	TargetID        uuid.UUID
+	RegenerateCodes bool // new codes for the moved backings
+	MergedBy        string
 }
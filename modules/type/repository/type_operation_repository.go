@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/codegen"
+	"github.com/rendyfutsuy/base-go/models"
+	typemodule "github.com/rendyfutsuy/base-go/modules/type"
+	"gorm.io/gorm"
+)
+
+// GetConflictingBackingNames returns the names of the active backings of sourceID already used by active backings of targetID
+func (r *typeRepository) GetConflictingBackingNames(ctx context.Context, sourceID uuid.UUID, targetID uuid.UUID) ([]string, error) {
+	var names []string
+	err := r.DB.WithContext(ctx).Table("backings b").
+		Where("b.type_id = ? AND b.deleted_at IS NULL", sourceID).
+		Where("EXISTS (SELECT 1 FROM backings bb WHERE bb.type_id = ? AND bb.name = b.name AND bb.deleted_at IS NULL)", targetID).
+		Order("b.name").
+		Pluck("b.name", &names).Error
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+func (r *typeRepository) Move(ctx context.Context, id uuid.UUID, params typemodule.MoveTypeParams) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		current := &models.Type{}
+		if err := tx.Select("id", "subgroup_id", "type_code").Where("id = ? AND deleted_at IS NULL", id).First(current).Error; err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		updates := map[string]interface{}{
+			"subgroup_id": params.SubgroupID,
+			"updated_at":  now,
+			"updated_by":  params.MovedBy,
+		}
+		if err := tx.Model(&models.Type{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+			return err
+		}
+		if params.RegenerateCodes {
+			if err := codegen.Recode(tx, constants.CodeEntityType, []uuid.UUID{id}, params.MovedBy, now); err != nil {
+				return err
+			}
+		}
+
+		backingIDs, err := activeBackingIDs(tx, id)
+		if err != nil {
+			return err
+		}
+		if params.RegenerateCodes {
+			if err := regenerateBackingCodes(tx, backingIDs, params.MovedBy, now); err != nil {
+				return err
+			}
+		}
+
+		var newCode string
+		if err := tx.Model(&models.Type{}).Where("id = ?", id).Pluck("type_code", &newCode).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&models.TaxonomyOperation{
+			Resource:         constants.CatalogTreeLevelType,
+			Operation:        constants.TaxonomyOperationMove,
+			SourceID:         id,
+			TargetID:         params.SubgroupID,
+			PreviousParentID: &current.SubgroupID,
+			PreviousCode:     &current.TypeCode,
+			NewCode:          &newCode,
+			RegenerateCodes:  params.RegenerateCodes,
+			AffectedChildren: len(backingIDs),
+			CreatedBy:        params.MovedBy,
+			CreatedAt:        now,
+		}).Error
+	})
+}
+
+func (r *typeRepository) Merge(ctx context.Context, sourceID uuid.UUID, params typemodule.MergeTypeParams) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		source := &models.Type{}
+		if err := tx.Select("id", "subgroup_id", "type_code").Where("id = ? AND deleted_at IS NULL", sourceID).First(source).Error; err != nil {
+			return err
+		}
+
+		backingIDs, err := activeBackingIDs(tx, sourceID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		if len(backingIDs) > 0 {
+			err := tx.Model(&models.Backing{}).Where("id IN (?)", backingIDs).Updates(map[string]interface{}{
+				"type_id":    params.TargetID,
+				"updated_at": now,
+				"updated_by": params.MergedBy,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+		if params.RegenerateCodes {
+			if err := regenerateBackingCodes(tx, backingIDs, params.MergedBy, now); err != nil {
+				return err
+			}
+		}
+
+		err = tx.Model(&models.Type{}).Where("id = ?", sourceID).Updates(map[string]interface{}{
+			"deleted_at": now,
+			"deleted_by": params.MergedBy,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&models.TaxonomyOperation{
+			Resource:         constants.CatalogTreeLevelType,
+			Operation:        constants.TaxonomyOperationMerge,
+			SourceID:         sourceID,
+			TargetID:         params.TargetID,
+			PreviousParentID: &source.SubgroupID,
+			PreviousCode:     &source.TypeCode,
+			RegenerateCodes:  params.RegenerateCodes,
+			AffectedChildren: len(backingIDs),
+			CreatedBy:        params.MergedBy,
+			CreatedAt:        now,
+		}).Error
+	})
+}
+
+// activeBackingIDs returns the ids of the active backings of a type
+func activeBackingIDs(tx *gorm.DB, typeID uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := tx.Model(&models.Backing{}).Where("type_id = ? AND deleted_at IS NULL", typeID).Order("created_at").Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// regenerateBackingCodes gives the backings new codes from the backing code policy
+func regenerateBackingCodes(tx *gorm.DB, backingIDs []uuid.UUID, updatedBy string, now time.Time) error {
+	return codegen.Recode(tx, constants.CodeEntityBacking, backingIDs, updatedBy, now)
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/codegen"
+	"github.com/rendyfutsuy/base-go/helpers/i18n"
 	"github.com/rendyfutsuy/base-go/helpers/request"
 	"github.com/rendyfutsuy/base-go/models"
 	"github.com/rendyfutsuy/base-go/modules/type/dto"
@@ -34,18 +36,22 @@ func (r *typeRepository) Create(ctx context.Context, subgroupID uuid.UUID, name
 		UpdatedAt:  now,
 		UpdatedBy:  createdBy,
 	}
-	// Omit type_code to let database generate it using DEFAULT generate_type_code()
-	if err := r.DB.WithContext(ctx).Omit("type_code").Create(t).Error; err != nil {
+	// type_code is allocated from the type code policy in the same transaction
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		code, err := codegen.Allocate(tx, constants.CodeEntityType, &subgroupID)
+		if err != nil {
+			return err
+		}
+		t.TypeCode = code
+		return tx.Create(t).Error
+	})
+	if err != nil {
 		return nil, err
 	}
 	// if t not update, return error
 	if t.ID == uuid.Nil {
 		return nil, errors.New(constants.TypeCreateFailedIDNotSet)
 	}
-	// Reload to get the generated type_code from database (generated by trigger)
-	if err := r.DB.WithContext(ctx).Select("id", "subgroup_id", "type_code", "name", "created_at", "created_by", "updated_at", "updated_by").Where("id = ?", t.ID).First(t).Error; err != nil {
-		return nil, err
-	}
 	return t, nil
 }
 
@@ -84,14 +90,14 @@ func (r *typeRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Typ
 			t.id,
 			t.subgroup_id,
 			t.type_code,
-			t.name,
+			`+i18n.NameColumn(ctx, constants.TranslationEntityType, "t.id", "t.name")+` AS name,
 			t.created_at,
 			t.created_by,
 			t.updated_at,
 			t.updated_by,
-			sg.name as subgroup_name,
+			`+i18n.NameColumn(ctx, constants.TranslationEntitySubGroup, "sg.id", "sg.name")+` AS subgroup_name,
 			sg.groups_id as groups_id,
-			gg.name as groups_name,
+			`+i18n.NameColumn(ctx, constants.TranslationEntityGroup, "gg.id", "gg.name")+` AS groups_name,
 			NOT EXISTS (
 				SELECT 1 FROM backings b
 				WHERE b.type_id = t.id AND b.deleted_at IS NULL
@@ -144,11 +150,11 @@ func (r *typeRepository) GetIndex(ctx context.Context, req request.PageRequest,
 			t.id,
 			t.subgroup_id,
 			t.type_code,
-			t.name,
+			` + i18n.NameColumn(ctx, constants.TranslationEntityType, "t.id", "t.name") + ` AS name,
 			t.created_at,
 			t.updated_at,
-			sg.name as subgroup_name,
-			gg.name as groups_name,
+			` + i18n.NameColumn(ctx, constants.TranslationEntitySubGroup, "sg.id", "sg.name") + ` AS subgroup_name,
+			` + i18n.NameColumn(ctx, constants.TranslationEntityGroup, "gg.id", "gg.name") + ` AS groups_name,
 			NOT EXISTS (
 				SELECT 1 FROM backings b
 				WHERE b.type_id = t.id AND b.deleted_at IS NULL
@@ -3,6 +3,8 @@ package test
 import (
 	"context"
 	"errors"
+	subgroup "github.com/rendyfutsuy/base-go/modules/sub-group"
+	typemodule "github.com/rendyfutsuy/base-go/modules/type"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -140,6 +142,42 @@ func (m *MockSubGroupRepository) ExistsInTypes(ctx context.Context, subGroupID u
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockSubGroupRepository) GetConflictingTypeNames(ctx context.Context, sourceID uuid.UUID, targetID uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, sourceID, targetID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockSubGroupRepository) Move(ctx context.Context, id uuid.UUID, params subgroup.MoveSubGroupParams) error {
+	args := m.Called(ctx, id, params)
+	return args.Error(0)
+}
+
+func (m *MockSubGroupRepository) Merge(ctx context.Context, sourceID uuid.UUID, params subgroup.MergeSubGroupParams) error {
+	args := m.Called(ctx, sourceID, params)
+	return args.Error(0)
+}
+
+func (m *MockTypeRepository) GetConflictingBackingNames(ctx context.Context, sourceID uuid.UUID, targetID uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, sourceID, targetID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockTypeRepository) Move(ctx context.Context, id uuid.UUID, params typemodule.MoveTypeParams) error {
+	args := m.Called(ctx, id, params)
+	return args.Error(0)
+}
+
+func (m *MockTypeRepository) Merge(ctx context.Context, sourceID uuid.UUID, params typemodule.MergeTypeParams) error {
+	args := m.Called(ctx, sourceID, params)
+	return args.Error(0)
+}
+
 func TestCreateType(t *testing.T) {
 	e := echo.New()
 	ctx := context.Background()
@@ -80,6 +80,22 @@ func (m *mockTypeUsecase) Export(ctx context.Context, filter dto.ReqTypeIndexFil
 	return args.Get(0).([]byte), args.Error(1)
 }
 
+func (m *mockTypeUsecase) Move(ctx context.Context, id string, req *dto.ReqMoveType, authId string) (*models.Type, error) {
+	args := m.Called(ctx, id, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Type), args.Error(1)
+}
+
+func (m *mockTypeUsecase) Merge(ctx context.Context, id string, req *dto.ReqMergeType, authId string) (*models.Type, error) {
+	args := m.Called(ctx, id, req, authId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Type), args.Error(1)
+}
+
 func newTypeEcho() *echo.Echo {
 	e := echo.New()
 	v := validator.New()
@@ -267,3 +283,22 @@ func TestTypeHandler_ExportValidateError(t *testing.T) {
 	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
 	assert.NotEmpty(t, resp.Message)
 }
+
+func TestTypeHandler_MergeValidationError(t *testing.T) {
+	e := newTypeEcho()
+	typeID := uuid.New().String()
+	req := httptest.NewRequest(http.MethodPost, "/v1/type/"+typeID+"/merge", strings.NewReader(`{"regenerate_codes":true}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(typeID)
+
+	mockUC := new(mockTypeUsecase)
+	handler := &typeHttp.TypeHandler{Usecase: mockUC}
+
+	err := handler.Merge(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockUC.AssertNotCalled(t, "Merge", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,130 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	typemodule "github.com/rendyfutsuy/base-go/modules/type"
+	typeDto "github.com/rendyfutsuy/base-go/modules/type/dto"
+	"github.com/rendyfutsuy/base-go/modules/type/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestMoveType(t *testing.T) {
+	ctx := context.Background()
+	typeID := uuid.New()
+	fromSubgroupID := uuid.New()
+	toSubgroupID := uuid.New()
+	current := &models.Type{ID: typeID, SubgroupID: fromSubgroupID, TypeCode: "03", Name: "POLOS"}
+
+	tests := []struct {
+		name          string
+		req           *typeDto.ReqMoveType
+		setupMock     func(*MockTypeRepository, *MockSubGroupRepository)
+		expectedError string
+	}{
+		{
+			name: "success move",
+			req:  &typeDto.ReqMoveType{SubgroupID: toSubgroupID},
+			setupMock: func(m *MockTypeRepository, ms *MockSubGroupRepository) {
+				m.On("GetByID", ctx, typeID).Return(current, nil).Once()
+				ms.On("GetByID", ctx, toSubgroupID).Return(&models.SubGroup{ID: toSubgroupID}, nil).Once()
+				m.On("ExistsByNameInSubgroup", ctx, toSubgroupID, "POLOS", typeID).Return(false, nil).Once()
+				m.On("Move", ctx, typeID, typemodule.MoveTypeParams{SubgroupID: toSubgroupID, MovedBy: "test-auth-id"}).Return(nil).Once()
+				m.On("GetByID", ctx, typeID).Return(&models.Type{ID: typeID, SubgroupID: toSubgroupID, TypeCode: "03", Name: "POLOS"}, nil).Once()
+			},
+		},
+		{
+			name: "error when already in target sub-group",
+			req:  &typeDto.ReqMoveType{SubgroupID: fromSubgroupID},
+			setupMock: func(m *MockTypeRepository, ms *MockSubGroupRepository) {
+				m.On("GetByID", ctx, typeID).Return(current, nil).Once()
+			},
+			expectedError: constants.TypeMoveSameSubGroup,
+		},
+		{
+			name: "error when type not found",
+			req:  &typeDto.ReqMoveType{SubgroupID: toSubgroupID},
+			setupMock: func(m *MockTypeRepository, ms *MockSubGroupRepository) {
+				m.On("GetByID", ctx, typeID).Return(nil, gorm.ErrRecordNotFound).Once()
+			},
+			expectedError: fmt.Sprintf(constants.TypeNotFound, typeID),
+		},
+		{
+			name: "error when name already used in target sub-group",
+			req:  &typeDto.ReqMoveType{SubgroupID: toSubgroupID},
+			setupMock: func(m *MockTypeRepository, ms *MockSubGroupRepository) {
+				m.On("GetByID", ctx, typeID).Return(current, nil).Once()
+				ms.On("GetByID", ctx, toSubgroupID).Return(&models.SubGroup{ID: toSubgroupID}, nil).Once()
+				m.On("ExistsByNameInSubgroup", ctx, toSubgroupID, "POLOS", typeID).Return(true, nil).Once()
+			},
+			expectedError: constants.TypeNameAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTypeRepository)
+			mockSubGroupRepo := new(MockSubGroupRepository)
+			tt.setupMock(mockRepo, mockSubGroupRepo)
+
+			result, err := usecase.NewTypeUsecase(mockRepo, mockSubGroupRepo).Move(ctx, typeID.String(), tt.req, "test-auth-id")
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, toSubgroupID, result.SubgroupID)
+			}
+			mockRepo.AssertExpectations(t)
+			mockSubGroupRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMergeType(t *testing.T) {
+	ctx := context.Background()
+	sourceID := uuid.New()
+	targetID := uuid.New()
+
+	t.Run("success merge with regenerated codes", func(t *testing.T) {
+		mockRepo := new(MockTypeRepository)
+		mockRepo.On("GetByID", ctx, sourceID).Return(&models.Type{ID: sourceID}, nil).Once()
+		mockRepo.On("GetByID", ctx, targetID).Return(&models.Type{ID: targetID}, nil).Once()
+		mockRepo.On("GetConflictingBackingNames", ctx, sourceID, targetID).Return([]string{}, nil).Once()
+		mockRepo.On("Merge", ctx, sourceID, typemodule.MergeTypeParams{TargetID: targetID, RegenerateCodes: true, MergedBy: "test-auth-id"}).Return(nil).Once()
+		mockRepo.On("GetByID", ctx, targetID).Return(&models.Type{ID: targetID, Name: "POLOS"}, nil).Once()
+
+		req := &typeDto.ReqMergeType{TargetID: targetID, RegenerateCodes: true}
+		result, err := usecase.NewTypeUsecase(mockRepo, new(MockSubGroupRepository)).Merge(ctx, sourceID.String(), req, "test-auth-id")
+		require.NoError(t, err)
+		assert.Equal(t, targetID, result.ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error when backing names conflict", func(t *testing.T) {
+		mockRepo := new(MockTypeRepository)
+		mockRepo.On("GetByID", ctx, sourceID).Return(&models.Type{ID: sourceID}, nil).Once()
+		mockRepo.On("GetByID", ctx, targetID).Return(&models.Type{ID: targetID}, nil).Once()
+		mockRepo.On("GetConflictingBackingNames", ctx, sourceID, targetID).Return([]string{"PUTIH"}, nil).Once()
+
+		req := &typeDto.ReqMergeType{TargetID: targetID}
+		result, err := usecase.NewTypeUsecase(mockRepo, new(MockSubGroupRepository)).Merge(ctx, sourceID.String(), req, "test-auth-id")
+		assert.EqualError(t, err, fmt.Sprintf(constants.TypeMergeNameConflict, "PUTIH"))
+		assert.Nil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error when merged into itself", func(t *testing.T) {
+		req := &typeDto.ReqMergeType{TargetID: sourceID}
+		result, err := usecase.NewTypeUsecase(new(MockTypeRepository), new(MockSubGroupRepository)).Merge(ctx, sourceID.String(), req, "test-auth-id")
+		assert.EqualError(t, err, constants.TypeMergeIntoItself)
+		assert.Nil(t, result)
+	})
+}
@@ -16,4 +16,6 @@ type Usecase interface {
 	GetIndex(ctx context.Context, req request.PageRequest, filter dto.ReqTypeIndexFilter) ([]models.Type, int, error)
 	GetAll(ctx context.Context, filter dto.ReqTypeIndexFilter) ([]models.Type, error)
 	Export(ctx context.Context, filter dto.ReqTypeIndexFilter) ([]byte, error)
+	Move(ctx context.Context, id string, req *dto.ReqMoveType, authId string) (*models.Type, error)
+	Merge(ctx context.Context, id string, req *dto.ReqMergeType, authId string) (*models.Type, error)
 }
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	mod "github.com/rendyfutsuy/base-go/modules/type"
+	"github.com/rendyfutsuy/base-go/modules/type/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"gorm.io/gorm"
+)
+
+// Move re-parents a type to another sub-group, its backings follow it
+func (u *typeUsecase) Move(ctx context.Context, id string, reqBody *dto.ReqMoveType, authId string) (*models.Type, error) {
+	tid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := u.repo.GetByID(ctx, tid)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.TypeNotFound, id)
+		}
+		return nil, err
+	}
+	if current.SubgroupID == reqBody.SubgroupID {
+		return nil, errors.New(constants.TypeMoveSameSubGroup)
+	}
+
+	if _, err := u.subGroupRepo.GetByID(ctx, reqBody.SubgroupID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New(constants.TypeSubGroupNotFound)
+		}
+		return nil, err
+	}
+
+	exists, err := u.repo.ExistsByNameInSubgroup(ctx, reqBody.SubgroupID, current.Name, tid)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New(constants.TypeNameAlreadyExists)
+	}
+
+	if err := u.repo.Move(ctx, tid, mod.MoveTypeParams{
+		SubgroupID:      reqBody.SubgroupID,
+		RegenerateCodes: reqBody.RegenerateCodes,
+		MovedBy:         authId,
+	}); err != nil {
+		return nil, err
+	}
+	return u.repo.GetByID(ctx, tid)
+}
+
+// Merge moves the backings of a duplicate type into the target and soft-deletes the duplicate
+func (u *typeUsecase) Merge(ctx context.Context, id string, reqBody *dto.ReqMergeType, authId string) (*models.Type, error) {
+	tid, err := utils.StringToUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	if tid == reqBody.TargetID {
+		return nil, errors.New(constants.TypeMergeIntoItself)
+	}
+
+	for _, checkID := range []uuid.UUID{tid, reqBody.TargetID} {
+		if _, err := u.repo.GetByID(ctx, checkID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, fmt.Errorf(constants.TypeNotFound, checkID)
+			}
+			return nil, err
+		}
+	}
+
+	conflicts, err := u.repo.GetConflictingBackingNames(ctx, tid, reqBody.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if len(conflicts) > 0 {
+		return nil, fmt.Errorf(constants.TypeMergeNameConflict, strings.Join(conflicts, ", "))
+	}
+
+	if err := u.repo.Merge(ctx, tid, mod.MergeTypeParams{
+		TargetID:        reqBody.TargetID,
+		RegenerateCodes: reqBody.RegenerateCodes,
+		MergedBy:        authId,
+	}); err != nil {
+		return nil, err
+	}
+	return u.repo.GetByID(ctx, reqBody.TargetID)
+}
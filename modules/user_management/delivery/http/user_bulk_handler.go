@@ -0,0 +1,247 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/user_management/dto"
+)
+
+// bulk scope
+// every bulk endpoint processes users one by one and returns a partial-success report
+
+// ForceUserPasswordReset godoc
+// @Summary		Force user password reset
+// @Description	Require a user to change the password on the next login, revoke all their tokens and notify them by email
+// @Tags			User Management
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		string	true	"User UUID"
+// @Success		200	{object}	response.NonPaginationResponse{data=dto.RespUserDetail}	"Successfully forced password reset"
+// @Failure		400	{object}	response.NonPaginationResponse	"Bad request - invalid UUID or user not found"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403	{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/user-management/user/{id}/force-password-reset [patch]
+func (handler *UserManagementHandler) ForceUserPasswordReset(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("id")
+
+	// validate id
+	if err := uuid.Validate(id); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, constants.ErrorUUIDNotRecognized))
+	}
+
+	authId := c.Get("user").(models.User).ID.String()
+	res, err := handler.UserUseCase.ForceUserPasswordReset(ctx, id, authId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resResp := dto.ToRespUserDetail(*res)
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(resResp)
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// BulkAssignRole godoc
+// @Summary		Bulk assign role
+// @Description	Assign the same role to many users. The requester's own account and non deletable users are skipped. Each user is processed independently and the response reports the outcome per user.
+// @Tags			User Management
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	body		dto.ReqBulkAssignRole	true	"Bulk assign role request"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespBulkUserOperation}	"Bulk operation report"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/user-management/user/bulk/assign-role [post]
+func (handler *UserManagementHandler) BulkAssignRole(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	req := new(dto.ReqBulkAssignRole)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// validate request
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	authId := c.Get("user").(models.User).ID.String()
+	res, err := handler.UserUseCase.BulkAssignRole(ctx, req, authId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// BulkBlockUser godoc
+// @Summary		Bulk block or unblock users
+// @Description	Block or unblock many users with a mandatory reason, optionally until unblock_at. Same side effects as the single block endpoint: tokens revoked, status history recorded and email sent. Blocking skips the requester's own account and non deletable users. Each user is processed independently and the response reports the outcome per user.
+// @Tags			User Management
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	body		dto.ReqBulkBlockUser	true	"Bulk block request"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespBulkUserOperation}	"Bulk operation report"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/user-management/user/bulk/block [post]
+func (handler *UserManagementHandler) BulkBlockUser(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	req := new(dto.ReqBulkBlockUser)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// validate request
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	authId := c.Get("user").(models.User).ID.String()
+	res, err := handler.UserUseCase.BulkBlockUser(ctx, req, authId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// BulkActivateUser godoc
+// @Summary		Bulk activate or deactivate users
+// @Description	Activate or deactivate many users with a mandatory reason. Same side effects as the single assign-status endpoint. Deactivating skips the requester's own account and non deletable users. Each user is processed independently and the response reports the outcome per user.
+// @Tags			User Management
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	body		dto.ReqBulkActivateUser	true	"Bulk activate request"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespBulkUserOperation}	"Bulk operation report"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/user-management/user/bulk/assign-status [post]
+func (handler *UserManagementHandler) BulkActivateUser(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	req := new(dto.ReqBulkActivateUser)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// validate request
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	authId := c.Get("user").(models.User).ID.String()
+	res, err := handler.UserUseCase.BulkActivateUser(ctx, req, authId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// BulkForcePasswordReset godoc
+// @Summary		Bulk force password reset
+// @Description	Require many users to change their password on the next login, revoke their tokens and notify them by email. Each user is processed independently and the response reports the outcome per user.
+// @Tags			User Management
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	body		dto.ReqBulkUserIds	true	"Bulk user ids"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespBulkUserOperation}	"Bulk operation report"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/user-management/user/bulk/force-password-reset [post]
+func (handler *UserManagementHandler) BulkForcePasswordReset(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	req := new(dto.ReqBulkUserIds)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// validate request
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	authId := c.Get("user").(models.User).ID.String()
+	res, err := handler.UserUseCase.BulkForcePasswordReset(ctx, req, authId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// BulkSoftDeleteUser godoc
+// @Summary		Bulk soft delete users
+// @Description	Soft delete many users. Non deletable users and the requester's own account are skipped. Each user is processed independently and the response reports the outcome per user.
+// @Tags			User Management
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	body		dto.ReqBulkUserIds	true	"Bulk user ids"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespBulkUserOperation}	"Bulk operation report"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/user-management/user/bulk/delete [post]
+func (handler *UserManagementHandler) BulkSoftDeleteUser(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	req := new(dto.ReqBulkUserIds)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	// validate request
+	if err := c.Validate(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	authId := c.Get("user").(models.User).ID.String()
+	res, err := handler.UserUseCase.BulkSoftDeleteUser(ctx, req, authId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+
+	return c.JSON(http.StatusOK, resp)
+}
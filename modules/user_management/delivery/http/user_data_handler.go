@@ -0,0 +1,157 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/user_management/dto"
+)
+
+// personal data scope (PDP law)
+// request data export
+// request data erasure
+// list data requests of a user
+// get data request
+
+// RequestUserDataExport godoc
+// @Summary		Request personal data export of a user
+// @Description	Queue a background job that packages the user's profile, password history metadata, sessions, uploaded files and authored posts into a ZIP archive in storage. Requires 'user.data-export' permission.
+// @Tags			User Management
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path	string	true	"User UUID"
+// @Success		202	{object}	response.NonPaginationResponse{data=dto.RespUserDataRequest}	"Export request queued"
+// @Failure		400	{object}	response.NonPaginationResponse	"Bad request - invalid UUID, user not found or request already queued"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403	{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/user-management/user/{id}/data-export [post]
+func (handler *UserManagementHandler) RequestUserDataExport(c echo.Context) error {
+	return handler.requestUserData(c, handler.UserUseCase.RequestUserDataExport)
+}
+
+// RequestUserDataErasure godoc
+// @Summary		Request personal data erasure of a user
+// @Description	Queue a background job that revokes sessions, removes uploaded files and anonymizes the user's name, email, NIK and username. The user row is kept so created_by / updated_by references stay valid. Requires 'user.data-erasure' permission.
+// @Tags			User Management
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path	string	true	"User UUID"
+// @Success		202	{object}	response.NonPaginationResponse{data=dto.RespUserDataRequest}	"Erasure request queued"
+// @Failure		400	{object}	response.NonPaginationResponse	"Bad request - invalid UUID, user not found, user not deletable or request already queued"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403	{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/user-management/user/{id}/data-erasure [post]
+func (handler *UserManagementHandler) RequestUserDataErasure(c echo.Context) error {
+	return handler.requestUserData(c, handler.UserUseCase.RequestUserDataErasure)
+}
+
+func (handler *UserManagementHandler) requestUserData(c echo.Context, requestFn func(ctx context.Context, id string, authId string) (*models.UserDataRequest, error)) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("id")
+
+	// validate id
+	if err := uuid.Validate(id); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, constants.ErrorUUIDNotRecognized))
+	}
+
+	// get auth ID
+	user := c.Get("user")
+	authId := user.(models.User).ID.String()
+
+	res, err := requestFn(ctx, id, authId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resResp := dto.ToRespUserDataRequest(*res)
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(resResp)
+
+	return c.JSON(http.StatusAccepted, resp)
+}
+
+// GetUserDataRequests godoc
+// @Summary		List personal data requests of a user
+// @Description	Retrieve the export / erasure request history of a user, newest first. Completed exports include a presigned download URL.
+// @Tags			User Management
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path	string	true	"User UUID"
+// @Success		200	{object}	response.NonPaginationResponse{data=[]dto.RespUserDataRequest}	"Successfully retrieved data requests"
+// @Failure		400	{object}	response.NonPaginationResponse	"Bad request - invalid UUID"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403	{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/user-management/user/{id}/data-requests [get]
+func (handler *UserManagementHandler) GetUserDataRequests(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("id")
+
+	// validate id
+	if err := uuid.Validate(id); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, constants.ErrorUUIDNotRecognized))
+	}
+
+	res, err := handler.UserUseCase.GetUserDataRequests(ctx, id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	respRequests := []dto.RespUserDataRequest{}
+	for _, v := range res {
+		respRequests = append(respRequests, dto.ToRespUserDataRequest(v))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(respRequests)
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetUserDataRequestByID godoc
+// @Summary		Get a personal data request
+// @Description	Retrieve the status of a single export / erasure request. Completed exports include a presigned download URL.
+// @Tags			User Management
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request_id	path	string	true	"Data request UUID"
+// @Success		200	{object}	response.NonPaginationResponse{data=dto.RespUserDataRequest}	"Successfully retrieved data request"
+// @Failure		400	{object}	response.NonPaginationResponse	"Bad request - invalid UUID"
+// @Failure		401	{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403	{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Failure		404	{object}	response.NonPaginationResponse	"Data request not found"
+// @Router			/v1/user-management/user/data-requests/{request_id} [get]
+func (handler *UserManagementHandler) GetUserDataRequestByID(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("request_id")
+
+	// validate id
+	if err := uuid.Validate(id); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, constants.ErrorUUIDNotRecognized))
+	}
+
+	res, err := handler.UserUseCase.GetUserDataRequestByID(ctx, id)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, response.SetErrorResponse(http.StatusNotFound, err.Error()))
+	}
+
+	resResp := dto.ToRespUserDataRequest(*res)
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(resResp)
+
+	return c.JSON(http.StatusOK, resp)
+}
@@ -247,7 +247,7 @@ func (handler *UserManagementHandler) GetAllUser(c echo.Context) error {
 
 // GetUserByID godoc
 // @Summary		Get user by ID
-// @Description	Retrieve a specific user by their ID
+// @Description	Retrieve a specific user by their ID, including the history of block / unblock / activate / deactivate changes
 // @Tags			User Management
 // @Accept			json
 // @Produce		json
@@ -275,7 +275,12 @@ func (handler *UserManagementHandler) GetUserByID(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
 	}
 
-	resResp := dto.ToRespUserDetail(*res)
+	histories, err := handler.UserUseCase.GetUserStatusHistories(ctx, id)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resResp := dto.ToRespUserDetailWithStatusHistories(*res, histories)
 	resp := response.NonPaginationResponse{}
 	resp, _ = resp.SetResponse(resResp)
 
@@ -461,21 +466,20 @@ func (handler *UserManagementHandler) GetDuplicatedEmail(c echo.Context) error {
 	return c.JSON(http.StatusConflict, resp)
 }
 
-// 2025/11/04: unused - commented first
-// // BlockUser godoc
-// // @Summary		Block a user
-// // @Description	Block a user account and revoke all their tokens
-// // @Tags			User Management
-// // @Accept			json
-// // @Produce		json
-// // @Security		BearerAuth
-// // @Param			id		path	string				true	"User UUID"
-// // @Param			request	body	dto.ReqBlockUser	true	"Block user request"
-// // @Success		200		{object}	response.NonPaginationResponse{data=dto.RespUserDetail}	"Successfully blocked user"
-// // @Failure		400		{object}	response.NonPaginationResponse	"Bad request"
-// // @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
-// // @Failure		404		{object}	response.NonPaginationResponse	"User not found"
-// // @Router			/v1/user-management/user/{id}/block [patch]
+// BlockUser godoc
+// @Summary		Block a user
+// @Description	Block or unblock a user account with a mandatory reason and revoke all their tokens. When blocking, unblock_at optionally sets the time the user is unblocked automatically. The change is recorded in the user status history and the user is notified by email.
+// @Tags			User Management
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string				true	"User UUID"
+// @Param			request	body	dto.ReqBlockUser	true	"Block user request"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespUserDetail}	"Successfully blocked user"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - validation error or unblock_at in the past"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		404		{object}	response.NonPaginationResponse	"User not found"
+// @Router			/v1/user-management/user/{id}/block [patch]
 func (handler *UserManagementHandler) BlockUser(c echo.Context) error {
 	// initialize context from echo
 	ctx := c.Request().Context()
@@ -500,13 +504,12 @@ func (handler *UserManagementHandler) BlockUser(c echo.Context) error {
 
 	// get Block User
 	// add revoke all user auth token
-	res, err := handler.UserUseCase.BlockUser(ctx, id, req)
+	authId := c.Get("user").(models.User).ID.String()
+	res, err := handler.UserUseCase.BlockUser(ctx, id, req, authId)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
 	}
 
-	// revoke user token
-
 	resResp := dto.ToRespUserDetail(*res)
 	resp := response.NonPaginationResponse{}
 	resp, _ = resp.SetResponse(resResp)
@@ -514,21 +517,20 @@ func (handler *UserManagementHandler) BlockUser(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp)
 }
 
-// 2025/11/04: unused - commented first
-// // ActivateUser godoc
-// // @Summary		Activate a user
-// // @Description	Activate or update status of a user account
-// // @Tags			User Management
-// // @Accept			json
-// // @Produce		json
-// // @Security		BearerAuth
-// // @Param			id		path	string				true	"User UUID"
-// // @Param			request	body	dto.ReqActivateUser	true	"Activate user request"
-// // @Success		200		{object}	response.NonPaginationResponse{data=dto.RespUserDetail}	"Successfully activated user"
-// // @Failure		400		{object}	response.NonPaginationResponse	"Bad request"
-// // @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
-// // @Failure		404		{object}	response.NonPaginationResponse	"User not found"
-// // @Router			/v1/user-management/user/{id}/assign-status [patch]
+// ActivateUser godoc
+// @Summary		Activate a user
+// @Description	Activate or deactivate a user account with a mandatory reason and revoke all their tokens. The change is recorded in the user status history and the user is notified by email.
+// @Tags			User Management
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string				true	"User UUID"
+// @Param			request	body	dto.ReqActivateUser	true	"Activate user request"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespUserDetail}	"Successfully activated user"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		404		{object}	response.NonPaginationResponse	"User not found"
+// @Router			/v1/user-management/user/{id}/assign-status [patch]
 func (handler *UserManagementHandler) ActivateUser(c echo.Context) error {
 	// initialize context from echo
 	ctx := c.Request().Context()
@@ -553,13 +555,12 @@ func (handler *UserManagementHandler) ActivateUser(c echo.Context) error {
 
 	// get Active User
 	// add revoke all user auth token
-	res, err := handler.UserUseCase.ActivateUser(ctx, id, req)
+	authId := c.Get("user").(models.User).ID.String()
+	res, err := handler.UserUseCase.ActivateUser(ctx, id, req, authId)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
 	}
 
-	// revoke user token
-
 	resResp := dto.ToRespUserDetail(*res)
 	resp := response.NonPaginationResponse{}
 	resp, _ = resp.SetResponse(resResp)
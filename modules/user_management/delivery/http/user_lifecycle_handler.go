@@ -0,0 +1,90 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/helpers/response"
+	"github.com/rendyfutsuy/base-go/modules/user_management/dto"
+)
+
+// lifecycle scope
+// schedule user deactivation
+// report of accounts affected by scheduled deactivation / dormant policy
+
+// ScheduleUserDeactivation godoc
+// @Summary		Schedule user deactivation
+// @Description	Set the time a user will be deactivated automatically (e.g. last working day). Send null to clear the schedule. The user receives a warning email before the deactivation.
+// @Tags			User Management
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path	string								true	"User UUID"
+// @Param			request	body	dto.ReqScheduleUserDeactivation	true	"Deactivation schedule"
+// @Success		200		{object}	response.NonPaginationResponse{data=dto.RespUserDetail}	"Successfully scheduled deactivation"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid UUID, user not found or deactivate_at in the past"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/user-management/user/{id}/deactivate-at [patch]
+func (handler *UserManagementHandler) ScheduleUserDeactivation(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	id := c.Param("id")
+
+	// validate id
+	if err := uuid.Validate(id); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, constants.ErrorUUIDNotRecognized))
+	}
+
+	req := new(dto.ReqScheduleUserDeactivation)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := handler.UserUseCase.ScheduleUserDeactivation(ctx, id, req)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resResp := dto.ToRespUserDetail(*res)
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(resResp)
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetUserLifecycleReport godoc
+// @Summary		Report of accounts affected by lifecycle policy
+// @Description	List accounts that will be blocked or deactivated within the next N days, either by scheduled deactivation or by the dormant-account policy, ordered by due date.
+// @Tags			User Management
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			days	query	int	false	"Look-ahead window in days"	default(30)
+// @Success		200		{object}	response.NonPaginationResponse{data=[]dto.RespUserLifecycleReport}	"Successfully retrieved report"
+// @Failure		400		{object}	response.NonPaginationResponse	"Bad request - invalid days"
+// @Failure		401		{object}	response.NonPaginationResponse	"Unauthorized"
+// @Failure		403		{object}	response.NonPaginationResponse	"Forbidden - insufficient permissions"
+// @Router			/v1/user-management/user/lifecycle/report [get]
+func (handler *UserManagementHandler) GetUserLifecycleReport(c echo.Context) error {
+	// initialize context from echo
+	ctx := c.Request().Context()
+
+	req := new(dto.ReqUserLifecycleReport)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	res, err := handler.UserUseCase.GetUserLifecycleReport(ctx, req.Days)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.SetErrorResponse(http.StatusBadRequest, err.Error()))
+	}
+
+	resp := response.NonPaginationResponse{}
+	resp, _ = resp.SetResponse(res)
+
+	return c.JSON(http.StatusOK, resp)
+}
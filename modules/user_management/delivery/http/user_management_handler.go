@@ -76,13 +76,26 @@ func NewUserManagementHandler(e *echo.Echo, us user_management.Usecase, mwP _req
 	r.POST("/user/check-name", handler.GetDuplicatedUser, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation(checkUserName))
 	r.POST("/user/check-email", handler.GetDuplicatedEmail, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation(checkUserName))
 
-	// 2025/11/04: unused - commented first
 	// user block
-	// r.PATCH("/user/:id/block", handler.BlockUser, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation([]string{"user.block"}))
+	r.PATCH("/user/:id/block", handler.BlockUser, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation([]string{"user.block"}))
 
-	// 2025/11/11: unused - commented first
 	// user activate
-	// r.PATCH("/user/:id/assign-status", handler.ActivateUser, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation([]string{"user.activate"}))
+	r.PATCH("/user/:id/assign-status", handler.ActivateUser, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation([]string{"user.activate"}))
+
+	// user force password reset
+	r.PATCH("/user/:id/force-password-reset", handler.ForceUserPasswordReset, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation(permissionToUpdate))
+
+	// user bulk operations
+	r.POST("/user/bulk/assign-role", handler.BulkAssignRole, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.POST("/user/bulk/block", handler.BulkBlockUser, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation([]string{"user.block"}))
+	r.POST("/user/bulk/assign-status", handler.BulkActivateUser, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation([]string{"user.activate"}))
+	r.POST("/user/bulk/force-password-reset", handler.BulkForcePasswordReset, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation(permissionToUpdate))
+	r.POST("/user/bulk/delete", handler.BulkSoftDeleteUser, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation(permissionToDelete))
+
+	// user lifecycle (scheduled deactivation & dormant accounts)
+	permissionToManageLifecycle := []string{"user.activate", "user.block"}
+	r.PATCH("/user/:id/deactivate-at", handler.ScheduleUserDeactivation, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation([]string{"user.activate"}))
+	r.GET("/user/lifecycle/report", handler.GetUserLifecycleReport, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation(permissionToManageLifecycle))
 
 	// user update password
 	// Allow password update without RequireActivatedUser so user can activate themselves
@@ -96,4 +109,13 @@ func NewUserManagementHandler(e *echo.Echo, us user_management.Usecase, mwP _req
 	r.GET("/user/import/template", handler.DownloadUserImportTemplate, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation(permissionToCreate))
 	r.POST("/user/import", handler.ImportUsersFromExcel, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation(permissionToCreate))
 
+	// user personal data (PDP law) export & erasure
+	permissionToExportData := []string{"user.data-export"}
+	permissionToEraseData := []string{"user.data-erasure"}
+	permissionToViewDataRequest := append(permissionToExportData, permissionToEraseData...)
+	r.POST("/user/:id/data-export", handler.RequestUserDataExport, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation(permissionToExportData))
+	r.POST("/user/:id/data-erasure", handler.RequestUserDataErasure, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation(permissionToEraseData))
+	r.GET("/user/:id/data-requests", handler.GetUserDataRequests, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation(permissionToViewDataRequest))
+	r.GET("/user/data-requests/:request_id", handler.GetUserDataRequestByID, middleware.RequireActivatedUser, handler.middlewarePermission.PermissionValidation(permissionToViewDataRequest))
+
 }
@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+)
+
+type ReqBulkUserIds struct {
+	UserIds []uuid.UUID `json:"user_ids" validate:"required,min=1,max=500"`
+}
+
+type ReqBulkAssignRole struct {
+	UserIds []uuid.UUID `json:"user_ids" validate:"required,min=1,max=500"`
+	RoleId  uuid.UUID   `json:"role_id" validate:"required"`
+}
+
+type ReqBulkBlockUser struct {
+	UserIds []uuid.UUID `json:"user_ids" validate:"required,min=1,max=500"`
+	ReqBlockUser
+}
+
+type ReqBulkActivateUser struct {
+	UserIds []uuid.UUID `json:"user_ids" validate:"required,min=1,max=500"`
+	ReqActivateUser
+}
+
+type RespBulkUserOperation struct {
+	Action    string                      `json:"action"`
+	Total     int                         `json:"total"`
+	Succeeded int                         `json:"succeeded"`
+	Failed    int                         `json:"failed"`
+	Results   []RespBulkUserOperationItem `json:"results"`
+}
+
+type RespBulkUserOperationItem struct {
+	UserID   uuid.UUID `json:"user_id"`
+	FullName string    `json:"full_name,omitempty"`
+	Success  bool      `json:"success"`
+	Message  string    `json:"message,omitempty"`
+}
+
+// AddResult appends the outcome of a single user to the report
+func (r *RespBulkUserOperation) AddResult(userID uuid.UUID, fullName string, err error) {
+	item := RespBulkUserOperationItem{
+		UserID:   userID,
+		FullName: fullName,
+		Success:  err == nil,
+	}
+
+	if err != nil {
+		item.Message = err.Error()
+		r.Failed++
+	} else {
+		r.Succeeded++
+	}
+
+	r.Total++
+	r.Results = append(r.Results, item)
+}
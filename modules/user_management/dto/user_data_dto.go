@@ -0,0 +1,138 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	utilsServices "github.com/rendyfutsuy/base-go/utils/services"
+)
+
+type ToDBCreateUserDataRequest struct {
+	UserID      uuid.UUID `json:"user_id"`
+	RequestType string    `json:"request_type"`
+	RequestedBy string    `json:"requested_by"`
+}
+
+type ToDBUpdateUserDataRequestStatus struct {
+	Status       string  `json:"status"`
+	FilePath     *string `json:"file_path"`
+	ErrorMessage *string `json:"error_message"`
+}
+
+// ToDBAnonymizeUser holds the replacement values used to scrub PII of an erased user
+type ToDBAnonymizeUser struct {
+	FullName       string `json:"name"`
+	Username       string `json:"username"`
+	Email          string `json:"email"`
+	HashedPassword string `json:"-"`
+}
+
+// UserDataFile represent a file linked to the user through files_to_module
+type UserDataFile struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	FilePath  *string   `json:"-"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// UserDataSession represent session metadata (tokens are never exported)
+type UserDataSession struct {
+	IsUsed           bool       `json:"is_used"`
+	RefreshExpiresAt time.Time  `json:"refresh_expires_at"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        *time.Time `json:"updated_at"`
+}
+
+// UserDataPasswordHistory represent password history metadata (hashes are never exported)
+type UserDataPasswordHistory struct {
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+type UserDataProfile struct {
+	ID                uuid.UUID  `json:"id"`
+	FullName          string     `json:"name"`
+	Username          string     `json:"username"`
+	Email             string     `json:"email"`
+	Nik               string     `json:"nik"`
+	Gender            string     `json:"gender"`
+	RoleName          string     `json:"role_name"`
+	IsActive          bool       `json:"is_active"`
+	VerifiedAt        *time.Time `json:"verified_at"`
+	PasswordExpiredAt time.Time  `json:"password_expired_at"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+type UserDataPost struct {
+	ID               uuid.UUID `json:"id"`
+	Title            string    `json:"title"`
+	ShortDescription string    `json:"short_description"`
+	Description      string    `json:"description"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+func ToUserDataProfile(userDb models.User) UserDataProfile {
+	return UserDataProfile{
+		ID:                userDb.ID,
+		FullName:          userDb.FullName,
+		Username:          userDb.Username,
+		Email:             userDb.Email,
+		Nik:               userDb.Nik,
+		Gender:            userDb.Gender,
+		RoleName:          userDb.RoleName,
+		IsActive:          userDb.IsActive,
+		VerifiedAt:        userDb.VerifiedAt,
+		PasswordExpiredAt: userDb.PasswordExpiredAt,
+		CreatedAt:         userDb.CreatedAt,
+		UpdatedAt:         userDb.UpdatedAt,
+	}
+}
+
+func ToUserDataPost(postDb models.Post) UserDataPost {
+	return UserDataPost{
+		ID:               postDb.ID,
+		Title:            postDb.Title,
+		ShortDescription: postDb.ShortDescription,
+		Description:      postDb.Description,
+		CreatedAt:        postDb.CreatedAt,
+		UpdatedAt:        postDb.UpdatedAt,
+	}
+}
+
+type RespUserDataRequest struct {
+	ID           uuid.UUID  `json:"id"`
+	UserID       uuid.UUID  `json:"user_id"`
+	RequestType  string     `json:"request_type"`
+	Status       string     `json:"status"`
+	DownloadURL  string     `json:"download_url"`
+	ErrorMessage *string    `json:"error_message"`
+	RequestedBy  string     `json:"requested_by"`
+	ProcessedAt  *time.Time `json:"processed_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+func ToRespUserDataRequest(m models.UserDataRequest) RespUserDataRequest {
+	downloadURL := ""
+	if m.Status == constants.UserDataRequestStatusComplete && m.FilePath != nil && *m.FilePath != "" {
+		downloadURL, _ = utilsServices.GeneratePresignedURL(*m.FilePath)
+	}
+
+	return RespUserDataRequest{
+		ID:           m.ID,
+		UserID:       m.UserID,
+		RequestType:  m.RequestType,
+		Status:       m.Status,
+		DownloadURL:  downloadURL,
+		ErrorMessage: m.ErrorMessage,
+		RequestedBy:  m.RequestedBy,
+		ProcessedAt:  m.ProcessedAt,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
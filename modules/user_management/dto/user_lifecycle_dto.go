@@ -0,0 +1,73 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/models"
+)
+
+type ReqScheduleUserDeactivation struct {
+	// null clears the schedule
+	DeactivateAt *time.Time `form:"deactivate_at" json:"deactivate_at"`
+}
+
+type ReqUserLifecycleReport struct {
+	Days int `query:"days" json:"days"`
+}
+
+// UserLifecyclePolicy holds the dormant-account thresholds, 0 disables the related action
+type UserLifecyclePolicy struct {
+	BlockAfterDays      int
+	DeactivateAfterDays int
+	WarningDays         int
+}
+
+// FirstActionDays returns the smallest enabled threshold of the dormant policy
+func (p UserLifecyclePolicy) FirstActionDays() int {
+	first := p.BlockAfterDays
+	if first <= 0 || (p.DeactivateAfterDays > 0 && p.DeactivateAfterDays < first) {
+		first = p.DeactivateAfterDays
+	}
+	if first < 0 {
+		return 0
+	}
+	return first
+}
+
+type RespUserLifecycleRun struct {
+	Warned      int `json:"warned"`
+	Blocked     int `json:"blocked"`
+	Deactivated int `json:"deactivated"`
+	Unblocked   int `json:"unblocked"`
+}
+
+type RespUserLifecycleReport struct {
+	ID           uuid.UUID  `json:"id"`
+	FullName     string     `json:"name"`
+	Username     string     `json:"username"`
+	Email        string     `json:"email"`
+	RoleName     string     `json:"role_name"`
+	Action       string     `json:"action"`
+	Reason       string     `json:"reason"`
+	DueAt        time.Time  `json:"due_at"`
+	LastLoginAt  *time.Time `json:"last_login_at"`
+	DeactivateAt *time.Time `json:"deactivate_at"`
+	IsBlocked    bool       `json:"is_blocked"`
+}
+
+func ToRespUserLifecycleReport(userDb models.User, action string, reason string, dueAt time.Time) RespUserLifecycleReport {
+	return RespUserLifecycleReport{
+		ID:           userDb.ID,
+		FullName:     userDb.FullName,
+		Username:     userDb.Username,
+		Email:        userDb.Email,
+		RoleName:     userDb.RoleName,
+		Action:       action,
+		Reason:       reason,
+		DueAt:        dueAt,
+		LastLoginAt:  userDb.LastLoginAt,
+		DeactivateAt: userDb.DeactivateAt,
+		IsBlocked:    userDb.IsBlocked,
+	}
+}
@@ -42,16 +42,23 @@ type RespPermissionGroupUserDetail struct {
 }
 
 type RespUserDetail struct {
-	ID        uuid.UUID `json:"id"`
-	FullName  string    `json:"name"`
-	Username  string    `json:"username"`
-	Email     string    `json:"email"`
-	Nik       string    `json:"nik"`
-	RoleId    uuid.UUID `json:"role_id"`
-	RoleName  string    `json:"role_name"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
-	Deletable bool      `json:"deletable"`
+	ID           uuid.UUID  `json:"id"`
+	FullName     string     `json:"name"`
+	Username     string     `json:"username"`
+	Email        string     `json:"email"`
+	Nik          string     `json:"nik"`
+	RoleId       uuid.UUID  `json:"role_id"`
+	RoleName     string     `json:"role_name"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	Deletable    bool       `json:"deletable"`
+	LastLoginAt  *time.Time `json:"last_login_at"`
+	DeactivateAt *time.Time `json:"deactivate_at"`
+	// status
+	IsActive        bool                    `json:"is_active"`
+	IsBlocked       bool                    `json:"is_blocked"`
+	AutoUnblockAt   *time.Time              `json:"auto_unblock_at"`
+	StatusHistories []RespUserStatusHistory `json:"status_histories,omitempty"`
 }
 
 // to get role info for compact use
@@ -84,15 +91,20 @@ func ToRespUserIndex(userDb models.User) RespUserIndex {
 func ToRespUserDetail(userDb models.User) RespUserDetail {
 
 	return RespUserDetail{
-		ID:        userDb.ID,
-		FullName:  userDb.FullName,
-		Username:  userDb.Username,
-		Email:     userDb.Email,
-		Nik:       userDb.Nik,
-		RoleId:    userDb.RoleId,
-		RoleName:  userDb.RoleName,
-		Deletable: userDb.Deletable,
-		CreatedAt: userDb.CreatedAt,
-		UpdatedAt: userDb.UpdatedAt,
+		ID:            userDb.ID,
+		FullName:      userDb.FullName,
+		Username:      userDb.Username,
+		Email:         userDb.Email,
+		Nik:           userDb.Nik,
+		RoleId:        userDb.RoleId,
+		RoleName:      userDb.RoleName,
+		Deletable:     userDb.Deletable,
+		CreatedAt:     userDb.CreatedAt,
+		UpdatedAt:     userDb.UpdatedAt,
+		LastLoginAt:   userDb.LastLoginAt,
+		DeactivateAt:  userDb.DeactivateAt,
+		IsActive:      userDb.IsActive,
+		IsBlocked:     userDb.IsBlocked,
+		AutoUnblockAt: userDb.AutoUnblockAt,
 	}
 }
@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/models"
+)
+
+type ToDBCreateUserStatusHistory struct {
+	UserID    uuid.UUID  `json:"user_id"`
+	Action    string     `json:"action"`
+	Reason    string     `json:"reason"`
+	UnblockAt *time.Time `json:"unblock_at"`
+	CreatedBy string     `json:"created_by"`
+}
+
+type RespUserStatusHistory struct {
+	ID            uuid.UUID  `json:"id"`
+	Action        string     `json:"action"`
+	Reason        string     `json:"reason"`
+	UnblockAt     *time.Time `json:"unblock_at"`
+	CreatedBy     string     `json:"created_by"`
+	CreatedByName string     `json:"created_by_name"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+func ToRespUserStatusHistory(m models.UserStatusHistory) RespUserStatusHistory {
+	return RespUserStatusHistory{
+		ID:            m.ID,
+		Action:        m.Action,
+		Reason:        m.Reason,
+		UnblockAt:     m.UnblockAt,
+		CreatedBy:     m.CreatedBy,
+		CreatedByName: m.CreatedByName,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+// to get user detail along with its status change history
+func ToRespUserDetailWithStatusHistories(userDb models.User, histories []models.UserStatusHistory) RespUserDetail {
+	resp := ToRespUserDetail(userDb)
+
+	resp.StatusHistories = []RespUserStatusHistory{}
+	for _, v := range histories {
+		resp.StatusHistories = append(resp.StatusHistories, ToRespUserStatusHistory(v))
+	}
+
+	return resp
+}
@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -10,11 +12,15 @@ type ReqUpdateUserPassword struct {
 }
 
 type ReqBlockUser struct {
-	IsBlock bool `form:"is_block" json:"is_block"`
+	IsBlock bool   `form:"is_block" json:"is_block"`
+	Reason  string `form:"reason" json:"reason" validate:"required,max=500"`
+	// optional, only when blocking: the user is unblocked automatically at this time
+	UnblockAt *time.Time `form:"unblock_at" json:"unblock_at"`
 }
 
 type ReqActivateUser struct {
-	IsActive bool `form:"is_active" json:"is_active"`
+	IsActive bool   `form:"is_active" json:"is_active"`
+	Reason   string `form:"reason" json:"reason" validate:"required,max=500"`
 }
 
 type ReqUpdateUser struct {
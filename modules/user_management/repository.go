@@ -2,6 +2,7 @@ package user_management
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rendyfutsuy/base-go/helpers/request"
@@ -53,4 +54,39 @@ type Repository interface {
 	// ------------------------------------------------- verification scope - BEGIN -------------------------------------------------
 	MarkUserVerified(ctx context.Context, id uuid.UUID) (*models.User, error)
 	// ------------------------------------------------- verification scope - END ---------------------------------------------------
+
+	// ------------------------------------------------- status history scope - BEGIN -----------------------------------------------
+	CreateUserStatusHistory(ctx context.Context, req dto.ToDBCreateUserStatusHistory) error
+	GetUserStatusHistories(ctx context.Context, userID uuid.UUID) (histories []models.UserStatusHistory, err error)
+	SetAutoUnblockAt(ctx context.Context, id uuid.UUID, unblockAt *time.Time) error
+	GetUsersDueForAutoUnblock(ctx context.Context, before time.Time) (users []models.User, err error)
+	// ------------------------------------------------- status history scope - END -------------------------------------------------
+
+	// ------------------------------------------------- bulk scope - BEGIN -----------------------------------------------
+	AssignRole(ctx context.Context, id uuid.UUID, roleId uuid.UUID) error
+	ForcePasswordReset(ctx context.Context, id uuid.UUID) error
+	// ------------------------------------------------- bulk scope - END -------------------------------------------------
+
+	// ------------------------------------------------- lifecycle scope - BEGIN ----------------------------------------------------
+	SetDeactivateAt(ctx context.Context, id uuid.UUID, deactivateAt *time.Time) (userRes *models.User, err error)
+	GetUsersInactiveSince(ctx context.Context, before time.Time) (users []models.User, err error)
+	GetUsersScheduledForDeactivation(ctx context.Context, before time.Time) (users []models.User, err error)
+	MarkLifecycleWarningSent(ctx context.Context, ids []uuid.UUID) error
+	MarkUserReactivated(ctx context.Context, id uuid.UUID) error
+	// ------------------------------------------------- lifecycle scope - END ------------------------------------------------------
+
+	// ------------------------------------------------- personal data scope - BEGIN ------------------------------------------------
+	CreateUserDataRequest(ctx context.Context, req dto.ToDBCreateUserDataRequest) (*models.UserDataRequest, error)
+	GetUserDataRequestByID(ctx context.Context, id uuid.UUID) (*models.UserDataRequest, error)
+	GetUserDataRequestsByUserID(ctx context.Context, userID uuid.UUID) ([]models.UserDataRequest, error)
+	HasActiveUserDataRequest(ctx context.Context, userID uuid.UUID, requestType string) (bool, error)
+	UpdateUserDataRequestStatus(ctx context.Context, id uuid.UUID, req dto.ToDBUpdateUserDataRequestStatus) error
+	ClearUserDataRequestFile(ctx context.Context, id uuid.UUID) error
+	GetUserForDataExport(ctx context.Context, id uuid.UUID) (*models.User, error)
+	GetPasswordHistoriesByUserID(ctx context.Context, userID uuid.UUID) ([]dto.UserDataPasswordHistory, error)
+	GetSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]dto.UserDataSession, error)
+	GetFilesByUserID(ctx context.Context, userID uuid.UUID) ([]dto.UserDataFile, error)
+	GetPostsByAuthorID(ctx context.Context, userID uuid.UUID) ([]models.Post, error)
+	AnonymizeUser(ctx context.Context, id uuid.UUID, req dto.ToDBAnonymizeUser) error
+	// ------------------------------------------------- personal data scope - END --------------------------------------------------
 }
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+)
+
+// AssignRole changes the role of a user.
+func (repo *userRepository) AssignRole(ctx context.Context, id uuid.UUID, roleId uuid.UUID) error {
+	result := repo.DB.WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"role_id":    roleId,
+			"updated_at": time.Now().UTC(),
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf(constants.UserIDNotFound, id)
+	}
+
+	return nil
+}
+
+// ForcePasswordReset flags the user to change the password on the next login.
+func (repo *userRepository) ForcePasswordReset(ctx context.Context, id uuid.UUID) error {
+	result := repo.DB.WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"is_first_time_login": true,
+			"updated_at":          time.Now().UTC(),
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf(constants.UserIDNotFound, id)
+	}
+
+	return nil
+}
@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/user_management/dto"
+	"gorm.io/gorm"
+)
+
+// CreateUserDataRequest stores a new personal data request (export / erasure) in pending state.
+func (repo *userRepository) CreateUserDataRequest(ctx context.Context, req dto.ToDBCreateUserDataRequest) (*models.UserDataRequest, error) {
+	now := time.Now().UTC()
+	res := &models.UserDataRequest{
+		UserID:      req.UserID,
+		RequestType: req.RequestType,
+		Status:      constants.UserDataRequestStatusPending,
+		RequestedBy: req.RequestedBy,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := repo.DB.WithContext(ctx).Create(res).Error; err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetUserDataRequestByID retrieves a personal data request by its ID.
+func (repo *userRepository) GetUserDataRequestByID(ctx context.Context, id uuid.UUID) (*models.UserDataRequest, error) {
+	res := &models.UserDataRequest{}
+	err := repo.DB.WithContext(ctx).
+		Where("id = ?", id).
+		First(res).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.UserDataRequestNotFound, id)
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetUserDataRequestsByUserID retrieves all personal data requests of a user, newest first.
+func (repo *userRepository) GetUserDataRequestsByUserID(ctx context.Context, userID uuid.UUID) ([]models.UserDataRequest, error) {
+	var res []models.UserDataRequest
+	err := repo.DB.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&res).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// HasActiveUserDataRequest checks whether the user already has a pending / processing request of the given type.
+func (repo *userRepository) HasActiveUserDataRequest(ctx context.Context, userID uuid.UUID, requestType string) (bool, error) {
+	var count int64
+	err := repo.DB.WithContext(ctx).
+		Model(&models.UserDataRequest{}).
+		Where("user_id = ? AND request_type = ?", userID, requestType).
+		Where("status IN ?", []string{constants.UserDataRequestStatusPending, constants.UserDataRequestStatusRunning}).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// UpdateUserDataRequestStatus moves a personal data request to the given status.
+// processed_at is filled once the request reaches a final state (completed / failed).
+func (repo *userRepository) UpdateUserDataRequestStatus(ctx context.Context, id uuid.UUID, req dto.ToDBUpdateUserDataRequestStatus) error {
+	now := time.Now().UTC()
+	updates := map[string]interface{}{
+		"status":     req.Status,
+		"updated_at": now,
+	}
+
+	if req.FilePath != nil {
+		updates["file_path"] = *req.FilePath
+	}
+
+	if req.ErrorMessage != nil {
+		updates["error_message"] = *req.ErrorMessage
+	}
+
+	if req.Status == constants.UserDataRequestStatusComplete || req.Status == constants.UserDataRequestStatusFailed {
+		updates["processed_at"] = now
+	}
+
+	result := repo.DB.WithContext(ctx).
+		Model(&models.UserDataRequest{}).
+		Where("id = ?", id).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf(constants.UserDataRequestNotFound, id)
+	}
+
+	return nil
+}
+
+// ClearUserDataRequestFile forgets the storage path of an export archive once the object is deleted.
+func (repo *userRepository) ClearUserDataRequestFile(ctx context.Context, id uuid.UUID) error {
+	return repo.DB.WithContext(ctx).
+		Model(&models.UserDataRequest{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"file_path":  nil,
+			"updated_at": time.Now().UTC(),
+		}).Error
+}
+
+// GetUserForDataExport retrieves the full profile of a user, including soft deleted ones.
+func (repo *userRepository) GetUserForDataExport(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	user := &models.User{}
+
+	err := repo.DB.WithContext(ctx).
+		Table("users usr").
+		Select(`
+			usr.id,
+			usr.full_name,
+			usr.username,
+			usr.email,
+			usr.nik,
+			usr.gender,
+			usr.is_active,
+			usr.verified_at,
+			usr.password_expired_at,
+			usr.created_at,
+			usr.updated_at,
+			usr.deleted_at,
+			usr.deletable,
+			rl.name AS role_name
+		`).
+		Joins("LEFT JOIN roles rl ON rl.id = usr.role_id").
+		Where("usr.id = ?", id).
+		Scan(user).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if user.ID == uuid.Nil {
+		return nil, fmt.Errorf(constants.UserIDNotFound, id)
+	}
+
+	return user, nil
+}
+
+// GetPasswordHistoriesByUserID retrieves password history metadata of a user (hashes are not selected).
+func (repo *userRepository) GetPasswordHistoriesByUserID(ctx context.Context, userID uuid.UUID) ([]dto.UserDataPasswordHistory, error) {
+	var res []dto.UserDataPasswordHistory
+	err := repo.DB.WithContext(ctx).
+		Table("password_histories").
+		Select("created_at", "updated_at").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Scan(&res).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetSessionsByUserID retrieves session metadata of a user (tokens are not selected).
+func (repo *userRepository) GetSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]dto.UserDataSession, error) {
+	var res []dto.UserDataSession
+	err := repo.DB.WithContext(ctx).
+		Table("jwt_tokens").
+		Select("is_used", "refresh_expires_at", "created_at", "updated_at").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Scan(&res).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetFilesByUserID retrieves files attached to a user through files_to_module.
+func (repo *userRepository) GetFilesByUserID(ctx context.Context, userID uuid.UUID) ([]dto.UserDataFile, error) {
+	var res []dto.UserDataFile
+	err := repo.DB.WithContext(ctx).
+		Table("files_to_module ftm").
+		Select("f.id, f.name, f.file_path, ftm.type, f.created_at").
+		Joins("JOIN files f ON f.id = ftm.file_id AND f.deleted_at IS NULL").
+		Where("ftm.module_type = ? AND ftm.module_id = ?", constants.ModuleTypeUser, userID).
+		Order("f.created_at DESC").
+		Scan(&res).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetPostsByAuthorID retrieves posts authored by the user.
+func (repo *userRepository) GetPostsByAuthorID(ctx context.Context, userID uuid.UUID) ([]models.Post, error) {
+	var res []models.Post
+	err := repo.DB.WithContext(ctx).
+		Where("created_by = ?", userID).
+		Order("created_at DESC").
+		Find(&res).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// AnonymizeUser scrubs personal data of a user while keeping the row itself,
+// so every created_by / updated_by / deleted_by reference across modules stays valid.
+// Credentials, sessions and files attached to the user are removed in the same transaction.
+func (repo *userRepository) AnonymizeUser(ctx context.Context, id uuid.UUID, req dto.ToDBAnonymizeUser) (err error) {
+	now := time.Now().UTC()
+
+	tx := repo.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	result := tx.Model(&models.User{}).
+		Unscoped().
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"full_name":   req.FullName,
+			"username":    req.Username,
+			"email":       req.Email,
+			"password":    req.HashedPassword,
+			"nik":         nil,
+			"gender":      nil,
+			"is_active":   false,
+			"verified_at": nil,
+			"deletable":   false,
+			"updated_at":  now,
+			"deleted_at":  gorm.Expr("COALESCE(deleted_at, ?)", now),
+		})
+	if result.Error != nil {
+		tx.Rollback()
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return fmt.Errorf(constants.UserIDNotFound, id)
+	}
+
+	for _, table := range []string{"password_histories", "otps", "reset_password_tokens", "jwt_tokens"} {
+		if err = tx.Exec("DELETE FROM "+table+" WHERE user_id = ?", id).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	// soft delete files owned by the user, then detach them
+	err = tx.Exec(`
+		UPDATE files SET deleted_at = ?, updated_at = ?
+		WHERE deleted_at IS NULL AND id IN (
+			SELECT file_id FROM files_to_module WHERE module_type = ? AND module_id = ?
+		)`, now, now, constants.ModuleTypeUser, id).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Where("module_type = ? AND module_id = ?", constants.ModuleTypeUser, id).
+		Delete(&models.FilesToModule{}).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
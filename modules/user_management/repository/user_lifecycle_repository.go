@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	"gorm.io/gorm"
+)
+
+// lifecycleUserColumns are the columns needed to evaluate the lifecycle policy of a user
+const lifecycleUserColumns = `
+	usr.id,
+	usr.full_name,
+	usr.username,
+	usr.email,
+	usr.is_active,
+	usr.counter,
+	usr.created_at,
+	usr.updated_at,
+	usr.last_login_at,
+	usr.reactivated_at,
+	usr.deactivate_at,
+	usr.dormant_warned_at,
+	usr.deletable,
+	CASE
+		WHEN usr.counter >= 3 THEN true
+		ELSE false
+	END AS is_blocked,
+	rl.name AS role_name
+`
+
+// SetDeactivateAt schedules (or clears when nil) the deactivation time of a user.
+func (repo *userRepository) SetDeactivateAt(ctx context.Context, id uuid.UUID, deactivateAt *time.Time) (userRes *models.User, err error) {
+	userRes = &models.User{}
+
+	err = repo.DB.WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"deactivate_at":     deactivateAt,
+			"dormant_warned_at": nil,
+			"updated_at":        time.Now().UTC(),
+		}).
+		Select("id", "full_name", "is_active", "deactivate_at", "created_at", "updated_at", "deleted_at").
+		First(userRes).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(constants.UserIDNotFound, id)
+		}
+		return nil, err
+	}
+
+	return userRes, nil
+}
+
+// GetUsersInactiveSince retrieves active users whose last activity (latest of last login, reactivation and creation)
+// happened at or before the given time. Non deletable (system) users are excluded from the dormant policy.
+func (repo *userRepository) GetUsersInactiveSince(ctx context.Context, before time.Time) (users []models.User, err error) {
+	err = repo.DB.WithContext(ctx).
+		Table("users usr").
+		Select(lifecycleUserColumns).
+		Joins("LEFT JOIN roles rl ON rl.id = usr.role_id").
+		Where("usr.deleted_at IS NULL AND usr.is_active = true AND usr.deletable = true").
+		Where("GREATEST(usr.last_login_at, usr.reactivated_at, usr.created_at) <= ?", before).
+		Order("GREATEST(usr.last_login_at, usr.reactivated_at, usr.created_at) ASC").
+		Scan(&users).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// GetUsersScheduledForDeactivation retrieves active users whose deactivate_at is at or before the given time.
+func (repo *userRepository) GetUsersScheduledForDeactivation(ctx context.Context, before time.Time) (users []models.User, err error) {
+	err = repo.DB.WithContext(ctx).
+		Table("users usr").
+		Select(lifecycleUserColumns).
+		Joins("LEFT JOIN roles rl ON rl.id = usr.role_id").
+		Where("usr.deleted_at IS NULL AND usr.is_active = true").
+		Where("usr.deactivate_at IS NOT NULL AND usr.deactivate_at <= ?", before).
+		Order("usr.deactivate_at ASC").
+		Scan(&users).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// MarkLifecycleWarningSent stamps dormant_warned_at of the given users.
+func (repo *userRepository) MarkLifecycleWarningSent(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return repo.DB.WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ANY(?)", pq.Array(ids)).
+		UpdateColumn("dormant_warned_at", time.Now().UTC()).Error
+}
+
+// MarkUserReactivated restarts the dormant period of a user that was manually activated or unblocked,
+// a warning sent for the previous period no longer applies.
+func (repo *userRepository) MarkUserReactivated(ctx context.Context, id uuid.UUID) error {
+	return repo.DB.WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"reactivated_at":    time.Now().UTC(),
+			"dormant_warned_at": nil,
+		}).Error
+}
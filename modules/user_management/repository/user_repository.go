@@ -112,7 +112,10 @@ func (repo *userRepository) GetUserByID(ctx context.Context, id uuid.UUID) (user
 				ELSE false
 			END AS is_blocked,
 			usr.nik,
-			usr.verified_at
+			usr.verified_at,
+			usr.last_login_at,
+			usr.deactivate_at,
+			usr.auto_unblock_at
 		`).
 		Joins("JOIN roles rl ON rl.id = usr.role_id").
 		Where("usr.id = ? AND usr.deleted_at IS NULL", id).
@@ -307,7 +310,10 @@ func (repo *userRepository) UnBlockUser(ctx context.Context, id uuid.UUID) (user
 	err = repo.DB.WithContext(ctx).
 		Model(&models.User{}).
 		Where("id = ?", id).
-		Update("counter", 0).
+		Updates(map[string]interface{}{
+			"counter":         0,
+			"auto_unblock_at": nil,
+		}).
 		Select("id", "full_name", "counter", "created_at", "updated_at", "deleted_at").
 		First(userRes).Error
 
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/user_management/dto"
+)
+
+// CreateUserStatusHistory records a status change (block / unblock / activate / deactivate) of a user.
+func (repo *userRepository) CreateUserStatusHistory(ctx context.Context, req dto.ToDBCreateUserStatusHistory) error {
+	history := &models.UserStatusHistory{
+		UserID:    req.UserID,
+		Action:    req.Action,
+		Reason:    req.Reason,
+		UnblockAt: req.UnblockAt,
+		CreatedBy: req.CreatedBy,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	return repo.DB.WithContext(ctx).Create(history).Error
+}
+
+// GetUserStatusHistories retrieves the status change history of a user, newest first,
+// along with the name of the actor.
+func (repo *userRepository) GetUserStatusHistories(ctx context.Context, userID uuid.UUID) (histories []models.UserStatusHistory, err error) {
+	err = repo.DB.WithContext(ctx).
+		Table("user_status_histories ush").
+		Select(`
+			ush.id,
+			ush.user_id,
+			ush.action,
+			ush.reason,
+			ush.unblock_at,
+			ush.created_by,
+			ush.created_at,
+			COALESCE(actor.full_name, ush.created_by) AS created_by_name
+		`).
+		Joins("LEFT JOIN users actor ON actor.id::text = ush.created_by").
+		Where("ush.user_id = ?", userID).
+		Order("ush.created_at DESC").
+		Scan(&histories).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return histories, nil
+}
+
+// SetAutoUnblockAt schedules (or clears when nil) the automatic unblock of a blocked user.
+func (repo *userRepository) SetAutoUnblockAt(ctx context.Context, id uuid.UUID, unblockAt *time.Time) error {
+	return repo.DB.WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ?", id).
+		UpdateColumn("auto_unblock_at", unblockAt).Error
+}
+
+// GetUsersDueForAutoUnblock retrieves blocked users whose auto_unblock_at is at or before the given time.
+func (repo *userRepository) GetUsersDueForAutoUnblock(ctx context.Context, before time.Time) (users []models.User, err error) {
+	err = repo.DB.WithContext(ctx).
+		Table("users usr").
+		Select(lifecycleUserColumns+", usr.auto_unblock_at").
+		Joins("LEFT JOIN roles rl ON rl.id = usr.role_id").
+		Where("usr.deleted_at IS NULL AND usr.counter >= 3").
+		Where("usr.auto_unblock_at IS NOT NULL AND usr.auto_unblock_at <= ?", before).
+		Order("usr.auto_unblock_at ASC").
+		Scan(&users).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
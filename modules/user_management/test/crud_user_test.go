@@ -224,6 +224,160 @@ func (m *MockUserRepository) MarkUserVerified(ctx context.Context, id uuid.UUID)
 	return args.Get(0).(*models.User), args.Error(1)
 }
 
+func (m *MockUserRepository) CreateUserStatusHistory(ctx context.Context, req userDto.ToDBCreateUserStatusHistory) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) GetUserStatusHistories(ctx context.Context, userID uuid.UUID) ([]models.UserStatusHistory, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.UserStatusHistory), args.Error(1)
+}
+
+func (m *MockUserRepository) SetAutoUnblockAt(ctx context.Context, id uuid.UUID, unblockAt *time.Time) error {
+	args := m.Called(ctx, id, unblockAt)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) GetUsersDueForAutoUnblock(ctx context.Context, before time.Time) ([]models.User, error) {
+	args := m.Called(ctx, before)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.User), args.Error(1)
+}
+
+func (m *MockUserRepository) AssignRole(ctx context.Context, id uuid.UUID, roleId uuid.UUID) error {
+	args := m.Called(ctx, id, roleId)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) ForcePasswordReset(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) SetDeactivateAt(ctx context.Context, id uuid.UUID, deactivateAt *time.Time) (*models.User, error) {
+	args := m.Called(ctx, id, deactivateAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetUsersInactiveSince(ctx context.Context, before time.Time) ([]models.User, error) {
+	args := m.Called(ctx, before)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetUsersScheduledForDeactivation(ctx context.Context, before time.Time) ([]models.User, error) {
+	args := m.Called(ctx, before)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.User), args.Error(1)
+}
+
+func (m *MockUserRepository) MarkUserReactivated(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) MarkLifecycleWarningSent(ctx context.Context, ids []uuid.UUID) error {
+	args := m.Called(ctx, ids)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) CreateUserDataRequest(ctx context.Context, req userDto.ToDBCreateUserDataRequest) (*models.UserDataRequest, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.UserDataRequest), args.Error(1)
+}
+
+func (m *MockUserRepository) GetUserDataRequestByID(ctx context.Context, id uuid.UUID) (*models.UserDataRequest, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.UserDataRequest), args.Error(1)
+}
+
+func (m *MockUserRepository) GetUserDataRequestsByUserID(ctx context.Context, userID uuid.UUID) ([]models.UserDataRequest, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.UserDataRequest), args.Error(1)
+}
+
+func (m *MockUserRepository) ClearUserDataRequestFile(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) HasActiveUserDataRequest(ctx context.Context, userID uuid.UUID, requestType string) (bool, error) {
+	args := m.Called(ctx, userID, requestType)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserRepository) UpdateUserDataRequestStatus(ctx context.Context, id uuid.UUID, req userDto.ToDBUpdateUserDataRequestStatus) error {
+	args := m.Called(ctx, id, req)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) GetUserForDataExport(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetPasswordHistoriesByUserID(ctx context.Context, userID uuid.UUID) ([]userDto.UserDataPasswordHistory, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]userDto.UserDataPasswordHistory), args.Error(1)
+}
+
+func (m *MockUserRepository) GetSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]userDto.UserDataSession, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]userDto.UserDataSession), args.Error(1)
+}
+
+func (m *MockUserRepository) GetFilesByUserID(ctx context.Context, userID uuid.UUID) ([]userDto.UserDataFile, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]userDto.UserDataFile), args.Error(1)
+}
+
+func (m *MockUserRepository) GetPostsByAuthorID(ctx context.Context, userID uuid.UUID) ([]models.Post, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Post), args.Error(1)
+}
+
+func (m *MockUserRepository) AnonymizeUser(ctx context.Context, id uuid.UUID, req userDto.ToDBAnonymizeUser) error {
+	args := m.Called(ctx, id, req)
+	return args.Error(0)
+}
+
 // MockAuthRepository is a mock implementation of auth.Repository
 type MockAuthRepository struct {
 	mock.Mock
@@ -1340,12 +1494,36 @@ func TestBlockUser(t *testing.T) {
 	validID := uuid.New()
 	validIDString := validID.String()
 
+	authId := uuid.New().String()
+
 	validBlockReq := &userDto.ReqBlockUser{
 		IsBlock: true,
+		Reason:  "Suspicious activity",
 	}
 
 	validUnblockReq := &userDto.ReqBlockUser{
 		IsBlock: false,
+		Reason:  "Verified by support",
+	}
+
+	unblockAt := time.Now().Add(24 * time.Hour).UTC()
+	temporaryBlockReq := &userDto.ReqBlockUser{
+		IsBlock:   true,
+		Reason:    "Cooling down",
+		UnblockAt: &unblockAt,
+	}
+
+	pastUnblockAt := time.Now().Add(-time.Hour)
+	pastUnblockReq := &userDto.ReqBlockUser{
+		IsBlock:   true,
+		Reason:    "Cooling down",
+		UnblockAt: &pastUnblockAt,
+	}
+
+	unblockWithDateReq := &userDto.ReqBlockUser{
+		IsBlock:   false,
+		Reason:    "Verified by support",
+		UnblockAt: &unblockAt,
 	}
 
 	expectedUser := &models.User{
@@ -1369,24 +1547,80 @@ func TestBlockUser(t *testing.T) {
 			req:  validBlockReq,
 			setupMock: func() {
 				mockUserRepo.On("BlockUser", ctx, validID).Return(expectedUser, nil).Once()
+				mockUserRepo.On("SetAutoUnblockAt", ctx, validID, (*time.Time)(nil)).Return(nil).Once()
 				mockTokenStorage.On("RevokeAllUserSessions", ctx, validID).Return(nil).Once()
 				mockUserRepo.On("GetUserByID", ctx, validID).Return(expectedUser, nil).Once()
+				mockUserRepo.On("CreateUserStatusHistory", ctx, userDto.ToDBCreateUserStatusHistory{
+					UserID:    validID,
+					Action:    constants.UserStatusActionBlock,
+					Reason:    "Suspicious activity",
+					CreatedBy: authId,
+				}).Return(nil).Once()
 			},
 			expectedError: false,
 			description:   "User should be blocked successfully",
 		},
+		{
+			name: "Positive case - successful temporary block user",
+			id:   validIDString,
+			req:  temporaryBlockReq,
+			setupMock: func() {
+				mockUserRepo.On("BlockUser", ctx, validID).Return(expectedUser, nil).Once()
+				mockUserRepo.On("SetAutoUnblockAt", ctx, validID, &unblockAt).Return(nil).Once()
+				mockTokenStorage.On("RevokeAllUserSessions", ctx, validID).Return(nil).Once()
+				mockUserRepo.On("GetUserByID", ctx, validID).Return(expectedUser, nil).Once()
+				mockUserRepo.On("CreateUserStatusHistory", ctx, userDto.ToDBCreateUserStatusHistory{
+					UserID:    validID,
+					Action:    constants.UserStatusActionBlock,
+					Reason:    "Cooling down",
+					UnblockAt: &unblockAt,
+					CreatedBy: authId,
+				}).Return(nil).Once()
+			},
+			expectedError: false,
+			description:   "User should be blocked until unblock_at",
+		},
 		{
 			name: "Positive case - successful unblock user",
 			id:   validIDString,
 			req:  validUnblockReq,
 			setupMock: func() {
 				mockUserRepo.On("UnBlockUser", ctx, validID).Return(expectedUser, nil).Once()
+				mockUserRepo.On("MarkUserReactivated", ctx, validID).Return(nil).Once()
 				mockTokenStorage.On("RevokeAllUserSessions", ctx, validID).Return(nil).Once()
 				mockUserRepo.On("GetUserByID", ctx, validID).Return(expectedUser, nil).Once()
+				mockUserRepo.On("CreateUserStatusHistory", ctx, userDto.ToDBCreateUserStatusHistory{
+					UserID:    validID,
+					Action:    constants.UserStatusActionUnblock,
+					Reason:    "Verified by support",
+					CreatedBy: authId,
+				}).Return(nil).Once()
 			},
 			expectedError: false,
 			description:   "User should be unblocked successfully",
 		},
+		{
+			name: "Negative case - unblock_at in the past",
+			id:   validIDString,
+			req:  pastUnblockReq,
+			setupMock: func() {
+				// No repository call should be made
+			},
+			expectedError:  true,
+			expectedErrMsg: constants.UserUnblockAtMustBeFuture,
+			description:    "unblock_at in the past should return error",
+		},
+		{
+			name: "Negative case - unblock_at on unblock",
+			id:   validIDString,
+			req:  unblockWithDateReq,
+			setupMock: func() {
+				// No repository call should be made
+			},
+			expectedError:  true,
+			expectedErrMsg: constants.UserUnblockAtOnlyOnBlock,
+			description:    "unblock_at is only allowed when blocking",
+		},
 		{
 			name: "Negative case - invalid UUID",
 			id:   "invalid-uuid",
@@ -1434,7 +1668,7 @@ func TestBlockUser(t *testing.T) {
 
 			c := e.NewContext(httptest.NewRequest(http.MethodPut, "/", nil), httptest.NewRecorder())
 
-			result, err := usecaseInstance.BlockUser(c.Request().Context(), tt.id, tt.req)
+			result, err := usecaseInstance.BlockUser(c.Request().Context(), tt.id, tt.req, authId)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -1469,12 +1703,16 @@ func TestActivateUser(t *testing.T) {
 	validID := uuid.New()
 	validIDString := validID.String()
 
+	authId := uuid.New().String()
+
 	validActivateReq := &userDto.ReqActivateUser{
 		IsActive: true,
+		Reason:   "Rejoined the company",
 	}
 
 	validDeactivateReq := &userDto.ReqActivateUser{
 		IsActive: false,
+		Reason:   "Resigned",
 	}
 
 	expectedUser := &models.User{
@@ -1498,8 +1736,15 @@ func TestActivateUser(t *testing.T) {
 			req:  validActivateReq,
 			setupMock: func() {
 				mockUserRepo.On("ActivateUser", ctx, validID).Return(expectedUser, nil).Once()
+				mockUserRepo.On("MarkUserReactivated", ctx, validID).Return(nil).Once()
 				mockTokenStorage.On("RevokeAllUserSessions", ctx, validID).Return(nil).Once()
 				mockUserRepo.On("GetUserByID", ctx, validID).Return(expectedUser, nil).Once()
+				mockUserRepo.On("CreateUserStatusHistory", ctx, userDto.ToDBCreateUserStatusHistory{
+					UserID:    validID,
+					Action:    constants.UserStatusActionActivate,
+					Reason:    "Rejoined the company",
+					CreatedBy: authId,
+				}).Return(nil).Once()
 			},
 			expectedError: false,
 			description:   "User should be activated successfully",
@@ -1512,6 +1757,12 @@ func TestActivateUser(t *testing.T) {
 				mockUserRepo.On("DisActivateUser", ctx, validID).Return(expectedUser, nil).Once()
 				mockTokenStorage.On("RevokeAllUserSessions", ctx, validID).Return(nil).Once()
 				mockUserRepo.On("GetUserByID", ctx, validID).Return(expectedUser, nil).Once()
+				mockUserRepo.On("CreateUserStatusHistory", ctx, userDto.ToDBCreateUserStatusHistory{
+					UserID:    validID,
+					Action:    constants.UserStatusActionDeactivate,
+					Reason:    "Resigned",
+					CreatedBy: authId,
+				}).Return(nil).Once()
 			},
 			expectedError: false,
 			description:   "User should be deactivated successfully",
@@ -1563,7 +1814,7 @@ func TestActivateUser(t *testing.T) {
 
 			c := e.NewContext(httptest.NewRequest(http.MethodPut, "/", nil), httptest.NewRecorder())
 
-			result, err := usecaseInstance.ActivateUser(c.Request().Context(), tt.id, tt.req)
+			result, err := usecaseInstance.ActivateUser(c.Request().Context(), tt.id, tt.req, authId)
 
 			if tt.expectedError {
 				assert.Error(t, err)
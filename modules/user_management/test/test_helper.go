@@ -1,6 +1,7 @@
 package test
 
 import (
+	"bytes"
 	"context"
 	"time"
 
@@ -51,3 +52,28 @@ func (m *MockTokenStorage) ValidateAccessToken(ctx context.Context, accessToken
 	args := m.Called(ctx, accessToken)
 	return args.Get(0).(models.User), args.Error(1)
 }
+
+// MockStorage is a mock implementation of storage.Storage, only DeleteFile is used by the user data requests
+type MockStorage struct {
+	mock.Mock
+}
+
+func (m *MockStorage) GetFullURL(path string) string { return path }
+func (m *MockStorage) UploadFile(buf bytes.Buffer, fileName string, destinatedPath string) (string, error) {
+	panic("not implemented")
+}
+func (m *MockStorage) DeleteFile(fileURL string) error {
+	args := m.Called(fileURL)
+	return args.Error(0)
+}
+func (m *MockStorage) GeneratePresignedURL(fullURL string) (string, error) { return fullURL, nil }
+func (m *MockStorage) GeneratePresignedURLWithPreview(fullURL string) (string, error) {
+	return fullURL, nil
+}
+func (m *MockStorage) DownloadFile(fileURL string) (*bytes.Buffer, error) {
+	panic("not implemented")
+}
+func (m *MockStorage) CopyFile(originalFileURL string, overrideName *string) (string, error) {
+	panic("not implemented")
+}
+func (m *MockStorage) HealthCheck(ctx context.Context) error { return nil }
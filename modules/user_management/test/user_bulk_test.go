@@ -0,0 +1,187 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	userDto "github.com/rendyfutsuy/base-go/modules/user_management/dto"
+	"github.com/rendyfutsuy/base-go/utils/token_storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBulkAssignRole(t *testing.T) {
+	setupTestLogger()
+
+	usecaseInstance, mockUserRepo, _, mockRoleRepo := createTestUsecase()
+	ctx := context.Background()
+
+	authID := uuid.New()
+	roleID := uuid.New()
+	regularUser := &models.User{ID: uuid.New(), FullName: "REGULAR", Deletable: true}
+	systemUser := &models.User{ID: uuid.New(), FullName: "SYSTEM", Deletable: false}
+	selfUser := &models.User{ID: authID, FullName: "SELF", Deletable: true}
+	missingID := uuid.New()
+
+	t.Run("Positive case - partial success report", func(t *testing.T) {
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.Calls = nil
+		mockRoleRepo.ExpectedCalls = nil
+		mockRoleRepo.Calls = nil
+
+		mockRoleRepo.On("GetRoleByID", ctx, roleID).Return(&models.Role{ID: roleID}, nil).Once()
+		mockUserRepo.On("GetUserByID", ctx, regularUser.ID).Return(regularUser, nil).Once()
+		mockUserRepo.On("GetUserByID", ctx, systemUser.ID).Return(systemUser, nil).Once()
+		mockUserRepo.On("GetUserByID", ctx, selfUser.ID).Return(selfUser, nil).Once()
+		mockUserRepo.On("GetUserByID", ctx, missingID).Return(nil, fmt.Errorf(constants.UserIDNotFound, missingID)).Once()
+		mockUserRepo.On("AssignRole", ctx, regularUser.ID, roleID).Return(nil).Once()
+
+		res, err := usecaseInstance.BulkAssignRole(ctx, &userDto.ReqBulkAssignRole{
+			// duplicated id is processed once
+			UserIds: []uuid.UUID{regularUser.ID, systemUser.ID, selfUser.ID, missingID, regularUser.ID},
+			RoleId:  roleID,
+		}, authID.String())
+
+		assert.NoError(t, err)
+		assert.Equal(t, constants.UserBulkActionAssignRole, res.Action)
+		assert.Equal(t, 4, res.Total)
+		assert.Equal(t, 1, res.Succeeded)
+		assert.Equal(t, 3, res.Failed)
+		assert.True(t, res.Results[0].Success)
+		assert.Equal(t, constants.UserBulkProtectedUser, res.Results[1].Message)
+		assert.Equal(t, fmt.Sprintf(constants.UserBulkSelfNotAllowed, "change the role of"), res.Results[2].Message)
+		assert.False(t, res.Results[3].Success)
+		mockUserRepo.AssertExpectations(t)
+		mockRoleRepo.AssertExpectations(t)
+	})
+
+	t.Run("Negative case - role not found", func(t *testing.T) {
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.Calls = nil
+		mockRoleRepo.ExpectedCalls = nil
+		mockRoleRepo.Calls = nil
+
+		mockRoleRepo.On("GetRoleByID", ctx, roleID).Return(nil, errors.New(constants.UserRoleNotFound)).Once()
+
+		res, err := usecaseInstance.BulkAssignRole(ctx, &userDto.ReqBulkAssignRole{
+			UserIds: []uuid.UUID{regularUser.ID},
+			RoleId:  roleID,
+		}, authID.String())
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		mockUserRepo.AssertExpectations(t)
+		mockRoleRepo.AssertExpectations(t)
+	})
+}
+
+func TestBulkBlockUser(t *testing.T) {
+	setupTestLogger()
+
+	usecaseInstance, mockUserRepo, _, _ := createTestUsecase()
+	ctx := context.Background()
+
+	mockTokenStorage := new(MockTokenStorage)
+	token_storage.SetTokenStorage(mockTokenStorage)
+
+	authID := uuid.New()
+	regularUser := &models.User{ID: uuid.New(), FullName: "REGULAR", Deletable: true}
+	failingUser := &models.User{ID: uuid.New(), FullName: "FAILING", Deletable: true}
+
+	t.Run("Positive case - same side effects as single block", func(t *testing.T) {
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.Calls = nil
+		mockTokenStorage.ExpectedCalls = nil
+		mockTokenStorage.Calls = nil
+
+		mockUserRepo.On("GetUserByID", ctx, regularUser.ID).Return(regularUser, nil).Twice()
+		mockUserRepo.On("BlockUser", ctx, regularUser.ID).Return(regularUser, nil).Once()
+		mockUserRepo.On("SetAutoUnblockAt", ctx, regularUser.ID, (*time.Time)(nil)).Return(nil).Once()
+		mockUserRepo.On("CreateUserStatusHistory", ctx, mock.AnythingOfType("dto.ToDBCreateUserStatusHistory")).Return(nil).Once()
+		mockTokenStorage.On("RevokeAllUserSessions", ctx, regularUser.ID).Return(nil).Once()
+
+		mockUserRepo.On("GetUserByID", ctx, failingUser.ID).Return(failingUser, nil).Once()
+		mockUserRepo.On("BlockUser", ctx, failingUser.ID).Return(nil, errors.New("database error")).Once()
+
+		res, err := usecaseInstance.BulkBlockUser(ctx, &userDto.ReqBulkBlockUser{
+			UserIds:      []uuid.UUID{regularUser.ID, failingUser.ID},
+			ReqBlockUser: userDto.ReqBlockUser{IsBlock: true, Reason: "Department closed"},
+		}, authID.String())
+
+		assert.NoError(t, err)
+		assert.Equal(t, constants.UserBulkActionBlock, res.Action)
+		assert.Equal(t, 1, res.Succeeded)
+		assert.Equal(t, 1, res.Failed)
+		assert.Equal(t, "database error", res.Results[1].Message)
+		mockUserRepo.AssertExpectations(t)
+		mockTokenStorage.AssertExpectations(t)
+	})
+
+	t.Run("Negative case - unblock_at in the past", func(t *testing.T) {
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.Calls = nil
+
+		past := time.Now().Add(-time.Hour)
+		res, err := usecaseInstance.BulkBlockUser(ctx, &userDto.ReqBulkBlockUser{
+			UserIds:      []uuid.UUID{regularUser.ID},
+			ReqBlockUser: userDto.ReqBlockUser{IsBlock: true, Reason: "Department closed", UnblockAt: &past},
+		}, authID.String())
+
+		assert.EqualError(t, err, constants.UserUnblockAtMustBeFuture)
+		assert.Nil(t, res)
+		mockUserRepo.AssertExpectations(t)
+	})
+}
+
+func TestBulkSoftDeleteUser(t *testing.T) {
+	setupTestLogger()
+
+	usecaseInstance, mockUserRepo, _, _ := createTestUsecase()
+	ctx := context.Background()
+
+	authID := uuid.New()
+	systemUser := &models.User{ID: uuid.New(), FullName: "SYSTEM", Deletable: false}
+
+	mockUserRepo.On("GetUserByID", ctx, systemUser.ID).Return(systemUser, nil).Once()
+
+	res, err := usecaseInstance.BulkSoftDeleteUser(ctx, &userDto.ReqBulkUserIds{
+		UserIds: []uuid.UUID{systemUser.ID},
+	}, authID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, res.Succeeded)
+	assert.Equal(t, 1, res.Failed)
+	assert.Equal(t, constants.UserBulkProtectedUser, res.Results[0].Message)
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestForceUserPasswordReset(t *testing.T) {
+	setupTestLogger()
+
+	usecaseInstance, mockUserRepo, _, _ := createTestUsecase()
+	ctx := context.Background()
+
+	mockTokenStorage := new(MockTokenStorage)
+	token_storage.SetTokenStorage(mockTokenStorage)
+
+	user := &models.User{ID: uuid.New(), FullName: "REGULAR", Deletable: true}
+
+	mockUserRepo.On("GetUserByID", ctx, user.ID).Return(user, nil).Twice()
+	mockUserRepo.On("ForcePasswordReset", ctx, user.ID).Return(nil).Once()
+	mockTokenStorage.On("RevokeAllUserSessions", ctx, user.ID).Return(nil).Once()
+
+	res, err := usecaseInstance.BulkForcePasswordReset(ctx, &userDto.ReqBulkUserIds{
+		UserIds: []uuid.UUID{user.ID},
+	}, uuid.New().String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, res.Succeeded)
+	mockUserRepo.AssertExpectations(t)
+	mockTokenStorage.AssertExpectations(t)
+}
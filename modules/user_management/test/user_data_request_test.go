@@ -0,0 +1,278 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	userDto "github.com/rendyfutsuy/base-go/modules/user_management/dto"
+	utilsServices "github.com/rendyfutsuy/base-go/utils/services"
+	"github.com/rendyfutsuy/base-go/utils/token_storage"
+	"github.com/rendyfutsuy/base-go/worker"
+	"github.com/rendyfutsuy/base-go/worker/payloads"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRequestUserData(t *testing.T) {
+	setupTestLogger()
+
+	usecaseInstance, mockUserRepo, _, _ := createTestUsecase()
+	ctx := context.Background()
+
+	originalQueue := worker.JobQueue
+	defer func() { worker.JobQueue = originalQueue }()
+
+	userID := uuid.New()
+	authID := uuid.New().String()
+	requestID := uuid.New()
+
+	deletableUser := &models.User{ID: userID, FullName: "Test User", Deletable: true}
+	nonDeletableUser := &models.User{ID: userID, FullName: "Test User", Deletable: false}
+	pendingRequest := &models.UserDataRequest{ID: requestID, UserID: userID, Status: constants.UserDataRequestStatusPending}
+
+	tests := []struct {
+		name           string
+		erasure        bool
+		queue          chan worker.Job
+		setupMock      func()
+		expectedError  bool
+		expectedErrMsg string
+		expectedJob    constants.JobType
+	}{
+		{
+			name:  "Positive case - export request queued",
+			queue: make(chan worker.Job, 1),
+			setupMock: func() {
+				mockUserRepo.On("GetUserByID", ctx, userID).Return(deletableUser, nil).Once()
+				mockUserRepo.On("HasActiveUserDataRequest", ctx, userID, constants.UserDataRequestTypeExport).Return(false, nil).Once()
+				mockUserRepo.On("CreateUserDataRequest", ctx, userDto.ToDBCreateUserDataRequest{
+					UserID:      userID,
+					RequestType: constants.UserDataRequestTypeExport,
+					RequestedBy: authID,
+				}).Return(pendingRequest, nil).Once()
+			},
+			expectedJob: constants.JobTypeUserDataExport,
+		},
+		{
+			name:    "Positive case - erasure request queued",
+			erasure: true,
+			queue:   make(chan worker.Job, 1),
+			setupMock: func() {
+				mockUserRepo.On("GetUserByID", ctx, userID).Return(deletableUser, nil).Once()
+				mockUserRepo.On("HasActiveUserDataRequest", ctx, userID, constants.UserDataRequestTypeErasure).Return(false, nil).Once()
+				mockUserRepo.On("CreateUserDataRequest", ctx, mock.Anything).Return(pendingRequest, nil).Once()
+			},
+			expectedJob: constants.JobTypeUserDataErasure,
+		},
+		{
+			name:  "Negative case - user not found",
+			queue: make(chan worker.Job, 1),
+			setupMock: func() {
+				mockUserRepo.On("GetUserByID", ctx, userID).Return(nil, errors.New("record not found")).Once()
+			},
+			expectedError:  true,
+			expectedErrMsg: constants.UserNotFound,
+		},
+		{
+			name:    "Negative case - erasure on non deletable user",
+			erasure: true,
+			queue:   make(chan worker.Job, 1),
+			setupMock: func() {
+				mockUserRepo.On("GetUserByID", ctx, userID).Return(nonDeletableUser, nil).Once()
+			},
+			expectedError:  true,
+			expectedErrMsg: constants.UserDataRequestCannotErase,
+		},
+		{
+			name:  "Negative case - request already queued",
+			queue: make(chan worker.Job, 1),
+			setupMock: func() {
+				mockUserRepo.On("GetUserByID", ctx, userID).Return(deletableUser, nil).Once()
+				mockUserRepo.On("HasActiveUserDataRequest", ctx, userID, constants.UserDataRequestTypeExport).Return(true, nil).Once()
+			},
+			expectedError:  true,
+			expectedErrMsg: "There is already a export request",
+		},
+		{
+			name:  "Negative case - worker not running marks request failed",
+			queue: nil,
+			setupMock: func() {
+				mockUserRepo.On("GetUserByID", ctx, userID).Return(deletableUser, nil).Once()
+				mockUserRepo.On("HasActiveUserDataRequest", ctx, userID, constants.UserDataRequestTypeExport).Return(false, nil).Once()
+				mockUserRepo.On("CreateUserDataRequest", ctx, mock.Anything).Return(pendingRequest, nil).Once()
+				mockUserRepo.On("UpdateUserDataRequestStatus", ctx, requestID, mock.MatchedBy(func(req userDto.ToDBUpdateUserDataRequestStatus) bool {
+					return req.Status == constants.UserDataRequestStatusFailed && req.ErrorMessage != nil
+				})).Return(nil).Once()
+			},
+			expectedError:  true,
+			expectedErrMsg: constants.UserDataRequestDispatchFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo.ExpectedCalls = nil
+			mockUserRepo.Calls = nil
+			worker.JobQueue = tt.queue
+			tt.setupMock()
+
+			var (
+				result *models.UserDataRequest
+				err    error
+			)
+			if tt.erasure {
+				result, err = usecaseInstance.RequestUserDataErasure(ctx, userID.String(), authID)
+			} else {
+				result, err = usecaseInstance.RequestUserDataExport(ctx, userID.String(), authID)
+			}
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrMsg)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, requestID, result.ID)
+
+				job := <-tt.queue
+				assert.Equal(t, tt.expectedJob, job.Type)
+				assert.Equal(t, payloads.UserDataRequestPayload{RequestID: requestID}, job.Payload)
+			}
+
+			mockUserRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProcessUserDataErasure(t *testing.T) {
+	setupTestLogger()
+
+	usecaseInstance, mockUserRepo, _, _ := createTestUsecase()
+	ctx := context.Background()
+
+	mockTokenStorage := new(MockTokenStorage)
+	token_storage.SetTokenStorage(mockTokenStorage)
+	mockStorage := new(MockStorage)
+	utilsServices.SetStorage(mockStorage)
+	defer utilsServices.SetStorage(nil)
+
+	userID := uuid.New()
+	requestID := uuid.New()
+	exportRequestID := uuid.New()
+	exportPath := "user-data-exports/" + userID.String() + "/export.zip"
+
+	t.Run("Positive case - user anonymized and request completed", func(t *testing.T) {
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.Calls = nil
+
+		mockUserRepo.On("GetUserDataRequestByID", ctx, requestID).Return(&models.UserDataRequest{
+			ID:          requestID,
+			UserID:      userID,
+			RequestType: constants.UserDataRequestTypeErasure,
+			Status:      constants.UserDataRequestStatusPending,
+		}, nil).Once()
+		mockUserRepo.On("UpdateUserDataRequestStatus", ctx, requestID, userDto.ToDBUpdateUserDataRequestStatus{Status: constants.UserDataRequestStatusRunning}).Return(nil).Once()
+		mockTokenStorage.On("RevokeAllUserSessions", ctx, userID).Return(nil).Once()
+		// the earlier export archive is deleted from storage and forgotten
+		mockUserRepo.On("GetUserDataRequestsByUserID", ctx, userID).Return([]models.UserDataRequest{
+			{ID: requestID, UserID: userID, RequestType: constants.UserDataRequestTypeErasure},
+			{ID: exportRequestID, UserID: userID, RequestType: constants.UserDataRequestTypeExport, FilePath: &exportPath},
+		}, nil).Once()
+		mockStorage.On("DeleteFile", exportPath).Return(nil).Once()
+		mockUserRepo.On("ClearUserDataRequestFile", ctx, exportRequestID).Return(nil).Once()
+		mockUserRepo.On("GetFilesByUserID", ctx, userID).Return([]userDto.UserDataFile{}, nil).Once()
+		mockUserRepo.On("AnonymizeUser", ctx, userID, mock.MatchedBy(func(req userDto.ToDBAnonymizeUser) bool {
+			return req.FullName == constants.UserErasedFullName &&
+				req.Username == constants.UserErasedUsernamePrefix+userID.String() &&
+				req.HashedPassword != ""
+		})).Return(nil).Once()
+		mockUserRepo.On("UpdateUserDataRequestStatus", ctx, requestID, userDto.ToDBUpdateUserDataRequestStatus{Status: constants.UserDataRequestStatusComplete}).Return(nil).Once()
+
+		err := usecaseInstance.ProcessUserDataErasure(ctx, requestID)
+
+		assert.NoError(t, err)
+		mockUserRepo.AssertExpectations(t)
+		mockTokenStorage.AssertExpectations(t)
+		mockStorage.AssertExpectations(t)
+	})
+
+	t.Run("Negative case - export archive delete failure keeps the user and marks request failed", func(t *testing.T) {
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.Calls = nil
+		mockTokenStorage.ExpectedCalls = nil
+		mockTokenStorage.Calls = nil
+		mockStorage.ExpectedCalls = nil
+		mockStorage.Calls = nil
+
+		mockUserRepo.On("GetUserDataRequestByID", ctx, requestID).Return(&models.UserDataRequest{
+			ID:          requestID,
+			UserID:      userID,
+			RequestType: constants.UserDataRequestTypeErasure,
+			Status:      constants.UserDataRequestStatusPending,
+		}, nil).Once()
+		mockUserRepo.On("UpdateUserDataRequestStatus", ctx, requestID, userDto.ToDBUpdateUserDataRequestStatus{Status: constants.UserDataRequestStatusRunning}).Return(nil).Once()
+		mockTokenStorage.On("RevokeAllUserSessions", ctx, userID).Return(nil).Once()
+		mockUserRepo.On("GetUserDataRequestsByUserID", ctx, userID).Return([]models.UserDataRequest{
+			{ID: exportRequestID, UserID: userID, RequestType: constants.UserDataRequestTypeExport, FilePath: &exportPath},
+		}, nil).Once()
+		mockStorage.On("DeleteFile", exportPath).Return(errors.New("storage unavailable")).Once()
+		mockUserRepo.On("UpdateUserDataRequestStatus", ctx, requestID, mock.MatchedBy(func(req userDto.ToDBUpdateUserDataRequestStatus) bool {
+			return req.Status == constants.UserDataRequestStatusFailed
+		})).Return(nil).Once()
+
+		err := usecaseInstance.ProcessUserDataErasure(ctx, requestID)
+
+		assert.EqualError(t, err, "storage unavailable")
+		mockUserRepo.AssertExpectations(t)
+		mockUserRepo.AssertNotCalled(t, "ClearUserDataRequestFile", mock.Anything, mock.Anything)
+		mockUserRepo.AssertNotCalled(t, "AnonymizeUser", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Negative case - request already processed", func(t *testing.T) {
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.Calls = nil
+
+		mockUserRepo.On("GetUserDataRequestByID", ctx, requestID).Return(&models.UserDataRequest{
+			ID:          requestID,
+			UserID:      userID,
+			RequestType: constants.UserDataRequestTypeErasure,
+			Status:      constants.UserDataRequestStatusComplete,
+		}, nil).Once()
+
+		err := usecaseInstance.ProcessUserDataErasure(ctx, requestID)
+
+		assert.EqualError(t, err, constants.UserDataRequestInvalidState)
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Negative case - anonymize failure marks request failed", func(t *testing.T) {
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.Calls = nil
+		mockTokenStorage.ExpectedCalls = nil
+		mockTokenStorage.Calls = nil
+
+		mockUserRepo.On("GetUserDataRequestByID", ctx, requestID).Return(&models.UserDataRequest{
+			ID:          requestID,
+			UserID:      userID,
+			RequestType: constants.UserDataRequestTypeErasure,
+			Status:      constants.UserDataRequestStatusPending,
+		}, nil).Once()
+		mockUserRepo.On("UpdateUserDataRequestStatus", ctx, requestID, userDto.ToDBUpdateUserDataRequestStatus{Status: constants.UserDataRequestStatusRunning}).Return(nil).Once()
+		mockTokenStorage.On("RevokeAllUserSessions", ctx, userID).Return(nil).Once()
+		mockUserRepo.On("GetUserDataRequestsByUserID", ctx, userID).Return([]models.UserDataRequest{}, nil).Once()
+		mockUserRepo.On("GetFilesByUserID", ctx, userID).Return([]userDto.UserDataFile{}, nil).Once()
+		mockUserRepo.On("AnonymizeUser", ctx, userID, mock.Anything).Return(errors.New("database error")).Once()
+		mockUserRepo.On("UpdateUserDataRequestStatus", ctx, requestID, mock.MatchedBy(func(req userDto.ToDBUpdateUserDataRequestStatus) bool {
+			return req.Status == constants.UserDataRequestStatusFailed
+		})).Return(nil).Once()
+
+		err := usecaseInstance.ProcessUserDataErasure(ctx, requestID)
+
+		assert.EqualError(t, err, "database error")
+		mockUserRepo.AssertExpectations(t)
+	})
+}
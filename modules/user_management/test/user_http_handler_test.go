@@ -100,22 +100,78 @@ func (m *mockUserManagementUsecase) UserNameIsNotDuplicated(ctx context.Context,
 	return nil, args.Error(1)
 }
 
-func (m *mockUserManagementUsecase) BlockUser(ctx context.Context, id string, req *dto.ReqBlockUser) (*models.User, error) {
-	args := m.Called(ctx, id, req)
+func (m *mockUserManagementUsecase) BlockUser(ctx context.Context, id string, req *dto.ReqBlockUser, authId string) (*models.User, error) {
+	args := m.Called(ctx, id, req, authId)
 	if user := args.Get(0); user != nil {
 		return user.(*models.User), args.Error(1)
 	}
 	return nil, args.Error(1)
 }
 
-func (m *mockUserManagementUsecase) ActivateUser(ctx context.Context, id string, req *dto.ReqActivateUser) (*models.User, error) {
-	args := m.Called(ctx, id, req)
+func (m *mockUserManagementUsecase) ActivateUser(ctx context.Context, id string, req *dto.ReqActivateUser, authId string) (*models.User, error) {
+	args := m.Called(ctx, id, req, authId)
 	if user := args.Get(0); user != nil {
 		return user.(*models.User), args.Error(1)
 	}
 	return nil, args.Error(1)
 }
 
+func (m *mockUserManagementUsecase) GetUserStatusHistories(ctx context.Context, id string) ([]models.UserStatusHistory, error) {
+	args := m.Called(ctx, id)
+	if histories := args.Get(0); histories != nil {
+		return histories.([]models.UserStatusHistory), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockUserManagementUsecase) ForceUserPasswordReset(ctx context.Context, id string, authId string) (*models.User, error) {
+	args := m.Called(ctx, id, authId)
+	if user := args.Get(0); user != nil {
+		return user.(*models.User), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockUserManagementUsecase) BulkAssignRole(ctx context.Context, req *dto.ReqBulkAssignRole, authId string) (*dto.RespBulkUserOperation, error) {
+	args := m.Called(ctx, req, authId)
+	if res := args.Get(0); res != nil {
+		return res.(*dto.RespBulkUserOperation), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockUserManagementUsecase) BulkBlockUser(ctx context.Context, req *dto.ReqBulkBlockUser, authId string) (*dto.RespBulkUserOperation, error) {
+	args := m.Called(ctx, req, authId)
+	if res := args.Get(0); res != nil {
+		return res.(*dto.RespBulkUserOperation), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockUserManagementUsecase) BulkActivateUser(ctx context.Context, req *dto.ReqBulkActivateUser, authId string) (*dto.RespBulkUserOperation, error) {
+	args := m.Called(ctx, req, authId)
+	if res := args.Get(0); res != nil {
+		return res.(*dto.RespBulkUserOperation), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockUserManagementUsecase) BulkForcePasswordReset(ctx context.Context, req *dto.ReqBulkUserIds, authId string) (*dto.RespBulkUserOperation, error) {
+	args := m.Called(ctx, req, authId)
+	if res := args.Get(0); res != nil {
+		return res.(*dto.RespBulkUserOperation), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockUserManagementUsecase) BulkSoftDeleteUser(ctx context.Context, req *dto.ReqBulkUserIds, authId string) (*dto.RespBulkUserOperation, error) {
+	args := m.Called(ctx, req, authId)
+	if res := args.Get(0); res != nil {
+		return res.(*dto.RespBulkUserOperation), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 func (m *mockUserManagementUsecase) UpdateUserPassword(ctx context.Context, userId string, passwordChunks *dto.ReqUpdateUserPassword) error {
 	args := m.Called(ctx, userId, passwordChunks)
 	return args.Error(0)
@@ -152,6 +208,72 @@ func (m *mockUserManagementUsecase) VerifyOTP(ctx context.Context, email string,
 	return nil, args.Error(1)
 }
 
+func (m *mockUserManagementUsecase) ScheduleUserDeactivation(ctx context.Context, id string, req *dto.ReqScheduleUserDeactivation) (*models.User, error) {
+	args := m.Called(ctx, id, req)
+	if user := args.Get(0); user != nil {
+		return user.(*models.User), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockUserManagementUsecase) ApplyUserLifecyclePolicy(ctx context.Context) (*dto.RespUserLifecycleRun, error) {
+	args := m.Called(ctx)
+	if res := args.Get(0); res != nil {
+		return res.(*dto.RespUserLifecycleRun), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockUserManagementUsecase) GetUserLifecycleReport(ctx context.Context, days int) ([]dto.RespUserLifecycleReport, error) {
+	args := m.Called(ctx, days)
+	if res := args.Get(0); res != nil {
+		return res.([]dto.RespUserLifecycleReport), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockUserManagementUsecase) RequestUserDataExport(ctx context.Context, id string, authId string) (*models.UserDataRequest, error) {
+	args := m.Called(ctx, id, authId)
+	if res := args.Get(0); res != nil {
+		return res.(*models.UserDataRequest), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockUserManagementUsecase) RequestUserDataErasure(ctx context.Context, id string, authId string) (*models.UserDataRequest, error) {
+	args := m.Called(ctx, id, authId)
+	if res := args.Get(0); res != nil {
+		return res.(*models.UserDataRequest), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockUserManagementUsecase) GetUserDataRequests(ctx context.Context, id string) ([]models.UserDataRequest, error) {
+	args := m.Called(ctx, id)
+	if res := args.Get(0); res != nil {
+		return res.([]models.UserDataRequest), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockUserManagementUsecase) GetUserDataRequestByID(ctx context.Context, requestId string) (*models.UserDataRequest, error) {
+	args := m.Called(ctx, requestId)
+	if res := args.Get(0); res != nil {
+		return res.(*models.UserDataRequest), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockUserManagementUsecase) ProcessUserDataExport(ctx context.Context, requestId uuid.UUID) error {
+	args := m.Called(ctx, requestId)
+	return args.Error(0)
+}
+
+func (m *mockUserManagementUsecase) ProcessUserDataErasure(ctx context.Context, requestId uuid.UUID) error {
+	args := m.Called(ctx, requestId)
+	return args.Error(0)
+}
+
 type noopMiddlewareAuth struct{}
 
 func (n *noopMiddlewareAuth) AuthorizationCheck(next echo.HandlerFunc) echo.HandlerFunc {
@@ -231,6 +353,11 @@ func TestNewUserManagementHandler_RegisterRoutes(t *testing.T) {
 	require.True(t, routeExists(e.Routes(), http.MethodPatch, "/v1/user-management/user/:id/password"))
 	require.True(t, routeExists(e.Routes(), http.MethodPost, "/v1/user-management/user/import"))
 	require.True(t, routeExists(e.Routes(), http.MethodPost, "/v1/user-management/user/check-email"))
+	require.True(t, routeExists(e.Routes(), http.MethodPatch, "/v1/user-management/user/:id/block"))
+	require.True(t, routeExists(e.Routes(), http.MethodPatch, "/v1/user-management/user/:id/assign-status"))
+	require.True(t, routeExists(e.Routes(), http.MethodPatch, "/v1/user-management/user/:id/force-password-reset"))
+	require.True(t, routeExists(e.Routes(), http.MethodPost, "/v1/user-management/user/bulk/assign-role"))
+	require.True(t, routeExists(e.Routes(), http.MethodPost, "/v1/user-management/user/bulk/delete"))
 }
 
 func TestUserHandler_CreateUserSuccess(t *testing.T) {
@@ -309,6 +436,73 @@ func TestUserHandler_GetUserByIDInvalidUUID(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, rec.Code)
 }
 
+func TestUserHandler_GetUserByIDWithStatusHistories(t *testing.T) {
+	e := newEcho()
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/v1/user-management/user/"+userID.String(), nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(userID.String())
+
+	mockUC := new(mockUserManagementUsecase)
+	handler := &httpHandler.UserManagementHandler{UserUseCase: mockUC}
+
+	histories := []models.UserStatusHistory{{ID: uuid.New(), UserID: userID, Action: "block", Reason: "Suspicious activity"}}
+	mockUC.On("GetUserByID", mock.Anything, userID.String()).Return(&models.User{ID: userID, FullName: "JOHN"}, nil).Once()
+	mockUC.On("GetUserStatusHistories", mock.Anything, userID.String()).Return(histories, nil).Once()
+
+	err := handler.GetUserByID(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Suspicious activity")
+	mockUC.AssertExpectations(t)
+}
+
+func TestUserHandler_BlockUser(t *testing.T) {
+	e := newEcho()
+	userID := uuid.New()
+	authID := uuid.New()
+	body := `{"is_block":true,"reason":"Suspicious activity"}`
+	req := httptest.NewRequest(http.MethodPatch, "/v1/user-management/user/"+userID.String()+"/block", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(userID.String())
+	c.Set("user", models.User{ID: authID})
+
+	mockUC := new(mockUserManagementUsecase)
+	handler := &httpHandler.UserManagementHandler{UserUseCase: mockUC}
+
+	mockUC.On("BlockUser", mock.Anything, userID.String(), mock.AnythingOfType("*dto.ReqBlockUser"), authID.String()).
+		Return(&models.User{ID: userID, FullName: "JOHN", IsBlocked: true}, nil).Once()
+
+	err := handler.BlockUser(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUC.AssertExpectations(t)
+}
+
+func TestUserHandler_BlockUserMissingReason(t *testing.T) {
+	e := newEcho()
+	userID := uuid.New()
+	body := `{"is_block":true}`
+	req := httptest.NewRequest(http.MethodPatch, "/v1/user-management/user/"+userID.String()+"/block", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(userID.String())
+	c.Set("user", models.User{ID: uuid.New()})
+
+	handler := &httpHandler.UserManagementHandler{UserUseCase: new(mockUserManagementUsecase)}
+
+	err := handler.BlockUser(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
 func TestUserHandler_UpdateUserSuperAdminPassword(t *testing.T) {
 	e := newEcho()
 	userID := uuid.New()
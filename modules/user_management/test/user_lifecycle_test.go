@@ -0,0 +1,253 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	userDto "github.com/rendyfutsuy/base-go/modules/user_management/dto"
+	"github.com/rendyfutsuy/base-go/utils/token_storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestApplyUserLifecyclePolicy(t *testing.T) {
+	setupTestLogger()
+
+	usecaseInstance, mockUserRepo, _, _ := createTestUsecase()
+	ctx := context.Background()
+
+	mockTokenStorage := new(MockTokenStorage)
+	token_storage.SetTokenStorage(mockTokenStorage)
+
+	now := time.Now().UTC()
+	daysAgo := func(days int) *time.Time {
+		at := now.AddDate(0, 0, -days)
+		return &at
+	}
+	scheduledAt := now.Add(-time.Hour)
+
+	// warned within the warning window of the due date, at least lifecycle_warning_days ago
+	scheduledWarnedAt := scheduledAt.AddDate(0, 0, -7).Add(30 * time.Minute)
+	scheduledUser := models.User{ID: uuid.New(), Email: "scheduled@example.com", IsActive: true, DeactivateAt: &scheduledAt, DormantWarnedAt: &scheduledWarnedAt}
+	longDormantUser := models.User{ID: uuid.New(), Email: "long@example.com", IsActive: true, LastLoginAt: daysAgo(200), DormantWarnedAt: daysAgo(10)}
+	dormantUser := models.User{ID: uuid.New(), Email: "dormant@example.com", IsActive: true, LastLoginAt: daysAgo(100), DormantWarnedAt: daysAgo(10)}
+	blockedDormantUser := models.User{ID: uuid.New(), Email: "blocked@example.com", IsActive: true, IsBlocked: true, LastLoginAt: daysAgo(120)}
+	soonDormantUser := models.User{ID: uuid.New(), Email: "soon@example.com", IsActive: true, LastLoginAt: daysAgo(85)}
+	alreadyWarnedUser := models.User{ID: uuid.New(), Email: "warned@example.com", IsActive: true, LastLoginAt: daysAgo(86), DormantWarnedAt: daysAgo(1)}
+	expiredBlockUser := models.User{ID: uuid.New(), Email: "expired@example.com", IsActive: true, IsBlocked: true, AutoUnblockAt: &scheduledAt}
+
+	anyTime := mock.AnythingOfType("time.Time")
+
+	// auto unblock
+	mockUserRepo.On("GetUsersDueForAutoUnblock", ctx, anyTime).Return([]models.User{expiredBlockUser}, nil).Once()
+	mockUserRepo.On("UnBlockUser", ctx, expiredBlockUser.ID).Return(&expiredBlockUser, nil).Once()
+	mockUserRepo.On("MarkUserReactivated", ctx, expiredBlockUser.ID).Return(nil).Once()
+	mockUserRepo.On("CreateUserStatusHistory", ctx, userDto.ToDBCreateUserStatusHistory{
+		UserID:    expiredBlockUser.ID,
+		Action:    constants.UserStatusActionUnblock,
+		Reason:    constants.UserStatusReasonAutoUnblock,
+		CreatedBy: constants.UserStatusChangedBySystem,
+	}).Return(nil).Once()
+
+	// apply
+	mockUserRepo.On("GetUsersScheduledForDeactivation", ctx, anyTime).Return([]models.User{scheduledUser}, nil).Once()
+	mockUserRepo.On("DisActivateUser", ctx, scheduledUser.ID).Return(&scheduledUser, nil).Once()
+	mockUserRepo.On("SetDeactivateAt", ctx, scheduledUser.ID, (*time.Time)(nil)).Return(&scheduledUser, nil).Once()
+	mockUserRepo.On("GetUsersInactiveSince", ctx, anyTime).Return([]models.User{longDormantUser, dormantUser, blockedDormantUser}, nil).Once()
+	mockUserRepo.On("DisActivateUser", ctx, longDormantUser.ID).Return(&longDormantUser, nil).Once()
+	mockUserRepo.On("BlockUser", ctx, dormantUser.ID).Return(&dormantUser, nil).Once()
+	mockUserRepo.On("CreateUserStatusHistory", ctx, mock.AnythingOfType("dto.ToDBCreateUserStatusHistory")).Return(nil).Times(3)
+
+	// forecast for warnings
+	mockUserRepo.On("GetUsersScheduledForDeactivation", ctx, anyTime).Return([]models.User{}, nil).Once()
+	mockUserRepo.On("GetUsersInactiveSince", ctx, anyTime).Return([]models.User{soonDormantUser, alreadyWarnedUser}, nil).Once()
+	mockUserRepo.On("MarkLifecycleWarningSent", ctx, []uuid.UUID{soonDormantUser.ID}).Return(nil).Once()
+
+	mockTokenStorage.On("RevokeAllUserSessions", ctx, mock.Anything).Return(nil).Times(3)
+
+	res, err := usecaseInstance.ApplyUserLifecyclePolicy(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &userDto.RespUserLifecycleRun{Warned: 1, Blocked: 1, Deactivated: 2, Unblocked: 1}, res)
+	mockUserRepo.AssertExpectations(t)
+	mockTokenStorage.AssertExpectations(t)
+}
+
+func TestApplyUserLifecyclePolicy_WarnsBeforeAction(t *testing.T) {
+	setupTestLogger()
+
+	usecaseInstance, mockUserRepo, _, _ := createTestUsecase()
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	daysAgo := func(days int) *time.Time {
+		at := now.AddDate(0, 0, -days)
+		return &at
+	}
+	scheduledAt := now.Add(-time.Hour)
+
+	// overdue right after deploy, never warned: warned now, acted on after the warning period
+	scheduledUser := models.User{ID: uuid.New(), Email: "scheduled@example.com", IsActive: true, DeactivateAt: &scheduledAt}
+	longDormantUser := models.User{ID: uuid.New(), Email: "long@example.com", IsActive: true, LastLoginAt: daysAgo(400)}
+	// warned 2 days ago: still within the warning period
+	recentlyWarnedUser := models.User{ID: uuid.New(), Email: "recent@example.com", IsActive: true, LastLoginAt: daysAgo(95), DormantWarnedAt: daysAgo(2)}
+	// warned for a previous due date only
+	staleWarnedUser := models.User{ID: uuid.New(), Email: "stale@example.com", IsActive: true, LastLoginAt: daysAgo(300), DormantWarnedAt: daysAgo(200)}
+
+	anyTime := mock.AnythingOfType("time.Time")
+
+	mockUserRepo.On("GetUsersDueForAutoUnblock", ctx, anyTime).Return([]models.User{}, nil).Once()
+	mockUserRepo.On("GetUsersScheduledForDeactivation", ctx, anyTime).Return([]models.User{scheduledUser}, nil).Once()
+	mockUserRepo.On("GetUsersInactiveSince", ctx, anyTime).Return([]models.User{longDormantUser, recentlyWarnedUser, staleWarnedUser}, nil).Once()
+	mockUserRepo.On("GetUsersScheduledForDeactivation", ctx, anyTime).Return([]models.User{}, nil).Once()
+	mockUserRepo.On("GetUsersInactiveSince", ctx, anyTime).Return([]models.User{}, nil).Once()
+	mockUserRepo.On("MarkLifecycleWarningSent", ctx, []uuid.UUID{scheduledUser.ID, longDormantUser.ID, staleWarnedUser.ID}).Return(nil).Once()
+
+	res, err := usecaseInstance.ApplyUserLifecyclePolicy(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &userDto.RespUserLifecycleRun{Warned: 3}, res)
+	mockUserRepo.AssertExpectations(t)
+	mockUserRepo.AssertNotCalled(t, "DisActivateUser", mock.Anything, mock.Anything)
+	mockUserRepo.AssertNotCalled(t, "BlockUser", mock.Anything, mock.Anything)
+}
+
+func TestApplyUserLifecyclePolicy_AfterReactivation(t *testing.T) {
+	setupTestLogger()
+
+	usecaseInstance, mockUserRepo, _, _ := createTestUsecase()
+	ctx := context.Background()
+
+	mockTokenStorage := new(MockTokenStorage)
+	token_storage.SetTokenStorage(mockTokenStorage)
+
+	now := time.Now().UTC()
+	lastLogin := now.AddDate(0, 0, -200)
+	warnedAt := now.AddDate(0, 0, -30)
+	userID := uuid.New()
+	deactivatedUser := &models.User{ID: userID, Email: "back@example.com", IsActive: false, LastLoginAt: &lastLogin, DormantWarnedAt: &warnedAt}
+
+	// the admin activates the dormant user again
+	mockUserRepo.On("ActivateUser", ctx, userID).Return(deactivatedUser, nil).Once()
+	mockUserRepo.On("MarkUserReactivated", ctx, userID).Return(nil).Once()
+	mockTokenStorage.On("RevokeAllUserSessions", ctx, userID).Return(nil).Once()
+	mockUserRepo.On("GetUserByID", ctx, userID).Return(deactivatedUser, nil).Once()
+	mockUserRepo.On("CreateUserStatusHistory", ctx, mock.AnythingOfType("dto.ToDBCreateUserStatusHistory")).Return(nil).Once()
+
+	_, err := usecaseInstance.ActivateUser(ctx, userID.String(), &userDto.ReqActivateUser{IsActive: true, Reason: "Back from leave"}, uuid.New().String())
+	assert.NoError(t, err)
+
+	// the next run sees the restarted dormant period and leaves the user alone
+	reactivatedAt := now.Add(-time.Minute)
+	reactivatedUser := models.User{ID: userID, Email: "back@example.com", IsActive: true, LastLoginAt: &lastLogin, ReactivatedAt: &reactivatedAt}
+	anyTime := mock.AnythingOfType("time.Time")
+	mockUserRepo.On("GetUsersDueForAutoUnblock", ctx, anyTime).Return([]models.User{}, nil).Once()
+	mockUserRepo.On("GetUsersScheduledForDeactivation", ctx, anyTime).Return([]models.User{}, nil).Twice()
+	mockUserRepo.On("GetUsersInactiveSince", ctx, anyTime).Return([]models.User{reactivatedUser}, nil).Twice()
+	mockUserRepo.On("MarkLifecycleWarningSent", ctx, []uuid.UUID{}).Return(nil).Once()
+
+	res, err := usecaseInstance.ApplyUserLifecyclePolicy(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &userDto.RespUserLifecycleRun{}, res)
+	mockUserRepo.AssertExpectations(t)
+	mockUserRepo.AssertNotCalled(t, "DisActivateUser", mock.Anything, mock.Anything)
+	mockUserRepo.AssertNotCalled(t, "BlockUser", mock.Anything, mock.Anything)
+}
+
+func TestGetUserLifecycleReport(t *testing.T) {
+	setupTestLogger()
+
+	usecaseInstance, mockUserRepo, _, _ := createTestUsecase()
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	scheduledAt := now.AddDate(0, 0, 20)
+	lastLogin := now.AddDate(0, 0, -80)
+
+	scheduledUser := models.User{ID: uuid.New(), IsActive: true, DeactivateAt: &scheduledAt}
+	dormantUser := models.User{ID: uuid.New(), IsActive: true, LastLoginAt: &lastLogin}
+
+	t.Run("Positive case - report ordered by due date", func(t *testing.T) {
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.Calls = nil
+
+		mockUserRepo.On("GetUsersScheduledForDeactivation", ctx, mock.AnythingOfType("time.Time")).Return([]models.User{scheduledUser}, nil).Once()
+		mockUserRepo.On("GetUsersInactiveSince", ctx, mock.AnythingOfType("time.Time")).Return([]models.User{dormantUser}, nil).Once()
+
+		res, err := usecaseInstance.GetUserLifecycleReport(ctx, 0)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+		assert.Equal(t, dormantUser.ID, res[0].ID)
+		assert.Equal(t, constants.UserLifecycleActionBlock, res[0].Action)
+		assert.Equal(t, constants.UserLifecycleReasonDormant, res[0].Reason)
+		assert.Equal(t, scheduledUser.ID, res[1].ID)
+		assert.Equal(t, constants.UserLifecycleActionDeactivate, res[1].Action)
+		assert.Equal(t, constants.UserLifecycleReasonScheduled, res[1].Reason)
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Negative case - days out of range", func(t *testing.T) {
+		res, err := usecaseInstance.GetUserLifecycleReport(ctx, constants.UserLifecycleReportDaysMax+1)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+}
+
+func TestScheduleUserDeactivation(t *testing.T) {
+	setupTestLogger()
+
+	usecaseInstance, mockUserRepo, _, _ := createTestUsecase()
+	ctx := context.Background()
+
+	userID := uuid.New()
+
+	t.Run("Positive case - schedule in the future", func(t *testing.T) {
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.Calls = nil
+
+		at := time.Now().Add(48 * time.Hour)
+		mockUserRepo.On("SetDeactivateAt", ctx, userID, mock.MatchedBy(func(v *time.Time) bool {
+			return v != nil && v.Equal(at)
+		})).Return(&models.User{ID: userID}, nil).Once()
+		mockUserRepo.On("GetUserByID", ctx, userID).Return(&models.User{ID: userID, DeactivateAt: &at}, nil).Once()
+
+		res, err := usecaseInstance.ScheduleUserDeactivation(ctx, userID.String(), &userDto.ReqScheduleUserDeactivation{DeactivateAt: &at})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res.DeactivateAt)
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Positive case - clear schedule", func(t *testing.T) {
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.Calls = nil
+
+		mockUserRepo.On("SetDeactivateAt", ctx, userID, (*time.Time)(nil)).Return(&models.User{ID: userID}, nil).Once()
+		mockUserRepo.On("GetUserByID", ctx, userID).Return(&models.User{ID: userID}, nil).Once()
+
+		res, err := usecaseInstance.ScheduleUserDeactivation(ctx, userID.String(), &userDto.ReqScheduleUserDeactivation{})
+
+		assert.NoError(t, err)
+		assert.Nil(t, res.DeactivateAt)
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Negative case - schedule in the past", func(t *testing.T) {
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.Calls = nil
+
+		at := time.Now().Add(-time.Hour)
+		res, err := usecaseInstance.ScheduleUserDeactivation(ctx, userID.String(), &userDto.ReqScheduleUserDeactivation{DeactivateAt: &at})
+
+		assert.EqualError(t, err, constants.UserDeactivateAtMustBeFuture)
+		assert.Nil(t, res)
+		mockUserRepo.AssertExpectations(t)
+	})
+}
@@ -19,8 +19,17 @@ type Usecase interface {
 	SoftDeleteUser(ctx context.Context, id string, authId string) (userRes *models.User, err error)
 	UserNameIsNotDuplicated(ctx context.Context, name string, id uuid.UUID) (userRes *models.User, err error)
 	EmailIsNotDuplicated(ctx context.Context, email string, id uuid.UUID) (userRes *models.User, err error)
-	BlockUser(ctx context.Context, id string, req *dto.ReqBlockUser) (userRes *models.User, err error)
-	ActivateUser(ctx context.Context, id string, req *dto.ReqActivateUser) (userRes *models.User, err error)
+	BlockUser(ctx context.Context, id string, req *dto.ReqBlockUser, authId string) (userRes *models.User, err error)
+	ActivateUser(ctx context.Context, id string, req *dto.ReqActivateUser, authId string) (userRes *models.User, err error)
+	GetUserStatusHistories(ctx context.Context, id string) (histories []models.UserStatusHistory, err error)
+	ForceUserPasswordReset(ctx context.Context, id string, authId string) (userRes *models.User, err error)
+
+	// bulk operations
+	BulkAssignRole(ctx context.Context, req *dto.ReqBulkAssignRole, authId string) (*dto.RespBulkUserOperation, error)
+	BulkBlockUser(ctx context.Context, req *dto.ReqBulkBlockUser, authId string) (*dto.RespBulkUserOperation, error)
+	BulkActivateUser(ctx context.Context, req *dto.ReqBulkActivateUser, authId string) (*dto.RespBulkUserOperation, error)
+	BulkForcePasswordReset(ctx context.Context, req *dto.ReqBulkUserIds, authId string) (*dto.RespBulkUserOperation, error)
+	BulkSoftDeleteUser(ctx context.Context, req *dto.ReqBulkUserIds, authId string) (*dto.RespBulkUserOperation, error)
 
 	// registration
 	RegisterUser(ctx context.Context, req *dto.ReqRegisterUser, userID string) (userRes *models.User, err error)
@@ -34,4 +43,17 @@ type Usecase interface {
 
 	// import users
 	ImportUsersFromExcel(ctx context.Context, filePath string) (res *dto.ResImportUsers, err error)
+
+	// lifecycle (scheduled deactivation & dormant accounts)
+	ScheduleUserDeactivation(ctx context.Context, id string, req *dto.ReqScheduleUserDeactivation) (userRes *models.User, err error)
+	ApplyUserLifecyclePolicy(ctx context.Context) (*dto.RespUserLifecycleRun, error)
+	GetUserLifecycleReport(ctx context.Context, days int) ([]dto.RespUserLifecycleReport, error)
+
+	// personal data (PDP law)
+	RequestUserDataExport(ctx context.Context, id string, authId string) (*models.UserDataRequest, error)
+	RequestUserDataErasure(ctx context.Context, id string, authId string) (*models.UserDataRequest, error)
+	GetUserDataRequests(ctx context.Context, id string) ([]models.UserDataRequest, error)
+	GetUserDataRequestByID(ctx context.Context, requestId string) (*models.UserDataRequest, error)
+	ProcessUserDataExport(ctx context.Context, requestId uuid.UUID) error
+	ProcessUserDataErasure(ctx context.Context, requestId uuid.UUID) error
 }
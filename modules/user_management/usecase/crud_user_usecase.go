@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rendyfutsuy/base-go/constants"
@@ -269,7 +270,25 @@ func (u *userUsecase) EmailIsNotDuplicated(ctx context.Context, email string, id
 	return u.userRepo.GetDuplicatedUserByEmail(ctx, email, id)
 }
 
-func (u *userUsecase) BlockUser(ctx context.Context, id string, req *dto.ReqBlockUser) (userRes *models.User, err error) {
+// validateUnblockAt checks the optional automatic unblock time of a block request and returns it in UTC
+func validateUnblockAt(req *dto.ReqBlockUser) (*time.Time, error) {
+	if req.UnblockAt == nil {
+		return nil, nil
+	}
+
+	if !req.IsBlock {
+		return nil, errors.New(constants.UserUnblockAtOnlyOnBlock)
+	}
+
+	at := req.UnblockAt.UTC()
+	if !at.After(time.Now().UTC()) {
+		return nil, errors.New(constants.UserUnblockAtMustBeFuture)
+	}
+
+	return &at, nil
+}
+
+func (u *userUsecase) BlockUser(ctx context.Context, id string, req *dto.ReqBlockUser, authId string) (userRes *models.User, err error) {
 	// parsing UUID
 	uId, err := utils.StringToUUID(id)
 	if err != nil {
@@ -277,14 +296,28 @@ func (u *userUsecase) BlockUser(ctx context.Context, id string, req *dto.ReqBloc
 		return nil, err
 	}
 
+	// validate automatic unblock time
+	unblockAt, err := validateUnblockAt(req)
+	if err != nil {
+		return nil, err
+	}
+
+	action := constants.UserStatusActionBlock
+
 	// determinate if user is block or not
 	if !req.IsBlock {
 		// user requested to be unblock
 		// unblock user
+		action = constants.UserStatusActionUnblock
 		_, err = u.userRepo.UnBlockUser(ctx, uId)
 		if err != nil {
 			return nil, err
 		}
+
+		// restart the dormant period, otherwise the next lifecycle run blocks the user again
+		if err = u.userRepo.MarkUserReactivated(ctx, uId); err != nil {
+			return nil, err
+		}
 	} else {
 		// user requested to be block
 		// block user
@@ -292,15 +325,26 @@ func (u *userUsecase) BlockUser(ctx context.Context, id string, req *dto.ReqBloc
 		if err != nil {
 			return nil, err
 		}
+
+		if err = u.userRepo.SetAutoUnblockAt(ctx, uId, unblockAt); err != nil {
+			return nil, err
+		}
 	}
 
 	// revoke user token
 	token_storage.RevokeAllUserSessions(ctx, uId)
 
-	return u.userRepo.GetUserByID(ctx, uId)
+	userRes, err = u.userRepo.GetUserByID(ctx, uId)
+	if err != nil {
+		return nil, err
+	}
+
+	u.recordUserStatusChange(ctx, *userRes, action, req.Reason, unblockAt, authId)
+
+	return userRes, nil
 }
 
-func (u *userUsecase) ActivateUser(ctx context.Context, id string, req *dto.ReqActivateUser) (userRes *models.User, err error) {
+func (u *userUsecase) ActivateUser(ctx context.Context, id string, req *dto.ReqActivateUser, authId string) (userRes *models.User, err error) {
 	// parsing UUID
 	uId, err := utils.StringToUUID(id)
 	if err != nil {
@@ -308,10 +352,13 @@ func (u *userUsecase) ActivateUser(ctx context.Context, id string, req *dto.ReqA
 		return nil, err
 	}
 
+	action := constants.UserStatusActionActivate
+
 	// determinate if user is block or not
 	if !req.IsActive {
 		// user requested to be dis-activate
 		// dis-activate user
+		action = constants.UserStatusActionDeactivate
 		_, err = u.userRepo.DisActivateUser(ctx, uId)
 		if err != nil {
 			return nil, err
@@ -323,10 +370,48 @@ func (u *userUsecase) ActivateUser(ctx context.Context, id string, req *dto.ReqA
 		if err != nil {
 			return nil, err
 		}
+
+		// restart the dormant period, otherwise the next lifecycle run deactivates the user again
+		if err = u.userRepo.MarkUserReactivated(ctx, uId); err != nil {
+			return nil, err
+		}
 	}
 
 	// revoke user token
 	token_storage.RevokeAllUserSessions(ctx, uId)
 
-	return u.userRepo.GetUserByID(ctx, uId)
+	userRes, err = u.userRepo.GetUserByID(ctx, uId)
+	if err != nil {
+		return nil, err
+	}
+
+	u.recordUserStatusChange(ctx, *userRes, action, req.Reason, nil, authId)
+
+	return userRes, nil
+}
+
+func (u *userUsecase) ForceUserPasswordReset(ctx context.Context, id string, authId string) (userRes *models.User, err error) {
+	// parsing UUID
+	uId, err := utils.StringToUUID(id)
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, err
+	}
+
+	// user must change the password on the next login
+	if err = u.userRepo.ForcePasswordReset(ctx, uId); err != nil {
+		return nil, err
+	}
+
+	// revoke user token
+	token_storage.RevokeAllUserSessions(ctx, uId)
+
+	userRes, err = u.userRepo.GetUserByID(ctx, uId)
+	if err != nil {
+		return nil, err
+	}
+
+	u.sendAccountNotification(*userRes, constants.UserForcePasswordResetSubject, constants.UserForcePasswordResetMessage)
+
+	return userRes, nil
 }
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/user_management/dto"
+)
+
+// bulk operations run per user: a failing user does not stop the others,
+// every outcome is collected into the returned report
+
+func (u *userUsecase) BulkAssignRole(ctx context.Context, req *dto.ReqBulkAssignRole, authId string) (*dto.RespBulkUserOperation, error) {
+	if err := u.validateRole(ctx, req.RoleId); err != nil {
+		return nil, err
+	}
+
+	return u.runBulkUserOperation(ctx, constants.UserBulkActionAssignRole, req.UserIds, authId, true, func(user *models.User) error {
+		return u.userRepo.AssignRole(ctx, user.ID, req.RoleId)
+	}), nil
+}
+
+func (u *userUsecase) BulkBlockUser(ctx context.Context, req *dto.ReqBulkBlockUser, authId string) (*dto.RespBulkUserOperation, error) {
+	if _, err := validateUnblockAt(&req.ReqBlockUser); err != nil {
+		return nil, err
+	}
+
+	action := constants.UserBulkActionUnblock
+	if req.IsBlock {
+		action = constants.UserBulkActionBlock
+	}
+
+	return u.runBulkUserOperation(ctx, action, req.UserIds, authId, req.IsBlock, func(user *models.User) error {
+		_, err := u.BlockUser(ctx, user.ID.String(), &req.ReqBlockUser, authId)
+		return err
+	}), nil
+}
+
+func (u *userUsecase) BulkActivateUser(ctx context.Context, req *dto.ReqBulkActivateUser, authId string) (*dto.RespBulkUserOperation, error) {
+	action := constants.UserBulkActionDeactivate
+	if req.IsActive {
+		action = constants.UserBulkActionActivate
+	}
+
+	return u.runBulkUserOperation(ctx, action, req.UserIds, authId, !req.IsActive, func(user *models.User) error {
+		_, err := u.ActivateUser(ctx, user.ID.String(), &req.ReqActivateUser, authId)
+		return err
+	}), nil
+}
+
+func (u *userUsecase) BulkForcePasswordReset(ctx context.Context, req *dto.ReqBulkUserIds, authId string) (*dto.RespBulkUserOperation, error) {
+	return u.runBulkUserOperation(ctx, constants.UserBulkActionForcePasswordReset, req.UserIds, authId, false, func(user *models.User) error {
+		_, err := u.ForceUserPasswordReset(ctx, user.ID.String(), authId)
+		return err
+	}), nil
+}
+
+func (u *userUsecase) BulkSoftDeleteUser(ctx context.Context, req *dto.ReqBulkUserIds, authId string) (*dto.RespBulkUserOperation, error) {
+	return u.runBulkUserOperation(ctx, constants.UserBulkActionDelete, req.UserIds, authId, true, func(user *models.User) error {
+		_, err := u.SoftDeleteUser(ctx, user.ID.String(), authId)
+		return err
+	}), nil
+}
+
+// runBulkUserOperation applies the operation to every distinct user.
+// When protected, the operation is refused on the requester's own account and on non deletable (system) users.
+func (u *userUsecase) runBulkUserOperation(ctx context.Context, action string, ids []uuid.UUID, authId string, protected bool, apply func(user *models.User) error) *dto.RespBulkUserOperation {
+	res := &dto.RespBulkUserOperation{
+		Action:  action,
+		Results: []dto.RespBulkUserOperationItem{},
+	}
+
+	seen := map[uuid.UUID]bool{}
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		user, err := u.userRepo.GetUserByID(ctx, id)
+		if err != nil {
+			res.AddResult(id, "", err)
+			continue
+		}
+
+		if protected {
+			if user.ID.String() == authId {
+				res.AddResult(user.ID, user.FullName, fmt.Errorf(constants.UserBulkSelfNotAllowed, bulkActionText(action)))
+				continue
+			}
+			if !user.Deletable {
+				res.AddResult(user.ID, user.FullName, errors.New(constants.UserBulkProtectedUser))
+				continue
+			}
+		}
+
+		res.AddResult(user.ID, user.FullName, apply(user))
+	}
+
+	return res
+}
+
+func bulkActionText(action string) string {
+	switch action {
+	case constants.UserBulkActionAssignRole:
+		return "change the role of"
+	case constants.UserBulkActionForcePasswordReset:
+		return "force a password reset on"
+	default:
+		return action
+	}
+}
@@ -0,0 +1,338 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/user_management/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	utilsServices "github.com/rendyfutsuy/base-go/utils/services"
+	"github.com/rendyfutsuy/base-go/utils/token_storage"
+	"github.com/rendyfutsuy/base-go/worker"
+	"github.com/rendyfutsuy/base-go/worker/payloads"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (u *userUsecase) RequestUserDataExport(ctx context.Context, id string, authId string) (*models.UserDataRequest, error) {
+	return u.requestUserData(ctx, id, authId, constants.UserDataRequestTypeExport, constants.JobTypeUserDataExport)
+}
+
+func (u *userUsecase) RequestUserDataErasure(ctx context.Context, id string, authId string) (*models.UserDataRequest, error) {
+	return u.requestUserData(ctx, id, authId, constants.UserDataRequestTypeErasure, constants.JobTypeUserDataErasure)
+}
+
+// requestUserData records the personal data request and hands it over to the background worker
+func (u *userUsecase) requestUserData(ctx context.Context, id string, authId string, requestType string, jobType constants.JobType) (*models.UserDataRequest, error) {
+	uId, err := utils.StringToUUID(id)
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, err
+	}
+
+	user, err := u.userRepo.GetUserByID(ctx, uId)
+	if err != nil || user == nil || user.ID == uuid.Nil {
+		return nil, errors.New(constants.UserNotFound)
+	}
+
+	if requestType == constants.UserDataRequestTypeErasure && !user.Deletable {
+		return nil, errors.New(constants.UserDataRequestCannotErase)
+	}
+
+	isActive, err := u.userRepo.HasActiveUserDataRequest(ctx, uId, requestType)
+	if err != nil {
+		return nil, err
+	}
+	if isActive {
+		return nil, fmt.Errorf(constants.UserDataRequestAlreadyQueued, requestType)
+	}
+
+	dataRequest, err := u.userRepo.CreateUserDataRequest(ctx, dto.ToDBCreateUserDataRequest{
+		UserID:      uId,
+		RequestType: requestType,
+		RequestedBy: authId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = worker.Dispatch(worker.Job{
+		ID:      dataRequest.ID,
+		Type:    jobType,
+		Payload: payloads.UserDataRequestPayload{RequestID: dataRequest.ID},
+	})
+	if err != nil {
+		utils.Logger.Error("failed to dispatch user data request", zap.String("request_id", dataRequest.ID.String()), zap.Error(err))
+		u.failUserDataRequest(ctx, dataRequest.ID, err)
+		return nil, errors.New(constants.UserDataRequestDispatchFailed)
+	}
+
+	return dataRequest, nil
+}
+
+func (u *userUsecase) GetUserDataRequests(ctx context.Context, id string) ([]models.UserDataRequest, error) {
+	uId, err := utils.StringToUUID(id)
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, err
+	}
+
+	return u.userRepo.GetUserDataRequestsByUserID(ctx, uId)
+}
+
+func (u *userUsecase) GetUserDataRequestByID(ctx context.Context, requestId string) (*models.UserDataRequest, error) {
+	rId, err := utils.StringToUUID(requestId)
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, err
+	}
+
+	return u.userRepo.GetUserDataRequestByID(ctx, rId)
+}
+
+// ProcessUserDataExport collects every personal data of the user into a ZIP archive and uploads it to storage.
+// Called by the background worker.
+func (u *userUsecase) ProcessUserDataExport(ctx context.Context, requestId uuid.UUID) error {
+	dataRequest, err := u.startUserDataRequest(ctx, requestId, constants.UserDataRequestTypeExport)
+	if err != nil {
+		return err
+	}
+
+	filePath, err := u.buildUserDataExport(ctx, dataRequest.UserID)
+	if err != nil {
+		u.failUserDataRequest(ctx, requestId, err)
+		return err
+	}
+
+	return u.userRepo.UpdateUserDataRequestStatus(ctx, requestId, dto.ToDBUpdateUserDataRequestStatus{
+		Status:   constants.UserDataRequestStatusComplete,
+		FilePath: &filePath,
+	})
+}
+
+// ProcessUserDataErasure revokes sessions, removes stored files and anonymizes the user.
+// Called by the background worker.
+func (u *userUsecase) ProcessUserDataErasure(ctx context.Context, requestId uuid.UUID) error {
+	dataRequest, err := u.startUserDataRequest(ctx, requestId, constants.UserDataRequestTypeErasure)
+	if err != nil {
+		return err
+	}
+
+	userID := dataRequest.UserID
+
+	// revoke user token
+	token_storage.RevokeAllUserSessions(ctx, userID)
+
+	// remove earlier export archives, they hold the full personal data of the user
+	if err := u.deleteUserDataExports(ctx, userID); err != nil {
+		u.failUserDataRequest(ctx, requestId, err)
+		return err
+	}
+
+	// remove stored objects, best effort: the rows are detached in AnonymizeUser anyway
+	files, err := u.userRepo.GetFilesByUserID(ctx, userID)
+	if err != nil {
+		u.failUserDataRequest(ctx, requestId, err)
+		return err
+	}
+	for _, file := range files {
+		if file.FilePath == nil || *file.FilePath == "" {
+			continue
+		}
+		if err := utilsServices.DeleteFile(*file.FilePath); err != nil {
+			utils.Logger.Warn("failed to delete user file from storage", zap.String("file_id", file.ID.String()), zap.Error(err))
+		}
+	}
+
+	hashedPassword, err := randomHashedPassword()
+	if err != nil {
+		u.failUserDataRequest(ctx, requestId, err)
+		return err
+	}
+
+	err = u.userRepo.AnonymizeUser(ctx, userID, dto.ToDBAnonymizeUser{
+		FullName:       constants.UserErasedFullName,
+		Username:       constants.UserErasedUsernamePrefix + userID.String(),
+		Email:          fmt.Sprintf("%s%s@%s", constants.UserErasedUsernamePrefix, userID.String(), constants.UserErasedEmailDomain),
+		HashedPassword: hashedPassword,
+	})
+	if err != nil {
+		u.failUserDataRequest(ctx, requestId, err)
+		return err
+	}
+
+	return u.userRepo.UpdateUserDataRequestStatus(ctx, requestId, dto.ToDBUpdateUserDataRequestStatus{
+		Status: constants.UserDataRequestStatusComplete,
+	})
+}
+
+// deleteUserDataExports deletes the export archives of the user from storage and clears their path.
+// Unlike the user files, a failed delete stops the erasure so the archive is not left behind untracked.
+func (u *userUsecase) deleteUserDataExports(ctx context.Context, userID uuid.UUID) error {
+	dataRequests, err := u.userRepo.GetUserDataRequestsByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, dataRequest := range dataRequests {
+		if dataRequest.FilePath == nil || *dataRequest.FilePath == "" {
+			continue
+		}
+		if err := utilsServices.DeleteFile(*dataRequest.FilePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			utils.Logger.Error("failed to delete user data export from storage", zap.String("request_id", dataRequest.ID.String()), zap.Error(err))
+			return err
+		}
+		if err := u.userRepo.ClearUserDataRequestFile(ctx, dataRequest.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// startUserDataRequest loads a pending request and marks it as processing
+func (u *userUsecase) startUserDataRequest(ctx context.Context, requestId uuid.UUID, requestType string) (*models.UserDataRequest, error) {
+	dataRequest, err := u.userRepo.GetUserDataRequestByID(ctx, requestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if dataRequest.RequestType != requestType || dataRequest.Status != constants.UserDataRequestStatusPending {
+		return nil, errors.New(constants.UserDataRequestInvalidState)
+	}
+
+	err = u.userRepo.UpdateUserDataRequestStatus(ctx, requestId, dto.ToDBUpdateUserDataRequestStatus{
+		Status: constants.UserDataRequestStatusRunning,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dataRequest, nil
+}
+
+func (u *userUsecase) failUserDataRequest(ctx context.Context, requestId uuid.UUID, cause error) {
+	message := cause.Error()
+	err := u.userRepo.UpdateUserDataRequestStatus(ctx, requestId, dto.ToDBUpdateUserDataRequestStatus{
+		Status:       constants.UserDataRequestStatusFailed,
+		ErrorMessage: &message,
+	})
+	if err != nil {
+		utils.Logger.Error("failed to mark user data request as failed", zap.String("request_id", requestId.String()), zap.Error(err))
+	}
+}
+
+// buildUserDataExport writes profile, password-history metadata, sessions, files and posts of the user
+// into a ZIP archive and returns its storage path
+func (u *userUsecase) buildUserDataExport(ctx context.Context, userID uuid.UUID) (string, error) {
+	user, err := u.userRepo.GetUserForDataExport(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	passwordHistories, err := u.userRepo.GetPasswordHistoriesByUserID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	sessions, err := u.userRepo.GetSessionsByUserID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	files, err := u.userRepo.GetFilesByUserID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	posts, err := u.userRepo.GetPostsByAuthorID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	respPosts := make([]dto.UserDataPost, 0, len(posts))
+	for _, post := range posts {
+		respPosts = append(respPosts, dto.ToUserDataPost(post))
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	documents := []struct {
+		name string
+		data interface{}
+	}{
+		{"profile.json", dto.ToUserDataProfile(*user)},
+		{"password_histories.json", passwordHistories},
+		{"sessions.json", sessions},
+		{"files.json", files},
+		{"posts.json", respPosts},
+	}
+	for _, document := range documents {
+		if err := writeZipJSON(zw, document.name, document.data); err != nil {
+			return "", err
+		}
+	}
+
+	// attach the uploaded files themselves, best effort
+	for _, file := range files {
+		if file.FilePath == nil || *file.FilePath == "" {
+			continue
+		}
+		content, err := utilsServices.DownloadFile(*file.FilePath)
+		if err != nil {
+			utils.Logger.Warn("failed to download user file for export", zap.String("file_id", file.ID.String()), zap.Error(err))
+			continue
+		}
+		w, err := zw.Create(path.Join("files", fmt.Sprintf("%s_%s", file.ID.String(), path.Base(file.Name))))
+		if err != nil {
+			return "", err
+		}
+		if _, err := w.Write(content.Bytes()); err != nil {
+			return "", err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+
+	fileName := fmt.Sprintf("user-data-%s-%s.zip", userID.String(), time.Now().UTC().Format("20060102150405"))
+	return utilsServices.UploadFile(buf, fileName, path.Join(constants.UserDataExportStoragePath, userID.String()))
+}
+
+func writeZipJSON(zw *zip.Writer, name string, data interface{}) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(data)
+}
+
+// randomHashedPassword generates an unusable password so an erased account can never log in again
+func randomHashedPassword() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(hex.EncodeToString(secret)), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hashed), nil
+}
@@ -0,0 +1,342 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/auth/tasks"
+	"github.com/rendyfutsuy/base-go/modules/user_management/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"github.com/rendyfutsuy/base-go/utils/token_storage"
+	"go.uber.org/zap"
+)
+
+// UserLifecyclePolicyFromConfig reads the dormant-account policy from config "user.*", falling back to defaults
+func UserLifecyclePolicyFromConfig() dto.UserLifecyclePolicy {
+	return dto.UserLifecyclePolicy{
+		BlockAfterDays:      configIntOrDefault("user.dormant_block_days", constants.UserDormantBlockDaysDefault),
+		DeactivateAfterDays: configIntOrDefault("user.dormant_deactivate_days", constants.UserDormantDeactivateDaysDefault),
+		WarningDays:         configIntOrDefault("user.lifecycle_warning_days", constants.UserLifecycleWarningDaysDefault),
+	}
+}
+
+// UserLifecycleIntervalFromConfig returns how often the lifecycle policy is applied, 0 disables the scheduler
+func UserLifecycleIntervalFromConfig() time.Duration {
+	return time.Duration(configIntOrDefault("user.lifecycle_interval_minutes", constants.UserLifecycleIntervalMinutesDefault)) * time.Minute
+}
+
+func configIntOrDefault(key string, def int) int {
+	if utils.ConfigVars == nil || !utils.ConfigVars.Exists(key) {
+		return def
+	}
+	return utils.ConfigVars.Int(key)
+}
+
+func (u *userUsecase) ScheduleUserDeactivation(ctx context.Context, id string, req *dto.ReqScheduleUserDeactivation) (userRes *models.User, err error) {
+	uId, err := utils.StringToUUID(id)
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, err
+	}
+
+	var deactivateAt *time.Time
+	if req.DeactivateAt != nil {
+		at := req.DeactivateAt.UTC()
+		if !at.After(time.Now().UTC()) {
+			return nil, errors.New(constants.UserDeactivateAtMustBeFuture)
+		}
+		deactivateAt = &at
+	}
+
+	if _, err = u.userRepo.SetDeactivateAt(ctx, uId, deactivateAt); err != nil {
+		return nil, err
+	}
+
+	return u.userRepo.GetUserByID(ctx, uId)
+}
+
+// ApplyUserLifecyclePolicy unblocks users whose block period ended, deactivates scheduled users, blocks / deactivates dormant users
+// and warns users whose account will be affected soon. Called by the background scheduler.
+// No account is blocked or deactivated before its user was warned at least lifecycle_warning_days earlier:
+// an action that is due without a warning sends the warning now and is postponed until the warning period ends.
+func (u *userUsecase) ApplyUserLifecyclePolicy(ctx context.Context) (*dto.RespUserLifecycleRun, error) {
+	now := time.Now().UTC()
+	policy := UserLifecyclePolicyFromConfig()
+	res := &dto.RespUserLifecycleRun{}
+
+	warned := map[uuid.UUID]bool{}
+	warnedIds := []uuid.UUID{}
+	warn := func(user models.User, entry dto.RespUserLifecycleReport) {
+		u.sendLifecycleWarning(user, entry)
+		warned[user.ID] = true
+		warnedIds = append(warnedIds, user.ID)
+	}
+
+	// actionReady reports whether a due action can be applied, warning the user first when it was not
+	actionReady := func(user models.User, action string, reason string, dueAt time.Time) bool {
+		if policy.WarningDays <= 0 {
+			return true
+		}
+		if lifecycleWarned(user, dueAt, policy.WarningDays) && !now.Before(user.DormantWarnedAt.AddDate(0, 0, policy.WarningDays)) {
+			return true
+		}
+		if !warned[user.ID] && !lifecycleWarned(user, dueAt, policy.WarningDays) {
+			warn(user, dto.ToRespUserLifecycleReport(user, action, reason, now.AddDate(0, 0, policy.WarningDays)))
+		}
+		return false
+	}
+
+	// 0) blocks whose period has ended
+	unblocked, err := u.autoUnblockUsers(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	res.Unblocked = unblocked
+
+	// 1) scheduled deactivation
+	scheduled, err := u.userRepo.GetUsersScheduledForDeactivation(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range scheduled {
+		if !actionReady(user, constants.UserLifecycleActionDeactivate, constants.UserLifecycleReasonScheduled, *user.DeactivateAt) {
+			continue
+		}
+		if _, err := u.userRepo.DisActivateUser(ctx, user.ID); err != nil {
+			utils.Logger.Error("failed to deactivate scheduled user", zap.String("user_id", user.ID.String()), zap.Error(err))
+			continue
+		}
+		if _, err := u.userRepo.SetDeactivateAt(ctx, user.ID, nil); err != nil {
+			utils.Logger.Warn("failed to clear deactivate_at", zap.String("user_id", user.ID.String()), zap.Error(err))
+		}
+		u.applyLifecycleSideEffects(ctx, user, constants.UserLifecycleActionDeactivate, constants.UserLifecycleReasonScheduled)
+		res.Deactivated++
+	}
+
+	// 2) dormant accounts
+	if firstDays := policy.FirstActionDays(); firstDays > 0 {
+		dormant, err := u.userRepo.GetUsersInactiveSince(ctx, now.AddDate(0, 0, -firstDays))
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range dormant {
+			if warned[user.ID] {
+				continue
+			}
+			lastActivity := userLastActivity(user)
+
+			switch {
+			case policy.DeactivateAfterDays > 0 && !lastActivity.After(now.AddDate(0, 0, -policy.DeactivateAfterDays)):
+				dueAt := lastActivity.AddDate(0, 0, policy.DeactivateAfterDays)
+				if !actionReady(user, constants.UserLifecycleActionDeactivate, constants.UserLifecycleReasonDormant, dueAt) {
+					continue
+				}
+				if _, err := u.userRepo.DisActivateUser(ctx, user.ID); err != nil {
+					utils.Logger.Error("failed to deactivate dormant user", zap.String("user_id", user.ID.String()), zap.Error(err))
+					continue
+				}
+				u.applyLifecycleSideEffects(ctx, user, constants.UserLifecycleActionDeactivate, constants.UserLifecycleReasonDormant)
+				res.Deactivated++
+			case policy.BlockAfterDays > 0 && !user.IsBlocked && !lastActivity.After(now.AddDate(0, 0, -policy.BlockAfterDays)):
+				dueAt := lastActivity.AddDate(0, 0, policy.BlockAfterDays)
+				if !actionReady(user, constants.UserLifecycleActionBlock, constants.UserLifecycleReasonDormant, dueAt) {
+					continue
+				}
+				if _, err := u.userRepo.BlockUser(ctx, user.ID); err != nil {
+					utils.Logger.Error("failed to block dormant user", zap.String("user_id", user.ID.String()), zap.Error(err))
+					continue
+				}
+				u.applyLifecycleSideEffects(ctx, user, constants.UserLifecycleActionBlock, constants.UserLifecycleReasonDormant)
+				res.Blocked++
+			}
+		}
+	}
+
+	// 3) warnings before action
+	if policy.WarningDays > 0 {
+		upcoming, err := u.forecastUserLifecycle(ctx, now, policy, policy.WarningDays)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range upcoming {
+			if warned[item.user.ID] || !item.entry.DueAt.After(now) {
+				continue
+			}
+			if lifecycleWarned(item.user, item.entry.DueAt, policy.WarningDays) {
+				continue
+			}
+			warn(item.user, item.entry)
+		}
+
+		if err := u.userRepo.MarkLifecycleWarningSent(ctx, warnedIds); err != nil {
+			return nil, err
+		}
+		res.Warned = len(warnedIds)
+	}
+
+	return res, nil
+}
+
+// lifecycleWarned reports whether the user was already warned within the warning window of the due date
+func lifecycleWarned(user models.User, dueAt time.Time, warningDays int) bool {
+	return user.DormantWarnedAt != nil && !user.DormantWarnedAt.Before(dueAt.AddDate(0, 0, -warningDays))
+}
+
+func (u *userUsecase) GetUserLifecycleReport(ctx context.Context, days int) ([]dto.RespUserLifecycleReport, error) {
+	if days == 0 {
+		days = constants.UserLifecycleReportDaysDefault
+	}
+	if days < 1 || days > constants.UserLifecycleReportDaysMax {
+		return nil, fmt.Errorf(constants.UserLifecycleReportInvalidDays, constants.UserLifecycleReportDaysMax)
+	}
+
+	upcoming, err := u.forecastUserLifecycle(ctx, time.Now().UTC(), UserLifecyclePolicyFromConfig(), days)
+	if err != nil {
+		return nil, err
+	}
+
+	report := make([]dto.RespUserLifecycleReport, 0, len(upcoming))
+	for _, item := range upcoming {
+		report = append(report, item.entry)
+	}
+
+	return report, nil
+}
+
+type userLifecycleForecast struct {
+	user  models.User
+	entry dto.RespUserLifecycleReport
+}
+
+// forecastUserLifecycle lists every action the lifecycle policy will take within the next `days` days, ordered by due date
+func (u *userUsecase) forecastUserLifecycle(ctx context.Context, now time.Time, policy dto.UserLifecyclePolicy, days int) ([]userLifecycleForecast, error) {
+	horizon := now.AddDate(0, 0, days)
+	forecast := []userLifecycleForecast{}
+
+	scheduled, err := u.userRepo.GetUsersScheduledForDeactivation(ctx, horizon)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range scheduled {
+		forecast = append(forecast, userLifecycleForecast{
+			user:  user,
+			entry: dto.ToRespUserLifecycleReport(user, constants.UserLifecycleActionDeactivate, constants.UserLifecycleReasonScheduled, *user.DeactivateAt),
+		})
+	}
+
+	if firstDays := policy.FirstActionDays(); firstDays > 0 {
+		dormant, err := u.userRepo.GetUsersInactiveSince(ctx, horizon.AddDate(0, 0, -firstDays))
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range dormant {
+			lastActivity := userLastActivity(user)
+
+			if policy.BlockAfterDays > 0 && !user.IsBlocked {
+				dueAt := lastActivity.AddDate(0, 0, policy.BlockAfterDays)
+				if !dueAt.After(horizon) {
+					forecast = append(forecast, userLifecycleForecast{
+						user:  user,
+						entry: dto.ToRespUserLifecycleReport(user, constants.UserLifecycleActionBlock, constants.UserLifecycleReasonDormant, dueAt),
+					})
+				}
+			}
+
+			if policy.DeactivateAfterDays > 0 {
+				dueAt := lastActivity.AddDate(0, 0, policy.DeactivateAfterDays)
+				if !dueAt.After(horizon) {
+					forecast = append(forecast, userLifecycleForecast{
+						user:  user,
+						entry: dto.ToRespUserLifecycleReport(user, constants.UserLifecycleActionDeactivate, constants.UserLifecycleReasonDormant, dueAt),
+					})
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(forecast, func(i, j int) bool {
+		return forecast[i].entry.DueAt.Before(forecast[j].entry.DueAt)
+	})
+
+	return forecast, nil
+}
+
+// userLastActivity returns the latest of the last login, the last manual reactivation / unblock and the creation of the user
+func userLastActivity(user models.User) time.Time {
+	lastActivity := user.CreatedAt
+	for _, at := range []*time.Time{user.LastLoginAt, user.ReactivatedAt} {
+		if at != nil && at.After(lastActivity) {
+			lastActivity = *at
+		}
+	}
+	return lastActivity
+}
+
+func (u *userUsecase) applyLifecycleSideEffects(ctx context.Context, user models.User, action string, reason string) {
+	// revoke user token
+	token_storage.RevokeAllUserSessions(ctx, user.ID)
+
+	historyReason := constants.UserStatusReasonDormant
+	if reason == constants.UserLifecycleReasonScheduled {
+		historyReason = constants.UserStatusReasonScheduled
+	}
+	u.createUserStatusHistory(ctx, user, action, historyReason, nil, constants.UserStatusChangedBySystem)
+
+	u.sendAccountNotification(user,
+		fmt.Sprintf(constants.UserLifecycleAppliedSubject, lifecycleActionPastTense(action)),
+		fmt.Sprintf(constants.UserLifecycleAppliedMessage, lifecycleActionPastTense(action), lifecycleReasonText(reason)),
+	)
+}
+
+func (u *userUsecase) sendLifecycleWarning(user models.User, entry dto.RespUserLifecycleReport) {
+	u.sendAccountNotification(user,
+		fmt.Sprintf(constants.UserLifecycleWarningSubject, lifecycleActionPastTense(entry.Action)),
+		fmt.Sprintf(constants.UserLifecycleWarningMessage, lifecycleActionPastTense(entry.Action), entry.DueAt.Format(constants.UserLifecycleWarningDateFormat), lifecycleReasonText(entry.Reason)),
+	)
+}
+
+// sendAccountNotification publishes an account notification email, best effort
+func (u *userUsecase) sendAccountNotification(user models.User, subject string, message string) {
+	if u.queue == nil || user.Email == "" {
+		// In tests or environments without queue, skip sending
+		return
+	}
+
+	payload, err := json.Marshal(tasks.AccountNotificationEmailPayload{
+		UserID:  user.ID,
+		Email:   user.Email,
+		Name:    user.FullName,
+		Subject: subject,
+		Message: message,
+	})
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return
+	}
+
+	if err := u.queue.Send(tasks.TypeEmailAccountNotification, payload); err != nil {
+		utils.Logger.Error("failed to queue account notification email", zap.String("user_id", user.ID.String()), zap.Error(err))
+	}
+}
+
+func lifecycleActionPastTense(action string) string {
+	if action == constants.UserLifecycleActionBlock {
+		return "blocked"
+	}
+	return "deactivated"
+}
+
+func lifecycleReasonText(reason string) string {
+	if reason == constants.UserLifecycleReasonScheduled {
+		return constants.UserLifecycleWarningScheduled
+	}
+	return constants.UserLifecycleWarningDormant
+}
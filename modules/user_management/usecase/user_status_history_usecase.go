@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/models"
+	"github.com/rendyfutsuy/base-go/modules/user_management/dto"
+	"github.com/rendyfutsuy/base-go/utils"
+	"go.uber.org/zap"
+)
+
+func (u *userUsecase) GetUserStatusHistories(ctx context.Context, id string) (histories []models.UserStatusHistory, err error) {
+	uId, err := utils.StringToUUID(id)
+	if err != nil {
+		utils.Logger.Error(err.Error())
+		return nil, err
+	}
+
+	return u.userRepo.GetUserStatusHistories(ctx, uId)
+}
+
+// recordUserStatusChange stores the status change made by an administrator and notifies the user by email
+func (u *userUsecase) recordUserStatusChange(ctx context.Context, user models.User, action string, reason string, unblockAt *time.Time, authId string) {
+	u.createUserStatusHistory(ctx, user, action, reason, unblockAt, authId)
+
+	message := fmt.Sprintf(constants.UserStatusChangedMessage, userStatusActionPastTense(action), reason)
+	if unblockAt != nil {
+		message += fmt.Sprintf(constants.UserStatusChangedUntilMessage, unblockAt.Format(constants.UserStatusChangedUntilFormat))
+	}
+
+	u.sendAccountNotification(user,
+		fmt.Sprintf(constants.UserStatusChangedSubject, userStatusActionPastTense(action)),
+		message,
+	)
+}
+
+// createUserStatusHistory stores a status history entry, best effort: the status change itself already succeeded
+func (u *userUsecase) createUserStatusHistory(ctx context.Context, user models.User, action string, reason string, unblockAt *time.Time, createdBy string) {
+	err := u.userRepo.CreateUserStatusHistory(ctx, dto.ToDBCreateUserStatusHistory{
+		UserID:    user.ID,
+		Action:    action,
+		Reason:    reason,
+		UnblockAt: unblockAt,
+		CreatedBy: createdBy,
+	})
+	if err != nil {
+		utils.Logger.Error("failed to record user status history", zap.String("user_id", user.ID.String()), zap.String("action", action), zap.Error(err))
+	}
+}
+
+// autoUnblockUsers unblocks users whose block period has ended, returns the number of unblocked users
+func (u *userUsecase) autoUnblockUsers(ctx context.Context, now time.Time) (int, error) {
+	users, err := u.userRepo.GetUsersDueForAutoUnblock(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	unblocked := 0
+	for _, user := range users {
+		if _, err := u.userRepo.UnBlockUser(ctx, user.ID); err != nil {
+			utils.Logger.Error("failed to auto unblock user", zap.String("user_id", user.ID.String()), zap.Error(err))
+			continue
+		}
+		if err := u.userRepo.MarkUserReactivated(ctx, user.ID); err != nil {
+			utils.Logger.Warn("failed to restart dormant period", zap.String("user_id", user.ID.String()), zap.Error(err))
+		}
+
+		u.createUserStatusHistory(ctx, user, constants.UserStatusActionUnblock, constants.UserStatusReasonAutoUnblock, nil, constants.UserStatusChangedBySystem)
+		u.sendAccountNotification(user,
+			fmt.Sprintf(constants.UserStatusChangedSubject, userStatusActionPastTense(constants.UserStatusActionUnblock)),
+			constants.UserStatusAutoUnblockedMessage,
+		)
+		unblocked++
+	}
+
+	return unblocked, nil
+}
+
+func userStatusActionPastTense(action string) string {
+	switch action {
+	case constants.UserStatusActionBlock:
+		return "blocked"
+	case constants.UserStatusActionUnblock:
+		return "unblocked"
+	case constants.UserStatusActionActivate:
+		return "activated"
+	default:
+		return "deactivated"
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/newrelic/go-agent/v3/integrations/nrecho-v4"
 	"github.com/newrelic/go-agent/v3/newrelic"
 	"github.com/redis/go-redis/v9"
+	"github.com/rendyfutsuy/base-go/constants"
 	_ "github.com/rendyfutsuy/base-go/docs"
 	"github.com/rendyfutsuy/base-go/utils"
 	"github.com/rendyfutsuy/base-go/utils/services"
@@ -56,6 +57,9 @@ import (
 	_parameterController "github.com/rendyfutsuy/base-go/modules/parameter/delivery/http"
 	_parameterRepo "github.com/rendyfutsuy/base-go/modules/parameter/repository"
 	_parameterService "github.com/rendyfutsuy/base-go/modules/parameter/usecase"
+	_parameterTypeController "github.com/rendyfutsuy/base-go/modules/parameter_type/delivery/http"
+	_parameterTypeRepo "github.com/rendyfutsuy/base-go/modules/parameter_type/repository"
+	_parameterTypeService "github.com/rendyfutsuy/base-go/modules/parameter_type/usecase"
 
 	_regencyController "github.com/rendyfutsuy/base-go/modules/regency/delivery/http"
 	_regencyRepo "github.com/rendyfutsuy/base-go/modules/regency/repository"
@@ -73,15 +77,40 @@ import (
 	_expeditionRepo "github.com/rendyfutsuy/base-go/modules/expedition/repository"
 	_expeditionService "github.com/rendyfutsuy/base-go/modules/expedition/usecase"
 
+	_supplierController "github.com/rendyfutsuy/base-go/modules/supplier/delivery/http"
+	_supplierRepo "github.com/rendyfutsuy/base-go/modules/supplier/repository"
+	_supplierService "github.com/rendyfutsuy/base-go/modules/supplier/usecase"
+
+	_customerController "github.com/rendyfutsuy/base-go/modules/customer/delivery/http"
+	_customerRepo "github.com/rendyfutsuy/base-go/modules/customer/repository"
+	_customerService "github.com/rendyfutsuy/base-go/modules/customer/usecase"
+
 	_backingController "github.com/rendyfutsuy/base-go/modules/backing/delivery/http"
 	_backingRepo "github.com/rendyfutsuy/base-go/modules/backing/repository"
 	_backingService "github.com/rendyfutsuy/base-go/modules/backing/usecase"
 
+	_itemController "github.com/rendyfutsuy/base-go/modules/item/delivery/http"
+	_itemRepo "github.com/rendyfutsuy/base-go/modules/item/repository"
+	_itemService "github.com/rendyfutsuy/base-go/modules/item/usecase"
+	_priceListController "github.com/rendyfutsuy/base-go/modules/price_list/delivery/http"
+	_priceListRepo "github.com/rendyfutsuy/base-go/modules/price_list/repository"
+	_priceListService "github.com/rendyfutsuy/base-go/modules/price_list/usecase"
+	_translationController "github.com/rendyfutsuy/base-go/modules/translation/delivery/http"
+	_translationRepo "github.com/rendyfutsuy/base-go/modules/translation/repository"
+	_translationService "github.com/rendyfutsuy/base-go/modules/translation/usecase"
+
 	_fileRepo "github.com/rendyfutsuy/base-go/modules/file/repository"
 	_fileService "github.com/rendyfutsuy/base-go/modules/file/usecase"
 	_postController "github.com/rendyfutsuy/base-go/modules/post/delivery/http"
 	_postRepo "github.com/rendyfutsuy/base-go/modules/post/repository"
 	_postService "github.com/rendyfutsuy/base-go/modules/post/usecase"
+
+	_codePolicyController "github.com/rendyfutsuy/base-go/modules/code_policy/delivery/http"
+	_codePolicyRepo "github.com/rendyfutsuy/base-go/modules/code_policy/repository"
+	_codePolicyService "github.com/rendyfutsuy/base-go/modules/code_policy/usecase"
+	_recycleBinController "github.com/rendyfutsuy/base-go/modules/recycle_bin/delivery/http"
+	_recycleBinRepo "github.com/rendyfutsuy/base-go/modules/recycle_bin/repository"
+	_recycleBinService "github.com/rendyfutsuy/base-go/modules/recycle_bin/usecase"
 )
 
 func InitializedRouter(gormDB *gorm.DB, redisClient *redis.Client, qsvc queue.QueueService, timeoutContext time.Duration, v *validator.Validate, nrApp *newrelic.Application) *echo.Echo {
@@ -93,7 +122,7 @@ func InitializedRouter(gormDB *gorm.DB, redisClient *redis.Client, qsvc queue.Qu
 	router.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		Skipper:          middleware.DefaultSkipper,
 		AllowOrigins:     []string{"*"},
-		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, echo.HeaderXCSRFToken},
+		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, echo.HeaderXCSRFToken, constants.FieldAcceptLanguage},
 		AllowMethods:     []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions},
 		AllowCredentials: false,
 		MaxAge:           300,
@@ -106,6 +135,9 @@ func InitializedRouter(gormDB *gorm.DB, redisClient *redis.Client, qsvc queue.Qu
 	throttleMiddleware := authmiddleware.NewThrottleMiddleware()
 	router.Use(throttleMiddleware.Throttle())
 
+	// Localized master data names in index and detail responses, from Accept-Language
+	router.Use(authmiddleware.Locale)
+
 	router.GET("/", _homepageController.DefaultHomepage)
 	router.GET("/health/storage", _homepageController.StorageHealth)
 
@@ -134,6 +166,8 @@ func InitializedRouter(gormDB *gorm.DB, redisClient *redis.Client, qsvc queue.Qu
 
 	parameterRepo := _parameterRepo.NewParameterRepository(gormDB) // Using GORM for parameter
 
+	parameterTypeRepo := _parameterTypeRepo.NewParameterTypeRepository(gormDB) // Using GORM for parameter type
+
 	regencyRepo := _regencyRepo.NewRegencyRepository(gormDB) // Using GORM for regency
 
 	subGroupRepo := _subGroupRepo.NewSubGroupRepository(gormDB) // Using GORM for sub-group
@@ -142,11 +176,24 @@ func InitializedRouter(gormDB *gorm.DB, redisClient *redis.Client, qsvc queue.Qu
 
 	backingRepo := _backingRepo.NewBackingRepository(gormDB) // Using GORM for backing
 
+	itemRepo := _itemRepo.NewItemRepository(gormDB) // Using GORM for item
+
+	priceListRepo := _priceListRepo.NewPriceListRepository(gormDB) // Using GORM for price list
+
+	translationRepo := _translationRepo.NewTranslationRepository(gormDB) // Using GORM for translation
+
 	expeditionRepo := _expeditionRepo.NewExpeditionRepository(gormDB) // Using GORM for expedition
 
+	supplierRepo := _supplierRepo.NewSupplierRepository(gormDB) // Using GORM for supplier
+	customerRepo := _customerRepo.NewCustomerRepository(gormDB) // Using GORM for customer
+
 	postRepo := _postRepo.NewPostRepository(gormDB) // Using GORM for Post
 	fileRepo := _fileRepo.NewFileRepository(gormDB) // Using GORM for File
 
+	recycleBinRepo := _recycleBinRepo.NewRecycleBinRepository(gormDB) // Using GORM for recycle bin
+
+	codePolicyRepo := _codePolicyRepo.NewCodePolicyRepository(gormDB) // Using GORM for code policy
+
 	// Middlewares ------------------------------------------------------------------------------------------------------------------------------------------------------
 	middlewareAuth := authmiddleware.NewMiddlewareAuth()
 	middlewarePermission := roleMiddleware.NewMiddlewarePermission(
@@ -167,6 +214,9 @@ func InitializedRouter(gormDB *gorm.DB, redisClient *redis.Client, qsvc queue.Qu
 		return c.JSON(http.StatusOK, map[string]string{"message": "This operation is protected from race conditions"})
 	})
 
+	// Parameter lookup (shared by modules), cached and invalidated through Redis when available
+	parameterLookup := _parameterService.NewParameterLookup(parameterRepo, redisClient, _parameterService.LookupCacheTTLFromConfig())
+
 	// File usecase (shared by modules)
 	fileService := _fileService.NewFileUsecase(fileRepo)
 
@@ -228,7 +278,7 @@ func InitializedRouter(gormDB *gorm.DB, redisClient *redis.Client, qsvc queue.Qu
 	)
 
 	// parameter management
-	parameterService := _parameterService.NewParameterUsecase(parameterRepo)
+	parameterService := _parameterService.NewParameterUsecase(parameterRepo, parameterTypeRepo, parameterLookup)
 	_parameterController.NewParameterHandler(
 		router,
 		parameterService,
@@ -237,6 +287,15 @@ func InitializedRouter(gormDB *gorm.DB, redisClient *redis.Client, qsvc queue.Qu
 		middlewarePermission,
 	)
 
+	// parameter type management
+	parameterTypeService := _parameterTypeService.NewParameterTypeUsecase(parameterTypeRepo)
+	_parameterTypeController.NewParameterTypeHandler(
+		router,
+		parameterTypeService,
+		middlewareAuth,
+		middlewarePermission,
+	)
+
 	// regency management
 	regencyService := _regencyService.NewRegencyUsecase(regencyRepo)
 	_regencyController.NewRegencyHandler(
@@ -287,8 +346,58 @@ func InitializedRouter(gormDB *gorm.DB, redisClient *redis.Client, qsvc queue.Qu
 		middlewarePermission,
 	)
 
+	// supplier management
+	supplierService := _supplierService.NewSupplierUsecase(supplierRepo)
+	_supplierController.NewSupplierHandler(
+		router,
+		supplierService,
+		middlewarePageRequest,
+		middlewareAuth,
+		middlewarePermission,
+	)
+
+	// customer management
+	customerService := _customerService.NewCustomerUsecase(customerRepo, parameterRepo)
+	_customerController.NewCustomerHandler(
+		router,
+		customerService,
+		middlewarePageRequest,
+		middlewareAuth,
+		middlewarePermission,
+	)
+
+	// item management
+	itemService := _itemService.NewItemUsecase(itemRepo, fileService)
+	_itemController.NewItemHandler(
+		router,
+		itemService,
+		middlewarePageRequest,
+		middlewareAuth,
+		middlewarePermission,
+	)
+
+	// price list management
+	priceListService := _priceListService.NewPriceListUsecase(priceListRepo, parameterRepo)
+	_priceListController.NewPriceListHandler(
+		router,
+		priceListService,
+		middlewarePageRequest,
+		middlewareAuth,
+		middlewarePermission,
+	)
+
+	// translation management (localized names of master data)
+	translationService := _translationService.NewTranslationUsecase(translationRepo)
+	_translationController.NewTranslationHandler(
+		router,
+		translationService,
+		middlewarePageRequest,
+		middlewareAuth,
+		middlewarePermission,
+	)
+
 	// post management (public index & detail, protected create/update/delete)
-	postService := _postService.NewPostUsecase(postRepo, parameterRepo, fileService)
+	postService := _postService.NewPostUsecase(postRepo, parameterRepo, parameterLookup, fileService)
 	_postController.NewPostHandler(
 		router,
 		postService,
@@ -297,13 +406,46 @@ func InitializedRouter(gormDB *gorm.DB, redisClient *redis.Client, qsvc queue.Qu
 		middlewarePermission,
 	)
 
+	// recycle bin (list, restore and permanently delete soft deleted records)
+	recycleBinService := _recycleBinService.NewRecycleBinUsecase(recycleBinRepo, _recycleBinService.RetentionDaysFromConfig())
+	_recycleBinController.NewRecycleBinHandler(
+		router,
+		recycleBinService,
+		middlewarePageRequest,
+		middlewareAuth,
+		middlewarePermission,
+	)
+
+	// code generation policies of master data codes
+	codePolicyService := _codePolicyService.NewCodePolicyUsecase(codePolicyRepo)
+	_codePolicyController.NewCodePolicyHandler(
+		router,
+		codePolicyService,
+		middlewareAuth,
+		middlewarePermission,
+	)
+
 	usecaseRegistry := worker.UsecaseRegistry{
+		UserManagement: userManagementService,
+		RecycleBin:     recycleBinService,
+		Post:           postService,
 		// Add any other usecases that your background jobs might need
 	}
 
 	dispatcher := worker.NewDispatcher(10, usecaseRegistry) // Using 10 workers, for example
 	dispatcher.Run()
 
+	// periodic jobs
+	if interval := _userManagementService.UserLifecycleIntervalFromConfig(); interval > 0 {
+		worker.NewScheduler(interval, constants.JobTypeUserLifecyclePolicy).Start()
+	}
+	if interval := _recycleBinService.PurgeIntervalFromConfig(); interval > 0 {
+		worker.NewScheduler(interval, constants.JobTypeRecycleBinPurge).Start()
+	}
+	if interval := _postService.PublishIntervalFromConfig(); interval > 0 {
+		worker.NewScheduler(interval, constants.JobTypePostPublishScheduled).Start()
+	}
+
 	time.Sleep(1000 * time.Millisecond)
 	return router
 }
@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rendyfutsuy/base-go/constants"
+)
+
+var (
+	phoneSeparatorRegex     = regexp.MustCompile(`[\s\-().]`)
+	phoneE164IndonesiaRegex = regexp.MustCompile(`^\+62[1-9][0-9]{4,12}$`)
+)
+
+// NormalizeIndonesianPhoneNumber converts a phone number into E.164 (e.g. +6221555).
+// The area code is only combined with local numbers, so "021" + "555", "021-555" and "+6221555" all normalize to the same value.
+func NormalizeIndonesianPhoneNumber(areaCode *string, phoneNumber string) (string, error) {
+	number := phoneSeparatorRegex.ReplaceAllString(phoneNumber, "")
+	if number == "" {
+		return "", errors.New(constants.PhoneNumberEmpty)
+	}
+
+	if !strings.HasPrefix(number, "+") && !strings.HasPrefix(number, "0") && areaCode != nil {
+		if ac := phoneSeparatorRegex.ReplaceAllString(*areaCode, ""); ac != "" {
+			number = ac + number
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(number, "+"):
+	case strings.HasPrefix(number, "0"):
+		number = "+" + constants.PhoneCountryCodeIndonesia + strings.TrimPrefix(number, "0")
+	case strings.HasPrefix(number, constants.PhoneCountryCodeIndonesia):
+		number = "+" + number
+	default:
+		number = "+" + constants.PhoneCountryCodeIndonesia + number
+	}
+
+	if !phoneE164IndonesiaRegex.MatchString(number) {
+		return "", fmt.Errorf(constants.PhoneNumberInvalid, strings.TrimSpace(phoneNumber))
+	}
+	return number, nil
+}
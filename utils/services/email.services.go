@@ -77,3 +77,16 @@ func (s *EmailService) SendVerificationEmail(email, code string) error {
 	d := gomail.NewDialer(s.smtpHost, s.smtpPort, s.authEmail, s.authPassword)
 	return d.DialAndSend(m)
 }
+
+func (s *EmailService) SendAccountNotificationEmail(email, name, subject, message string) error {
+	body := fmt.Sprintf("<p>Hi %s,</p><p>%s</p>", template.HTMLEscapeString(name), template.HTMLEscapeString(message))
+
+	m := gomail.NewMessage()
+	m.SetHeader("From", s.senderEmail)
+	m.SetHeader("To", email)
+	m.SetHeader("Subject", subject)
+	m.SetBody("text/html", body)
+
+	d := gomail.NewDialer(s.smtpHost, s.smtpPort, s.authEmail, s.authPassword)
+	return d.DialAndSend(m)
+}
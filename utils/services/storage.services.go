@@ -54,6 +54,11 @@ func InitStorage(driver string) error {
 	return nil
 }
 
+// SetStorage replaces the configured storage provider, used by tests.
+func SetStorage(s storage.Storage) {
+	defaultStorage = s
+}
+
 // GetFullURL return full URL of a relative path based on storage driver.
 //
 // It takes the document relative path as parameter.
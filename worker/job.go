@@ -1,6 +1,8 @@
 package worker
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
 	"github.com/rendyfutsuy/base-go/constants"
 )
@@ -14,3 +16,22 @@ type Job struct {
 
 // JobQueue is the central channel for submitting background jobs.
 var JobQueue chan Job
+
+// Dispatch submits a job to the JobQueue without blocking the caller.
+// It returns an error when the dispatcher is not running or the queue is full.
+func Dispatch(job Job) error {
+	if JobQueue == nil {
+		return errors.New("background worker is not running")
+	}
+
+	if job.ID == uuid.Nil {
+		job.ID = uuid.New()
+	}
+
+	select {
+	case JobQueue <- job:
+		return nil
+	default:
+		return errors.New("background job queue is full")
+	}
+}
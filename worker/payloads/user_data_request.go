@@ -0,0 +1,9 @@
+package payloads
+
+import "github.com/google/uuid"
+
+// UserDataRequestPayload carries the user_data_requests row to be processed
+// by the export / erasure jobs.
+type UserDataRequestPayload struct {
+	RequestID uuid.UUID
+}
@@ -0,0 +1,49 @@
+package worker
+
+import (
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendyfutsuy/base-go/constants"
+)
+
+// Scheduler periodically submits a job of the given type to the JobQueue.
+type Scheduler struct {
+	interval time.Duration
+	jobType  constants.JobType
+	quit     chan bool
+}
+
+// NewScheduler creates a new scheduler. Start it after the dispatcher is running.
+func NewScheduler(interval time.Duration, jobType constants.JobType) *Scheduler {
+	return &Scheduler{
+		interval: interval,
+		jobType:  jobType,
+		quit:     make(chan bool),
+	}
+}
+
+// Start begins submitting jobs on every tick until Stop is called.
+func (s *Scheduler) Start() {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := Dispatch(Job{ID: uuid.New(), Type: s.jobType}); err != nil {
+					log.Printf("Scheduler: failed to dispatch job of type %s: %v\n", s.jobType, err)
+				}
+			case <-s.quit:
+				return
+			}
+		}
+	}()
+	log.Printf("Scheduler for %s is running every %s.\n", s.jobType, s.interval)
+}
+
+// Stop stops the scheduler.
+func (s *Scheduler) Stop() {
+	close(s.quit)
+}
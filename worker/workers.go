@@ -1,13 +1,25 @@
 package worker
 
 import (
+	"context"
 	"log"
+
 	// 💡 2. Import the packages containing the usecase INTERFACES, not the implementation folders.
-	// ..
+	"github.com/rendyfutsuy/base-go/constants"
+	"github.com/rendyfutsuy/base-go/modules/post"
+	postDto "github.com/rendyfutsuy/base-go/modules/post/dto"
+	"github.com/rendyfutsuy/base-go/modules/recycle_bin"
+	recycleBinDto "github.com/rendyfutsuy/base-go/modules/recycle_bin/dto"
+	"github.com/rendyfutsuy/base-go/modules/user_management"
+	"github.com/rendyfutsuy/base-go/modules/user_management/dto"
+	"github.com/rendyfutsuy/base-go/worker/payloads"
 )
 
 // UsecaseRegistry holds all the usecase interfaces that the worker might need.
 type UsecaseRegistry struct {
+	UserManagement user_management.Usecase
+	RecycleBin     recycle_bin.Usecase
+	Post           post.Usecase
 	// Add other usecase interfaces here as needed
 }
 
@@ -47,5 +59,60 @@ func (w Worker) Start() {
 
 // processJob is the worker's router. It delegates the job to the correct usecase.
 func (w Worker) processJob(job Job) {
-	// TBA
+	ctx := context.Background()
+
+	var err error
+	switch job.Type {
+	case constants.JobTypeUserDataExport:
+		payload, ok := job.Payload.(payloads.UserDataRequestPayload)
+		if !ok || w.usecases.UserManagement == nil {
+			log.Printf("Worker %d: invalid job %s of type %s\n", w.ID, job.ID, job.Type)
+			return
+		}
+		err = w.usecases.UserManagement.ProcessUserDataExport(ctx, payload.RequestID)
+	case constants.JobTypeUserDataErasure:
+		payload, ok := job.Payload.(payloads.UserDataRequestPayload)
+		if !ok || w.usecases.UserManagement == nil {
+			log.Printf("Worker %d: invalid job %s of type %s\n", w.ID, job.ID, job.Type)
+			return
+		}
+		err = w.usecases.UserManagement.ProcessUserDataErasure(ctx, payload.RequestID)
+	case constants.JobTypeUserLifecyclePolicy:
+		if w.usecases.UserManagement == nil {
+			log.Printf("Worker %d: invalid job %s of type %s\n", w.ID, job.ID, job.Type)
+			return
+		}
+		var res *dto.RespUserLifecycleRun
+		res, err = w.usecases.UserManagement.ApplyUserLifecyclePolicy(ctx)
+		if err == nil {
+			log.Printf("Worker %d: user lifecycle policy applied: warned=%d blocked=%d deactivated=%d unblocked=%d\n", w.ID, res.Warned, res.Blocked, res.Deactivated, res.Unblocked)
+		}
+	case constants.JobTypeRecycleBinPurge:
+		if w.usecases.RecycleBin == nil {
+			log.Printf("Worker %d: invalid job %s of type %s\n", w.ID, job.ID, job.Type)
+			return
+		}
+		var res *recycleBinDto.RespRecycleBinPurge
+		res, err = w.usecases.RecycleBin.PurgeExpired(ctx)
+		if res != nil {
+			log.Printf("Worker %d: recycle bin purged: purged=%d skipped=%d\n", w.ID, res.Purged, res.Skipped)
+		}
+	case constants.JobTypePostPublishScheduled:
+		if w.usecases.Post == nil {
+			log.Printf("Worker %d: invalid job %s of type %s\n", w.ID, job.ID, job.Type)
+			return
+		}
+		var res *postDto.RespPublishScheduledPosts
+		res, err = w.usecases.Post.PublishScheduled(ctx)
+		if err == nil {
+			log.Printf("Worker %d: scheduled posts published: published=%d\n", w.ID, res.Published)
+		}
+	default:
+		log.Printf("Worker %d: unknown job type %s\n", w.ID, job.Type)
+		return
+	}
+
+	if err != nil {
+		log.Printf("Worker %d: job %s of type %s failed: %v\n", w.ID, job.ID, job.Type, err)
+	}
 }